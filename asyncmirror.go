@@ -0,0 +1,1047 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// Asynchronous remote volume mirroring (ARVM, /async-mirrors) copies the changes to the volumes of a mirror group
+// to another array at intervals, or when asked to. Each interval ends with a recovery point on the secondary array
+// that is consistent across the volumes of the group. A group is created on the primary array and exists on both;
+// volumes are added to it in pairs of a primary volume and a secondary volume of at least the same size, each with
+// a repository volume that tracks changes. A pair added on the primary may be left incomplete on the secondary
+// until its secondary volume is given there. The roles of the arrays can be reversed for failover and failback.
+// MirrorPeers drives both arrays of a group.
+
+// GetAsyncMirrorGroups returns the asynchronous mirror groups of the array, whichever its role in them.
+func (d Client) GetAsyncMirrorGroups(ctx context.Context) ([]AsyncMirrorGroup, error) {
+	ctx, span := d.startSpan(ctx, "GetAsyncMirrorGroups")
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "GetAsyncMirrorGroups",
+			"Type":   "Client",
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetAsyncMirrorGroups")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetAsyncMirrorGroups")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/async-mirrors")
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "failed to read async mirror groups")
+	}
+
+	groups := make([]AsyncMirrorGroup, 0)
+	if err := json.Unmarshal(responseBody, &groups); err != nil {
+		return nil, fmt.Errorf("could not parse async mirror groups: %s; %v", string(responseBody), err)
+	}
+	return groups, nil
+}
+
+// GetAsyncMirrorGroup returns an asynchronous mirror group. The returned error matches ErrNotFound if the group
+// does not exist.
+func (d Client) GetAsyncMirrorGroup(ctx context.Context, groupRef string) (*AsyncMirrorGroup, error) {
+	ctx, span := d.startSpan(ctx, "GetAsyncMirrorGroup", AttributeMirrorGroupRef.String(groupRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":   "GetAsyncMirrorGroup",
+			"Type":     "Client",
+			"groupRef": groupRef,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetAsyncMirrorGroup")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetAsyncMirrorGroup")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/async-mirrors/"+groupRef)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not get async mirror group %s", groupRef)
+	}
+
+	return parseAsyncMirrorGroup(responseBody)
+}
+
+// CreateAsyncMirrorGroup creates an asynchronous mirror group with this array as the primary. The secondary is
+// given by its ID as known to this array, from GetAsyncMirrorTargets; MirrorPeers.CreateAsyncMirrorGroup looks it
+// up. The group is created on the secondary array as well.
+func (d Client) CreateAsyncMirrorGroup(
+	ctx context.Context, request v11.AsyncMirrorGroupCreateRequest,
+) (*AsyncMirrorGroup, error) {
+	ctx, span := d.startSpan(ctx, "CreateAsyncMirrorGroup", AttributeName.String(request.Name))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":  "CreateAsyncMirrorGroup",
+			"Type":    "Client",
+			"request": request,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> CreateAsyncMirrorGroup")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< CreateAsyncMirrorGroup")
+	}
+
+	if request.Name == "" || request.SecondaryArrayID == "" {
+		return nil, fmt.Errorf("a group name and a secondary array are required: %w", ErrInvalidArgument)
+	}
+	if len(request.Name) > maxNameLength {
+		return nil, fmt.Errorf("the group name %s is longer than %d characters: %w", request.Name, maxNameLength,
+			ErrInvalidArgument)
+	}
+	// Firmware reports asynchronous mirroring as the second type of remote mirroring
+	if err := d.checkFeature("asynchronous mirroring", "remoteMirroringType2", "remoteMirroring"); err != nil {
+		return nil, err
+	}
+
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/async-mirrors")
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return nil, d.newAPIError(response, responseBody, "could not create async mirror group %s", request.Name)
+	}
+
+	group, err := parseAsyncMirrorGroup(responseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	Logc(ctx).WithFields(log.Fields{
+		"Name":           group.Label,
+		"GroupRef":       group.GroupRef,
+		"SecondaryArray": request.SecondaryArrayID,
+	}).Debug("Created async mirror group.")
+
+	return group, nil
+}
+
+// UpdateAsyncMirrorGroup renames an asynchronous mirror group or changes its synchronization interval and alert
+// thresholds. Only the fields set in the request are changed.
+func (d Client) UpdateAsyncMirrorGroup(
+	ctx context.Context, groupRef string, request v11.AsyncMirrorGroupUpdateRequest,
+) (*AsyncMirrorGroup, error) {
+	ctx, span := d.startSpan(ctx, "UpdateAsyncMirrorGroup", AttributeMirrorGroupRef.String(groupRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":   "UpdateAsyncMirrorGroup",
+			"Type":     "Client",
+			"groupRef": groupRef,
+			"request":  request,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> UpdateAsyncMirrorGroup")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< UpdateAsyncMirrorGroup")
+	}
+
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/async-mirrors/"+groupRef)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not update async mirror group %s", groupRef)
+	}
+
+	return parseAsyncMirrorGroup(responseBody)
+}
+
+// DeleteAsyncMirrorGroup removes an empty asynchronous mirror group from both arrays. The returned error matches
+// ErrConflict if the group still has pairs.
+func (d Client) DeleteAsyncMirrorGroup(ctx context.Context, groupRef string) error {
+	ctx, span := d.startSpan(ctx, "DeleteAsyncMirrorGroup", AttributeMirrorGroupRef.String(groupRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":   "DeleteAsyncMirrorGroup",
+			"Type":     "Client",
+			"groupRef": groupRef,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> DeleteAsyncMirrorGroup")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< DeleteAsyncMirrorGroup")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "DELETE", "/async-mirrors/"+groupRef)
+	if err != nil {
+		return fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return d.newAPIError(response, responseBody, "could not delete async mirror group %s", groupRef)
+	}
+	return nil
+}
+
+// GetAsyncMirrorTargets returns the arrays that this array can mirror to, with the IDs that
+// CreateAsyncMirrorGroup expects. With compatibleOnly, arrays that cannot take part in asynchronous mirroring,
+// e.g. for lack of a connection, are left out.
+func (d Client) GetAsyncMirrorTargets(ctx context.Context, compatibleOnly bool) ([]v11.RemoteCandidate, error) {
+	ctx, span := d.startSpan(ctx, "GetAsyncMirrorTargets")
+	defer span.End()
+
+	resourcePath := "/async-mirrors/arvm-arrays"
+	if compatibleOnly {
+		resourcePath += "?compatibleOnly=true"
+	}
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", resourcePath)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "failed to read async mirror target arrays")
+	}
+
+	targets := make([]v11.RemoteCandidate, 0)
+	if err := json.Unmarshal(responseBody, &targets); err != nil {
+		return nil, fmt.Errorf("could not parse async mirror target arrays: %s; %v", string(responseBody), err)
+	}
+	return targets, nil
+}
+
+// GetAsyncMirrorDefaults returns the defaults and limits of asynchronous mirroring on the array, such as the
+// shortest synchronization interval and the default repository capacity.
+func (d Client) GetAsyncMirrorDefaults(ctx context.Context) (*v11.ArvmDefaultsResponse, error) {
+	ctx, span := d.startSpan(ctx, "GetAsyncMirrorDefaults")
+	defer span.End()
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/features/defaults/arvm")
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "failed to read async mirror defaults")
+	}
+
+	var defaults v11.ArvmDefaultsResponse
+	if err := json.Unmarshal(responseBody, &defaults); err != nil {
+		return nil, fmt.Errorf("could not parse async mirror defaults: %s; %v", string(responseBody), err)
+	}
+	return &defaults, nil
+}
+
+// GetAsyncMirrorPairs returns the pairs of an asynchronous mirror group, or those of all groups if groupRef is
+// empty.
+func (d Client) GetAsyncMirrorPairs(ctx context.Context, groupRef string) ([]AsyncMirrorPair, error) {
+	ctx, span := d.startSpan(ctx, "GetAsyncMirrorPairs", AttributeMirrorGroupRef.String(groupRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":   "GetAsyncMirrorPairs",
+			"Type":     "Client",
+			"groupRef": groupRef,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetAsyncMirrorPairs")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetAsyncMirrorPairs")
+	}
+
+	resourcePath := "/async-mirrors/pairs"
+	if groupRef != "" {
+		resourcePath = "/async-mirrors/" + groupRef + "/pairs"
+	}
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", resourcePath)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "failed to read async mirror pairs")
+	}
+
+	pairs := make([]AsyncMirrorPair, 0)
+	if err := json.Unmarshal(responseBody, &pairs); err != nil {
+		return nil, fmt.Errorf("could not parse async mirror pairs: %s; %v", string(responseBody), err)
+	}
+	return pairs, nil
+}
+
+// GetAsyncMirrorPair returns a pair of an asynchronous mirror group. The returned error matches ErrNotFound if the
+// pair does not exist.
+func (d Client) GetAsyncMirrorPair(ctx context.Context, memberRef string) (*AsyncMirrorPair, error) {
+	ctx, span := d.startSpan(ctx, "GetAsyncMirrorPair", AttributeMirrorRef.String(memberRef))
+	defer span.End()
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/async-mirrors/pairs/"+memberRef)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not get async mirror pair %s", memberRef)
+	}
+
+	return parseAsyncMirrorPair(responseBody)
+}
+
+// AddAsyncMirrorPair adds a volume of the primary array to an asynchronous mirror group, creating the repository
+// volumes of the pair. Depending on the web services, a pair added without a SecondaryVolumeRef, or added through
+// the embedded web services of the primary, may stay incomplete until CompleteAsyncMirrorPair is called on the
+// secondary; MirrorPeers.AddAsyncMirrorPair takes care of that.
+func (d Client) AddAsyncMirrorPair(
+	ctx context.Context, groupRef string, request v11.AsyncMirrorGroupMemberCreateRequest,
+) (*AsyncMirrorPair, error) {
+	ctx, span := d.startSpan(ctx, "AddAsyncMirrorPair", AttributeMirrorGroupRef.String(groupRef),
+		AttributeVolumeRef.String(request.PrimaryVolumeRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":   "AddAsyncMirrorPair",
+			"Type":     "Client",
+			"groupRef": groupRef,
+			"request":  request,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> AddAsyncMirrorPair")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< AddAsyncMirrorPair")
+	}
+
+	if request.PrimaryVolumeRef == "" {
+		return nil, fmt.Errorf("a primary volume is required: %w", ErrInvalidArgument)
+	}
+
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/async-mirrors/"+groupRef+"/pairs")
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return nil, d.newAPIError(response, responseBody, "could not add volume %s to async mirror group %s",
+			request.PrimaryVolumeRef, groupRef)
+	}
+
+	pair, err := parseAsyncMirrorPair(responseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	Logc(ctx).WithFields(log.Fields{
+		"GroupRef":    groupRef,
+		"MemberRef":   pair.MemberRef,
+		"Volume":      pair.LocalVolume,
+		"MemberState": pair.MemberState,
+	}).Debug("Added async mirror pair.")
+
+	return pair, nil
+}
+
+// RemoveAsyncMirrorPair removes a pair from an asynchronous mirror group, with its repository volumes. The
+// volumes themselves are kept, on both arrays.
+func (d Client) RemoveAsyncMirrorPair(ctx context.Context, groupRef, memberRef string) error {
+	ctx, span := d.startSpan(ctx, "RemoveAsyncMirrorPair", AttributeMirrorGroupRef.String(groupRef),
+		AttributeMirrorRef.String(memberRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":    "RemoveAsyncMirrorPair",
+			"Type":      "Client",
+			"groupRef":  groupRef,
+			"memberRef": memberRef,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> RemoveAsyncMirrorPair")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< RemoveAsyncMirrorPair")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "DELETE", "/async-mirrors/"+groupRef+"/pairs/"+memberRef)
+	if err != nil {
+		return fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return d.newAPIError(response, responseBody, "could not remove pair %s from async mirror group %s",
+			memberRef, groupRef)
+	}
+	return nil
+}
+
+// GetIncompleteAsyncMirrorPairs returns the pairs that were added on the primary array but still lack a secondary
+// volume on this one, for an asynchronous mirror group or, if groupRef is empty, all groups.
+func (d Client) GetIncompleteAsyncMirrorPairs(ctx context.Context, groupRef string) ([]v11.AmgIncompleteMember, error) {
+	ctx, span := d.startSpan(ctx, "GetIncompleteAsyncMirrorPairs", AttributeMirrorGroupRef.String(groupRef))
+	defer span.End()
+
+	resourcePath := "/async-mirrors/incomplete-pairs"
+	if groupRef != "" {
+		resourcePath += "/" + groupRef
+	}
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", resourcePath)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "failed to read incomplete async mirror pairs")
+	}
+
+	pairs := make([]v11.AmgIncompleteMember, 0)
+	if err := json.Unmarshal(responseBody, &pairs); err != nil {
+		return nil, fmt.Errorf("could not parse incomplete async mirror pairs: %s; %v", string(responseBody), err)
+	}
+	return pairs, nil
+}
+
+// CompleteAsyncMirrorPair completes an incomplete pair on the secondary array with the volume that is to receive
+// the data of the primary volume. The initial synchronization of the pair starts once it is complete.
+func (d Client) CompleteAsyncMirrorPair(
+	ctx context.Context, memberRef string, request v11.AsyncMirrorGroupMemberCompletionRequest,
+) (*AsyncMirrorPair, error) {
+	ctx, span := d.startSpan(ctx, "CompleteAsyncMirrorPair", AttributeMirrorRef.String(memberRef),
+		AttributeVolumeRef.String(request.SecondaryVolumeRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":    "CompleteAsyncMirrorPair",
+			"Type":      "Client",
+			"memberRef": memberRef,
+			"request":   request,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> CompleteAsyncMirrorPair")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< CompleteAsyncMirrorPair")
+	}
+
+	if request.SecondaryVolumeRef == "" {
+		return nil, fmt.Errorf("a secondary volume is required: %w", ErrInvalidArgument)
+	}
+
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/async-mirrors/incomplete-pairs/"+memberRef)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not complete async mirror pair %s", memberRef)
+	}
+
+	return parseAsyncMirrorPair(responseBody)
+}
+
+// RemoveIncompleteAsyncMirrorPair removes an incomplete pair from the secondary array, so that the primary volume
+// is no longer waiting for a secondary.
+func (d Client) RemoveIncompleteAsyncMirrorPair(ctx context.Context, memberRef string) error {
+	ctx, span := d.startSpan(ctx, "RemoveIncompleteAsyncMirrorPair", AttributeMirrorRef.String(memberRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":    "RemoveIncompleteAsyncMirrorPair",
+			"Type":      "Client",
+			"memberRef": memberRef,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> RemoveIncompleteAsyncMirrorPair")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< RemoveIncompleteAsyncMirrorPair")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "DELETE", "/async-mirrors/incomplete-pairs/"+memberRef)
+	if err != nil {
+		return fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return d.newAPIError(response, responseBody, "could not remove incomplete async mirror pair %s", memberRef)
+	}
+	return nil
+}
+
+// GetAsyncMirrorRepositoryUtilization returns how much of the repository of an asynchronous mirror pair is in
+// use. The group raises an alert when the utilization passes its RepositoryUtilizationWarnThreshold.
+func (d Client) GetAsyncMirrorRepositoryUtilization(
+	ctx context.Context, memberRef string,
+) (*v11.AsyncMirrorRepositoryUtilization, error) {
+	ctx, span := d.startSpan(ctx, "GetAsyncMirrorRepositoryUtilization", AttributeMirrorRef.String(memberRef))
+	defer span.End()
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET",
+		"/async-mirrors/pairs/"+memberRef+"/repository-utilization")
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not get repository utilization of async mirror "+
+			"pair %s", memberRef)
+	}
+
+	var utilization v11.AsyncMirrorRepositoryUtilization
+	if err := json.Unmarshal(responseBody, &utilization); err != nil {
+		return nil, fmt.Errorf("could not parse repository utilization: %s; %v", string(responseBody), err)
+	}
+	return &utilization, nil
+}
+
+// SyncAsyncMirrorGroup starts a synchronization of an asynchronous mirror group on its primary array, outside
+// the regular intervals; AsyncMirrorSync polls it. If the secondary has no room for another recovery point, the
+// request fails unless deleteRecoveryPointIfNecessary allows the oldest one to be deleted.
+func (d Client) SyncAsyncMirrorGroup(ctx context.Context, groupRef string, deleteRecoveryPointIfNecessary bool) error {
+	var request v11.AsyncMirrorGroupSyncRequest
+	if deleteRecoveryPointIfNecessary {
+		request.DeleteRecoveryPointIfNecessary = &deleteRecoveryPointIfNecessary
+	}
+	return d.asyncMirrorGroupAction(ctx, "SyncAsyncMirrorGroup", groupRef, "sync", request)
+}
+
+// SuspendAsyncMirrorGroup stops the synchronization of an asynchronous mirror group. Writes to the primary volumes
+// are tracked, and copied to the secondary once the group is resumed.
+func (d Client) SuspendAsyncMirrorGroup(ctx context.Context, groupRef string) error {
+	return d.asyncMirrorGroupAction(ctx, "SuspendAsyncMirrorGroup", groupRef, "suspend", nil)
+}
+
+// ResumeAsyncMirrorGroup resumes the synchronization of a suspended asynchronous mirror group, starting with the
+// writes made while it was suspended.
+func (d Client) ResumeAsyncMirrorGroup(
+	ctx context.Context, groupRef string, deleteRecoveryPointIfNecessary bool,
+) error {
+	action := "resume"
+	if deleteRecoveryPointIfNecessary {
+		action += "?deleteRecoveryPointIfNecessary=true"
+	}
+	return d.asyncMirrorGroupAction(ctx, "ResumeAsyncMirrorGroup", groupRef, action, nil)
+}
+
+// asyncMirrorGroupAction posts an action that returns no content to an asynchronous mirror group.
+func (d Client) asyncMirrorGroupAction(
+	ctx context.Context, method, groupRef, action string, request interface{},
+) error {
+	ctx, span := d.startSpan(ctx, method, AttributeMirrorGroupRef.String(groupRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":   method,
+			"Type":     "Client",
+			"groupRef": groupRef,
+			"request":  request,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> " + method)
+		defer Logc(ctx).WithFields(fields).Debug("<<<< " + method)
+	}
+
+	var jsonRequest []byte
+	if request != nil {
+		var err error
+		if jsonRequest, err = json.Marshal(request); err != nil {
+			return fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
+		}
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/async-mirrors/"+groupRef+"/"+action)
+	if err != nil {
+		return fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		verb, _, _ := strings.Cut(action, "?")
+		return d.newAPIError(response, responseBody, "could not %s async mirror group %s", verb, groupRef)
+	}
+
+	Logc(ctx).WithField("GroupRef", groupRef).Debugf("Requested %s of async mirror group.", action)
+
+	return nil
+}
+
+// SetAsyncMirrorGroupRole changes the role of this array in an asynchronous mirror group, which reverses the roles
+// of both arrays. By default the change is orderly: the primary synchronizes the secondary first. With NoSync the
+// secondary rolls back to its last recovery point instead. Force promotes a secondary whose primary cannot be
+// reached; both arrays are primary once they can connect again, until one of them is made secondary.
+func (d Client) SetAsyncMirrorGroupRole(
+	ctx context.Context, groupRef string, request v11.AsyncMirrorGroupRoleUpdateRequest,
+) (*AsyncMirrorGroup, error) {
+	ctx, span := d.startSpan(ctx, "SetAsyncMirrorGroupRole", AttributeMirrorGroupRef.String(groupRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":   "SetAsyncMirrorGroupRole",
+			"Type":     "Client",
+			"groupRef": groupRef,
+			"request":  request,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> SetAsyncMirrorGroupRole")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< SetAsyncMirrorGroupRole")
+	}
+
+	if role := MirrorRole(request.Role); role != MirrorRolePrimary && role != MirrorRoleSecondary {
+		return nil, fmt.Errorf("the role %q is neither primary nor secondary: %w", request.Role, ErrInvalidArgument)
+	}
+
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/async-mirrors/"+groupRef+"/role")
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not make this array %s in async mirror group %s",
+			request.Role, groupRef)
+	}
+
+	group, err := parseAsyncMirrorGroup(responseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	Logc(ctx).WithFields(log.Fields{
+		"GroupRef":  groupRef,
+		"LocalRole": group.LocalRole,
+		"Progress":  group.RoleChangeProgress,
+	}).Info("Changed async mirror group role.")
+
+	return group, nil
+}
+
+// GetAsyncMirrorProgress returns the progress of the running synchronization of an asynchronous mirror group, for
+// the group and for each of its pairs.
+func (d Client) GetAsyncMirrorProgress(
+	ctx context.Context, groupRef string,
+) (*v11.AsyncMirrorGroupSyncProgress, error) {
+	ctx, span := d.startSpan(ctx, "GetAsyncMirrorProgress", AttributeMirrorGroupRef.String(groupRef))
+	defer span.End()
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/async-mirrors/"+groupRef+"/progress")
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not get progress of async mirror group %s",
+			groupRef)
+	}
+
+	var progress v11.AsyncMirrorGroupSyncProgress
+	if err := json.Unmarshal(responseBody, &progress); err != nil {
+		return nil, fmt.Errorf("could not parse async mirror progress: %s; %v", string(responseBody), err)
+	}
+	return &progress, nil
+}
+
+// GetAsyncMirrorConnections returns the ports that connect the arrays of an asynchronous mirror group.
+func (d Client) GetAsyncMirrorConnections(
+	ctx context.Context, groupRef string,
+) (*v11.AsyncMirrorGroupConnectionsResponse, error) {
+	ctx, span := d.startSpan(ctx, "GetAsyncMirrorConnections", AttributeMirrorGroupRef.String(groupRef))
+	defer span.End()
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/async-mirrors/"+groupRef+"/connections")
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not get connections of async mirror group %s",
+			groupRef)
+	}
+
+	var connections v11.AsyncMirrorGroupConnectionsResponse
+	if err := json.Unmarshal(responseBody, &connections); err != nil {
+		return nil, fmt.Errorf("could not parse async mirror connections: %s; %v", string(responseBody), err)
+	}
+	return &connections, nil
+}
+
+// TestAsyncMirrorGroup tests the communication between the arrays of an asynchronous mirror group, by default
+// for connectivity, and returns the result of each controller.
+func (d Client) TestAsyncMirrorGroup(
+	ctx context.Context, groupRef string, request v11.AsyncMirrorGroupConnectivityTestRequest,
+) ([]v11.AsyncMirrorGroupCommunicationData, error) {
+	ctx, span := d.startSpan(ctx, "TestAsyncMirrorGroup", AttributeMirrorGroupRef.String(groupRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":   "TestAsyncMirrorGroup",
+			"Type":     "Client",
+			"groupRef": groupRef,
+			"request":  request,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> TestAsyncMirrorGroup")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< TestAsyncMirrorGroup")
+	}
+
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/async-mirrors/"+groupRef+"/test")
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not test async mirror group %s", groupRef)
+	}
+
+	results := make([]v11.AsyncMirrorGroupCommunicationData, 0)
+	if err := json.Unmarshal(responseBody, &results); err != nil {
+		return nil, fmt.Errorf("could not parse async mirror test results: %s; %v", string(responseBody), err)
+	}
+	return results, nil
+}
+
+// GetAsyncMirrorStatus summarizes an asynchronous mirror group as seen from this array: the roles, the state and
+// the progress of a running synchronization, the age of the oldest recovery point of the pairs, by the clock of
+// the client, and the highest utilization of their repositories.
+func (d Client) GetAsyncMirrorStatus(ctx context.Context, groupRef string) (*MirrorStatus, error) {
+	ctx, span := d.startSpan(ctx, "GetAsyncMirrorStatus", AttributeMirrorGroupRef.String(groupRef))
+	defer span.End()
+
+	group, err := d.GetAsyncMirrorGroup(ctx, groupRef)
+	if err != nil {
+		return nil, err
+	}
+	pairs, err := d.GetAsyncMirrorPairs(ctx, groupRef)
+	if err != nil {
+		return nil, err
+	}
+
+	status := asyncMirrorStatus(*group)
+	if status.State == MirrorStateSynchronizing {
+		progress, err := d.GetAsyncMirrorProgress(ctx, groupRef)
+		if err != nil {
+			return nil, err
+		}
+		status.PercentComplete = progress.GroupPercentComplete
+		status.TimeRemaining = time.Duration(progress.GroupTimeToCompletion) * time.Minute
+	}
+
+	var oldest time.Time
+	complete := len(pairs) > 0
+	for _, pair := range pairs {
+		if pair.MemberState == "incomplete" {
+			complete = false
+			continue
+		}
+		recoveryPoint, ok := pair.RecoveryPoint()
+		if !ok {
+			complete = false
+		} else if oldest.IsZero() || recoveryPoint.Before(oldest) {
+			oldest = recoveryPoint
+		}
+
+		utilization, err := d.GetAsyncMirrorRepositoryUtilization(ctx, pair.MemberRef)
+		if err != nil {
+			return nil, err
+		}
+		if percent := repositoryUtilizationPercent(*utilization); percent > status.RepositoryUtilization {
+			status.RepositoryUtilization = percent
+		}
+	}
+	if complete {
+		status.RecoveryPointAge = time.Since(oldest)
+	}
+
+	return status, nil
+}
+
+// AsyncMirrorSync returns an Operation that polls the synchronization of an asynchronous mirror group, the initial
+// one or one started with SyncAsyncMirrorGroup. It completes when the group is idle with a recovery point; a group
+// that is suspended or degraded, or whose recovery point failed, counts as failed.
+func (d Client) AsyncMirrorSync(groupRef string) Operation {
+	return OperationFunc{
+		Description: "async mirror group " + groupRef,
+		PollFunc: func(ctx context.Context) (Progress, error) {
+
+			group, err := d.GetAsyncMirrorGroup(ctx, groupRef)
+			if err != nil {
+				return Progress{}, err
+			}
+
+			status := asyncMirrorStatus(*group)
+			progress := Progress{State: OperationComplete, Action: group.SyncActivity, PercentComplete: 100}
+			switch status.State {
+			case MirrorStateSynchronizing:
+				current, err := d.GetAsyncMirrorProgress(ctx, groupRef)
+				if err != nil {
+					return Progress{}, err
+				}
+				progress.State = OperationRunning
+				progress.PercentComplete = current.GroupPercentComplete
+				if current.GroupTimeToCompletion > 0 {
+					progress.TimeRemaining = time.Duration(current.GroupTimeToCompletion) * time.Minute
+				}
+			case MirrorStateSuspended:
+				progress.State = OperationFailed
+				progress.Message = fmt.Sprintf("synchronization of async mirror group %s is suspended (%s)",
+					group.Label, group.SyncActivity)
+			case MirrorStateDegraded:
+				progress.State = OperationFailed
+				progress.Message = fmt.Sprintf("async mirror group %s is degraded", group.Label)
+			case MirrorStateFailed:
+				progress.State = OperationFailed
+				progress.Message = fmt.Sprintf("async mirror group %s has no usable recovery point", group.Label)
+			}
+			return progress, nil
+		},
+	}
+}
+
+// SyncAsyncMirrorGroupAndWait synchronizes an asynchronous mirror group like SyncAsyncMirrorGroup and waits for
+// the synchronization to complete.
+func (d Client) SyncAsyncMirrorGroupAndWait(ctx context.Context, groupRef string, config WaitConfig) error {
+	ctx, span := d.startSpan(ctx, "SyncAsyncMirrorGroupAndWait", AttributeMirrorGroupRef.String(groupRef))
+	defer span.End()
+
+	if err := d.SyncAsyncMirrorGroup(ctx, groupRef, false); err != nil {
+		return err
+	}
+	_, err := WaitFor(ctx, d.AsyncMirrorSync(groupRef), config)
+	return err
+}
+
+// RecoveryPoint returns the time of the last recovery point of a pair on the secondary array, or false if the
+// pair has none yet.
+func (p AsyncMirrorPair) RecoveryPoint() (time.Time, bool) {
+	seconds, err := strconv.ParseInt(p.LastRecoveryPointTime, 10, 64)
+	if err != nil || seconds <= 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(seconds, 0), true
+}
+
+// CreateAsyncMirrorGroup creates an asynchronous mirror group on the Local array, mirrored to the Remote array.
+// If the request has no SecondaryArrayID, the ID of the Remote array is looked up among the targets of Local.
+func (p *MirrorPeers) CreateAsyncMirrorGroup(
+	ctx context.Context, request v11.AsyncMirrorGroupCreateRequest,
+) (*AsyncMirrorGroup, error) {
+
+	if request.SecondaryArrayID == "" {
+		remote, err := p.Remote.GetStorageSystem(ctx)
+		if err != nil {
+			return nil, err
+		}
+		targets, err := p.Local.GetAsyncMirrorTargets(ctx, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, target := range targets {
+			if strings.EqualFold(target.WWN, remote.Wwn) {
+				request.SecondaryArrayID = target.ID
+			}
+		}
+		if request.SecondaryArrayID == "" {
+			return nil, fmt.Errorf("storage system %s is not an async mirror target of this array: %w", remote.Name,
+				ErrNotFound)
+		}
+	}
+	return p.Local.CreateAsyncMirrorGroup(ctx, request)
+}
+
+// AsyncMirrorGroups returns an asynchronous mirror group, identified by its world wide name, as seen from the
+// Local and the Remote array.
+func (p *MirrorPeers) AsyncMirrorGroups(
+	ctx context.Context, groupWWN string,
+) (local, remote *AsyncMirrorGroup, err error) {
+	if local, err = findAsyncMirrorGroup(ctx, p.Local, groupWWN); err != nil {
+		return nil, nil, err
+	}
+	if remote, err = findAsyncMirrorGroup(ctx, p.Remote, groupWWN); err != nil {
+		return nil, nil, err
+	}
+	return local, remote, nil
+}
+
+// AddAsyncMirrorPair adds a pair to an asynchronous mirror group, identified by its world wide name, on whichever
+// array is primary, and completes the pair on the secondary array if it was left incomplete. The request must
+// name the secondary volume.
+func (p *MirrorPeers) AddAsyncMirrorPair(
+	ctx context.Context, groupWWN string, request v11.AsyncMirrorGroupMemberCreateRequest,
+) (*AsyncMirrorPair, error) {
+
+	if request.SecondaryVolumeRef == "" {
+		return nil, fmt.Errorf("a secondary volume is required: %w", ErrInvalidArgument)
+	}
+
+	local, remote, err := p.AsyncMirrorGroups(ctx, groupWWN)
+	if err != nil {
+		return nil, err
+	}
+	primary, primaryGroup, secondary, secondaryGroup := p.Local, local, p.Remote, remote
+	if MirrorRole(local.LocalRole) != MirrorRolePrimary {
+		primary, primaryGroup, secondary, secondaryGroup = p.Remote, remote, p.Local, local
+	}
+
+	volume, err := primary.GetVolumeByRef(ctx, request.PrimaryVolumeRef)
+	if err != nil {
+		return nil, err
+	}
+	pair, err := primary.AddAsyncMirrorPair(ctx, primaryGroup.GroupRef, request)
+	if err != nil {
+		return nil, err
+	}
+
+	incomplete, err := secondary.GetIncompleteAsyncMirrorPairs(ctx, secondaryGroup.GroupRef)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range incomplete {
+		if !strings.EqualFold(member.PrimaryVolWWN, volume.WorldWideName) {
+			continue
+		}
+		_, err := secondary.CompleteAsyncMirrorPair(ctx, member.MemberRef, v11.AsyncMirrorGroupMemberCompletionRequest{
+			SecondaryPoolID:              request.SecondaryPoolID,
+			SecondaryVolumeRef:           request.SecondaryVolumeRef,
+			ScanMedia:                    request.ScanMedia,
+			ValidateRepositoryParity:     request.ValidateRepositoryParity,
+			SecondaryPercentCapacity:     request.SecondaryPercentCapacity,
+			SecondaryRepositoryCandidate: request.SecondaryRepositoryCandidate,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return primary.GetAsyncMirrorPair(ctx, pair.MemberRef)
+	}
+	return pair, nil
+}
+
+// Failover makes the Remote array primary for an asynchronous mirror group. Without force the role change is
+// orderly and needs both arrays; with force, Remote is promoted even though Local cannot be reached, and is
+// rolled back to its last recovery point.
+func (p *MirrorPeers) Failover(ctx context.Context, groupWWN string, force bool) (*AsyncMirrorGroup, error) {
+
+	remote, err := findAsyncMirrorGroup(ctx, p.Remote, groupWWN)
+	if err != nil {
+		return nil, err
+	}
+	if MirrorRole(remote.LocalRole) == MirrorRolePrimary {
+		return remote, nil
+	}
+
+	request := v11.AsyncMirrorGroupRoleUpdateRequest{Role: string(MirrorRolePrimary)}
+	if force {
+		request.Force = &force
+	}
+	return p.Remote.SetAsyncMirrorGroupRole(ctx, remote.GroupRef, request)
+}
+
+// Failback makes the Local array primary again for an asynchronous mirror group after a Failover. If both arrays
+// are primary after a forced failover, Local is made secondary first, discarding its writes since the failover,
+// and the wait config bounds the wait for it to be synchronized from Remote. The role change itself is orderly.
+func (p *MirrorPeers) Failback(ctx context.Context, groupWWN string, config WaitConfig) (*AsyncMirrorGroup, error) {
+
+	local, remote, err := p.AsyncMirrorGroups(ctx, groupWWN)
+	if err != nil {
+		return nil, err
+	}
+	if MirrorRole(local.LocalRole) == MirrorRolePrimary && MirrorRole(remote.LocalRole) != MirrorRolePrimary {
+		return local, nil
+	}
+
+	if MirrorRole(local.LocalRole) == MirrorRolePrimary {
+		Logc(ctx).WithField("Group", local.Label).Warning(
+			"Both arrays are primary for the async mirror group; making the local array secondary.")
+		if _, err := p.Local.SetAsyncMirrorGroupRole(ctx, local.GroupRef,
+			v11.AsyncMirrorGroupRoleUpdateRequest{Role: string(MirrorRoleSecondary)}); err != nil {
+			return nil, err
+		}
+		if _, err := WaitFor(ctx, p.Remote.AsyncMirrorSync(remote.GroupRef), config); err != nil {
+			return nil, err
+		}
+	}
+	return p.Local.SetAsyncMirrorGroupRole(ctx, local.GroupRef,
+		v11.AsyncMirrorGroupRoleUpdateRequest{Role: string(MirrorRolePrimary)})
+}
+
+// findAsyncMirrorGroup returns the asynchronous mirror group with a world wide name on an array.
+func findAsyncMirrorGroup(ctx context.Context, client *Client, groupWWN string) (*AsyncMirrorGroup, error) {
+	groups, err := client.GetAsyncMirrorGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range groups {
+		if strings.EqualFold(groups[i].WorldWideName, groupWWN) {
+			return &groups[i], nil
+		}
+	}
+	return nil, fmt.Errorf("async mirror group %s not found on storage system %s: %w", groupWWN,
+		client.arrayID(), ErrNotFound)
+}
+
+// asyncMirrorStatus describes an asynchronous mirror group in the terms of MirrorStatus.
+func asyncMirrorStatus(group AsyncMirrorGroup) *MirrorStatus {
+
+	status := &MirrorStatus{
+		Type:              MirrorTypeAsync,
+		Ref:               group.GroupRef,
+		Name:              group.Label,
+		LocalRole:         mirrorRole(group.LocalRole),
+		RemoteRole:        mirrorRole(group.RemoteRole),
+		ArrayState:        group.GroupState,
+		RoleChangePending: group.RoleChangeProgress == "pending" || group.RoleChangeProgress == "inProgress",
+	}
+	switch {
+	case group.GroupState == "rpFailed":
+		status.State = MirrorStateFailed
+	case group.GroupState == "degraded":
+		status.State = MirrorStateDegraded
+	case group.SyncActivity == "userSuspended" || group.SyncActivity == "internallySuspended" ||
+		group.SyncActivity == "paused":
+		status.State = MirrorStateSuspended
+	case group.GroupState == "initialSync" || group.SyncActivity == "active":
+		status.State = MirrorStateSynchronizing
+	case group.GroupState == "optimal":
+		status.State = MirrorStateOptimal
+	default:
+		status.State = MirrorStateUnknown
+	}
+	return status
+}
+
+// repositoryUtilizationPercent returns the share of a mirror repository that is in use, in percent.
+func repositoryUtilizationPercent(utilization v11.AsyncMirrorRepositoryUtilization) int {
+	var used, available uint64
+	for _, field := range []string{utilization.PitDataBytes, utilization.DeltaLogBytes} {
+		bytes, _ := strconv.ParseUint(field, 10, 64)
+		used += bytes
+	}
+	available, _ = strconv.ParseUint(utilization.BytesAvailable, 10, 64)
+	if used+available == 0 {
+		return 0
+	}
+	return int(used * 100 / (used + available))
+}
+
+// parseAsyncMirrorGroup parses an asynchronous mirror group returned by the array.
+func parseAsyncMirrorGroup(responseBody []byte) (*AsyncMirrorGroup, error) {
+	var group AsyncMirrorGroup
+	if err := json.Unmarshal(responseBody, &group); err != nil {
+		return nil, fmt.Errorf("could not parse async mirror group: %s; %v", string(responseBody), err)
+	}
+	return &group, nil
+}
+
+// parseAsyncMirrorPair parses an asynchronous mirror pair returned by the array.
+func parseAsyncMirrorPair(responseBody []byte) (*AsyncMirrorPair, error) {
+	var pair AsyncMirrorPair
+	if err := json.Unmarshal(responseBody, &pair); err != nil {
+		return nil, fmt.Errorf("could not parse async mirror pair: %s; %v", string(responseBody), err)
+	}
+	return &pair, nil
+}
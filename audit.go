@@ -0,0 +1,392 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/user"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// With ClientConfig.Audit set, every call that changes the array (POST, PUT, DELETE) is written to an audit sink
+// after it completes, successfully or not. The journal records who made the call and through which client, which
+// the array's own audit log (GetAuditLog) cannot tell apart when several tools share an account.
+
+// Audit outcomes
+const (
+	AuditSuccess = "success"
+	AuditFailure = "failure"
+)
+
+// AuditEvent records a call that changed, or tried to change, the array.
+type AuditEvent struct {
+	Time       time.Time       `json:"time"`                 // When the call was made
+	Caller     string          `json:"caller,omitempty"`     // Who made the call (AuditConfig.Identity or ContextKeyCaller)
+	User       string          `json:"user,omitempty"`       // Account used with the web services, if known
+	Operation  string          `json:"operation,omitempty"`  // Client method that made the call
+	Method     string          `json:"method"`               // HTTP method
+	ArrayID    string          `json:"arrayId,omitempty"`    // Storage system; empty for the storage system list
+	Path       string          `json:"path"`                 // Resource path relative to the storage system
+	Refs       []string        `json:"refs,omitempty"`       // Objects the call worked on or created
+	Body       json.RawMessage `json:"body,omitempty"`       // Request body with secrets redacted
+	Outcome    string          `json:"outcome"`              // AuditSuccess or AuditFailure
+	StatusCode int             `json:"statusCode,omitempty"` // 0 if no response was received
+	Error      string          `json:"error,omitempty"`
+	Duration   time.Duration   `json:"duration"` // Including retries, in nanoseconds in JSON
+	Controller string          `json:"controller,omitempty"`
+	RequestID  string          `json:"requestId,omitempty"` // X-Request-ID sent with the call
+}
+
+// AuditSink receives the audit events of a client. Record is called synchronously after each mutating call, so
+// slow sinks slow down the client; errors are logged and otherwise ignored.
+type AuditSink interface {
+	Record(ctx context.Context, event AuditEvent) error
+}
+
+// AuditFunc adapts a function to the AuditSink interface.
+type AuditFunc func(ctx context.Context, event AuditEvent) error
+
+func (f AuditFunc) Record(ctx context.Context, event AuditEvent) error {
+	return f(ctx, event)
+}
+
+// MultiAuditSink writes each event to all of its sinks.
+type MultiAuditSink []AuditSink
+
+func (m MultiAuditSink) Record(ctx context.Context, event AuditEvent) error {
+	var errs []error
+	for _, sink := range m {
+		if err := sink.Record(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// AuditConfig configures the audit journal of a client.
+type AuditConfig struct {
+	Sink AuditSink // Required; NewAPIClient rejects an AuditConfig without one
+
+	// Caller recorded with each event, default user@host of the process. A string stored in the context of a
+	// call under ContextKeyCaller takes precedence.
+	Identity string
+}
+
+// JSONLinesAuditSink writes audit events as JSON, one per line.
+type JSONLinesAuditSink struct {
+	m      sync.Mutex
+	w      io.Writer
+	closer io.Closer
+}
+
+// NewJSONLinesAuditSink returns a sink writing to w.
+func NewJSONLinesAuditSink(w io.Writer) *JSONLinesAuditSink {
+	return &JSONLinesAuditSink{w: w}
+}
+
+// OpenAuditFile returns a sink appending to a file, which is created with owner-only permissions if needed.
+func OpenAuditFile(path string) (*JSONLinesAuditSink, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("could not open audit file: %w", err)
+	}
+	return &JSONLinesAuditSink{w: file, closer: file}, nil
+}
+
+func (s *JSONLinesAuditSink) Record(ctx context.Context, event AuditEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	_, err = s.w.Write(line)
+	return err
+}
+
+// Close closes the file opened by OpenAuditFile. It does nothing for sinks made with NewJSONLinesAuditSink.
+func (s *JSONLinesAuditSink) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
+
+// ReadAuditEvents reads audit events written by a JSONLinesAuditSink.
+func ReadAuditEvents(r io.Reader) ([]AuditEvent, error) {
+	var events []AuditEvent
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var event AuditEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			return events, fmt.Errorf("could not parse audit event on line %d: %w", line, err)
+		}
+		events = append(events, event)
+	}
+	return events, scanner.Err()
+}
+
+// audit records a mutating call with the audit sink of the client.
+func (d Client) audit(
+	ctx context.Context, startTime time.Time, requestBody []byte, method, arrayID, resourcePath string,
+	response *http.Response, responseBody []byte, err error,
+) {
+
+	event := AuditEvent{
+		Time:      startTime,
+		Caller:    d.auditCaller(ctx),
+		User:      d.config.Username,
+		Operation: operationName(ctx),
+		Method:    method,
+		ArrayID:   arrayID,
+		Path:      resourcePath,
+		Body:      RedactJSON(requestBody),
+		Outcome:   AuditSuccess,
+		Duration:  time.Since(startTime),
+		RequestID: requestID(ctx),
+	}
+	if d.config.Credentials != nil || d.config.BearerToken != "" {
+		event.User = ""
+	}
+
+	var transportErr *TransportError
+	switch {
+	case err != nil:
+		event.Outcome = AuditFailure
+		event.Error = err.Error()
+		if errors.As(err, &transportErr) {
+			event.Controller = transportErr.Controller
+		}
+	case response.StatusCode >= http.StatusBadRequest:
+		event.Outcome = AuditFailure
+		event.Error = d.getErrorFromHTTPResponse(response, responseBody).Error()
+	}
+	if response != nil {
+		event.StatusCode = response.StatusCode
+		if response.Request != nil {
+			event.Controller = response.Request.URL.Hostname()
+		}
+	}
+	if event.Outcome == AuditSuccess {
+		event.Refs = auditRefs(resourcePath, requestBody, responseBody)
+	} else {
+		event.Refs = auditRefs(resourcePath, requestBody, nil)
+	}
+
+	if err := d.config.Audit.Sink.Record(ctx, event); err != nil {
+		Logc(ctx).WithFields(log.Fields{
+			"method": method,
+			"path":   resourcePath,
+			"error":  err,
+		}).Warn("Could not record audit event.")
+	}
+}
+
+// auditCaller returns the identity recorded as the caller of a call.
+func (d Client) auditCaller(ctx context.Context) string {
+	if caller, ok := ctx.Value(ContextKeyCaller).(string); ok && caller != "" {
+		return caller
+	}
+	if d.config.Audit.Identity != "" {
+		return d.config.Audit.Identity
+	}
+	return processIdentity()
+}
+
+// processIdentity returns user@host of the current process.
+var processIdentity = sync.OnceValue(func() string {
+	name := os.Getenv("USER")
+	if current, err := user.Current(); err == nil {
+		name = current.Username
+	}
+	host, _ := os.Hostname()
+	return name + "@" + host
+})
+
+// auditRefs returns the objects a call worked on: the IDs in its path, the refs and IDs in its request and the
+// ref of the object it created, if any.
+func auditRefs(resourcePath string, requestBody, responseBody []byte) []string {
+
+	var refs []string
+	add := func(ref string) {
+		if ref != "" && ref != NullRef && !slices.Contains(refs, ref) {
+			refs = append(refs, ref)
+		}
+	}
+
+	path, _, _ := strings.Cut(resourcePath, "?")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for _, segment := range segments {
+		if segment != "" && !routeSegments[segment] {
+			add(segment)
+		}
+	}
+
+	var request map[string]any
+	if json.Unmarshal(requestBody, &request) == nil {
+		keys := make([]string, 0, len(request))
+		for key := range request {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			value, ok := request[key].(string)
+			if ok && (strings.HasSuffix(key, "Ref") || strings.HasSuffix(key, "Id") || strings.HasSuffix(key, "ID") ||
+				key == "id") {
+				add(value)
+			}
+		}
+	}
+
+	var response map[string]any
+	if field, ok := collectionRefFields[segments[0]]; ok && len(segments) == 1 &&
+		json.Unmarshal(responseBody, &response) == nil {
+		if ref, ok := response[field].(string); ok {
+			add(ref)
+		}
+	}
+	return refs
+}
+
+// GetAuditLog returns the records of the array's audit log made between begin and end. A zero time leaves that
+// end of the range open. Depending on the array's audit log level, only mutating calls may be logged.
+func (d Client) GetAuditLog(ctx context.Context, begin, end time.Time) ([]v11.AuditLogRecord, error) {
+	ctx, span := d.startSpan(ctx, "GetAuditLog")
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "GetAuditLog",
+			"Type":   "Client",
+			"begin":  begin,
+			"end":    end,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetAuditLog")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetAuditLog")
+	}
+
+	query := url.Values{}
+	if !begin.IsZero() {
+		query.Set("beginTime", begin.UTC().Format(time.RFC3339))
+	}
+	if !end.IsZero() {
+		query.Set("endTime", end.UTC().Format(time.RFC3339))
+	}
+	resourcePath := "/audit-log"
+	if len(query) > 0 {
+		resourcePath += "?" + query.Encode()
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", resourcePath)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not read audit log")
+	}
+
+	var auditLog v11.AuditLogGetResponse
+	if err := json.Unmarshal(responseBody, &auditLog); err != nil {
+		return nil, fmt.Errorf("could not parse audit log: %v. %w", string(responseBody), err)
+	}
+	return auditLog.LogRecords, nil
+}
+
+// AuditMatch pairs an audit event with the record of the same call in the array's audit log.
+type AuditMatch struct {
+	Event  AuditEvent
+	Record *v11.AuditLogRecord // nil if no record was found
+}
+
+// CorrelateAuditLog finds the array's audit log record of each event: a record of the same method, resource and
+// status code, made while the call was in progress. The tolerance allows for clock skew between the client and
+// the array. Each record matches at most one event.
+func CorrelateAuditLog(events []AuditEvent, records []v11.AuditLogRecord, tolerance time.Duration) []AuditMatch {
+
+	used := make([]bool, len(records))
+	matches := make([]AuditMatch, 0, len(events))
+	for _, event := range events {
+		match := AuditMatch{Event: event}
+		best, bestDistance := -1, time.Duration(0)
+		for i, record := range records {
+			if used[i] || !auditRecordMatches(event, record) {
+				continue
+			}
+			recordTime, ok := auditRecordTime(record)
+			if !ok {
+				continue
+			}
+			start, end := event.Time.Add(-tolerance), event.Time.Add(event.Duration+tolerance)
+			if recordTime.Before(start) || recordTime.After(end) {
+				continue
+			}
+			distance := recordTime.Sub(event.Time)
+			if distance < 0 {
+				distance = -distance
+			}
+			if best < 0 || distance < bestDistance {
+				best, bestDistance = i, distance
+			}
+		}
+		if best >= 0 {
+			used[best] = true
+			match.Record = &records[best]
+		}
+		matches = append(matches, match)
+	}
+	return matches
+}
+
+// auditRecordMatches reports whether an audit log record is about the same method, resource and status as an
+// event.
+func auditRecordMatches(event AuditEvent, record v11.AuditLogRecord) bool {
+	if !strings.EqualFold(event.Method, record.Method) {
+		return false
+	}
+	if event.StatusCode != 0 && record.HTTPStatus != 0 && event.StatusCode != record.HTTPStatus {
+		return false
+	}
+	accessed := record.AccessedURL
+	if u, err := url.Parse(accessed); err == nil {
+		accessed = u.Path
+	}
+	eventPath, _, _ := strings.Cut(event.Path, "?")
+	suffix := "/storage-systems" + eventPath
+	if event.ArrayID != "" {
+		suffix = "/storage-systems/" + event.ArrayID + eventPath
+	}
+	return strings.HasSuffix(strings.TrimSuffix(accessed, "/"), strings.TrimSuffix(suffix, "/"))
+}
+
+// auditRecordTime returns the time of an audit log record.
+func auditRecordTime(record v11.AuditLogRecord) (time.Time, bool) {
+	if t, err := time.Parse(time.RFC3339, record.DateTime); err == nil {
+		return t, true
+	}
+	if seconds, err := strconv.ParseInt(record.Timestamp, 10, 64); err == nil {
+		return time.Unix(seconds, 0), true
+	}
+	return time.Time{}, false
+}
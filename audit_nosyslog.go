@@ -0,0 +1,28 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+//go:build windows || plan9
+
+package santricity
+
+import (
+	"context"
+	"errors"
+)
+
+var errNoSyslog = errors.New("syslog is not available on this platform")
+
+// SyslogAuditSink is not available on this platform.
+type SyslogAuditSink struct{}
+
+// NewSyslogAuditSink always fails on this platform.
+func NewSyslogAuditSink(network, raddr, tag string) (*SyslogAuditSink, error) {
+	return nil, errNoSyslog
+}
+
+func (s *SyslogAuditSink) Record(ctx context.Context, event AuditEvent) error {
+	return errNoSyslog
+}
+
+func (s *SyslogAuditSink) Close() error {
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+//go:build !windows && !plan9
+
+package santricity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/syslog"
+)
+
+// SyslogAuditSink writes audit events as JSON to syslog, failures at warning and everything else at notice
+// severity.
+type SyslogAuditSink struct {
+	writer *syslog.Writer
+}
+
+// NewSyslogAuditSink connects to a syslog server, or to the local syslog daemon if network and raddr are empty.
+// The tag defaults to the name of the program.
+func NewSyslogAuditSink(network, raddr, tag string) (*SyslogAuditSink, error) {
+	writer, err := syslog.Dial(network, raddr, syslog.LOG_NOTICE|syslog.LOG_USER, tag)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to syslog: %w", err)
+	}
+	return &SyslogAuditSink{writer: writer}, nil
+}
+
+func (s *SyslogAuditSink) Record(ctx context.Context, event AuditEvent) error {
+	message, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if event.Outcome == AuditFailure {
+		return s.writer.Warning(string(message))
+	}
+	return s.writer.Notice(string(message))
+}
+
+// Close closes the connection to syslog.
+func (s *SyslogAuditSink) Close() error {
+	return s.writer.Close()
+}
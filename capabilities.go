@@ -0,0 +1,239 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/scaleoutsean/santricity-go/models/v11"
+	log "github.com/sirupsen/logrus"
+)
+
+// MinimumAPIVersion is the oldest embedded web services version (i.e. SANtricity OS release) the client supports.
+const MinimumAPIVersion = "11.90"
+
+// Capabilities describes the software and the features of a storage system. Connect detects them once; the
+// client then rejects operations the system does not support with an UnsupportedError instead of sending them.
+type Capabilities struct {
+	APIVersion      string `json:"apiVersion"`      // Web services version, from /devmgr/utils/about
+	FirmwareVersion string `json:"firmwareVersion"` // Controller firmware version
+	Model           string `json:"model"`
+	RunningAsProxy  bool   `json:"runningAsProxy"` // True if the client talks to a Web Services Proxy
+
+	// Features supported by the firmware, e.g. "snapshots", "volumeCopy", "remoteMirroring"
+	Features []string `json:"features"`
+	// Premium features that are enabled, licensed or not (see FeatureState.IsCompliant in the model)
+	EnabledFeatures []string `json:"enabledFeatures"`
+	// Controller capabilities, e.g. "capabilityDiskPools", "capability4knativeAdvancedFormatSupport"
+	ProductCapabilities []string `json:"productCapabilities"`
+	// Name of the feature pack (feature bundle) applied to the system, if any
+	FeaturePack string `json:"featurePack,omitempty"`
+
+	// Complete /capabilities response, for details not summarized above
+	Response v11.StorageSystemCapabilitiesResponse `json:"-"`
+}
+
+// HasFeature reports whether the firmware supports a premium feature.
+func (c *Capabilities) HasFeature(feature string) bool {
+	return slices.Contains(c.Features, feature)
+}
+
+// FeatureEnabled reports whether a premium feature is enabled.
+func (c *Capabilities) FeatureEnabled(feature string) bool {
+	return slices.Contains(c.EnabledFeatures, feature)
+}
+
+// HasProductCapability reports whether the controllers have a capability, e.g. "capabilityDiskPools".
+func (c *Capabilities) HasProductCapability(capability string) bool {
+	return slices.Contains(c.ProductCapabilities, capability)
+}
+
+// FirmwareAtLeast reports whether the controller firmware is the given version (e.g. "08.50") or later. Unknown
+// versions are assumed to be recent.
+func (c *Capabilities) FirmwareAtLeast(version string) bool {
+	return versionAtLeast(c.FirmwareVersion, version)
+}
+
+// APIAtLeast reports whether the web services version is the given version (e.g. "11.90") or later. Unknown
+// versions are assumed to be recent.
+func (c *Capabilities) APIAtLeast(version string) bool {
+	return versionAtLeast(c.APIVersion, version)
+}
+
+// Supported reports whether the client supports the storage system's API version. Web Services Proxy versions
+// are numbered independently of SANtricity OS and are not checked.
+func (c *Capabilities) Supported() bool {
+	return c.RunningAsProxy || c.APIAtLeast(MinimumAPIVersion)
+}
+
+// String summarizes the capabilities on one line, for logs.
+func (c *Capabilities) String() string {
+	summary := fmt.Sprintf("API %s, firmware %s, model %s", c.APIVersion, c.FirmwareVersion, c.Model)
+	if c.RunningAsProxy {
+		summary += ", proxy"
+	}
+	if c.FeaturePack != "" {
+		summary += ", feature pack " + c.FeaturePack
+	}
+	if len(c.EnabledFeatures) > 0 {
+		summary += ", features: " + strings.Join(c.EnabledFeatures, " ")
+	}
+	return summary
+}
+
+// Capabilities returns the capabilities detected by Connect or the last call of GetCapabilities, or nil if they
+// have not been detected yet.
+func (d Client) Capabilities() *Capabilities {
+	return d.capabilities.Load()
+}
+
+// GetCapabilities reads the versions and features of the storage system and remembers them for capability
+// checks. Licensed features are reported by /capabilities; the /feature-pack endpoint only accepts uploads.
+func (d Client) GetCapabilities(ctx context.Context) (*Capabilities, error) {
+	ctx, span := d.startSpan(ctx, "GetCapabilities")
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "GetCapabilities",
+			"Type":   "Client",
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetCapabilities")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetCapabilities")
+	}
+
+	about, err := d.AboutInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	system, err := d.GetStorageSystem(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/capabilities")
+	if err != nil {
+		return nil, fmt.Errorf("could not read capabilities: %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not read capabilities")
+	}
+
+	capabilities := &Capabilities{
+		APIVersion:      about.Version,
+		FirmwareVersion: system.FwVersion,
+		Model:           system.Model,
+		RunningAsProxy:  about.RunningAsProxy,
+	}
+	if err := json.Unmarshal(responseBody, &capabilities.Response); err != nil {
+		return nil, fmt.Errorf("could not parse capabilities: %s; %v", string(responseBody), err)
+	}
+
+	capabilities.Features = capabilities.Response.Capabilities
+	capabilities.ProductCapabilities = capabilities.Response.ProductCapabilities
+	capabilities.FeaturePack = capabilities.Response.FeatureBundle.Name
+	for _, state := range capabilities.Response.FeatureStates {
+		if state.IsEnabled {
+			capabilities.EnabledFeatures = append(capabilities.EnabledFeatures, state.Capability)
+		}
+	}
+
+	d.capabilities.Store(capabilities)
+
+	Logc(ctx).WithFields(log.Fields{
+		"APIVersion":      capabilities.APIVersion,
+		"FirmwareVersion": capabilities.FirmwareVersion,
+		"FeaturePack":     capabilities.FeaturePack,
+	}).Debug("Read storage system capabilities.")
+
+	return capabilities, nil
+}
+
+// checkBlockSize returns an UnsupportedError if a pool does not support volumes with the given block size.
+// Pools that do not report the supported block sizes (older firmware) are not checked.
+func (d Client) checkBlockSize(ctx context.Context, volumeGroupRef string, blockSize int) error {
+
+	pool, err := d.GetVolumePoolByRef(ctx, volumeGroupRef)
+	if err != nil {
+		return err
+	}
+	if len(pool.BlkSizeSupported) == 0 || slices.Contains(pool.BlkSizeSupported, blockSize) {
+		return nil
+	}
+
+	return d.unsupportedError(fmt.Sprintf("%d-byte blocks on pool %s (supported: %v)", blockSize, pool.Label,
+		pool.BlkSizeSupported))
+}
+
+// checkFeature returns an UnsupportedError for an operation that needs one of the given premium features, if the
+// capabilities are known and the storage system supports none of them or has none of them enabled.
+func (d Client) checkFeature(operation string, features ...string) error {
+
+	capabilities := d.capabilities.Load()
+	if capabilities == nil {
+		return nil
+	}
+	if !slices.ContainsFunc(features, capabilities.HasFeature) {
+		return d.unsupportedError(operation)
+	}
+	if !slices.ContainsFunc(features, capabilities.FeatureEnabled) {
+		return d.unsupportedError(fmt.Sprintf("%s without enabling the %s feature", operation, features[0]))
+	}
+	return nil
+}
+
+// unsupportedError returns an UnsupportedError for a feature, with the firmware version if it is known.
+func (d Client) unsupportedError(feature string) error {
+	unsupported := &UnsupportedError{Feature: feature}
+	if capabilities := d.capabilities.Load(); capabilities != nil {
+		unsupported.FirmwareVersion = capabilities.FirmwareVersion
+	}
+	return unsupported
+}
+
+// versionAtLeast compares dotted version strings numerically, component by component. It returns true if either
+// version cannot be parsed.
+func versionAtLeast(version, minimum string) bool {
+
+	have, ok := parseVersion(version)
+	if !ok {
+		return true
+	}
+	want, ok := parseVersion(minimum)
+	if !ok {
+		return true
+	}
+
+	for i := range want {
+		component := 0
+		if i < len(have) {
+			component = have[i]
+		}
+		if component != want[i] {
+			return component > want[i]
+		}
+	}
+	return true
+}
+
+func parseVersion(version string) ([]int, bool) {
+
+	if version == "" {
+		return nil, false
+	}
+	var components []int
+	for _, part := range strings.Split(version, ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, false
+		}
+		components = append(components, n)
+	}
+	return components, true
+}
@@ -6,22 +6,24 @@ package santricity
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/cookiejar"
 	"os"
 	"regexp"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const maxNameLength = 30
@@ -35,11 +37,38 @@ type ClientConfig struct {
 	// API Info
 	ApiControllers []string
 	ApiPort        int
-	VerifyTLS      bool // If true, verify TLS certificate
+	ArraySelector  string // WWN, name, chassis serial number or ID of the storage system to manage (Web Services Proxy)
+	VerifyTLS      bool   // If true, verify TLS certificate
 	CACertPEM      string
 	Username       string
 	Password       string
 	BearerToken    string // Optional JWT/Bearer token
+	UseSession     bool   // If true, log in once per controller and reuse the session cookie instead of Basic auth
+
+	// Optional source of credentials, asked before each request and again after a 401. If set, Username,
+	// Password and BearerToken are ignored.
+	Credentials CredentialProvider
+
+	// HTTP Transport (zero values select the defaults from constants.go)
+	RequestTimeout        time.Duration // Timeout for a single HTTP request to one controller, including the body
+	OperationTimeout      time.Duration // Timeout for an API call across all controllers; 0 means no extra limit
+	DialTimeout           time.Duration
+	TLSHandshakeTimeout   time.Duration
+	ResponseHeaderTimeout time.Duration // 0 means no limit beyond RequestTimeout
+	IdleConnTimeout       time.Duration
+	MaxIdleConns          int
+	MaxIdleConnsPerHost   int
+	MaxConnsPerHost       int  // 0 means no limit
+	DisableHTTP2          bool // If true, always use HTTP/1.1
+
+	// Optional wrapper of the HTTP transport, for example to record or replay requests (see the santricityrecord
+	// package). It gets the transport configured above.
+	WrapTransport func(next http.RoundTripper) http.RoundTripper
+
+	// Controller Failover (zero values select the defaults from constants.go)
+	FailureThreshold    int           // Consecutive transport errors before a controller is marked unhealthy
+	FailureCooldown     time.Duration // How long an unhealthy controller is only tried as a last resort
+	HealthProbeInterval time.Duration // How often an unhealthy controller is probed; default half the cooldown
 
 	// Options
 	PoolNameSearchPattern string
@@ -51,8 +80,27 @@ type ClientConfig struct {
 	// Volume Filtering
 	IncludeRepositoryVolumes bool // If true, include repository volumes (like repos_*) in GetVolumes output
 
-	// Metrics Hook
-	OnRequest func(method string, path string, statusCode int, duration time.Duration)
+	// Metrics Hooks, called after every HTTP request sent to a controller. OnRequest gets the route template of
+	// the request (see RequestInfo.Route), not the full path.
+	OnRequest     func(method string, route string, statusCode int, duration time.Duration)
+	OnRequestInfo func(info RequestInfo)
+
+	// Retries of failed calls (nil disables retrying)
+	RetryPolicy *RetryPolicy
+
+	// Client-side cache of the volume, host, pool, mapping and snapshot lists (nil disables caching)
+	Cache *CacheConfig
+
+	// If true, calls that would change the array are recorded (see Client.Plan) instead of sent
+	DryRun bool
+
+	// Journal of the calls that change the array (nil disables auditing)
+	Audit *AuditConfig
+
+	// OpenTelemetry tracing of client methods and HTTP requests (nil TracerProvider records no spans; nil
+	// Propagator sends the W3C trace context of the caller)
+	TracerProvider trace.TracerProvider
+	Propagator     propagation.TextMapPropagator
 
 	// Internal Config Variables
 	ArrayID                       string // Unique ID for array
@@ -67,10 +115,34 @@ type ClientConfig struct {
 	ConfigVersion int
 }
 
+// RequestInfo describes one HTTP request sent to a controller, as passed to ClientConfig.OnRequestInfo.
+type RequestInfo struct {
+	Method     string
+	Path       string // Resource path relative to the storage system
+	Route      string // Path with object IDs replaced by "{id}", such as /volumes/{id}/expand
+	Operation  string // Client method that made the call, such as CreateVolume, or ContextKeyOperation
+	StatusCode int    // 0 if no response was received
+	Duration   time.Duration
+	Controller string
+	Attempt    int   // 1 for the first attempt of a call, higher for retries
+	Failover   bool  // True if an earlier controller failed during this attempt
+	Err        error // Transport error, if any
+}
+
 // Client is the object to use for interacting with the E-series API.
 type Client struct {
-	config *ClientConfig
-	m      *sync.Mutex
+	config     *ClientConfig
+	m          *sync.Mutex
+	httpClient *http.Client
+	session    *sessionState
+	health     *controllerTracker
+	initErr    error // Invalid configuration detected by NewAPIClient, returned by every API call
+	array      *arraySelection
+
+	capabilities *atomic.Pointer[Capabilities] // Detected by Connect
+	derived      bool                          // Created by ForStorageSystem, shares the parent's connections
+	inventory    *inventoryCache               // Cached object lists, nil if ClientConfig.Cache is not set
+	dryRun       *dryRunPlan                   // Calls recorded instead of sent, nil unless ClientConfig.DryRun is set
 }
 
 // NewAPIClient is a factory method for creating a new instance.
@@ -79,14 +151,21 @@ func NewAPIClient(ctx context.Context, config ClientConfig) *Client {
 		config.ApiPort = 8443
 	}
 	c := &Client{
-		config: &config,
-		m:      &sync.Mutex{},
+		config:       &config,
+		m:            &sync.Mutex{},
+		array:        &arraySelection{},
+		capabilities: &atomic.Pointer[Capabilities]{},
+		inventory:    newInventoryCache(config.Cache),
+	}
+	if config.DryRun {
+		c.dryRun = &dryRunPlan{}
 	}
 
 	// Initialize internal config variables
-	// Do NOT blindly reset ArrayID - respect what resides in config if passed by caller (e.g. for EWS)
-	if c.config.ArrayID == "" {
-		c.config.ArrayID = ""
+	// Do NOT blindly reset ArrayID - respect what resides in config if passed by caller (e.g. for EWS). A selector
+	// replaces it, and is resolved by the first API call.
+	if c.config.ArraySelector == "" {
+		c.array.id = c.config.ArrayID
 	}
 
 	compiledRegex, err := regexp.Compile(c.config.PoolNameSearchPattern)
@@ -100,9 +179,90 @@ func NewAPIClient(ctx context.Context, config ClientConfig) *Client {
 	}
 	c.config.CompiledPoolNameSearchPattern = compiledRegex
 
+	c.httpClient, c.initErr = newHTTPClient(c.config)
+	if c.initErr != nil {
+		Logc(ctx).WithError(c.initErr).Error("Could not configure HTTP transport.")
+	}
+	if c.initErr == nil && c.config.Audit != nil && c.config.Audit.Sink == nil {
+		c.initErr = fmt.Errorf("audit is enabled without a sink: %w", ErrInvalidArgument)
+		Logc(ctx).WithError(c.initErr).Error("Invalid audit configuration.")
+	}
+
+	c.session = &sessionState{loggedIn: make(map[string]bool)}
+	c.health = newControllerTracker(c.config)
+	if c.httpClient != nil && c.config.UseSession {
+		c.httpClient.Jar, _ = cookiejar.New(nil)
+	}
+
 	return c
 }
 
+// Close logs out of any web services sessions, stops the background health probes and releases the idle
+// connections held by the client. The client should not be used afterwards.
+func (c *Client) Close() error {
+	if c.derived {
+		return nil
+	}
+	c.health.close()
+	if c.httpClient == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), logoutTimeout)
+	defer cancel()
+
+	err := c.logoutAll(ctx)
+	c.httpClient.CloseIdleConnections()
+	return err
+}
+
+// newRequest creates a request to a controller with the standard headers and the configured authentication.
+// When sessions are enabled, this logs in to the controller first if needed.
+func (d Client) newRequest(
+	ctx context.Context, controller, method, url string, requestBody []byte,
+) (*http.Request, error) {
+
+	var body io.Reader
+	if requestBody != nil {
+		body = bytes.NewBuffer(requestBody)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("X-Request-ID", requestID(ctx))
+	d.injectTraceContext(ctx, request)
+
+	creds, err := d.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case creds.BearerToken != "":
+		request.Header.Set("Authorization", "Bearer "+creds.BearerToken)
+	case d.config.UseSession:
+		if err := d.ensureSession(ctx, controller, creds); err != nil {
+			return nil, err
+		}
+	default:
+		request.SetBasicAuth(creds.Username, creds.Password)
+	}
+
+	return request, nil
+}
+
+// withOperationTimeout applies ClientConfig.OperationTimeout, if set, to an API call spanning all controllers.
+func (d Client) withOperationTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if d.config.OperationTimeout > 0 {
+		return context.WithTimeout(ctx, d.config.OperationTimeout)
+	}
+	return ctx, func() {}
+}
+
 // SetIncludeRepositoryVolumes allows toggling the display of repository volumes
 func (c *Client) SetIncludeRepositoryVolumes(include bool) {
 	c.config.IncludeRepositoryVolumes = include
@@ -111,9 +271,100 @@ func (c *Client) SetIncludeRepositoryVolumes(include bool) {
 // InvokeAPI makes a REST call. The body must be a marshaled JSON byte array (or nil).
 // The method is the HTTP verb (i.e. GET, POST, ...).  The resource path is appended to the base URL to identify
 // the desired server resource; it should start with '/'.
+// Failed calls are repeated as configured by ClientConfig.RetryPolicy.
 func (d Client) InvokeAPI(
 	ctx context.Context, requestBody []byte, method string, resourcePath string,
 ) (*http.Response, []byte, error) {
+	if d.inventory != nil {
+		return d.cachedInvokeAPI(ctx, requestBody, method, resourcePath)
+	}
+	arrayID, err := d.resolveArrayID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return d.invokeAPI(ctx, requestBody, method, arrayID, resourcePath)
+}
+
+// invokeAPI makes a REST call for a resource of the given storage system. If arrayID is empty, the resource path
+// is relative to the list of storage systems.
+func (d Client) invokeAPI(
+	ctx context.Context, requestBody []byte, method string, arrayID string, resourcePath string,
+) (*http.Response, []byte, error) {
+
+	if d.initErr != nil {
+		return nil, nil, d.initErr
+	}
+
+	// Ensure we have controllers configured
+	if len(d.config.ApiControllers) == 0 {
+		return nil, nil, fmt.Errorf("no API controllers configured: %w", ErrInvalidArgument)
+	}
+
+	if d.dryRun != nil && isMutating(method) {
+		return d.plan(ctx, requestBody, method, arrayID, resourcePath)
+	}
+
+	ctx = withRequestID(ctx)
+	if d.config.Audit != nil && isMutating(method) {
+		startTime := time.Now()
+		response, responseBody, err := d.invokeWithRetries(ctx, requestBody, method, arrayID, resourcePath)
+		d.audit(ctx, startTime, requestBody, method, arrayID, resourcePath, response, responseBody, err)
+		return response, responseBody, err
+	}
+	return d.invokeWithRetries(ctx, requestBody, method, arrayID, resourcePath)
+}
+
+// invokeWithRetries makes a REST call, repeating it as configured by ClientConfig.RetryPolicy.
+func (d Client) invokeWithRetries(
+	ctx context.Context, requestBody []byte, method string, arrayID string, resourcePath string,
+) (*http.Response, []byte, error) {
+
+	ctx, cancel := d.withOperationTimeout(ctx)
+	defer cancel()
+
+	policy := d.config.RetryPolicy
+	if policy == nil {
+		return d.invokeControllers(ctx, requestBody, method, arrayID, resourcePath, 1)
+	}
+
+	retryBackoff := policy.newBackOff(ctx)
+	for attempt := 1; ; attempt++ {
+		response, responseBody, err := d.invokeControllers(ctx, requestBody, method, arrayID, resourcePath, attempt)
+		if attempt >= policy.maxAttempts() ||
+			!d.shouldRetry(ctx, method, resourcePath, requestBody, response, responseBody, err) {
+			return response, responseBody, err
+		}
+
+		delay := retryBackoff.NextBackOff()
+		if delay == backoff.Stop {
+			return response, responseBody, err
+		}
+
+		fields := log.Fields{
+			"method":  method,
+			"path":    resourcePath,
+			"attempt": attempt,
+			"delay":   delay,
+		}
+		if err != nil {
+			fields["error"] = err
+		} else {
+			fields["statusCode"] = response.StatusCode
+		}
+		Logc(ctx).WithFields(fields).Warn("API call failed, retrying.")
+
+		select {
+		case <-ctx.Done():
+			return response, responseBody, err
+		case <-time.After(delay):
+		}
+	}
+}
+
+// invokeControllers makes one attempt of an API call, failing over to the next controller on transport errors.
+func (d Client) invokeControllers(
+	ctx context.Context, requestBody []byte, method string, arrayID string, resourcePath string, attempt int,
+) (*http.Response, []byte, error) {
 
 	// Default to secure connection
 	scheme := "https"
@@ -122,23 +373,18 @@ func (d Client) InvokeAPI(
 	var response *http.Response
 	var responseBody []byte
 
-	// Ensure we have controllers configured
-	if len(d.config.ApiControllers) == 0 {
-		return nil, nil, errors.New("no API controllers configured")
-	}
-
-	for _, controller := range d.config.ApiControllers {
+	for i, controller := range d.health.order(d.config.ApiControllers) {
 
 		// Build URL
 		// If ArrayID is empty, we probably want to query the root or list systems
 		urlPath := ""
-		if d.config.ArrayID == "" {
+		if arrayID == "" {
 			// If no array ID, do not include a slash before it, but handle resource path
 			// effectively /devmgr/v2/storage-systems + resourcePath
 			// If resourcePath is empty, we list systems
 			urlPath = fmt.Sprintf("/devmgr/v2/storage-systems%s", resourcePath)
 		} else {
-			urlPath = fmt.Sprintf("/devmgr/v2/storage-systems/%s%s", d.config.ArrayID, resourcePath)
+			urlPath = fmt.Sprintf("/devmgr/v2/storage-systems/%s%s", arrayID, resourcePath)
 		}
 
 		url := fmt.Sprintf("%s://%s:%d%s", scheme, controller, d.config.ApiPort, urlPath)
@@ -146,26 +392,21 @@ func (d Client) InvokeAPI(
 		var request *http.Request
 		var prettyResponseBuffer bytes.Buffer
 
+		attemptCtx, span := d.startAttemptSpan(ctx, method, arrayID, resourcePath, controller, attempt, i > 0)
+
 		// Create the request
-		if requestBody == nil {
-			request, lastErr = http.NewRequestWithContext(ctx, method, url, nil)
-		} else {
-			request, lastErr = http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(requestBody))
-		}
+		request, lastErr = d.newRequest(attemptCtx, controller, method, url, requestBody)
 		if lastErr != nil {
+			endAttemptSpan(span, nil, lastErr)
+			if errors.Is(lastErr, ErrTransport) {
+				d.recordFailure(ctx, controller, lastErr)
+				continue // Could not log in to this controller, try next one
+			}
+			d.reportRequest(ctx, RequestInfo{Method: method, Path: resourcePath, StatusCode: errorStatusCode(lastErr),
+				Controller: controller, Attempt: attempt, Failover: i > 0, Err: lastErr})
 			return nil, nil, lastErr
 		}
 
-		request.Header.Set("Content-Type", "application/json")
-		request.Header.Set("Accept", "application/json")
-		request.Header.Set("X-Request-ID", fmt.Sprint(ctx.Value(ContextKeyRequestID)))
-
-		if d.config.BearerToken != "" {
-			request.Header.Set("Authorization", "Bearer "+d.config.BearerToken)
-		} else {
-			request.SetBasicAuth(d.config.Username, d.config.Password)
-		}
-
 		// Log the request
 		if d.config.DebugTraceFlags["api"] {
 			logBody := requestBody
@@ -177,50 +418,82 @@ func (d Client) InvokeAPI(
 		}
 
 		// Send the request
-		tlsConfig := &tls.Config{
-			InsecureSkipVerify: !d.config.VerifyTLS, // Allow certificate validation override
-			MinVersion:         MinTLSVersion,
-		}
-		if d.config.VerifyTLS && d.config.CACertPEM != "" {
-			caCertPool := x509.NewCertPool()
-			caCertPool.AppendCertsFromPEM([]byte(d.config.CACertPEM))
-			tlsConfig.RootCAs = caCertPool
-		}
-
-		tr := &http.Transport{
-			TLSClientConfig: tlsConfig,
-		}
-		client := &http.Client{
-			Transport: tr,
-			Timeout:   time.Duration(StorageAPITimeoutSeconds * time.Second),
-		}
-
 		startTime := time.Now()
-		response, lastErr = client.Do(request)
-		duration := time.Since(startTime)
+		response, lastErr = d.httpClient.Do(request)
 
-		if d.config.OnRequest != nil {
-			statusCode := 0
-			if response != nil {
-				statusCode = response.StatusCode
+		if lastErr == nil && response.StatusCode == http.StatusUnauthorized && d.canReauthenticate() {
+			// The session expired or the credentials were rotated; fetch fresh credentials, log in again if
+			// needed and repeat the request once
+			response.Body.Close()
+			d.reportRequest(ctx, RequestInfo{Method: method, Path: resourcePath, StatusCode: response.StatusCode,
+				Duration: time.Since(startTime), Controller: controller, Attempt: attempt, Failover: i > 0})
+			Logc(ctx).WithField("controller", controller).Debug("Credentials rejected, authenticating again.")
+			d.invalidateCredentials(controller)
+
+			request, lastErr = d.newRequest(attemptCtx, controller, method, url, requestBody)
+			if lastErr != nil {
+				endAttemptSpan(span, nil, lastErr)
+				if errors.Is(lastErr, ErrTransport) {
+					d.recordFailure(ctx, controller, lastErr)
+					continue
+				}
+				d.reportRequest(ctx, RequestInfo{Method: method, Path: resourcePath,
+					StatusCode: errorStatusCode(lastErr), Controller: controller, Attempt: attempt, Failover: i > 0,
+					Err: lastErr})
+				return nil, nil, lastErr
 			}
-			// Use the resourcePath as the metric label
-			d.config.OnRequest(method, resourcePath, statusCode, duration)
+			startTime = time.Now()
+			response, lastErr = d.httpClient.Do(request)
 		}
+		duration := time.Since(startTime)
+
+		statusCode := 0
+		if response != nil {
+			statusCode = response.StatusCode
+		}
+		d.reportRequest(ctx, RequestInfo{
+			Method:     method,
+			Path:       resourcePath,
+			StatusCode: statusCode,
+			Duration:   duration,
+			Controller: controller,
+			Attempt:    attempt,
+			Failover:   i > 0,
+			Err:        lastErr,
+		})
 
 		if lastErr != nil {
+			endAttemptSpan(span, nil, lastErr)
 			Logc(ctx).Warnf("Error communicating with controller %s: %v", controller, lastErr)
+			lastErr = &TransportError{
+				Controller: controller,
+				RequestID:  request.Header.Get("X-Request-ID"),
+				Err:        lastErr,
+			}
+			if ctx.Err() == nil {
+				d.recordFailure(ctx, controller, lastErr)
+			}
 			continue // Try next controller
 		}
 
 		// Read Body
 		responseBody, lastErr = io.ReadAll(response.Body)
 		response.Body.Close()
+		endAttemptSpan(span, response, lastErr)
 
 		if lastErr != nil {
 			Logc(ctx).Warnf("Error reading response body from controller %s: %v", controller, lastErr)
+			lastErr = &TransportError{
+				Controller: controller,
+				RequestID:  request.Header.Get("X-Request-ID"),
+				Err:        lastErr,
+			}
+			if ctx.Err() == nil {
+				d.recordFailure(ctx, controller, lastErr)
+			}
 			continue
 		}
+		d.recordSuccess(ctx, controller)
 
 		// Success logic for logging
 		if method == "GET" && resourcePath == "/volumes" {
@@ -255,20 +528,42 @@ func (d Client) InvokeAPI(
 	return response, responseBody, lastErr
 }
 
+// reportRequest passes a request sent to a controller to the metrics hooks, with its route and operation name.
+func (d Client) reportRequest(ctx context.Context, info RequestInfo) {
+	info.Route = routeTemplate(info.Path)
+	info.Operation = operationName(ctx)
+	if d.config.OnRequest != nil {
+		d.config.OnRequest(info.Method, info.Route, info.StatusCode, info.Duration)
+	}
+	if d.config.OnRequestInfo != nil {
+		d.config.OnRequestInfo(info)
+	}
+}
+
 // AboutInfo retrieves information about this E-Series system
 func (d Client) AboutInfo(ctx context.Context) (*AboutResponse, error) {
+	ctx, span := d.startSpan(ctx, "AboutInfo")
+	defer span.End()
 
 	// Default to secure connection
 	scheme := "https"
 
+	if d.initErr != nil {
+		return nil, d.initErr
+	}
+
 	// Ensure we have controllers configured
 	if len(d.config.ApiControllers) == 0 {
-		return nil, errors.New("no API controllers configured")
+		return nil, fmt.Errorf("no API controllers configured: %w", ErrInvalidArgument)
 	}
 
+	ctx, cancel := d.withOperationTimeout(ctx)
+	defer cancel()
+	ctx = withRequestID(ctx)
+
 	var lastErr error
 
-	for _, controller := range d.config.ApiControllers {
+	for i, controller := range d.health.order(d.config.ApiControllers) {
 
 		// Build URL
 		url := fmt.Sprintf("%s://%s:%d/devmgr/utils/about", scheme, controller, d.config.ApiPort)
@@ -276,57 +571,36 @@ func (d Client) AboutInfo(ctx context.Context) (*AboutResponse, error) {
 		var request *http.Request
 		var prettyResponseBuffer bytes.Buffer
 
+		attemptCtx, span := d.startAttemptSpan(ctx, "GET", "", "/devmgr/utils/about", controller, 1, i > 0)
+
 		// Create the request
-		request, lastErr = http.NewRequestWithContext(ctx, "GET", url, nil)
+		request, lastErr = d.newRequest(attemptCtx, controller, "GET", url, nil)
 		if lastErr != nil {
+			endAttemptSpan(span, nil, lastErr)
+			if errors.Is(lastErr, ErrTransport) {
+				d.recordFailure(ctx, controller, lastErr)
+				continue
+			}
 			return nil, lastErr
 		}
 
-		request.Header.Set("Content-Type", "application/json")
-		request.Header.Set("accept", "application/json")
-		request.Header.Set("X-Request-ID", fmt.Sprint(ctx.Value(ContextKeyRequestID)))
-
-		if d.config.BearerToken != "" {
-			request.Header.Set("Authorization", "Bearer "+d.config.BearerToken)
-		} else {
-			request.SetBasicAuth(d.config.Username, d.config.Password)
-		}
-
 		// Log the request
 		if d.config.DebugTraceFlags["api"] {
 			LogHTTPRequest(request, []byte("<suppressed>"))
 		}
 
 		// Send the request
-		tlsConfig := &tls.Config{
-			InsecureSkipVerify: !d.config.VerifyTLS, // Allow certificate validation override
-			MinVersion:         MinTLSVersion,
-		}
-		if d.config.VerifyTLS && d.config.CACertPEM != "" {
-			caCertPool := x509.NewCertPool()
-			caCertPool.AppendCertsFromPEM([]byte(d.config.CACertPEM))
-			tlsConfig.RootCAs = caCertPool
-		}
-
-		tr := &http.Transport{
-			TLSClientConfig: tlsConfig,
-		}
-		client := &http.Client{
-			Transport: tr,
-			Timeout:   StorageAPITimeoutSeconds * time.Second,
-		}
-		response, err := client.Do(request)
+		response, err := d.httpClient.Do(request)
 		if err != nil {
+			endAttemptSpan(span, nil, err)
 			Logc(ctx).Warnf("Error communicating with controller %s: %v", controller, err)
-			lastErr = err
-			continue
-		}
-
-		if response.StatusCode != http.StatusOK {
-			response.Body.Close()
-			lastErr = Error{
-				Code:    response.StatusCode,
-				Message: "could not get about information",
+			lastErr = &TransportError{
+				Controller: controller,
+				RequestID:  request.Header.Get("X-Request-ID"),
+				Err:        err,
+			}
+			if ctx.Err() == nil {
+				d.recordFailure(ctx, controller, lastErr)
 			}
 			continue
 		}
@@ -334,9 +608,23 @@ func (d Client) AboutInfo(ctx context.Context) (*AboutResponse, error) {
 		responseBody := []byte{}
 		responseBody, err = io.ReadAll(response.Body)
 		response.Body.Close()
+		endAttemptSpan(span, response, err)
 
 		if err != nil {
-			lastErr = err
+			lastErr = &TransportError{
+				Controller: controller,
+				RequestID:  request.Header.Get("X-Request-ID"),
+				Err:        err,
+			}
+			if ctx.Err() == nil {
+				d.recordFailure(ctx, controller, lastErr)
+			}
+			continue
+		}
+		d.recordSuccess(ctx, controller)
+
+		if response.StatusCode != http.StatusOK {
+			lastErr = d.newAPIError(response, responseBody, "could not get about information")
 			continue
 		}
 
@@ -361,55 +649,59 @@ func (d Client) AboutInfo(ctx context.Context) (*AboutResponse, error) {
 	return nil, lastErr
 }
 
-// Connect to the E-Series system via the API
+// Connect checks connectivity and selects the storage system to manage: the one matching
+// ClientConfig.ArraySelector, or the only system known to the web services (always the case with the embedded
+// web services). It returns the ID of the selected system.
 func (d Client) Connect(ctx context.Context) (string, error) {
+	ctx, span := d.startSpan(ctx, "Connect")
+	defer span.End()
 
 	// First check basic connectivity
 	_, err := d.AboutInfo(ctx)
 	if err != nil {
-		return "", fmt.Errorf("could not connect to system: %v", err)
+		return "", fmt.Errorf("could not connect to system: %w", err)
 	}
 
 	// Now fetch the actual storage system ID (e.g. "1" or WWN)
-	// by querying /devmgr/v2/storage-systems/
-	// This does not require an ArrayID in the path yet.
-
-	// Temporarily unset ArrayID to ensure we just query the root of the API?
-	// Actually InvokeAPI uses config.ArrayID in the path: /devmgr/v2/storage-systems/{ArrayID}{resourcePath}
-	// If ArrayID is empty, path is /devmgr/v2/storage-systems/{resourcePath}.
-	// If resourcePath is "", we get /devmgr/v2/storage-systems/ which lists them.
-
-	d.config.ArrayID = ""
-	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "")
+	systems, err := d.ListStorageSystems(ctx)
 	if err != nil {
-		// Fallback for older systems or proxies where maybe we should trust AboutInfo?
-		// But for now, let's error out if we can't find the system.
-		return "", fmt.Errorf("could not list storage systems: %v", err)
-	}
-
-	if response.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("API error listing storage systems: %d", response.StatusCode)
+		return "", err
 	}
-
-	var systems []StorageSystem
-	if err := json.Unmarshal(responseBody, &systems); err != nil {
-		return "", fmt.Errorf("could not parse storage systems list: %v", err)
+	selector := d.config.ArraySelector
+	if selector == "" && len(systems) > 1 {
+		// Keep a configured ID rather than failing, as methods that call Connect again would
+		selector = d.arrayID()
 	}
-
-	if len(systems) == 0 {
-		return "", errors.New("no storage systems found")
+	system, err := selectStorageSystem(systems, selector)
+	if err != nil {
+		return "", err
 	}
+	d.array.set(system.ID)
 
-	// Use the first system found. In direct connect, there is usually only one ("1").
-	d.config.ArrayID = systems[0].ID
+	Logc(ctx).WithField("ArrayID", system.ID).Debug("Connected to storage system.")
 
-	Logc(ctx).WithField("ArrayID", d.config.ArrayID).Debug("Connected to storage system.")
+	// Detect versions and features for capability checks, once: methods such as GetHosts call Connect every time.
+	// Without them, nothing is checked in advance.
+	if d.capabilities.Load() != nil {
+		return system.ID, nil
+	}
+	capabilities, err := d.GetCapabilities(ctx)
+	if err != nil {
+		Logc(ctx).WithError(err).Warn("Could not detect storage system capabilities.")
+	} else if !capabilities.Supported() {
+		Logc(ctx).WithFields(log.Fields{
+			"APIVersion":        capabilities.APIVersion,
+			"MinimumAPIVersion": MinimumAPIVersion,
+		}).Warn("Storage system API version is older than the oldest supported version.")
+	}
 
-	return d.config.ArrayID, nil
+	return system.ID, nil
 }
 
 // GetStorageSystem returns a struct detailing the storage system.
 func (d Client) GetStorageSystem(ctx context.Context) (*StorageSystem, error) {
+	ctx, span := d.startSpan(ctx, "GetStorageSystem")
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -423,14 +715,11 @@ func (d Client) GetStorageSystem(ctx context.Context) (*StorageSystem, error) {
 	// Query array
 	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/")
 	if err != nil {
-		return nil, errors.New("could not read storage system")
+		return nil, fmt.Errorf("could not read storage system: %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return nil, Error{
-			Code:    response.StatusCode,
-			Message: "could not read storage system",
-		}
+		return nil, d.newAPIError(response, responseBody, "could not read storage system")
 	}
 
 	storageSystem := StorageSystem{}
@@ -446,6 +735,8 @@ func (d Client) GetStorageSystem(ctx context.Context) (*StorageSystem, error) {
 
 // GetChassisSerialNumber returns the chassis serial number for this storage system.
 func (d Client) GetChassisSerialNumber(ctx context.Context) (string, error) {
+	ctx, span := d.startSpan(ctx, "GetChassisSerialNumber")
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -468,11 +759,45 @@ func (d Client) GetChassisSerialNumber(ctx context.Context) (string, error) {
 	return storageSystem.ChassisSerialNumber, nil
 }
 
+// GetFailures returns the failures currently reported by the storage system (the Recovery Guru list). An
+// optimal system has none.
+func (d Client) GetFailures(ctx context.Context) ([]Failure, error) {
+	ctx, span := d.startSpan(ctx, "GetFailures")
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "GetFailures",
+			"Type":   "Client",
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetFailures")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetFailures")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/failures")
+	if err != nil {
+		return nil, fmt.Errorf("could not read failures: %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not read failures")
+	}
+
+	failures := make([]Failure, 0)
+	if err := json.Unmarshal(responseBody, &failures); err != nil {
+		return nil, fmt.Errorf("could not parse failures: %s. %v", string(responseBody), err)
+	}
+
+	return failures, nil
+}
+
 // GetVolumePools reads all pools on the array, including volume groups and dynamic disk pools. It then
 // filters them based on several selection parameters and returns the ones that match.
 func (d Client) GetVolumePools(
 	ctx context.Context, mediaType string, minFreeSpaceBytes uint64, poolName string,
 ) ([]VolumeGroupEx, error) {
+	ctx, span := d.startSpan(ctx, "GetVolumePools", AttributeName.String(poolName))
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -489,14 +814,11 @@ func (d Client) GetVolumePools(
 	// Get the storage pools (includes volume RAID groups and dynamic disk pools)
 	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/storage-pools")
 	if err != nil {
-		return nil, fmt.Errorf("could not get storage pools: %v", err)
+		return nil, fmt.Errorf("could not get storage pools: %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return nil, Error{
-			Code:    response.StatusCode,
-			Message: "could not get storage pools",
-		}
+		return nil, d.newAPIError(response, responseBody, "could not get storage pools")
 	}
 
 	// Parse JSON data
@@ -578,9 +900,11 @@ func (d Client) GetVolumePools(
 
 // GetVolumePoolByRef returns the pool with the specified volumeGroupRef.
 func (d Client) GetVolumePoolByRef(ctx context.Context, volumeGroupRef string) (VolumeGroupEx, error) {
+	ctx, span := d.startSpan(ctx, "GetVolumePoolByRef", AttributePoolRef.String(volumeGroupRef))
+	defer span.End()
 
 	if volumeGroupRef == "0000000000000000000000000000000000000000" || volumeGroupRef == "" {
-		return VolumeGroupEx{}, fmt.Errorf("invalid volumeGroupRef: %s", volumeGroupRef)
+		return VolumeGroupEx{}, fmt.Errorf("invalid volumeGroupRef %q: %w", volumeGroupRef, ErrInvalidArgument)
 	}
 
 	if d.config.DebugTraceFlags["method"] {
@@ -597,14 +921,11 @@ func (d Client) GetVolumePoolByRef(ctx context.Context, volumeGroupRef string) (
 	resourcePath := "/storage-pools/" + volumeGroupRef
 	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", resourcePath)
 	if err != nil {
-		return VolumeGroupEx{}, fmt.Errorf("could not get storage pool: %v", err)
+		return VolumeGroupEx{}, fmt.Errorf("could not get storage pool: %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return VolumeGroupEx{}, Error{
-			Code:    response.StatusCode,
-			Message: "could not get storage pool",
-		}
+		return VolumeGroupEx{}, d.newAPIError(response, responseBody, "could not get storage pool")
 	}
 
 	// Parse JSON data
@@ -624,7 +945,7 @@ func (d Client) GetVolumePoolByRef(ctx context.Context, volumeGroupRef string) (
 	}
 
 	if pool.VolumeGroupRef == "" {
-		return VolumeGroupEx{}, fmt.Errorf("storage pool not found or returned empty data for ref: %s", volumeGroupRef)
+		return VolumeGroupEx{}, fmt.Errorf("storage pool not found or returned empty data for ref %s: %w", volumeGroupRef, ErrNotFound)
 	}
 
 	return pool, nil
@@ -632,6 +953,8 @@ func (d Client) GetVolumePoolByRef(ctx context.Context, volumeGroupRef string) (
 
 // GetVolumes returns an array containing all the volumes on the array.
 func (d Client) GetVolumes(ctx context.Context) ([]VolumeEx, error) {
+	ctx, span := d.startSpan(ctx, "GetVolumes")
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -645,14 +968,11 @@ func (d Client) GetVolumes(ctx context.Context) ([]VolumeEx, error) {
 	// Query volumes on array
 	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/volumes")
 	if err != nil {
-		return nil, errors.New("failed to read volumes")
+		return nil, fmt.Errorf("failed to read volumes: %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return nil, Error{
-			Code:    response.StatusCode,
-			Message: "failed to read volumes",
-		}
+		return nil, d.newAPIError(response, responseBody, "failed to read volumes")
 	}
 
 	rawVolumes := make([]VolumeEx, 0)
@@ -685,6 +1005,21 @@ func (d Client) GetVolumes(ctx context.Context) ([]VolumeEx, error) {
 		}
 	}
 
+	// Thin volumes are listed separately by the array, and added unless /volumes already returned them
+	thinVolumes, err := d.listThinVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	listed := make(map[string]bool, len(rawVolumes))
+	for _, v := range rawVolumes {
+		listed[v.VolumeRef] = true
+	}
+	for _, v := range thinVolumes {
+		if !listed[v.VolumeRef] {
+			volumes = append(volumes, v)
+		}
+	}
+
 	Logc(ctx).WithField("Count", len(volumes)).Debug("Read volumes.")
 
 	return volumes, nil
@@ -692,6 +1027,8 @@ func (d Client) GetVolumes(ctx context.Context) ([]VolumeEx, error) {
 
 // ListVolumes returns an array containing all the volume names on the array.
 func (d Client) ListVolumes(ctx context.Context) ([]string, error) {
+	ctx, span := d.startSpan(ctx, "ListVolumes")
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -723,6 +1060,8 @@ func (d Client) ListVolumes(ctx context.Context) ([]string, error) {
 // read all volumes to find the one of interest. Most methods in this module operate on the returned VolumeEx structure, not
 // the volume name, to minimize the need for calling this method.
 func (d Client) GetVolume(ctx context.Context, name string) (VolumeEx, error) {
+	ctx, span := d.startSpan(ctx, "GetVolume", AttributeName.String(name))
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -748,11 +1087,13 @@ func (d Client) GetVolume(ctx context.Context, name string) (VolumeEx, error) {
 	return VolumeEx{}, nil
 }
 
-// GetVolumeByRef gets a single volume from the array.
+// GetVolumeByRef gets a single volume, thick or thin, from the array.
 func (d Client) GetVolumeByRef(ctx context.Context, volumeRef string) (VolumeEx, error) {
+	ctx, span := d.startSpan(ctx, "GetVolumeByRef", AttributeVolumeRef.String(volumeRef))
+	defer span.End()
 
 	if volumeRef == "" {
-		return VolumeEx{}, fmt.Errorf("volumeRef cannot be empty")
+		return VolumeEx{}, fmt.Errorf("volumeRef cannot be empty: %w", ErrInvalidArgument)
 	}
 
 	if d.config.DebugTraceFlags["method"] {
@@ -768,16 +1109,20 @@ func (d Client) GetVolumeByRef(ctx context.Context, volumeRef string) (VolumeEx,
 	// Get a single volume by its ref ID from storage array
 	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/volumes/"+volumeRef)
 	if err != nil {
-		return VolumeEx{}, fmt.Errorf("API invocation failed. %v", err)
+		return VolumeEx{}, fmt.Errorf("API invocation failed. %w", err)
 	}
 
-	if response.StatusCode != http.StatusOK {
-		return VolumeEx{}, Error{
-			Code:    response.StatusCode,
-			Message: "failed to read volume",
+	// Thin volumes are not found among the other volumes
+	if response.StatusCode == http.StatusNotFound {
+		if volume, ok, err := d.getThinVolumeByRef(ctx, volumeRef); err != nil || ok {
+			return volume, err
 		}
 	}
 
+	if response.StatusCode != http.StatusOK {
+		return VolumeEx{}, d.newAPIError(response, responseBody, "failed to read volume")
+	}
+
 	var volume VolumeEx
 	err = json.Unmarshal(responseBody, &volume)
 	if err != nil {
@@ -855,6 +1200,8 @@ func (d Client) CreateVolume(
 	ctx context.Context, name string, volumeGroupRef string, size uint64, mediaType, fstype string,
 	raidLevel string, blockSize int, segmentSize int, extraTags map[string]string,
 ) (VolumeEx, error) {
+	ctx, span := d.startSpan(ctx, "CreateVolume", AttributeName.String(name), AttributePoolRef.String(volumeGroupRef))
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -872,22 +1219,17 @@ func (d Client) CreateVolume(
 
 	// Ensure that we do not exceed the maximum allowed volume length
 	if len(name) > maxNameLength {
-		return VolumeEx{}, fmt.Errorf("the volume name %v exceeds the maximum length of %d characters", name,
-			maxNameLength)
+		return VolumeEx{}, fmt.Errorf("the volume name %v exceeds the maximum length of %d characters: %w", name,
+			maxNameLength, ErrInvalidArgument)
 	}
 
-	// Copy static volume metadata and add fstype
-	tags := []VolumeTag{
-		{"fstype", fstype},
-	}
-	if d.config.Protocol != "" {
-		tags = append(tags, VolumeTag{"IF", d.config.Protocol})
+	if blockSize != 0 {
+		if err := d.checkBlockSize(ctx, volumeGroupRef, blockSize); err != nil {
+			return VolumeEx{}, err
+		}
 	}
 
-	// Add extra tags (like PVC metadata)
-	for k, v := range extraTags {
-		tags = append(tags, VolumeTag{k, v})
-	}
+	tags := d.newVolumeTags(fstype, extraTags)
 
 	// Set up the volume create request
 	request := VolumeCreateRequest{
@@ -910,10 +1252,15 @@ func (d Client) CreateVolume(
 		return VolumeEx{}, fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
 	}
 
-	// Create the volume
-	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/volumes")
+	// Create the volume. A create that failed with a 422 may still have taken effect on the array, so the retry
+	// policy only repeats it while no volume of that name exists.
+	createCtx := withWriteVerifier(ctx, func(ctx context.Context) bool {
+		existing, err := d.GetVolume(ctx, name)
+		return err == nil && existing.VolumeRef == ""
+	})
+	response, responseBody, err := d.InvokeAPI(createCtx, jsonRequest, "POST", "/volumes")
 	if err != nil {
-		return VolumeEx{}, fmt.Errorf("API invocation failed. %v", err)
+		return VolumeEx{}, fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	// Work around API limitation by re-reading the volume we (hopefully) just created
@@ -926,14 +1273,18 @@ func (d Client) CreateVolume(
 		}
 
 		if retryVolume.VolumeRef == "" {
-			return VolumeEx{}, fmt.Errorf("volume %s could not be found after 422 response. Array rejection message: %s", name, string(responseBody))
+			return VolumeEx{}, d.newAPIError(response, responseBody, "volume %s could not be found after 422 response", name)
+		}
+		if d.volumeHasTags(retryVolume, tags) {
+			return retryVolume, nil
 		}
 
+		// The tag update may run into the same race, so it is repeated for a while, with or without a retry policy
 		result, retryErr := d.ensureVolumeTagsWithRetry(ctx, retryVolume.VolumeRef, tags)
 		if retryErr != nil {
 			// best effort cleanup of the volume we created that never received its required tags
 			if deleteErr := d.DeleteVolume(ctx, retryVolume); deleteErr != nil {
-				return VolumeEx{}, fmt.Errorf("%v; %v", retryErr.Error(), deleteErr.Error())
+				return VolumeEx{}, fmt.Errorf("%w; %v", retryErr, deleteErr)
 			}
 			return VolumeEx{}, retryErr
 		}
@@ -961,6 +1312,22 @@ func (d Client) CreateVolume(
 	return vol, nil
 }
 
+// newVolumeTags returns the tags of a new volume: the static volume metadata, the fstype and any extra tags
+// (like PVC metadata).
+func (d Client) newVolumeTags(fstype string, extraTags map[string]string) []VolumeTag {
+
+	tags := []VolumeTag{
+		{"fstype", fstype},
+	}
+	if d.config.Protocol != "" {
+		tags = append(tags, VolumeTag{"IF", d.config.Protocol})
+	}
+	for k, v := range extraTags {
+		tags = append(tags, VolumeTag{k, v})
+	}
+	return tags
+}
+
 func (d Client) volumeHasTags(volume VolumeEx, tags []VolumeTag) bool {
 
 	for _, tag := range tags {
@@ -986,6 +1353,8 @@ func (d Client) volumeHasTags(volume VolumeEx, tags []VolumeTag) bool {
 func (d Client) UpdateVolumeTags(
 	ctx context.Context, volumeRef string, tags []VolumeTag,
 ) (VolumeEx, error) {
+	ctx, span := d.startSpan(ctx, "UpdateVolumeTags", AttributeVolumeRef.String(volumeRef))
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -1000,7 +1369,7 @@ func (d Client) UpdateVolumeTags(
 
 	// We need a valid volumeRef
 	if len(volumeRef) <= 0 {
-		return VolumeEx{}, fmt.Errorf("the volumeRef is invalid")
+		return VolumeEx{}, fmt.Errorf("the volumeRef is invalid: %w", ErrInvalidArgument)
 	}
 
 	// Set up the volume update request
@@ -1013,6 +1382,9 @@ func (d Client) UpdateVolumeTags(
 
 // UpdateVolume updates a volume configuration.
 func (d Client) UpdateVolume(ctx context.Context, volumeRef string, request VolumeUpdateRequest) (VolumeEx, error) {
+	ctx, span := d.startSpan(ctx, "UpdateVolume", AttributeVolumeRef.String(volumeRef))
+	defer span.End()
+
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
 			"Method":    "UpdateVolume",
@@ -1032,7 +1404,7 @@ func (d Client) UpdateVolume(ctx context.Context, volumeRef string, request Volu
 	// Update the volume
 	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/volumes/"+volumeRef)
 	if err != nil {
-		return VolumeEx{}, fmt.Errorf("API invocation failed. %v", err)
+		return VolumeEx{}, fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
@@ -1059,6 +1431,8 @@ func (d Client) UpdateVolume(ctx context.Context, volumeRef string, request Volu
 
 // ResizingVolume checks to see if an expand operation is in progress for the volume.
 func (d Client) ResizingVolume(ctx context.Context, volume VolumeEx) (bool, error) {
+	ctx, span := d.startSpan(ctx, "ResizingVolume", AttributeVolumeRef.String(volume.VolumeRef))
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -1070,10 +1444,15 @@ func (d Client) ResizingVolume(ctx context.Context, volume VolumeEx) (bool, erro
 		defer Logc(ctx).WithFields(fields).Debug("<<<< ResizingVolume")
 	}
 
+	// Expanding a thin volume completes at once
+	if volume.ThinProvisioned {
+		return false, nil
+	}
+
 	resourcePath := "/volumes/" + volume.VolumeRef + "/expand"
 	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", resourcePath)
 	if err != nil {
-		return false, fmt.Errorf("API invocation failed. %v", err)
+		return false, fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	// Parse JSON data
@@ -1092,7 +1471,7 @@ func (d Client) ResizingVolume(ctx context.Context, volume VolumeEx) (bool, erro
 	switch response.StatusCode {
 	case http.StatusOK:
 		if responseData.Action != "none" {
-			return true, fmt.Errorf("volume resize operation in progress with action: %v", responseData.Action)
+			return true, fmt.Errorf("volume resize operation in progress with action %v: %w", responseData.Action, ErrConflict)
 		} else {
 			return false, nil
 		}
@@ -1103,9 +1482,10 @@ func (d Client) ResizingVolume(ctx context.Context, volume VolumeEx) (bool, erro
 	}
 }
 
-// ResizeVolume expands a volume's size. Thin provisioning is not supported on E-series
-// so we only call the API for expanding thick provisioned volumes.
+// ResizeVolume expands a volume's size. The virtual capacity of thin volumes is expanded with ExpandThinVolume.
 func (d Client) ResizeVolume(ctx context.Context, volume VolumeEx, size uint64) error {
+	ctx, span := d.startSpan(ctx, "ResizeVolume", AttributeVolumeRef.String(volume.VolumeRef))
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -1118,6 +1498,10 @@ func (d Client) ResizeVolume(ctx context.Context, volume VolumeEx, size uint64)
 		defer Logc(ctx).WithFields(fields).Debug("<<<< ResizeVolume")
 	}
 
+	if volume.ThinProvisioned {
+		return d.ExpandThinVolume(ctx, volume.VolumeRef, size, 0)
+	}
+
 	// The API requires size to be an int (not uint64) so pass as an int but in KB.
 	expansionSize := int(size / 1024)
 
@@ -1134,7 +1518,7 @@ func (d Client) ResizeVolume(ctx context.Context, volume VolumeEx, size uint64)
 	resourcePath := "/volumes/" + volume.VolumeRef + "/expand"
 	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", resourcePath)
 	if err != nil {
-		return fmt.Errorf("API invocation failed: %v", err)
+		return fmt.Errorf("API invocation failed: %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
@@ -1151,8 +1535,11 @@ func (d Client) ResizeVolume(ctx context.Context, volume VolumeEx, size uint64)
 	return nil
 }
 
-// DeleteVolume deletes a volume from the array.
+// DeleteVolume deletes a volume, thick or thin, from the array. A volume given only by its ref that is not found
+// among the thick volumes is deleted as a thin volume.
 func (d Client) DeleteVolume(ctx context.Context, volume VolumeEx) error {
+	ctx, span := d.startSpan(ctx, "DeleteVolume", AttributeVolumeRef.String(volume.VolumeRef))
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -1164,10 +1551,22 @@ func (d Client) DeleteVolume(ctx context.Context, volume VolumeEx) error {
 		defer Logc(ctx).WithFields(fields).Debug("<<<< DeleteVolume")
 	}
 
+	resourcePath := "/volumes/"
+	if volume.ThinProvisioned {
+		resourcePath = "/thin-volumes/"
+	}
+
 	// Remove this volume from storage array
-	response, responseBody, err := d.InvokeAPI(ctx, nil, "DELETE", "/volumes/"+volume.VolumeRef)
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "DELETE", resourcePath+volume.VolumeRef)
 	if err != nil {
-		return fmt.Errorf("API invocation failed. %v", err)
+		return fmt.Errorf("API invocation failed. %w", err)
+	}
+
+	if response.StatusCode == http.StatusNotFound && !volume.ThinProvisioned {
+		response, responseBody, err = d.InvokeAPI(ctx, nil, "DELETE", "/thin-volumes/"+volume.VolumeRef)
+		if err != nil {
+			return fmt.Errorf("API invocation failed. %w", err)
+		}
 	}
 
 	switch response.StatusCode {
@@ -1195,7 +1594,10 @@ func (d Client) DeleteVolume(ctx context.Context, volume VolumeEx) error {
 // ExpandVolume expands a volume to the specified size in bytes.
 // Note: This operation is asynchronous on the array.
 // The expansionSize parameter corresponds to the new TOTAL size of the volume in bytes.
+// A volume that is not found among the thick volumes is expanded as a thin volume, which completes at once.
 func (d Client) ExpandVolume(ctx context.Context, volumeRef string, expansionSize int64) error {
+	ctx, span := d.startSpan(ctx, "ExpandVolume", AttributeVolumeRef.String(volumeRef))
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -1208,6 +1610,13 @@ func (d Client) ExpandVolume(ctx context.Context, volumeRef string, expansionSiz
 		defer Logc(ctx).WithFields(fields).Debug("<<<< ExpandVolume")
 	}
 
+	_, err := d.expandVolume(ctx, volumeRef, expansionSize)
+	return err
+}
+
+// expandVolume implements ExpandVolume, and reports whether the volume was a thin one.
+func (d Client) expandVolume(ctx context.Context, volumeRef string, expansionSize int64) (bool, error) {
+
 	request := VolumeExpansionRequest{
 		ExpansionSize: fmt.Sprintf("%d", expansionSize),
 		SizeUnit:      "bytes",
@@ -1215,22 +1624,41 @@ func (d Client) ExpandVolume(ctx context.Context, volumeRef string, expansionSiz
 
 	jsonRequest, err := json.Marshal(request)
 	if err != nil {
-		return fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
+		return false, fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
 	}
 
 	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/volumes/"+volumeRef+"/expand")
 	if err != nil {
-		return fmt.Errorf("API invocation failed. %v", err)
+		return false, fmt.Errorf("API invocation failed. %w", err)
 	}
 
-	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusAccepted {
-		return Error{
-			Code:    response.StatusCode,
-			Message: fmt.Sprintf("could not expand volume %s: %s", volumeRef, string(responseBody)),
+	if response.StatusCode == http.StatusNotFound && expansionSize > 0 {
+		if _, ok, err := d.getThinVolumeByRef(ctx, volumeRef); err != nil {
+			return false, err
+		} else if ok {
+			return true, d.ExpandThinVolume(ctx, volumeRef, uint64(expansionSize), 0)
 		}
 	}
 
-	return nil
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusAccepted {
+		return false, d.newAPIError(response, responseBody, "could not expand volume %s", volumeRef)
+	}
+
+	return false, nil
+}
+
+// ExpandVolumeAndWait expands a volume like ExpandVolume and waits until the array has finished the expansion.
+// Errors of the wait are *OperationError values.
+func (d Client) ExpandVolumeAndWait(ctx context.Context, volumeRef string, expansionSize int64, config WaitConfig) error {
+	ctx, span := d.startSpan(ctx, "ExpandVolumeAndWait", AttributeVolumeRef.String(volumeRef))
+	defer span.End()
+
+	thin, err := d.expandVolume(ctx, volumeRef, expansionSize)
+	if err != nil || thin {
+		return err
+	}
+	_, err = WaitFor(ctx, d.VolumeAction(volumeRef), config)
+	return err
 }
 
 // EnsureHostForIQN handles automatic E-series Host and Host Group creation. Given the IQN of a host, this method
@@ -1238,6 +1666,9 @@ func (d Client) ExpandVolume(ctx context.Context, volumeRef string, expansionSiz
 // taken. If not, this method chooses a unique name for the Host and creates it on the array. Once the Host is created,
 // it is placed in the Host Group used for nDVP volumes.
 func (d Client) EnsureHostForIQN(ctx context.Context, iqn string) (HostEx, error) {
+	ctx, span := d.startSpan(ctx, "EnsureHostForIQN", AttributePortID.String(iqn))
+	defer span.End()
+
 	return d.EnsureHostForPort(ctx, iqn, "iscsi")
 }
 
@@ -1246,10 +1677,15 @@ func (d Client) EnsureHostForIQN(ctx context.Context, iqn string) (HostEx, error
 // taken. If not, this method chooses a unique name for the Host and creates it on the array. Once the Host is created,
 // it is placed in the Host Group used for nDVP volumes.
 func (d Client) EnsureHostForNQN(ctx context.Context, nqn string) (HostEx, error) {
+	ctx, span := d.startSpan(ctx, "EnsureHostForNQN", AttributePortID.String(nqn))
+	defer span.End()
+
 	return d.EnsureHostForPort(ctx, nqn, "nvmeof")
 }
 
 func (d Client) EnsureHostForPort(ctx context.Context, portID, portType string) (HostEx, error) {
+	ctx, span := d.startSpan(ctx, "EnsureHostForPort", AttributePortID.String(portID))
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -1266,7 +1702,7 @@ func (d Client) EnsureHostForPort(ctx context.Context, portID, portType string)
 	// GetHostForPort actually searches for both IQN and NQN/NVMeNodeName
 	host, err := d.GetHostForPort(ctx, portID)
 	if err != nil {
-		return HostEx{}, fmt.Errorf("could not ensure host for PortID %s: %v", portID, err)
+		return HostEx{}, fmt.Errorf("could not ensure host for PortID %s: %w", portID, err)
 	}
 
 	// If we found a host, return it and leave well enough alone, since the host could have been defined
@@ -1349,6 +1785,8 @@ func (d Client) createNameForPort(host string) string {
 // GetHostForPort queries the Host objects on the array an returns one matching the supplied port ID (IQN or NQN). An empty struct is
 // returned if a matching host is not found, so the caller should check for empty values in the result.
 func (d Client) GetHostForPort(ctx context.Context, portID string) (HostEx, error) {
+	ctx, span := d.startSpan(ctx, "GetHostForPort", AttributePortID.String(portID))
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -1363,14 +1801,11 @@ func (d Client) GetHostForPort(ctx context.Context, portID string) (HostEx, erro
 	// Get hosts
 	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/hosts")
 	if err != nil {
-		return HostEx{}, fmt.Errorf("API invocation failed. %v", err)
+		return HostEx{}, fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return HostEx{}, Error{
-			Code:    response.StatusCode,
-			Message: "could not get hosts from array",
-		}
+		return HostEx{}, d.newAPIError(response, responseBody, "could not get hosts from array")
 	}
 
 	// Parse JSON data
@@ -1436,6 +1871,8 @@ func (d Client) GetHostForPort(ctx context.Context, portID string) (HostEx, erro
 
 // CreateHost creates a Host on the array. If a HostGroup is specified, the Host is placed in that group.
 func (d Client) CreateHost(ctx context.Context, name, portID, portType, hostType, authSecret string, hostGroup HostGroup) (HostEx, error) {
+	ctx, span := d.startSpan(ctx, "CreateHost", AttributeName.String(name), AttributePortID.String(portID))
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -1480,14 +1917,11 @@ func (d Client) CreateHost(ctx context.Context, name, portID, portType, hostType
 	// Create the host
 	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/hosts")
 	if err != nil {
-		return HostEx{}, fmt.Errorf("API invocation failed. %v", err)
+		return HostEx{}, fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusCreated && response.StatusCode != http.StatusOK {
-		return HostEx{}, Error{
-			Code:    response.StatusCode,
-			Message: fmt.Sprintf("could not create host %s; Response: %s; Request: %s", name, string(responseBody), string(jsonRequest)),
-		}
+		return HostEx{}, d.newAPIError(response, responseBody, "could not create host %s", name)
 	}
 
 	// Parse JSON data
@@ -1508,6 +1942,9 @@ func (d Client) CreateHost(ctx context.Context, name, portID, portType, hostType
 
 // DeleteHost deletes a host from the array.
 func (d Client) DeleteHost(ctx context.Context, hostRef string) error {
+	ctx, span := d.startSpan(ctx, "DeleteHost", AttributeHostRef.String(hostRef))
+	defer span.End()
+
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
 			"Method":  "DeleteHost",
@@ -1519,22 +1956,21 @@ func (d Client) DeleteHost(ctx context.Context, hostRef string) error {
 	}
 
 	url := fmt.Sprintf("/hosts/%s", hostRef)
-	response, _, err := d.InvokeAPI(ctx, nil, "DELETE", url)
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "DELETE", url)
 	if err != nil {
-		return fmt.Errorf("API invocation failed. %v", err)
+		return fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
-		return Error{
-			Code:    response.StatusCode,
-			Message: fmt.Sprintf("could not delete host %s", hostRef),
-		}
+		return d.newAPIError(response, responseBody, "could not delete host %s", hostRef)
 	}
 
 	return nil
 }
 
 func (d Client) GetBestIndexForHostType(ctx context.Context, hostType string) int {
+	ctx, span := d.startSpan(ctx, "GetBestIndexForHostType")
+	defer span.End()
 
 	// If the hostType is explicitly an integer (as a string), use it directly.
 	if val, err := strconv.Atoi(hostType); err == nil {
@@ -1584,14 +2020,11 @@ func (d Client) getIndexForHostType(ctx context.Context, hostTypeCode string) (i
 	// Get host types
 	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/host-types")
 	if err != nil {
-		return -1, fmt.Errorf("API invocation failed. %v", err)
+		return -1, fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return -1, Error{
-			Code:    response.StatusCode,
-			Message: "could not get host types from array",
-		}
+		return -1, d.newAPIError(response, responseBody, "could not get host types from array")
 	}
 
 	// Parse JSON data
@@ -1622,6 +2055,8 @@ func (d Client) getIndexForHostType(ctx context.Context, hostTypeCode string) (i
 // The group name is taken from the config structure. If the group exists, the group structure is returned and no further
 // action is taken. If not, this method creates the group and returns the resulting group structure.
 func (d Client) EnsureHostGroup(ctx context.Context) (HostGroup, error) {
+	ctx, span := d.startSpan(ctx, "EnsureHostGroup")
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -1640,7 +2075,7 @@ func (d Client) EnsureHostGroup(ctx context.Context) (HostGroup, error) {
 	// Get the group with the preconfigured name
 	hostGroup, err := d.GetHostGroup(ctx, hostGroupName)
 	if err != nil {
-		return HostGroup{}, fmt.Errorf("could not ensure host group %s: %v", hostGroupName, err)
+		return HostGroup{}, fmt.Errorf("could not ensure host group %s: %w", hostGroupName, err)
 	}
 
 	// Group found, so use it for host creation
@@ -1652,7 +2087,7 @@ func (d Client) EnsureHostGroup(ctx context.Context) (HostGroup, error) {
 	// Create the group
 	hostGroup, err = d.CreateHostGroup(ctx, hostGroupName)
 	if err != nil {
-		return HostGroup{}, fmt.Errorf("could not create host group %s: %v", hostGroupName, err)
+		return HostGroup{}, fmt.Errorf("could not create host group %s: %w", hostGroupName, err)
 	}
 
 	Logc(ctx).WithFields(log.Fields{
@@ -1665,6 +2100,9 @@ func (d Client) EnsureHostGroup(ctx context.Context) (HostGroup, error) {
 
 // GetHostGroups returns a list of all HostGroups.
 func (d Client) GetHostGroups(ctx context.Context) ([]HostGroup, error) {
+	ctx, span := d.startSpan(ctx, "GetHostGroups")
+	defer span.End()
+
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
 			"Method": "GetHostGroups",
@@ -1676,14 +2114,11 @@ func (d Client) GetHostGroups(ctx context.Context) ([]HostGroup, error) {
 
 	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/host-groups")
 	if err != nil {
-		return nil, fmt.Errorf("API invocation failed. %v", err)
+		return nil, fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return nil, Error{
-			Code:    response.StatusCode,
-			Message: "could not get host groups from array",
-		}
+		return nil, d.newAPIError(response, responseBody, "could not get host groups from array")
 	}
 
 	hostGroups := make([]HostGroup, 0)
@@ -1697,6 +2132,8 @@ func (d Client) GetHostGroups(ctx context.Context) ([]HostGroup, error) {
 
 // empty structure is returned, so the caller should check for empty values in the result.
 func (d Client) GetHostGroup(ctx context.Context, name string) (HostGroup, error) {
+	ctx, span := d.startSpan(ctx, "GetHostGroup", AttributeName.String(name))
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -1711,14 +2148,11 @@ func (d Client) GetHostGroup(ctx context.Context, name string) (HostGroup, error
 	// Get host groups
 	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/host-groups")
 	if err != nil {
-		return HostGroup{}, fmt.Errorf("API invocation failed. %v", err)
+		return HostGroup{}, fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return HostGroup{}, Error{
-			Code:    response.StatusCode,
-			Message: "could not get host groups from array",
-		}
+		return HostGroup{}, d.newAPIError(response, responseBody, "could not get host groups from array")
 	}
 
 	// Parse JSON data
@@ -1739,6 +2173,8 @@ func (d Client) GetHostGroup(ctx context.Context, name string) (HostGroup, error
 
 // CreateHostGroup creates an E-series HostGroup object with the specified name and returns the resulting HostGroup structure.
 func (d Client) CreateHostGroup(ctx context.Context, name string) (HostGroup, error) {
+	ctx, span := d.startSpan(ctx, "CreateHostGroup", AttributeName.String(name))
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -1764,14 +2200,11 @@ func (d Client) CreateHostGroup(ctx context.Context, name string) (HostGroup, er
 	// Create the host group
 	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/host-groups")
 	if err != nil {
-		return HostGroup{}, fmt.Errorf("API invocation failed. %v", err)
+		return HostGroup{}, fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusCreated && response.StatusCode != http.StatusOK {
-		return HostGroup{}, Error{
-			Code:    response.StatusCode,
-			Message: fmt.Sprintf("could not create host group %s", name),
-		}
+		return HostGroup{}, d.newAPIError(response, responseBody, "could not create host group %s", name)
 	}
 
 	// Parse JSON data
@@ -1785,6 +2218,8 @@ func (d Client) CreateHostGroup(ctx context.Context, name string) (HostGroup, er
 
 // GetHostByRef returns a Host structure for the given host reference.
 func (d Client) GetHostByRef(ctx context.Context, hostRef string) (HostEx, error) {
+	ctx, span := d.startSpan(ctx, "GetHostByRef", AttributeHostRef.String(hostRef))
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -1798,14 +2233,11 @@ func (d Client) GetHostByRef(ctx context.Context, hostRef string) (HostEx, error
 
 	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/hosts/"+hostRef)
 	if err != nil {
-		return HostEx{}, fmt.Errorf("API invocation failed. %v", err)
+		return HostEx{}, fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return HostEx{}, Error{
-			Code:    response.StatusCode,
-			Message: fmt.Sprintf("could not get host %s", hostRef),
-		}
+		return HostEx{}, d.newAPIError(response, responseBody, "could not get host %s", hostRef)
 	}
 
 	var host HostEx
@@ -1818,6 +2250,9 @@ func (d Client) GetHostByRef(ctx context.Context, hostRef string) (HostEx, error
 
 // UpdateHost updates a host configuration.
 func (d Client) UpdateHost(ctx context.Context, hostRef string, request HostUpdateRequest) (HostEx, error) {
+	ctx, span := d.startSpan(ctx, "UpdateHost", AttributeHostRef.String(hostRef))
+	defer span.End()
+
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
 			"Method":  "UpdateHost",
@@ -1836,14 +2271,11 @@ func (d Client) UpdateHost(ctx context.Context, hostRef string, request HostUpda
 
 	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/hosts/"+hostRef)
 	if err != nil {
-		return HostEx{}, fmt.Errorf("API invocation failed. %v", err)
+		return HostEx{}, fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return HostEx{}, Error{
-			Code:    response.StatusCode,
-			Message: fmt.Sprintf("could not update host %s", hostRef),
-		}
+		return HostEx{}, d.newAPIError(response, responseBody, "could not update host %s", hostRef)
 	}
 
 	var host HostEx
@@ -1856,6 +2288,9 @@ func (d Client) UpdateHost(ctx context.Context, hostRef string, request HostUpda
 
 // DeleteHostGroup deletes a HostGroup from the array.
 func (d Client) DeleteHostGroup(ctx context.Context, hostGroupRef string) error {
+	ctx, span := d.startSpan(ctx, "DeleteHostGroup", AttributeHostGroupRef.String(hostGroupRef))
+	defer span.End()
+
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
 			"Method":       "DeleteHostGroup",
@@ -1867,16 +2302,13 @@ func (d Client) DeleteHostGroup(ctx context.Context, hostGroupRef string) error
 	}
 
 	url := fmt.Sprintf("/host-groups/%s", hostGroupRef)
-	response, _, err := d.InvokeAPI(ctx, nil, "DELETE", url)
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "DELETE", url)
 	if err != nil {
-		return fmt.Errorf("API invocation failed. %v", err)
+		return fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
-		return Error{
-			Code:    response.StatusCode,
-			Message: fmt.Sprintf("could not delete host group %s", hostGroupRef),
-		}
+		return d.newAPIError(response, responseBody, "could not delete host group %s", hostGroupRef)
 	}
 
 	return nil
@@ -1884,6 +2316,9 @@ func (d Client) DeleteHostGroup(ctx context.Context, hostGroupRef string) error
 
 // GetHostGroupByRef returns a HostGroup structure for the given reference.
 func (d Client) GetHostGroupByRef(ctx context.Context, hostGroupRef string) (HostGroup, error) {
+	ctx, span := d.startSpan(ctx, "GetHostGroupByRef", AttributeHostGroupRef.String(hostGroupRef))
+	defer span.End()
+
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
 			"Method":       "GetHostGroupByRef",
@@ -1896,14 +2331,11 @@ func (d Client) GetHostGroupByRef(ctx context.Context, hostGroupRef string) (Hos
 
 	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/host-groups/"+hostGroupRef)
 	if err != nil {
-		return HostGroup{}, fmt.Errorf("API invocation failed. %v", err)
+		return HostGroup{}, fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return HostGroup{}, Error{
-			Code:    response.StatusCode,
-			Message: fmt.Sprintf("could not get host group %s", hostGroupRef),
-		}
+		return HostGroup{}, d.newAPIError(response, responseBody, "could not get host group %s", hostGroupRef)
 	}
 
 	var hostGroup HostGroup
@@ -1919,6 +2351,8 @@ func (d Client) GetHostGroupByRef(ctx context.Context, hostGroupRef string) (Hos
 // the method returns an error. Note that if the host is in a group, the volume will actually be mapped to the group instead of the
 // individual host.
 func (d Client) MapVolume(ctx context.Context, volume VolumeEx, host HostEx, lun int) (LUNMapping, error) {
+	ctx, span := d.startSpan(ctx, "MapVolume", AttributeVolumeRef.String(volume.VolumeRef), AttributeHostRef.String(host.HostRef))
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -1938,7 +2372,8 @@ func (d Client) MapVolume(ctx context.Context, volume VolumeEx, host HostEx, lun
 		mapping, err := d.mapVolume(ctx, volume, host, lun)
 
 		// Work around API limitation by re-reading the map
-		if apiError, ok := err.(Error); ok && apiError.Code == http.StatusUnprocessableEntity {
+		var apiError Error
+		if errors.As(err, &apiError) && apiError.Code == http.StatusUnprocessableEntity {
 
 			Logc(ctx).Debug("Volume map failed with 422 response, attempting to re-read volume/map.")
 
@@ -1986,8 +2421,8 @@ func (d Client) MapVolume(ctx context.Context, volume VolumeEx, host HostEx, lun
 		} else {
 
 			// Mapped elsewhere, so return an error
-			return LUNMapping{}, fmt.Errorf("volume %s is already mapped to a different host or host group",
-				volume.Label)
+			return LUNMapping{}, fmt.Errorf("volume %s is already mapped to a different host or host group: %w",
+				volume.Label, ErrConflict)
 		}
 	}
 }
@@ -2026,17 +2461,19 @@ func (d Client) mapVolume(ctx context.Context, volume VolumeEx, host HostEx, lun
 		return LUNMapping{}, fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
 	}
 
-	// Create the mapping
-	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/volume-mappings")
+	// Create the mapping. A 422 does not prove that the mapping failed, so the retry policy only repeats the
+	// request while the volume is still unmapped.
+	mapCtx := withWriteVerifier(ctx, func(ctx context.Context) bool {
+		current, err := d.GetVolumeByRef(ctx, volume.VolumeRef)
+		return err == nil && !current.IsMapped
+	})
+	response, responseBody, err := d.InvokeAPI(mapCtx, jsonRequest, "POST", "/volume-mappings")
 	if err != nil {
-		return LUNMapping{}, fmt.Errorf("API invocation failed. %v", err)
+		return LUNMapping{}, fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return LUNMapping{}, Error{
-			Code:    response.StatusCode,
-			Message: fmt.Sprintf("could not map volume %s", volume.Label),
-		}
+		return LUNMapping{}, d.newAPIError(response, responseBody, "could not map volume %s", volume.Label)
 	}
 
 	// Parse JSON data
@@ -2128,6 +2565,8 @@ func (d Client) volumeIsMappedToHost(ctx context.Context, volume VolumeEx, host
 
 // UnmapVolume removes a mapping from the specified volume. If no map exists, no action is taken.
 func (d Client) UnmapVolume(ctx context.Context, volume VolumeEx) error {
+	ctx, span := d.startSpan(ctx, "UnmapVolume", AttributeVolumeRef.String(volume.VolumeRef))
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -2152,16 +2591,13 @@ func (d Client) UnmapVolume(ctx context.Context, volume VolumeEx) error {
 	mapping := volume.Mappings[0]
 
 	// Remove this volume mapping from storage array
-	response, _, err := d.InvokeAPI(ctx, nil, "DELETE", "/volume-mappings/"+mapping.LunMappingRef)
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "DELETE", "/volume-mappings/"+mapping.LunMappingRef)
 	if err != nil {
-		return fmt.Errorf("API invocation failed. %v", err)
+		return fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
-		return Error{
-			Code:    response.StatusCode,
-			Message: fmt.Sprintf("could not unmap volume %s", volume.Label),
-		}
+		return d.newAPIError(response, responseBody, "could not unmap volume %s", volume.Label)
 	}
 
 	Logc(ctx).WithFields(log.Fields{
@@ -2177,6 +2613,8 @@ func (d Client) UnmapVolume(ctx context.Context, volume VolumeEx) error {
 
 // GetTargetIQN returns the IQN for the array.
 func (d *Client) GetTargetIQN(ctx context.Context) (string, error) {
+	ctx, span := d.startSpan(ctx, "GetTargetIQN")
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -2190,14 +2628,11 @@ func (d *Client) GetTargetIQN(ctx context.Context) (string, error) {
 	// Query iSCSI target settings
 	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/iscsi/target-settings")
 	if err != nil {
-		return "", fmt.Errorf("API invocation failed. %v", err)
+		return "", fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return "", Error{
-			Code:    response.StatusCode,
-			Message: "could not read iSCSI settings",
-		}
+		return "", d.newAPIError(response, responseBody, "could not read iSCSI settings")
 	}
 
 	var settings IscsiTargetSettings
@@ -2215,6 +2650,8 @@ func (d *Client) GetTargetIQN(ctx context.Context) (string, error) {
 
 // GetTargetSettings returns the iSCSI target settings for the array.
 func (d *Client) GetTargetSettings(ctx context.Context) (*IscsiTargetSettings, error) {
+	ctx, span := d.startSpan(ctx, "GetTargetSettings")
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -2228,14 +2665,11 @@ func (d *Client) GetTargetSettings(ctx context.Context) (*IscsiTargetSettings, e
 	// Query iSCSI target settings
 	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/iscsi/target-settings")
 	if err != nil {
-		return nil, fmt.Errorf("API invocation failed. %v", err)
+		return nil, fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return nil, Error{
-			Code:    response.StatusCode,
-			Message: "could not read iSCSI settings",
-		}
+		return nil, d.newAPIError(response, responseBody, "could not read iSCSI settings")
 	}
 
 	var settings IscsiTargetSettings
@@ -2253,6 +2687,8 @@ func (d *Client) GetTargetSettings(ctx context.Context) (*IscsiTargetSettings, e
 
 // GetNVMeoFSettings returns the NVMeoF initiator settings for the array.
 func (d *Client) GetNVMeoFSettings(ctx context.Context) (*NvmeofTargetSettings, error) {
+	ctx, span := d.startSpan(ctx, "GetNVMeoFSettings")
+	defer span.End()
 
 	if d.config.DebugTraceFlags["method"] {
 		fields := log.Fields{
@@ -2266,14 +2702,11 @@ func (d *Client) GetNVMeoFSettings(ctx context.Context) (*NvmeofTargetSettings,
 	// Query NVMeoF target settings
 	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/nvmeof/initiator-settings")
 	if err != nil {
-		return nil, fmt.Errorf("API invocation failed. %v", err)
+		return nil, fmt.Errorf("API invocation failed. %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return nil, Error{
-			Code:    response.StatusCode,
-			Message: "could not read NVMeoF settings",
-		}
+		return nil, d.newAPIError(response, responseBody, "could not read NVMeoF settings")
 	}
 
 	var settings NvmeofTargetSettings
@@ -2301,43 +2734,20 @@ func (d Client) IsRefValid(ref string) bool {
 	}
 }
 
-// getErrorFromHTTPResponse converts error information from some E-series API responses into GoLang error objects that
-// embed the additional error text.
-func (d Client) getErrorFromHTTPResponse(response *http.Response, responseBody []byte) Error {
-
-	if response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusUnprocessableEntity {
-
-		// Parse JSON error data
-		responseData := CallResponseError{}
-		if err := json.Unmarshal(responseBody, &responseData); err != nil {
-			return Error{
-				Code:    response.StatusCode,
-				Message: fmt.Sprintf("could not parse API error response: %s; %v", string(responseBody), err),
-			}
-		}
-
-		return Error{
-			Code: response.StatusCode,
-			Message: fmt.Sprintf("API failed; Error: %s; Localized: %s",
-				responseData.ErrorMsg, responseData.LocalizedMsg),
-		}
-	} else {
-
-		// Other error
-		return Error{
-			Code:    response.StatusCode,
-			Message: "API failed",
-		}
-	}
-}
-
 // GetSnapshotGroups retrieves all Snapshot Groups (PiT Groups)
 func (d Client) GetSnapshotGroups(ctx context.Context) ([]SnapshotGroup, error) {
+	ctx, span := d.startSpan(ctx, "GetSnapshotGroups")
+	defer span.End()
+
 	Logc(ctx).Debug("Getting SnapshotGroups")
 
-	_, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/snapshot-groups")
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/snapshot-groups")
 	if err != nil {
-		return nil, fmt.Errorf("could not get snapshot groups: %v", err)
+		return nil, fmt.Errorf("could not get snapshot groups: %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not get snapshot groups")
 	}
 
 	var groups []SnapshotGroup
@@ -2350,11 +2760,18 @@ func (d Client) GetSnapshotGroups(ctx context.Context) ([]SnapshotGroup, error)
 
 // GetSnapshotImages retrieves all Snapshot Images (PiTs)
 func (d Client) GetSnapshotImages(ctx context.Context) ([]SnapshotImage, error) {
+	ctx, span := d.startSpan(ctx, "GetSnapshotImages")
+	defer span.End()
+
 	Logc(ctx).Debug("Getting SnapshotImages")
 
-	_, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/snapshot-images")
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/snapshot-images")
 	if err != nil {
-		return nil, fmt.Errorf("could not get snapshot images: %v", err)
+		return nil, fmt.Errorf("could not get snapshot images: %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not get snapshot images")
 	}
 
 	var images []SnapshotImage
@@ -2367,11 +2784,18 @@ func (d Client) GetSnapshotImages(ctx context.Context) ([]SnapshotImage, error)
 
 // GetSnapshotVolumes retrieves all Snapshot Volumes (Linked Clones)
 func (d Client) GetSnapshotVolumes(ctx context.Context) ([]SnapshotVolume, error) {
+	ctx, span := d.startSpan(ctx, "GetSnapshotVolumes")
+	defer span.End()
+
 	Logc(ctx).Debug("Getting SnapshotVolumes")
 
-	_, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/snapshot-volumes")
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/snapshot-volumes")
 	if err != nil {
-		return nil, fmt.Errorf("could not get snapshot volumes: %v", err)
+		return nil, fmt.Errorf("could not get snapshot volumes: %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not get snapshot volumes")
 	}
 
 	var volumes []SnapshotVolume
@@ -2384,46 +2808,23 @@ func (d Client) GetSnapshotVolumes(ctx context.Context) ([]SnapshotVolume, error
 
 // CheckVolumeDependencies checks if a volume has dependent Snapshots or Linked Clones
 func (d Client) CheckVolumeDependencies(ctx context.Context, volumeRef string) error {
-	Logc(ctx).WithField("volumeRef", volumeRef).Debug("Checking volume dependencies")
+	ctx, span := d.startSpan(ctx, "CheckVolumeDependencies", AttributeVolumeRef.String(volumeRef))
+	defer span.End()
 
-	// 1. Check for Linked Clones (SnapshotVolumes) directly depending on the volume
-	snapVols, err := d.GetSnapshotVolumes(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to list snapshot volumes: %v", err)
-	}
-	for _, sv := range snapVols {
-		if sv.BaseVolume == volumeRef {
-			return fmt.Errorf("volume has dependent Linked Clone (SnapshotVolume): %s (%s)", sv.Label, sv.SnapshotRef)
-		}
-	}
+	Logc(ctx).WithField("volumeRef", volumeRef).Debug("Checking volume dependencies")
 
-	// 2. Check for SnapshotGroups (PiTGroups) depending on the volume
-	// And if they contain active SnapshotImages (PiTs)
-	snapGroups, err := d.GetSnapshotGroups(ctx)
+	graph, err := d.configGraph(ctx, false)
 	if err != nil {
-		return fmt.Errorf("failed to list snapshot groups: %v", err)
-	}
-
-	var dependentGroups []string
-	for _, g := range snapGroups {
-		if g.BaseVolume == volumeRef {
-			dependentGroups = append(dependentGroups, g.PitGroupRef)
-		}
+		return fmt.Errorf("failed to read volume dependencies: %w", err)
 	}
 
-	if len(dependentGroups) > 0 {
-		// Found groups, now check if they have snapshots
-		snapImages, err := d.GetSnapshotImages(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to list snapshot images: %v", err)
-		}
-
-		for _, img := range snapImages {
-			for _, gRef := range dependentGroups {
-				if img.PitGroupRef == gRef {
-					return fmt.Errorf("volume has dependent Snapshot Image (PiT): %s (Group: %s)", img.PitRef, gRef)
-				}
-			}
+	// Mappings are removed by the caller; Linked Clones (SnapshotVolumes) and Snapshot Images (PiTs) are not
+	for _, node := range graph.DeleteBlockers(volumeRef) {
+		switch n := node.(type) {
+		case *SnapshotVolumeNode:
+			return fmt.Errorf("volume has dependent Linked Clone (SnapshotVolume): %s (%s): %w", n.Label, n.SnapshotRef, ErrConflict)
+		case *SnapshotImageNode:
+			return fmt.Errorf("volume has dependent Snapshot Image (PiT): %s (Group: %s): %w", n.PitRef, n.PitGroupRef, ErrConflict)
 		}
 	}
 
@@ -2432,6 +2833,9 @@ func (d Client) CheckVolumeDependencies(ctx context.Context, volumeRef string) e
 
 // CreateVolumeMapping creates a mapping between a volume and a host or host group.
 func (d Client) CreateVolumeMapping(ctx context.Context, request VolumeMappingCreateRequest) (*LUNMapping, error) {
+	ctx, span := d.startSpan(ctx, "CreateVolumeMapping")
+	defer span.End()
+
 	if _, err := d.Connect(ctx); err != nil {
 		return nil, err
 	}
@@ -2448,7 +2852,7 @@ func (d Client) CreateVolumeMapping(ctx context.Context, request VolumeMappingCr
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
-		return nil, fmt.Errorf("failed to create volume mapping: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, d.newAPIError(resp, responseBody, "failed to create volume mapping")
 	}
 
 	var mapping LUNMapping
@@ -2461,6 +2865,9 @@ func (d Client) CreateVolumeMapping(ctx context.Context, request VolumeMappingCr
 
 // GetHosts returns a list of all hosts
 func (d Client) GetHosts(ctx context.Context) ([]Host, error) {
+	ctx, span := d.startSpan(ctx, "GetHosts")
+	defer span.End()
+
 	if _, err := d.Connect(ctx); err != nil {
 		return nil, err
 	}
@@ -2471,7 +2878,7 @@ func (d Client) GetHosts(ctx context.Context) ([]Host, error) {
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get hosts: %d", resp.StatusCode)
+		return nil, d.newAPIError(resp, body, "failed to get hosts")
 	}
 
 	var hosts []Host
@@ -2484,17 +2891,20 @@ func (d Client) GetHosts(ctx context.Context) ([]Host, error) {
 
 // GetVolumeMappings returns a list of all volume mappings on the array
 func (d Client) GetVolumeMappings(ctx context.Context) ([]LUNMapping, error) {
+	ctx, span := d.startSpan(ctx, "GetVolumeMappings")
+	defer span.End()
+
 	if _, err := d.Connect(ctx); err != nil {
 		return nil, err
 	}
 
 	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/volume-mappings")
 	if err != nil {
-		return nil, fmt.Errorf("failed to get volume mappings: %v", err)
+		return nil, fmt.Errorf("failed to get volume mappings: %w", err)
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get volume mappings: status %d", response.StatusCode)
+		return nil, d.newAPIError(response, responseBody, "failed to get volume mappings")
 	}
 
 	var mappings []LUNMapping
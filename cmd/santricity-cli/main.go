@@ -3,29 +3,54 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	santricity "github.com/scaleoutsean/santricity-go"
+	"github.com/scaleoutsean/santricity-go/models/v11"
+	"github.com/scaleoutsean/santricity-go/santricityrecord"
 	"github.com/spf13/cobra"
 )
 
 var (
 	endpoint     string
+	arraySelect  string
 	username     string
 	password     string
 	token        string
+	tokenCommand string
 	caCert       string
 	insecure     bool
+	useSession   bool
 	debug        bool
+	dryRun       bool
+	auditFile    string
+	auditSyslog  bool
+	auditSinks   []io.Closer
+	recordFile   string
+	recorder     *santricityrecord.Recorder
+	timeout      time.Duration
 	outputFormat string
-	apiClient    *santricity.Client
+	apiClient    santricity.API
+	arrayID      string
+	allArrays    bool
+	fleet        *santricity.Fleet
 	ctx          context.Context
 )
 
+// skipConnect marks commands that work with the list of storage systems instead of one selected system, so the
+// client must not select one at startup.
+const skipConnect = "skip-connect"
+
+// fleetCapable marks commands that support --all-arrays.
+const fleetCapable = "fleet-capable"
+
 func main() {
 	var rootCmd = &cobra.Command{
 		Use:   "santricity-cli",
@@ -51,12 +76,21 @@ func main() {
 			if token == "" {
 				token = os.Getenv("SANTRICITY_TOKEN")
 			}
+			if tokenCommand == "" {
+				tokenCommand = os.Getenv("SANTRICITY_TOKEN_COMMAND")
+			}
 			if !cmd.Flags().Changed("insecure") && os.Getenv("SANTRICITY_INSECURE") == "true" {
 				insecure = true
 			}
 			if caCert == "" {
 				caCert = os.Getenv("SANTRICITY_CA_CERT")
 			}
+			if arraySelect == "" {
+				arraySelect = os.Getenv("SANTRICITY_ARRAY")
+			}
+			if auditFile == "" {
+				auditFile = os.Getenv("SANTRICITY_AUDIT_FILE")
+			}
 			if endpoint == "" {
 				log.Fatal("Error: --endpoint or SANTRICITY_ENDPOINT is required.")
 			}
@@ -69,6 +103,9 @@ func main() {
 			if token != "" && (cmd.Flags().Changed("username") || cmd.Flags().Changed("password")) {
 				log.Fatal("Error: --token is mutually exclusive with --username/--password.")
 			}
+			if tokenCommand != "" && token != "" {
+				log.Fatal("Error: --token-command and --token are mutually exclusive.")
+			}
 
 			// Initialize client
 			var caCertPEM string
@@ -87,39 +124,108 @@ func main() {
 			}
 
 			config := santricity.ClientConfig{
-				ApiControllers:  []string{endpoint},
+				ApiControllers:  strings.Split(endpoint, ","),
 				ApiPort:         8443,
 				Username:        username,
 				Password:        password,
 				BearerToken:     token,
+				UseSession:      useSession,
 				VerifyTLS:       !insecure,
 				CACertPEM:       caCertPEM,
 				DebugTraceFlags: debugFlags,
+				RequestTimeout:  timeout,
+				RetryPolicy:     santricity.DefaultRetryPolicy(),
+				ArraySelector:   arraySelect,
+				// Name lookups read the same lists many times during one command
+				Cache:  &santricity.CacheConfig{},
+				DryRun: dryRun,
+			}
+			if tokenCommand != "" {
+				config.Credentials = &santricity.CommandCredentialProvider{
+					Command: strings.Fields(tokenCommand),
+				}
+			}
+			config.Audit = openAudit()
+			if recordFile != "" {
+				recorder = santricityrecord.NewRecorder()
+				config.WrapTransport = recorder.Wrap
 			}
 			ctx = context.Background()
-			apiClient = santricity.NewAPIClient(ctx, config)
+			client := santricity.NewAPIClient(ctx, config)
+			apiClient = client
+			if cmd.Annotations[skipConnect] == "true" {
+				return
+			}
+
+			// Query every array known to the (proxy) web services instead of selecting one
+			if allArrays {
+				if cmd.Annotations[fleetCapable] != "true" {
+					log.Fatalf("Error: %s does not support --all-arrays", cmd.CommandPath())
+				}
+				var err error
+				fleet, err = santricity.NewFleetFromProxy(ctx, client, santricity.FleetConfig{})
+				if err != nil {
+					log.Fatalf("Error listing storage systems: %v", err)
+				}
+				return
+			}
 
 			// Establish connection to find the System ID
-			if _, err := apiClient.Connect(ctx); err != nil {
+			var err error
+			if arrayID, err = apiClient.Connect(ctx); err != nil {
 				log.Fatalf("Error connecting to system: %v", err)
 			}
+			if caps := apiClient.Capabilities(); caps != nil {
+				if !caps.Supported() {
+					log.Printf("Warning: API version %s is older than the oldest supported version %s",
+						caps.APIVersion, santricity.MinimumAPIVersion)
+				}
+				if debug {
+					log.Printf("Capabilities: %s", caps)
+				}
+			}
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			if apiClient != nil {
+				if dryRun {
+					printPlan(apiClient.Plan())
+				}
+				apiClient.Close()
+			}
+			if recorder != nil {
+				if err := recorder.Save(recordFile); err != nil {
+					log.Printf("Error: %v", err)
+				}
+			}
+			for _, sink := range auditSinks {
+				sink.Close()
+			}
 		},
 	}
 
-	rootCmd.PersistentFlags().StringVar(&endpoint, "endpoint", "", "Controller IP/Hostname (required)")
+	rootCmd.PersistentFlags().StringVar(&endpoint, "endpoint", "", "Controller IP/Hostname, or a comma-separated list of both controllers (required)")
+	rootCmd.PersistentFlags().StringVar(&arraySelect, "array", "", "Storage system to manage through a Web Services Proxy: WWN, name, chassis serial or ID")
 	rootCmd.PersistentFlags().StringVar(&caCert, "ca-cert", "", "Path to CA Certificate file")
 	rootCmd.PersistentFlags().StringVarP(&username, "username", "u", "admin", "Username")
 	rootCmd.PersistentFlags().StringVarP(&password, "password", "p", "", "Password")
 	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "text", "Output format (text, json)")
 	rootCmd.PersistentFlags().StringVar(&token, "token", "", "Bearer Token")
+	rootCmd.PersistentFlags().StringVar(&tokenCommand, "token-command", "", "Command that prints a Bearer Token")
 	rootCmd.PersistentFlags().BoolVar(&insecure, "insecure", false, "Skip TLS verification")
+	rootCmd.PersistentFlags().BoolVar(&useSession, "session", false, "Log in once and reuse the session instead of Basic auth")
 	rootCmd.PersistentFlags().BoolVar(&debug, "debug", false, "Enable debug logging")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Print the API calls that would change the array instead of making them")
+	rootCmd.PersistentFlags().StringVar(&auditFile, "audit-file", "", "Append a JSON-lines record of every change made to the array to this file")
+	rootCmd.PersistentFlags().BoolVar(&auditSyslog, "audit-syslog", false, "Send a record of every change made to the array to syslog")
+	rootCmd.PersistentFlags().StringVar(&recordFile, "record", "", "Record the API requests and responses, with secrets removed, to this cassette file")
+	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 0, "Timeout for each API request (default 90s)")
 	// rootCmd.MarkPersistentFlagRequired("endpoint")
 
 	var getCmd = &cobra.Command{
 		Use:   "get",
 		Short: "Get resources",
 	}
+	getCmd.PersistentFlags().BoolVar(&allArrays, "all-arrays", false, "Query every storage system known to the Web Services Proxy")
 
 	var getSystemCmd = &cobra.Command{
 		Use:   "system",
@@ -136,46 +242,37 @@ func main() {
 	var showRepoVols bool
 	var showOrphansOnly bool
 	var getVolumesCmd = &cobra.Command{
-		Use:   "volumes",
-		Short: "List volumes",
+		Use:         "volumes",
+		Short:       "List volumes",
+		Annotations: map[string]string{fleetCapable: "true"},
 		Run: func(cmd *cobra.Command, args []string) {
 			volNameFilter, _ := cmd.Flags().GetString("volume-name")
 
-			// If the user wants orphans, we must tell the client to fetch ALL system volumes first,
-			// because orphans are technically system/repo volumes.
-			if showOrphansOnly {
-				apiClient.SetIncludeRepositoryVolumes(true)
-			} else {
-				apiClient.SetIncludeRepositoryVolumes(showRepoVols)
-			}
-
-			vols, err := apiClient.GetVolumes(ctx)
-			if err != nil {
-				log.Fatalf("Error getting volumes: %v", err)
+			printVolume := func(prefix string, v santricity.VolumeEx) {
+				usageInfo := ""
+				if v.VolumeUse != "" {
+					usageInfo = fmt.Sprintf(" [%s]", v.VolumeUse)
+				}
+				provisioning := "thick"
+				if v.ThinProvisioned {
+					provisioning = "thin"
+				}
+				log.Printf("%sVolume: %s (Size: %s Ref: %s Provisioning: %s)%s",
+					prefix, v.Label, v.VolumeSize, v.VolumeRef, provisioning, usageInfo)
 			}
 
-			// Apply Filters
-			var filteredVols []santricity.VolumeEx
-
-			// 1. Orphan Filter takes precedence if set
-			if showOrphansOnly {
-				for _, v := range vols {
-					if v.VolumeUse == "freeRepositoryVolume" {
-						filteredVols = append(filteredVols, v)
-					}
-				}
-				vols = filteredVols
+			if fleet != nil {
+				items, err := santricity.FleetCollect(ctx, fleet,
+					func(ctx context.Context, client *santricity.Client) ([]santricity.VolumeEx, error) {
+						return listVolumes(ctx, client, volNameFilter, showRepoVols, showOrphansOnly)
+					})
+				printFleetItems(items, err, printVolume)
+				return
 			}
 
-			// 2. Name Filter
-			if volNameFilter != "" {
-				var nameFiltered []santricity.VolumeEx
-				for _, v := range vols {
-					if v.Label == volNameFilter {
-						nameFiltered = append(nameFiltered, v)
-					}
-				}
-				vols = nameFiltered
+			vols, err := listVolumes(ctx, apiClient, volNameFilter, showRepoVols, showOrphansOnly)
+			if err != nil {
+				log.Fatalf("Error getting volumes: %v", err)
 			}
 
 			if outputFormat == "json" {
@@ -186,11 +283,7 @@ func main() {
 				fmt.Println(string(b))
 			} else {
 				for _, v := range vols {
-					usageInfo := ""
-					if v.VolumeUse != "" {
-						usageInfo = fmt.Sprintf(" [%s]", v.VolumeUse)
-					}
-					log.Printf("Volume: %s (Size: %s Ref: %s)%s", v.Label, v.VolumeSize, v.VolumeRef, usageInfo)
+					printVolume("", v)
 				}
 			}
 		},
@@ -200,9 +293,20 @@ func main() {
 	getVolumesCmd.Flags().String("volume-name", "", "Filter by volume name")
 
 	var getHostsCmd = &cobra.Command{
-		Use:   "hosts",
-		Short: "List hosts",
+		Use:         "hosts",
+		Short:       "List hosts",
+		Annotations: map[string]string{fleetCapable: "true"},
 		Run: func(cmd *cobra.Command, args []string) {
+			printHost := func(prefix string, h santricity.Host) {
+				log.Printf("%sHost: %s (Ref: %s, Cluster: %s)", prefix, h.Label, h.HostRef, h.ClusterRef)
+			}
+
+			if fleet != nil {
+				items, err := fleet.GetHosts(ctx)
+				printFleetItems(items, err, printHost)
+				return
+			}
+
 			hosts, err := apiClient.GetHosts(ctx)
 			if err != nil {
 				log.Fatalf("Error getting hosts: %v", err)
@@ -215,7 +319,7 @@ func main() {
 				fmt.Println(string(b))
 			} else {
 				for _, h := range hosts {
-					log.Printf("Host: %s (Ref: %s, Cluster: %s)", h.Label, h.HostRef, h.ClusterRef)
+					printHost("", h)
 				}
 			}
 		},
@@ -270,9 +374,25 @@ func main() {
 	getCmd.AddCommand(getMappingsCmd)
 
 	var getPoolsCmd = &cobra.Command{
-		Use:   "pools",
-		Short: "List storage pools",
+		Use:         "pools",
+		Short:       "List storage pools",
+		Annotations: map[string]string{fleetCapable: "true"},
 		Run: func(cmd *cobra.Command, args []string) {
+			printPool := func(prefix string, p santricity.VolumeGroupEx) {
+				log.Printf("%sPool: %s", prefix, p.Label)
+				log.Printf("%s  ID: %s", prefix, p.VolumeGroupRef)
+				log.Printf("%s  Media: %s", prefix, p.DriveMediaType)
+				log.Printf("%s  PhyType: %s", prefix, p.DrivePhysicalType)
+				log.Printf("%s  RAID: %s", prefix, p.RaidLevel)
+				log.Printf("%s  Free: %s", prefix, p.FreeSpace)
+			}
+
+			if fleet != nil {
+				items, err := fleet.GetVolumePools(ctx, "", 0, "")
+				printFleetItems(items, err, printPool)
+				return
+			}
+
 			pools, err := apiClient.GetVolumePools(ctx, "", 0, "")
 			if err != nil {
 				log.Fatalf("Error getting pools: %v", err)
@@ -285,20 +405,72 @@ func main() {
 				fmt.Println(string(b))
 			} else {
 				for _, p := range pools {
-					log.Printf("Pool: %s", p.Label)
-					log.Printf("  ID: %s", p.VolumeGroupRef)
-					log.Printf("  Media: %s", p.DriveMediaType)
-					log.Printf("  PhyType: %s", p.DrivePhysicalType)
-					log.Printf("  RAID: %s", p.RaidLevel)
-					log.Printf("  Free: %s", p.FreeSpace)
+					printPool("", p)
+				}
+			}
+		},
+	}
+
+	var getControllersCmd = &cobra.Command{
+		Use:   "controllers",
+		Short: "Show management controller health",
+		Run: func(cmd *cobra.Command, args []string) {
+			status := apiClient.ControllerStatus()
+			if outputFormat == "json" {
+				b, err := json.MarshalIndent(status, "", "  ")
+				if err != nil {
+					log.Fatalf("Error marshaling to JSON: %v", err)
+				}
+				fmt.Println(string(b))
+			} else {
+				for _, c := range status {
+					marker := ""
+					if c.Active {
+						marker = " [active]"
+					}
+					log.Printf("Controller: %s (Healthy: %t, Failures: %d)%s", c.Controller, c.Healthy,
+						c.ConsecutiveFailures, marker)
 				}
 			}
 		},
 	}
 
+	var getCapabilitiesCmd = &cobra.Command{
+		Use:   "capabilities",
+		Short: "Show API and firmware versions and features",
+		Run: func(cmd *cobra.Command, args []string) {
+			caps, err := apiClient.GetCapabilities(ctx)
+			if err != nil {
+				log.Fatalf("Error getting capabilities: %v", err)
+			}
+			if outputFormat == "json" {
+				b, err := json.MarshalIndent(caps, "", "  ")
+				if err != nil {
+					log.Fatalf("Error marshaling to JSON: %v", err)
+				}
+				fmt.Println(string(b))
+			} else {
+				log.Printf("API Version: %s (supported: %t)", caps.APIVersion, caps.Supported())
+				log.Printf("Firmware: %s", caps.FirmwareVersion)
+				log.Printf("Model: %s", caps.Model)
+				if caps.FeaturePack != "" {
+					log.Printf("Feature Pack: %s", caps.FeaturePack)
+				}
+				log.Printf("Enabled Features: %s", strings.Join(caps.EnabledFeatures, ", "))
+				log.Printf("Supported Features: %s", strings.Join(caps.Features, ", "))
+			}
+		},
+	}
+
 	getCmd.AddCommand(getSystemCmd)
+	getCmd.AddCommand(getCapabilitiesCmd)
+	getCmd.AddCommand(getControllersCmd)
 	getCmd.AddCommand(getVolumesCmd)
 	getCmd.AddCommand(getPoolsCmd)
+	getCmd.AddCommand(getStorageSystemsCmd)
+	getCmd.AddCommand(getFailuresCmd)
+	getCmd.AddCommand(getDependentsCmd)
+	getCmd.AddCommand(getAuditLogCmd)
 	rootCmd.AddCommand(getCmd)
 
 	var createCmd = &cobra.Command{
@@ -370,7 +542,9 @@ func main() {
 	createCmd.AddCommand(createHostGroupCmd)
 
 	var volName, volPoolID, volSizeStr, volMediaType, volFSType, volRaidLevel string
-	var volBlockSize int
+	var volBlockSize, volAlertThreshold int
+	var volThin bool
+	var volRepoSizeGB uint64
 	var createVolumeCmd = &cobra.Command{
 		Use:   "volume",
 		Short: "Create a volume",
@@ -387,7 +561,22 @@ func main() {
 			fmt.Sscanf(volSizeStr, "%d", &sizeGB) // Simple parsing
 			sizeBytes := sizeGB * 1024 * 1024 * 1024
 
-			vol, err := apiClient.CreateVolume(ctx, volName, volPoolID, sizeBytes, volMediaType, volFSType, volRaidLevel, volBlockSize, 0, nil)
+			var vol santricity.VolumeEx
+			var err error
+			if volThin {
+				if volRaidLevel != "" || volBlockSize != 0 {
+					log.Fatal("Error: --raid-level and --block-size cannot be used with --thin")
+				}
+				options := santricity.ThinVolumeOptions{
+					RepositorySize:       volRepoSizeGB * 1024 * 1024 * 1024,
+					GrowthAlertThreshold: volAlertThreshold,
+				}
+				var thinVol santricity.ThinVolume
+				thinVol, err = apiClient.CreateThinVolume(ctx, volName, volPoolID, sizeBytes, volFSType, options, nil)
+				vol = thinVol.VolumeEx
+			} else {
+				vol, err = apiClient.CreateVolume(ctx, volName, volPoolID, sizeBytes, volMediaType, volFSType, volRaidLevel, volBlockSize, 0, nil)
+			}
 			if err != nil {
 				log.Fatalf("Error creating volume: %v", err)
 			}
@@ -409,6 +598,9 @@ func main() {
 	createVolumeCmd.Flags().StringVar(&volFSType, "fstype", "xfs", "Filesystem Type")
 	createVolumeCmd.Flags().StringVar(&volRaidLevel, "raid-level", "", "RAID Level (e.g. raid1, raid6). Leave empty to use pool default.")
 	createVolumeCmd.Flags().IntVar(&volBlockSize, "block-size", 0, "Block Size (e.g. 512, 4096)")
+	createVolumeCmd.Flags().BoolVar(&volThin, "thin", false, "Create a thin volume (disk pools only)")
+	createVolumeCmd.Flags().Uint64Var(&volRepoSizeGB, "repo-size", 0, "Initial repository size in GB (thin only, default 4)")
+	createVolumeCmd.Flags().IntVar(&volAlertThreshold, "alert-threshold", 0, "Repository utilization (%) that raises an alert (thin only)")
 
 	var mappingVolID, mappingTargetID string
 	var mappingLun int
@@ -447,6 +639,7 @@ func main() {
 
 	createCmd.AddCommand(createMappingCmd)
 	createCmd.AddCommand(createVolumeCmd)
+	createCmd.AddCommand(createStorageSystemCmd)
 	rootCmd.AddCommand(createCmd)
 
 	getCmd.AddCommand(getSnapshotGroupsCmd)
@@ -461,6 +654,12 @@ func main() {
 	createCmd.AddCommand(createCGSnapshotCmd)
 	createCmd.AddCommand(createCGViewCmd)
 
+	getCmd.AddCommand(getMirrorPairsCmd)
+	createCmd.AddCommand(createMirrorPairCmd)
+
+	getCmd.AddCommand(getSnapshotSchedulesCmd)
+	createCmd.AddCommand(createSnapshotScheduleCmd)
+
 	var rollbackCmd = &cobra.Command{
 		Use:   "rollback",
 		Short: "Rollback operations",
@@ -503,6 +702,228 @@ func main() {
 	}
 }
 
+var getStorageSystemsCmd = &cobra.Command{
+	Use:         "storage-systems",
+	Short:       "List the storage systems known to the web services (Web Services Proxy)",
+	Annotations: map[string]string{skipConnect: "true"},
+	Run: func(cmd *cobra.Command, args []string) {
+		systems, err := apiClient.ListStorageSystems(ctx)
+		if err != nil {
+			log.Fatalf("Error listing storage systems: %v", err)
+		}
+		if outputFormat == "json" {
+			jsonData, _ := json.MarshalIndent(systems, "", "  ")
+			fmt.Println(string(jsonData))
+		} else {
+			for _, s := range systems {
+				fmt.Printf("ID: %s, Name: %s, WWN: %s, Serial: %s, Status: %s, Firmware: %s\n",
+					s.ID, s.Name, s.Wwn, s.ChassisSerialNumber, s.Status, s.FwVersion)
+			}
+		}
+	},
+}
+
+var getFailuresCmd = &cobra.Command{
+	Use:         "failures",
+	Short:       "List the failures reported by the Recovery Guru",
+	Annotations: map[string]string{fleetCapable: "true"},
+	Run: func(cmd *cobra.Command, args []string) {
+		printFailure := func(prefix string, f santricity.Failure) {
+			log.Printf("%sFailure: %s (Object: %s %s)", prefix, f.FailureType, f.ObjectType, f.ObjectRef)
+		}
+
+		if fleet != nil {
+			items, err := fleet.GetFailures(ctx)
+			printFleetItems(items, err, printFailure)
+			return
+		}
+
+		failures, err := apiClient.GetFailures(ctx)
+		if err != nil {
+			log.Fatalf("Error getting failures: %v", err)
+		}
+		if outputFormat == "json" {
+			jsonData, _ := json.MarshalIndent(failures, "", "  ")
+			fmt.Println(string(jsonData))
+		} else {
+			if len(failures) == 0 {
+				log.Printf("No failures")
+			}
+			for _, f := range failures {
+				printFailure("", f)
+			}
+		}
+	},
+}
+
+var getAuditLogCmd = &cobra.Command{
+	Use:   "audit-log",
+	Short: "List the array's audit log, or match it against an audit file written with --audit-file",
+	Run: func(cmd *cobra.Command, args []string) {
+		since, _ := cmd.Flags().GetDuration("since")
+		journal, _ := cmd.Flags().GetString("journal")
+		tolerance, _ := cmd.Flags().GetDuration("tolerance")
+
+		begin := time.Now().Add(-since)
+		records, err := apiClient.GetAuditLog(ctx, begin.Add(-tolerance), time.Time{})
+		if err != nil {
+			log.Fatalf("Error reading audit log: %v", err)
+		}
+
+		if journal == "" {
+			if outputFormat == "json" {
+				if records == nil {
+					records = []v11.AuditLogRecord{}
+				}
+				jsonData, _ := json.MarshalIndent(records, "", "  ")
+				fmt.Println(string(jsonData))
+				return
+			}
+			for _, r := range records {
+				fmt.Printf("%s %s %s %s %d (%s, %s)\n",
+					r.DateTime, r.UserID, r.Method, r.AccessedURL, r.HTTPStatus, r.ClientIP, r.UserAgent)
+			}
+			return
+		}
+
+		file, err := os.Open(journal)
+		if err != nil {
+			log.Fatalf("Error opening audit file: %v", err)
+		}
+		defer file.Close()
+		all, err := santricity.ReadAuditEvents(file)
+		if err != nil {
+			log.Fatalf("Error reading audit file: %v", err)
+		}
+		var events []santricity.AuditEvent
+		for _, event := range all {
+			if event.ArrayID == arrayID && !event.Time.Before(begin) {
+				events = append(events, event)
+			}
+		}
+
+		matches := santricity.CorrelateAuditLog(events, records, tolerance)
+		if outputFormat == "json" {
+			jsonData, _ := json.MarshalIndent(matches, "", "  ")
+			fmt.Println(string(jsonData))
+			return
+		}
+		for _, m := range matches {
+			fmt.Printf("%s %s %s %s %s (%s)\n", m.Event.Time.Format(time.RFC3339), m.Event.Caller,
+				m.Event.Method, m.Event.Path, m.Event.Outcome, m.Event.Operation)
+			if m.Record != nil {
+				fmt.Printf("    array: %s %s %d\n", m.Record.DateTime, m.Record.UserID, m.Record.HTTPStatus)
+			} else {
+				fmt.Println("    array: no matching record")
+			}
+		}
+	},
+}
+
+var getDependentsCmd = &cobra.Command{
+	Use:   "dependents",
+	Short: "List the objects that depend on an object, block its deletion, or belong to a PVC",
+	Run: func(cmd *cobra.Command, args []string) {
+		id, _ := cmd.Flags().GetString("id")
+		blockers, _ := cmd.Flags().GetBool("blockers")
+		pvc, _ := cmd.Flags().GetString("pvc")
+
+		if (id == "") == (pvc == "") {
+			log.Fatal("Error: one of --id or --pvc is required")
+		}
+
+		graph, err := apiClient.GetConfigGraph(ctx)
+		if err != nil {
+			log.Fatalf("Error reading configuration: %v", err)
+		}
+
+		var nodes []santricity.GraphNode
+		switch {
+		case pvc != "":
+			namespace, name, found := strings.Cut(pvc, "/")
+			if !found {
+				namespace, name = "", pvc
+			}
+			nodes = graph.OwnedByPVC(namespace, name)
+		case graph.Node(id) == nil:
+			log.Fatalf("Object %s not found", id)
+		case blockers:
+			nodes = graph.DeleteBlockers(id)
+		default:
+			nodes = graph.Dependents(id)
+		}
+
+		if outputFormat == "json" {
+			type object struct {
+				Type  string `json:"type"`
+				Ref   string `json:"ref"`
+				Label string `json:"label"`
+			}
+			objects := make([]object, 0, len(nodes))
+			for _, n := range nodes {
+				objects = append(objects, object{Type: n.NodeType(), Ref: n.NodeRef(), Label: n.NodeLabel()})
+			}
+			jsonData, _ := json.MarshalIndent(objects, "", "  ")
+			fmt.Println(string(jsonData))
+			return
+		}
+		if len(nodes) == 0 {
+			log.Printf("No objects found")
+		}
+		for _, n := range nodes {
+			fmt.Printf("%-24s %-42s %s\n", n.NodeType(), n.NodeRef(), n.NodeLabel())
+		}
+	},
+}
+
+var createStorageSystemCmd = &cobra.Command{
+	Use:         "storage-system",
+	Short:       "Register a storage system with a Web Services Proxy",
+	Annotations: map[string]string{skipConnect: "true"},
+	Run: func(cmd *cobra.Command, args []string) {
+		controllers, _ := cmd.Flags().GetString("controllers")
+		id, _ := cmd.Flags().GetString("id")
+		arrayPassword, _ := cmd.Flags().GetString("array-password")
+		acceptCert, _ := cmd.Flags().GetBool("accept-cert")
+		validate, _ := cmd.Flags().GetBool("validate")
+
+		req := santricity.StorageSystemRegisterRequest{
+			ID:                  id,
+			ControllerAddresses: strings.Split(controllers, ","),
+			AcceptCertificate:   acceptCert,
+			Validate:            validate,
+			Password:            arrayPassword,
+		}
+		newID, err := apiClient.RegisterStorageSystem(ctx, req)
+		if err != nil {
+			log.Fatalf("Error registering storage system: %v", err)
+		}
+		fmt.Printf("Registered Storage System %s\n", newID)
+	},
+}
+
+var deleteStorageSystemCmd = &cobra.Command{
+	Use:         "storage-system",
+	Short:       "Remove a storage system from a Web Services Proxy",
+	Annotations: map[string]string{skipConnect: "true"},
+	Run: func(cmd *cobra.Command, args []string) {
+		id, _ := cmd.Flags().GetString("id")
+		if id == "" {
+			log.Fatal("Error: --id is required")
+		}
+
+		// Accept any selector, but only remove a system that is known
+		system, err := apiClient.FindStorageSystem(ctx, id)
+		if err != nil {
+			log.Fatalf("Error finding storage system: %v", err)
+		}
+		if err := apiClient.UnregisterStorageSystem(ctx, system.ID); err != nil {
+			log.Fatalf("Error removing storage system: %v", err)
+		}
+		fmt.Printf("Removed Storage System %s (%s)\n", system.ID, system.Name)
+	},
+}
+
 var getSnapshotGroupsCmd = &cobra.Command{
 	Use:   "snapshot-groups",
 	Short: "Get all Snapshot Groups",
@@ -860,31 +1281,15 @@ var deleteSnapshotGroupCmd = &cobra.Command{
 			}
 		}
 
-		// Check for dependencies (Linked Clones / Snapshot Volumes)
-		// 1. Get all images in this group
-		images, err := apiClient.GetSnapshotImages(ctx)
+		// Check for dependencies (Linked Clones / Snapshot Volumes of the group's images)
+		graph, err := apiClient.GetConfigGraph(ctx)
 		if err != nil {
-			log.Fatalf("Error listing snapshot images: %v", err)
-		}
-		var groupImages []string
-		for _, img := range images {
-			if img.PitGroupRef == id {
-				groupImages = append(groupImages, img.PitRef)
-			}
-		}
-
-		// 2. Get all snapshot volumes that map to these images
-		snapVols, err := apiClient.GetSnapshotVolumes(ctx)
-		if err != nil {
-			log.Printf("Warning: Could not list snapshot volumes to check dependencies: %v", err)
+			log.Printf("Warning: Could not read the configuration to check dependencies: %v", err)
 		} else {
 			var dependentVols []santricity.SnapshotVolume
-			for _, vol := range snapVols {
-				for _, pitRef := range groupImages {
-					if vol.BasePIT == pitRef {
-						dependentVols = append(dependentVols, vol)
-						break
-					}
+			for _, node := range graph.DeleteBlockers(id) {
+				if vol, ok := node.(*santricity.SnapshotVolumeNode); ok {
+					dependentVols = append(dependentVols, vol.SnapshotVolume)
 				}
 			}
 
@@ -1245,6 +1650,245 @@ var deleteCGViewCmd = &cobra.Command{
 	},
 }
 
+var getMirrorPairsCmd = &cobra.Command{
+	Use:     "mirror-pairs",
+	Aliases: []string{"mirror-pair"},
+	Short:   "Get synchronous Remote Mirror Pairs",
+	Run: func(cmd *cobra.Command, args []string) {
+		id, _ := cmd.Flags().GetString("id")
+
+		if id != "" {
+			status, err := apiClient.GetRemoteMirrorStatus(ctx, id)
+			if err != nil {
+				log.Fatalf("Error getting mirror pair status: %v", err)
+			}
+			if outputFormat == "json" {
+				jsonData, _ := json.MarshalIndent(status, "", "  ")
+				fmt.Println(string(jsonData))
+			} else {
+				fmt.Printf("ID:         %s\n", status.Ref)
+				fmt.Printf("Volume:     %s\n", status.Name)
+				fmt.Printf("Role:       %s (remote %s)\n", status.LocalRole, status.RemoteRole)
+				fmt.Printf("State:      %s (%s)\n", status.State, status.ArrayState)
+				if status.State == santricity.MirrorStateSynchronizing {
+					fmt.Printf("Progress:   %d%%\n", status.PercentComplete)
+				}
+			}
+			return
+		}
+
+		pairs, err := apiClient.GetRemoteMirrorPairs(ctx)
+		if err != nil {
+			log.Fatalf("Error getting mirror pairs: %v", err)
+		}
+		if outputFormat == "json" {
+			jsonData, _ := json.MarshalIndent(pairs, "", "  ")
+			fmt.Println(string(jsonData))
+		} else {
+			fmt.Printf("%-36s %-20s %-20s %-16s %-6s\n", "ID", "Volume", "Remote Array", "Status", "Mode")
+			for _, p := range pairs {
+				fmt.Printf("%-36s %-20s %-20s %-16s %-6d\n", p.ID, p.Base.Label, p.TargetArray, p.Status, p.WriteMode)
+			}
+		}
+	},
+}
+
+var createMirrorPairCmd = &cobra.Command{
+	Use:   "mirror-pair",
+	Short: "Mirror a volume synchronously to a volume of a remote array",
+	Run: func(cmd *cobra.Command, args []string) {
+		volID, _ := cmd.Flags().GetString("volume-id")
+		targetWWN, _ := cmd.Flags().GetString("target-wwn")
+		remoteArray, _ := cmd.Flags().GetString("remote-array")
+		writeMode, _ := cmd.Flags().GetInt("write-mode")
+		priority, _ := cmd.Flags().GetInt("priority")
+		autoResync, _ := cmd.Flags().GetBool("auto-resync")
+
+		// Find the array of the target volume among the candidates
+		if remoteArray == "" {
+			candidates, err := apiClient.GetRemoteMirrorCandidates(ctx, volID)
+			if err != nil {
+				log.Fatalf("Error getting mirror candidates: %v", err)
+			}
+			for _, c := range candidates {
+				for _, v := range c.TargetVolumes {
+					if strings.EqualFold(v.WorldWideName, targetWWN) {
+						remoteArray = c.TargetArrayID
+					}
+				}
+			}
+			if remoteArray == "" {
+				log.Fatalf("Volume %s is not a mirror candidate for volume %s", targetWWN, volID)
+			}
+		}
+
+		req := v11.RemoteVolumeMirrorCreateRequest{
+			SrcVolID:      volID,
+			TgtVolWWN:     targetWWN,
+			RemoteArrayID: remoteArray,
+			CopyType:      writeMode,
+			Priority:      priority,
+			AutoResync:    autoResync,
+		}
+		pair, err := apiClient.CreateRemoteMirrorPair(ctx, req)
+		if err != nil {
+			log.Fatalf("Error creating mirror pair: %v", err)
+		}
+		if outputFormat == "json" {
+			jsonData, _ := json.MarshalIndent(pair, "", "  ")
+			fmt.Println(string(jsonData))
+		} else {
+			fmt.Printf("Created Mirror Pair: %s (Volume: %s, Remote Array: %s)\n", pair.ID, pair.Base.Label, pair.TargetArray)
+		}
+	},
+}
+
+var deleteMirrorPairCmd = &cobra.Command{
+	Use:   "mirror-pair",
+	Short: "Delete a Remote Mirror Pair",
+	Run: func(cmd *cobra.Command, args []string) {
+		id, _ := cmd.Flags().GetString("id")
+		if id == "" {
+			log.Fatal("Error: --id is required")
+		}
+
+		err := apiClient.DeleteRemoteMirrorPair(ctx, id)
+		if err != nil {
+			log.Fatalf("Error deleting mirror pair: %v", err)
+		}
+		fmt.Printf("Deleted Mirror Pair %s\n", id)
+	},
+}
+
+var getSnapshotSchedulesCmd = &cobra.Command{
+	Use:     "snapshot-schedules",
+	Aliases: []string{"snapshot-schedule"},
+	Short:   "Get Snapshot Schedules",
+	Run: func(cmd *cobra.Command, args []string) {
+		id, _ := cmd.Flags().GetString("id")
+
+		var schedules []santricity.SnapshotSchedule
+		if id != "" {
+			schedule, err := apiClient.GetSnapshotSchedule(ctx, id)
+			if err != nil {
+				log.Fatalf("Error getting snapshot schedule: %v", err)
+			}
+			schedules = append(schedules, *schedule)
+		} else {
+			var err error
+			schedules, err = apiClient.GetSnapshotSchedules(ctx)
+			if err != nil {
+				log.Fatalf("Error getting snapshot schedules: %v", err)
+			}
+		}
+
+		if outputFormat == "json" {
+			jsonData, _ := json.MarshalIndent(schedules, "", "  ")
+			fmt.Println(string(jsonData))
+		} else {
+			fmt.Printf("%-36s %-36s %-10s %-8s %-22s %-20s\n", "ID", "Target", "Status", "Repeat", "Time Zone", "Next Run")
+			for _, s := range schedules {
+				config := s.Config()
+				nextRun := "-"
+				if !s.NextRun().IsZero() {
+					nextRun = s.NextRun().Format("2006-01-02 15:04")
+				}
+				fmt.Printf("%-36s %-36s %-10s %-8s %-22s %-20s\n", s.ID, s.TargetObject, s.ScheduleStatus,
+					config.Recurrence, config.Location, nextRun)
+			}
+		}
+	},
+}
+
+var createSnapshotScheduleCmd = &cobra.Command{
+	Use:   "snapshot-schedule",
+	Short: "Create a Snapshot Group with a schedule that takes its Snapshot Images",
+	Run: func(cmd *cobra.Command, args []string) {
+		volID, _ := cmd.Flags().GetString("volume-id")
+		name, _ := cmd.Flags().GetString("name")
+		repoPct, _ := cmd.Flags().GetFloat64("repo-pct")
+
+		req := santricity.SnapshotGroupCreateRequest{
+			BaseMappableObjectId: volID,
+			Name:                 name,
+			RepositoryPercentage: repoPct,
+			WarningThreshold:     80,
+			AutoDeleteLimit:      30,
+			FullPolicy:           "purgepit",
+		}
+		schedule, err := apiClient.CreateSnapshotSchedule(ctx, req, snapshotScheduleConfig(cmd))
+		if err != nil {
+			log.Fatalf("Error creating snapshot schedule: %v", err)
+		}
+		if outputFormat == "json" {
+			jsonData, _ := json.MarshalIndent(schedule, "", "  ")
+			fmt.Println(string(jsonData))
+		} else {
+			fmt.Printf("Created Snapshot Schedule: %s (Snapshot Group: %s, Next Run: %s)\n", schedule.ID,
+				schedule.TargetObject, schedule.NextRun().Format("2006-01-02 15:04 MST"))
+		}
+	},
+}
+
+// addSnapshotScheduleFlags adds the timing and retention flags of the create snapshot-schedule command.
+func addSnapshotScheduleFlags(cmd *cobra.Command) {
+	cmd.Flags().String("recurrence", santricity.SnapshotScheduleDaily, "Recurrence (daily, weekly)")
+	cmd.Flags().String("days", "", "Comma-separated days of a weekly schedule (e.g. mon,wed,fri)")
+	cmd.Flags().String("start-time", "00:00", "Time of day of the first Snapshot Image (HH:MM)")
+	cmd.Flags().String("timezone", "", "IANA time zone of the start time (e.g. Europe/Berlin; default local)")
+	cmd.Flags().Int("times-per-day", 1, "Snapshot Images per day")
+	cmd.Flags().Duration("interval", 0, "Time between the Snapshot Images of a day (e.g. 4h)")
+	cmd.Flags().Int("retention", 0, "Snapshot Images to keep: the auto-delete limit of the group (1-32; 0 keeps 30)")
+}
+
+// snapshotScheduleConfig returns the snapshot schedule config given by the flags of addSnapshotScheduleFlags.
+func snapshotScheduleConfig(cmd *cobra.Command) santricity.SnapshotScheduleConfig {
+	recurrence, _ := cmd.Flags().GetString("recurrence")
+	days, _ := cmd.Flags().GetString("days")
+	startTime, _ := cmd.Flags().GetString("start-time")
+	timezone, _ := cmd.Flags().GetString("timezone")
+	timesPerDay, _ := cmd.Flags().GetInt("times-per-day")
+	interval, _ := cmd.Flags().GetDuration("interval")
+	retention, _ := cmd.Flags().GetInt("retention")
+
+	config := santricity.SnapshotScheduleConfig{
+		Recurrence:  recurrence,
+		TimesPerDay: timesPerDay,
+		Interval:    interval,
+		Retention:   retention,
+	}
+
+	clock, err := time.Parse("15:04", startTime)
+	if err != nil {
+		log.Fatalf("Error: invalid --start-time %q, expected HH:MM", startTime)
+	}
+	config.StartTime = time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute
+
+	if timezone != "" {
+		if config.Location, err = time.LoadLocation(timezone); err != nil {
+			log.Fatalf("Error: invalid --timezone: %v", err)
+		}
+	}
+
+	for _, name := range strings.Split(days, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		found := false
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if full := strings.ToLower(day.String()); name == full || name == full[:3] {
+				config.DaysOfWeek = append(config.DaysOfWeek, day)
+				found = true
+			}
+		}
+		if !found {
+			log.Fatalf("Error: invalid day of the week %q in --days", name)
+		}
+	}
+	return config
+}
+
 func init() {
 	createSnapshotGroupCmd.Flags().String("volume-id", "", "Base Volume ID (Ref)")
 	createSnapshotGroupCmd.Flags().String("name", "", "Snapshot Group Name")
@@ -1276,6 +1920,25 @@ func init() {
 	deleteCmd.AddCommand(deleteSnapshotVolumeCmd)
 	deleteCmd.AddCommand(deleteCGCmd)
 	deleteCmd.AddCommand(deleteCGViewCmd)
+	deleteCmd.AddCommand(deleteStorageSystemCmd)
+	deleteCmd.AddCommand(deleteMirrorPairCmd)
+
+	createStorageSystemCmd.Flags().String("controllers", "", "Comma-separated management addresses of both controllers")
+	createStorageSystemCmd.Flags().String("id", "", "Storage system ID (assigned by the proxy if empty)")
+	createStorageSystemCmd.Flags().String("array-password", "", "Storage system admin password")
+	createStorageSystemCmd.Flags().Bool("accept-cert", false, "Accept self-signed controller certificates")
+	createStorageSystemCmd.Flags().Bool("validate", false, "Check that the storage system can be reached")
+	createStorageSystemCmd.MarkFlagRequired("controllers")
+
+	deleteStorageSystemCmd.Flags().String("id", "", "Storage system ID, WWN, name or chassis serial")
+
+	getDependentsCmd.Flags().String("id", "", "Object ID (Ref)")
+	getDependentsCmd.Flags().Bool("blockers", false, "Only list the objects that must be removed before deleting the object")
+	getDependentsCmd.Flags().String("pvc", "", "List the objects of a persistent volume claim (namespace/name)")
+
+	getAuditLogCmd.Flags().Duration("since", 24*time.Hour, "How far back to read the audit log")
+	getAuditLogCmd.Flags().String("journal", "", "Audit file written with --audit-file to match against the array's audit log")
+	getAuditLogCmd.Flags().Duration("tolerance", 5*time.Second, "Allowed clock difference between this host and the array")
 
 	deleteVolumeCmd.Flags().String("id", "", "Volume ID (Ref)")
 	deleteVolumeCmd.Flags().String("name", "", "Volume Name")
@@ -1327,4 +1990,155 @@ func init() {
 	createCGViewCmd.MarkFlagRequired("cg-id")
 	createCGViewCmd.MarkFlagRequired("snapshot-id")
 	createCGViewCmd.MarkFlagRequired("name")
+
+	getMirrorPairsCmd.Flags().String("id", "", "Show the status of this Mirror Pair ID")
+
+	createMirrorPairCmd.Flags().String("volume-id", "", "Primary Volume ID (Ref)")
+	createMirrorPairCmd.Flags().String("target-wwn", "", "WWN of the secondary volume on the remote array")
+	createMirrorPairCmd.Flags().String("remote-array", "", "Remote array ID (found from the mirror candidates if empty)")
+	createMirrorPairCmd.Flags().Int("write-mode", santricity.RemoteMirrorWriteModeSynchronous, "Write mode (0 synchronous, 1 asynchronous, 2 consistent asynchronous)")
+	createMirrorPairCmd.Flags().Int("priority", 2, "Synchronization priority (0 lowest - 4 highest)")
+	createMirrorPairCmd.Flags().Bool("auto-resync", false, "Resynchronize automatically after a communication failure")
+	createMirrorPairCmd.MarkFlagRequired("volume-id")
+	createMirrorPairCmd.MarkFlagRequired("target-wwn")
+
+	deleteMirrorPairCmd.Flags().String("id", "", "Mirror Pair ID")
+
+	getSnapshotSchedulesCmd.Flags().String("id", "", "Snapshot Schedule ID")
+
+	addSnapshotScheduleFlags(createSnapshotScheduleCmd)
+	createSnapshotScheduleCmd.Flags().String("volume-id", "", "Base Volume ID (Ref)")
+	createSnapshotScheduleCmd.Flags().String("name", "", "Snapshot Group Name")
+	createSnapshotScheduleCmd.Flags().Float64("repo-pct", 20.0, "Repository Percentage")
+	createSnapshotScheduleCmd.MarkFlagRequired("volume-id")
+	createSnapshotScheduleCmd.MarkFlagRequired("name")
+}
+
+// listVolumes returns the volumes selected by the get volumes flags.
+func listVolumes(
+	ctx context.Context, client santricity.API, volNameFilter string, showRepoVols, showOrphansOnly bool,
+) ([]santricity.VolumeEx, error) {
+
+	// If the user wants orphans, we must tell the client to fetch ALL system volumes first,
+	// because orphans are technically system/repo volumes.
+	if showOrphansOnly {
+		client.SetIncludeRepositoryVolumes(true)
+	} else {
+		client.SetIncludeRepositoryVolumes(showRepoVols)
+	}
+
+	vols, err := client.GetVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply Filters
+	var filteredVols []santricity.VolumeEx
+
+	// 1. Orphan Filter takes precedence if set
+	if showOrphansOnly {
+		for _, v := range vols {
+			if v.VolumeUse == "freeRepositoryVolume" {
+				filteredVols = append(filteredVols, v)
+			}
+		}
+		vols = filteredVols
+	}
+
+	// 2. Name Filter
+	if volNameFilter != "" {
+		var nameFiltered []santricity.VolumeEx
+		for _, v := range vols {
+			if v.Label == volNameFilter {
+				nameFiltered = append(nameFiltered, v)
+			}
+		}
+		vols = nameFiltered
+	}
+
+	return vols, nil
+}
+
+// openAudit opens the audit sinks selected with --audit-file and --audit-syslog, or returns nil if there are none.
+func openAudit() *santricity.AuditConfig {
+	var sinks santricity.MultiAuditSink
+	if auditFile != "" {
+		sink, err := santricity.OpenAuditFile(auditFile)
+		if err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		sinks = append(sinks, sink)
+		auditSinks = append(auditSinks, sink)
+	}
+	if auditSyslog {
+		sink, err := santricity.NewSyslogAuditSink("", "", "santricity-cli")
+		if err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		sinks = append(sinks, sink)
+		auditSinks = append(auditSinks, sink)
+	}
+	if len(sinks) == 0 {
+		return nil
+	}
+	return &santricity.AuditConfig{Sink: sinks}
+}
+
+// printFleetItems prints the results of an --all-arrays query, tagged with their array, then reports the arrays
+// that failed and exits with an error if there were any.
+func printFleetItems[T any](items []santricity.FleetItem[T], err error, print func(prefix string, item T)) {
+
+	if outputFormat == "json" {
+		if items == nil {
+			items = []santricity.FleetItem[T]{}
+		}
+		b, jsonErr := json.MarshalIndent(items, "", "  ")
+		if jsonErr != nil {
+			log.Fatalf("Error marshaling to JSON: %v", jsonErr)
+		}
+		fmt.Println(string(b))
+	} else {
+		for _, item := range items {
+			print(fmt.Sprintf("[%s] ", item.Array), item.Item)
+		}
+	}
+
+	var fleetErr *santricity.FleetError
+	if errors.As(err, &fleetErr) {
+		for _, array := range fleet.Arrays() {
+			if arrayErr, ok := fleetErr.Errors[array]; ok {
+				log.Printf("Error on array %s: %v", array, arrayErr)
+			}
+		}
+		os.Exit(1)
+	} else if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+}
+
+// printPlan prints the API calls recorded by a dry run.
+func printPlan(plan []santricity.PlannedCall) {
+	if outputFormat == "json" {
+		if plan == nil {
+			plan = []santricity.PlannedCall{}
+		}
+		b, err := json.MarshalIndent(plan, "", "  ")
+		if err != nil {
+			log.Fatalf("Error marshaling to JSON: %v", err)
+		}
+		fmt.Println(string(b))
+		return
+	}
+
+	if len(plan) == 0 {
+		fmt.Println("Dry run: no changes would be made.")
+		return
+	}
+	fmt.Printf("Dry run: no changes were made. Planned API calls (%d):\n", len(plan))
+	for i, call := range plan {
+		fmt.Printf("%3d. %s\n", i+1, call)
+		if len(call.Body) > 0 {
+			fmt.Printf("     %s\n", call.Body)
+		}
+	}
 }
@@ -1,6 +1,38 @@
 package santricity
 
+import "time"
+
 const (
 	StorageAPITimeoutSeconds = 90
 	MinTLSVersion            = 0x0303 // TLS 1.2
 )
+
+// Defaults for the HTTP transport shared by all requests of a Client. A zero value in the corresponding
+// ClientConfig field selects the default.
+const (
+	DefaultDialTimeout           = 30 * time.Second
+	DefaultTLSHandshakeTimeout   = 10 * time.Second
+	DefaultIdleConnTimeout       = 90 * time.Second
+	DefaultMaxIdleConns          = 16
+	DefaultMaxIdleConnsPerHost   = 4
+	DefaultExpectContinueTimeout = 1 * time.Second
+)
+
+// Defaults for controller health tracking.
+const (
+	DefaultFailureThreshold = 2
+	DefaultFailureCooldown  = 30 * time.Second
+)
+
+// Defaults for waiting on long-running operations (WaitConfig).
+const (
+	DefaultPollInterval    = 2 * time.Second
+	DefaultMaxPollInterval = 30 * time.Second
+)
+
+// DefaultCacheTTL is how long the inventory cache keeps object lists, unless configured (CacheConfig).
+const DefaultCacheTTL = 30 * time.Second
+
+// DefaultThinRepositorySize is the initial repository capacity of thin volumes, unless configured
+// (ThinVolumeOptions). It is the smallest repository the array allows.
+const DefaultThinRepositorySize = 4 << 30
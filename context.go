@@ -1,7 +1,26 @@
 package santricity
 
+import "context"
+
 type contextKey string
 
 const (
-	ContextKeyRequestID contextKey = "requestID"
+	ContextKeyRequestID      contextKey = "requestID"
+	ContextKeyRetryableWrite contextKey = "retryableWrite"
+	ContextKeyOperation      contextKey = "operation" // Name of the operation reported to the metrics hooks
+	ContextKeyCaller         contextKey = "caller"    // Caller recorded in audit events
 )
+
+// withOperation names the operation of the API calls made with a context, unless the caller named it already.
+func withOperation(ctx context.Context, name string) context.Context {
+	if operationName(ctx) != "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ContextKeyOperation, name)
+}
+
+// operationName returns the operation name of a context, or an empty string.
+func operationName(ctx context.Context) string {
+	name, _ := ctx.Value(ContextKeyOperation).(string)
+	return name
+}
@@ -0,0 +1,229 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Credentials hold the values used to authenticate API requests. If BearerToken is set, Username and Password
+// are ignored.
+type Credentials struct {
+	Username    string
+	Password    string
+	BearerToken string
+}
+
+// CredentialProvider supplies the credentials for API requests. The client asks for credentials before each
+// request, so implementations should cache anything that is expensive to obtain.
+type CredentialProvider interface {
+	// Credentials returns the current credentials.
+	Credentials(ctx context.Context) (Credentials, error)
+
+	// Invalidate is called after the array rejected the credentials last returned (HTTP 401), so that the next
+	// call to Credentials fetches fresh ones instead of returning a cached value.
+	Invalidate()
+}
+
+// StaticCredentialProvider always returns the same credentials.
+type StaticCredentialProvider struct {
+	Value Credentials
+}
+
+func (p *StaticCredentialProvider) Credentials(ctx context.Context) (Credentials, error) {
+	return p.Value, nil
+}
+
+func (p *StaticCredentialProvider) Invalidate() {}
+
+// EnvCredentialProvider reads credentials from environment variables on every request. Empty variable names
+// select SANTRICITY_USERNAME, SANTRICITY_PASSWORD and SANTRICITY_TOKEN.
+type EnvCredentialProvider struct {
+	UsernameVar string
+	PasswordVar string
+	TokenVar    string
+}
+
+func (p *EnvCredentialProvider) Credentials(ctx context.Context) (Credentials, error) {
+	creds := Credentials{
+		Username:    os.Getenv(stringOrDefault(p.UsernameVar, "SANTRICITY_USERNAME")),
+		Password:    os.Getenv(stringOrDefault(p.PasswordVar, "SANTRICITY_PASSWORD")),
+		BearerToken: os.Getenv(stringOrDefault(p.TokenVar, "SANTRICITY_TOKEN")),
+	}
+	if creds.BearerToken == "" && creds.Username == "" {
+		return Credentials{}, fmt.Errorf("neither a username nor a token is set in the environment: %w",
+			ErrInvalidArgument)
+	}
+	return creds, nil
+}
+
+func (p *EnvCredentialProvider) Invalidate() {}
+
+// FileCredentialProvider reads credentials from files, one value per file, such as the keys of a Kubernetes
+// secret mounted as a volume. The files are checked for changes before each request and re-read when they
+// were rotated, so the new credentials are picked up without restarting the process. Unset paths are skipped.
+type FileCredentialProvider struct {
+	UsernameFile string
+	PasswordFile string
+	TokenFile    string
+
+	mu      sync.Mutex
+	cached  Credentials
+	modTime map[string]time.Time
+	loaded  bool
+}
+
+// NewFileCredentialProvider returns a provider for the "username", "password" and "token" files in dir.
+// Files that don't exist are ignored.
+func NewFileCredentialProvider(dir string) *FileCredentialProvider {
+	p := &FileCredentialProvider{}
+	for name, path := range map[string]*string{
+		"username": &p.UsernameFile,
+		"password": &p.PasswordFile,
+		"token":    &p.TokenFile,
+	} {
+		file := filepath.Join(dir, name)
+		if _, err := os.Stat(file); err == nil {
+			*path = file
+		}
+	}
+	return p
+}
+
+func (p *FileCredentialProvider) Credentials(ctx context.Context) (Credentials, error) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.loaded && !p.changed() {
+		return p.cached, nil
+	}
+
+	creds := Credentials{}
+	modTime := make(map[string]time.Time)
+	for _, file := range []struct {
+		path  string
+		value *string
+	}{
+		{p.UsernameFile, &creds.Username},
+		{p.PasswordFile, &creds.Password},
+		{p.TokenFile, &creds.BearerToken},
+	} {
+		if file.path == "" {
+			continue
+		}
+		info, err := os.Stat(file.path)
+		if err != nil {
+			return Credentials{}, fmt.Errorf("could not read credentials file: %w", err)
+		}
+		content, err := os.ReadFile(file.path)
+		if err != nil {
+			return Credentials{}, fmt.Errorf("could not read credentials file: %w", err)
+		}
+		*file.value = strings.TrimSpace(string(content))
+		modTime[file.path] = info.ModTime()
+	}
+
+	if p.loaded {
+		Logc(ctx).Info("Credentials files changed, reloaded credentials.")
+	}
+	p.cached, p.modTime, p.loaded = creds, modTime, true
+	return creds, nil
+}
+
+// changed reports whether any of the files was modified since it was last read.
+func (p *FileCredentialProvider) changed() bool {
+	for path, modTime := range p.modTime {
+		info, err := os.Stat(path)
+		if err != nil || !info.ModTime().Equal(modTime) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *FileCredentialProvider) Invalidate() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.loaded = false
+}
+
+// CommandCredentialProvider runs an external command that prints a bearer token on standard output. The token
+// is cached for TTL (or until the array rejects it) and the command is run again to obtain a new one.
+type CommandCredentialProvider struct {
+	Command []string      // Program and arguments
+	TTL     time.Duration // How long to reuse a token; 0 means until it is rejected
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+func (p *CommandCredentialProvider) Credentials(ctx context.Context) (Credentials, error) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token != "" && (p.TTL == 0 || time.Now().Before(p.expires)) {
+		return Credentials{BearerToken: p.token}, nil
+	}
+
+	if len(p.Command) == 0 {
+		return Credentials{}, fmt.Errorf("no credentials command configured: %w", ErrInvalidArgument)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.Command[0], p.Command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return Credentials{}, fmt.Errorf("credentials command %s failed: %v; %s", p.Command[0], err,
+			strings.TrimSpace(stderr.String()))
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return Credentials{}, fmt.Errorf("credentials command %s printed no token", p.Command[0])
+	}
+
+	p.token = token
+	p.expires = time.Now().Add(p.TTL)
+	return Credentials{BearerToken: token}, nil
+}
+
+func (p *CommandCredentialProvider) Invalidate() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.token = ""
+}
+
+// credentials returns the credentials for the next request, from the configured provider if there is one.
+func (d Client) credentials(ctx context.Context) (Credentials, error) {
+	if d.config.Credentials == nil {
+		return Credentials{
+			Username:    d.config.Username,
+			Password:    d.config.Password,
+			BearerToken: d.config.BearerToken,
+		}, nil
+	}
+	creds, err := d.config.Credentials.Credentials(ctx)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("could not get credentials: %w", err)
+	}
+	return creds, nil
+}
+
+func stringOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,41 @@
+package driver
+
+import (
+	"os"
+	"strings"
+
+	santricity "github.com/scaleoutsean/santricity-go"
+	"k8s.io/klog/v2"
+)
+
+// newAuditConfig records the calls that change the array to the JSON-lines file named by SANTRICITY_AUDIT_FILE
+// and, with SANTRICITY_AUDIT_SYSLOG=true, to syslog. It returns nil if neither is set. The caller is recorded as
+// the driver name and the pod (host) name.
+func newAuditConfig(driverName string) *santricity.AuditConfig {
+	var sinks santricity.MultiAuditSink
+
+	if path := os.Getenv("SANTRICITY_AUDIT_FILE"); path != "" {
+		sink, err := santricity.OpenAuditFile(path)
+		if err != nil {
+			klog.Errorf("Audit file disabled: %v", err)
+		} else {
+			klog.Infof("Recording changes to the array in %s", path)
+			sinks = append(sinks, sink)
+		}
+	}
+	if strings.EqualFold(os.Getenv("SANTRICITY_AUDIT_SYSLOG"), "true") {
+		sink, err := santricity.NewSyslogAuditSink("", "", driverName)
+		if err != nil {
+			klog.Errorf("Syslog audit disabled: %v", err)
+		} else {
+			klog.Info("Recording changes to the array in syslog")
+			sinks = append(sinks, sink)
+		}
+	}
+	if len(sinks) == 0 {
+		return nil
+	}
+
+	hostname, _ := os.Hostname()
+	return &santricity.AuditConfig{Sink: sinks, Identity: driverName + "@" + hostname}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -129,6 +131,33 @@ func (d *Driver) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequest)
 		blockSize = bs
 	}
 
+	// Parse optional thin provisioning parameters. Thin volumes require a disk pool (DDP).
+	thin := false
+	switch params["provisioning"] {
+	case "", "thick":
+	case "thin":
+		thin = true
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "provisioning must be thick or thin, got %s", params["provisioning"])
+	}
+	var thinOptions santricity.ThinVolumeOptions
+	if thresholdStr, ok := params["growthAlertThreshold"]; ok {
+		threshold, err := strconv.Atoi(thresholdStr)
+		if err != nil || threshold < 1 || threshold > 100 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid growthAlertThreshold parameter: %s", thresholdStr)
+		}
+		thinOptions.GrowthAlertThreshold = threshold
+	}
+	if policy, ok := params["expansionPolicy"]; ok {
+		if policy != "automatic" && policy != "manual" {
+			return nil, status.Errorf(codes.InvalidArgument, "expansionPolicy must be automatic or manual, got %s", policy)
+		}
+		thinOptions.ExpansionPolicy = policy
+	}
+	if thin && (raidLevel != "" || blockSize != 0) {
+		return nil, status.Error(codes.InvalidArgument, "raidLevel and blockSize cannot be used with thin provisioning")
+	}
+
 	// Find Storage Pool
 	var selectedPoolRef string
 
@@ -136,17 +165,17 @@ func (d *Driver) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequest)
 	// These usually come from external-provisioner using --extra-create-metadata
 	metadata := make(map[string]string)
 	if v, ok := params["csi.storage.k8s.io/pvc/name"]; ok {
-		metadata["pvc_name"] = v
+		metadata[santricity.TagPVCName] = v
 	}
 	if v, ok := params["csi.storage.k8s.io/pvc/namespace"]; ok {
-		metadata["pvc_namespace"] = v
+		metadata[santricity.TagPVCNamespace] = v
 	}
 	if v, ok := params["csi.storage.k8s.io/pv/name"]; ok {
-		metadata["pv_name"] = v
+		metadata[santricity.TagPVName] = v
 	}
 
 	// Inject the CSI driver name so we can distinguish our volumes from orphaned or third-party volumes
-	metadata["csi_driver"] = d.name
+	metadata[santricity.TagCSIDriver] = d.name
 
 	if poolID != "" {
 		// Verify if Pool Exists
@@ -158,10 +187,18 @@ func (d *Driver) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequest)
 		klog.Infof("Selected storage pool by ID: %s (%s)", p.Label, p.VolumeGroupRef)
 	} else {
 		// Fallback to searching by Name/Criteria
-		pools, err := d.client.GetVolumePools(ctx, mediaType, uint64(reqBytes), poolName)
+		// Thin volumes only need the free capacity of their initial repository
+		minFreeBytes := uint64(reqBytes)
+		if thin {
+			minFreeBytes = santricity.DefaultThinRepositorySize
+		}
+		pools, err := d.client.GetVolumePools(ctx, mediaType, minFreeBytes, poolName)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Failed to get storage pools: %v", err)
 		}
+		if thin {
+			pools = slices.DeleteFunc(pools, func(p santricity.VolumeGroupEx) bool { return p.RaidLevel != "raidDiskPool" })
+		}
 		if len(pools) == 0 {
 			return nil, status.Errorf(codes.ResourceExhausted, "No storage pools found matching requirements (mediaType=%s, name=%s)", mediaType, poolName)
 		}
@@ -176,8 +213,19 @@ func (d *Driver) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequest)
 
 	// Create Volume
 	// Note: segmentSize=0 uses default, blockSize=0 uses array default (unless specified)
-	vol, err := d.client.CreateVolume(ctx, name, selectedPoolRef, uint64(reqBytes), mediaType, fsType, raidLevel, blockSize, 0, metadata)
+	var vol santricity.VolumeEx
+	var err error
+	if thin {
+		var thinVol santricity.ThinVolume
+		thinVol, err = d.client.CreateThinVolume(ctx, name, selectedPoolRef, uint64(reqBytes), fsType, thinOptions, metadata)
+		vol = thinVol.VolumeEx
+	} else {
+		vol, err = d.client.CreateVolume(ctx, name, selectedPoolRef, uint64(reqBytes), mediaType, fsType, raidLevel, blockSize, 0, metadata)
+	}
 	if err != nil {
+		if errors.Is(err, santricity.ErrUnsupported) {
+			return nil, status.Errorf(codes.InvalidArgument, "Failed to create volume: %v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "Failed to create volume: %v", err)
 	}
 
@@ -273,7 +321,10 @@ func (d *Driver) ControllerPublishVolume(ctx context.Context, req *csi.Controlle
 	// 2. Map Volume
 	vol, err := d.client.GetVolumeByRef(ctx, volID)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "Volume %s not found: %v", volID, err)
+		if errors.Is(err, santricity.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Volume %s not found: %v", volID, err)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to get volume %s: %v", volID, err)
 	}
 
 	// Determine Target Portal (IP) and Connection Settings
@@ -331,6 +382,9 @@ func (d *Driver) ControllerPublishVolume(ctx context.Context, req *csi.Controlle
 		} else if isNVMe {
 			nvmeSettings, err := d.client.GetNVMeoFSettings(ctx)
 			if err != nil {
+				if errors.Is(err, santricity.ErrUnsupported) {
+					return nil, status.Errorf(codes.FailedPrecondition, "Failed to get NVMeoF target settings: %v", err)
+				}
 				return nil, status.Errorf(codes.Internal, "Failed to get NVMeoF target settings: %v", err)
 			}
 			klog.Infof("NVMeoF Target Settings: NvmeNodeName=%s, IscsiNodeName=%v", nvmeSettings.NodeName.NvmeNodeName, nvmeSettings.NodeName.IscsiNodeName)
@@ -391,7 +445,10 @@ func (d *Driver) ControllerUnpublishVolume(ctx context.Context, req *csi.Control
 	vol, err := d.client.GetVolumeByRef(ctx, volID)
 	if err != nil {
 		// If volume not found, purely idempotent
-		return &csi.ControllerUnpublishVolumeResponse{}, nil
+		if errors.Is(err, santricity.ErrNotFound) {
+			return &csi.ControllerUnpublishVolumeResponse{}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to get volume %s: %v", volID, err)
 	}
 
 	var mappingsToDelete []santricity.LUNMapping
@@ -435,10 +492,8 @@ func (d *Driver) ControllerUnpublishVolume(ctx context.Context, req *csi.Control
 			volCheck, errCheck := d.client.GetVolumeByRef(ctx, volID)
 			if errCheck != nil {
 				// If getting the volume fails, we need to determine if it's because it wasn't found.
-				// d.client.GetVolumeByRef usually errors if not found.
-				// Since we don't have typed errors from the client yet, we check the string or rely on the fact that if we can't read it, we probably can't delete it either.
-				// But specifically for 404 on GET, we assume success (volume gone).
-				if strings.Contains(strings.ToLower(errCheck.Error()), "not found") || strings.Contains(errCheck.Error(), "404") {
+				// Specifically for a not-found error on GET, we assume success (volume gone).
+				if errors.Is(errCheck, santricity.ErrNotFound) {
 					klog.Infof("Volume %s not found during verification (err: %v); assuming mapping %s is removed.", volID, errCheck, m.LunMappingRef)
 					continue // Success, move to next mapping
 				}
@@ -529,7 +584,10 @@ func (d *Driver) ControllerExpandVolume(ctx context.Context, req *csi.Controller
 
 	vol, err := d.client.GetVolumeByRef(ctx, volID)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "Volume %s not found: %v", volID, err)
+		if errors.Is(err, santricity.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Volume %s not found: %v", volID, err)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to get volume %s: %v", volID, err)
 	}
 
 	currentBytes, _ := strconv.ParseInt(vol.VolumeSize, 10, 64) // VolumeSize is string
@@ -543,7 +601,11 @@ func (d *Driver) ControllerExpandVolume(ctx context.Context, req *csi.Controller
 
 	klog.Infof("Expanding volume %s from %d to %d bytes", volID, currentBytes, requiredBytes)
 
-	err = d.client.ExpandVolume(ctx, volID, requiredBytes)
+	if vol.ThinProvisioned {
+		err = d.client.ExpandThinVolume(ctx, volID, uint64(requiredBytes), 0)
+	} else {
+		err = d.client.ExpandVolume(ctx, volID, requiredBytes)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to expand volume: %v", err)
 	}
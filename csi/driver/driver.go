@@ -15,6 +15,8 @@ import (
 	"github.com/kubernetes-csi/csi-lib-utils/protosanitizer"
 	santricity "github.com/scaleoutsean/santricity-go"
 	"github.com/scaleoutsean/santricity-go/csi/metrics"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
 	"google.golang.org/grpc"
 	"k8s.io/klog/v2"
 )
@@ -36,7 +38,7 @@ type Driver struct {
 	name     string
 	nodeID   string
 	endpoint string
-	client   *santricity.Client
+	client   santricity.API
 
 	// Config
 	dataIPs []string // Override for data path IPs (comma-separated SANTRICITY_DATA_IPS)
@@ -59,7 +61,7 @@ func NewDriver(driverName, nodeID, endpoint, apiUrl, user, password string) (*Dr
 	klog.Infof("Driver: %v Version: %v Commit: %v", driverName, Version, GitCommit)
 
 	// If API URL is provided, initialize client
-	var client *santricity.Client
+	var client santricity.API
 	var dataIPs []string
 
 	// Fallback to Env vars if flags are empty, similar to CLI
@@ -138,8 +140,31 @@ func NewDriver(driverName, nodeID, endpoint, apiUrl, user, password string) (*Dr
 				"method": true,
 				"api":    true, // Enables debugging the actual HTTP requests
 			},
-			VerifyTLS: strings.EqualFold(os.Getenv("SANTRICITY_VERIFY_TLS"), "true"), // Explicitly disable verification for lab use by default
-			OnRequest: metrics.RequestCallback,
+			VerifyTLS:     strings.EqualFold(os.Getenv("SANTRICITY_VERIFY_TLS"), "true"), // Explicitly disable verification for lab use by default
+			OnRequestInfo: metrics.RequestCallback,
+			RetryPolicy:   santricity.DefaultRetryPolicy(),
+			// Log in once and reuse the session cookie instead of sending the password with every request
+			UseSession: strings.EqualFold(os.Getenv("SANTRICITY_USE_SESSION"), "true"),
+			// Record API requests as children of the CSI call spans (see SetupTracing)
+			TracerProvider: otel.GetTracerProvider(),
+			Propagator:     otel.GetTextMapPropagator(),
+		}
+
+		// Read credentials from a mounted secret, if configured, so that rotated credentials are picked up
+		// without restarting the controller
+		if credsDir := os.Getenv("SANTRICITY_CREDENTIALS_DIR"); credsDir != "" {
+			klog.Infof("Reading API credentials from %s", credsDir)
+			config.Credentials = santricity.NewFileCredentialProvider(credsDir)
+		}
+
+		// Keep a local record of the changes made to the array, in addition to its own audit log
+		config.Audit = newAuditConfig(driverName)
+
+		// Behind a Web Services Proxy, select the array by WWN, name or chassis serial number
+		if selector := os.Getenv("SANTRICITY_ARRAY"); selector != "" {
+			klog.Infof("Managing storage system %s", selector)
+			config.ArraySelector = selector
+			config.ArrayID = ""
 		}
 
 		client = santricity.NewAPIClient(context.Background(), config)
@@ -164,6 +189,15 @@ func NewDriver(driverName, nodeID, endpoint, apiUrl, user, password string) (*Dr
 		checkCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
+		if config.ArraySelector != "" {
+			// Resolve the selector to the array's ID now: every other request needs it, and the client keeps it
+			arrayID, err := client.Connect(checkCtx)
+			if err != nil {
+				klog.Fatalf("Could not select storage system %s: %v", config.ArraySelector, err)
+			}
+			klog.Infof("Storage system %s has ID %s", config.ArraySelector, arrayID)
+		}
+
 		sys, err := client.GetStorageSystem(checkCtx)
 		if err != nil {
 			klog.Errorf("Connectivity Check Failed: %v", err)
@@ -171,7 +205,19 @@ func NewDriver(driverName, nodeID, endpoint, apiUrl, user, password string) (*Dr
 			// Panic might be good to restart the pod quickly if config is wrong
 			// But for now purely logging is safer to debug
 		} else {
-			klog.Infof("Connectivity Check Passed: Connected to array %s", sys.Name)
+			klog.Infof("Connectivity Check Passed: Connected to array %s via controller %s", sys.Name,
+				client.ActiveController())
+
+			caps, err := client.GetCapabilities(checkCtx)
+			if err != nil {
+				klog.Warningf("Could not detect array capabilities: %v", err)
+			} else {
+				klog.Infof("Array capabilities: %s", caps)
+				if !caps.Supported() {
+					klog.Warningf("Array API version %s is older than the oldest supported version %s",
+						caps.APIVersion, santricity.MinimumAPIVersion)
+				}
+			}
 		}
 	} else {
 		klog.Warning("No valid SANtricity API URL provided. Controller operations will fail.")
@@ -216,6 +262,8 @@ func (d *Driver) Run(isController, isNode bool) error {
 
 	opts := []grpc.ServerOption{
 		grpc.UnaryInterceptor(sanitizeGRPC),
+		// Continue the trace of the caller, if any, in a span for each CSI call
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 	}
 	d.srv = grpc.NewServer(opts...)
 
@@ -263,11 +311,37 @@ func sanitizeGRPC(ctx context.Context, req interface{}, info *grpc.UnaryServerIn
 func (d *Driver) runMetricsLoop() {
 	// Update metrics immediately
 	d.updateVolumeMetrics()
+	d.updateControllerMetrics()
 
 	ticker := time.NewTicker(5 * time.Minute)
 	defer ticker.Stop()
-	for range ticker.C {
-		d.updateVolumeMetrics()
+	healthTicker := time.NewTicker(30 * time.Second)
+	defer healthTicker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			d.updateVolumeMetrics()
+		case <-healthTicker.C:
+			d.updateControllerMetrics()
+		}
+	}
+}
+
+// updateControllerMetrics publishes the client's view of the management controllers. It doesn't call the API.
+func (d *Driver) updateControllerMetrics() {
+	if d.client == nil {
+		return
+	}
+	for _, status := range d.client.ControllerStatus() {
+		healthy, active := 0.0, 0.0
+		if status.Healthy {
+			healthy = 1
+		}
+		if status.Active {
+			active = 1
+		}
+		metrics.SantricityControllerHealthy.WithLabelValues(status.Controller).Set(healthy)
+		metrics.SantricityControllerActive.WithLabelValues(status.Controller).Set(active)
 	}
 }
 
@@ -316,11 +390,11 @@ func (d *Driver) updateVolumeMetrics() {
 		pvcNamespace := ""
 		csiDriver := ""
 		for _, tag := range vol.VolumeTags {
-			if tag.Key == "pvc_name" {
+			if tag.Key == santricity.TagPVCName {
 				pvcName = tag.Value
-			} else if tag.Key == "pvc_namespace" {
+			} else if tag.Key == santricity.TagPVCNamespace {
 				pvcNamespace = tag.Value
-			} else if tag.Key == "csi_driver" {
+			} else if tag.Key == santricity.TagCSIDriver {
 				csiDriver = tag.Value
 			}
 		}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
@@ -52,6 +53,13 @@ func main() {
 	metrics.RegisterMetrics()
 	metrics.StartMetricsServer(*metricsPort)
 
+	shutdownTracing, err := driver.SetupTracing(context.Background(), *driverName)
+	if err != nil {
+		klog.Errorf("Could not set up OpenTelemetry tracing: %v", err)
+	} else {
+		defer shutdownTracing(context.Background())
+	}
+
 	handle()
 }
 
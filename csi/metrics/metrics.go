@@ -1,12 +1,13 @@
 package metrics
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
-	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	santricity "github.com/scaleoutsean/santricity-go"
 	"k8s.io/klog/v2"
 )
 
@@ -17,7 +18,7 @@ var (
 			Help:    "Latency of SANtricity API requests",
 			Buckets: prometheus.DefBuckets,
 		},
-		[]string{"method", "path", "status_code"},
+		[]string{"method", "path", "status_code", "operation"},
 	)
 
 	SantricityAPIRequestsTotal = prometheus.NewCounterVec(
@@ -25,7 +26,47 @@ var (
 			Name: "santricity_api_requests_total",
 			Help: "Total number of SANtricity API requests",
 		},
-		[]string{"method", "path", "status_code"},
+		[]string{"method", "path", "status_code", "operation"},
+	)
+
+	SantricityAPIRetriesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "santricity_api_retries_total",
+			Help: "Total number of SANtricity API calls repeated after a failed attempt",
+		},
+		[]string{"method", "path", "operation"},
+	)
+
+	SantricityAPIFailoversTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "santricity_api_failovers_total",
+			Help: "Total number of SANtricity API requests sent to a controller after another one failed",
+		},
+		[]string{"controller"},
+	)
+
+	SantricityAPIAuthFailuresTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "santricity_api_auth_failures_total",
+			Help: "Total number of SANtricity API requests and logins rejected for bad or expired credentials",
+		},
+		[]string{"controller"},
+	)
+
+	SantricityControllerHealthy = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "santricity_api_controller_healthy",
+			Help: "Whether a SANtricity management controller is considered reachable (1) or not (0)",
+		},
+		[]string{"controller"},
+	)
+
+	SantricityControllerActive = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "santricity_api_controller_active",
+			Help: "Whether a SANtricity management controller is the one API requests are sent to first",
+		},
+		[]string{"controller"},
 	)
 
 	DriverVolumesTotal = prometheus.NewGaugeVec(
@@ -50,6 +91,11 @@ var registry = prometheus.NewRegistry()
 func RegisterMetrics() {
 	registry.MustRegister(SantricityAPIRequestsLatencies)
 	registry.MustRegister(SantricityAPIRequestsTotal)
+	registry.MustRegister(SantricityAPIRetriesTotal)
+	registry.MustRegister(SantricityAPIFailoversTotal)
+	registry.MustRegister(SantricityAPIAuthFailuresTotal)
+	registry.MustRegister(SantricityControllerHealthy)
+	registry.MustRegister(SantricityControllerActive)
 	registry.MustRegister(DriverVolumesTotal)
 	registry.MustRegister(DriverVolumeInfo)
 }
@@ -67,9 +113,24 @@ func StartMetricsServer(port string) {
 	}()
 }
 
-func RequestCallback(method string, path string, statusCode int, duration time.Duration) {
-	statusStr := strconv.Itoa(statusCode)
+// RequestCallback records an API request; it is meant for santricity.ClientConfig.OnRequestInfo. Requests are
+// labeled with their route template, never the full path, to keep the number of series bounded.
+func RequestCallback(info santricity.RequestInfo) {
+	statusStr := strconv.Itoa(info.StatusCode)
+
+	SantricityAPIRequestsLatencies.WithLabelValues(info.Method, info.Route, statusStr, info.Operation).
+		Observe(info.Duration.Seconds())
+	SantricityAPIRequestsTotal.WithLabelValues(info.Method, info.Route, statusStr, info.Operation).Inc()
 
-	SantricityAPIRequestsLatencies.WithLabelValues(method, path, statusStr).Observe(duration.Seconds())
-	SantricityAPIRequestsTotal.WithLabelValues(method, path, statusStr).Inc()
+	// Count each retry once, not once per controller tried
+	if info.Attempt > 1 && !info.Failover {
+		SantricityAPIRetriesTotal.WithLabelValues(info.Method, info.Route, info.Operation).Inc()
+	}
+	if info.Failover {
+		SantricityAPIFailoversTotal.WithLabelValues(info.Controller).Inc()
+	}
+	if info.StatusCode == http.StatusUnauthorized || info.StatusCode == http.StatusForbidden ||
+		errors.Is(info.Err, santricity.ErrAuthFailed) {
+		SantricityAPIAuthFailuresTotal.WithLabelValues(info.Controller).Inc()
+	}
 }
@@ -0,0 +1,159 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// With ClientConfig.DryRun set, calls that would change the array are recorded in a plan instead of being sent,
+// and answered with a synthetic success response. Reads still go to the array, so a workflow sees the array as it
+// is, not as the plan would leave it.
+
+// PlannedCall is an API call recorded in dry-run mode.
+type PlannedCall struct {
+	Method    string          `json:"method"`
+	ArrayID   string          `json:"arrayId,omitempty"`
+	Path      string          `json:"path"`                // Resource path relative to the storage system
+	Operation string          `json:"operation,omitempty"` // Client method that made the call
+	Body      json.RawMessage `json:"body,omitempty"`
+	Time      time.Time       `json:"time"`
+}
+
+func (c PlannedCall) String() string {
+	s := c.Method + " " + c.Path
+	if c.Operation != "" {
+		s += " (" + c.Operation + ")"
+	}
+	return s
+}
+
+// dryRunRefPrefix starts the refs of the objects that dry-run calls pretend to create.
+const dryRunRefPrefix = "DRYRUN"
+
+// dryRunListRoutes are the routes whose POST responses are lists rather than objects.
+var dryRunListRoutes = map[string]bool{
+	"/consistency-groups/{id}/snapshots": true,
+}
+
+// dryRunPlan collects the calls of a client in dry-run mode. Clients derived for other storage systems share it.
+type dryRunPlan struct {
+	m     sync.Mutex
+	calls []PlannedCall
+	refs  int
+}
+
+// isMutating reports whether a call with the given HTTP method may change the array.
+func isMutating(method string) bool {
+	return method != http.MethodGet && method != http.MethodHead && method != http.MethodOptions
+}
+
+// plan records a call that would change the array and returns a synthetic response for it.
+func (d Client) plan(
+	ctx context.Context, requestBody []byte, method, arrayID, resourcePath string,
+) (*http.Response, []byte, error) {
+
+	call := PlannedCall{
+		Method:    method,
+		ArrayID:   arrayID,
+		Path:      resourcePath,
+		Operation: operationName(ctx),
+		Time:      time.Now(),
+	}
+	call.Body = RedactJSON(requestBody)
+
+	d.dryRun.m.Lock()
+	d.dryRun.calls = append(d.dryRun.calls, call)
+	d.dryRun.refs++
+	ref := fmt.Sprintf("%s%034d", dryRunRefPrefix, d.dryRun.refs)
+	d.dryRun.m.Unlock()
+
+	Logc(ctx).WithFields(log.Fields{
+		"method": method,
+		"path":   resourcePath,
+	}).Info("Dry run, not sending API call.")
+
+	if method == http.MethodDelete {
+		return dryRunResponse(http.StatusNoContent), nil, nil
+	}
+	return dryRunResponse(http.StatusOK), dryRunBody(requestBody, resourcePath, ref), nil
+}
+
+// dryRunBody makes up the response to a call that would create or change an object: the request with the
+// object's ref added. An existing object keeps the ref from the resource path; a new one gets the given ref.
+func dryRunBody(requestBody []byte, resourcePath, ref string) []byte {
+
+	route := routeTemplate(resourcePath)
+	if dryRunListRoutes[route] {
+		return []byte("[]")
+	}
+
+	object := map[string]any{}
+	if len(requestBody) > 0 {
+		if err := json.Unmarshal(requestBody, &object); err != nil {
+			object = map[string]any{}
+		}
+	}
+
+	segments := strings.Split(strings.Trim(resourcePath, "/"), "/")
+	if strings.HasSuffix(route, "/{id}") {
+		ref = segments[len(segments)-1]
+	}
+	if _, ok := object["id"]; !ok {
+		object["id"] = ref
+	}
+	if field, ok := collectionRefFields[segments[0]]; ok && len(segments) <= 2 {
+		if _, ok := object[field]; !ok {
+			object[field] = ref
+		}
+	}
+	if name, ok := object["name"]; ok {
+		if _, ok := object["label"]; !ok {
+			object["label"] = name
+		}
+	}
+
+	body, _ := json.Marshal(object)
+	return body
+}
+
+// dryRunResponse returns a synthetic response with the given status.
+func dryRunResponse(statusCode int) *http.Response {
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode: statusCode,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       http.NoBody,
+	}
+}
+
+// Plan returns the calls recorded in dry-run mode, in the order they were made.
+func (d Client) Plan() []PlannedCall {
+	if d.dryRun == nil {
+		return nil
+	}
+	d.dryRun.m.Lock()
+	defer d.dryRun.m.Unlock()
+	return append([]PlannedCall(nil), d.dryRun.calls...)
+}
+
+// ResetPlan forgets the calls recorded in dry-run mode.
+func (d Client) ResetPlan() {
+	if d.dryRun == nil {
+		return
+	}
+	d.dryRun.m.Lock()
+	defer d.dryRun.m.Unlock()
+	d.dryRun.calls = nil
+}
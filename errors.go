@@ -0,0 +1,295 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors used to classify failures returned by Client methods. Use errors.Is to test for them; the
+// concrete Error or TransportError values carry the details reported by the array.
+var (
+	ErrNotFound        = errors.New("not found")
+	ErrAlreadyExists   = errors.New("already exists")
+	ErrConflict        = errors.New("conflict")
+	ErrAuthFailed      = errors.New("authentication failed")
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrArrayDegraded   = errors.New("array degraded")
+	ErrTransport       = errors.New("transport error")
+	ErrUnsupported     = errors.New("unsupported on this firmware")
+	ErrOperationFailed = errors.New("operation failed")
+)
+
+// retcodeKinds maps SANtricity symbol return codes (the "retcode" field of a CallResponse) to an error class.
+// Return codes not listed here are classified by HTTP status code only.
+var retcodeKinds = map[string]error{
+	// Referenced object does not exist
+	"volumeNotExist":                     ErrNotFound,
+	"volumeGroupNotExist":                ErrNotFound,
+	"invalidVolumeref":                   ErrNotFound,
+	"invalidVolumegroupref":              ErrNotFound,
+	"invalidVolumecopyref":               ErrNotFound,
+	"invalidPitGroupRef":                 ErrNotFound,
+	"invalidPitRef":                      ErrNotFound,
+	"invalidPitViewRef":                  ErrNotFound,
+	"invalidConcatVolRef":                ErrNotFound,
+	"invalidPitConsistencyGroupRef":      ErrNotFound,
+	"invalidPitConsistencyGroupViewRef":  ErrNotFound,
+	"partNodeNonexistent":                ErrNotFound,
+	"partVolumeNonexistent":              ErrNotFound,
+	"partMappingNonexistent":             ErrNotFound,
+	"mappingInvalidRef":                  ErrNotFound,
+	"arvmGroupDoesNotExist":              ErrNotFound,
+	"arvmMirrorMemberDoesNotExist":       ErrNotFound,
+	"remoteTargetNotFound":               ErrNotFound,
+	"arvmRemoteGroupDoesNotExist":        ErrNotFound,
+	"arvmRemoteMirrorMemberDoesNotExist": ErrNotFound,
+	"remoteNoArray":                      ErrNotFound,
+
+	// Object with the same identity already exists
+	"partDupId":                      ErrAlreadyExists,
+	"partLunCollision":               ErrAlreadyExists,
+	"duplicateVolMapping":            ErrAlreadyExists,
+	"mappingInvalidDuplicate":        ErrAlreadyExists,
+	"keyValueTagInvalidDuplicate":    ErrAlreadyExists,
+	"metadataAlreadyExists":          ErrAlreadyExists,
+	"arvmGroupUserLabelExists":       ErrAlreadyExists,
+	"arvmRemoteGroupUserLabelExists": ErrAlreadyExists,
+	"flashcacheUserLabelExists":      ErrAlreadyExists,
+
+	// Object is busy or in a state that conflicts with the request
+	"busy":                                  ErrConflict,
+	"tryAlternate":                          ErrConflict,
+	"reservationConflict":                   ErrConflict,
+	"volumeReconfiguring":                   ErrConflict,
+	"volumeFormatting":                      ErrConflict,
+	"volumeInitializing":                    ErrConflict,
+	"volumeCreationInProgress":              ErrConflict,
+	"volumeInUse":                           ErrConflict,
+	"volumeHasSnapshotRelationship":         ErrConflict,
+	"volumeHasMirrorRelationship":           ErrConflict,
+	"volumeHasVolcopyRelationship":          ErrConflict,
+	"volumeHasAsyncMirror":                  ErrConflict,
+	"memberVolMapped":                       ErrConflict,
+	"pitGroupInConsistencyGroup":            ErrConflict,
+	"diskPoolNotEmpty":                      ErrConflict,
+	"arvmGroupNotEmpty":                     ErrConflict,
+	"copyActive":                            ErrConflict,
+	"rollbackInProgress":                    ErrConflict,
+	"parityScanInProgress":                  ErrConflict,
+	"reconstructionInProgress":              ErrConflict,
+	"copybackInProgress":                    ErrConflict,
+	"downloadInProgress":                    ErrConflict,
+	"databaseResyncInProgress":              ErrConflict,
+	"learnActiveTryLater":                   ErrConflict,
+	"controllerInServiceMode":               ErrConflict,
+	"arvmRoleChangeInProgress":              ErrConflict,
+	"arvmManualSyncAlreadyInProgress":       ErrConflict,
+	"arvmManualSyncRetryTooSoon":            ErrConflict,
+	"arvmConnectivityTestAlreadyInProgress": ErrConflict,
+	"arvmVolumeAlreadyInMirrorRelationship": ErrConflict,
+	"arvmGroupNotPrimary":                   ErrConflict,
+	"arvmGroupNotSecondary":                 ErrConflict,
+	"arvmGroupNotSuspended":                 ErrConflict,
+	"arvmGroupHasIncompleteMember":          ErrConflict,
+	"arvmMirrorGroupRoleConflict":           ErrConflict,
+	"arvmInvalidAmgRequestWhileSuspended":   ErrConflict,
+	"arvmRecoveryPointDeletionRequired":     ErrConflict,
+	"rvmOperNotAllowedOnSec":                ErrConflict,
+	"rvmQuiescenceInProgress":               ErrConflict,
+
+	// Credentials rejected
+	"authFailParam":          ErrAuthFailed,
+	"authFailPassword":       ErrAuthFailed,
+	"authFailReadpassword":   ErrAuthFailed,
+	"authFailContLockout":    ErrAuthFailed,
+	"remoteAuthFailPassword": ErrAuthFailed,
+
+	// Request parameters rejected
+	"illegalParam":                   ErrInvalidArgument,
+	"invalidRequest":                 ErrInvalidArgument,
+	"invalidLabel":                   ErrInvalidArgument,
+	"invalidSegmentsize":             ErrInvalidArgument,
+	"invalidRaidlevel":               ErrInvalidArgument,
+	"invalidBlockSize":               ErrInvalidArgument,
+	"incompatibleBlockSizes":         ErrInvalidArgument,
+	"invalidHostTypeIndex":           ErrInvalidArgument,
+	"invalidLun":                     ErrInvalidArgument,
+	"invalidExpansionSize":           ErrInvalidArgument,
+	"invalidRepositoryCapacity":      ErrInvalidArgument,
+	"invalidPitGroupLabel":           ErrInvalidArgument,
+	"invalidPitViewLabel":            ErrInvalidArgument,
+	"invalidSnapLabel":               ErrInvalidArgument,
+	"invalidWarnThreshold":           ErrInvalidArgument,
+	"invalidPitAutoDeleteLimit":      ErrInvalidArgument,
+	"invalidPitRepositoryFullPolicy": ErrInvalidArgument,
+	"invalidCopyPriority":            ErrInvalidArgument,
+	"invalidSyncPriority":            ErrInvalidArgument,
+	"arvmInvalidSyncInterval":        ErrInvalidArgument,
+	"arvmInvalidSecondaryCapacity":   ErrInvalidArgument,
+	"invalidMirrorCandidateVol":      ErrInvalidArgument,
+	"invalidMirrorvol":               ErrInvalidArgument,
+	"rvmInvalidRemotevol":            ErrInvalidArgument,
+
+	// Array (or the objects involved) is not in an optimal state
+	"notDualActive":              ErrArrayDegraded,
+	"volumeDead":                 ErrArrayDegraded,
+	"volumeOffline":              ErrArrayDegraded,
+	"volumeNotOptimal":           ErrArrayDegraded,
+	"volumeInaccessible":         ErrArrayDegraded,
+	"volumeNotAvailable":         ErrArrayDegraded,
+	"volumeGroupNotOnline":       ErrArrayDegraded,
+	"volumeGroupInaccessible":    ErrArrayDegraded,
+	"volumeGroupHasFailedDrives": ErrArrayDegraded,
+	"driveNotOptimal":            ErrArrayDegraded,
+	"controllerNotOptimal":       ErrArrayDegraded,
+	"repositoryOffline":          ErrArrayDegraded,
+	"repositoryFailed":           ErrArrayDegraded,
+	"baseVolumeFailed":           ErrArrayDegraded,
+	"baseVolumeOffline":          ErrArrayDegraded,
+	"mirrorDegraded":             ErrArrayDegraded,
+	"arvmMemberFailed":           ErrArrayDegraded,
+	"arvmDegradedMirrorGroup":    ErrArrayDegraded,
+	"rvmCommunicationError":      ErrArrayDegraded,
+	"lockdown":                   ErrArrayDegraded,
+	"alternateLockdown":          ErrArrayDegraded,
+}
+
+// Error is returned when the array answers a request with an unexpected HTTP status. Besides the status code it
+// carries the CallResponse details reported by the array, if any, and the controller that served the request.
+type Error struct {
+	Code             int    // HTTP status code
+	Message          string // Description of the failed operation
+	ReturnCode       string // "retcode" from the array's CallResponse
+	CodeType         string // "codeType" from the array's CallResponse: symbol, systemerror, devicemgrerror, ...
+	LocalizedMessage string // "localizedMessage" from the array's CallResponse
+	Controller       string // Controller (management address) that returned the error
+	RequestID        string // Value of the X-Request-ID header sent with the request
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("device API error: %s, Status code: %d", e.Message, e.Code)
+}
+
+// Is reports whether the error belongs to the class identified by one of the sentinel errors in this package.
+func (e Error) Is(target error) bool {
+	kind := e.Kind()
+	return kind != nil && kind == target
+}
+
+// Kind returns the sentinel error that classifies this error, or nil if it does not fall into any class.
+// The array's return code takes precedence over the HTTP status code.
+func (e Error) Kind() error {
+	if kind, ok := retcodeKinds[e.ReturnCode]; ok {
+		return kind
+	}
+
+	switch e.Code {
+	case http.StatusBadRequest:
+		return ErrInvalidArgument
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrAuthFailed
+	case http.StatusNotFound, http.StatusGone:
+		return ErrNotFound
+	case http.StatusConflict, http.StatusLocked, http.StatusServiceUnavailable:
+		return ErrConflict
+	default:
+		return nil
+	}
+}
+
+// TransportError is returned when a request could not be completed with any configured controller, for example
+// because of a connection failure, a TLS error or a timeout.
+type TransportError struct {
+	Controller string // Last controller tried
+	RequestID  string // Value of the X-Request-ID header sent with the request
+	Err        error  // Underlying error from the HTTP client
+}
+
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("error communicating with controller %s: %v", e.Controller, e.Err)
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrTransport.
+func (e *TransportError) Is(target error) bool {
+	return target == ErrTransport
+}
+
+// UnsupportedError is returned without contacting the array when the storage system does not support an operation
+// or option, according to the capabilities detected by Connect.
+type UnsupportedError struct {
+	Feature          string // Operation or option that is not supported
+	FirmwareVersion  string // Controller firmware of the storage system
+	RequiredFirmware string // Oldest firmware that supports the feature, if that is the reason
+}
+
+func (e *UnsupportedError) Error() string {
+	if e.RequiredFirmware != "" {
+		return fmt.Sprintf("%s is not supported on firmware %s, it requires %s or later", e.Feature,
+			e.FirmwareVersion, e.RequiredFirmware)
+	}
+	if e.FirmwareVersion != "" {
+		return fmt.Sprintf("%s is not supported on this storage system (firmware %s)", e.Feature, e.FirmwareVersion)
+	}
+	return fmt.Sprintf("%s is not supported on this storage system", e.Feature)
+}
+
+// Is reports whether target is ErrUnsupported.
+func (e *UnsupportedError) Is(target error) bool {
+	return target == ErrUnsupported
+}
+
+// getErrorFromHTTPResponse converts error information from E-series API responses into GoLang error objects that
+// embed the additional error text and the CallResponse details, if the array supplied them.
+func (d Client) getErrorFromHTTPResponse(response *http.Response, responseBody []byte) Error {
+
+	apiError := Error{
+		Code:    response.StatusCode,
+		Message: "API failed",
+	}
+
+	if response.Request != nil {
+		apiError.Controller = response.Request.URL.Hostname()
+		apiError.RequestID = response.Request.Header.Get("X-Request-ID")
+	}
+
+	// Parse JSON error data, if any. Not every error response carries a CallResponse body.
+	responseData := CallResponseError{}
+	if len(responseBody) == 0 || json.Unmarshal(responseBody, &responseData) != nil {
+		return apiError
+	}
+
+	apiError.ReturnCode = responseData.ReturnCode
+	apiError.CodeType = responseData.CodeType
+	apiError.LocalizedMessage = responseData.LocalizedMsg
+
+	if responseData.ErrorMsg != "" || responseData.LocalizedMsg != "" {
+		apiError.Message = fmt.Sprintf("API failed; Error: %s; Localized: %s",
+			responseData.ErrorMsg, responseData.LocalizedMsg)
+	}
+
+	return apiError
+}
+
+// errorStatusCode returns the HTTP status code of an Error, or 0 for other errors.
+func errorStatusCode(err error) int {
+	var apiError Error
+	if errors.As(err, &apiError) {
+		return apiError.Code
+	}
+	return 0
+}
+
+// newAPIError builds an Error from an HTTP response and prefixes its message with a description of the operation.
+func (d Client) newAPIError(response *http.Response, responseBody []byte, format string, args ...interface{}) Error {
+	apiError := d.getErrorFromHTTPResponse(response, responseBody)
+	apiError.Message = fmt.Sprintf("%s; %s", fmt.Sprintf(format, args...), apiError.Message)
+	return apiError
+}
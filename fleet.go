@@ -0,0 +1,327 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultFleetParallelism is the number of arrays a Fleet works with at the same time, unless configured.
+const DefaultFleetParallelism = 8
+
+// FleetConfig configures a Fleet. Zero values select the defaults.
+type FleetConfig struct {
+	Parallelism int // Arrays queried at the same time (default DefaultFleetParallelism)
+}
+
+// Fleet holds the clients of several storage systems, keyed by array name or chassis serial number, and runs the
+// same query against all of them concurrently. Results are tagged with the key of the array they came from, and
+// an array that fails does not keep the others from returning their results.
+type Fleet struct {
+	config  FleetConfig
+	m       sync.RWMutex
+	arrays  []string // Keys, in the order the clients were added
+	clients map[string]*Client
+}
+
+// FleetItem is an object returned by one array of a Fleet, tagged with the key of that array.
+type FleetItem[T any] struct {
+	Array string `json:"array"`
+	Item  T      `json:"item"`
+}
+
+// FleetError is returned when an operation failed on some arrays of a Fleet. The results of the other arrays are
+// returned with it. errors.Is and errors.As look at the errors of all failed arrays.
+type FleetError struct {
+	Errors map[string]error // Error of each failed array, by array key
+}
+
+func (e *FleetError) Error() string {
+	arrays := make([]string, 0, len(e.Errors))
+	for array := range e.Errors {
+		arrays = append(arrays, array)
+	}
+	sort.Strings(arrays)
+
+	messages := make([]string, 0, len(arrays))
+	for _, array := range arrays {
+		messages = append(messages, fmt.Sprintf("%s: %v", array, e.Errors[array]))
+	}
+	return fmt.Sprintf("failed on %d arrays: %s", len(arrays), strings.Join(messages, "; "))
+}
+
+func (e *FleetError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// NewFleet returns an empty Fleet. Add the clients of the arrays with Add.
+func NewFleet(config FleetConfig) *Fleet {
+	if config.Parallelism <= 0 {
+		config.Parallelism = DefaultFleetParallelism
+	}
+	return &Fleet{
+		config:  config,
+		clients: make(map[string]*Client),
+	}
+}
+
+// NewFleetFromProxy returns a Fleet with a client for every storage system known to the web services that a
+// client is connected to, usually a Web Services Proxy. The clients share the connections of the given client,
+// which must stay open while the Fleet is used. Arrays are keyed by name, or by chassis serial number if the
+// name is missing or not unique.
+func NewFleetFromProxy(ctx context.Context, client *Client, config FleetConfig) (*Fleet, error) {
+
+	systems, err := client.ListStorageSystems(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]int)
+	for _, system := range systems {
+		names[system.Name]++
+	}
+
+	fleet := NewFleet(config)
+	clients := make([]*Client, len(systems))
+	runBounded(ctx, fleet.config.Parallelism, len(systems), func(ctx context.Context, i int) error {
+		clients[i] = client.derive(ctx, systems[i].ID, systems[i].ID)
+		return nil
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	for i, system := range systems {
+		key := system.Name
+		switch {
+		case key != "" && names[key] == 1:
+		case system.ChassisSerialNumber != "":
+			key = system.ChassisSerialNumber
+		default:
+			key = system.ID
+		}
+		if err := fleet.Add(key, clients[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	Logc(ctx).WithField("Arrays", fleet.Arrays()).Debug("Created fleet from storage system list.")
+
+	return fleet, nil
+}
+
+// Add adds the client of an array under a key, usually the array's name or chassis serial number.
+func (f *Fleet) Add(array string, client *Client) error {
+
+	if array == "" || client == nil {
+		return fmt.Errorf("an array key and a client are required: %w", ErrInvalidArgument)
+	}
+
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if _, ok := f.clients[array]; ok {
+		return fmt.Errorf("array %s is already in the fleet: %w", array, ErrAlreadyExists)
+	}
+	f.clients[array] = client
+	f.arrays = append(f.arrays, array)
+	return nil
+}
+
+// Remove removes an array from the fleet and returns its client, which is not closed, or nil if the array is not
+// in the fleet.
+func (f *Fleet) Remove(array string) *Client {
+
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	client, ok := f.clients[array]
+	if !ok {
+		return nil
+	}
+	delete(f.clients, array)
+	for i, key := range f.arrays {
+		if key == array {
+			f.arrays = append(f.arrays[:i], f.arrays[i+1:]...)
+			break
+		}
+	}
+	return client
+}
+
+// Client returns the client of an array, or nil if the array is not in the fleet.
+func (f *Fleet) Client(array string) *Client {
+	f.m.RLock()
+	defer f.m.RUnlock()
+	return f.clients[array]
+}
+
+// Arrays returns the keys of the arrays in the fleet, in the order they were added.
+func (f *Fleet) Arrays() []string {
+	f.m.RLock()
+	defer f.m.RUnlock()
+	return append([]string(nil), f.arrays...)
+}
+
+// Close closes the clients of all arrays.
+func (f *Fleet) Close() error {
+
+	f.m.RLock()
+	defer f.m.RUnlock()
+
+	var errs []error
+	for _, array := range f.arrays {
+		if err := f.clients[array].Close(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", array, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Run calls fn for every array of the fleet, at most Parallelism at a time, and waits for all calls to return.
+// It returns a *FleetError with the errors of the arrays for which fn failed, or nil. Arrays not started when
+// the context is done fail with the context's error.
+func (f *Fleet) Run(ctx context.Context, fn func(ctx context.Context, array string, client *Client) error) error {
+
+	arrays := f.Arrays()
+	clients := make([]*Client, len(arrays))
+	for i, array := range arrays {
+		clients[i] = f.Client(array)
+	}
+
+	errs := runBounded(ctx, f.config.Parallelism, len(arrays), func(ctx context.Context, i int) error {
+		return fn(ctx, arrays[i], clients[i])
+	})
+
+	failed := make(map[string]error)
+	for i, err := range errs {
+		if err != nil {
+			failed[arrays[i]] = err
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+
+	Logc(ctx).WithFields(log.Fields{
+		"Arrays": len(arrays),
+		"Failed": len(failed),
+	}).Debug("Fleet operation failed on some arrays.")
+
+	return &FleetError{Errors: failed}
+}
+
+// FleetCollect calls fn for every array of a fleet, like Fleet.Run, and returns the objects of all arrays tagged
+// with the array they came from, in the order of the arrays in the fleet. If some arrays fail, the objects of
+// the others are returned together with a *FleetError.
+func FleetCollect[T any](
+	ctx context.Context, f *Fleet, fn func(ctx context.Context, client *Client) ([]T, error),
+) ([]FleetItem[T], error) {
+
+	var m sync.Mutex
+	results := make(map[string][]T)
+
+	err := f.Run(ctx, func(ctx context.Context, array string, client *Client) error {
+		items, err := fn(ctx, client)
+		if err != nil {
+			return err
+		}
+		m.Lock()
+		results[array] = items
+		m.Unlock()
+		return nil
+	})
+
+	var tagged []FleetItem[T]
+	for _, array := range f.Arrays() {
+		for _, item := range results[array] {
+			tagged = append(tagged, FleetItem[T]{Array: array, Item: item})
+		}
+	}
+	return tagged, err
+}
+
+// GetStorageSystems returns the storage system details of every array.
+func (f *Fleet) GetStorageSystems(ctx context.Context) ([]FleetItem[StorageSystem], error) {
+	return FleetCollect(ctx, f, func(ctx context.Context, client *Client) ([]StorageSystem, error) {
+		system, err := client.GetStorageSystem(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return []StorageSystem{*system}, nil
+	})
+}
+
+// GetVolumes returns the volumes of every array.
+func (f *Fleet) GetVolumes(ctx context.Context) ([]FleetItem[VolumeEx], error) {
+	return FleetCollect(ctx, f, func(ctx context.Context, client *Client) ([]VolumeEx, error) {
+		return client.GetVolumes(ctx)
+	})
+}
+
+// GetVolumePools returns the storage pools of every array that match the selection parameters, as for
+// Client.GetVolumePools.
+func (f *Fleet) GetVolumePools(
+	ctx context.Context, mediaType string, minFreeSpaceBytes uint64, poolName string,
+) ([]FleetItem[VolumeGroupEx], error) {
+	return FleetCollect(ctx, f, func(ctx context.Context, client *Client) ([]VolumeGroupEx, error) {
+		return client.GetVolumePools(ctx, mediaType, minFreeSpaceBytes, poolName)
+	})
+}
+
+// GetHosts returns the hosts defined on every array.
+func (f *Fleet) GetHosts(ctx context.Context) ([]FleetItem[Host], error) {
+	return FleetCollect(ctx, f, func(ctx context.Context, client *Client) ([]Host, error) {
+		return client.GetHosts(ctx)
+	})
+}
+
+// GetFailures returns the failures reported by every array.
+func (f *Fleet) GetFailures(ctx context.Context) ([]FleetItem[Failure], error) {
+	return FleetCollect(ctx, f, func(ctx context.Context, client *Client) ([]Failure, error) {
+		return client.GetFailures(ctx)
+	})
+}
+
+// runBounded calls fn for the indexes 0 to count-1 with at most parallelism calls running at a time, waits for
+// all of them and returns their errors by index. Calls not started when the context is done are skipped and
+// get the context's error.
+func runBounded(ctx context.Context, parallelism, count int, fn func(ctx context.Context, i int) error) []error {
+
+	errs := make([]error, count)
+	semaphore := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+
+	for i := 0; i < count; i++ {
+		if err := ctx.Err(); err != nil {
+			errs[i] = err
+			continue
+		}
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			errs[i] = fn(ctx, i)
+		}(i)
+	}
+
+	wg.Wait()
+	return errs
+}
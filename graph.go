@@ -0,0 +1,708 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Volume tags set by the CSI driver on the volumes it provisions.
+const (
+	TagPVCName      = "pvc_name"
+	TagPVCNamespace = "pvc_namespace"
+	TagPVName       = "pv_name"
+	TagCSIDriver    = "csi_driver"
+)
+
+// GraphNode is an object of a ConfigGraph.
+type GraphNode interface {
+	// NodeType returns the name of the object's list in the array's object graph, as in InventoryObject.Type.
+	NodeType() string
+	NodeRef() string
+	NodeLabel() string
+}
+
+// ConfigGraph is the configuration of an array with the references between objects resolved, so that it can be
+// navigated without joining lists by hand. It is a snapshot: changes made afterwards are not reflected. The
+// links between nodes are not serialized to JSON.
+type ConfigGraph struct {
+	Pools                  []*PoolNode
+	Volumes                []*VolumeNode
+	Hosts                  []*HostNode
+	HostGroups             []*HostGroupNode
+	Mappings               []*MappingNode
+	SnapshotGroups         []*SnapshotGroupNode
+	SnapshotImages         []*SnapshotImageNode
+	SnapshotVolumes        []*SnapshotVolumeNode
+	ConsistencyGroups      []*ConsistencyGroupNode
+	ConsistencyGroupViews  []*ConsistencyGroupViewNode
+	Repositories           []*RepositoryNode
+	byRef                  map[string]GraphNode
+	repositoriesByOwnerRef map[string][]*RepositoryNode
+}
+
+// PoolNode is a storage pool (volume group or disk pool) and the volumes in it.
+type PoolNode struct {
+	VolumeGroupEx
+	Volumes []*VolumeNode `json:"-"`
+}
+
+// VolumeNode is a volume and the objects that refer to it.
+type VolumeNode struct {
+	VolumeEx
+	Pool              *PoolNode               `json:"-"`
+	LUNMappings       []*MappingNode          `json:"-"`
+	SnapshotGroups    []*SnapshotGroupNode    `json:"-"` // Snapshot groups of the volume, including consistency group members
+	SnapshotVolumes   []*SnapshotVolumeNode   `json:"-"` // Snapshot volumes (linked clones) of the volume's images
+	ConsistencyGroups []*ConsistencyGroupNode `json:"-"`
+	Repositories      []*RepositoryNode       `json:"-"` // Repositories of the volume's snapshots, or of a thin volume
+	Repository        *RepositoryNode         `json:"-"` // Set if the volume is a member of a repository
+}
+
+// HostNode is a host, its host group and its mappings.
+type HostNode struct {
+	HostEx
+	HostGroup   *HostGroupNode `json:"-"`
+	LUNMappings []*MappingNode `json:"-"`
+}
+
+// HostGroupNode is a host group (cluster), its hosts and its mappings.
+type HostGroupNode struct {
+	HostGroup
+	Hosts       []*HostNode    `json:"-"`
+	LUNMappings []*MappingNode `json:"-"`
+}
+
+// MappingNode is a LUN mapping of a volume or snapshot volume to a host or host group.
+type MappingNode struct {
+	LUNMapping
+	Volume    GraphNode      `json:"-"` // *VolumeNode or *SnapshotVolumeNode
+	Host      *HostNode      `json:"-"` // Set for mappings to a host
+	HostGroup *HostGroupNode `json:"-"` // Set for mappings to a host group
+}
+
+// SnapshotGroupNode is a snapshot group (PiT group) and its images.
+type SnapshotGroupNode struct {
+	SnapshotGroup
+	Base       *VolumeNode           `json:"-"`
+	Images     []*SnapshotImageNode  `json:"-"`
+	MemberOf   *ConsistencyGroupNode `json:"-"` // Set for the groups of consistency group members
+	Repository *RepositoryNode       `json:"-"`
+}
+
+// SnapshotImageNode is a snapshot image (PiT) and the snapshot volumes created from it.
+type SnapshotImageNode struct {
+	SnapshotImage
+	Group           *SnapshotGroupNode    `json:"-"`
+	Base            *VolumeNode           `json:"-"`
+	SnapshotVolumes []*SnapshotVolumeNode `json:"-"`
+}
+
+// SnapshotVolumeNode is a snapshot volume (linked clone).
+type SnapshotVolumeNode struct {
+	SnapshotVolume
+	Base        *VolumeNode        `json:"-"`
+	Image       *SnapshotImageNode `json:"-"`
+	LUNMappings []*MappingNode     `json:"-"`
+	Repository  *RepositoryNode    `json:"-"`
+}
+
+// ConsistencyGroupNode is a consistency group, the snapshot groups of its members and its views.
+type ConsistencyGroupNode struct {
+	ConsistencyGroup
+	Members []*SnapshotGroupNode        `json:"-"`
+	Views   []*ConsistencyGroupViewNode `json:"-"`
+}
+
+// ConsistencyGroupViewNode is a view of a consistency group snapshot.
+type ConsistencyGroupViewNode struct {
+	ConsistencyGroupView
+	Group        *ConsistencyGroupNode `json:"-"`
+	Repositories []*RepositoryNode     `json:"-"`
+}
+
+// RepositoryNode is a concat repository volume and the volumes it consists of.
+type RepositoryNode struct {
+	ConcatRepositoryVolume
+	Members []*VolumeNode `json:"-"`
+	Owner   GraphNode     `json:"-"` // Snapshot object, consistency group view or thin volume; nil if unknown
+}
+
+func (n *PoolNode) NodeType() string  { return "volumeGroup" }
+func (n *PoolNode) NodeRef() string   { return n.VolumeGroupRef }
+func (n *PoolNode) NodeLabel() string { return n.Label }
+
+func (n *VolumeNode) NodeType() string {
+	if n.ThinProvisioned {
+		return "thinVolume"
+	}
+	return "volume"
+}
+func (n *VolumeNode) NodeRef() string   { return n.VolumeRef }
+func (n *VolumeNode) NodeLabel() string { return n.Label }
+
+func (n *HostNode) NodeType() string  { return "host" }
+func (n *HostNode) NodeRef() string   { return n.HostRef }
+func (n *HostNode) NodeLabel() string { return n.Label }
+
+func (n *HostGroupNode) NodeType() string  { return "cluster" }
+func (n *HostGroupNode) NodeRef() string   { return n.ClusterRef }
+func (n *HostGroupNode) NodeLabel() string { return n.Label }
+
+func (n *MappingNode) NodeType() string  { return "lunMapping" }
+func (n *MappingNode) NodeRef() string   { return n.LunMappingRef }
+func (n *MappingNode) NodeLabel() string { return fmt.Sprintf("LUN %d", n.LunNumber) }
+
+func (n *SnapshotGroupNode) NodeType() string  { return "pitGroup" }
+func (n *SnapshotGroupNode) NodeRef() string   { return n.PitGroupRef }
+func (n *SnapshotGroupNode) NodeLabel() string { return n.Label }
+
+func (n *SnapshotImageNode) NodeType() string  { return "pit" }
+func (n *SnapshotImageNode) NodeRef() string   { return n.PitRef }
+func (n *SnapshotImageNode) NodeLabel() string { return n.PitSequenceNumber }
+
+func (n *SnapshotVolumeNode) NodeType() string  { return "pitView" }
+func (n *SnapshotVolumeNode) NodeRef() string   { return n.SnapshotRef }
+func (n *SnapshotVolumeNode) NodeLabel() string { return n.Label }
+
+func (n *ConsistencyGroupNode) NodeType() string  { return "pitConsistencyGroup" }
+func (n *ConsistencyGroupNode) NodeRef() string   { return n.ConsistencyGroupRef }
+func (n *ConsistencyGroupNode) NodeLabel() string { return n.Label }
+
+func (n *ConsistencyGroupViewNode) NodeType() string  { return "pitConsistencyGroupView" }
+func (n *ConsistencyGroupViewNode) NodeRef() string   { return n.ConsistencyGroupViewRef }
+func (n *ConsistencyGroupViewNode) NodeLabel() string { return n.Label }
+
+func (n *RepositoryNode) NodeType() string  { return "concatVolume" }
+func (n *RepositoryNode) NodeRef() string   { return n.ConcatVolRef }
+func (n *RepositoryNode) NodeLabel() string { return n.Name }
+
+// thinVolumeRepository is the repository of a thin volume, as listed in the object graph.
+type thinVolumeRepository struct {
+	VolumeRef     string `json:"volumeRef"`
+	RepositoryRef string `json:"repositoryRef"`
+}
+
+// concatVolumeMember is a member volume of a concat repository, as listed in the object graph.
+type concatVolumeMember struct {
+	ConcatVolRef     string `json:"concatVolRef"`
+	StorageVolumeRef string `json:"storageVolumeRef"`
+}
+
+// GetConfigGraph reads the array's configuration from its object graph and resolves the references between the
+// objects. The volumes are completed with their tags from the volume list, which the object graph lacks. With
+// ClientConfig.Cache set, both are served from the cache if possible.
+func (d Client) GetConfigGraph(ctx context.Context) (*ConfigGraph, error) {
+	ctx, span := d.startSpan(ctx, "GetConfigGraph")
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "GetConfigGraph",
+			"Type":   "Client",
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetConfigGraph")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetConfigGraph")
+	}
+
+	graph, err := d.configGraph(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	Logc(ctx).WithFields(log.Fields{
+		"Volumes": len(graph.Volumes),
+		"Objects": len(graph.byRef),
+	}).Debug("Built configuration graph.")
+
+	return graph, nil
+}
+
+// configGraph builds the configuration graph, with the volume tags only if asked for, as they take another
+// request.
+func (d Client) configGraph(ctx context.Context, withTags bool) (*ConfigGraph, error) {
+
+	index, err := d.GetInventory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var volumes []VolumeEx
+	if withTags {
+		if volumes, err = d.GetVolumes(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return newConfigGraph(index, volumes)
+}
+
+// decodeNodes decodes the objects of a type in an inventory index.
+func decodeNodes[T any](index *InventoryIndex, objectType string) ([]*T, error) {
+	objects := index.Objects(objectType)
+	nodes := make([]*T, 0, len(objects))
+	for _, object := range objects {
+		node := new(T)
+		if err := object.Decode(node); err != nil {
+			return nil, fmt.Errorf("could not parse %s %s in object graph: %v", objectType, object.Ref, err)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// newConfigGraph builds a configuration graph from the objects of an object graph. Volumes found in the volume
+// list replace those of the object graph, which have no tags or mappings.
+func newConfigGraph(index *InventoryIndex, volumes []VolumeEx) (*ConfigGraph, error) {
+
+	g := &ConfigGraph{
+		byRef:                  make(map[string]GraphNode),
+		repositoriesByOwnerRef: make(map[string][]*RepositoryNode),
+	}
+
+	var err error
+	if g.Pools, err = decodeNodes[PoolNode](index, "volumeGroup"); err != nil {
+		return nil, err
+	}
+	if g.Volumes, err = decodeNodes[VolumeNode](index, "volume"); err != nil {
+		return nil, err
+	}
+	thinVolumes, err := decodeNodes[VolumeNode](index, "thinVolume")
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range thinVolumes {
+		v.ThinProvisioned = true
+	}
+	g.Volumes = append(g.Volumes, thinVolumes...)
+	if g.Hosts, err = decodeNodes[HostNode](index, "host"); err != nil {
+		return nil, err
+	}
+	if g.HostGroups, err = decodeNodes[HostGroupNode](index, "cluster"); err != nil {
+		return nil, err
+	}
+	if g.Mappings, err = decodeNodes[MappingNode](index, "lunMapping"); err != nil {
+		return nil, err
+	}
+	if g.SnapshotGroups, err = decodeNodes[SnapshotGroupNode](index, "pitGroup"); err != nil {
+		return nil, err
+	}
+	if g.SnapshotImages, err = decodeNodes[SnapshotImageNode](index, "pit"); err != nil {
+		return nil, err
+	}
+	if g.SnapshotVolumes, err = decodeNodes[SnapshotVolumeNode](index, "pitView"); err != nil {
+		return nil, err
+	}
+	if g.ConsistencyGroups, err = decodeNodes[ConsistencyGroupNode](index, "pitConsistencyGroup"); err != nil {
+		return nil, err
+	}
+	if g.ConsistencyGroupViews, err = decodeNodes[ConsistencyGroupViewNode](index,
+		"pitConsistencyGroupView"); err != nil {
+		return nil, err
+	}
+	if g.Repositories, err = decodeNodes[RepositoryNode](index, "concatVolume"); err != nil {
+		return nil, err
+	}
+	members, err := decodeNodes[concatVolumeMember](index, "concatVolMember")
+	if err != nil {
+		return nil, err
+	}
+	thinRepositories, err := decodeNodes[thinVolumeRepository](index, "thinVolume")
+	if err != nil {
+		return nil, err
+	}
+	initiators, err := decodeNodes[struct {
+		HostExInitiator
+		HostRef string `json:"hostRef"`
+	}](index, "initiator")
+	if err != nil {
+		return nil, err
+	}
+
+	listed := make(map[string]VolumeEx, len(volumes))
+	for _, volume := range volumes {
+		listed[volume.VolumeRef] = volume
+	}
+	for _, volume := range g.Volumes {
+		if v, ok := listed[volume.VolumeRef]; ok {
+			volume.VolumeEx = v
+		}
+	}
+
+	g.index()
+	g.link(members, thinRepositories)
+
+	for _, initiator := range initiators {
+		host, _ := g.byRef[initiator.HostRef].(*HostNode)
+		if host != nil && !hasInitiator(host.Initiators, initiator.InitiatorRef) {
+			host.Initiators = append(host.Initiators, initiator.HostExInitiator)
+		}
+	}
+
+	return g, nil
+}
+
+func hasInitiator(initiators []HostExInitiator, ref string) bool {
+	for _, initiator := range initiators {
+		if initiator.InitiatorRef == ref {
+			return true
+		}
+	}
+	return false
+}
+
+// index fills the map of nodes by ref.
+func (g *ConfigGraph) index() {
+	add := func(node GraphNode) {
+		if ref := node.NodeRef(); ref != "" {
+			if _, ok := g.byRef[ref]; !ok {
+				g.byRef[ref] = node
+			}
+		}
+	}
+	for _, n := range g.Pools {
+		add(n)
+	}
+	for _, n := range g.Volumes {
+		add(n)
+	}
+	for _, n := range g.Hosts {
+		add(n)
+	}
+	for _, n := range g.HostGroups {
+		add(n)
+	}
+	for _, n := range g.Mappings {
+		add(n)
+	}
+	for _, n := range g.SnapshotGroups {
+		add(n)
+	}
+	for _, n := range g.SnapshotImages {
+		add(n)
+	}
+	for _, n := range g.SnapshotVolumes {
+		add(n)
+	}
+	for _, n := range g.ConsistencyGroups {
+		add(n)
+	}
+	for _, n := range g.ConsistencyGroupViews {
+		add(n)
+	}
+	for _, n := range g.Repositories {
+		add(n)
+	}
+}
+
+// link resolves the references between the nodes.
+func (g *ConfigGraph) link(members []*concatVolumeMember, thinRepositories []*thinVolumeRepository) {
+
+	volume := func(ref string) *VolumeNode { n, _ := g.byRef[ref].(*VolumeNode); return n }
+
+	for _, v := range g.Volumes {
+		if pool, ok := g.byRef[v.VolumeGroupRef].(*PoolNode); ok {
+			v.Pool = pool
+			pool.Volumes = append(pool.Volumes, v)
+		}
+	}
+
+	for _, h := range g.Hosts {
+		if group, ok := g.byRef[h.ClusterRef].(*HostGroupNode); ok {
+			h.HostGroup = group
+			group.Hosts = append(group.Hosts, h)
+		}
+	}
+
+	for _, m := range g.Mappings {
+		switch target := g.byRef[m.VolumeRef].(type) {
+		case *VolumeNode:
+			m.Volume = target
+			target.LUNMappings = append(target.LUNMappings, m)
+		case *SnapshotVolumeNode:
+			m.Volume = target
+			target.LUNMappings = append(target.LUNMappings, m)
+		}
+		switch target := g.byRef[m.MapRef].(type) {
+		case *HostNode:
+			m.Host = target
+			target.LUNMappings = append(target.LUNMappings, m)
+		case *HostGroupNode:
+			m.HostGroup = target
+			target.LUNMappings = append(target.LUNMappings, m)
+		}
+	}
+
+	// Repositories refer to their owner by ID, owners to their repository by ref; either may be missing
+	for _, m := range members {
+		if r, ok := g.byRef[m.ConcatVolRef].(*RepositoryNode); ok && !slices.Contains(r.MemberRefs, m.StorageVolumeRef) {
+			r.MemberRefs = append(r.MemberRefs, m.StorageVolumeRef)
+		}
+	}
+	for _, r := range g.Repositories {
+		for _, ref := range r.MemberRefs {
+			if v := volume(ref); v != nil {
+				v.Repository = r
+				r.Members = append(r.Members, v)
+			}
+		}
+		if r.BaseObjectId != "" {
+			g.repositoriesByOwnerRef[r.BaseObjectId] = append(g.repositoriesByOwnerRef[r.BaseObjectId], r)
+		}
+	}
+	repository := func(owner GraphNode, ref string) *RepositoryNode {
+		r, _ := g.byRef[ref].(*RepositoryNode)
+		if r == nil && len(g.repositoriesByOwnerRef[owner.NodeRef()]) > 0 {
+			r = g.repositoriesByOwnerRef[owner.NodeRef()][0]
+		}
+		if r != nil && r.Owner == nil {
+			r.Owner = owner
+		}
+		return r
+	}
+
+	// Thin volumes take their capacity from their repository, and so are in the pool of its members
+	for _, t := range thinRepositories {
+		v := volume(t.VolumeRef)
+		if v == nil {
+			continue
+		}
+		if r := repository(v, t.RepositoryRef); r != nil {
+			v.Repositories = append(v.Repositories, r)
+			if v.Pool == nil && len(r.Members) > 0 && r.Members[0].Pool != nil {
+				v.Pool = r.Members[0].Pool
+				v.Pool.Volumes = append(v.Pool.Volumes, v)
+			}
+		}
+	}
+
+	for _, cg := range g.ConsistencyGroups {
+		for _, r := range g.repositoriesByOwnerRef[cg.ConsistencyGroupRef] {
+			r.Owner = cg
+		}
+	}
+
+	for _, sg := range g.SnapshotGroups {
+		sg.Repository = repository(sg, sg.RepositoryVolume)
+		if v := volume(sg.BaseVolume); v != nil {
+			sg.Base = v
+			v.SnapshotGroups = append(v.SnapshotGroups, sg)
+			if sg.Repository != nil {
+				v.Repositories = append(v.Repositories, sg.Repository)
+			}
+		}
+		if cg, ok := g.byRef[sg.ConsistencyGroupRef].(*ConsistencyGroupNode); ok {
+			sg.MemberOf = cg
+			cg.Members = append(cg.Members, sg)
+			if sg.Base != nil {
+				sg.Base.ConsistencyGroups = append(sg.Base.ConsistencyGroups, cg)
+			}
+		}
+	}
+
+	for _, image := range g.SnapshotImages {
+		if sg, ok := g.byRef[image.PitGroupRef].(*SnapshotGroupNode); ok {
+			image.Group = sg
+			sg.Images = append(sg.Images, image)
+			image.Base = sg.Base
+		}
+		if image.Base == nil {
+			image.Base = volume(image.BaseVol)
+		}
+	}
+
+	for _, sv := range g.SnapshotVolumes {
+		sv.Repository = repository(sv, sv.RepositoryVolume)
+		if image, ok := g.byRef[sv.BasePIT].(*SnapshotImageNode); ok {
+			sv.Image = image
+			image.SnapshotVolumes = append(image.SnapshotVolumes, sv)
+		}
+		sv.Base = volume(sv.BaseVolume)
+		if sv.Base == nil && sv.Image != nil {
+			sv.Base = sv.Image.Base
+		}
+		if sv.Base != nil {
+			sv.Base.SnapshotVolumes = append(sv.Base.SnapshotVolumes, sv)
+			if sv.Repository != nil {
+				sv.Base.Repositories = append(sv.Base.Repositories, sv.Repository)
+			}
+		}
+	}
+
+	for _, view := range g.ConsistencyGroupViews {
+		if cg, ok := g.byRef[view.GroupRef].(*ConsistencyGroupNode); ok {
+			view.Group = cg
+			cg.Views = append(cg.Views, view)
+		}
+		for _, r := range g.repositoriesByOwnerRef[view.ConsistencyGroupViewRef] {
+			r.Owner = view
+			view.Repositories = append(view.Repositories, r)
+		}
+	}
+}
+
+// Node returns the object with the given ref, or nil.
+func (g *ConfigGraph) Node(ref string) GraphNode {
+	return g.byRef[ref]
+}
+
+// Volume returns the volume with the given label, or nil.
+func (g *ConfigGraph) Volume(label string) *VolumeNode {
+	for _, v := range g.Volumes {
+		if v.Label == label {
+			return v
+		}
+	}
+	return nil
+}
+
+// children returns the objects that exist only because of a node, and go away or become useless without it.
+func children(node GraphNode) []GraphNode {
+
+	var nodes []GraphNode
+	switch n := node.(type) {
+	case *PoolNode:
+		nodes = appendNodes(nodes, n.Volumes)
+	case *VolumeNode:
+		nodes = appendNodes(nodes, n.LUNMappings)
+		nodes = appendNodes(nodes, n.SnapshotGroups)
+		nodes = appendNodes(nodes, n.SnapshotVolumes)
+	case *HostNode:
+		nodes = appendNodes(nodes, n.LUNMappings)
+	case *HostGroupNode:
+		nodes = appendNodes(nodes, n.LUNMappings)
+	case *SnapshotGroupNode:
+		nodes = appendNodes(nodes, n.Images)
+		if n.Repository != nil {
+			nodes = append(nodes, n.Repository)
+		}
+	case *SnapshotImageNode:
+		nodes = appendNodes(nodes, n.SnapshotVolumes)
+	case *SnapshotVolumeNode:
+		nodes = appendNodes(nodes, n.LUNMappings)
+		if n.Repository != nil {
+			nodes = append(nodes, n.Repository)
+		}
+	case *ConsistencyGroupNode:
+		nodes = appendNodes(nodes, n.Members)
+		nodes = appendNodes(nodes, n.Views)
+	case *ConsistencyGroupViewNode:
+		nodes = appendNodes(nodes, n.Repositories)
+	case *RepositoryNode:
+		nodes = appendNodes(nodes, n.Members)
+	}
+	return nodes
+}
+
+func appendNodes[T GraphNode](nodes []GraphNode, add []T) []GraphNode {
+	for _, node := range add {
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// Dependents returns all objects that depend on the object with the given ref, directly or through other
+// objects: for a volume, its mappings, snapshot groups, snapshot images, snapshot volumes and their
+// repositories. Objects are listed before the objects that depend on them.
+func (g *ConfigGraph) Dependents(ref string) []GraphNode {
+
+	root := g.byRef[ref]
+	if root == nil {
+		return nil
+	}
+
+	seen := map[GraphNode]bool{root: true}
+	var dependents []GraphNode
+	queue := children(root)
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		if seen[node] {
+			continue
+		}
+		seen[node] = true
+		dependents = append(dependents, node)
+		queue = append(queue, children(node)...)
+	}
+	return dependents
+}
+
+// DeleteBlockers returns the objects that keep the object with the given ref from being deleted, and must be
+// removed first: mappings of volumes, snapshot volumes and hosts, snapshot images and snapshot volumes of a
+// volume, snapshot volumes created from the images of a snapshot group, and the volumes of a pool. It returns
+// nil if nothing blocks the deletion or the object is unknown.
+func (g *ConfigGraph) DeleteBlockers(ref string) []GraphNode {
+
+	var blockers []GraphNode
+	switch n := g.byRef[ref].(type) {
+	case *PoolNode:
+		blockers = appendNodes(blockers, n.Volumes)
+	case *VolumeNode:
+		blockers = appendNodes(blockers, n.LUNMappings)
+		blockers = appendNodes(blockers, n.SnapshotVolumes)
+		for _, group := range n.SnapshotGroups {
+			blockers = appendNodes(blockers, group.Images)
+		}
+	case *HostNode:
+		blockers = appendNodes(blockers, n.LUNMappings)
+	case *HostGroupNode:
+		blockers = appendNodes(blockers, n.LUNMappings)
+	case *SnapshotGroupNode:
+		for _, image := range n.Images {
+			blockers = appendNodes(blockers, image.SnapshotVolumes)
+		}
+	case *SnapshotImageNode:
+		blockers = appendNodes(blockers, n.SnapshotVolumes)
+	case *SnapshotVolumeNode:
+		blockers = appendNodes(blockers, n.LUNMappings)
+	}
+	return blockers
+}
+
+// VolumesWithTag returns the volumes that have a tag with the given key and value.
+func (g *ConfigGraph) VolumesWithTag(key, value string) []*VolumeNode {
+	var volumes []*VolumeNode
+	for _, v := range g.Volumes {
+		for _, tag := range v.VolumeTags {
+			if tag.Key == key && tag.Value == value {
+				volumes = append(volumes, v)
+				break
+			}
+		}
+	}
+	return volumes
+}
+
+// OwnedByPVC returns the volumes provisioned by the CSI driver for a persistent volume claim, followed by all
+// objects that depend on them. An empty namespace matches claims of that name in any namespace.
+func (g *ConfigGraph) OwnedByPVC(namespace, name string) []GraphNode {
+
+	var owned []GraphNode
+	seen := make(map[GraphNode]bool)
+	for _, v := range g.VolumesWithTag(TagPVCName, name) {
+		if namespace != "" && v.tag(TagPVCNamespace) != namespace {
+			continue
+		}
+		for _, node := range append([]GraphNode{v}, g.Dependents(v.VolumeRef)...) {
+			if !seen[node] {
+				seen[node] = true
+				owned = append(owned, node)
+			}
+		}
+	}
+	return owned
+}
+
+// tag returns the value of a volume tag, or an empty string.
+func (v *VolumeNode) tag(key string) string {
+	for _, tag := range v.VolumeTags {
+		if tag.Key == key {
+			return tag.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,272 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ControllerHealth reports what the client knows about one of the configured management controllers.
+type ControllerHealth struct {
+	Controller          string    `json:"controller"`
+	Active              bool      `json:"active"`  // Controller tried first by the next request
+	Healthy             bool      `json:"healthy"` // False while the circuit breaker is open
+	ConsecutiveFailures int       `json:"consecutiveFailures"`
+	LastSuccess         time.Time `json:"lastSuccess,omitempty"`
+	LastFailure         time.Time `json:"lastFailure,omitempty"`
+	LastError           string    `json:"lastError,omitempty"`
+	CooldownUntil       time.Time `json:"cooldownUntil,omitempty"` // Set while the circuit breaker is open
+}
+
+// controllerTracker keeps the health of the management controllers of one client. Requests go to the last
+// controller that answered first. A controller that failed FailureThreshold times in a row is only tried after
+// all others until its cooldown expires or a background probe finds it reachable again.
+type controllerTracker struct {
+	mu        sync.Mutex
+	state     map[string]*ControllerHealth
+	active    string
+	probing   map[string]bool
+	stop      chan struct{}
+	stopOnce  sync.Once
+	threshold int
+	cooldown  time.Duration
+}
+
+func newControllerTracker(config *ClientConfig) *controllerTracker {
+	t := &controllerTracker{
+		state:     make(map[string]*ControllerHealth),
+		probing:   make(map[string]bool),
+		stop:      make(chan struct{}),
+		threshold: intOrDefault(config.FailureThreshold, DefaultFailureThreshold),
+		cooldown:  durationOrDefault(config.FailureCooldown, DefaultFailureCooldown),
+	}
+	for _, controller := range config.ApiControllers {
+		t.state[controller] = &ControllerHealth{Controller: controller, Healthy: true}
+	}
+	if len(config.ApiControllers) > 0 {
+		t.active = config.ApiControllers[0]
+	}
+	return t
+}
+
+// order returns the controllers in the order a request should try them: the active controller, then the other
+// healthy ones, then those whose circuit breaker is open.
+func (t *controllerTracker) order(controllers []string) []string {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	ordered := make([]string, 0, len(controllers))
+	var open []string
+
+	for _, controller := range controllers {
+		if controller == t.active && t.available(controller, now) {
+			ordered = append(ordered, controller)
+		}
+	}
+	for _, controller := range controllers {
+		switch {
+		case controller == t.active && t.available(controller, now):
+			// Already first
+		case t.available(controller, now):
+			ordered = append(ordered, controller)
+		default:
+			open = append(open, controller)
+		}
+	}
+	return append(ordered, open...)
+}
+
+// available reports whether the circuit breaker of a controller is closed or its cooldown expired.
+// Must be called with the lock held.
+func (t *controllerTracker) available(controller string, now time.Time) bool {
+	state, ok := t.state[controller]
+	return !ok || state.Healthy || now.After(state.CooldownUntil)
+}
+
+// success records that a controller answered a request, and makes it the active controller. It returns the
+// previously active controller.
+func (t *controllerTracker) success(controller string) string {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state := t.get(controller)
+	state.Healthy = true
+	state.ConsecutiveFailures = 0
+	state.CooldownUntil = time.Time{}
+	state.LastSuccess = time.Now()
+
+	previous := t.active
+	t.active = controller
+	return previous
+}
+
+// failure records a transport error from a controller. It returns true if this opened the circuit breaker.
+func (t *controllerTracker) failure(controller string, err error) bool {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state := t.get(controller)
+	state.ConsecutiveFailures++
+	state.LastFailure = time.Now()
+	state.LastError = err.Error()
+
+	if state.ConsecutiveFailures < t.threshold {
+		return false
+	}
+	wasHealthy := state.Healthy
+	state.Healthy = false
+	state.CooldownUntil = state.LastFailure.Add(t.cooldown)
+	return wasHealthy
+}
+
+// get returns the state of a controller, creating it if needed. Must be called with the lock held.
+func (t *controllerTracker) get(controller string) *ControllerHealth {
+	state, ok := t.state[controller]
+	if !ok {
+		state = &ControllerHealth{Controller: controller, Healthy: true}
+		t.state[controller] = state
+	}
+	return state
+}
+
+// snapshot returns a copy of the health of the given controllers.
+func (t *controllerTracker) snapshot(controllers []string) []ControllerHealth {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	status := make([]ControllerHealth, 0, len(controllers))
+	for _, controller := range controllers {
+		state := *t.get(controller)
+		state.Active = controller == t.active
+		status = append(status, state)
+	}
+	return status
+}
+
+// close stops the background probes.
+func (t *controllerTracker) close() {
+	t.stopOnce.Do(func() { close(t.stop) })
+}
+
+// ControllerStatus returns the health of each configured management controller, in configuration order.
+func (d Client) ControllerStatus() []ControllerHealth {
+	return d.health.snapshot(d.config.ApiControllers)
+}
+
+// ActiveController returns the management controller the next request will be sent to first.
+func (d Client) ActiveController() string {
+	if order := d.health.order(d.config.ApiControllers); len(order) > 0 {
+		return order[0]
+	}
+	return ""
+}
+
+// recordSuccess updates the health of a controller after it answered a request.
+func (d Client) recordSuccess(ctx context.Context, controller string) {
+	if previous := d.health.success(controller); previous != controller {
+		Logc(ctx).WithFields(log.Fields{
+			"controller": controller,
+			"previous":   previous,
+		}).Info("Switched active management controller.")
+	}
+}
+
+// recordFailure updates the health of a controller after a transport error, and starts probing it in the
+// background once its circuit breaker opens.
+func (d Client) recordFailure(ctx context.Context, controller string, err error) {
+	if !d.health.failure(controller, err) {
+		return
+	}
+	Logc(ctx).WithFields(log.Fields{
+		"controller": controller,
+		"cooldown":   d.health.cooldown,
+	}).Warn("Controller marked unhealthy.")
+	d.startProbe(controller)
+}
+
+// startProbe checks an unhealthy controller periodically until it answers again or the client is closed.
+func (d Client) startProbe(controller string) {
+
+	d.health.mu.Lock()
+	if d.health.probing[controller] {
+		d.health.mu.Unlock()
+		return
+	}
+	d.health.probing[controller] = true
+	d.health.mu.Unlock()
+
+	interval := durationOrDefault(d.config.HealthProbeInterval, d.health.cooldown/2)
+
+	go func() {
+		defer func() {
+			d.health.mu.Lock()
+			delete(d.health.probing, controller)
+			d.health.mu.Unlock()
+		}()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-d.health.stop:
+				return
+			case <-ticker.C:
+			}
+
+			d.health.mu.Lock()
+			healthy := d.health.get(controller).Healthy
+			d.health.mu.Unlock()
+			if healthy {
+				return // A regular request got through in the meantime
+			}
+
+			if err := d.probe(controller, interval); err != nil {
+				Logc(context.Background()).WithField("controller", controller).WithError(err).Debug(
+					"Controller still unreachable.")
+				continue
+			}
+
+			d.health.mu.Lock()
+			state := d.health.get(controller)
+			state.Healthy = true
+			state.ConsecutiveFailures = 0
+			state.CooldownUntil = time.Time{}
+			state.LastSuccess = time.Now()
+			d.health.mu.Unlock()
+
+			Logc(context.Background()).WithField("controller", controller).Info("Controller reachable again.")
+			return
+		}
+	}()
+}
+
+// probe sends an unauthenticated request to a controller. Any HTTP response counts as reachable.
+func (d Client) probe(controller string, timeout time.Duration) error {
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	url := fmt.Sprintf("https://%s:%d/devmgr/utils/about", controller, d.config.ApiPort)
+	request, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	response, err := d.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+	return nil
+}
@@ -0,0 +1,276 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// The interfaces below split the method set of Client by area, so that code built on the library can depend on
+// only what it uses, substitute a mock (see the santricitymock package) in unit tests, or wrap the client with
+// decorators for caching, auditing or rate limiting. *Client implements all of them.
+
+// SystemAPI covers connectivity and storage system information.
+type SystemAPI interface {
+	AboutInfo(ctx context.Context) (*AboutResponse, error)
+	Connect(ctx context.Context) (string, error)
+	GetStorageSystem(ctx context.Context) (*StorageSystem, error)
+	GetChassisSerialNumber(ctx context.Context) (string, error)
+	GetFailures(ctx context.Context) ([]Failure, error)
+	GetCapabilities(ctx context.Context) (*Capabilities, error)
+	GetAuditLog(ctx context.Context, begin, end time.Time) ([]v11.AuditLogRecord, error)
+	Capabilities() *Capabilities
+}
+
+// ProxyAPI covers the storage systems known to the web services, for a Web Services Proxy managing many arrays.
+type ProxyAPI interface {
+	ListStorageSystems(ctx context.Context) ([]StorageSystem, error)
+	FindStorageSystem(ctx context.Context, selector string) (*StorageSystem, error)
+	RegisterStorageSystem(ctx context.Context, request StorageSystemRegisterRequest) (string, error)
+	UnregisterStorageSystem(ctx context.Context, id string) error
+}
+
+// PoolAPI covers storage pools (volume groups and disk pools).
+type PoolAPI interface {
+	GetVolumePools(ctx context.Context, mediaType string, minFreeSpaceBytes uint64, poolName string) (
+		[]VolumeGroupEx, error)
+	GetVolumePoolByRef(ctx context.Context, volumeGroupRef string) (VolumeGroupEx, error)
+	PoolAction(volumeGroupRef string) Operation
+}
+
+// VolumeAPI covers volumes, thick and thin, and their life cycle.
+type VolumeAPI interface {
+	GetVolumes(ctx context.Context) ([]VolumeEx, error)
+	ListVolumes(ctx context.Context) ([]string, error)
+	GetVolume(ctx context.Context, name string) (VolumeEx, error)
+	GetVolumeByRef(ctx context.Context, volumeRef string) (VolumeEx, error)
+	CreateVolume(ctx context.Context, name string, volumeGroupRef string, size uint64, mediaType, fstype string,
+		raidLevel string, blockSize int, segmentSize int, extraTags map[string]string) (VolumeEx, error)
+	UpdateVolume(ctx context.Context, volumeRef string, request VolumeUpdateRequest) (VolumeEx, error)
+	UpdateVolumeTags(ctx context.Context, volumeRef string, tags []VolumeTag) (VolumeEx, error)
+	ResizingVolume(ctx context.Context, volume VolumeEx) (bool, error)
+	ResizeVolume(ctx context.Context, volume VolumeEx, size uint64) error
+	ExpandVolume(ctx context.Context, volumeRef string, expansionSize int64) error
+	ExpandVolumeAndWait(ctx context.Context, volumeRef string, expansionSize int64, config WaitConfig) error
+	VolumeAction(volumeRef string) Operation
+	StartVolumeParityCheck(ctx context.Context, volumeRef string, request v11.CheckVolumeParityRequest) (string, error)
+	GetVolumeParityCheckJob(ctx context.Context, jobID string) (*ParityCheckJob, error)
+	ParityCheck(jobID string) Operation
+	CheckVolumeParityAndWait(ctx context.Context, volumeRef string, request v11.CheckVolumeParityRequest,
+		config WaitConfig) (*ParityCheckJob, error)
+	DeleteVolume(ctx context.Context, volume VolumeEx) error
+	CheckVolumeDependencies(ctx context.Context, volumeRef string) error
+	GetThinVolumes(ctx context.Context) ([]ThinVolume, error)
+	GetThinVolumeByRef(ctx context.Context, volumeRef string) (ThinVolume, error)
+	CreateThinVolume(ctx context.Context, name string, poolRef string, size uint64, fstype string,
+		options ThinVolumeOptions, extraTags map[string]string) (ThinVolume, error)
+	UpdateThinVolume(ctx context.Context, volumeRef string, request ThinVolumeUpdateRequest) (ThinVolume, error)
+	SetThinVolumeAlertThreshold(ctx context.Context, volumeRef string, percent int) (ThinVolume, error)
+	ExpandThinVolume(ctx context.Context, volumeRef string, newVirtualSize, newRepositorySize uint64) error
+	GetThinVolumeDefaults(ctx context.Context) (*v11.ThinVolumeDefaultsResponse, error)
+}
+
+// VolumeCopyAPI covers volume copy jobs and full-copy clones of volumes.
+type VolumeCopyAPI interface {
+	GetVolumeCopyJobs(ctx context.Context) ([]VolumeCopyJob, error)
+	GetVolumeCopyJob(ctx context.Context, jobRef string) (*VolumeCopyJob, error)
+	CreateVolumeCopyJob(ctx context.Context, request v11.VolumeCopyCreateRequest) (*VolumeCopyJob, error)
+	UpdateVolumeCopyJob(ctx context.Context, jobRef string, request v11.VolumeCopyUpdateRequest) (
+		*VolumeCopyJob, error)
+	SetVolumeCopyPriority(ctx context.Context, jobRef, priority string) (*VolumeCopyJob, error)
+	DeleteVolumeCopyJob(ctx context.Context, jobRef string, retainRepositories bool) error
+	GetVolumeCopyProgress(ctx context.Context, jobRef string) (*v11.VolumeCopyProgress, error)
+	StartVolumeCopyJob(ctx context.Context, jobRef string) error
+	StopVolumeCopyJob(ctx context.Context, jobRef string) error
+	VolumeCopy(jobRef string) Operation
+	CloneVolume(ctx context.Context, sourceRef, name, poolRef string, options CloneVolumeOptions) (
+		*VolumeCopyJob, error)
+	CloneVolumeAndWait(ctx context.Context, sourceRef, name, poolRef string, options CloneVolumeOptions,
+		config WaitConfig) (VolumeEx, error)
+}
+
+// AsyncMirrorAPI covers asynchronous mirror groups, their pairs, synchronization and roles.
+type AsyncMirrorAPI interface {
+	GetAsyncMirrorGroups(ctx context.Context) ([]AsyncMirrorGroup, error)
+	GetAsyncMirrorGroup(ctx context.Context, groupRef string) (*AsyncMirrorGroup, error)
+	CreateAsyncMirrorGroup(ctx context.Context, request v11.AsyncMirrorGroupCreateRequest) (*AsyncMirrorGroup, error)
+	UpdateAsyncMirrorGroup(ctx context.Context, groupRef string, request v11.AsyncMirrorGroupUpdateRequest) (
+		*AsyncMirrorGroup, error)
+	DeleteAsyncMirrorGroup(ctx context.Context, groupRef string) error
+	GetAsyncMirrorTargets(ctx context.Context, compatibleOnly bool) ([]v11.RemoteCandidate, error)
+	GetAsyncMirrorDefaults(ctx context.Context) (*v11.ArvmDefaultsResponse, error)
+	GetAsyncMirrorPairs(ctx context.Context, groupRef string) ([]AsyncMirrorPair, error)
+	GetAsyncMirrorPair(ctx context.Context, memberRef string) (*AsyncMirrorPair, error)
+	AddAsyncMirrorPair(ctx context.Context, groupRef string, request v11.AsyncMirrorGroupMemberCreateRequest) (
+		*AsyncMirrorPair, error)
+	RemoveAsyncMirrorPair(ctx context.Context, groupRef, memberRef string) error
+	GetIncompleteAsyncMirrorPairs(ctx context.Context, groupRef string) ([]v11.AmgIncompleteMember, error)
+	CompleteAsyncMirrorPair(ctx context.Context, memberRef string,
+		request v11.AsyncMirrorGroupMemberCompletionRequest) (*AsyncMirrorPair, error)
+	RemoveIncompleteAsyncMirrorPair(ctx context.Context, memberRef string) error
+	GetAsyncMirrorRepositoryUtilization(ctx context.Context, memberRef string) (
+		*v11.AsyncMirrorRepositoryUtilization, error)
+	SyncAsyncMirrorGroup(ctx context.Context, groupRef string, deleteRecoveryPointIfNecessary bool) error
+	SuspendAsyncMirrorGroup(ctx context.Context, groupRef string) error
+	ResumeAsyncMirrorGroup(ctx context.Context, groupRef string, deleteRecoveryPointIfNecessary bool) error
+	SetAsyncMirrorGroupRole(ctx context.Context, groupRef string, request v11.AsyncMirrorGroupRoleUpdateRequest) (
+		*AsyncMirrorGroup, error)
+	GetAsyncMirrorProgress(ctx context.Context, groupRef string) (*v11.AsyncMirrorGroupSyncProgress, error)
+	GetAsyncMirrorConnections(ctx context.Context, groupRef string) (*v11.AsyncMirrorGroupConnectionsResponse, error)
+	TestAsyncMirrorGroup(ctx context.Context, groupRef string, request v11.AsyncMirrorGroupConnectivityTestRequest) (
+		[]v11.AsyncMirrorGroupCommunicationData, error)
+	GetAsyncMirrorStatus(ctx context.Context, groupRef string) (*MirrorStatus, error)
+	AsyncMirrorSync(groupRef string) Operation
+	SyncAsyncMirrorGroupAndWait(ctx context.Context, groupRef string, config WaitConfig) error
+}
+
+// RemoteMirrorAPI covers remote (synchronous) mirror pairs, their synchronization, write mode and roles.
+type RemoteMirrorAPI interface {
+	GetRemoteMirrorPairs(ctx context.Context) ([]RemoteMirrorPair, error)
+	GetRemoteMirrorPair(ctx context.Context, pairID string) (*RemoteMirrorPair, error)
+	GetRemoteMirrorCandidates(ctx context.Context, volumeRef string) ([]v11.RemoteMirrorCandidate, error)
+	CreateRemoteMirrorPair(ctx context.Context, request v11.RemoteVolumeMirrorCreateRequest) (*RemoteMirrorPair, error)
+	DeleteRemoteMirrorPair(ctx context.Context, pairID string) error
+	UpdateRemoteMirrorPair(ctx context.Context, pairID string, request v11.RemoteVolumeMirrorUpdateRequest) (
+		*RemoteMirrorPair, error)
+	SuspendRemoteMirrorPair(ctx context.Context, pairID string) (*RemoteMirrorPair, error)
+	ResumeRemoteMirrorPair(ctx context.Context, pairID string) (*RemoteMirrorPair, error)
+	ResyncRemoteMirrorPair(ctx context.Context, pairID string) (*RemoteMirrorPair, error)
+	SetRemoteMirrorRole(ctx context.Context, pairID string, role MirrorRole) (*RemoteMirrorPair, error)
+	SetRemoteMirrorWriteMode(ctx context.Context, pairID string, writeMode int) (*RemoteMirrorPair, error)
+	TestRemoteMirrorCommunication(ctx context.Context, pairID string) (*v11.RemoteCommunicationData, error)
+	GetRemoteMirrorSyncProgress(ctx context.Context, pairID string) (*v11.JobProgress, error)
+	GetRemoteMirrorStatus(ctx context.Context, pairID string) (*MirrorStatus, error)
+	RemoteMirrorSync(pairID string) Operation
+}
+
+// HostAPI covers hosts, host types and host groups.
+type HostAPI interface {
+	GetHosts(ctx context.Context) ([]Host, error)
+	GetHostByRef(ctx context.Context, hostRef string) (HostEx, error)
+	GetHostForPort(ctx context.Context, portID string) (HostEx, error)
+	EnsureHostForIQN(ctx context.Context, iqn string) (HostEx, error)
+	EnsureHostForNQN(ctx context.Context, nqn string) (HostEx, error)
+	EnsureHostForPort(ctx context.Context, portID, portType string) (HostEx, error)
+	CreateHost(ctx context.Context, name, portID, portType, hostType, authSecret string, hostGroup HostGroup) (
+		HostEx, error)
+	UpdateHost(ctx context.Context, hostRef string, request HostUpdateRequest) (HostEx, error)
+	DeleteHost(ctx context.Context, hostRef string) error
+	GetBestIndexForHostType(ctx context.Context, hostType string) int
+	EnsureHostGroup(ctx context.Context) (HostGroup, error)
+	GetHostGroups(ctx context.Context) ([]HostGroup, error)
+	GetHostGroup(ctx context.Context, name string) (HostGroup, error)
+	GetHostGroupByRef(ctx context.Context, hostGroupRef string) (HostGroup, error)
+	CreateHostGroup(ctx context.Context, name string) (HostGroup, error)
+	DeleteHostGroup(ctx context.Context, hostGroupRef string) error
+}
+
+// MappingAPI covers LUN mappings of volumes to hosts and host groups.
+type MappingAPI interface {
+	MapVolume(ctx context.Context, volume VolumeEx, host HostEx, lun int) (LUNMapping, error)
+	UnmapVolume(ctx context.Context, volume VolumeEx) error
+	CreateVolumeMapping(ctx context.Context, request VolumeMappingCreateRequest) (*LUNMapping, error)
+	GetVolumeMappings(ctx context.Context) ([]LUNMapping, error)
+}
+
+// SnapshotAPI covers snapshot groups, snapshot images, snapshot volumes and their repositories.
+type SnapshotAPI interface {
+	GetSnapshotGroups(ctx context.Context) ([]SnapshotGroup, error)
+	GetSnapshotGroup(ctx context.Context, id string) (*SnapshotGroup, error)
+	CreateSnapshotGroup(ctx context.Context, request SnapshotGroupCreateRequest) (*SnapshotGroup, error)
+	UpdateSnapshotGroup(ctx context.Context, id string, request v11.SnapshotGroupUpdateRequest) (*SnapshotGroup, error)
+	DeleteSnapshotGroup(ctx context.Context, id string) error
+	GetSnapshotImages(ctx context.Context) ([]SnapshotImage, error)
+	GetSnapshotImage(ctx context.Context, id string) (*SnapshotImage, error)
+	CreateSnapshotImage(ctx context.Context, request SnapshotImageCreateRequest) (*SnapshotImage, error)
+	DeleteSnapshotImage(ctx context.Context, id string) error
+	RollbackSnapshotImage(ctx context.Context, imageRef string) error
+	GetSnapshotVolumes(ctx context.Context) ([]SnapshotVolume, error)
+	GetSnapshotVolume(ctx context.Context, id string) (*SnapshotVolume, error)
+	CreateSnapshotVolume(ctx context.Context, request SnapshotVolumeCreateRequest) (*SnapshotVolume, error)
+	DeleteSnapshotVolume(ctx context.Context, id string) error
+	GetConcatRepositoryVolumes(ctx context.Context) ([]ConcatRepositoryVolume, error)
+	GetConcatRepositoryVolume(ctx context.Context, id string) (*ConcatRepositoryVolume, error)
+}
+
+// ConsistencyGroupAPI covers consistency groups with their members, snapshots and views.
+type ConsistencyGroupAPI interface {
+	GetConsistencyGroup(ctx context.Context, id string) (*ConsistencyGroup, error)
+	CreateConsistencyGroup(ctx context.Context, request ConsistencyGroupCreateRequest) (*ConsistencyGroup, error)
+	UpdateConsistencyGroup(ctx context.Context, id string, request v11.ConsistencyGroupUpdateRequest) (
+		*ConsistencyGroup, error)
+	DeleteConsistencyGroup(ctx context.Context, cgID string) error
+	GetConsistencyGroupMember(ctx context.Context, cgID string, volumeID string) (*ConsistencyGroupMember, error)
+	AddConsistencyGroupMember(ctx context.Context, cgID string, request ConsistencyGroupMemberAddRequest) (
+		*ConsistencyGroupMember, error)
+	RemoveConsistencyGroupMember(ctx context.Context, cgID string, memberVolumeID string) error
+	GetConsistencyGroupSnapshot(ctx context.Context, cgID string, sequenceNumber string) ([]SnapshotImage, error)
+	CreateConsistencyGroupSnapshot(ctx context.Context, cgID string) ([]SnapshotImage, error)
+	DeleteConsistencyGroupSnapshot(ctx context.Context, cgID string, sequenceNumber string) error
+	GetConsistencyGroupView(ctx context.Context, cgID string, viewID string) (*ConsistencyGroupView, error)
+	CreateConsistencyGroupView(ctx context.Context, cgID string, request ConsistencyGroupViewCreateRequest) (
+		*ConsistencyGroupView, error)
+	DeleteConsistencyGroupView(ctx context.Context, cgID string, viewID string) error
+}
+
+// SnapshotScheduleAPI covers the schedules on which the array takes snapshot images of snapshot groups and
+// consistency groups. The array creates a schedule with a new snapshot group and deletes it with the group; schedules
+// cannot be changed or deleted on their own.
+type SnapshotScheduleAPI interface {
+	GetSnapshotSchedules(ctx context.Context) ([]SnapshotSchedule, error)
+	GetSnapshotSchedule(ctx context.Context, id string) (*SnapshotSchedule, error)
+	CreateSnapshotSchedule(ctx context.Context, group SnapshotGroupCreateRequest, config SnapshotScheduleConfig) (
+		*SnapshotSchedule, error)
+	UpdateSnapshotSchedule(ctx context.Context, id string, config SnapshotScheduleConfig) (*SnapshotSchedule, error)
+	DeleteSnapshotSchedule(ctx context.Context, id string) error
+	GetSnapshotScheduleRetention(ctx context.Context, schedule *SnapshotSchedule) (int, error)
+}
+
+// TargetSettingsAPI covers the array's iSCSI and NVMe-oF target settings.
+type TargetSettingsAPI interface {
+	GetTargetIQN(ctx context.Context) (string, error)
+	GetTargetSettings(ctx context.Context) (*IscsiTargetSettings, error)
+	GetNVMeoFSettings(ctx context.Context) (*NvmeofTargetSettings, error)
+}
+
+// InventoryAPI covers the index and graph of the array's configuration and the client-side inventory cache.
+type InventoryAPI interface {
+	GetInventory(ctx context.Context) (*InventoryIndex, error)
+	RefreshInventory(ctx context.Context) (*InventoryIndex, error)
+	InvalidateInventory(objectTypes ...InventoryType)
+	GetConfigGraph(ctx context.Context) (*ConfigGraph, error)
+}
+
+// API is the complete method set of Client.
+type API interface {
+	SystemAPI
+	ProxyAPI
+	PoolAPI
+	VolumeAPI
+	VolumeCopyAPI
+	AsyncMirrorAPI
+	RemoteMirrorAPI
+	HostAPI
+	MappingAPI
+	SnapshotAPI
+	ConsistencyGroupAPI
+	SnapshotScheduleAPI
+	TargetSettingsAPI
+	InventoryAPI
+
+	InvokeAPI(ctx context.Context, requestBody []byte, method string, resourcePath string) (
+		*http.Response, []byte, error)
+	ControllerStatus() []ControllerHealth
+	ActiveController() string
+	IsRefValid(ref string) bool
+	SetIncludeRepositoryVolumes(include bool)
+	Plan() []PlannedCall
+	ResetPlan()
+	Close() error
+}
+
+var _ API = (*Client)(nil)
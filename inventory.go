@@ -0,0 +1,475 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// The web services have little server-side filtering, so finding a host by port or a volume by name means
+// downloading the whole list. With ClientConfig.Cache set, the lists below are kept for a while and served from
+// memory. Any change the client makes to the array drops the lists it may affect; changes made by others are
+// only seen when the cached lists expire or are invalidated with InvalidateInventory.
+
+// InventoryType is a kind of object list kept by the inventory cache, named after its resource path.
+type InventoryType string
+
+const (
+	InventoryVolumes         InventoryType = "volumes"
+	InventoryThinVolumes     InventoryType = "thin-volumes"
+	InventoryPools           InventoryType = "storage-pools"
+	InventoryHosts           InventoryType = "hosts"
+	InventoryHostGroups      InventoryType = "host-groups"
+	InventoryMappings        InventoryType = "volume-mappings"
+	InventorySnapshotGroups  InventoryType = "snapshot-groups"
+	InventorySnapshotImages  InventoryType = "snapshot-images"
+	InventorySnapshotVolumes InventoryType = "snapshot-volumes"
+	InventoryGraph           InventoryType = "graph" // The object graph with the whole configuration
+)
+
+// inventoryTypes are the cached resources.
+var inventoryTypes = []InventoryType{
+	InventoryVolumes, InventoryThinVolumes, InventoryPools, InventoryHosts, InventoryHostGroups, InventoryMappings,
+	InventorySnapshotGroups, InventorySnapshotImages, InventorySnapshotVolumes, InventoryGraph,
+}
+
+// inventoryDependents lists, by the first element of a resource path, the cached lists that a change to that
+// resource may affect. The object graph is always affected, and changes to other resources drop everything.
+var inventoryDependents = map[string][]InventoryType{
+	"volumes": {InventoryVolumes, InventoryPools, InventoryMappings, InventorySnapshotGroups,
+		InventorySnapshotImages, InventorySnapshotVolumes},
+	"thin-volumes": {InventoryThinVolumes, InventoryVolumes, InventoryPools, InventoryMappings,
+		InventorySnapshotGroups, InventorySnapshotImages, InventorySnapshotVolumes},
+	"storage-pools":   {InventoryPools, InventoryVolumes, InventoryThinVolumes},
+	"hosts":           {InventoryHosts, InventoryHostGroups, InventoryMappings},
+	"host-groups":     {InventoryHostGroups, InventoryHosts, InventoryMappings},
+	"volume-mappings": {InventoryMappings, InventoryVolumes, InventoryThinVolumes, InventoryHosts, InventoryHostGroups},
+	"snapshot-groups": {InventorySnapshotGroups, InventorySnapshotImages, InventorySnapshotVolumes,
+		InventoryVolumes, InventoryThinVolumes, InventoryPools},
+	"snapshot-images": {InventorySnapshotGroups, InventorySnapshotImages, InventorySnapshotVolumes,
+		InventoryVolumes, InventoryThinVolumes, InventoryPools},
+	"snapshot-volumes": {InventorySnapshotGroups, InventorySnapshotImages, InventorySnapshotVolumes,
+		InventoryVolumes, InventoryThinVolumes, InventoryPools, InventoryMappings},
+	"volume-copy-jobs": {InventoryVolumes, InventoryThinVolumes, InventoryPools, InventorySnapshotGroups,
+		InventorySnapshotImages},
+	"volume-copy-jobs-control": {InventoryVolumes, InventoryThinVolumes},
+	"async-mirrors":            {InventoryVolumes, InventoryThinVolumes, InventoryPools},
+	"remote-mirror-pairs":      {InventoryVolumes, InventoryThinVolumes},
+	"snapshot-schedules":       {},
+}
+
+// CacheConfig configures the inventory cache of a client. Zero values select the defaults.
+type CacheConfig struct {
+	TTL time.Duration // How long cached lists are used; default DefaultCacheTTL
+
+	// TTLs overrides TTL for some object types. A negative value disables caching for the type.
+	TTLs map[InventoryType]time.Duration
+}
+
+// inventoryCache holds the cached lists of a client. Clients derived for other storage systems have their own.
+type inventoryCache struct {
+	config  CacheConfig
+	m       sync.Mutex
+	entries map[InventoryType]*inventoryEntry
+
+	// Incremented when a type is invalidated, so that a list read while the array was being changed is not
+	// stored.
+	generations map[InventoryType]uint64
+}
+
+type inventoryEntry struct {
+	body    []byte
+	expires time.Time
+	index   *InventoryIndex // Index built from the object graph, once asked for
+}
+
+func newInventoryCache(config *CacheConfig) *inventoryCache {
+	if config == nil {
+		return nil
+	}
+	cache := &inventoryCache{
+		config:      *config,
+		entries:     make(map[InventoryType]*inventoryEntry),
+		generations: make(map[InventoryType]uint64),
+	}
+	if cache.config.TTL <= 0 {
+		cache.config.TTL = DefaultCacheTTL
+	}
+	return cache
+}
+
+// ttl returns how long lists of a type are kept, or 0 if they are not cached.
+func (c *inventoryCache) ttl(objectType InventoryType) time.Duration {
+	ttl, ok := c.config.TTLs[objectType]
+	if !ok {
+		return c.config.TTL
+	}
+	if ttl < 0 {
+		return 0
+	}
+	return durationOrDefault(ttl, c.config.TTL)
+}
+
+// get returns the cached entry of a type, or nil if there is none or it expired.
+func (c *inventoryCache) get(objectType InventoryType) *inventoryEntry {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	entry := c.entries[objectType]
+	if entry == nil || time.Now().After(entry.expires) {
+		return nil
+	}
+	return entry
+}
+
+// generation returns the current generation of a type, to be passed to put.
+func (c *inventoryCache) generation(objectType InventoryType) uint64 {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.generations[objectType]
+}
+
+// put stores a list read from the array, unless the type was invalidated since the read started.
+func (c *inventoryCache) put(objectType InventoryType, generation uint64, body []byte) *inventoryEntry {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	entry := &inventoryEntry{body: body, expires: time.Now().Add(c.ttl(objectType))}
+	if c.generations[objectType] == generation {
+		c.entries[objectType] = entry
+	}
+	return entry
+}
+
+// setIndex stores the index built from a cached object graph.
+func (c *inventoryCache) setIndex(entry *inventoryEntry, index *InventoryIndex) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	entry.index = index
+}
+
+// invalidate drops the cached lists of the given types, or of all types if none are given.
+func (c *inventoryCache) invalidate(objectTypes ...InventoryType) {
+	if len(objectTypes) == 0 {
+		objectTypes = inventoryTypes
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	for _, objectType := range objectTypes {
+		delete(c.entries, objectType)
+		c.generations[objectType]++
+	}
+}
+
+// invalidatePath drops the cached lists that a change to the given resource may affect.
+func (c *inventoryCache) invalidatePath(resourcePath string) {
+	resource, _, _ := strings.Cut(strings.Trim(resourcePath, "/"), "/")
+	dependents, ok := inventoryDependents[resource]
+	if !ok {
+		c.invalidate()
+		return
+	}
+	c.invalidate(append(dependents, InventoryGraph)...)
+}
+
+// cachedInvokeAPI is InvokeAPI for clients with an inventory cache. Reads of cached lists are answered from the
+// cache if possible, and any other method drops the lists that the change may affect, before and after the call
+// so that a concurrent read cannot store what the array returned while it was being changed.
+func (d Client) cachedInvokeAPI(
+	ctx context.Context, requestBody []byte, method string, resourcePath string,
+) (*http.Response, []byte, error) {
+
+	arrayID, err := d.resolveArrayID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if method != http.MethodGet {
+		d.inventory.invalidatePath(resourcePath)
+		defer d.inventory.invalidatePath(resourcePath)
+		return d.invokeAPI(ctx, requestBody, method, arrayID, resourcePath)
+	}
+
+	objectType := InventoryType(strings.TrimPrefix(resourcePath, "/"))
+	if !slices.Contains(inventoryTypes, objectType) || d.inventory.ttl(objectType) == 0 {
+		return d.invokeAPI(ctx, requestBody, method, arrayID, resourcePath)
+	}
+
+	if entry := d.inventory.get(objectType); entry != nil {
+		Logc(ctx).WithField("Type", objectType).Trace("Inventory cache hit.")
+		return cachedResponse(), entry.body, nil
+	}
+
+	generation := d.inventory.generation(objectType)
+	response, responseBody, err := d.invokeAPI(ctx, requestBody, method, arrayID, resourcePath)
+	if err == nil && response.StatusCode == http.StatusOK {
+		d.inventory.put(objectType, generation, responseBody)
+	}
+	return response, responseBody, err
+}
+
+// cachedResponse returns the response given to callers for a list served from the cache.
+func cachedResponse() *http.Response {
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       http.NoBody,
+	}
+}
+
+// InvalidateInventory drops cached lists of the given types, or all of them if no types are given, so that the
+// next read goes to the array. Use it after the array was changed by other means than this client. It does
+// nothing if the client has no cache.
+func (d Client) InvalidateInventory(objectTypes ...InventoryType) {
+	if d.inventory != nil {
+		d.inventory.invalidate(objectTypes...)
+	}
+}
+
+// InventoryObject is an object of the array's configuration, as found in the object graph.
+type InventoryObject struct {
+	Type  string          `json:"type"` // Name of the object list in the graph, e.g. "volume", "host" or "pit"
+	Ref   string          `json:"ref"`
+	Label string          `json:"label,omitempty"`
+	WWN   string          `json:"wwn,omitempty"`
+	Raw   json.RawMessage `json:"object"`
+}
+
+// Decode parses the object into one of the library's types, such as VolumeEx for a volume or HostEx for a host.
+func (o InventoryObject) Decode(value interface{}) error {
+	return json.Unmarshal(o.Raw, value)
+}
+
+// InventoryIndex finds the objects of an array's configuration by ref, WWN or label.
+type InventoryIndex struct {
+	objects []InventoryObject
+	byRef   map[string]int
+	byWWN   map[string]int
+	byLabel map[string][]int
+}
+
+// inventoryRefFields names the ref field of the graph objects that have no "id" and whose ref field is not
+// named after the object list.
+var inventoryRefFields = map[string]string{
+	"pitView":             "viewRef",
+	"pitConsistencyGroup": "cgRef",
+	"concatVolume":        "concatVolRef",
+	"thinVolume":          "volumeRef",
+	"asyncMirrorGroup":    "groupRef",
+	"consistencyGroup":    "groupRef",
+	"remoteTargetGroups":  "groupRef",
+	"remoteTargetVolumes": "remoteTargetVolRef",
+	"mirrorVol":           "mirrorProxyRef",
+	"remoteVol":           "remoteVolRef",
+	"ghostVol":            "volumeRef",
+}
+
+// newInventoryIndex indexes the objects of an object graph, including those in nested bundles.
+func newInventoryIndex(graph []byte) (*InventoryIndex, error) {
+
+	var bundle map[string]json.RawMessage
+	if err := json.Unmarshal(graph, &bundle); err != nil {
+		return nil, fmt.Errorf("could not parse object graph: %v", err)
+	}
+
+	index := &InventoryIndex{
+		byRef:   make(map[string]int),
+		byWWN:   make(map[string]int),
+		byLabel: make(map[string][]int),
+	}
+	if err := index.addBundle(bundle); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+func (x *InventoryIndex) addBundle(bundle map[string]json.RawMessage) error {
+
+	keys := make([]string, 0, len(bundle))
+	for key := range bundle {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		raw := bundle[key]
+		switch trimmed := strings.TrimSpace(string(raw)); {
+		case strings.HasPrefix(trimmed, "["):
+			var items []json.RawMessage
+			if err := json.Unmarshal(raw, &items); err != nil {
+				return fmt.Errorf("could not parse %s objects in object graph: %v", key, err)
+			}
+			for _, item := range items {
+				x.add(key, item)
+			}
+		case strings.HasPrefix(trimmed, "{") && strings.HasSuffix(key, "Bundle"):
+			var nested map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &nested); err != nil {
+				return fmt.Errorf("could not parse %s in object graph: %v", key, err)
+			}
+			if err := x.addBundle(nested); err != nil {
+				return err
+			}
+		case strings.HasPrefix(trimmed, "{"):
+			x.add(key, raw)
+		}
+	}
+	return nil
+}
+
+func (x *InventoryIndex) add(objectType string, raw json.RawMessage) {
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return
+	}
+	field := func(names ...string) string {
+		for _, name := range names {
+			if value, ok := fields[name].(string); ok && value != "" {
+				return value
+			}
+		}
+		return ""
+	}
+
+	refField := inventoryRefFields[objectType]
+	if refField == "" {
+		refField = objectType + "Ref"
+	}
+	object := InventoryObject{
+		Type:  objectType,
+		Ref:   field("id", refField),
+		Label: field("label", "name"),
+		WWN:   field("worldWideName", "wwn"),
+		Raw:   raw,
+	}
+
+	i := len(x.objects)
+	x.objects = append(x.objects, object)
+	if _, ok := x.byRef[object.Ref]; object.Ref != "" && !ok {
+		x.byRef[object.Ref] = i
+	}
+	if _, ok := x.byWWN[strings.ToUpper(object.WWN)]; object.WWN != "" && !ok {
+		x.byWWN[strings.ToUpper(object.WWN)] = i
+	}
+	if object.Label != "" {
+		x.byLabel[object.Label] = append(x.byLabel[object.Label], i)
+	}
+}
+
+// Len returns the number of objects in the index.
+func (x *InventoryIndex) Len() int {
+	return len(x.objects)
+}
+
+// ByRef returns the object with the given ref.
+func (x *InventoryIndex) ByRef(ref string) (InventoryObject, bool) {
+	i, ok := x.byRef[ref]
+	if !ok {
+		return InventoryObject{}, false
+	}
+	return x.objects[i], true
+}
+
+// ByWWN returns the object with the given world wide name, ignoring case.
+func (x *InventoryIndex) ByWWN(wwn string) (InventoryObject, bool) {
+	i, ok := x.byWWN[strings.ToUpper(wwn)]
+	if !ok {
+		return InventoryObject{}, false
+	}
+	return x.objects[i], true
+}
+
+// ByLabel returns the objects with the given label or name, optionally only those of the given types. Labels are
+// only unique among objects of the same type.
+func (x *InventoryIndex) ByLabel(label string, objectTypes ...string) []InventoryObject {
+	var objects []InventoryObject
+	for _, i := range x.byLabel[label] {
+		if len(objectTypes) == 0 || slices.Contains(objectTypes, x.objects[i].Type) {
+			objects = append(objects, x.objects[i])
+		}
+	}
+	return objects
+}
+
+// Objects returns the objects of a type, e.g. "volume", in the order of the object graph.
+func (x *InventoryIndex) Objects(objectType string) []InventoryObject {
+	var objects []InventoryObject
+	for _, object := range x.objects {
+		if object.Type == objectType {
+			objects = append(objects, object)
+		}
+	}
+	return objects
+}
+
+// GetInventory returns an index of all objects of the array, read from its object graph in one request. With
+// ClientConfig.Cache set, the graph and its index are kept like the other cached lists.
+func (d Client) GetInventory(ctx context.Context) (*InventoryIndex, error) {
+	ctx, span := d.startSpan(ctx, "GetInventory")
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "GetInventory",
+			"Type":   "Client",
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetInventory")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetInventory")
+	}
+
+	if d.inventory != nil {
+		if entry := d.inventory.get(InventoryGraph); entry != nil && entry.index != nil {
+			return entry.index, nil
+		}
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/"+string(InventoryGraph))
+	if err != nil {
+		return nil, fmt.Errorf("could not read object graph: %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not read object graph")
+	}
+
+	index, err := newInventoryIndex(responseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.inventory != nil {
+		if entry := d.inventory.get(InventoryGraph); entry != nil {
+			d.inventory.setIndex(entry, index)
+		}
+	}
+
+	Logc(ctx).WithField("Objects", index.Len()).Debug("Indexed object graph.")
+
+	return index, nil
+}
+
+// RefreshInventory drops all cached lists and reads the object graph again.
+func (d Client) RefreshInventory(ctx context.Context) (*InventoryIndex, error) {
+	ctx, span := d.startSpan(ctx, "RefreshInventory")
+	defer span.End()
+
+	d.InvalidateInventory()
+	return d.GetInventory(ctx)
+}
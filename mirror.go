@@ -0,0 +1,101 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Asynchronous mirror groups and synchronous mirror pairs report their condition in different terms. MirrorStatus
+// describes both the same way, so that monitoring and failover code does not need to know which kind of mirror it
+// is looking at; the value reported by the array is kept in ArrayState.
+
+// MirrorRole is the role of an array in a mirror relationship.
+type MirrorRole string
+
+const (
+	MirrorRolePrimary   MirrorRole = "primary"   // Hosts write to the volumes of this array
+	MirrorRoleSecondary MirrorRole = "secondary" // This array receives the writes of the primary
+	MirrorRoleUnknown   MirrorRole = "unknown"
+)
+
+// MirrorState is the condition of a mirror relationship.
+type MirrorState string
+
+const (
+	MirrorStateOptimal       MirrorState = "optimal"       // Mirrored data is current, up to the last interval
+	MirrorStateSynchronizing MirrorState = "synchronizing" // Data is being copied to the secondary
+	MirrorStateSuspended     MirrorState = "suspended"     // Mirroring was stopped; changes are tracked for a resync
+	MirrorStateDegraded      MirrorState = "degraded"      // Mirroring is impaired, e.g. the arrays cannot connect
+	MirrorStateFailed        MirrorState = "failed"        // The secondary does not hold a usable copy
+	MirrorStateUnknown       MirrorState = "unknown"
+)
+
+// Kinds of mirror reported in MirrorStatus.Type
+const (
+	MirrorTypeAsync = "async"
+	MirrorTypeSync  = "sync"
+)
+
+// MirrorStatus describes a mirror relationship as seen from one of its arrays.
+type MirrorStatus struct {
+	Type              string      `json:"type"` // MirrorTypeAsync or MirrorTypeSync
+	Ref               string      `json:"ref"`  // Ref of the mirror group or ID of the mirror pair on this array
+	Name              string      `json:"name"`
+	LocalRole         MirrorRole  `json:"localRole"`
+	RemoteRole        MirrorRole  `json:"remoteRole"`
+	State             MirrorState `json:"state"`
+	ArrayState        string      `json:"arrayState"` // State as reported by the array, e.g. "initialSync"
+	RoleChangePending bool        `json:"roleChangePending"`
+
+	// Progress of a running synchronization
+	PercentComplete int           `json:"percentComplete"`
+	TimeRemaining   time.Duration `json:"timeRemaining"`
+
+	// Asynchronous mirrors only: age of the oldest recovery point of the pairs, and the highest utilization of
+	// their repositories in percent. RecoveryPointAge is 0 until every pair has a recovery point.
+	RecoveryPointAge      time.Duration `json:"recoveryPointAge,omitempty"`
+	RepositoryUtilization int           `json:"repositoryUtilization,omitempty"`
+}
+
+// mirrorRole converts a role reported by the array.
+func mirrorRole(role string) MirrorRole {
+	switch role {
+	case "primary":
+		return MirrorRolePrimary
+	case "secondary":
+		return MirrorRoleSecondary
+	}
+	return MirrorRoleUnknown
+}
+
+// MirrorPeers drives both arrays of mirror relationships. Local is the array the mirrors are created on, which is
+// primary in normal operation; Remote is the array they are mirrored to. The clients may connect to the embedded
+// web services of each array, or be derived from the client of a Web Services Proxy that manages both, as with
+// NewMirrorPeersFromProxy.
+type MirrorPeers struct {
+	Local  *Client
+	Remote *Client
+}
+
+// NewMirrorPeersFromProxy returns the MirrorPeers of two storage systems managed by the web services a client is
+// connected to, selected like ClientConfig.ArraySelector.
+func NewMirrorPeersFromProxy(ctx context.Context, client *Client, localSelector, remoteSelector string) (
+	*MirrorPeers, error,
+) {
+	local, err := client.ForStorageSystem(ctx, localSelector)
+	if err != nil {
+		return nil, err
+	}
+	remote, err := client.ForStorageSystem(ctx, remoteSelector)
+	if err != nil {
+		return nil, err
+	}
+	if local.arrayID() == remote.arrayID() {
+		return nil, fmt.Errorf("storage systems %s and %s are the same: %w", localSelector, remoteSelector,
+			ErrInvalidArgument)
+	}
+	return &MirrorPeers{Local: local, Remote: remote}, nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// The response types in types.go embed the generated v11 model of their API definition, so every property the
+// array returns is available (e.g. volume.CacheSettings or pool.Usage) next to the hand-written fields. Where a
+// hand-written field has the same JSON name as a model field, encoding/json would only fill the hand-written
+// one, so these types decode a response once into the model and derive their hand-written fields from it.
+
+// unmarshalModel decodes data into model, which is the embedded model of v or a struct embedding it, and then
+// derives the hand-written fields of v from the model fields of the same JSON name. A type mismatch between the
+// model and the response means the array no longer matches the specification, and is returned rather than
+// leaving the affected field empty.
+func unmarshalModel(data []byte, v, model any) error {
+
+	if err := json.Unmarshal(data, model); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return fmt.Errorf("response does not match the %T model: %w", v, err)
+		}
+		return err
+	}
+	deriveFields(reflect.ValueOf(v).Elem(), reflect.ValueOf(model).Elem())
+	return nil
+}
+
+// deriveFields sets the fields of the struct dst from the fields of the struct src with the same JSON name.
+// Fields of the same type are copied, structs and slices of different types (e.g. a hand-written type and its
+// model) are derived field by field. Embedded structs of dst are taken from a src field of the same type, or
+// derived from src itself.
+func deriveFields(dst, src reflect.Value) {
+
+	byName := make(map[string]reflect.Value)
+	byType := make(map[reflect.Type]reflect.Value)
+	collectFields(src, byName, byType)
+
+	for i := 0; i < dst.NumField(); i++ {
+		field := dst.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if field.Anonymous {
+			if value, ok := byType[field.Type]; ok {
+				dst.Field(i).Set(value)
+			} else if field.Type.Kind() == reflect.Struct {
+				deriveFields(dst.Field(i), src)
+			}
+			continue
+		}
+		if value, ok := byName[jsonName(field)]; ok {
+			deriveValue(dst.Field(i), value)
+		}
+	}
+}
+
+// collectFields indexes the fields of the struct src by JSON name and its embedded structs by type. Outer fields
+// take precedence over those of embedded structs, as in encoding/json.
+func collectFields(src reflect.Value, byName map[string]reflect.Value, byType map[reflect.Type]reflect.Value) {
+
+	if _, ok := byType[src.Type()]; !ok {
+		byType[src.Type()] = src
+	}
+	var embedded []reflect.Value
+	for i := 0; i < src.NumField(); i++ {
+		field := src.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			embedded = append(embedded, src.Field(i))
+			continue
+		}
+		if _, ok := byName[jsonName(field)]; !ok {
+			byName[jsonName(field)] = src.Field(i)
+		}
+	}
+	for _, value := range embedded {
+		collectFields(value, byName, byType)
+	}
+}
+
+// deriveValue sets dst from a model value src of the same JSON property.
+func deriveValue(dst, src reflect.Value) {
+
+	switch {
+	case src.Type() == dst.Type():
+		dst.Set(src)
+	case src.Kind() == reflect.Struct && dst.Kind() == reflect.Struct:
+		deriveFields(dst, src)
+	case src.Kind() == reflect.Slice && dst.Kind() == reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.MakeSlice(dst.Type(), src.Len(), src.Len()))
+		for i := 0; i < src.Len(); i++ {
+			deriveValue(dst.Index(i), src.Index(i))
+		}
+	case src.Kind() == dst.Kind() && src.Type().ConvertibleTo(dst.Type()):
+		dst.Set(src.Convert(dst.Type()))
+	}
+}
+
+// jsonName returns the JSON property name of a struct field.
+func jsonName(field reflect.StructField) string {
+	if name, _, _ := strings.Cut(field.Tag.Get("json"), ","); name != "" {
+		return name
+	}
+	return field.Name
+}
+
+// UnmarshalJSON also reads bootTime, which the StorageSystem definition lacks.
+func (s *StorageSystem) UnmarshalJSON(data []byte) error {
+	var response struct {
+		v11.StorageSystemResponse
+		BootTime string `json:"bootTime"`
+	}
+	return unmarshalModel(data, s, &response)
+}
+
+func (p *VolumeGroupEx) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, p, &p.VolumeGroupEx)
+}
+
+func (v *VolumeEx) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, v, &v.VolumeEx)
+}
+
+// UnmarshalJSON decodes the volume model together with the thin provisioning details, which VolumeEx lacks.
+func (t *ThinVolume) UnmarshalJSON(data []byte) error {
+	var response struct {
+		v11.VolumeEx
+		ThinVolumeDetails
+	}
+	return unmarshalModel(data, t, &response)
+}
+
+func (h *HostEx) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, h, &h.HostEx)
+}
+
+func (h *Host) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, h, &h.HostEx)
+}
+
+func (g *HostGroup) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, g, &g.HostGroup)
+}
+
+func (m *LUNMapping) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, m, &m.LUNMapping)
+}
+
+func (g *SnapshotGroup) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, g, &g.SnapshotGroup)
+}
+
+func (i *SnapshotImage) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, i, &i.Snapshot)
+}
+
+func (v *SnapshotVolume) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, v, &v.PitViewEx)
+}
+
+func (g *ConsistencyGroup) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, g, &g.PITConsistencyGroup)
+}
+
+func (m *ConsistencyGroupMember) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, m, &m.PITCGMember)
+}
+
+func (v *ConsistencyGroupView) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, v, &v.PITConsistencyGroupView)
+}
+
+// UnmarshalJSON also reads name and memberNames, which the ConcatRepositoryVolume definition lacks.
+func (v *ConcatRepositoryVolume) UnmarshalJSON(data []byte) error {
+	var response struct {
+		v11.ConcatRepositoryVolume
+		Name        string `json:"name"`
+		MemberNames string `json:"memberNames"`
+	}
+	return unmarshalModel(data, v, &response)
+}
+
+func (s *SnapshotSchedule) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, s, &s.ScheduleInstance)
+}
+
+func (j *VolumeCopyJob) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, j, &j.VolumeCopyPair)
+}
+
+func (g *AsyncMirrorGroup) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, g, &g.AsyncMirrorGroupEx)
+}
+
+func (p *AsyncMirrorPair) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, p, &p.AsyncMirrorGroupMemberEx)
+}
+
+func (p *RemoteMirrorPair) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, p, &p.RemoteMirrorPair)
+}
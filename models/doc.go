@@ -0,0 +1,22 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+// Package models is the home of the API models generated from the Web Services swagger specifications bundled
+// with this repository. Each supported API version has its own package:
+//
+//	v11 - SANtricity 11.90 (swagger-11.9x.json)
+//	v12 - SANtricity 12.00 (swagger-12.0x.json)
+//
+// Every definition of a specification becomes a struct with a field for each property, so the complete object
+// is available without transcribing fields by hand. Optional properties are omitted when empty. In request models
+// (those sent as a request body and never returned), optional booleans and numbers are pointers so that an
+// explicit false or 0 can be told apart from an unset value.
+//
+// The santricity package embeds the v11 models in its response types, as 11.90 is the oldest supported version.
+// Code that relies on properties added in a later version uses that version's package directly, which makes
+// the dependency on the newer API visible at compile time.
+//
+// Run "go generate" in this directory after updating a specification.
+package models
+
+//go:generate go run gen.go -spec ../swagger-11.9x.json -package v11
+//go:generate go run gen.go -spec ../swagger-12.0x.json -package v12
@@ -0,0 +1,429 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+//go:build ignore
+
+// gen.go generates a package of model structs from the definitions of a swagger 2.0 specification.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	outputFile   = "models_gen.go"
+	commentWidth = 116
+)
+
+// initialisms are the words written in upper case in Go names.
+var initialisms = map[string]bool{
+	"API": true, "CPU": true, "DNS": true, "HTTP": true, "HTTPS": true, "ID": true, "IP": true, "JSON": true,
+	"SSL": true, "TCP": true, "TLS": true, "UDP": true, "URI": true, "URL": true, "UUID": true, "WWN": true,
+}
+
+// schema is the subset of a swagger schema object used for models.
+type schema struct {
+	Ref                  string      `json:"$ref"`
+	Type                 string      `json:"type"`
+	Format               string      `json:"format"`
+	Description          string      `json:"description"`
+	Enum                 []string    `json:"enum"`
+	Items                *schema     `json:"items"`
+	AdditionalProperties *schema     `json:"additionalProperties"`
+	Properties           *properties `json:"properties"`
+	Required             []string    `json:"required"`
+	AllOf                []*schema   `json:"allOf"`
+}
+
+// properties keeps the properties of a schema in the order of the specification.
+type properties struct {
+	names   []string
+	schemas map[string]*schema
+}
+
+func (p *properties) UnmarshalJSON(data []byte) error {
+
+	var schemas map[string]*schema
+	if err := json.Unmarshal(data, &schemas); err != nil {
+		return err
+	}
+	p.schemas = schemas
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		p.names = append(p.names, key.(string))
+		var skip json.RawMessage
+		if err := decoder.Decode(&skip); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type parameter struct {
+	In     string  `json:"in"`
+	Schema *schema `json:"schema"`
+}
+
+type operation struct {
+	Parameters []parameter `json:"parameters"`
+	Responses  map[string]struct {
+		Schema *schema `json:"schema"`
+	} `json:"responses"`
+}
+
+type spec struct {
+	Paths       map[string]map[string]json.RawMessage `json:"paths"`
+	Definitions map[string]*schema                    `json:"definitions"`
+}
+
+// field is a property of a model after merging allOf compositions.
+type field struct {
+	name     string
+	schema   *schema
+	required bool
+}
+
+type generator struct {
+	spec     spec
+	fields   map[string][]field
+	requests map[string]bool
+	pointers map[string]map[string]bool
+}
+
+func main() {
+
+	specFile := flag.String("spec", "", "swagger specification to read")
+	pkg := flag.String("package", "", "package (and directory) to generate")
+	flag.Parse()
+	if *specFile == "" || *pkg == "" {
+		log.Fatal("usage: go run gen.go -spec <file> -package <name>")
+	}
+
+	data, err := os.ReadFile(*specFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	g := &generator{
+		fields:   make(map[string][]field),
+		requests: make(map[string]bool),
+		pointers: make(map[string]map[string]bool),
+	}
+	if err := json.Unmarshal(data, &g.spec); err != nil {
+		log.Fatalf("could not parse %s: %v", *specFile, err)
+	}
+
+	for name := range g.spec.Definitions {
+		g.fields[name] = g.merge(name, g.spec.Definitions[name], map[string]bool{})
+	}
+	g.findRequests()
+	g.findCycles()
+
+	names := make([]string, 0, len(g.spec.Definitions))
+	for name := range g.spec.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by gen.go from %s; DO NOT EDIT.\n\n", filepath.Base(*specFile))
+	fmt.Fprintf(&out, "// Package %s has the models of the SANtricity Web Services API described by %s.\n",
+		*pkg, filepath.Base(*specFile))
+	fmt.Fprintf(&out, "package %s\n", *pkg)
+	for _, name := range names {
+		g.writeModel(&out, name)
+	}
+
+	source, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatalf("could not format generated code: %v", err)
+	}
+	if err := os.MkdirAll(*pkg, 0755); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(*pkg, outputFile), source, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// merge returns the properties of a definition, including those of the definitions it composes with allOf.
+func (g *generator) merge(name string, s *schema, seen map[string]bool) []field {
+
+	if seen[name] {
+		log.Fatalf("definition %s composes itself", name)
+	}
+	seen[name] = true
+
+	var fields []field
+	if s.Properties != nil {
+		required := make(map[string]bool)
+		for _, r := range s.Required {
+			required[r] = true
+		}
+		for _, property := range s.Properties.names {
+			fields = append(fields, field{property, s.Properties.schemas[property], required[property]})
+		}
+	}
+	for _, part := range s.AllOf {
+		if part.Ref != "" {
+			base := refName(part.Ref)
+			fields = append(fields, g.merge(base, g.spec.Definitions[base], seen)...)
+		} else {
+			fields = append(fields, g.merge(name+"#inline", part, map[string]bool{})...)
+		}
+	}
+
+	// Later declarations (of the composing definition) take precedence over the ones they extend
+	index := make(map[string]int)
+	var merged []field
+	for _, f := range fields {
+		if i, ok := index[f.name]; ok {
+			merged[i] = f
+			continue
+		}
+		index[f.name] = len(merged)
+		merged = append(merged, f)
+	}
+	return merged
+}
+
+// findRequests records the definitions sent as request bodies that are never part of a response.
+func (g *generator) findRequests() {
+
+	responses := make(map[string]bool)
+	for _, item := range g.spec.Paths {
+		for method, raw := range item {
+			if method == "parameters" {
+				continue
+			}
+			var op operation
+			if err := json.Unmarshal(raw, &op); err != nil {
+				log.Fatalf("could not parse operation: %v", err)
+			}
+			for _, p := range op.Parameters {
+				if p.In == "body" && p.Schema != nil {
+					if name := schemaRef(p.Schema); name != "" {
+						g.requests[name] = true
+					}
+				}
+			}
+			for _, r := range op.Responses {
+				if r.Schema != nil {
+					if name := schemaRef(r.Schema); name != "" {
+						responses[name] = true
+					}
+				}
+			}
+		}
+	}
+	for name := range responses {
+		delete(g.requests, name)
+	}
+}
+
+// findCycles marks the fields that must be pointers because the models would otherwise contain themselves.
+func (g *generator) findCycles() {
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int)
+
+	var visit func(name string)
+	visit = func(name string) {
+		state[name] = visiting
+		for _, f := range g.fields[name] {
+			if f.schema.Ref == "" {
+				continue
+			}
+			target := refName(f.schema.Ref)
+			switch state[target] {
+			case visiting:
+				if g.pointers[name] == nil {
+					g.pointers[name] = make(map[string]bool)
+				}
+				g.pointers[name][f.name] = true
+			case unvisited:
+				visit(target)
+			}
+		}
+		state[name] = done
+	}
+
+	names := make([]string, 0, len(g.fields))
+	for name := range g.fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if state[name] == unvisited {
+			visit(name)
+		}
+	}
+}
+
+func (g *generator) writeModel(out *bytes.Buffer, name string) {
+
+	s := g.spec.Definitions[name]
+	fmt.Fprintf(out, "\n// %s is the %s model.\n", typeName(name), name)
+	if s.Description != "" {
+		writeComment(out, "", s.Description)
+	}
+	fmt.Fprintf(out, "type %s struct {\n", typeName(name))
+
+	for _, f := range g.fields[name] {
+		if f.schema.Description != "" || len(f.schema.Enum) > 0 {
+			writeComment(out, "\t", f.schema.Description+enumText(f.schema.Enum))
+		}
+
+		goType := g.goType(f.schema)
+		tag := f.name
+		if !f.required {
+			switch {
+			case f.schema.Ref != "":
+				tag += ",omitzero"
+			case g.requests[name] && isScalar(f.schema):
+				goType = "*" + goType
+				tag += ",omitempty"
+			default:
+				tag += ",omitempty"
+			}
+		}
+		if g.pointers[name][f.name] && !strings.HasPrefix(goType, "*") {
+			goType = "*" + goType
+		}
+		fmt.Fprintf(out, "\t%s %s `json:\"%s\"`\n", fieldName(f.name), goType, tag)
+	}
+	fmt.Fprintf(out, "}\n")
+}
+
+// goType returns the Go type of a property.
+func (g *generator) goType(s *schema) string {
+
+	if s.Ref != "" {
+		return typeName(refName(s.Ref))
+	}
+	switch s.Type {
+	case "string":
+		return "string" // Including int64 values, which the API returns as strings
+	case "boolean":
+		return "bool"
+	case "integer":
+		if s.Format == "int64" {
+			return "int64"
+		}
+		return "int"
+	case "number":
+		return "float64"
+	case "array":
+		return "[]" + g.goType(s.Items)
+	case "object":
+		if s.AdditionalProperties != nil {
+			return "map[string]" + g.goType(s.AdditionalProperties)
+		}
+		return "map[string]any"
+	}
+	log.Fatalf("unsupported schema type %q", s.Type)
+	return ""
+}
+
+func isScalar(s *schema) bool {
+	return s.Ref == "" && (s.Type == "boolean" || s.Type == "integer" || s.Type == "number")
+}
+
+// schemaRef returns the definition used by a schema, directly or as the items of an array.
+func schemaRef(s *schema) string {
+	switch {
+	case s.Ref != "":
+		return refName(s.Ref)
+	case s.Type == "array" && s.Items != nil:
+		return schemaRef(s.Items)
+	}
+	return ""
+}
+
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+func typeName(name string) string {
+	return fieldName(name)
+}
+
+// fieldName converts a JSON property name to an exported Go name, with initialisms in upper case.
+func fieldName(name string) string {
+
+	var words []string
+	start := 0
+	runes := []rune(name)
+	for i := 1; i < len(runes); i++ {
+		if unicode.IsUpper(runes[i]) && !unicode.IsUpper(runes[i-1]) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	words = append(words, string(runes[start:]))
+
+	var b strings.Builder
+	for _, word := range words {
+		if upper := strings.ToUpper(word); initialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		r := []rune(word)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+	return b.String()
+}
+
+func enumText(values []string) string {
+
+	var known []string
+	for _, v := range values {
+		if v != "__UNDEFINED" {
+			known = append(known, v)
+		}
+	}
+	if len(known) == 0 {
+		return ""
+	}
+	return " Values: " + strings.Join(known, ", ") + "."
+}
+
+// writeComment writes text as line comments wrapped to the comment width.
+func writeComment(out *bytes.Buffer, indent, text string) {
+
+	line := ""
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(indent)*4+len(line)+len(word)+4 > commentWidth {
+			fmt.Fprintf(out, "%s// %s\n", indent, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		fmt.Fprintf(out, "%s// %s\n", indent, line)
+	}
+}
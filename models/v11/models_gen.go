@@ -0,0 +1,16463 @@
+// Code generated by gen.go from swagger-11.9x.json; DO NOT EDIT.
+
+// Package v11 has the models of the SANtricity Web Services API described by swagger-11.9x.json.
+package v11
+
+// AccessTokenSettings is the AccessTokenSettings model.
+type AccessTokenSettings struct {
+	// Maximum amount of time in seconds that an access token may be active. 0 will disable the generation of new
+	// access tokens.
+	MaxDuration float64 `json:"maxDuration"`
+}
+
+// AccessVolume is the AccessVolume model.
+type AccessVolume struct {
+	// True if the access volume feature (and thus the UTM command transfer capability) is enabled, or false if it
+	// is disabled.
+	Enabled bool `json:"enabled,omitempty"`
+	// An identifier value for the AccessVolume object. This will generally correspond to the logical unit number
+	// (LUN) that is used to access the volume for UTM protocol command transactions.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// The (simulated) capacity of the AccessVolume maintained by the controller. Since there is essentially no true
+	// data storage capacity present for an AccessVolume, this value is used to determine the block addressing limit
+	// of the unit, which is an important aspect of the UTM command transfer protocol.
+	Capacity string `json:"capacity,omitempty"`
+	// The identifier value for this AccessVolume. Other objects may use this reference value to refer to the
+	// AccessVolume.
+	AccessVolumeRef string `json:"accessVolumeRef,omitempty"`
+	Reserved1       string `json:"reserved1,omitempty"`
+}
+
+// AccessVolumeEx is the AccessVolumeEx model.
+type AccessVolumeEx struct {
+	// True if the access volume feature (and thus the UTM command transfer capability) is enabled, or false if it
+	// is disabled.
+	Enabled bool `json:"enabled,omitempty"`
+	// An identifier value for the AccessVolume object. This will generally correspond to the logical unit number
+	// (LUN) that is used to access the volume for UTM protocol command transactions.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// The (simulated) capacity of the AccessVolume maintained by the controller. Since there is essentially no true
+	// data storage capacity present for an AccessVolume, this value is used to determine the block addressing limit
+	// of the unit, which is an important aspect of the UTM command transfer protocol.
+	Capacity string `json:"capacity,omitempty"`
+	// The identifier value for this AccessVolume. Other objects may use this reference value to refer to the
+	// AccessVolume.
+	AccessVolumeRef string `json:"accessVolumeRef,omitempty"`
+	Reserved1       string `json:"reserved1,omitempty"`
+	Name            string `json:"name,omitempty"`
+	ID              string `json:"id,omitempty"`
+	// Values: unknown, volume, pool, host, lunMapping, hostGroup, thinVolume, drive, volumeCopy, pit, pitView,
+	// snapshotGroup, snapshot, accessVolume, legacySnapshot, hostType, metadataTag, managementUrl, folder,
+	// asyncMirrorGroup, asyncMirrorGroupMember, asyncMirrorGroupIncompleteMember, consistencyGroup,
+	// consistencyGroupView, fan, battery, storageSystem, controller, powerSupply, minihub, esm, drawer, hostBoard,
+	// interconnectCRU, cacheBackupDevice, tray, supportCRU, hostPort, initiator, snapshotSchedule, thermalSensor,
+	// sfp, flashCache, featureAttribute, featureState, lockKeyId, remoteVolume, mirrorVolume, vaultMirrorVolume,
+	// vaultMirrorGroup, metadataVolume, sasPort, sasExpander, channelPort, speedNegError, snmpAgentBundle,
+	// stagedFirmware, workload, ekmsCertRevocatSettings, databaseMetadata, directoryServicesDomain,
+	// kmsCertificateExpirationDetails, externalKMSEndpoint, notOnPreferredPath, remoteStorageVolume, remoteStorage,
+	// alarm.
+	ObjectType            string       `json:"objectType,omitempty"`
+	CurrentControllerID   string       `json:"currentControllerId,omitempty"`
+	WWN                   string       `json:"wwn,omitempty"`
+	PreferredControllerID string       `json:"preferredControllerId,omitempty"`
+	TotalSizeInBytes      string       `json:"totalSizeInBytes,omitempty"`
+	Mapped                bool         `json:"mapped"`
+	ListOfMappings        []LUNMapping `json:"listOfMappings,omitempty"`
+}
+
+// AddBatchCGMembersRequest is the AddBatchCGMembersRequest model.
+type AddBatchCGMembersRequest struct {
+	// A map of volume refs to ConcatVolumeCandidates that will be used for creating the repository volumes.
+	VolumeToCandidates map[string]ConcatVolumeCandidate `json:"volumeToCandidates"`
+	ScanMedia          *bool                            `json:"scanMedia,omitempty"`
+	ValidateParity     *bool                            `json:"validateParity,omitempty"`
+}
+
+// AddConsistencyGroupMemberRequest is the AddConsistencyGroupMemberRequest model.
+// Add consistency group member volume
+type AddConsistencyGroupMemberRequest struct {
+	// The member volume ref
+	VolumeID string `json:"volumeId"`
+	// The repository volume pool
+	RepositoryPoolID    string                `json:"repositoryPoolId,omitempty"`
+	ScanMedia           *bool                 `json:"scanMedia,omitempty"`
+	ValidateParity      *bool                 `json:"validateParity,omitempty"`
+	RepositoryPercent   *float64              `json:"repositoryPercent,omitempty"`
+	RepositoryCandidate ConcatVolumeCandidate `json:"repositoryCandidate,omitzero"`
+}
+
+// AddStorageSystemRequest is the AddStorageSystemRequest model.
+// The add storage system request.
+type AddStorageSystemRequest struct {
+	// The id to assign to the storage system. The id must be unique and if left null, a unique id will
+	// automatically be generated and assigned.
+	ID string `json:"id,omitempty"`
+	// The list of controller ip addresses or hostnames.
+	ControllerAddresses []string `json:"controllerAddresses"`
+	// True if any self-signed certificates on the storage system will be automatically accepted, otherwise false.
+	AcceptCertificate *bool `json:"acceptCertificate,omitempty"`
+	// True to enable validation of the added storage system, otherwise false.
+	Validate *bool `json:"validate,omitempty"`
+	// The admin's password or the storage system admin password.
+	Password string `json:"password,omitempty"`
+	// The world wide name of the storage system. Note, that this is only needed for in-band management with an
+	// in-band agent that is managing more than a single storage system. If provided, all alphabetical characters
+	// that are part of the wwn must be in uppercase.
+	WWN string `json:"wwn,omitempty"`
+	// True to enable trace logging for SYMbol calls to the storage system, otherwise false.
+	EnableTrace *bool `json:"enableTrace,omitempty"`
+	// Optional meta tags to associate to the storage system.
+	MetaTags []KeyValue `json:"metaTags,omitempty"`
+}
+
+// AddStorageSystemResponse is the AddStorageSystemResponse model.
+// The add storage system response.
+type AddStorageSystemResponse struct {
+	// The storage system id.
+	ID string `json:"id,omitempty"`
+	// True if the storage system already exists, otherwise false.
+	AlreadyExists bool `json:"alreadyExists,omitempty"`
+}
+
+// Alarm is the Alarm model.
+type Alarm struct {
+	// The reference for this physical alarm.
+	AlarmRef string `json:"alarmRef,omitempty"`
+	// The location of the alarm. The parent reference in Location identifies the tray containing the alarm, and the
+	// position field is set to 1.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// The operational status of the alarm. Values: unknown, enabled, disabled, sounding, muted, removed.
+	Status string `json:"status,omitempty"`
+	// True if the alarm is manageable using the setAlarm procedure.
+	IsManageable bool `json:"isManageable,omitempty"`
+	// This field has been deprecated and should not be used.
+	RtrAttributes RTRAttributes `json:"rtrAttributes,omitzero"`
+	// The repair policy for the alarm component.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+}
+
+// AlertSyslogConfiguration is the AlertSyslogConfiguration model.
+// API object that includes data related to syslog configuration.
+type AlertSyslogConfiguration struct {
+	// The list of syslog receivers
+	SyslogReceivers []AlertSyslogServer `json:"syslogReceivers"`
+	// The syslog default facility
+	DefaultFacility int `json:"defaultFacility"`
+	// The syslog default tag
+	DefaultTag string `json:"defaultTag"`
+	// The syslog message format Values: rfc3164, rfc5424.
+	SyslogFormat string `json:"syslogFormat,omitempty"`
+}
+
+// AlertSyslogResponse is the AlertSyslogResponse model.
+type AlertSyslogResponse struct {
+	// Syslog test response Values: syslogSentOK, noSyslogReceiversConfigured, syslogSendFailed.
+	Response string `json:"response"`
+}
+
+// AlertSyslogServer is the AlertSyslogServer model.
+// Configures Syslog server and port
+type AlertSyslogServer struct {
+	// Fully qualified name or IP address
+	ServerName string `json:"serverName"`
+	// Port number for syslog server
+	PortNumber int `json:"portNumber"`
+}
+
+// AmgIncompleteMember is the AmgIncompleteMember model.
+type AmgIncompleteMember struct {
+	// The reference (key) for the group member.
+	MemberRef string `json:"memberRef,omitempty"`
+	// The associated Async Mirror Group.
+	GroupRef string `json:"groupRef,omitempty"`
+	// The WWN of the primary volume on the remote array.
+	PrimaryVolWWN string `json:"primaryVolWWN,omitempty"`
+	// Capacity of the primary (used to aid in selection of mirror secondary volume).
+	PrimaryVolCapacity string `json:"primaryVolCapacity,omitempty"`
+	// RAID level of the primary volume. Values: raidUnsupported, raidAll, raid0, raid1, raid3, raid5, raid6,
+	// raidDiskPool, raid1p.
+	PrimaryVolRAIDLevel string `json:"primaryVolRAIDLevel,omitempty"`
+	// The protection type of the primary volume. Values: type0Protection, type1Protection, type2Protection,
+	// type3Protection.
+	PrimaryVolProtectionType string `json:"primaryVolProtectionType,omitempty"`
+	// Security type of the primary volume. Values: unknown, none, capable, enabled.
+	PrimaryVolSecurityType string `json:"primaryVolSecurityType,omitempty"`
+	// User label of the primary volume.
+	PrimaryVolUserLabel string `json:"primaryVolUserLabel,omitempty"`
+	// If true, the incomplete member is an orphan.
+	OrphanIncompleteMember bool `json:"orphanIncompleteMember,omitempty"`
+	// The capacity provisioning parameters for the primary volume.
+	PrimaryVolumeParameters VolumeTypeParameters `json:"primaryVolumeParameters,omitzero"`
+	// Refines the information in the securityType field to describe the set of drives. Values: unknown, none,
+	// mixed, fde, fips.
+	PrimaryVolSecurityLevel string `json:"primaryVolSecurityLevel,omitempty"`
+	// The block size (in bytes) of the volume.
+	BlkSize int `json:"blkSize,omitempty"`
+	// This reports the physical block size (in bytes) used when sending I/O to the underlying drives.
+	BlkSizePhysical int `json:"blkSizePhysical,omitempty"`
+	// The WWN of the target array in the mirroring relationship. This field may not be immediately available after
+	// an AMG is created.
+	RemoteTargetWWN string `json:"remoteTargetWwn,omitempty"`
+	// The user label of the target array in the mirroring relationship. This field may not be immediately available
+	// after an AMG is created, and will not be available in embedded mode.
+	RemoteTargetName string `json:"remoteTargetName,omitempty"`
+	// The id of the target array in the mirroring relationship. This field may not be immediately available after
+	// an AMG is created, and will not be available in embedded mode.
+	RemoteTargetID string `json:"remoteTargetId,omitempty"`
+	ID             string `json:"id,omitempty"`
+}
+
+// AnalysedControllerStatistics is the AnalysedControllerStatistics model.
+// Controller statistics for storage system.
+type AnalysedControllerStatistics struct {
+	// A timestamp representing when the data was collected
+	ObservedTime string `json:"observedTime"`
+	// The time in which this data was polled and generated in milliseconds
+	ObservedTimeInMS string `json:"observedTimeInMS"`
+	// Read operations per second.
+	ReadIOps float64 `json:"readIOps"`
+	// Write operations per second.
+	WriteIOps float64 `json:"writeIOps"`
+	// SCSI Operations to the disk that are not read/write operations. Example: Test Unit Ready.
+	OtherIOps float64 `json:"otherIOps"`
+	// All operations per second.
+	CombinedIOps float64 `json:"combinedIOps"`
+	// Read throughput in MB/s.
+	ReadThroughput float64 `json:"readThroughput"`
+	// Write throughput in MB/s.
+	WriteThroughput float64 `json:"writeThroughput"`
+	// Combined read/write throughput in MB/s.
+	CombinedThroughput float64 `json:"combinedThroughput"`
+	// Read response time average in milliseconds.
+	ReadResponseTime float64 `json:"readResponseTime"`
+	// The standard deviation of read response times.
+	ReadResponseTimeStdDev float64 `json:"readResponseTimeStdDev"`
+	// Write response time average in milliseconds.
+	WriteResponseTime float64 `json:"writeResponseTime"`
+	// The standard deviation of write response times.
+	WriteResponseTimeStdDev float64 `json:"writeResponseTimeStdDev"`
+	// Combined average response time in milliseconds.
+	CombinedResponseTime float64 `json:"combinedResponseTime"`
+	// The standard deviation of combined response times.
+	CombinedResponseTimeStdDev float64 `json:"combinedResponseTimeStdDev"`
+	// Average read operation size in bytes.
+	AverageReadOpSize float64 `json:"averageReadOpSize"`
+	// Average write operation size in bytes.
+	AverageWriteOpSize float64 `json:"averageWriteOpSize"`
+	// The amount of read operations in this analysed interval.
+	ReadOps float64 `json:"readOps"`
+	// The amount of write operations in this analysed interval.
+	WriteOps float64 `json:"writeOps"`
+	// Physical read operations per second.
+	ReadPhysicalIOps float64 `json:"readPhysicalIOps"`
+	// Physical write operations per second.
+	WritePhysicalIOps float64 `json:"writePhysicalIOps"`
+	ControllerID      string  `json:"controllerId"`
+	// The percentage of bytes that hit cache.
+	CacheHitBytesPercent float64 `json:"cacheHitBytesPercent,omitempty"`
+	// The percentage of IOs that are classified as random.
+	RandomIosPercent float64 `json:"randomIosPercent,omitempty"`
+	// The percentage of bytes serviced by the controller that are characterized as cache mirroring related.
+	MirrorBytesPercent float64 `json:"mirrorBytesPercent,omitempty"`
+	// The percentage of bytes serviced by the controller that are characterized as full stripe writes.
+	FullStripeWritesBytesPercent float64 `json:"fullStripeWritesBytesPercent,omitempty"`
+	// The max cpu utilization over the observation period.
+	MaxCPUUtilization float64 `json:"maxCpuUtilization,omitempty"`
+	// The max cpu utilization over the observation period per core.
+	MaxCPUUtilizationPerCore []float64 `json:"maxCpuUtilizationPerCore,omitempty"`
+	// The average CPU utilization.
+	CPUAvgUtilization float64 `json:"cpuAvgUtilization,omitempty"`
+	// The average percentage of CPU core utilization.
+	CPUAvgUtilizationPerCore []float64 `json:"cpuAvgUtilizationPerCore,omitempty"`
+	// The standard deviation of CPU core utilization.
+	CPUAvgUtilizationPerCoreStdDev []float64 `json:"cpuAvgUtilizationPerCoreStdDev,omitempty"`
+	// The percentage of operations serviced to a RAID 0 volume.
+	Raid0BytesPercent float64 `json:"raid0BytesPercent,omitempty"`
+	// The percentage of operations serviced to a RAID 1 volume.
+	Raid1BytesPercent float64 `json:"raid1BytesPercent,omitempty"`
+	// The percentage of operations serviced to a RAID 5 volume.
+	Raid5BytesPercent float64 `json:"raid5BytesPercent,omitempty"`
+	// The percentage of operations serviced to a RAID 6 volume.
+	Raid6BytesPercent float64 `json:"raid6BytesPercent,omitempty"`
+	// The percentage of operations serviced to a dynamic disk pool(DDP) volume.
+	DdpBytesPercent float64 `json:"ddpBytesPercent,omitempty"`
+	// Read cache hit response time average in milliseconds.
+	ReadHitResponseTime float64 `json:"readHitResponseTime"`
+	// The standard deviation of read cache hit response times
+	ReadHitResponseTimeStdDev float64 `json:"readHitResponseTimeStdDev"`
+	// Write cache hit response time average in milliseconds.
+	WriteHitResponseTime float64 `json:"writeHitResponseTime"`
+	// The standard deviation of write cache hit response times
+	WriteHitResponseTimeStdDev float64 `json:"writeHitResponseTimeStdDev"`
+	// Combined average cache hit response time in milliseconds.
+	CombinedHitResponseTime float64 `json:"combinedHitResponseTime"`
+	// The standard deviation of combined cache hit response times.
+	CombinedHitResponseTimeStdDev float64 `json:"combinedHitResponseTimeStdDev"`
+	// Theoretical maximum possible Bytes per second under current load.
+	MaxPossibleBpsUnderCurrentLoad float64 `json:"maxPossibleBpsUnderCurrentLoad"`
+	// Theoretical maximum possible IO per second under current load.
+	MaxPossibleIopsUnderCurrentLoad float64 `json:"maxPossibleIopsUnderCurrentLoad"`
+}
+
+// AnalysedDiskStatistics is the AnalysedDiskStatistics model.
+// Disk statistics for storage system.
+type AnalysedDiskStatistics struct {
+	// A timestamp representing when the data was collected
+	ObservedTime string `json:"observedTime"`
+	// The time in which this data was polled and generated in milliseconds
+	ObservedTimeInMS string `json:"observedTimeInMS"`
+	// Read operations per second.
+	ReadIOps float64 `json:"readIOps"`
+	// Write operations per second.
+	WriteIOps float64 `json:"writeIOps"`
+	// SCSI Operations to the disk that are not read/write operations. Example: Test Unit Ready.
+	OtherIOps float64 `json:"otherIOps"`
+	// All operations per second.
+	CombinedIOps float64 `json:"combinedIOps"`
+	// Read throughput in MB/s.
+	ReadThroughput float64 `json:"readThroughput"`
+	// Write throughput in MB/s.
+	WriteThroughput float64 `json:"writeThroughput"`
+	// Combined read/write throughput in MB/s.
+	CombinedThroughput float64 `json:"combinedThroughput"`
+	// Read response time average in milliseconds.
+	ReadResponseTime float64 `json:"readResponseTime"`
+	// The standard deviation of read response times.
+	ReadResponseTimeStdDev float64 `json:"readResponseTimeStdDev"`
+	// Write response time average in milliseconds.
+	WriteResponseTime float64 `json:"writeResponseTime"`
+	// The standard deviation of write response times.
+	WriteResponseTimeStdDev float64 `json:"writeResponseTimeStdDev"`
+	// Combined average response time in milliseconds.
+	CombinedResponseTime float64 `json:"combinedResponseTime"`
+	// The standard deviation of combined response times.
+	CombinedResponseTimeStdDev float64 `json:"combinedResponseTimeStdDev"`
+	// Average read operation size in bytes.
+	AverageReadOpSize float64 `json:"averageReadOpSize"`
+	// Average write operation size in bytes.
+	AverageWriteOpSize float64 `json:"averageWriteOpSize"`
+	// The amount of read operations in this analysed interval.
+	ReadOps float64 `json:"readOps"`
+	// The amount of write operations in this analysed interval.
+	WriteOps float64 `json:"writeOps"`
+	// Id for the disk
+	DiskID string `json:"diskId"`
+	// Slot the drive is currently located in.
+	DriveSlot int `json:"driveSlot"`
+	// Tray reference that the drive is located in
+	TrayRef string `json:"trayRef"`
+	// Tray that the drive is located in
+	TrayID int `json:"trayId"`
+	// The volume group ID that the drive belong and/or belonged to. This will be set to null if the record does not
+	// have a historically recorded volume group or the drive is not in a volume.
+	VolGroupID string `json:"volGroupId"`
+	// The volume group name that the drive belong and/or belonged to. This will be set to null if the record does
+	// not have a historically recorded volume group or the drive is not in a volume.
+	VolGroupName string `json:"volGroupName"`
+	// Physical read operations per second. For disk drives, the physical IOps will beequal to the total IOps.
+	ReadPhysicalIOps float64 `json:"readPhysicalIOps"`
+	// Physical write operations per second. For disk drives, the physical IOps will beequal to the total IOps.
+	WritePhysicalIOps float64 `json:"writePhysicalIOps"`
+	// The max time servicing read operations.
+	ReadTimeMax float64 `json:"readTimeMax"`
+	// The max time servicing write operations.
+	WriteTimeMax float64 `json:"writeTimeMax"`
+	// Maximum queue depth.
+	QueueDepthMax float64 `json:"queueDepthMax"`
+	// Average queue depth over the observation time.
+	AverageQueueDepth float64 `json:"averageQueueDepth"`
+	// The percentage of IOs that are classified as random.
+	RandomIosPercent float64 `json:"randomIosPercent"`
+	// The percentage of bytes that are classified as random.
+	RandomBytesPercent float64 `json:"randomBytesPercent"`
+}
+
+// AnalysedStorageSystemStatistics is the AnalysedStorageSystemStatistics model.
+// Statistics for storage system. some fields may not populate for systems running code versions older than
+// 08.30.20.xx/11.30.20.xx.
+type AnalysedStorageSystemStatistics struct {
+	// A timestamp representing when the data was collected
+	ObservedTime string `json:"observedTime"`
+	// The time in which this data was polled and generated in milliseconds
+	ObservedTimeInMS string `json:"observedTimeInMS"`
+	// Read operations per second.
+	ReadIOps float64 `json:"readIOps"`
+	// Write operations per second.
+	WriteIOps float64 `json:"writeIOps"`
+	// SCSI Operations to the disk that are not read/write operations. Example: Test Unit Ready.
+	OtherIOps float64 `json:"otherIOps"`
+	// All operations per second.
+	CombinedIOps float64 `json:"combinedIOps"`
+	// Read throughput in MB/s.
+	ReadThroughput float64 `json:"readThroughput"`
+	// Write throughput in MB/s.
+	WriteThroughput float64 `json:"writeThroughput"`
+	// Combined read/write throughput in MB/s.
+	CombinedThroughput float64 `json:"combinedThroughput"`
+	// Read response time average in milliseconds.
+	ReadResponseTime float64 `json:"readResponseTime"`
+	// The standard deviation of read response times.
+	ReadResponseTimeStdDev float64 `json:"readResponseTimeStdDev"`
+	// Write response time average in milliseconds.
+	WriteResponseTime float64 `json:"writeResponseTime"`
+	// The standard deviation of write response times.
+	WriteResponseTimeStdDev float64 `json:"writeResponseTimeStdDev"`
+	// Combined average response time in milliseconds.
+	CombinedResponseTime float64 `json:"combinedResponseTime"`
+	// The standard deviation of combined response times.
+	CombinedResponseTimeStdDev float64 `json:"combinedResponseTimeStdDev"`
+	// Average read operation size in bytes.
+	AverageReadOpSize float64 `json:"averageReadOpSize"`
+	// Average write operation size in bytes.
+	AverageWriteOpSize float64 `json:"averageWriteOpSize"`
+	// The amount of read operations in this analysed interval.
+	ReadOps float64 `json:"readOps"`
+	// The amount of write operations in this analysed interval.
+	WriteOps float64 `json:"writeOps"`
+	// Physical read operations per second.
+	ReadPhysicalIOps float64 `json:"readPhysicalIOps"`
+	// Physical write operations per second.
+	WritePhysicalIOps float64 `json:"writePhysicalIOps"`
+	StorageSystemID   string  `json:"storageSystemId"`
+	StorageSystemWWN  string  `json:"storageSystemWWN"`
+	StorageSystemName string  `json:"storageSystemName"`
+	// The percentage of bytes that hit cache.This field will not populate for systems running code versions older
+	// than 08.30.20.xx/11.30.20.xx.
+	CacheHitBytesPercent float64 `json:"cacheHitBytesPercent,omitempty"`
+	// The percentage of IOs that are classified as random.This field will not populate for systems running code
+	// versions older than 08.30.20.xx/11.30.20.xx.
+	RandomIosPercent float64 `json:"randomIosPercent,omitempty"`
+	// The percentage of bytes serviced by the controller that are characterized as cache mirroring related.This
+	// field will not populate for systems running code versions older than 08.30.20.xx/11.30.20.xx.
+	MirrorBytesPercent float64 `json:"mirrorBytesPercent,omitempty"`
+	// The percentage of bytes serviced by the controller that are characterized as full stripe writes.This field
+	// will not populate for systems running code versions older than 08.30.20.xx/11.30.20.xx.
+	FullStripeWritesBytesPercent float64 `json:"fullStripeWritesBytesPercent,omitempty"`
+	// The max cpu utilization over the observation period.This field will not populate for systems running code
+	// versions older than 08.30.20.xx/11.30.20.xx.
+	MaxCPUUtilization float64 `json:"maxCpuUtilization,omitempty"`
+	// The average CPU utilization.This field will not populate for systems running code versions older than
+	// 08.30.20.xx/11.30.20.xx.
+	CPUAvgUtilization float64 `json:"cpuAvgUtilization,omitempty"`
+	// The percentage of operations serviced to a RAID 0 volume.This field will not populate for systems running
+	// code versions older than 08.30.20.xx/11.30.20.xx.
+	Raid0BytesPercent float64 `json:"raid0BytesPercent,omitempty"`
+	// The percentage of operations serviced to a RAID 1 volume.This field will not populate for systems running
+	// code versions older than 08.30.20.xx/11.30.20.xx.
+	Raid1BytesPercent float64 `json:"raid1BytesPercent,omitempty"`
+	// The percentage of operations serviced to a RAID 5 volume.This field will not populate for systems running
+	// code versions older than 08.30.20.xx/11.30.20.xx.
+	Raid5BytesPercent float64 `json:"raid5BytesPercent,omitempty"`
+	// The percentage of operations serviced to a RAID 6 volume.This field will not populate for systems running
+	// code versions older than 08.30.20.xx/11.30.20.xx.
+	Raid6BytesPercent float64 `json:"raid6BytesPercent,omitempty"`
+	// The percentage of operations serviced to a dynamic disk pool(DDP) volume.This field will not populate for
+	// systems running code versions older than 08.30.20.xx/11.30.20.xx.
+	DdpBytesPercent float64 `json:"ddpBytesPercent,omitempty"`
+	// Read cache hit response time average in milliseconds.
+	ReadHitResponseTime float64 `json:"readHitResponseTime"`
+	// The standard deviation of read cache hit response times
+	ReadHitResponseTimeStdDev float64 `json:"readHitResponseTimeStdDev"`
+	// Write cache hit response time average in milliseconds.
+	WriteHitResponseTime float64 `json:"writeHitResponseTime"`
+	// The standard deviation of write cache hit response times
+	WriteHitResponseTimeStdDev float64 `json:"writeHitResponseTimeStdDev"`
+	// Combined average cache hit response time in milliseconds.
+	CombinedHitResponseTime float64 `json:"combinedHitResponseTime"`
+	// The standard deviation of combined cache hit response times.
+	CombinedHitResponseTimeStdDev float64 `json:"combinedHitResponseTimeStdDev"`
+	// Theoretical maximum possible Bytes per second under current load.
+	MaxPossibleBpsUnderCurrentLoad float64 `json:"maxPossibleBpsUnderCurrentLoad"`
+	// Theoretical maximum possible IO per second under current load.
+	MaxPossibleIopsUnderCurrentLoad float64 `json:"maxPossibleIopsUnderCurrentLoad"`
+}
+
+// AnalysedVolumeStatistics is the AnalysedVolumeStatistics model.
+// Volume statistics for storage system.
+type AnalysedVolumeStatistics struct {
+	// A timestamp representing when the data was collected
+	ObservedTime string `json:"observedTime"`
+	// The time in which this data was polled and generated in milliseconds
+	ObservedTimeInMS string `json:"observedTimeInMS"`
+	// Read operations per second.
+	ReadIOps float64 `json:"readIOps"`
+	// Write operations per second.
+	WriteIOps float64 `json:"writeIOps"`
+	// SCSI Operations to the disk that are not read/write operations. Example: Test Unit Ready.
+	OtherIOps float64 `json:"otherIOps"`
+	// All operations per second.
+	CombinedIOps float64 `json:"combinedIOps"`
+	// Read throughput in MB/s.
+	ReadThroughput float64 `json:"readThroughput"`
+	// Write throughput in MB/s.
+	WriteThroughput float64 `json:"writeThroughput"`
+	// Combined read/write throughput in MB/s.
+	CombinedThroughput float64 `json:"combinedThroughput"`
+	// Read response time average in milliseconds.
+	ReadResponseTime float64 `json:"readResponseTime"`
+	// The standard deviation of read response times.
+	ReadResponseTimeStdDev float64 `json:"readResponseTimeStdDev"`
+	// Write response time average in milliseconds.
+	WriteResponseTime float64 `json:"writeResponseTime"`
+	// The standard deviation of write response times.
+	WriteResponseTimeStdDev float64 `json:"writeResponseTimeStdDev"`
+	// Combined average response time in milliseconds.
+	CombinedResponseTime float64 `json:"combinedResponseTime"`
+	// The standard deviation of combined response times.
+	CombinedResponseTimeStdDev float64 `json:"combinedResponseTimeStdDev"`
+	// Average read operation size in bytes.
+	AverageReadOpSize float64 `json:"averageReadOpSize"`
+	// Average write operation size in bytes.
+	AverageWriteOpSize float64 `json:"averageWriteOpSize"`
+	// The amount of read operations in this analysed interval.
+	ReadOps float64 `json:"readOps"`
+	// The amount of write operations in this analysed interval.
+	WriteOps     float64 `json:"writeOps"`
+	VolumeID     string  `json:"volumeId"`
+	VolumeName   string  `json:"volumeName"`
+	PoolID       string  `json:"poolId"`
+	ControllerID string  `json:"controllerId"`
+	WorkLoadID   string  `json:"workLoadId,omitempty"`
+	// True if the volume was mapped to a host at the point of data collection
+	Mapped bool `json:"mapped"`
+	// Number of read operations that hit cache
+	ReadHitOps float64 `json:"readHitOps"`
+	// Average read cache hit response time in milliseconds.
+	ReadHitResponseTime float64 `json:"readHitResponseTime"`
+	// The standard deviation of read cache hit response times
+	ReadHitResponseTimeStdDev float64 `json:"readHitResponseTimeStdDev"`
+	// Number of bytes read from cache
+	ReadHitBytes float64 `json:"readHitBytes"`
+	// Number of bytes written to cache
+	WriteHitBytes float64 `json:"writeHitBytes"`
+	// Number of write operations that hit cache
+	WriteHitOps float64 `json:"writeHitOps"`
+	// Average write cache hit response time in milliseconds.
+	WriteHitResponseTime float64 `json:"writeHitResponseTime"`
+	// The standard deviation of write cache hit response times.
+	WriteHitResponseTimeStdDev float64 `json:"writeHitResponseTimeStdDev"`
+	// Combined average cache hit response time in milliseconds.
+	CombinedHitResponseTime float64 `json:"combinedHitResponseTime"`
+	// The standard deviation of combined cache hit response times.
+	CombinedHitResponseTimeStdDev float64 `json:"combinedHitResponseTimeStdDev"`
+	// Percentage of bytes read from cache
+	ReadCacheUtilization float64 `json:"readCacheUtilization"`
+	// Percentage of bytes written to cache
+	WriteCacheUtilization float64 `json:"writeCacheUtilization"`
+	// Number of read operations that hit flash(SSD) cache
+	FlashCacheReadHitOps float64 `json:"flashCacheReadHitOps"`
+	// Number of bytes read from flash(SSD) cache
+	FlashCacheReadHitBytes float64 `json:"flashCacheReadHitBytes"`
+	// Physical read operations per second.
+	ReadPhysicalIOps float64 `json:"readPhysicalIOps"`
+	// Physical write operations per second.
+	WritePhysicalIOps float64 `json:"writePhysicalIOps"`
+	// Total channel queue depth.
+	QueueDepthTotal float64 `json:"queueDepthTotal"`
+	// Maximum channel queue depth.
+	QueueDepthMax float64 `json:"queueDepthMax"`
+	// The max time servicing read operations.
+	ReadTimeMax float64 `json:"readTimeMax"`
+	// The max time servicing write operations.
+	WriteTimeMax float64 `json:"writeTimeMax"`
+	// Average queue depth over the observation time.
+	AverageQueueDepth float64 `json:"averageQueueDepth"`
+	// The percentage of IOs that are classified as random.
+	RandomIosPercent float64 `json:"randomIosPercent"`
+	// The percentage of bytes that are classified as random.
+	RandomBytesPercent float64 `json:"randomBytesPercent"`
+	// The percentage of write operations that are delayed, waiting for cache flush.
+	CacheWriteWaitOpsPercent float64 `json:"cacheWriteWaitOpsPercent"`
+	// The percentage of write bytes that are delayed, waiting for cache flush.
+	CacheWriteWaitBytesPercent float64 `json:"cacheWriteWaitBytesPercent"`
+	// The percentage of pre-fetched hits that are ultimately read by the host.
+	PrefetchHitPercent float64 `json:"prefetchHitPercent"`
+	// This value will always report -1 and will be removed in a future release.
+	FullStripeWritesBytesPercent float64 `json:"fullStripeWritesBytesPercent"`
+	FlashCacheReadThroughput     float64 `json:"flashCacheReadThroughput,omitempty"`
+	FlashCacheReadResponseTime   float64 `json:"flashCacheReadResponseTime,omitempty"`
+	FlashCacheHitPct             float64 `json:"flashCacheHitPct,omitempty"`
+}
+
+// AnalyzedApplicationStatistics is the AnalyzedApplicationStatistics model.
+// Aggregation of volume statistics for application.
+type AnalyzedApplicationStatistics struct {
+	// A timestamp representing when the data was collected
+	ObservedTime string `json:"observedTime"`
+	// The time in which this data was polled and generated in milliseconds
+	ObservedTimeInMS string `json:"observedTimeInMS"`
+	// Read operations per second.
+	ReadIOps float64 `json:"readIOps"`
+	// Write operations per second.
+	WriteIOps float64 `json:"writeIOps"`
+	// SCSI Operations to the disk that are not read/write operations. Example: Test Unit Ready.
+	OtherIOps float64 `json:"otherIOps"`
+	// All operations per second.
+	CombinedIOps float64 `json:"combinedIOps"`
+	// Read throughput in MB/s.
+	ReadThroughput float64 `json:"readThroughput"`
+	// Write throughput in MB/s.
+	WriteThroughput float64 `json:"writeThroughput"`
+	// Combined read/write throughput in MB/s.
+	CombinedThroughput float64 `json:"combinedThroughput"`
+	// Read response time average in milliseconds.
+	ReadResponseTime float64 `json:"readResponseTime"`
+	// The standard deviation of read response times.
+	ReadResponseTimeStdDev float64 `json:"readResponseTimeStdDev"`
+	// Write response time average in milliseconds.
+	WriteResponseTime float64 `json:"writeResponseTime"`
+	// The standard deviation of write response times.
+	WriteResponseTimeStdDev float64 `json:"writeResponseTimeStdDev"`
+	// Combined average response time in milliseconds.
+	CombinedResponseTime float64 `json:"combinedResponseTime"`
+	// The standard deviation of combined response times.
+	CombinedResponseTimeStdDev float64 `json:"combinedResponseTimeStdDev"`
+	// Average read operation size in bytes.
+	AverageReadOpSize float64 `json:"averageReadOpSize"`
+	// Average write operation size in bytes.
+	AverageWriteOpSize float64 `json:"averageWriteOpSize"`
+	// The amount of read operations in this analysed interval.
+	ReadOps float64 `json:"readOps"`
+	// The amount of write operations in this analysed interval.
+	WriteOps float64 `json:"writeOps"`
+	// Physical read operations per second.
+	ReadPhysicalIOps float64 `json:"readPhysicalIOps"`
+	// Physical write operations per second.
+	WritePhysicalIOps float64 `json:"writePhysicalIOps"`
+	// The application id of the collection
+	ApplicationID string `json:"applicationId"`
+}
+
+// AnalyzedInterfaceStatistics is the AnalyzedInterfaceStatistics model.
+// Interface statistics for storage system.
+type AnalyzedInterfaceStatistics struct {
+	// A timestamp representing when the data was collected
+	ObservedTime string `json:"observedTime"`
+	// The time in which this data was polled and generated in milliseconds
+	ObservedTimeInMS string `json:"observedTimeInMS"`
+	// Read operations per second.
+	ReadIOps float64 `json:"readIOps"`
+	// Write operations per second.
+	WriteIOps float64 `json:"writeIOps"`
+	// SCSI Operations to the disk that are not read/write operations. Example: Test Unit Ready.
+	OtherIOps float64 `json:"otherIOps"`
+	// All operations per second.
+	CombinedIOps float64 `json:"combinedIOps"`
+	// Read throughput in MB/s.
+	ReadThroughput float64 `json:"readThroughput"`
+	// Write throughput in MB/s.
+	WriteThroughput float64 `json:"writeThroughput"`
+	// Combined read/write throughput in MB/s.
+	CombinedThroughput float64 `json:"combinedThroughput"`
+	// Read response time average in milliseconds.
+	ReadResponseTime float64 `json:"readResponseTime"`
+	// The standard deviation of read response times.
+	ReadResponseTimeStdDev float64 `json:"readResponseTimeStdDev"`
+	// Write response time average in milliseconds.
+	WriteResponseTime float64 `json:"writeResponseTime"`
+	// The standard deviation of write response times.
+	WriteResponseTimeStdDev float64 `json:"writeResponseTimeStdDev"`
+	// Combined average response time in milliseconds.
+	CombinedResponseTime float64 `json:"combinedResponseTime"`
+	// The standard deviation of combined response times.
+	CombinedResponseTimeStdDev float64 `json:"combinedResponseTimeStdDev"`
+	// Average read operation size in bytes.
+	AverageReadOpSize float64 `json:"averageReadOpSize"`
+	// Average write operation size in bytes.
+	AverageWriteOpSize float64 `json:"averageWriteOpSize"`
+	// The amount of read operations in this analysed interval.
+	ReadOps float64 `json:"readOps"`
+	// The amount of write operations in this analysed interval.
+	WriteOps    float64 `json:"writeOps"`
+	InterfaceID string  `json:"interfaceId"`
+	// Controller this interface belongs to
+	ControllerID string `json:"controllerId"`
+	// The channel type for the interface. Values: hostside, driveside, management.
+	ChannelType string `json:"channelType"`
+	// Total channel queue depth.
+	QueueDepthTotal float64 `json:"queueDepthTotal"`
+	// Maximum channel queue depth.
+	QueueDepthMax float64 `json:"queueDepthMax"`
+	// Number of channels.
+	ChannelNumber int `json:"channelNumber"`
+	// Maximum channel queue depth.
+	ChannelErrorCounts float64 `json:"channelErrorCounts"`
+}
+
+// AnalyzedPoolStatistics is the AnalyzedPoolStatistics model.
+// Volume statistics for storage pools.
+type AnalyzedPoolStatistics struct {
+	// A timestamp representing when the data was collected
+	ObservedTime string `json:"observedTime"`
+	// The time in which this data was polled and generated in milliseconds
+	ObservedTimeInMS string `json:"observedTimeInMS"`
+	// Read operations per second.
+	ReadIOps float64 `json:"readIOps"`
+	// Write operations per second.
+	WriteIOps float64 `json:"writeIOps"`
+	// SCSI Operations to the disk that are not read/write operations. Example: Test Unit Ready.
+	OtherIOps float64 `json:"otherIOps"`
+	// All operations per second.
+	CombinedIOps float64 `json:"combinedIOps"`
+	// Read throughput in MB/s.
+	ReadThroughput float64 `json:"readThroughput"`
+	// Write throughput in MB/s.
+	WriteThroughput float64 `json:"writeThroughput"`
+	// Combined read/write throughput in MB/s.
+	CombinedThroughput float64 `json:"combinedThroughput"`
+	// Read response time average in milliseconds.
+	ReadResponseTime float64 `json:"readResponseTime"`
+	// The standard deviation of read response times.
+	ReadResponseTimeStdDev float64 `json:"readResponseTimeStdDev"`
+	// Write response time average in milliseconds.
+	WriteResponseTime float64 `json:"writeResponseTime"`
+	// The standard deviation of write response times.
+	WriteResponseTimeStdDev float64 `json:"writeResponseTimeStdDev"`
+	// Combined average response time in milliseconds.
+	CombinedResponseTime float64 `json:"combinedResponseTime"`
+	// The standard deviation of combined response times.
+	CombinedResponseTimeStdDev float64 `json:"combinedResponseTimeStdDev"`
+	// Average read operation size in bytes.
+	AverageReadOpSize float64 `json:"averageReadOpSize"`
+	// Average write operation size in bytes.
+	AverageWriteOpSize float64 `json:"averageWriteOpSize"`
+	// The amount of read operations in this analysed interval.
+	ReadOps float64 `json:"readOps"`
+	// The amount of write operations in this analysed interval.
+	WriteOps float64 `json:"writeOps"`
+	// Physical read operations per second.
+	ReadPhysicalIOps float64 `json:"readPhysicalIOps"`
+	// Physical write operations per second.
+	WritePhysicalIOps float64 `json:"writePhysicalIOps"`
+	// The storage pool or volume group the volume is a member of
+	PoolID string `json:"poolId"`
+}
+
+// AnalyzedWorkloadStatistics is the AnalyzedWorkloadStatistics model.
+// Aggregation of volume statistics for a workload.
+type AnalyzedWorkloadStatistics struct {
+	// A timestamp representing when the data was collected
+	ObservedTime string `json:"observedTime"`
+	// The time in which this data was polled and generated in milliseconds
+	ObservedTimeInMS string `json:"observedTimeInMS"`
+	// Read operations per second.
+	ReadIOps float64 `json:"readIOps"`
+	// Write operations per second.
+	WriteIOps float64 `json:"writeIOps"`
+	// SCSI Operations to the disk that are not read/write operations. Example: Test Unit Ready.
+	OtherIOps float64 `json:"otherIOps"`
+	// All operations per second.
+	CombinedIOps float64 `json:"combinedIOps"`
+	// Read throughput in MB/s.
+	ReadThroughput float64 `json:"readThroughput"`
+	// Write throughput in MB/s.
+	WriteThroughput float64 `json:"writeThroughput"`
+	// Combined read/write throughput in MB/s.
+	CombinedThroughput float64 `json:"combinedThroughput"`
+	// Read response time average in milliseconds.
+	ReadResponseTime float64 `json:"readResponseTime"`
+	// The standard deviation of read response times.
+	ReadResponseTimeStdDev float64 `json:"readResponseTimeStdDev"`
+	// Write response time average in milliseconds.
+	WriteResponseTime float64 `json:"writeResponseTime"`
+	// The standard deviation of write response times.
+	WriteResponseTimeStdDev float64 `json:"writeResponseTimeStdDev"`
+	// Combined average response time in milliseconds.
+	CombinedResponseTime float64 `json:"combinedResponseTime"`
+	// The standard deviation of combined response times.
+	CombinedResponseTimeStdDev float64 `json:"combinedResponseTimeStdDev"`
+	// Average read operation size in bytes.
+	AverageReadOpSize float64 `json:"averageReadOpSize"`
+	// Average write operation size in bytes.
+	AverageWriteOpSize float64 `json:"averageWriteOpSize"`
+	// The amount of read operations in this analysed interval.
+	ReadOps float64 `json:"readOps"`
+	// The amount of write operations in this analysed interval.
+	WriteOps float64 `json:"writeOps"`
+	// Physical read operations per second.
+	ReadPhysicalIOps float64 `json:"readPhysicalIOps"`
+	// Physical write operations per second.
+	WritePhysicalIOps float64 `json:"writePhysicalIOps"`
+	// The workload id of the collection
+	WorkloadID string `json:"workloadId"`
+}
+
+// ApplicationAwarenessBundle is the ApplicationAwarenessBundle model.
+type ApplicationAwarenessBundle struct {
+	// This is an array of elements that report the a key-value tag and its reference.
+	KeyValueTag []KeyValueTag `json:"keyValueTag,omitempty"`
+	// This element is an array of the mappings (using references) between a key-value tag and a volume.
+	KeyValueTagMapping []KeyValueTagMapping `json:"keyValueTagMapping,omitempty"`
+	// This is an array of elements that reports Workload references.
+	Workload []Workload `json:"workload,omitempty"`
+	// This is an array of elements that report the mapping between workloads and volumes.
+	WorkloadVolumeMapping []WorkloadVolumeMapping `json:"workloadVolumeMapping,omitempty"`
+}
+
+// ApplicationStatistics is the ApplicationStatistics model.
+// Aggregation of volume statistics for application.
+type ApplicationStatistics struct {
+	// End time for this collection as measured by the number of seconds since baseTime.
+	ObservedTime      string `json:"observedTime"`
+	ObservedTimeInMS  string `json:"observedTimeInMS"`
+	LastResetTime     string `json:"lastResetTime"`
+	LastResetTimeInMS string `json:"lastResetTimeInMS"`
+	ArrayID           string `json:"arrayId"`
+	ArrayWWN          string `json:"arrayWwn"`
+	// Hash of member volume ids.
+	MemberIdsHash string `json:"memberIdsHash"`
+	// Number of read operations
+	ReadOps float64 `json:"readOps"`
+	// Number of read operations that hit cache
+	ReadHitOps float64 `json:"readHitOps"`
+	// Number of bytes read from cache
+	ReadHitBytes     float64 `json:"readHitBytes"`
+	ReadTimeTotal    float64 `json:"readTimeTotal"`
+	ReadHitTimeTotal float64 `json:"readHitTimeTotal"`
+	// Number of write operations
+	WriteOps                           float64 `json:"writeOps"`
+	WriteTimeTotal                     float64 `json:"writeTimeTotal"`
+	WriteHitTimeTotal                  float64 `json:"writeHitTimeTotal"`
+	ErrRedundancyChkIndeterminateReads float64 `json:"errRedundancyChkIndeterminateReads"`
+	ErrRedundancyChkRecoveredReads     float64 `json:"errRedundancyChkRecoveredReads"`
+	ErrRedundancyChkUnrecoveredReads   float64 `json:"errRedundancyChkUnrecoveredReads"`
+	IdleTime                           float64 `json:"idleTime"`
+	OtherOps                           float64 `json:"otherOps"`
+	OtherTimeMax                       float64 `json:"otherTimeMax"`
+	OtherTimeTotal                     float64 `json:"otherTimeTotal"`
+	ReadBytes                          float64 `json:"readBytes"`
+	ReadHitTimeMax                     float64 `json:"readHitTimeMax"`
+	ReadTimeMax                        float64 `json:"readTimeMax"`
+	WriteBytes                         float64 `json:"writeBytes"`
+	// Number of bytes written to cache
+	WriteHitBytes float64 `json:"writeHitBytes"`
+	// Number of write operations that hit cache
+	WriteHitOps     float64 `json:"writeHitOps"`
+	WriteHitTimeMax float64 `json:"writeHitTimeMax"`
+	WriteTimeMax    float64 `json:"writeTimeMax"`
+	// Total channel queue depth.
+	QueueDepthTotal float64 `json:"queueDepthTotal"`
+	// Maximum channel queue depth.
+	QueueDepthMax float64 `json:"queueDepthMax"`
+	// The number of flash cache read hit operations.
+	FlashCacheReadHitOps float64 `json:"flashCacheReadHitOps"`
+	// The number of flash cache read hit bytes.
+	FlashCacheReadHitBytes float64 `json:"flashCacheReadHitBytes"`
+	// The sum of all response times for all reads that have cache hits, in microseconds
+	FlashCacheReadHitTimeTotal float64 `json:"flashCacheReadHitTimeTotal"`
+	// Maximum I/O time in microseconds for any one read I/O that has a cache hit
+	FlashCacheReadHitTimeMax float64 `json:"flashCacheReadHitTimeMax"`
+	// The application id of the collection
+	ApplicationID string `json:"applicationId"`
+}
+
+// ArvmDefaultsResponse is the ArvmDefaultsResponse model.
+// The asynchronous remove volume mirror defaults.
+type ArvmDefaultsResponse struct {
+	// The minimum repository size (in bytes) for a mirror repository.
+	MinRepositoryCapacityInBytes string `json:"minRepositoryCapacityInBytes"`
+	// The maximum repository capacity as a percentage of the mirror capacity.
+	MaxRepositoryCapacityAsPercentOfMirroredCapacity int `json:"maxRepositoryCapacityAsPercentOfMirroredCapacity"`
+	// The default repository capacity as a percentage of the mirror capacity.
+	DefaultRepositoryCapacityAsPercentOfMirroredCapacity int `json:"defaultRepositoryCapacityAsPercentOfMirroredCapacity"`
+	// The default repository utilization warning threshold percentage.
+	DefaultRepositoryUtilizationWarnThreshold int `json:"defaultRepositoryUtilizationWarnThreshold"`
+	// The minimum sync interval in minutes.
+	MinSyncIntervalInMinutes int `json:"minSyncIntervalInMinutes"`
+	// The default sync interval in minutes.
+	DefaultSyncIntervalInMinutes int `json:"defaultSyncIntervalInMinutes"`
+	// The default sync completion time alert threshold in minutes.
+	DefaultSyncCompletionTimeAlertThresholdInMinutes int `json:"defaultSyncCompletionTimeAlertThresholdInMinutes"`
+	// The default recovery point age alert threshold in minutes.
+	DefaultRecoveryPointAgeAlertThresholdInMinutes int `json:"defaultRecoveryPointAgeAlertThresholdInMinutes"`
+	// The maximum timeout (in seconds) allowed for async mirror group connectivity test.
+	MaxConnectivityTestTimeoutInSeconds int `json:"maxConnectivityTestTimeoutInSeconds"`
+	// The maximum number of test iterations allowed for a link latency test.
+	MaxLinkLatencyTestIterations int `json:"maxLinkLatencyTestIterations"`
+	// The maximum number of test iterations allowed for a bandwidth test.
+	MaxLinkBandwidthTestIterations int `json:"maxLinkBandwidthTestIterations"`
+	// The minimum repository size as 1/100ths of a percent of mirror capacity.
+	MinRepositoryCapacityInHundredthsOfPercentOfMirroredCapacity int `json:"minRepositoryCapacityInHundredthsOfPercentOfMirroredCapacity"`
+	// The default number of test iterations for a link latency test.
+	DefaultLinkLatencyTestIterations int `json:"defaultLinkLatencyTestIterations"`
+	// The default number of test iterations for a bandwidth test.
+	DefaultLinkBandwidthTestIterations int `json:"defaultLinkBandwidthTestIterations"`
+	// The recommended default sample list length limit for recent statistics request.
+	DefaultRecentStatisticsListLength int `json:"defaultRecentStatisticsListLength"`
+	// The maximum sample list length allowed for a recent statistics request.
+	MaxRecentStatisticsListLength int `json:"maxRecentStatisticsListLength"`
+	// The recommended default sample list length limit for a longest statistics request.
+	DefaultLongestStatisticsListLength int `json:"defaultLongestStatisticsListLength"`
+	// The maximum sample list length allowed for a longest statistics request.
+	MaxLongestStatisticsListLength int `json:"maxLongestStatisticsListLength"`
+	// The recommended default sample list length limit for an error statistics request.
+	DefaultErrorStatisticsListLength int `json:"defaultErrorStatisticsListLength"`
+	// The maximum sample list length allowed for an error statistics request.
+	MaxErrorStatisticsListLength int `json:"maxErrorStatisticsListLength"`
+	// Indicates the maximum allowed link latency between two remote volume mirror storage systems.
+	MaxAllowedLinkLatencyInMicroseconds int `json:"maxAllowedLinkLatencyInMicroseconds"`
+}
+
+// AssignHotSpareCountToStoragePoolRequest is the AssignHotSpareCountToStoragePoolRequest model.
+// Used to assign a number of hot spares to a volume group.
+type AssignHotSpareCountToStoragePoolRequest struct {
+	// The number of hot spares to be assigned to the volume group.
+	HotSpareCount int `json:"hotSpareCount"`
+}
+
+// AssignHotSpareCountToStoragePoolResponse is the AssignHotSpareCountToStoragePoolResponse model.
+type AssignHotSpareCountToStoragePoolResponse struct {
+	HotSpares []HotSpare `json:"hotSpares,omitempty"`
+}
+
+// AssignHotSpareRequest is the AssignHotSpareRequest model.
+// Used to assign a drive as a hot spare.
+type AssignHotSpareRequest struct {
+	// A drive ref to the drive to assign as a hot spare.
+	DriveRef string `json:"driveRef"`
+}
+
+// AssignHotSpareResponse is the AssignHotSpareResponse model.
+// A global hot spare is a drive dedicated to a RAID volume group which serves as a temporary replacement drive in
+// the event that a drive in the RAID group fails or becomes out of service.
+type AssignHotSpareResponse struct {
+	// The drive ref of the drive that was assigned as a hot spare.
+	DriveRef string `json:"driveRef"`
+}
+
+// AsupMaintenanceWindowRequest is the AsupMaintenanceWindowRequest model.
+// Request to enable a ASUP maintanence window
+type AsupMaintenanceWindowRequest struct {
+	// True to enable ASUP maintenance window, otherwise false to disable
+	MaintenanceWindowEnabled bool `json:"maintenanceWindowEnabled"`
+	// An optional duration in hours to enable maintenance window (if not provided, the maximum supported duration
+	// will be used)
+	Duration *int `json:"duration,omitempty"`
+	// The list email address to send the confirmation that the ASUP backend processed the maintenance window
+	// request
+	EmailAddresses []string `json:"emailAddresses"`
+}
+
+// AsupMaintenanceWindowResponse is the AsupMaintenanceWindowResponse model.
+// Response to setting ASUP maintanence window
+type AsupMaintenanceWindowResponse struct {
+	// The current ASUP maintenance window state
+	MaintenanceWindowEnabled bool `json:"maintenanceWindowEnabled,omitempty"`
+	// The ASUP maintenance window duration in hours
+	Duration int `json:"duration,omitempty"`
+	// The list of email addresses to send the confirmation that the ASUP backend processed the maintenance window
+	// request
+	EmailAddresses []string `json:"emailAddresses,omitempty"`
+}
+
+// AsyncMirrorGroup is the AsyncMirrorGroup model.
+type AsyncMirrorGroup struct {
+	// The reference (key) for the mirror group.
+	GroupRef string `json:"groupRef,omitempty"`
+	// The world wide name of the mirror group. This can be used to identify the AMG pair on both arrays. The
+	// AsyncMirrorGroupRef is not guaranteed to be unique across multiple arrays.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The user assigned name for the mirror group.
+	Label string `json:"label,omitempty"`
+	// The Mirror Group State. This is determined primarily by the presence or lack of a recovery point. Values:
+	// unknown, initialSync, optimal, degraded, rpFailed.
+	GroupState string `json:"groupState,omitempty"`
+	// The current role of this (local) array with respect to this mirror group. Values: unknown, primary,
+	// secondary.
+	LocalRole string `json:"localRole,omitempty"`
+	// The current role of the peer (remote) array with respect to this mirror group. Values: unknown, primary,
+	// secondary.
+	RemoteRole string `json:"remoteRole,omitempty"`
+	// This field indicates the current state of the role change process. This may be an extended condition due to
+	// the data sync needed for an orderly role change or the recovery point rollback needed for a no-sync or forced
+	// role change. Values: unknown, none, pending, inProgress.
+	RoleChangeProgress string `json:"roleChangeProgress,omitempty"`
+	// The time in minutes between starting points of periodic synchronization intervals. A value of
+	// ARVM_MANUAL_SYNC_INTERVAL indicates synchronization intervals are manually started by the user.
+	SyncIntervalMinutes int `json:"syncIntervalMinutes,omitempty"`
+	// The threshold (in minutes) for notifying the user that periodic synchronization has taken too long to
+	// complete. A value of ARVM_SYNC_COMPLETION_TIME_THRESHOLD_NONE indicates no threshold is set.
+	SyncCompletionTimeAlertThresholdMinutes int `json:"syncCompletionTimeAlertThresholdMinutes,omitempty"`
+	// The recovery point age objective (in minutes). The user is notified via needs-attention when the age of the
+	// last good recovery point exceeds this value. A value of ARVM_RECOVERY_POINT_AGE_THRESHOLD_NONE indicates no
+	// threshold is set.
+	RecoveryPointAgeAlertThresholdMinutes int `json:"recoveryPointAgeAlertThresholdMinutes,omitempty"`
+	// The repository utilization warning threshold (0-100 percent). A needs attention condition will be generated
+	// if the percent of the repository capacity currently utilized exceeds this threshold.
+	RepositoryUtilizationWarnThreshold int `json:"repositoryUtilizationWarnThreshold,omitempty"`
+	// The path to the remote array to be used by this AMG (a reference to the associated RemoteTarget).
+	MirrorChannelRemoteTarget string `json:"mirrorChannelRemoteTarget,omitempty"`
+	// Current synchronization activity. Values: unknown, idle, active, paused, userSuspended, internallySuspended.
+	SyncActivity string `json:"syncActivity,omitempty"`
+	// If true, the mirror group is an orphan.
+	OrphanGroup bool `json:"orphanGroup,omitempty"`
+}
+
+// AsyncMirrorGroupClearRecoveryFailureRequest is the AsyncMirrorGroupClearRecoveryFailureRequest model.
+// The clear asynchronous mirror group recovery failure request.
+type AsyncMirrorGroupClearRecoveryFailureRequest struct {
+	// The recovery failure type to clear. Values: unknownFailure, failedFan, failedPowerSupply, failedMinihub,
+	// failedEsm, batteryNearExpiration, failedBattery, nominalTempExceeded, maxTempExceeded, upsOnBattery,
+	// nonPreferredPath, memParityError, failedDriveScsiChannel, degradedVolume, failedVolumeInterruptedWrite,
+	// failedModifyingVolume, failedModifyingMultiRaid, failedVolume, failedVolumeMultiRaid, impairedVolume,
+	// failedVolumeAwaitingInit, volumeHotSpareInUse, offlineVolumeGroup, failedDrive,
+	// impendingDriveFailureRiskHigh, impendingDriveFailureRiskMed, impendingDriveFailureRiskLow, uncertifiedDrive,
+	// offlineCtl, passiveCtl, partitionsNotCompliant, esmCodeVersionMismatch, lostRedundancyDrive,
+	// lostRedundancyTray, lostRedundancyEsm, trayidMismatch, trayidConflict, failedTransceiverModule,
+	// channelMiswire, nonFruBatteryNearExpiration, nonFruFailedBattery, rpaErrCtl, repositoryOverThreshold,
+	// repositoryFull, snapshotFailed, unsupportedMinihub, esmMiswire, ghostVolume, snapshotNotCompliant,
+	// rvmNotCompliant, metadataOffline, mirrorDualPrimary, mirrorDualSecondary, mirrorUnsynchronized, remoteNoLun,
+	// remoteNoArray, remoteNoFabric, remoteWwnChangeFailed, fanUnknownStat, powerSupplyUnknownStat, esmUnknownStat,
+	// tempSensorUnknownStat, transModUnknownStat, driveBypassedSpeedMismatch, driveBypassedCauseUnknown,
+	// minihubSpeedMismatch, removedEsm, removedBattery, nonFruRemovedBattery, volcopyFailed, volcopyNotCompliant,
+	// channelDegraded, unsupportedTray, speedNegError, usmDatabaseFull, usmUnreadableSectorsExist, pathDegraded,
+	// netSetupError, mismatchedDriveType, ctlMismatch, failedDiscreteLine, channelFailed,
+	// linkSpeedDetectionMismatch, uncertifiedEsm, removedFan, removedPowerSupply, removedTempSensor, removedIccCru,
+	// removedSupportCru, failedIccCru, failedSupportCru, batteryUnknownStat, driveTraysNotGroupedTogether,
+	// goldKeyNotCompliant, mismatchedMdtSettings, mismatchedGoldKeySettings, ctlMiswire, supportCruNoinput,
+	// submodelNotSupported, submodelNotSet, submodelMismatch, failedBatterySystem, removedBatteryPack,
+	// batteryConfigMismatch, esmHardwareMismatch, hostBoardFault, ddcAvailable, replacedDriveWrongType,
+	// redundantPsRequired, driveTraysNotCompliant, sasDeviceLimitExceeded, sasPortMiswired, sasPortDegraded,
+	// sasPortFailed, corruptVpdEeprom, failedI2cBus, enclosureMisconfigured, featureBundleNotCompliant,
+	// driveBypassedSinglePort, esmFactoryDefaultsMismatch, hostBoardUnknownStat, failedHostIoCard, batteryWarn,
+	// volumeGroupPartiallyComplete, volumeGroupIncomplete, volumeGroupMissing, hotspareDriveMissing,
+	// driveIncompatibleUprevDacstore, driveIncompatibleDownrevDacstore, iccCruUnknownStat, removedAlarm,
+	// supportCruUnknownStat, performanceTierNotCompliant, raid6NotCompliant, driveIncompatibleSectorSize,
+	// foreignDriveInconsistent, foreignDriveRefersToNativeDrive, nativeVgRefersToForeignDrive,
+	// nativeVgForeignDriveMutualRef, vgCloned, foreignDriveHasInconsistentRole, vgDrivePartOfMultipleVgs,
+	// incompatibleFailedLegacyDrive, unsupportedCacheMemorySize, dedicatedMirrorChannelFailed,
+	// vgHasDrivePartOfMultipleVgs, ddfDriveOtherVendor, multipleConfigDatabasesDetected,
+	// adoptionFailedRaidLevelUnsupported, dbAdoptionHardLimitExceeded, legacyVgNotOptimal, cacheMemSizeMismatch,
+	// altCtlrBoardIdUnreadable, cacheBackupDeviceFailed, cacheBackupDeviceWriteProtected,
+	// cacheBackupDeviceInsufficientCapacity, expiredBattery, expiredIntegratedBattery, procMemTooSmallForCache,
+	// batteryOvertemp, invalidHostTypeIndex, insufficientProcMemory, ctlFailedCacheBackupDev, removedController,
+	// degradedHostIoCard, ldFwVersionMismatch, ldIncompatibleDatabase, hostBoardIdMismatch, iccMissing,
+	// linkSpeedMismatch, unsupportedHostBoard, writebackCachingDisabled, securityGetKey, securityKeyInconsistent,
+	// securityNotCompliant, mixedDriveEnclosureMiswire, unsuccessIsolationRedunMismatch,
+	// thresExcdedMismatchCorrected, inactiveHostPort, inactiveInitiator, protectionInformationNotCompliant,
+	// protectionInformationNotSupported, replacedInsufficientDriveCapacity, drawerFailed, drawerOpened,
+	// ssdNotCompliant, ssdAtEndOfLife, fibreTrunkMiswire, fibreTrunkIncompatibleEsm, driveSlotLimitNotCompliant,
+	// securityGetNewKey, externalKmsNotCompliant, sbbValidationFailure, invalidPowerSupply,
+	// enclosureThermalShutdown, wbCachingForciblyDisabled, driveUnsupportedProtocolConnection, failedTwiBus,
+	// mismatchedMidplaneEeproms, driveUnsupportedCapacity, evaluationLicenseExpirationImminent,
+	// externalKmsKeyInvalid, multipleMismatchedKeyIdsFound, securityKeyValidationLock, cacheDataLoss,
+	// baseControllerDiagFailed, featureNotCompliant, driveIncompatiblePiType, drawerDegraded, offlineCtlIocFail,
+	// invalidSataFlashConfiguration, iocDiagFail, sasPhyDisabledLocalWidePortDegraded,
+	// sasPhyDisabledSharedWidePortDegraded, drawerInvalid, drawerRemoved, driveUnsupportedInterposerFwVersion,
+	// redundancyGroupNotConsistentDuringReconfig, snapshotRollbackPaused, pitRollbackPaused,
+	// pitGroupRepositoryOverThreshold, pitViewRepositoryOverThreshold, pitGroupRepositoryFull,
+	// pitViewRepositoryFull, pitGroupFailed, pitViewFailed, pitPurged, arvmDegradedMirrorGroup, arvmFailedMirror,
+	// arvmSyncIntervalTimeOverThreshold, arvmRepositoryOverWarnThreshold, arvmMirrorGroupRoleConflict,
+	// incompatibleVolumeGroupSecurity, arvmOrphanGroup, arvmOrphanMember, arvmMirrorGroupRecoveryPointLost,
+	// diskPoolPartiallyPresent, diskPoolIncomplete, diskPoolMissing,
+	// diskPoolReconstructionDriveCountBelowThreshold, diskPoolUtilizationWarning, diskPoolUtilizationCritical,
+	// pendingPitCreationFailed, pendingCgpitCreationFailed, tpvRepositoryOverThreshold, tpvRepositoryFull,
+	// tpvFailed, arvmSecondaryRepositoryFull, arvmSyncInternallySuspended, databaseRecoveryMode,
+	// arvmPrimaryRepositoryFull, incompatibleSataDrive, diskPoolCapacityDepleted,
+	// driveIncompatibleModelNumberUnsupported, flashCacheNonOptimalDrives, flashCacheHotSpareInUse,
+	// allDrivesBypassedIncompatibleNvsram, diskPoolDriveFailure, diskPoolInsufficientMemory,
+	// arvmOrphanIncompleteMember, arvmSyncPausedAltState, arvmRoleChangePaused,
+	// driveIncompatibleAlignmentForEmulationDrive, lossOfExternalRedundancy, rvmWriteModeInconsistent,
+	// sasHostMiswire, sasCrossMiswire, sasLoopMiswire, copyThenFailWaitingOnHotSpare, missingDriveLockdown,
+	// hicConfigurationOoc, copyThenFailWaitingOnDdpCapacity, piErrorServiceMode, piErrorLockdown,
+	// sasPortDiscoveryError, netNtpResolutionFail, netNtpQueryFail, netNtpServiceUnavailable,
+	// multipathConfigurationError, hostRedundancyLost, excessiveRebootsDetected, cacheNotFlushedOnOnlyCtlr,
+	// securityAuditLogFull, securityAuditLogWarnThresh, directoryServicesConfigError,
+	// kmipServerCertificateNearingExpiration, kmipServerCertificateExpired, kmipServerDnsError,
+	// kmipServerCertificateError, kmipServerAuthenticationFailure, kmipServerConnectionFailure, kmipServerError,
+	// kmipServerKeyRequirementNotMet, ocspResponderUnreachable, certificateRevoked, ssdDataLoss,
+	// driveFastFormatFailed, driveFastFormatSuspendNoDatabase, slowInterruptedWriteLogging, driveLostPrimaryPath,
+	// nvmeDriveSpeedError, driveUserAssignmentFailed, cacheBackupDeviceAtEndOfLife, cbaSignatureFailed,
+	// nvsramMismatch, sasPathNonOptimal, auxAltCommLinkError, altCommLinkError, rtgLinkDown, rtvLinkDown,
+	// diskPoolInvalidVersion, driveFwUnverified.
+	RecoveryFailureType string `json:"recoveryFailureType"`
+}
+
+// AsyncMirrorGroupCommunicationData is the AsyncMirrorGroupCommunicationData model.
+// The asynchronous mirror group data on a per controller basis.
+type AsyncMirrorGroupCommunicationData struct {
+	// The timeout in seconds.
+	Timeout int `json:"timeout,omitempty"`
+	// The connectivity test return status. Values: uninitialized, ok, error, busy, illegalParam, noHeap,
+	// driveNotExist, driveNotUnassigned, noSparesAssigned, someSparesAssigned, volumeNotExist, volumeReconfiguring,
+	// notDualActive, tryAlternate, background, notImplemented, reservationConflict, volumeDead, internalError,
+	// invalidRequest, iconFailure, volumeFormatting, altRemoved, cacheSyncFailure, invalidFile,
+	// reconfigSmallDacstore, reconfigFailure, nvramError, flashError, authFailParam, authFailPassword,
+	// memParityError, invalidControllerref, invalidVolumegroupref, invalidVolumeref, invalidDriveref,
+	// invalidFreeextentref, volumeOffline, volumeNotOptimal, modesenseError, invalidSegmentsize,
+	// invalidCacheblksize, invalidFlushThreshold, invalidFlushAmount, invalidLabel, invalidCacheModifier,
+	// invalidReadahead, invalidReconpriority, invalidScanperiod, invalidTrayposLength, invalidRegionid,
+	// invalidFibreid, invalidEncryption, invalidRaidlevel, invalidExpansionList, noSparesDeassigned,
+	// someSparesDeassigned, partDupId, partLabelInvalid, partNodeNonexistent, partPortIdInvalid,
+	// partVolumeNonexistent, partLunCollision, maxVolMappingExceeded, partMappingNonexistent, partNoHostports,
+	// imageTransferred, fileTooLarge, invalidOffset, overrun, invalidChunksize, invalidTotalsize,
+	// downloadNotPermitted, spawnError, voltransferError, invalidDlstate, cacheconfigError, downloadInProgress,
+	// driveNotOptimal, driveRemoved, duplicateDrives, numdrivesAdditional, numdrivesGroup, driveTooSmall,
+	// capacityConstrained, maxVolumesExceeded, partIsUtmLun, someSparesTooSmall, sparesSmallUnassigned,
+	// tooManyPartitions, parityScanInProgress, invalidSafeId, invalidSafeKey, invalidSafeCapability,
+	// invalidSafeVersion, partitionsDisabled, driveDownloadFailed, esmDownloadFailed, esmPartialUpdate,
+	// utmConflict, noVolumes, authFailReadpassword, partCrteFailTblFull, attemptToSetLocal, invalidHostTypeIndex,
+	// failVolumeVisible, noDeleteUtmInUse, invalidLun, utmTooManyMaps, diagReadFailure, diagSrcLinkDown,
+	// diagWriteFailure, diagLoopbackError, diagTimeout, diagInProgress, diagNoAlt, diagIconSendErr, diagInitErr,
+	// diagModeErr, diagInvalidTestId, diagDriveErr, diagLockErr, diagConfigErr, diagNoCacheMem, diagNotQuiesced,
+	// diagUtmNotEnabled, invalidModeSwitch, invalidPortname, duplicateVolMapping, maxSnapsPerBaseExceeded,
+	// maxSnapsExceeded, invalidBasevol, snapNotAvailable, notDisabled, snapshotFeatureDisabled, repositoryOffline,
+	// repositoryReconfiguring, rollbackInProgress, numVolumesGroup, ghostVolume, repositoryMissing,
+	// invalidRepositoryLabel, invalidSnapLabel, invalidRollbackPriority, invalidWarnThreshold, cannotMapVolume,
+	// cannotFormatVolume, dstNotFibre, repositoryTooSmall, repositoryFailed, baseVolumeFailed, baseVolumeOffline,
+	// baseVolumeFormatting, metadataVolNonexistent, rvmFeatureDisabled, mirrorsPresent, rvmFeatureDeactivated,
+	// maxMirrorsExceeded, invalidMirrorCandidateVol, invalidMirrorvol, metadataAlreadyExists, metadataMissing,
+	// metadataOffline, metadataReconfiguring, localRoleChangeFailed, remoteRoleChangeFailed,
+	// localRoleChangeSuccessful, onlyLocalMirrorDeleted, noValidMirrorCandidate, remoteMaxMirrorsExceeded,
+	// remoteRvmFeatureDisabled, remoteMetadataVolNonexistent, notRegistered, remoteInvalidCfgGen,
+	// localRoleChangedNotForced, remoteRoleChangedLocalFailed, rvmSpmError, remoteAuthFailPassword,
+	// rvmVersionMismatch, rvmRemoteArrayError, rvmCommunicationError, rvmFibreError, mirrorVolNotPrimary,
+	// secNotPromoteable, priNotDemoteable, metadataChildDeletion, rmtvolOrphanDeletion, rvmActivateDisallowed,
+	// invalidTrayref, partialDeletion, defaultUtmCollision, invalidCopyPriority, invalidVolumecopyref,
+	// copyChangeFailed, copyActive, copyInactive, copyIncompatibleSource, copyIncompatibleTarget, copyGhostSource,
+	// copyGhostTarget, copyInvalidSourceRef, copyInvalidTargetRef, copyInvalidSourceState, copyInvalidTargetState,
+	// copySourceReconfig, copyTargetReconfig, copyTargetTooSmall, copyTargetLimit, maxVolumeCopysExceeded,
+	// copySourceReservation, copyTargetReservation, copySourceFormat, copyTargetFormat, copyStartFailed,
+	// copyStopFailed, volcopyFeatureDisabled, writeLock, cannotReconfigure, authFailContLockout,
+	// prReservationConflict, regDeleteFailed, batteryNotInConfig, batteryMissing, noChannel,
+	// rvmOperNotAllowedOnSec, dataRedundancyRequired, copySourceZeroCapacity, invHostlunDefineMapping,
+	// invHostlunMoveMapping, invHostlunDefineHosttype, invHostlunMoveHostport, fwIncompatible,
+	// mirrorAlreadySuspended, insuffLocalMirRepResources, insuffRemtMirRepResources, ghostHasUnreadableSectors,
+	// rvmCommStatRecoveredTimeout, rvmCommStatRecoveredDelay, rvmCommStatNotReady, rvmCommStatTimeout,
+	// rvmCommStatChannelFailure, rvmCommStatNetworkFailure, rvmCommStatDeviceMissing, rvmCommStatLoginRejected,
+	// rvmCommStatLoginFailure, rvmCommStatInvNumSamplesReqd, rvmQuiescenceInProgress, rvmInvalidRemotevol,
+	// sodInProgress, invalidDrives, invalidSetid, invalidSetsize, missingData, quiescenceFailed, validationError,
+	// downloadHalted, allFailed, partialOk, obsolete, usmClearFailed, controllerInServiceMode, invalidDrive,
+	// databaseError, backgroundAutocfg, autocfgInprogress, unsupportedLhaSataEsm, parityScanFailed,
+	// parityRepairFailed, mediaRepairFailed, mirrorDegraded, prohibitedByMdtRestrictions,
+	// prohibitedByGoldKeyRestrictions, safeControllerNotSubjectToGoldKey, safeMdtNotPremiumFeature,
+	// alarmNotPresent, dltNotCompleted, dependancyError, cdmDatabaseFull, requiredConditionNotPresent, ddcUnavail,
+	// ddcIllegalParam, invalidDdcTag, hosttypeConflict, portConflict, invalidHosttypeString, invalidProtocol,
+	// portRemoved, disableNotPermitted, prohibitedByDriveTrayLimit, invalidEsmref, invalidBundleMigration,
+	// invalidBundleKey, noSparesNeeded, prohibitedByFeatureBundleViolation, invalidAuthMethod, invalidSecret,
+	// secretAlreadyInUse, manualConfigModeSet, noIscsiSessions, invalidInterfaceref, initiatorConflict,
+	// initiatorRemoved, basevolSizeChanged, volumeGroupNotExist, volumeGroupNotOnline, volumeGroupHasHotspare,
+	// volumeGroupReconfiguring, volumeGroupStateNotValid, controllerNotOptimal, insufficientCapacity,
+	// volumeGroupExported, volumeNotConfigurable, volumeGroupNotConfigurable, invalidDriveState,
+	// volumeGroupReconstructing, volumeGroupUndergoingCopyback, volumeGroupNotComplete, volumeGroupHasFailedDrives,
+	// volumeGroupHasNonOptimalVols, volumeGroupHasMirrorRelationship, volumeGroupHasVolcopyRelationship,
+	// volumeGroupHasMirroringMetadata, volumeGroupHasMappedVols, volumeGroupHasReservations,
+	// volumeGroupHasIncompatibleDacstores, volumeLimitExceeded, volumeGroupHasUnknownRaidLevel,
+	// volumeGroupHasUnsupportedRaidLevel, volumeGroupHasCloneOpportunity, volumeGroupHasInsufficientDrives,
+	// volumeGroupHasFailedVols, perfTierSafeUpgradeDisabled, raid6FeatureUnsupported, raid6FeatureDisabled,
+	// safeControllerNotSubjectToRaid6, volumeGroupNotContingent, channelDiagsRunning, channelDiagsResultsPartial,
+	// volumeGroupHasSnapshotRelationship, prohibitedBySafeViolation, legacyVg, vgNotForceable, channelDiagsLockErr,
+	// channelDiagsNotQuiesced, channelDiagsAltCommFailed, channelDiagsChanSetupFailed,
+	// channelDiagsDeviceBypassFailed, channelDiagsResultsNotAvailable, driveSpinUpError, driveTypeMismatch,
+	// localRemoteArrayHasSameWwn, volumeGroupHasIncompatibleDrive, volumeGroupVolumeEncroachesOnDacstore,
+	// volumeGroupImportInProgress, drivesNeedToBeSpunUp, noNativeSstor, noSuchDebugChunk, debugInfoConfigChanged,
+	// lockdown, drivesDacstoresOverlap, volumeHasAsyncMirror, reconfigLogSpaceError, volumeGroupInaccessible,
+	// volumeInitializing, insufficientCache, volumeInaccessible, noDrivesAdopted, someDrivesAdopted,
+	// exportingDrivesDatabaseResynchronizing, exportingDrivesDatabaseFailed, exportingDrivesQuiesced,
+	// learnActiveTryLater, noLockedDrives, driveSecurityEnabledFailed, lockkeyFailed, invalidSecurity, noFdeDrives,
+	// volumeGroupSecure, invalidBlob, unlockFailed, noKeySet, rekeyInProgress, defaultHostGroupMappingNotAllowed,
+	// ssdMediaScanNotAllowed, premiumFeatureLimitExceedsMaximum, disableEvaluationFeatureNotPermitted,
+	// requestFailedDueToLun0Restrictions, externalKmsEnabled, externalKmsFailed, externalKmsNotEnabled,
+	// keyNotNeeded, keyInvalidSequence, diagNotRunning, ctrlNotInServiceMode, invalidFeatureref,
+	// cacheBackupDevNotExist, noMatchingLockKeyIdFound, lockKeyValidationFailed, lockKeyValidationDisabled,
+	// externalKmsNotCompliant, externalKmsTimeout, cannotDisableNoKey, previouslyEnabledForEval, featureNotKeyable,
+	// evalNotSupported, rawdataTransferBadType, rawdataTransferNotStarted, rawdataTransferAlreadyStarted,
+	// rawdataTransferPreparing, rawdataTransferReadError, rawdataTransferNoDrives, rawdataTransferInvalidImage,
+	// rawdataTransferCrcError, dbmRestoreWriteError, dbmRestoreNoDrives, rawdataBadSeqNum, invalidCapability,
+	// externalKeyNotInMemory, invalidLockKeyId, invalidProtection, volumeHasSnapshotRelationship,
+	// volumeHasMirrorRelationship, externalKmsDisabledNoKey, dbmRestoreAltCtlNotOffline, copyApptagMismatch,
+	// invalidRequestForEnclosure, dqRetrieveNothingToTransfer, invalidIscsiConfiguration,
+	// volumeHasVolcopyRelationship, partPiIncapable, requestFailedDueToPiRestrictions,
+	// rawdataTransferUserCancelled, duplicateIscsiIpAddress, portSpeedConflict, factoryDefaultDownloadFailed,
+	// errorWritingToEeprom, factoryDefaultPartialUpdate, snapshotNotActive, cannotRollback, mirrorSyncNotPossible,
+	// psuFirmwareDownloadFailed, psuFirmwareUpdateMfgDeviceCodeMismatch, psuFirmwareUpdateNotAllRedundant,
+	// psuFirmwareUpdateNotAllOptimal, insufficientRepositoryCapacity, rollbackStartFailure, csbReserveFailed,
+	// csbReleaseFailedNoLock, csbReleaseFailedInvalidKey, flashcacheAlreadyExists, flashcacheFeatureDisabled,
+	// flashcacheAlreadySuspended, flashcacheNotSuspended, flashcacheInvalidConfigType, invalidPitGroupLabel,
+	// invalidPitConsistencyGroupLabel, invalidPitAutoDeleteLimit, invalidPitRepositoryFullPolicy,
+	// invalidConcatVolMemberLabel, concatVolMemberTooSmall, invalidPitGroupRef, invalidPitRef, dveNotAllowed,
+	// dssNotAllowed, dplCoreDumpInvalidTag, invalidPitViewLabel, invalidPitViewRef, invalidConcatVolRef,
+	// notFlashcacheVol, flashcacheDeleted, flashcacheEnabled, flashcacheNotEnabled, noRepDeletion,
+	// maxPitsPerGroupExceeded, maxPitsExceeded, maxPitGroupsPerBaseExceeded, maxPitGroupsExceeded,
+	// maxViewsPerPitExceeded, maxViewsExceeded, maxConsistencyGroupsExceeded, maxConsistencyGroupMembersExceeded,
+	// maxMappableVolumesExceeded, notOldestPit, viewStopped, concatMemberLimitExceeded, invalidMemberVol,
+	// memberVolMapped, invalidMemberVolState, invalidTrimCount, pitGroupInConsistencyGroup, pitInConsistencyGroup,
+	// pitViewInConsistencyGroup, incompatibleMemberVol, volumeInUse, rvmOverIscsiNotSupported,
+	// arvmGroupUserLabelExists, arvmGroupDoesNotExist, arvmGroupNotEmpty, concatVolumeFailed,
+	// invalidPitConsistencyGroupRef, invalidPitConsistencyGroupViewRef, invalidPitConsistencyGroupViewLabel,
+	// alternateRequiredForOperation, invalidPitForView, consistencyGroupArvmBindingConflict, attributeFixedByArvm,
+	// operationFailedVolumeCopyClone, pitCreatePending, dbmDbSourceUnavailable, dbmRestoreSourceMismatch,
+	// invalidCriticalThreshold, volumeGroupHasArvmRelationship, arvmRecoveryPointDeletionRequired,
+	// volumeGroupHasPitgroupRelationship, volumeGroupHasPitviewRelationship, volumeGroupHasConcatRelationship,
+	// flashcacheSuspended, flashcacheAlreadyEnabled, dbmDbImageCorrupt, illegalVolume, invalidRepositoryCapacity,
+	// invalidProvisionedCapacityQuota, invalidExpansionPolicy, invalidVirtualCapacity, cannotExpandConcatMember,
+	// thresholdBelowUsedCapacity, invalidExpansionOperation, repositoryFull, insufficientExpansionSpace,
+	// invalidExpansionSize, invalidReinitAction, invalidReinitCapacity, invalidIncompleteMemberRef,
+	// arvmGroupNotPrimary, arvmGroupNotSecondary, arvmMemberFailed, arvmGroupNotSuspended, arvmInvalidMirrorState,
+	// arvmVolumeAlreadyInMirrorRelationship, arvmMemberLimitExceeded, arvmSuspendFailure, arvmResumeFailure,
+	// arvmSynchronizeFailure, remoteTargetNotFound, arvmMirrorMemberDoesNotExist, snapConversionTooManySnaps,
+	// snapConversionMissingLabel, arvmFeatureDeactivated, incompatibleRepositorySecurity,
+	// incompatibleSecondarySecurity, mirrorProtocolMismatch, arvmAsyncMirrorGroupPresent,
+	// cacheParametersNotChangeable, flashcacheMaxCapacityExceeded, flashcacheFailed, dplCoreDumpRestoreInProgress,
+	// arvmGroupHasIncompleteMember, arvmConnectivityTestAlreadyInProgress, arvmConnectivityTestNetworkError,
+	// arvmConnectivityTestRemoteTimeout, arvmConnectivityTestLoginFailure, arvmConnectivityTestNameServiceError,
+	// arvmConnectivityTestTurError, arvmConnectivityTestMissingRemoteAmg, arvmConnectivityTestAmgMemberMismatch,
+	// invalidSyncPriority, invalidRecoveryPointAlertThreshold, invalidSyncAlertThreshold,
+	// mustSpecifyExistingVolumes, arvmConnectivityTestTimeoutExceeded, flashcacheMaxLimitExceeded,
+	// volsInVgUsingNonSecureCapableFlashcache, volsInVgUsingSecureDisabledFlashcache, invalidSubmodelId,
+	// premiumFeatureLimitMismatch, volumeGroupNotImportable, primaryCacheSizeMismatch, flashcacheUserLabelExists,
+	// maxThinVolumesExceeded, arvmInvalidSecondaryCapacity, arvmOnlyPrimaryMemberRemoved,
+	// arvmOnlySecondaryMemberRemoved, arvmInvalidAmgRequestWhileSuspended, arvmManualSyncAlreadyInProgress,
+	// arvmManualSyncRetryTooSoon, diskPoolNotEmpty, flashCacheInvalidBaseVol, flashCacheFdeEnablementDisallowed,
+	// remoteArvmFeatureDeactivated, remoteArvmFeatureDisabled, arvmOrphanGroup, arvmOrphanMember,
+	// volumeNotAvailable, volumeHasUnreadableSectors, thinProvisioningFeatureDisabled, pitGroupsFeatureDisabled,
+	// exceedDiskPoolLimit, flashcacheDegradedState, flashcacheNonDaCapableDriveDisallowed,
+	// arvmMaxAsyncMirrorGroupsExceeded, arvmMaxMirrorsPerArrayExceeded, maxTotalMirrorsPerArrayExceeded,
+	// exceedDiskPoolCapacity, exceedMaxVolumeCapacity, arvmRemoteMaxAsyncMirrorGroupsExceeded,
+	// arvmRemoteMaxMirrorsPerArrayExceeded, remoteMaxTotalMirrorsPerArrayExceeded, arvmInvalidSyncInterval,
+	// remoteNoHeap, remoteInternalError, remoteRvmSpmError, arvmRemoteMirrorMemberDoesNotExist,
+	// arvmRemoteGroupUserLabelExists, arvmRemoteGroupNotSecondary, arvmRemoteGroupDoesNotExist,
+	// remoteInvalidProtection, remoteDatabaseError, arvmRemoteGroupNotEmpty, arvmRemoteSuspendFailure,
+	// arvmRemoteResumeFailure, arvmRemoteSynchronizeFailure, flashcacheInvalidAnalyticsState,
+	// arvmExpansionSynchronizationInProgress, arvmRemoteExpansionSynchronizationInProgress,
+	// faultConditionStillExists, remoteTryAlternate, arvmOnlyLocalAmgDeleted, arvmRoleChangePending,
+	// arvmRoleChangeInProgress, arvmMemberStopped, reconstructionInProgress, copybackInProgress,
+	// adminPasswordNotSet, keyDoesNotExist, takeRecoveryActionsFirst, coredumpBackupInProgress,
+	// legacyRvmAsyncModeUnsupported, arvmIncorrectVolumeType, thinVolumeParametersCannotBeModified,
+	// arvmRemoteThinNotSupported, snmpInvalidCommunityName, snmpInvalidCommunityPermission,
+	// snmpInvalidCommunityRef, snmpInvalidTrapDestinationRef, invalidIpAddress, snmpMaxCommunitiesExceeded,
+	// snmpMaxTrapDestinationsExceeded, snmpCommunityNameInUse, snmpTrapDestinationAddressInUse,
+	// snmpUnknownSystemVariable, snmpInvalidSystemVariableValue, snmpIncompatibleFirmware, snmpAgentDisabled,
+	// snmpAgentInitFailed, arvmThinVolInitError, arvmRemoteThinVolInitError, snmpIncompatibleIpv4Address,
+	// snmpIncompatibleIpv6Address, drivesNotAvailableForRemoval, snmpCannotDisableIpv4, snmpCannotDisableIpv6,
+	// snmpIpv4ConfigError, iocDumpInProgress, iocRestoreInProgress, iocDumpInvalidTag, unsupportedEsmRequest,
+	// isnsDhcpNotSupported, dpcVolumeGroupNotRedundant, dpcVolumeNotInitialized, dpcExclusiveOperationActive,
+	// dpcUnableToPowerUpDrive, dpcFormatActive, dpcUnreadableSectorsPresent, dpcPowerCycleAlreadyInProgress,
+	// dpcEnclosureHardwareUnsupported, dpcEnclosureFwDownlevel, evacInProgress, noEvacFound, noHotspareAvailable,
+	// driveServiceInProgress, hdd4kbSegmentsizeNotAllowed, diskPoolNoSpareDrives, diskPoolExceedSpareCapacity,
+	// autoLoadBalanceUserDisabled, autoLoadBalanceInsufficientStatistics, invalidLoadBalanceAction,
+	// invalidLoadBalanceDelay, reservedAddress, volumeCreationInProgress, keyValueTagInvalidRef,
+	// keyValueTagInvalidDuplicate, keyValueTagInUse, workloadInvalidRef, invalidKeyValueTagObjectReference,
+	// mappingInvalidDuplicate, downloadCompleteNoReboot, downloadCompleteMswOnlyReboot, workloadInvalidDuplicate,
+	// mappingInvalidRef, workloadInUse, embeddedExternalKeyManagementEnabled,
+	// embeddedExternalKeyManagementCertificatesNotInstalled, illegalOperationOnAllConfigDrives,
+	// databaseResyncInProgress, embeddedEkmsKeyserverInfoMissing, embeddedEkmsClientKeyMissing,
+	// embeddedEkmsClientCertMissing, embeddedEkmsClientCertMismatch, embeddedEkmsCertExpired,
+	// embeddedEkmsProxyNotResponding, embeddedEkmsAuthenticationFailure, embeddedEkmsKeyOwnerRequired,
+	// embeddedEkmsServerError, embeddedEkmsServerCertMissing, embeddedEkmsCertDuplicate, embeddedEkmsCertInvalid,
+	// embeddedEkmsConnectionFailure, invalidPasswordLength, certRevoked, missingSignature, sigValidationFailed,
+	// unableToConnectToOcspResponder, requestFailedDueToAlbEnabled, driveLacksPathRedundancy,
+	// driveSecurityErasePsidFailed, driveSecurityEraseInvalidPsid, driveDulbeIncompatible,
+	// setControllerTimeInvalidNtpEnabled, remoteTargetVolumeFeatureDisabled, remoteTargetVolumesOverallMaxExceeded,
+	// remoteTargetGroupDoesNotExist, remoteTargetGroupsOverallMaxExceeded, remoteTargetGroupNotEmpty,
+	// remoteTargetGroupNotFound, remoteTargetVolumeNotFound, remoteTargetVolumeConnectionFailure,
+	// essErrSecretKeyCollision, essErrSecretKeyUnknown, essErrSecretKeyInvalid, incompatibleBlockSizes,
+	// invalidBlockSize, essErrSecretDataSizeInvalid, essErrSecretGroupSizeInvalid, essErrSecretInUse,
+	// essErrSecretGroupNotFound, ioTransportSecretValidationDisabled, alternateLockdown, speedFecModeConflict,
+	// remoteTargetGroupNotOptimal, snmpInvalidUsmUserEngineIdType, snmpInvalidUsmUserEngineId,
+	// snmpInvalidUsmUserName, snmpInvalidUsmUserPermission, snmpInvalidUsmUserSecurityLevel,
+	// snmpInvalidUsmUserAuthProtocol, snmpInvalidUsmUserAuthPassword, snmpInvalidUsmUserPrivProtocol,
+	// snmpInvalidUsmUserPrivPassword, snmpInvalidUsmUserRef, snmpCommunityExists, snmpUsmUserExists,
+	// snmpMaxUsmUsersExceeded, snmpMissingUsmUserEngineId, snmpMissingUsmUserPrivPassword,
+	// snmpMissingUsmUserAuthPassword, snmpUsmUserEngineIdExists, externalKmsOldKeyNotDeleted,
+	// targetPortLunMappingUnsupported, diskPoolUseOnly.
+	ReturnStatus string `json:"returnStatus,omitempty"`
+	// True if the test was successful, otherwise false.
+	Successful bool `json:"successful,omitempty"`
+	// The controller reference.
+	ControllerRef string `json:"controllerRef,omitempty"`
+	// The asynchronous mirror group reference.
+	AmgRef string `json:"amgRef,omitempty"`
+	// The connectivity test type. Values: connectivityTestUnknown, basicConnectivityTest, linkLatencyTest,
+	// linkBandwidthTest.
+	TestType string `json:"testType,omitempty"`
+	// The asynchronous mirror group link bandwidth test results.
+	BwTestResult AsyncMirrorGroupLinkBandwidthTestResults `json:"bwTestResult,omitzero"`
+	// The asynchronous mirror group link latency test results.
+	LatTestResult AsyncMirrorGroupLinkLatencyTestResults `json:"latTestResult,omitzero"`
+}
+
+// AsyncMirrorGroupConnectionsResponse is the AsyncMirrorGroupConnectionsResponse model.
+// The asynchronous mirror group connections response.
+type AsyncMirrorGroupConnectionsResponse struct {
+	// The asynchronous mirror group reference.
+	AmgRef string `json:"amgRef,omitempty"`
+	// The list of connections to the remote storage system.
+	Connections []AsyncMirrorGroupRemoteConnection `json:"connections,omitempty"`
+}
+
+// AsyncMirrorGroupConnectivityTestRequest is the AsyncMirrorGroupConnectivityTestRequest model.
+// The asynchronous mirror group connectivity test request.
+type AsyncMirrorGroupConnectivityTestRequest struct {
+	// The type of test to perform. Values: connectivityTestUnknown, basicConnectivityTest, linkLatencyTest,
+	// linkBandwidthTest.
+	RequestedTestType string `json:"requestedTestType,omitempty"`
+	// The number of iterations to run.
+	TestIterations *int `json:"testIterations,omitempty"`
+	// The timeout in seconds. To specify no timeout, set the value to -1.
+	Timeout *int `json:"timeout,omitempty"`
+}
+
+// AsyncMirrorGroupCreateRequest is the AsyncMirrorGroupCreateRequest model.
+// The asynchronous mirror group create request. This is issued to the storage system designated to the primary
+// role.
+type AsyncMirrorGroupCreateRequest struct {
+	// The user label to assign to the new asynchronous mirror group.
+	Name string `json:"name"`
+	// The id of the secondary storage system.
+	SecondaryArrayID string `json:"secondaryArrayId"`
+	// The sync interval in minutes.
+	SyncIntervalMinutes *int `json:"syncIntervalMinutes,omitempty"`
+	// True to set the synchronization method to manual, otherwise false. Note, when the synchronization method is
+	// set to manual, the other synchronization fields in the request will be ignored.
+	ManualSync *bool `json:"manualSync,omitempty"`
+	// The recovery warning threshold in minutes. Note, the user will be warned when the age of the last good
+	// failures point exceeds this value.
+	RecoveryWarnThresholdMinutes *int `json:"recoveryWarnThresholdMinutes,omitempty"`
+	// The repository utilization warning threshold percentage.
+	RepoUtilizationWarnThreshold *int `json:"repoUtilizationWarnThreshold,omitempty"`
+	// The intended protocol to use if both Fibre and iSCSI are available. Values: fibre, fibreAndIscsi, iscsi,
+	// none.
+	InterfaceType string `json:"interfaceType,omitempty"`
+	// The threshold in minutes for notifying the user that periodic synchronization has taken too long to complete.
+	SyncWarnThresholdMinutes *int `json:"syncWarnThresholdMinutes,omitempty"`
+}
+
+// AsyncMirrorGroupEx is the AsyncMirrorGroupEx model.
+// Asynchronous Mirror Group
+type AsyncMirrorGroupEx struct {
+	// The reference (key) for the mirror group.
+	GroupRef string `json:"groupRef,omitempty"`
+	// The world wide name of the mirror group. This can be used to identify the AMG pair on both arrays. The
+	// AsyncMirrorGroupRef is not guaranteed to be unique across multiple arrays.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The user assigned name for the mirror group.
+	Label string `json:"label,omitempty"`
+	// The Mirror Group State. This is determined primarily by the presence or lack of a recovery point. Values:
+	// unknown, initialSync, optimal, degraded, rpFailed.
+	GroupState string `json:"groupState,omitempty"`
+	// The current role of this (local) array with respect to this mirror group. Values: unknown, primary,
+	// secondary.
+	LocalRole string `json:"localRole,omitempty"`
+	// The current role of the peer (remote) array with respect to this mirror group. Values: unknown, primary,
+	// secondary.
+	RemoteRole string `json:"remoteRole,omitempty"`
+	// This field indicates the current state of the role change process. This may be an extended condition due to
+	// the data sync needed for an orderly role change or the recovery point rollback needed for a no-sync or forced
+	// role change. Values: unknown, none, pending, inProgress.
+	RoleChangeProgress string `json:"roleChangeProgress,omitempty"`
+	// The time in minutes between starting points of periodic synchronization intervals. A value of
+	// ARVM_MANUAL_SYNC_INTERVAL indicates synchronization intervals are manually started by the user.
+	SyncIntervalMinutes int `json:"syncIntervalMinutes,omitempty"`
+	// The threshold (in minutes) for notifying the user that periodic synchronization has taken too long to
+	// complete. A value of ARVM_SYNC_COMPLETION_TIME_THRESHOLD_NONE indicates no threshold is set.
+	SyncCompletionTimeAlertThresholdMinutes int `json:"syncCompletionTimeAlertThresholdMinutes,omitempty"`
+	// The recovery point age objective (in minutes). The user is notified via needs-attention when the age of the
+	// last good recovery point exceeds this value. A value of ARVM_RECOVERY_POINT_AGE_THRESHOLD_NONE indicates no
+	// threshold is set.
+	RecoveryPointAgeAlertThresholdMinutes int `json:"recoveryPointAgeAlertThresholdMinutes,omitempty"`
+	// The repository utilization warning threshold (0-100 percent). A needs attention condition will be generated
+	// if the percent of the repository capacity currently utilized exceeds this threshold.
+	RepositoryUtilizationWarnThreshold int `json:"repositoryUtilizationWarnThreshold,omitempty"`
+	// The path to the remote array to be used by this AMG (a reference to the associated RemoteTarget).
+	MirrorChannelRemoteTarget string `json:"mirrorChannelRemoteTarget,omitempty"`
+	// Current synchronization activity. Values: unknown, idle, active, paused, userSuspended, internallySuspended.
+	SyncActivity string `json:"syncActivity,omitempty"`
+	// If true, the mirror group is an orphan.
+	OrphanGroup bool `json:"orphanGroup,omitempty"`
+	// The connection type used to create this mirror group. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib,
+	// fcoe, nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	ConnectionType string `json:"connectionType,omitempty"`
+	// The WWN of the target array in the mirroring relationship. This field may not be immediately available after
+	// an AMG is created.
+	RemoteTargetWWN string `json:"remoteTargetWwn,omitempty"`
+	// The user label of the target array in the mirroring relationship. This field may not be immediately available
+	// after an AMG is created, and will not be available in embedded mode.
+	RemoteTargetName string `json:"remoteTargetName,omitempty"`
+	// The id of the target array in the mirroring relationship. This field may not be immediately available after
+	// an AMG is created, and will not be available in embedded mode.
+	RemoteTargetID string `json:"remoteTargetId,omitempty"`
+	// The connection information for the remote StorageSystem.
+	RemoteTarget RemoteTarget `json:"remoteTarget,omitzero"`
+	ID           string       `json:"id,omitempty"`
+}
+
+// AsyncMirrorGroupIncompleteMember is the AsyncMirrorGroupIncompleteMember model.
+type AsyncMirrorGroupIncompleteMember struct {
+	// The reference (key) for the group member.
+	MemberRef string `json:"memberRef,omitempty"`
+	// The associated Async Mirror Group.
+	GroupRef string `json:"groupRef,omitempty"`
+	// The WWN of the primary volume on the remote array.
+	PrimaryVolWWN string `json:"primaryVolWWN,omitempty"`
+	// Capacity of the primary (used to aid in selection of mirror secondary volume).
+	PrimaryVolCapacity string `json:"primaryVolCapacity,omitempty"`
+	// RAID level of the primary volume. Values: raidUnsupported, raidAll, raid0, raid1, raid3, raid5, raid6,
+	// raidDiskPool, raid1p.
+	PrimaryVolRAIDLevel string `json:"primaryVolRAIDLevel,omitempty"`
+	// The protection type of the primary volume. Values: type0Protection, type1Protection, type2Protection,
+	// type3Protection.
+	PrimaryVolProtectionType string `json:"primaryVolProtectionType,omitempty"`
+	// Security type of the primary volume. Values: unknown, none, capable, enabled.
+	PrimaryVolSecurityType string `json:"primaryVolSecurityType,omitempty"`
+	// User label of the primary volume.
+	PrimaryVolUserLabel string `json:"primaryVolUserLabel,omitempty"`
+	// If true, the incomplete member is an orphan.
+	OrphanIncompleteMember bool `json:"orphanIncompleteMember,omitempty"`
+	// The capacity provisioning parameters for the primary volume.
+	PrimaryVolumeParameters VolumeTypeParameters `json:"primaryVolumeParameters,omitzero"`
+	// Refines the information in the securityType field to describe the set of drives. Values: unknown, none,
+	// mixed, fde, fips.
+	PrimaryVolSecurityLevel string `json:"primaryVolSecurityLevel,omitempty"`
+	// The block size (in bytes) of the volume.
+	BlkSize int `json:"blkSize,omitempty"`
+	// This reports the physical block size (in bytes) used when sending I/O to the underlying drives.
+	BlkSizePhysical int `json:"blkSizePhysical,omitempty"`
+}
+
+// AsyncMirrorGroupLinkBandwidthTestResults is the AsyncMirrorGroupLinkBandwidthTestResults model.
+type AsyncMirrorGroupLinkBandwidthTestResults struct {
+	// The bandwidth test also returns latency values since latency numbers are measured in order to factor them out
+	// of the bandwidth calculations.
+	LatencyMeasures AsyncMirrorGroupLinkLatencyTestResults `json:"latencyMeasures,omitzero"`
+	// The minimum bandwidth measured in bits per second.
+	MinBandwidthBitsPerSecond string `json:"minBandwidthBitsPerSecond,omitempty"`
+	// The maximum bandwidth measured in bits per second.
+	MaxBandwidthBitsPerSecond string `json:"maxBandwidthBitsPerSecond,omitempty"`
+	// The average bandwidth measured in bits per second.
+	AvgBandwidthBitsPerSecond string `json:"avgBandwidthBitsPerSecond,omitempty"`
+	// The negotiated link speed measured in bits per second.
+	NegotiatedLinkSpeedBitsPerSecond string `json:"negotiatedLinkSpeedBitsPerSecond,omitempty"`
+}
+
+// AsyncMirrorGroupLinkLatencyTestResults is the AsyncMirrorGroupLinkLatencyTestResults model.
+type AsyncMirrorGroupLinkLatencyTestResults struct {
+	// The minimum latency measured in microseconds.
+	MinLatencyInMicroseconds int `json:"minLatencyInMicroseconds,omitempty"`
+	// The maximum latency measured in microseconds.
+	MaxLatencyInMicroseconds int `json:"maxLatencyInMicroseconds,omitempty"`
+	// The average latency measured in microseconds.
+	AvgLatencyInMicroseconds int `json:"avgLatencyInMicroseconds,omitempty"`
+}
+
+// AsyncMirrorGroupMember is the AsyncMirrorGroupMember model.
+type AsyncMirrorGroupMember struct {
+	// The reference (key) for the group member.
+	MemberRef string `json:"memberRef,omitempty"`
+	// The AMG with which this member is associated.
+	MirrorGroup string `json:"mirrorGroup,omitempty"`
+	// The local volume for this mirror. This is the volume being mirrored if the AMG role is primary, otherwise
+	// this is the target of mirror synchronization.
+	LocalVolume string `json:"localVolume,omitempty"`
+	// The remote volume. This is the target of mirror synchronization on the remote array if the AMG role is
+	// primary, otherwise this is the volume on the remote array that is being mirrored.
+	RemoteVolume string `json:"remoteVolume,omitempty"`
+	// The state of this mirror relationship (as known by this array). Values: unknown, initialSync, optimal,
+	// failed, incomplete, orphan, stopped.
+	MemberState string `json:"memberState,omitempty"`
+	// The timestamp, represented in seconds since midnight GMT on January 1, 1970, of the last recovery point PiT
+	// on secondary. If no recovery point image exists, the value of this field will be
+	// ARVM_NO_RECOVERY_POINT_TIMESTAMP.
+	LastRecoveryPointTime string `json:"lastRecoveryPointTime,omitempty"`
+	// If true, the repository capacity utilized is over the warning threshold.
+	OverUtilizationWarnThreshold bool `json:"overUtilizationWarnThreshold,omitempty"`
+	// The current local volume capacity that is being mirrored. This will be less than the actual local volume
+	// capacity if we have not yet reached a point where the next PiT can be taken and delta-logs resized (and until
+	// that point the expanded local capacity will not be opened up to host writes).
+	MirroredLocalCapacity string `json:"mirroredLocalCapacity,omitempty"`
+	// The metadata volume (Copy-on-Write / DeltaLog Repository) for this mirror.
+	RepositoryVolume string `json:"repositoryVolume,omitempty"`
+	// The WWN of the async mirror proxy.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The SSID of the async mirror proxy.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// If true, the repository is full (100% of available capacity utilized).
+	RepositoryFull bool   `json:"repositoryFull,omitempty"`
+	ID             string `json:"id,omitempty"`
+}
+
+// AsyncMirrorGroupMemberClearRecoveryFailureRequest is the AsyncMirrorGroupMemberClearRecoveryFailureRequest model.
+// The clear asynchronous mirror group member recovery failure request.
+type AsyncMirrorGroupMemberClearRecoveryFailureRequest struct {
+	// The recovery failure type to clear. Values: unknownFailure, failedFan, failedPowerSupply, failedMinihub,
+	// failedEsm, batteryNearExpiration, failedBattery, nominalTempExceeded, maxTempExceeded, upsOnBattery,
+	// nonPreferredPath, memParityError, failedDriveScsiChannel, degradedVolume, failedVolumeInterruptedWrite,
+	// failedModifyingVolume, failedModifyingMultiRaid, failedVolume, failedVolumeMultiRaid, impairedVolume,
+	// failedVolumeAwaitingInit, volumeHotSpareInUse, offlineVolumeGroup, failedDrive,
+	// impendingDriveFailureRiskHigh, impendingDriveFailureRiskMed, impendingDriveFailureRiskLow, uncertifiedDrive,
+	// offlineCtl, passiveCtl, partitionsNotCompliant, esmCodeVersionMismatch, lostRedundancyDrive,
+	// lostRedundancyTray, lostRedundancyEsm, trayidMismatch, trayidConflict, failedTransceiverModule,
+	// channelMiswire, nonFruBatteryNearExpiration, nonFruFailedBattery, rpaErrCtl, repositoryOverThreshold,
+	// repositoryFull, snapshotFailed, unsupportedMinihub, esmMiswire, ghostVolume, snapshotNotCompliant,
+	// rvmNotCompliant, metadataOffline, mirrorDualPrimary, mirrorDualSecondary, mirrorUnsynchronized, remoteNoLun,
+	// remoteNoArray, remoteNoFabric, remoteWwnChangeFailed, fanUnknownStat, powerSupplyUnknownStat, esmUnknownStat,
+	// tempSensorUnknownStat, transModUnknownStat, driveBypassedSpeedMismatch, driveBypassedCauseUnknown,
+	// minihubSpeedMismatch, removedEsm, removedBattery, nonFruRemovedBattery, volcopyFailed, volcopyNotCompliant,
+	// channelDegraded, unsupportedTray, speedNegError, usmDatabaseFull, usmUnreadableSectorsExist, pathDegraded,
+	// netSetupError, mismatchedDriveType, ctlMismatch, failedDiscreteLine, channelFailed,
+	// linkSpeedDetectionMismatch, uncertifiedEsm, removedFan, removedPowerSupply, removedTempSensor, removedIccCru,
+	// removedSupportCru, failedIccCru, failedSupportCru, batteryUnknownStat, driveTraysNotGroupedTogether,
+	// goldKeyNotCompliant, mismatchedMdtSettings, mismatchedGoldKeySettings, ctlMiswire, supportCruNoinput,
+	// submodelNotSupported, submodelNotSet, submodelMismatch, failedBatterySystem, removedBatteryPack,
+	// batteryConfigMismatch, esmHardwareMismatch, hostBoardFault, ddcAvailable, replacedDriveWrongType,
+	// redundantPsRequired, driveTraysNotCompliant, sasDeviceLimitExceeded, sasPortMiswired, sasPortDegraded,
+	// sasPortFailed, corruptVpdEeprom, failedI2cBus, enclosureMisconfigured, featureBundleNotCompliant,
+	// driveBypassedSinglePort, esmFactoryDefaultsMismatch, hostBoardUnknownStat, failedHostIoCard, batteryWarn,
+	// volumeGroupPartiallyComplete, volumeGroupIncomplete, volumeGroupMissing, hotspareDriveMissing,
+	// driveIncompatibleUprevDacstore, driveIncompatibleDownrevDacstore, iccCruUnknownStat, removedAlarm,
+	// supportCruUnknownStat, performanceTierNotCompliant, raid6NotCompliant, driveIncompatibleSectorSize,
+	// foreignDriveInconsistent, foreignDriveRefersToNativeDrive, nativeVgRefersToForeignDrive,
+	// nativeVgForeignDriveMutualRef, vgCloned, foreignDriveHasInconsistentRole, vgDrivePartOfMultipleVgs,
+	// incompatibleFailedLegacyDrive, unsupportedCacheMemorySize, dedicatedMirrorChannelFailed,
+	// vgHasDrivePartOfMultipleVgs, ddfDriveOtherVendor, multipleConfigDatabasesDetected,
+	// adoptionFailedRaidLevelUnsupported, dbAdoptionHardLimitExceeded, legacyVgNotOptimal, cacheMemSizeMismatch,
+	// altCtlrBoardIdUnreadable, cacheBackupDeviceFailed, cacheBackupDeviceWriteProtected,
+	// cacheBackupDeviceInsufficientCapacity, expiredBattery, expiredIntegratedBattery, procMemTooSmallForCache,
+	// batteryOvertemp, invalidHostTypeIndex, insufficientProcMemory, ctlFailedCacheBackupDev, removedController,
+	// degradedHostIoCard, ldFwVersionMismatch, ldIncompatibleDatabase, hostBoardIdMismatch, iccMissing,
+	// linkSpeedMismatch, unsupportedHostBoard, writebackCachingDisabled, securityGetKey, securityKeyInconsistent,
+	// securityNotCompliant, mixedDriveEnclosureMiswire, unsuccessIsolationRedunMismatch,
+	// thresExcdedMismatchCorrected, inactiveHostPort, inactiveInitiator, protectionInformationNotCompliant,
+	// protectionInformationNotSupported, replacedInsufficientDriveCapacity, drawerFailed, drawerOpened,
+	// ssdNotCompliant, ssdAtEndOfLife, fibreTrunkMiswire, fibreTrunkIncompatibleEsm, driveSlotLimitNotCompliant,
+	// securityGetNewKey, externalKmsNotCompliant, sbbValidationFailure, invalidPowerSupply,
+	// enclosureThermalShutdown, wbCachingForciblyDisabled, driveUnsupportedProtocolConnection, failedTwiBus,
+	// mismatchedMidplaneEeproms, driveUnsupportedCapacity, evaluationLicenseExpirationImminent,
+	// externalKmsKeyInvalid, multipleMismatchedKeyIdsFound, securityKeyValidationLock, cacheDataLoss,
+	// baseControllerDiagFailed, featureNotCompliant, driveIncompatiblePiType, drawerDegraded, offlineCtlIocFail,
+	// invalidSataFlashConfiguration, iocDiagFail, sasPhyDisabledLocalWidePortDegraded,
+	// sasPhyDisabledSharedWidePortDegraded, drawerInvalid, drawerRemoved, driveUnsupportedInterposerFwVersion,
+	// redundancyGroupNotConsistentDuringReconfig, snapshotRollbackPaused, pitRollbackPaused,
+	// pitGroupRepositoryOverThreshold, pitViewRepositoryOverThreshold, pitGroupRepositoryFull,
+	// pitViewRepositoryFull, pitGroupFailed, pitViewFailed, pitPurged, arvmDegradedMirrorGroup, arvmFailedMirror,
+	// arvmSyncIntervalTimeOverThreshold, arvmRepositoryOverWarnThreshold, arvmMirrorGroupRoleConflict,
+	// incompatibleVolumeGroupSecurity, arvmOrphanGroup, arvmOrphanMember, arvmMirrorGroupRecoveryPointLost,
+	// diskPoolPartiallyPresent, diskPoolIncomplete, diskPoolMissing,
+	// diskPoolReconstructionDriveCountBelowThreshold, diskPoolUtilizationWarning, diskPoolUtilizationCritical,
+	// pendingPitCreationFailed, pendingCgpitCreationFailed, tpvRepositoryOverThreshold, tpvRepositoryFull,
+	// tpvFailed, arvmSecondaryRepositoryFull, arvmSyncInternallySuspended, databaseRecoveryMode,
+	// arvmPrimaryRepositoryFull, incompatibleSataDrive, diskPoolCapacityDepleted,
+	// driveIncompatibleModelNumberUnsupported, flashCacheNonOptimalDrives, flashCacheHotSpareInUse,
+	// allDrivesBypassedIncompatibleNvsram, diskPoolDriveFailure, diskPoolInsufficientMemory,
+	// arvmOrphanIncompleteMember, arvmSyncPausedAltState, arvmRoleChangePaused,
+	// driveIncompatibleAlignmentForEmulationDrive, lossOfExternalRedundancy, rvmWriteModeInconsistent,
+	// sasHostMiswire, sasCrossMiswire, sasLoopMiswire, copyThenFailWaitingOnHotSpare, missingDriveLockdown,
+	// hicConfigurationOoc, copyThenFailWaitingOnDdpCapacity, piErrorServiceMode, piErrorLockdown,
+	// sasPortDiscoveryError, netNtpResolutionFail, netNtpQueryFail, netNtpServiceUnavailable,
+	// multipathConfigurationError, hostRedundancyLost, excessiveRebootsDetected, cacheNotFlushedOnOnlyCtlr,
+	// securityAuditLogFull, securityAuditLogWarnThresh, directoryServicesConfigError,
+	// kmipServerCertificateNearingExpiration, kmipServerCertificateExpired, kmipServerDnsError,
+	// kmipServerCertificateError, kmipServerAuthenticationFailure, kmipServerConnectionFailure, kmipServerError,
+	// kmipServerKeyRequirementNotMet, ocspResponderUnreachable, certificateRevoked, ssdDataLoss,
+	// driveFastFormatFailed, driveFastFormatSuspendNoDatabase, slowInterruptedWriteLogging, driveLostPrimaryPath,
+	// nvmeDriveSpeedError, driveUserAssignmentFailed, cacheBackupDeviceAtEndOfLife, cbaSignatureFailed,
+	// nvsramMismatch, sasPathNonOptimal, auxAltCommLinkError, altCommLinkError, rtgLinkDown, rtvLinkDown,
+	// diskPoolInvalidVersion, driveFwUnverified.
+	RecoveryFailureType string `json:"recoveryFailureType"`
+}
+
+// AsyncMirrorGroupMemberCompletionRequest is the AsyncMirrorGroupMemberCompletionRequest model.
+type AsyncMirrorGroupMemberCompletionRequest struct {
+	// Storage pool on the target StorageSystem to create the mirrored pair repository volume on. This defaults to
+	// the pool that the secondaryVolume belongs to.
+	SecondaryPoolID          string `json:"secondaryPoolId,omitempty"`
+	SecondaryVolumeRef       string `json:"secondaryVolumeRef"`
+	ScanMedia                *bool  `json:"scanMedia,omitempty"`
+	ValidateRepositoryParity *bool  `json:"validateRepositoryParity,omitempty"`
+	// Percentage of the capacity of the secondary volume to use for the repository capacity.
+	SecondaryPercentCapacity *float64 `json:"secondaryPercentCapacity,omitempty"`
+	// Allows a repository candidate to be manually specified for use in the creation. By default, the best
+	// candidate will be selected.
+	SecondaryRepositoryCandidate ConcatVolumeCandidate `json:"secondaryRepositoryCandidate,omitzero"`
+}
+
+// AsyncMirrorGroupMemberCreateRequest is the AsyncMirrorGroupMemberCreateRequest model.
+type AsyncMirrorGroupMemberCreateRequest struct {
+	// Storage pool on the source StorageSystem to create the mirrored pair repository volume on. This defaults to
+	// the pool that the primaryVolume belongs to.
+	PrimaryPoolID string `json:"primaryPoolId"`
+	// Storage pool on the target StorageSystem to create the mirrored pair repository volume on. This defaults to
+	// the pool that the secondaryVolume belongs to.
+	SecondaryPoolID    string `json:"secondaryPoolId"`
+	PrimaryVolumeRef   string `json:"primaryVolumeRef"`
+	SecondaryVolumeRef string `json:"secondaryVolumeRef"`
+	// Allows a repository candidate to be manually specified for use in the creation. By default, the best
+	// candidate will be selected.
+	PrimaryRepositoryCandidate ConcatVolumeCandidate `json:"primaryRepositoryCandidate,omitzero"`
+	// Allows a repository candidate to be manually specified for use in the creation. By default, the best
+	// candidate will be selected.
+	SecondaryRepositoryCandidate ConcatVolumeCandidate `json:"secondaryRepositoryCandidate,omitzero"`
+	ScanMedia                    *bool                 `json:"scanMedia,omitempty"`
+	ValidateRepositoryParity     *bool                 `json:"validateRepositoryParity,omitempty"`
+	// Percentage of the capacity of the primary volume to use for the repository capacity.
+	PercentCapacity *float64 `json:"percentCapacity,omitempty"`
+	// Percentage of the capacity of the secondary volume to use for the repository capacity. Defaults to
+	// percentCapacity.
+	SecondaryPercentCapacity *float64 `json:"secondaryPercentCapacity,omitempty"`
+}
+
+// AsyncMirrorGroupMemberEx is the AsyncMirrorGroupMemberEx model.
+// A representation of an asynchronous mirroring relationship between a local and remote volume.
+type AsyncMirrorGroupMemberEx struct {
+	// The reference (key) for the group member.
+	MemberRef string `json:"memberRef,omitempty"`
+	// The AMG with which this member is associated.
+	MirrorGroup string `json:"mirrorGroup,omitempty"`
+	// The local volume for this mirror. This is the volume being mirrored if the AMG role is primary, otherwise
+	// this is the target of mirror synchronization.
+	LocalVolume string `json:"localVolume,omitempty"`
+	// The remote volume. This is the target of mirror synchronization on the remote array if the AMG role is
+	// primary, otherwise this is the volume on the remote array that is being mirrored.
+	RemoteVolume string `json:"remoteVolume,omitempty"`
+	// The state of this mirror relationship (as known by this array). Values: unknown, initialSync, optimal,
+	// failed, incomplete, orphan, stopped.
+	MemberState string `json:"memberState,omitempty"`
+	// The timestamp, represented in seconds since midnight GMT on January 1, 1970, of the last recovery point PiT
+	// on secondary. If no recovery point image exists, the value of this field will be
+	// ARVM_NO_RECOVERY_POINT_TIMESTAMP.
+	LastRecoveryPointTime string `json:"lastRecoveryPointTime,omitempty"`
+	// If true, the repository capacity utilized is over the warning threshold.
+	OverUtilizationWarnThreshold bool `json:"overUtilizationWarnThreshold,omitempty"`
+	// The current local volume capacity that is being mirrored. This will be less than the actual local volume
+	// capacity if we have not yet reached a point where the next PiT can be taken and delta-logs resized (and until
+	// that point the expanded local capacity will not be opened up to host writes).
+	MirroredLocalCapacity string `json:"mirroredLocalCapacity,omitempty"`
+	// The metadata volume (Copy-on-Write / DeltaLog Repository) for this mirror.
+	RepositoryVolume string `json:"repositoryVolume,omitempty"`
+	// The WWN of the async mirror proxy.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The SSID of the async mirror proxy.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// If true, the repository is full (100% of available capacity utilized).
+	RepositoryFull bool `json:"repositoryFull,omitempty"`
+	// The label of the source volume on the local StorageSystem.
+	LocalVolumeName string `json:"localVolumeName,omitempty"`
+	// The label of the remote target volume.
+	RemoteVolumeName string `json:"remoteVolumeName,omitempty"`
+	// Detailed information on the remote volume.
+	RemoteVolumeInfo RemoteVolume `json:"remoteVolumeInfo,omitzero"`
+	// The WWN of the target array in the mirroring relationship. This field may not be immediately available after
+	// an AMG is created.
+	RemoteTargetWWN string `json:"remoteTargetWwn,omitempty"`
+	// The user label of the target array in the mirroring relationship. This field may not be immediately available
+	// after an AMG is created, and will not be available in embedded mode.
+	RemoteTargetName string `json:"remoteTargetName,omitempty"`
+	// The id of the target array in the mirroring relationship. This field may not be immediately available after
+	// an AMG is created, and will not be available in embedded mode.
+	RemoteTargetID string `json:"remoteTargetId,omitempty"`
+	// The capacity of the underlying repository volume in bytes.
+	TotalSizeInBytes string `json:"totalSizeInBytes,omitempty"`
+	// The volume-specific media scan settings currently in effect for the repository volume.
+	MediaScan VolumeMediaScanParams `json:"mediaScan,omitzero"`
+	ID        string                `json:"id,omitempty"`
+}
+
+// AsyncMirrorGroupMemberSyncProgress is the AsyncMirrorGroupMemberSyncProgress model.
+type AsyncMirrorGroupMemberSyncProgress struct {
+	// The completion percentage for the operation. If the operation is not currently running this value will be
+	// equal to PERCENT_COMPLETE_OP_NOT_RUNNING.
+	PercentComplete int `json:"percentComplete,omitempty"`
+	// The estimated time to completion in minutes. If the time is not known this value will be equal to
+	// TIME_TO_COMPLETION_UNKNOWN.
+	TimeToCompletion int `json:"timeToCompletion,omitempty"`
+	// The amount of data that remains to be synchronized in the current synchronization step.
+	SyncBytes string `json:"syncBytes,omitempty"`
+	// A reference to the async mirror member.
+	MirrorRef string `json:"mirrorRef,omitempty"`
+	// Amount of data (in bytes) marked as unsynchronized in the tracking delta log for this member.
+	TrackingBytes string `json:"trackingBytes,omitempty"`
+}
+
+// AsyncMirrorGroupRemoteConnection is the AsyncMirrorGroupRemoteConnection model.
+// The asynchronous mirror group remote connection data.
+type AsyncMirrorGroupRemoteConnection struct {
+	// The local port name. This is the unique wwn of the local port and is only valid if the interface type is fc.
+	LocalPortName string `json:"localPortName,omitempty"`
+	// The remote port name. This is the unique wwn of the remote port and is only valid if the interface type is
+	// fc.
+	RemotePortName string `json:"remotePortName,omitempty"`
+	// The remote port id. This is the switch port identifier for the remote port and is only valid if the interface
+	// type is fc.
+	RemotePortID string `json:"remotePortId,omitempty"`
+	// The local port id. This is the switch port identifier for the local port and is only valid if the interface
+	// type is fc.
+	LocalPortID string `json:"localPortId,omitempty"`
+	// The local port number.
+	LocalPortNumber int `json:"localPortNumber,omitempty"`
+	// The remote port number.
+	RemotePortNumber int `json:"remotePortNumber,omitempty"`
+	// The local controller acting as the initiator for the connection.
+	Controller string `json:"controller,omitempty"`
+	// The remote target connections IO interface type. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib,
+	// fcoe, nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	IoInterfaceType string `json:"ioInterfaceType,omitempty"`
+	// The local port reference. This is the local initiator port for these connections.
+	LocalPortRef string `json:"localPortRef,omitempty"`
+	// The details of the connections to the remote target.
+	RemoteTargetConnections ScsiRemoteTargetConnections `json:"remoteTargetConnections,omitzero"`
+}
+
+// AsyncMirrorGroupRoleUpdateRequest is the AsyncMirrorGroupRoleUpdateRequest model.
+// The asynchronous mirror group role update request.
+type AsyncMirrorGroupRoleUpdateRequest struct {
+	// The new role. Values: unknown, primary, secondary.
+	Role string `json:"role"`
+	// True to not synchronize, otherwise false.
+	NoSync *bool `json:"noSync,omitempty"`
+	// True to force, otherwise false.
+	Force *bool `json:"force,omitempty"`
+}
+
+// AsyncMirrorGroupSyncProgress is the AsyncMirrorGroupSyncProgress model.
+type AsyncMirrorGroupSyncProgress struct {
+	// Completion percentage for the operation across the entire group. If synchronization is not currently running
+	// this value will be equal to PERCENT_COMPLETE_OP_NOT_RUNNING.
+	GroupPercentComplete int `json:"groupPercentComplete,omitempty"`
+	// Estimated time to completion in minutes for all members in the group that are undergoing synchronization. If
+	// the time is not known this value will be equal to TIME_TO_COMPLETION_UNKNOWN.
+	GroupTimeToCompletion int `json:"groupTimeToCompletion,omitempty"`
+	// Amount of data that needs to be synchronized for the entire group.
+	GroupSyncBytes string `json:"groupSyncBytes,omitempty"`
+	// Reference to the async mirror group.
+	GroupRef string `json:"groupRef,omitempty"`
+	// List of progress information for each mirror group member.
+	MemberProgress []AsyncMirrorGroupMemberSyncProgress `json:"memberProgress,omitempty"`
+	// The amount of data (in bytes) marked as unsynchronized in the tracking delta logs for the entire group.
+	GroupTrackingBytes string `json:"groupTrackingBytes,omitempty"`
+}
+
+// AsyncMirrorGroupSyncRequest is the AsyncMirrorGroupSyncRequest model.
+// The asynchronous mirror group start synchronization request.
+type AsyncMirrorGroupSyncRequest struct {
+	// True to delete the failures point on the secondary if necessary, otherwise false.
+	DeleteRecoveryPointIfNecessary *bool `json:"deleteRecoveryPointIfNecessary,omitempty"`
+}
+
+// AsyncMirrorGroupUpdateRequest is the AsyncMirrorGroupUpdateRequest model.
+// The asynchronous mirror group update request.
+type AsyncMirrorGroupUpdateRequest struct {
+	// The user label to assign to the asynchronous mirror group.
+	Name string `json:"name,omitempty"`
+	// The sync interval in minutes.
+	SyncIntervalMinutes *int `json:"syncIntervalMinutes,omitempty"`
+	// The recovery warning threshold in minutes.
+	RecoveryWarnThresholdMinutes *int `json:"recoveryWarnThresholdMinutes,omitempty"`
+	// The repository utilization warning threshold percentage.
+	RepoUtilizationWarnThreshold *int `json:"repoUtilizationWarnThreshold,omitempty"`
+	// The threshold in minutes for notifying the user that periodic synchronization has taken too long to complete.
+	SyncWarnThresholdMinutes *int `json:"syncWarnThresholdMinutes,omitempty"`
+}
+
+// AsyncMirrorRepositoryUtilization is the AsyncMirrorRepositoryUtilization model.
+type AsyncMirrorRepositoryUtilization struct {
+	// The total number of bytes used in the repository for copy-on-write data and related metadata.
+	PitDataBytes string `json:"pitDataBytes,omitempty"`
+	// The total number of bytes used for the delta logs (bitmaps).
+	DeltaLogBytes string `json:"deltaLogBytes,omitempty"`
+	// The total number of bytes available for ongoing copy-on-write operations.
+	BytesAvailable string `json:"bytesAvailable,omitempty"`
+	// The mirror group member for which this utilization information applies.
+	MirrorRef string `json:"mirrorRef,omitempty"`
+	ID        string `json:"id,omitempty"`
+}
+
+// AsyncResponse is the AsyncResponse model.
+type AsyncResponse struct {
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// AsyncStringResponse is the AsyncStringResponse model.
+// Provides a unique string identifier for requesting status of an asynchronous call.
+type AsyncStringResponse struct {
+	// A unique value that identifies the request ID for later retrieval.
+	RequestID string `json:"requestId"`
+}
+
+// AuditLogConfiguration is the AuditLogConfiguration model.
+type AuditLogConfiguration struct {
+	// The maximum number of audit log records to be retained.
+	AuditLogMaxRecords int `json:"auditLogMaxRecords,omitempty"`
+	// If set to 'all', records for all GET, POST, DELETE and PUT executed endpoints will be logged. If set to
+	// 'writeOnly', only records for PUT, DELETE and POST executed endpoints will be logged. Values: all, writeOnly.
+	AuditLogLevel string `json:"auditLogLevel,omitempty"`
+	// If set to 'overWrite' and the maximum number of log records has been reached, older records will be
+	// automatically deleted so that new log records can be added. If set to 'preventSystemAccess' and the maximum
+	// number of audit log records has been reached, log records will have to be deleted manually before new log
+	// records can be added. Values: overWrite, preventSystemAccess.
+	AuditLogFullPolicy string `json:"auditLogFullPolicy,omitempty"`
+	// Indicates how full the audit log can get before a MEL event is generated. This value must be between 60 and
+	// 90.
+	AuditLogWarningThresholdPct int `json:"auditLogWarningThresholdPct,omitempty"`
+}
+
+// AuditLogDeleteResponse is the AuditLogDeleteResponse model.
+type AuditLogDeleteResponse struct {
+	// Total number of audit log records deleted
+	TotalDeletedRecords int `json:"totalDeletedRecords"`
+}
+
+// AuditLogGetResponse is the AuditLogGetResponse model.
+type AuditLogGetResponse struct {
+	// Total audit log records available in the database
+	TotalLogRecords int `json:"totalLogRecords"`
+	// The log records requested
+	LogRecords []AuditLogRecord `json:"logRecords"`
+	// First record ordinalDeprecated: This field isn't overly useful since the ordinal values are currently
+	// unstable and are relative based on the records returned.
+	FirstRecordOrdinal int `json:"firstRecordOrdinal"`
+	// Last record ordinal. Deprecated: This field isn't overly useful since the ordinal values are currently
+	// unstable and are relative based on the records returned.
+	LastRecordOrdinal int `json:"lastRecordOrdinal"`
+}
+
+// AuditLogInfoResponse is the AuditLogInfoResponse model.
+type AuditLogInfoResponse struct {
+	// Total audit log records.
+	TotalLogRecords int `json:"totalLogRecords"`
+	// First record timestamp.
+	FirstRecordTimestamp string `json:"firstRecordTimestamp"`
+	// First record date in the form of 'yyyy-mm-ddThh:mm:ssZ'
+	FirstRecordDate string `json:"firstRecordDate"`
+	// Last record timestamp.
+	LastRecordTimestamp string `json:"lastRecordTimestamp"`
+	// Last record date in the form of 'yyyy-mm-ddThh:mm:ssZ'
+	LastRecordDate string `json:"lastRecordDate"`
+}
+
+// AuditLogMessagesRequest is the AuditLogMessagesRequest model.
+// Object used when requesting messages from the Audit Log
+type AuditLogMessagesRequest struct {
+	// Starting record ordinal
+	StartRecordOrdinal *int `json:"startRecordOrdinal,omitempty"`
+	// Ending record ordinal
+	EndingRecordOrdinal *int `json:"endingRecordOrdinal,omitempty"`
+	// Beginning timestamp(UTC) in the forms
+	// of:<br>yyyy-mm-ddThh:mm:ssZ',<br>'yyyy-mm-ddThh:mm:ss.sssZ',<br>'yyyy-mm-ddThh:mm:ss+|-hh:mm
+	// <br><br>EXAMPLES:<br>'2018-01-15T05:06:08Z',<br>'2018-07-12T17:57:14.922Z',<br>'2018-07-13T14:59:52+00:00'
+	BeginTime string `json:"beginTime,omitempty"`
+	// Ending timestamp(UTC) in the forms
+	// of:<br>yyyy-mm-ddThh:mm:ssZ',<br>'yyyy-mm-ddThh:mm:ss.sssZ',<br>'yyyy-mm-ddThh:mm:ss+|-hh:mm
+	// <br><br>EXAMPLES:<br>'2018-01-15T05:06:08Z',<br>'2018-07-12T17:57:14.922Z',<br>'2018-07-13T14:59:52+00:00'
+	EndTime string `json:"endTime,omitempty"`
+}
+
+// AuditLogRecord is the AuditLogRecord model.
+// Audit log record.
+type AuditLogRecord struct {
+	// HTTP method, for example GET and POST.
+	Method string `json:"method"`
+	// Accessed URL.
+	AccessedURL string `json:"accessedUrl"`
+	// The authentication id.
+	AuthID string `json:"authId"`
+	// The authentication type. Values: jwt, ldap, localAccount, saml, unknown.
+	AuthType string `json:"authType"`
+	// IP address of client.
+	ClientIP string `json:"clientIp"`
+	// Username of the user accessing the URL.
+	UserID string `json:"userId"`
+	// HTTP status code of the operation.
+	HTTPStatus int `json:"httpStatus"`
+	// HTTP reason phrase.
+	HTTPReasonPhrase string `json:"httpReasonPhrase"`
+	// Indicates if the user was authenticated by WSP in a federated security environment.
+	IsFederated bool `json:"isFederated"`
+	// Logging agent.
+	LoggingAgent string `json:"loggingAgent"`
+	// The user agent.
+	UserAgent string `json:"userAgent"`
+	// CLI command executed.
+	CliCommandExecuted string `json:"cliCommandExecuted,omitempty"`
+	// CLI status code or request for input files.
+	CliReturnStatus string `json:"cliReturnStatus,omitempty"`
+	// Symbol procedure.
+	SymbolProcedure string `json:"symbolProcedure,omitempty"`
+	// SSH login event type. Values: login, logout, login_fail, signature_validation_failure, ntp_time_change,
+	// cfw_integrity_check, firmware_download_start, activation_complete, audit_log_shutdown, audit_log_start,
+	// session_timeout, certificate_validation_failure, timestamp_validation_failure.
+	SshEventType string `json:"sshEventType,omitempty"`
+	// SSH session process ID.
+	SshSessionPid int `json:"sshSessionPid,omitempty"`
+	// SSH session duration (in seconds).
+	SshSessionDuration int `json:"sshSessionDuration,omitempty"`
+	// Date and time of accessed URL in seconds since midnight GMT on January 1, 1970.
+	Timestamp string `json:"timestamp"`
+	// Date and time of accessed URL in the form of 'yyyy-mm-ddThh:mm:ssZ'
+	DateTime string `json:"dateTime"`
+	// Record ordinal.
+	RecordOrdinal int `json:"recordOrdinal"`
+	// Optional Text.
+	OptionalText string `json:"optionalText,omitempty"`
+}
+
+// AuthMethodTypeData is the AuthMethodTypeData model.
+type AuthMethodTypeData struct {
+	// This enumeration type identifies the available iSCSIauthentication methods. The supportedAuthMethods array in
+	// the FeatureParams structure will contain values from this enumeration identifying which iSCSI authentication
+	// methods are supported on the storage array. Values: none, chap.
+	AuthMethod string `json:"authMethod,omitempty"`
+	// This field is present only if the authentication method is AUTH_METHOD_CHAP. If the field length indicator is
+	// zero, there is no associated secret; otherwise there is a secret and it may or may not be specified,
+	// depending on the usage context for this data type. If the client is using this structure to set a secret, the
+	// length indicator should be one and the secret should be filled in; if the client is using this structure to
+	// set an authentication method with no secret, the length indicator should be zero. If the array is reporting
+	// this data, it sets the length indicator according to whether or not there is a secret. (The actual secret is
+	// never reported by the array; instead it uses the ENCRYPT_UNSPECIFIED case of EncryptedPassword.) Finally,
+	// because CHAP secrets are, by definition, limited to the ASCII character set, the clear text submitted for
+	// encryption must be ASCII, not Unicode.
+	ChapSecret []EncryptedPassword `json:"chapSecret,omitempty"`
+}
+
+// AuthenticationBundle is the AuthenticationBundle model.
+type AuthenticationBundle struct {
+	// A variable-length array containing authentication methods and any associated data.
+	AuthMethodData []AuthMethodTypeData `json:"authMethodData,omitempty"`
+}
+
+// AuthenticationSettings is the AuthenticationSettings model.
+// Server authentication settings.
+type AuthenticationSettings struct {
+	// Disable Basic Authentication, a value of false will allow Basic Authentication, a value of true will prevent
+	// Basic Authentication.
+	DisableBasicAuthentication bool `json:"disableBasicAuthentication"`
+}
+
+// AutoSupportAsyncResponse is the AutoSupportAsyncResponse model.
+type AutoSupportAsyncResponse struct {
+	// The AutoSupport job id.
+	JobID string `json:"jobId"`
+	// The AutoSupport operation type. Values: collect, collectAndDispatch.
+	OperationType string `json:"operationType"`
+	// The AutoSupport dispatch type. Values: daily, weekly, monthly, manual, test.
+	DispatchType string `json:"dispatchType"`
+}
+
+// AutoSupportConfigRequest is the AutoSupportConfigRequest model.
+type AutoSupportConfigRequest struct {
+	// Determines whether to enable or disable the AutoSupport feature.
+	AutoSupportEnabled *bool `json:"autoSupportEnabled,omitempty"`
+	// The desired AutoSupport schedule type. Values: daily, weekly, monthly.
+	ScheduleType string `json:"scheduleType,omitempty"`
+	// The desired AutoSupport delivery type. Values: https, smtp.
+	DeliveryType string `json:"deliveryType,omitempty"`
+	// The desired smtp configuration.
+	SmtpConfiguration AutoSupportSmtpConfiguration `json:"smtpConfiguration,omitzero"`
+}
+
+// AutoSupportConfigResponse is the AutoSupportConfigResponse model.
+type AutoSupportConfigResponse struct {
+	// Determines whether AutoSupport is currently enabled.
+	AutoSupportEnabled bool `json:"autoSupportEnabled,omitempty"`
+	// The current AutoSupport schedule type. Values: daily, weekly, monthly.
+	ScheduleType string `json:"scheduleType,omitempty"`
+	// The current AutoSupport scheduled weekdays on which AutoSupport collections will occur.
+	ScheduledDays []string `json:"scheduledDays,omitempty"`
+	// The current AutoSupport delivery type. Values: https, smtp.
+	DeliveryType string `json:"deliveryType,omitempty"`
+	// The http delivery type. Values: direct.
+	HTTPDeliveryType string `json:"httpDeliveryType,omitempty"`
+	// The smtp configuration.
+	SmtpConfiguration AutoSupportSmtpConfiguration `json:"smtpConfiguration,omitzero"`
+	// The AutoSupport sequence number.
+	SequenceNumber string `json:"sequenceNumber,omitempty"`
+}
+
+// AutoSupportRequest is the AutoSupportRequest model.
+type AutoSupportRequest struct {
+	// The AutoSupport operation type. Values: collect, collectAndDispatch.
+	OperationType string `json:"operationType"`
+	// The dispatch type determines the level of content that will be included in the AutoSupport bundle. For daily,
+	// weekly, and monthly dispatch types the appropriate logs (covering that specific amount of time) will be
+	// collected and included in the bundle. A dispatch type of manual will include all logs available. Finally, a
+	// dispatch type of test will collect a minimal set of logs sufficient to test communication with the
+	// AutoSupport backend server. Values: daily, weekly, monthly, manual, test.
+	DispatchType string `json:"dispatchType"`
+}
+
+// AutoSupportSmtpConfiguration is the AutoSupportSmtpConfiguration model.
+type AutoSupportSmtpConfiguration struct {
+	// The smtp mail server for smtp-based configurations.
+	MailServer string `json:"mailServer,omitempty"`
+	// The mail sender address for smtp-based configurations.
+	MailSenderAddress string `json:"mailSenderAddress,omitempty"`
+	// The mail reply address for smtp-based configurations.
+	MailReplyAddress string `json:"mailReplyAddress,omitempty"`
+	// The encryption to be used to communicate with the server. May be "none" (unencrypted), "smtps" (SSL/TLS
+	// encrypted connection), or "starttls" (connection upgraded to SSL/TLS encryption). Defaults to "none" if no
+	// encryption type has been set previously. Values: none, smtps, starttls.
+	EmailServerEncryption string `json:"emailServerEncryption,omitempty"`
+	// The port to be used to connect to the server. A value of 0 may be specified to use the default port based on
+	// the encryption type set. Default is encryption dependent: "none" defaults to 25, "smtps" defaults to 465, and
+	// "starttls" defaults to 587
+	EmailServerPort int `json:"emailServerPort,omitempty"`
+	// The user name to authenticate a user that is authorized to send + e-mails through the e-mail server.
+	EmailServerUsername string `json:"emailServerUsername,omitempty"`
+	// The password to authenticate a user that is authorized to send e-mails through the e-mail server.
+	EmailServerPassword string `json:"emailServerPassword,omitempty"`
+}
+
+// AutoSupportStatusResponse is the AutoSupportStatusResponse model.
+type AutoSupportStatusResponse struct {
+	// The AutoSupport job id.
+	ID string `json:"id"`
+	// The AutoSupport operation type. Values: collect, collectAndDispatch.
+	OperationType string `json:"operationType"`
+	// The AutoSupport dispatch type. Values: daily, weekly, monthly, manual, test.
+	DispatchType string `json:"dispatchType"`
+	// The current status of the AutoSupport bundle job. Values: notStarted, inProgress, completed, failed.
+	Status string `json:"status"`
+	// The current dispatch status of the AutoSupport bundle job. Values: ok, autoSupportFeatureNotEnabled, failed,
+	// invalidHostUploadUrl, proxyError, bundleNotFound, invalidProtocol, unsupportedEncoding, socketTimeout,
+	// socketIoError, sessionFailed, sendMessageFailed, sendHandshakeFailed, unknownError.
+	DispatchStatus string `json:"dispatchStatus"`
+	// Provides information to retrieve the bundle file from the private files endpoint.
+	BundleFile PrivateFileInfo `json:"bundleFile"`
+	// Provides an error message if an error occurs while a given request is in progress.
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// AverageAnalysedApplicationStats is the AverageAnalysedApplicationStats model.
+// The statistics for a volume averaged over a time range.
+type AverageAnalysedApplicationStats struct {
+	ID string `json:"id"`
+	// Lantency in milliseconds
+	LatencyRead AverageAnalysedValue `json:"latencyRead"`
+	// Latency in milliseconds
+	LatencyWrite AverageAnalysedValue `json:"latencyWrite"`
+	IopsRead     AverageAnalysedValue `json:"iopsRead"`
+	IopsWrite    AverageAnalysedValue `json:"iopsWrite"`
+	// Read throughput in B/s
+	ThroughputRead AverageAnalysedValue `json:"throughputRead"`
+	// Write throughput in B/s
+	ThroughputWrite         AverageAnalysedValue `json:"throughputWrite"`
+	PrimaryCacheReadHitPct  AverageAnalysedValue `json:"primaryCacheReadHitPct"`
+	PrimaryCacheWriteHitPct AverageAnalysedValue `json:"primaryCacheWriteHitPct"`
+	SsdCacheReadHitPct      AverageAnalysedValue `json:"ssdCacheReadHitPct"`
+}
+
+// AverageAnalysedControllerStats is the AverageAnalysedControllerStats model.
+// Controller statistics averaged over a time range.
+type AverageAnalysedControllerStats struct {
+	ID        string               `json:"id"`
+	IopsRead  AverageAnalysedValue `json:"iopsRead"`
+	IopsWrite AverageAnalysedValue `json:"iopsWrite"`
+	// Read throughput in B/s
+	ThroughputRead AverageAnalysedValue `json:"throughputRead"`
+	// Write throughput in B/s
+	ThroughputWrite AverageAnalysedValue `json:"throughputWrite"`
+	CPUUtilization  AverageAnalysedValue `json:"cpuUtilization"`
+	// Performance headroom percentage
+	HeadroomPct AverageAnalysedValue `json:"headroomPct"`
+}
+
+// AverageAnalysedDriveStats is the AverageAnalysedDriveStats model.
+// The statistics for a drive averaged over a time range.
+type AverageAnalysedDriveStats struct {
+	ID string `json:"id"`
+	// Latency in milliseconds
+	LatencyRead AverageAnalysedValue `json:"latencyRead"`
+	// Latency in milliseconds
+	LatencyWrite AverageAnalysedValue `json:"latencyWrite"`
+	IopsRead     AverageAnalysedValue `json:"iopsRead"`
+	IopsWrite    AverageAnalysedValue `json:"iopsWrite"`
+	// Read throughput in B/s
+	ThroughputRead AverageAnalysedValue `json:"throughputRead"`
+	// Write throughput in B/s
+	ThroughputWrite AverageAnalysedValue `json:"throughputWrite"`
+}
+
+// AverageAnalysedInterfaceStats is the AverageAnalysedInterfaceStats model.
+// The statistics for an interface averaged over a time range.
+type AverageAnalysedInterfaceStats struct {
+	ID string `json:"id"`
+	// Latency in milliseconds
+	LatencyRead AverageAnalysedValue `json:"latencyRead"`
+	// Latency in milliseconds
+	LatencyWrite AverageAnalysedValue `json:"latencyWrite"`
+	IopsRead     AverageAnalysedValue `json:"iopsRead"`
+	IopsWrite    AverageAnalysedValue `json:"iopsWrite"`
+	// Read throughput in B/s
+	ThroughputRead AverageAnalysedValue `json:"throughputRead"`
+	// Write throughput in B/s
+	ThroughputWrite AverageAnalysedValue `json:"throughputWrite"`
+	// Performance headroom percentage
+	HeadroomPct AverageAnalysedValue `json:"headroomPct"`
+}
+
+// AverageAnalysedPoolStats is the AverageAnalysedPoolStats model.
+// The statistics for a volume averaged over a time range.
+type AverageAnalysedPoolStats struct {
+	ID string `json:"id"`
+	// Lantency in milliseconds
+	LatencyRead AverageAnalysedValue `json:"latencyRead"`
+	// Latency in milliseconds
+	LatencyWrite AverageAnalysedValue `json:"latencyWrite"`
+	IopsRead     AverageAnalysedValue `json:"iopsRead"`
+	IopsWrite    AverageAnalysedValue `json:"iopsWrite"`
+	// Read throughput in B/s
+	ThroughputRead AverageAnalysedValue `json:"throughputRead"`
+	// Write throughput in B/s
+	ThroughputWrite         AverageAnalysedValue `json:"throughputWrite"`
+	PrimaryCacheReadHitPct  AverageAnalysedValue `json:"primaryCacheReadHitPct"`
+	PrimaryCacheWriteHitPct AverageAnalysedValue `json:"primaryCacheWriteHitPct"`
+	SsdCacheReadHitPct      AverageAnalysedValue `json:"ssdCacheReadHitPct"`
+}
+
+// AverageAnalysedStatsResponse is the AverageAnalysedStatsResponse model.
+// The collection of statistics averaged over a time range.
+type AverageAnalysedStatsResponse struct {
+	VolumeStats      []AverageAnalysedVolumeStats      `json:"volumeStats"`
+	DiskStats        []AverageAnalysedDriveStats       `json:"diskStats"`
+	ControllerStats  []AverageAnalysedControllerStats  `json:"controllerStats"`
+	InterfaceStats   []AverageAnalysedInterfaceStats   `json:"interfaceStats"`
+	SystemStats      []AverageAnalysedSystemStats      `json:"systemStats"`
+	PoolStats        []AverageAnalysedPoolStats        `json:"poolStats"`
+	WorkloadStats    []AverageAnalysedWorkloadStats    `json:"workloadStats"`
+	ApplicationStats []AverageAnalysedApplicationStats `json:"applicationStats"`
+}
+
+// AverageAnalysedSystemControllerStats is the AverageAnalysedSystemControllerStats model.
+// The statistics for a controller averaged over a time range.
+type AverageAnalysedSystemControllerStats struct {
+	ControllerID   string               `json:"controllerId"`
+	CPUUtilization AverageAnalysedValue `json:"cpuUtilization"`
+}
+
+// AverageAnalysedSystemStats is the AverageAnalysedSystemStats model.
+// The statistics for a storage system averaged over a time range.
+type AverageAnalysedSystemStats struct {
+	ID        string               `json:"id"`
+	IopsRead  AverageAnalysedValue `json:"iopsRead"`
+	IopsWrite AverageAnalysedValue `json:"iopsWrite"`
+	// Read throughput in B/s
+	ThroughputRead AverageAnalysedValue `json:"throughputRead"`
+	// Write throughput in B/s
+	ThroughputWrite AverageAnalysedValue                   `json:"throughputWrite"`
+	ControllerStats []AverageAnalysedSystemControllerStats `json:"controllerStats"`
+}
+
+// AverageAnalysedValue is the AverageAnalysedValue model.
+// Statistics data averaged over a time range.
+type AverageAnalysedValue struct {
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Value float64 `json:"value"`
+	Std   float64 `json:"std"`
+}
+
+// AverageAnalysedVolumeStats is the AverageAnalysedVolumeStats model.
+// The statistics for a volume averaged over a time range.
+type AverageAnalysedVolumeStats struct {
+	ID string `json:"id"`
+	// Lantency in milliseconds
+	LatencyRead AverageAnalysedValue `json:"latencyRead"`
+	// Latency in milliseconds
+	LatencyWrite AverageAnalysedValue `json:"latencyWrite"`
+	IopsRead     AverageAnalysedValue `json:"iopsRead"`
+	IopsWrite    AverageAnalysedValue `json:"iopsWrite"`
+	// Read throughput in B/s
+	ThroughputRead AverageAnalysedValue `json:"throughputRead"`
+	// Write throughput in B/s
+	ThroughputWrite         AverageAnalysedValue `json:"throughputWrite"`
+	PrimaryCacheReadHitPct  AverageAnalysedValue `json:"primaryCacheReadHitPct"`
+	PrimaryCacheWriteHitPct AverageAnalysedValue `json:"primaryCacheWriteHitPct"`
+	SsdCacheReadHitPct      AverageAnalysedValue `json:"ssdCacheReadHitPct"`
+}
+
+// AverageAnalysedWorkloadStats is the AverageAnalysedWorkloadStats model.
+// The statistics for a volume averaged over a time range.
+type AverageAnalysedWorkloadStats struct {
+	ID string `json:"id"`
+	// Lantency in milliseconds
+	LatencyRead AverageAnalysedValue `json:"latencyRead"`
+	// Latency in milliseconds
+	LatencyWrite AverageAnalysedValue `json:"latencyWrite"`
+	IopsRead     AverageAnalysedValue `json:"iopsRead"`
+	IopsWrite    AverageAnalysedValue `json:"iopsWrite"`
+	// Read throughput in B/s
+	ThroughputRead AverageAnalysedValue `json:"throughputRead"`
+	// Write throughput in B/s
+	ThroughputWrite         AverageAnalysedValue `json:"throughputWrite"`
+	PrimaryCacheReadHitPct  AverageAnalysedValue `json:"primaryCacheReadHitPct"`
+	PrimaryCacheWriteHitPct AverageAnalysedValue `json:"primaryCacheWriteHitPct"`
+	SsdCacheReadHitPct      AverageAnalysedValue `json:"ssdCacheReadHitPct"`
+}
+
+// BatchInfo is the BatchInfo model.
+type BatchInfo struct {
+	Info []BatchMetadata `json:"info,omitempty"`
+}
+
+// BatchJobDetails is the BatchJobDetails model.
+type BatchJobDetails struct {
+	// The numeric HTTP result code returned for this job step.
+	HTTPStatusCode int `json:"httpStatusCode,omitempty"`
+	// A short phrase describing the HTTP status code.
+	HTTPStatusPhrase string `json:"httpStatusPhrase,omitempty"`
+	// The HTTP headers returned.
+	HTTPResultHeaders []Pair `json:"httpResultHeaders,omitempty"`
+	// The JSON body returned from this job step. This data must be decoded by the client as it will be specific for
+	// the type of call made.
+	HTTPReturnBody string `json:"httpReturnBody,omitempty"`
+}
+
+// BatchJobStepStatus is the BatchJobStepStatus model.
+type BatchJobStepStatus struct {
+	// The name of this job. This value will be the batch name plus the job ID.
+	JobName string `json:"jobName,omitempty"`
+	// The numeric ID for this individual job.
+	JobID int `json:"jobId,omitempty"`
+	// If true then this job has completed.
+	JobComplete bool `json:"jobComplete,omitempty"`
+	// The percent complete for this step (may be estimated).
+	PercentComplete int `json:"percentComplete,omitempty"`
+	// The System Id that this job executed against.
+	SystemID string `json:"systemId,omitempty"`
+	// The name of the system that this job executed against.
+	SystemName string `json:"systemName,omitempty"`
+	// The HTTP method called.
+	HTTPVerb string `json:"httpVerb,omitempty"`
+	// The URL that this command executed against. For dual controller systems this provides the path to the
+	// controller that executed the command.
+	ExecutionURL string `json:"executionUrl,omitempty"`
+	// The number of milliseconds that this job took to execute.
+	ExecutionMillis int `json:"executionMillis,omitempty"`
+	// The number of retries that were attempted for this job.
+	RetriesAttempted int `json:"retriesAttempted,omitempty"`
+	// The job result enum value. Values: NOTSTARTED, INPROGRESS, SUCCESS, FAILED, CANCELLED, TIMEOUT,
+	// CONNECTFAILED.
+	ResultStatus string `json:"resultStatus,omitempty"`
+	// The detailed return data for this step.
+	StepResult BatchJobDetails `json:"stepResult,omitzero"`
+}
+
+// BatchMetadata is the BatchMetadata model.
+type BatchMetadata struct {
+	// The batch ID for this batch.
+	BatchID int `json:"batchId,omitempty"`
+	// The batch name.
+	BatchName string `json:"batchName,omitempty"`
+	// The batch group id.
+	BatchGroupID string `json:"batchGroupId,omitempty"`
+	// Array of System Ids this batch was run against. If multiple jobs were defined, this list contains all
+	// systemIds from all jobs.
+	SystemIds []string `json:"systemIds,omitempty"`
+	// The set of URLs that the batch was initiated against. Note that for batches with more than one job, there may
+	// be multiple URLs.
+	APIURL []string `json:"apiUrl,omitempty"`
+	// The current state of the batch. Values: NOTSTARTED, STARTING, RUNNING, CANCELLED, COMPLETE.
+	State string `json:"state,omitempty"`
+}
+
+// BatchResponse is the BatchResponse model.
+type BatchResponse struct {
+	// The ID for this batch request.
+	BatchID int `json:"batchId,omitempty"`
+	// The batch name.
+	BatchName string `json:"batchName,omitempty"`
+	// The batch group id.
+	BatchGroupID string `json:"batchGroupId,omitempty"`
+	// The time that this batch was initiated.
+	StartTime string `json:"startTime,omitempty"`
+	// If true the entire batch is complete.
+	BatchComplete bool `json:"batchComplete,omitempty"`
+	// The percent complete for the entire batch (estimated).
+	PercentComplete int `json:"percentComplete,omitempty"`
+	// Describes the process state for this batch request. Values: NOTSTARTED, STARTING, RUNNING, CANCELLED,
+	// COMPLETE.
+	BatchState string `json:"batchState,omitempty"`
+	// The number of job status results contained within the batch response.
+	NumberJobs int `json:"numberJobs,omitempty"`
+	// The number of system Ids the batch was executed against.
+	NumberSystemIds int `json:"numberSystemIds,omitempty"`
+	// The number of individual job steps executed within this batch.
+	NumberJobSteps int `json:"numberJobSteps,omitempty"`
+	// An array of BatchJobStepStatus objects.
+	JobStepStatus []BatchJobStepStatus `json:"jobStepStatus,omitempty"`
+}
+
+// BatchSystemChangeEvent is the BatchSystemChangeEvent model.
+type BatchSystemChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType  string `json:"eventType"`
+	BatchName  string `json:"batchName"`
+	BatchState string `json:"batchState"`
+	BatchID    string `json:"batchId"`
+}
+
+// Battery is the Battery model.
+type Battery struct {
+	// The reference for this battery.
+	BatteryRef string `json:"batteryRef,omitempty"`
+	// The operational status of the battery. Values: optimal, fullCharging, nearExpiration, failed, removed,
+	// unknown, notInConfig, configMismatch, learning, overtemp, expired, maintenanceCharging, replacementRequired.
+	Status string `json:"status,omitempty"`
+	// The physical location of the battery. The parent reference in Location identifies the CRU that physically
+	// houses the battery, and the position field is the parent-relative/like-component relative slot number of the
+	// battery, starting at one.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// Current battery age, in days.
+	BatteryAge int `json:"batteryAge,omitempty"`
+	// Days of battery life remaining. A value of -1 indicates that the battery life expiration age has been set to
+	// 0xFF in NVSRAM, disabling cache battery expiration event notification.
+	BatteryLifeRemaining int `json:"batteryLifeRemaining,omitempty"`
+	// Used to determine the scope of the battery.
+	BatteryTypeData BatteryTypeData `json:"batteryTypeData,omitzero"`
+	Reserved1       string          `json:"reserved1,omitempty"`
+	Reserved2       string          `json:"reserved2,omitempty"`
+	// VPD manufacture date.
+	ManufacturerDate string `json:"manufacturerDate,omitempty"`
+	// VPD vendor name.
+	VendorName string `json:"vendorName,omitempty"`
+	// VPD part number.
+	VendorPN string `json:"vendorPN,omitempty"`
+	// VPD serial number.
+	VendorSN string `json:"vendorSN,omitempty"`
+	// VPD field replaceable unit type.
+	FruType string `json:"fruType,omitempty"`
+	// This field has been deprecated and should not be used.
+	RtrAttributes RTRAttributes `json:"rtrAttributes,omitzero"`
+	// The repair policy for the battery component.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+	// This boolean field is set to true when cache battery expired event notification is enabled.
+	BatteryCanExpire bool `json:"batteryCanExpire,omitempty"`
+	// This boolean field is set to true when the batteryCanExpire field in this structure is set to false and when
+	// the controller tracks the battery serial number.
+	AutomaticAgeReset bool `json:"automaticAgeReset,omitempty"`
+	// Contains details about the learn cycle for this battery
+	LearnCycleData SmartBatteryData `json:"learnCycleData,omitzero"`
+	ID             string           `json:"id,omitempty"`
+}
+
+// BatteryEx is the BatteryEx model.
+type BatteryEx struct {
+	// The reference for this battery.
+	BatteryRef string `json:"batteryRef,omitempty"`
+	// The operational status of the battery. Values: optimal, fullCharging, nearExpiration, failed, removed,
+	// unknown, notInConfig, configMismatch, learning, overtemp, expired, maintenanceCharging, replacementRequired.
+	Status string `json:"status,omitempty"`
+	// The physical location of the battery. The parent reference in Location identifies the CRU that physically
+	// houses the battery, and the position field is the parent-relative/like-component relative slot number of the
+	// battery, starting at one.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// Current battery age, in days.
+	BatteryAge int `json:"batteryAge,omitempty"`
+	// Days of battery life remaining. A value of -1 indicates that the battery life expiration age has been set to
+	// 0xFF in NVSRAM, disabling cache battery expiration event notification.
+	BatteryLifeRemaining int `json:"batteryLifeRemaining,omitempty"`
+	// Used to determine the scope of the battery.
+	BatteryTypeData BatteryTypeData `json:"batteryTypeData,omitzero"`
+	Reserved1       string          `json:"reserved1,omitempty"`
+	Reserved2       string          `json:"reserved2,omitempty"`
+	// VPD manufacture date.
+	ManufacturerDate string `json:"manufacturerDate,omitempty"`
+	// VPD vendor name.
+	VendorName string `json:"vendorName,omitempty"`
+	// VPD part number.
+	VendorPN string `json:"vendorPN,omitempty"`
+	// VPD serial number.
+	VendorSN string `json:"vendorSN,omitempty"`
+	// VPD field replaceable unit type.
+	FruType string `json:"fruType,omitempty"`
+	// This field has been deprecated and should not be used.
+	RtrAttributes RTRAttributes `json:"rtrAttributes,omitzero"`
+	// The repair policy for the battery component.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+	// This boolean field is set to true when cache battery expired event notification is enabled.
+	BatteryCanExpire bool `json:"batteryCanExpire,omitempty"`
+	// This boolean field is set to true when the batteryCanExpire field in this structure is set to false and when
+	// the controller tracks the battery serial number.
+	AutomaticAgeReset bool `json:"automaticAgeReset,omitempty"`
+	// Contains details about the learn cycle for this battery
+	LearnCycleData   SmartBatteryData `json:"learnCycleData,omitzero"`
+	SmartBatteryData SmartBatteryData `json:"smartBatteryData,omitzero"`
+	ID               string           `json:"id,omitempty"`
+}
+
+// BatteryTypeData is the BatteryTypeData model.
+type BatteryTypeData struct {
+	// This enumeration object is used to specify the battery configurations present on a controller. Values:
+	// singleSharedCru, dualIndividualFrus, dualSharedCrus, dualIndividualCrus.
+	BatteryType string `json:"batteryType,omitempty"`
+	// This field is present only if the batteryType value is equal to BATTERY_TYPE_DUAL_INDIVIDUAL_CRUS. It is a
+	// reference to the controller that the battery CRU is associated with.
+	CruParentController string `json:"cruParentController,omitempty"`
+	// This field is present only if the batteryType value is equal to BATTERY_TYPE_DUAL_INDIVIDUAL_FRUS. It is a
+	// reference to the the controller that the battery FRU is associated with.
+	ParentController string `json:"parentController,omitempty"`
+}
+
+// BindLookupUser is the BindLookupUser model.
+type BindLookupUser struct {
+	// The password for the bind user
+	Password string `json:"password"`
+	// The full DN for the bind user account
+	User string `json:"user"`
+}
+
+// BundledFeature is the BundledFeature model.
+type BundledFeature struct {
+	// This field indicate what feature this instance of BundledFeature represents. Values: volumesPerPartition,
+	// totalNumberOfVolumes, storagePartitions, snapshot, volumeCopy, remoteMirroring, driveTrayExpansion,
+	// mixedDriveTypes, mgmtApplication, supportedDrives, supportedDriveTrays, performanceTier,
+	// totalNumberOfSnapshots, totalNumberOfVolCopies, goldKey, snapshotsPerVolume, totalNumberOfMirrors, raid6,
+	// stateCapture, sataStrLen, secureVolume, protectionInformation, solidStateDisk, driveSlotLimit,
+	// fdeProxyKeyManagement, supportedInterposer, vendorSupportedDrives, flashReadCache,
+	// totalNumberOfAsyncMirrorGroups, totalNumberOfAsyncMirrorsPerGroup, totalNumberOfArvmMirrorsPerArray,
+	// totalNumberOfPitsPerArray, pitGroupsPerVolume, totalNumberOfPitGroups, pitsPerPitGroup,
+	// memberVolsPerPitConsistencyGroup, totalNumberOfPitConsistencyGroups, totalNumberOfPitViews,
+	// totalNumberOfThinVolumesPerArray, nativeSataDriveSupport, solidStateDiskLimit,
+	// totalNumberOfRemoteMirrorsPerArray, asup, ectSelector, embeddedSnmpOid, asupOnDemand, dacstoreCompatId,
+	// samoaHicProtocol, targetPortLunMapping, hildaBaseboardProtocol, denali2Protocol, containerBasedApplication,
+	// fibreChannelHicProtocol, trayVpdSettable, ephemeralIscsiLoginSupport, foreignVolumeCopyIn,
+	// driveFlashTechnology.
+	FeatureID string `json:"featureId,omitempty"`
+	// This field is a variable-length array of FeatureAttribute instances representing the attributes that apply
+	// for this particular feature.
+	FeatureAttribute []FeatureAttribute `json:"featureAttribute,omitempty"`
+}
+
+// CFWBatchUpgradeChangeEvent is the CFWBatchUpgradeChangeEvent model.
+type CFWBatchUpgradeChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType        string `json:"eventType"`
+	JobID            string `json:"jobId"`
+	JobState         string `json:"jobState"`
+	TotalSystems     int    `json:"totalSystems"`
+	FailedSystems    int    `json:"failedSystems"`
+	Activate         bool   `json:"activate"`
+	FirmwareFilename string `json:"firmwareFilename"`
+	NvsramFilename   string `json:"nvsramFilename"`
+}
+
+// CFWBatchUpgradeRequest is the CFWBatchUpgradeRequest model.
+// Description of CFWBatchUpgradeRequest object.
+type CFWBatchUpgradeRequest struct {
+	// The list of system infos of the arrays being upgraded.
+	SystemInfos []SystemInfo `json:"systemInfos,omitempty"`
+	// Name of firmware file to be downloaded. If neither firmware file nor NVSRAM file not present assume activate
+	// only.
+	FirmwareFile string `json:"firmwareFile,omitempty"`
+	// Name of NVSRAM file to be downloaded. If neither firmware file nor NVSRAM file not present assume activate
+	// only.
+	NvsramFile string `json:"nvsramFile,omitempty"`
+	// Set to true if activating after download or for activate only action.
+	Activate *bool `json:"activate,omitempty"`
+}
+
+// CFWBatchUpgradeResponse is the CFWBatchUpgradeResponse model.
+// CFW batch upgrade response Object from a GET operation.
+type CFWBatchUpgradeResponse struct {
+	// The job Id for this controller firmware upgrade batch.
+	JobID string `json:"jobId,omitempty"`
+	// The system Id's of the controllers being upgraded.
+	SystemInfos []SystemInfo `json:"systemInfos,omitempty"`
+	// Name of the firmware file being downloaded.
+	FirmwareFilename string `json:"firmwareFilename,omitempty"`
+	// Name of the NVSRAM file being downloaded.
+	NvsramFilename string `json:"nvsramFilename,omitempty"`
+	// True if activate should follow the file transfer.
+	Activate bool `json:"activate,omitempty"`
+	// Current phase of batch operation. Values: none, fileTransfer, healthCheck, activate, waitForReboot, complete.
+	Phase string `json:"phase,omitempty"`
+	// Localized string representation of the phase.
+	PhaseText string `json:"phaseText,omitempty"`
+	// Current state of the batch process. Values: running, complete, failed, cancelled, notStarted.
+	Status string `json:"status,omitempty"`
+	// Localized string representation of the status.
+	StatusText string `json:"statusText,omitempty"`
+	// Number of system upgrades that have failed during processing.
+	SystemFailureCount int `json:"systemFailureCount,omitempty"`
+	// The start time for this job.
+	StartTime string `json:"startTime,omitempty"`
+	// Total runtime for the job in seconds. If the job is still in progress, depicts time thus far.
+	ElapsedTime string `json:"elapsedTime,omitempty"`
+	// A list of system status records. Note, these values are only supplied if detailed results are requested.
+	StatusEntries []CFWBatchUpgradeStatusDetail `json:"statusEntries,omitempty"`
+}
+
+// CFWBatchUpgradeStatusDetail is the CFWBatchUpgradeStatusDetail model.
+// Detailed upgrade status of an individual array.
+type CFWBatchUpgradeStatusDetail struct {
+	// The time that this step started.
+	StartTime string `json:"startTime,omitempty"`
+	// The Id of the system that had the failure.
+	SystemID string `json:"systemId,omitempty"`
+	// The name of the system that had the failure.
+	SystemName string `json:"systemName,omitempty"`
+	// Current status of this array process. Values: running, complete, failed, cancelled, notStarted.
+	Status string `json:"status,omitempty"`
+	// Localized string of the current status.
+	StatusText string `json:"statusText,omitempty"`
+	// The final phase if the process did not complete successfully. Will be set to None until the process is in a
+	// terminal state. Values: none, fileTransfer, healthCheck, activate, waitForReboot, complete.
+	FinalPhase string `json:"finalPhase,omitempty"`
+	// Localized string representation of the final phase.
+	FinalPhaseText string `json:"finalPhaseText,omitempty"`
+	// The phase the batch upgrade proccess is currently in. Values: none, fileTransfer, healthCheck, activate,
+	// waitForReboot, complete.
+	CurrentPhase string `json:"currentPhase,omitempty"`
+	// Localized string representation of the current phase.
+	CurrentPhaseText string `json:"currentPhaseText,omitempty"`
+	// A text description of the result status of the operation.
+	StatusDesc string `json:"statusDesc,omitempty"`
+	// A text description of any status paramaters.
+	StatusParams string `json:"statusParams,omitempty"`
+}
+
+// CFWBatchVersionsResponse is the CFWBatchVersionsResponse model.
+// Container object for installed and staged firmware versions for an array.
+type CFWBatchVersionsResponse struct {
+	// The Id of the system of the array associated with the firmware.
+	SystemID string `json:"systemId,omitempty"`
+	// Flag to indicate if valid staged firmware is present.
+	IsStagedValid     bool                   `json:"isStagedValid,omitempty"`
+	InstalledVersions []VersionDescriptor    `json:"installedVersions,omitempty"`
+	StagedVersions    StagedFirmwareResponse `json:"stagedVersions,omitzero"`
+}
+
+// CFWVersionChangeEvent is the CFWVersionChangeEvent model.
+type CFWVersionChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType                 string              `json:"eventType,omitempty"`
+	CurrentVersionDescriptors []VersionDescriptor `json:"currentVersionDescriptors,omitempty"`
+	CurrentStagedFirmware     []StagedFirmware    `json:"currentStagedFirmware,omitempty"`
+	StorageSystemID           string              `json:"storageSystemId,omitempty"`
+}
+
+// CGSnapshotViewRequest is the CGSnapshotViewRequest model.
+type CGSnapshotViewRequest struct {
+	// The id of the PIT to create a View for.
+	PitID string `json:"pitId"`
+	// A manually specified ConcatVolumeCandidate of type newVol or existingVols. Required if accessMode ==
+	// readWrite.
+	Candidate ConcatVolumeCandidate `json:"candidate,omitzero"`
+	// The access mode determines whether or not a repository volume should be created. Values: modeUnknown,
+	// readWrite, readOnly.
+	AccessMode     string `json:"accessMode,omitempty"`
+	ScanMedia      bool   `json:"scanMedia,omitempty"`
+	ValidateParity bool   `json:"validateParity,omitempty"`
+}
+
+// CVCandidateMultipleSelectionRequest is the CVCandidateMultipleSelectionRequest model.
+type CVCandidateMultipleSelectionRequest struct {
+	CandidateRequests        []ConcatVolumeCandidateRequest `json:"candidateRequests,omitempty"`
+	Exclusions               []ConcatVolumeCandidate        `json:"exclusions,omitempty"`
+	UseFreeRepositoryVolumes *bool                          `json:"useFreeRepositoryVolumes,omitempty"`
+}
+
+// CVCandidateResponse is the CVCandidateResponse model.
+type CVCandidateResponse struct {
+	RaidLevelMatch       bool                  `json:"raidLevelMatch,omitempty"`
+	SamePool             bool                  `json:"samePool,omitempty"`
+	Candidate            ConcatVolumeCandidate `json:"candidate,omitzero"`
+	VolumeGroupID        string                `json:"volumeGroupId,omitempty"`
+	CapacityMatch        bool                  `json:"capacityMatch,omitempty"`
+	QosMatch             bool                  `json:"qosMatch,omitempty"`
+	DiskPool             bool                  `json:"diskPool,omitempty"`
+	Capacity             string                `json:"capacity,omitempty"`
+	BaseMappableObjectID string                `json:"baseMappableObjectId,omitempty"`
+	// Values: unknown, newVol, existingVols, expansion.
+	CandidateType string `json:"candidateType,omitempty"`
+	// True if the drive security settings for the base pool and the candidate's pool match.
+	SecurityMatch bool `json:"securityMatch,omitempty"`
+	// True if the DataAssurance settings for the base and the candidate match.
+	DaMatch bool `json:"daMatch,omitempty"`
+	// True if the drives for the base pool and the candidate's pool match.
+	DriveTypeMatch bool `json:"driveTypeMatch,omitempty"`
+	// True if the repository candidate is based on an existing volume and does not require a volume creation to
+	// occur.
+	ExistingCandidate bool `json:"existingCandidate,omitempty"`
+}
+
+// CVCandidateSelectionRequest is the CVCandidateSelectionRequest model.
+type CVCandidateSelectionRequest struct {
+	UseFreeRepositoryVolumes *bool                        `json:"useFreeRepositoryVolumes,omitempty"`
+	CandidateRequest         ConcatVolumeCandidateRequest `json:"candidateRequest"`
+	Exclusions               []ConcatVolumeCandidate      `json:"exclusions,omitempty"`
+}
+
+// CacheBackupDevice is the CacheBackupDevice model.
+type CacheBackupDevice struct {
+	// The SYMbol reference that identifies the instance of the device
+	BackupDeviceRef string `json:"backupDeviceRef,omitempty"`
+	// The number of the slot on the controller where the backup device resides. Cache backup device slots are
+	// numbered starting at one, independent of other types of slots (e.g., host board slots) on the controller.
+	// Note that this field is targeted for deletion in SYMbol rev. 85b.
+	BackupDeviceControllerSlot int `json:"backupDeviceControllerSlot,omitempty"`
+	// This field has been deprecated and should not be used.
+	ParentController string `json:"parentController,omitempty"`
+	// The type of the backup device ( e.g., USB flash drive). Values: unknown, usbFlash, sdFlash, sataFlash.
+	BackupDeviceType string `json:"backupDeviceType,omitempty"`
+	// The status of the backup device Values: unknown, optimal, failed, removed, writeProtected, incompatible.
+	BackupDeviceStatus string `json:"backupDeviceStatus,omitempty"`
+	// Vital product data for the backup device.
+	BackupDeviceVpd CacheBackupDeviceVpd `json:"backupDeviceVpd,omitzero"`
+	// The capacity of backup device, expressed in megabytes.
+	BackupDeviceCapacity int `json:"backupDeviceCapacity,omitempty"`
+	// The repair policy for the cache backup device component.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+	// The physical location of the cache backup device. The parent field of Location is set to the controller where
+	// the device physically resides, and the position field is the parent-relative/like-component-relative slot
+	// number of the device, starting at one.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// Wear life data for this cache backup device.
+	CacheBackupDeviceWearLife CacheBackupDeviceWearLife `json:"cacheBackupDeviceWearLife,omitzero"`
+	ID                        string                    `json:"id,omitempty"`
+}
+
+// CacheBackupDeviceLocation is the CacheBackupDeviceLocation model.
+type CacheBackupDeviceLocation struct {
+	// The tray ID of the controller that houses the cache backup device.
+	ControllerTray int `json:"controllerTray,omitempty"`
+	// The slot number of the controller that houses the cache backup device.
+	ControllerSlot int `json:"controllerSlot,omitempty"`
+	// The number of the slot on the controller in which the cache backup device resides.
+	CacheBackupDeviceSlot int `json:"cacheBackupDeviceSlot,omitempty"`
+}
+
+// CacheBackupDeviceVpd is the CacheBackupDeviceVpd model.
+type CacheBackupDeviceVpd struct {
+	// The variable-length ASCII text string that identifies the device manufacturer..
+	Manufacturer string `json:"manufacturer,omitempty"`
+	// The date of manufacture of the device, represented in seconds since midnight GMT on January 1, 1970.
+	ManufactureDate string `json:"manufactureDate,omitempty"`
+	// The variable-length ASCII text string that identifies the device by name.
+	ProductID string `json:"productId,omitempty"`
+	// The variable-length ASCII text string that identifies the device revision level.
+	ProductRevLevel string `json:"productRevLevel,omitempty"`
+	// The variable-length ASCII text string that identifies the device part number.
+	PartNumber string `json:"partNumber,omitempty"`
+	// The variable-length ASCII text string that identifies the device serial number.
+	SerialNumber string `json:"serialNumber,omitempty"`
+}
+
+// CacheBackupDeviceWearLife is the CacheBackupDeviceWearLife model.
+type CacheBackupDeviceWearLife struct {
+	// This enumeration defines the different levels of wear life information that can be reported for the cache
+	// backup device. Values: unknown, notReported, modelNotRecognized, enduranceUsed, stats.
+	CacheBackupDeviceWearLifeReportingLevel string `json:"cacheBackupDeviceWearLifeReportingLevel,omitempty"`
+	// Only percent life used is reported for this device.
+	PercentEnduranceUsed int `json:"percentEnduranceUsed,omitempty"`
+	// Percent life used, percent life remaining and average erase count are reported for this cache backup device.
+	CacheBackupDeviceWearLifeStats CacheBackupDeviceWearLifeStats `json:"cacheBackupDeviceWearLifeStats,omitzero"`
+}
+
+// CacheBackupDeviceWearLifeStats is the CacheBackupDeviceWearLifeStats model.
+type CacheBackupDeviceWearLifeStats struct {
+	// Percent life used.
+	PercentEnduranceUsed int `json:"percentEnduranceUsed,omitempty"`
+	// Percent life remaining.
+	PercentEnduranceRemaining int `json:"percentEnduranceRemaining,omitempty"`
+	// Average erase count.
+	AverageEraseCount int `json:"averageEraseCount,omitempty"`
+}
+
+// CacheMemoryDimm is the CacheMemoryDimm model.
+type CacheMemoryDimm struct {
+	// The SYMbol reference value that uniquely identifies an instance of a cache memory DIMM object.
+	CacheMemoryDimmRef string `json:"cacheMemoryDimmRef,omitempty"`
+	// The operational status of the DIMM. Values: unknown, optimal, empty, failed.
+	Status string `json:"status,omitempty"`
+	// The capacity of the DIMM expressed in megabytes.
+	CapacityInMegabytes int `json:"capacityInMegabytes,omitempty"`
+	// The serial number of the DIMM.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// The LSI Logic part number of the DIMM.
+	PartNumber string `json:"partNumber,omitempty"`
+	// The OEM part number of the DIMM.
+	OemPartNumber string `json:"oemPartNumber,omitempty"`
+	// The manufacturer part number of the DIMM.
+	ManufacturerPartNumber string `json:"manufacturerPartNumber,omitempty"`
+	// The manufacturer part number of the DIMM.
+	Manufacturer string `json:"manufacturer,omitempty"`
+	// The manufacture date of the DIMM
+	ManufactureDate string `json:"manufactureDate,omitempty"`
+	// The repair policy for the cache memory DIMM component.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+	// The physical location of the cache memory DIMM. The parent reference in Location identifies the controller
+	// where the DIMM physically resides, and the position field is the parent-relative/like-component relative slot
+	// number of the DIMM, starting at 1.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// This field is set to true if the DIMM is shared between processor and cache. It is set to false if the DIMM
+	// is dedicated to the cache.
+	Shared bool `json:"shared,omitempty"`
+	// The module revision code of the DIMM.
+	RevisionCode string `json:"revisionCode,omitempty"`
+}
+
+// CacheMemoryDimmLocation is the CacheMemoryDimmLocation model.
+type CacheMemoryDimmLocation struct {
+	// The tray ID of the controller where the DIMM is installed.
+	ControllerTray int `json:"controllerTray,omitempty"`
+	// The slot number of the controller where the DIMM is installed.
+	ControllerSlot int `json:"controllerSlot,omitempty"`
+	// The number of the slot on the controller where the cache memory DIMM is installed. Cache memory DIMM slots
+	// are numbered starting at one, independent of other types of slots (e.g., cache backup device slots) on the
+	// controller.
+	CacheMemoryDimmControllerSlot int `json:"cacheMemoryDimmControllerSlot,omitempty"`
+}
+
+// CallResponse is the CallResponse model.
+// API response to report errors back to the user.
+type CallResponse struct {
+	// The error message.
+	ErrorMessage string `json:"errorMessage"`
+	// An optional developer message providing further error details.
+	DeveloperMessage string `json:"developerMessage"`
+	// The localized message.
+	LocalizedMessage string `json:"localizedMessage"`
+	// The value of retcode can vary by the code type. To be unique, it must be combined with code type. It is a
+	// string. Even if the returned value looks like a number, don't assume its a number. It is a short label that
+	// will not change based on Internationalization.
+	Retcode string `json:"retcode"`
+	// The API error code type Values: symbol, systemerror, devicemgrerror.
+	CodeType string `json:"codeType"`
+	// The list of invalid fields. The list will be populated with known invalid fields. In the case no invalid
+	// fields are known, the list will be empty.
+	InvalidFieldsIfKnown []string `json:"invalidFieldsIfKnown"`
+}
+
+// CertRevocatSettings is the CertRevocatSettings model.
+// Server certificate revocation settings.
+type CertRevocatSettings struct {
+	// Set to true to enable certificate revocation checking; by default this is not enabled.
+	RevocationChecking bool `json:"revocationChecking,omitempty"`
+	// The URL of the OCSP responder to be used by default; if left blank the OCSP responder address specified in
+	// the certificate will be used.
+	OcspResponderAddress string `json:"ocspResponderAddress,omitempty"`
+	// Set to true to enable strict certificate verification checking; by default this is not enabled.
+	StrictCertVerificationEnabled bool `json:"strictCertVerificationEnabled,omitempty"`
+}
+
+// CertificateRevocationSettings is the CertificateRevocationSettings model.
+type CertificateRevocationSettings struct {
+	// Used to determine if certificate revocation checking is enabled.
+	CertificateRevocationCheckEnabled bool `json:"certificateRevocationCheckEnabled,omitempty"`
+	// If set this will over-ride the OCSP (On-line Certificate Status Protocol) responder address found in the
+	// certificate.
+	OcspResponderAddress string `json:"ocspResponderAddress,omitempty"`
+	// True if the OCSP (On-line Certificate Status Protocol) server address is set; otherwise false.
+	IsOCSPResponderAddressSet bool `json:"isOCSPResponderAddressSet,omitempty"`
+}
+
+// CfwActivationRequest is the CfwActivationRequest model.
+type CfwActivationRequest struct {
+	SkipMelCheck *bool `json:"skipMelCheck,omitempty"`
+}
+
+// CfwUpgradeRequest is the CfwUpgradeRequest model.
+// Run a controller firmware upgrade operation
+type CfwUpgradeRequest struct {
+	CfwFile       string `json:"cfwFile,omitempty"`
+	NvsramFile    string `json:"nvsramFile,omitempty"`
+	StageFirmware *bool  `json:"stageFirmware,omitempty"`
+	// Skip check of the MEL events for issues with the storage-system.
+	SkipMelCheck *bool `json:"skipMelCheck,omitempty"`
+}
+
+// CfwUpgradeResponse is the CfwUpgradeResponse model.
+type CfwUpgradeResponse struct {
+	// A unique value that identifies the request for later retrieval.
+	RequestID                  string                           `json:"requestId"`
+	HealthCheck                FirmwareUpgradeHealthCheckResult `json:"healthCheck"`
+	StartTime                  string                           `json:"startTime"`
+	UploadStartTime            string                           `json:"uploadStartTime"`
+	ActivationStartTime        string                           `json:"activationStartTime"`
+	UploadCompletionTime       string                           `json:"uploadCompletionTime"`
+	UploadCompletionPercentage int                              `json:"uploadCompletionPercentage"`
+	ActivationCompletionTime   string                           `json:"activationCompletionTime"`
+	Running                    bool                             `json:"running"`
+}
+
+// ChannelLocation is the ChannelLocation model.
+type ChannelLocation struct {
+	// The channel type. Values: hostside, driveside, management.
+	ChannelType string `json:"channelType,omitempty"`
+	// The channel location.
+	Index int `json:"index,omitempty"`
+	// Controller number 1 if in top slot. Controller number 2 if in bottom slot.
+	ControllerSlot int `json:"controllerSlot,omitempty"`
+}
+
+// ChannelPort is the ChannelPort model.
+type ChannelPort struct {
+	// A reference to this channel port.
+	PortRef string `json:"portRef,omitempty"`
+	// This field has been deprecated and should not be used.
+	PortParent ChannelPortParent `json:"portParent,omitzero"`
+	// This field has been deprecated and should not be used.
+	PortNumber int `json:"portNumber,omitempty"`
+	// This field has been deprecated and should not be used.
+	Channel int `json:"channel,omitempty"`
+	// The type of the associated channel - either host-side or drive-side. Values: hostside, driveside, management.
+	ChannelType string `json:"channelType,omitempty"`
+	// The physical location of the channel port. The parent reference in Location identifies the physical CRU where
+	// the port is located, and the position field is the parent-relative/like-port relative number of the port,
+	// starting at 1.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// This field is true if a trunk connection has been established and is being maintained on this controller or
+	// ESM channel port. This field is always false if the channel port is on a controller or ESM that does not have
+	// a Fibre Channel I/O interface type, or if the channel port is associated with a host-side channel.
+	IsTrunkActive bool `json:"isTrunkActive,omitempty"`
+	// The list of channels associated with this ChannelPort
+	ChannelList []int  `json:"channelList,omitempty"`
+	ID          string `json:"id,omitempty"`
+}
+
+// ChannelPortParent is the ChannelPortParent model.
+type ChannelPortParent struct {
+	// This field has been deprecated and should not be used. Values: unknown, esm, minihub, controller, hic.
+	Type string `json:"type,omitempty"`
+	// This field is present only if the type field is equal to CHANNEL_PORT_PARENT_CONTROLLER.
+	ControllerRef string `json:"controllerRef,omitempty"`
+	// This field is present only if the type field is equal to CHANNEL_PORT_PARENT_ESM.
+	EsmRef string `json:"esmRef,omitempty"`
+	// This field is present only if the type field is equal to CHANNEL_PORT_PARENT_MINIHUB.
+	MinihubRef string `json:"minihubRef,omitempty"`
+	// This field is present only if the type field is equal to CHANNEL_PORT_PARENT_HIC.
+	HicRef string `json:"hicRef,omitempty"`
+}
+
+// ChannelPorts is the ChannelPorts model.
+type ChannelPorts struct {
+	// This is set to true only if speed detection has failed.
+	SpeedDetError bool `json:"speedDetError,omitempty"`
+	// This is set to true if the port is bypassed.
+	ManuallyBypassed bool `json:"manuallyBypassed,omitempty"`
+	// This is the ChannelPort port number, 1 based. Values: portUnknown, port1, port2, port3, port4.
+	PortNumber string `json:"portNumber,omitempty"`
+}
+
+// CheckVolumeParityAsyncResponse is the CheckVolumeParityAsyncResponse model.
+// The check volume parity response.
+type CheckVolumeParityAsyncResponse struct {
+	// The check volume parity job id.
+	JobID string `json:"jobId,omitempty"`
+	// The volume id.
+	VolumeID string `json:"volumeId,omitempty"`
+	// The volume name
+	VolumeName string `json:"volumeName,omitempty"`
+}
+
+// CheckVolumeParityError is the CheckVolumeParityError model.
+// Contains the data related to the detected parity error.
+type CheckVolumeParityError struct {
+	// The LBA on which the parity error was detected.
+	Lba string `json:"lba,omitempty"`
+	// Values: ok, timeout, parityError, mediaError, edcError, raid6QError, raid6PqError, okFollowingRetry,
+	// okFollowingRaid6Correction, raid6DataError.
+	ParityStatus string `json:"parityStatus,omitempty"`
+}
+
+// CheckVolumeParityJobErrorResponse is the CheckVolumeParityJobErrorResponse model.
+// The check volume parity job errors response.
+type CheckVolumeParityJobErrorResponse struct {
+	// The check volume parity job id.
+	JobID string `json:"jobId,omitempty"`
+	// Values: notStarted, inProgress, cancelling, cancelled, completed, failed.
+	JobStatus string `json:"jobStatus,omitempty"`
+	// The volume id.
+	VolumeID string `json:"volumeId,omitempty"`
+	// The volume name.
+	VolumeName string `json:"volumeName,omitempty"`
+	// The detailed list of parity errors detected during the parity scan.
+	ParityErrors []CheckVolumeParityError `json:"parityErrors,omitempty"`
+}
+
+// CheckVolumeParityJobResponse is the CheckVolumeParityJobResponse model.
+// The check volume parity job response.
+type CheckVolumeParityJobResponse struct {
+	// The check volume parity job id.
+	JobID string `json:"jobId,omitempty"`
+	// The timestamp in ISO-8601 format representing the time the job was started.
+	JobStartedTimestamp string `json:"jobStartedTimestamp,omitempty"`
+	// The volume id.
+	VolumeID string `json:"volumeId,omitempty"`
+	// The volume name.
+	VolumeName string `json:"volumeName,omitempty"`
+	// Values: notStarted, inProgress, cancelling, cancelled, completed, failed.
+	JobStatus string `json:"jobStatus,omitempty"`
+	// The starting LBA.
+	StartLba string `json:"startLba,omitempty"`
+	// The ending LBA.
+	EndLba string `json:"endLba,omitempty"`
+	// Values: priority0, priority1, priority2, priority3, priority4.
+	ScanPriority string `json:"scanPriority,omitempty"`
+	// True to automatically repair parity errors detected; otherwise false to only check the volume parity.
+	RepairParityErrors bool `json:"repairParityErrors,omitempty"`
+	// True to automatically repair media and EDC errors detected, otherwise false to only check the volume parity.
+	RepairMediaErrors bool `json:"repairMediaErrors,omitempty"`
+	// The last scanned LBA.
+	LastScannedLba string `json:"lastScannedLba,omitempty"`
+	// The job's percentage complete.
+	PercentComplete int `json:"percentComplete,omitempty"`
+	// The job run time in seconds.
+	RuntimeInSec string `json:"runtimeInSec,omitempty"`
+	// The job's estimated time remaining in seconds.
+	EstimatedTimeRemainingInSec string `json:"estimatedTimeRemainingInSec,omitempty"`
+	// The total number of parity errors detected.
+	TotalParityErrorsDetected int `json:"totalParityErrorsDetected,omitempty"`
+}
+
+// CheckVolumeParityRequest is the CheckVolumeParityRequest model.
+// The check volume parity request.
+type CheckVolumeParityRequest struct {
+	// The starting LBA. This is an optional field and will default to the volume's starting LBA if not set.
+	StartLba string `json:"startLba,omitempty"`
+	// The ending LBA. This is an optional field and will default to the volume's ending LBA if not set.
+	EndLba string `json:"endLba,omitempty"`
+	// Values: priority0, priority1, priority2, priority3, priority4.
+	ScanPriority string `json:"scanPriority,omitempty"`
+	// True to automatically repair parity errors detected; otherwise false to only check the volume parity. This is
+	// an optional field and will default to true.
+	RepairParityErrors *bool `json:"repairParityErrors,omitempty"`
+	// True to automatically repair media and EDC errors detected, otherwise false to only check the volume parity.
+	// This is an optional field and will default to true.
+	RepairMediaErrors *bool `json:"repairMediaErrors,omitempty"`
+}
+
+// Cluster is the Cluster model.
+type Cluster struct {
+	// The unique identification value for this object. Other objects may use this reference value to refer to the
+	// cluster.
+	ClusterRef string `json:"clusterRef,omitempty"`
+	// The user-assigned, descriptive label string for the cluster.
+	Label string `json:"label,omitempty"`
+	// If true, indicates that I/O accesses from this cluster are subject to the storage array's default
+	// LUN-to-volume mappings. If false, indicates that I/O accesses from the cluster are subject to
+	// cluster-specific LUN-to-volume mappings.
+	IsSAControlled bool `json:"isSAControlled,omitempty"`
+	// This field has been deprecated and should not be used.
+	ConfirmLUNMappingCreation bool `json:"confirmLUNMappingCreation,omitempty"`
+	// This field is true if the host has a PI capable access method.
+	ProtectionInformationCapableAccessMethod bool `json:"protectionInformationCapableAccessMethod,omitempty"`
+	// Provides an indication as to whether LUN 0 is restricted (i.e., disallowed) for the associated cluster.
+	IsLun0Restricted bool   `json:"isLun0Restricted,omitempty"`
+	Name             string `json:"name,omitempty"`
+	ID               string `json:"id,omitempty"`
+}
+
+// CommandProtocolProperties is the CommandProtocolProperties model.
+type CommandProtocolProperties struct {
+	// This enumeration describes the command protocol (e.g. SCSI vs. NVMe). Values: unknown, scsi, nvme.
+	CommandProtocol string `json:"commandProtocol,omitempty"`
+	// This field describes the NVMe command protocol properties.
+	NvmeProperties NVMeCommandProtocolProperties `json:"nvmeProperties,omitzero"`
+	// This field describes the SCSI command protocol properties.
+	ScsiProperties SCSICommandProtocolProperties `json:"scsiProperties,omitzero"`
+}
+
+// CommandProtocolPropertiesList is the CommandProtocolPropertiesList model.
+type CommandProtocolPropertiesList struct {
+	// This describes a list of command protocol properties.
+	CommandProtocolProperties []CommandProtocolProperties `json:"commandProtocolProperties,omitempty"`
+}
+
+// CompatibleFirmware is the CompatibleFirmware model.
+type CompatibleFirmware struct {
+	// A firmware package version identifier, which may or may not contain bytes with a value of
+	// FW_VERSION_WILDCARD.
+	FwVersion string `json:"fwVersion,omitempty"`
+	// A true value indicates that any firmware version that matches the one specified in this structure is
+	// compatible with the currently-installed storage array firmware. A false value indicates that matching
+	// firmware versions are NOT compatible.
+	Compatible bool `json:"compatible,omitempty"`
+}
+
+// ComponentBundle is the ComponentBundle model.
+type ComponentBundle struct {
+	// The fan component.
+	Fan []Fan `json:"fan,omitempty"`
+	// The battery component.
+	Battery []Battery `json:"battery,omitempty"`
+	// The power supply component.
+	PowerSupply []PowerSupply `json:"powerSupply,omitempty"`
+	// The thermal sensor component.
+	ThermalSensor []ThermalSensor `json:"thermalSensor,omitempty"`
+	// The ESM component.
+	Esm []Esm `json:"esm,omitempty"`
+	// The UPS component.
+	Ups []Ups `json:"ups,omitempty"`
+	// The minihub component.
+	Minihub []Minihub `json:"minihub,omitempty"`
+	// The GBIC component.
+	Gbic []Gbic `json:"gbic,omitempty"`
+	// The SFP component.
+	Sfp []Sfp `json:"sfp,omitempty"`
+	// The interconnect CRU.
+	InterconnectCRU []InterconnectCRU `json:"interconnectCRU,omitempty"`
+	// The Support CRU.
+	SupportCRU []SupportCRU `json:"supportCRU,omitempty"`
+	// The alarm.
+	Alarm []Alarm `json:"alarm,omitempty"`
+	// The host board.
+	HostBoard []HostBoard `json:"hostBoard,omitempty"`
+	// The SAS expanders.
+	SasExpander []SasExpander `json:"sasExpander,omitempty"`
+	// The cache backup devices.
+	CacheBackupDevice []CacheBackupDevice `json:"cacheBackupDevice,omitempty"`
+	// List of field replaceable cache memory DIMM modules.
+	CacheMemoryDimm []CacheMemoryDimm `json:"cacheMemoryDimm,omitempty"`
+	// List of field replaceable processor memory DIMMs.
+	ProcessorMemoryDimm []ProcessorMemoryDimm `json:"processorMemoryDimm,omitempty"`
+	// List of drawers.
+	Drawer []Drawer `json:"drawer,omitempty"`
+}
+
+// ComponentLocation is the ComponentLocation model.
+type ComponentLocation struct {
+	// This enumeration object is used to specify the different component types that can be associated with entries
+	// in the Major Event Log. Values: unknown, drive, powerSply, fan, minihub, tempSensor, channel, esm,
+	// controller, battery, enclosure, ups, chip, volume, volumeGrp, portCru, interconnectCru, supportCru, alarm,
+	// channelPort, sfpPort, hostBoard, newFormat, ctlrSfp, ctlrSoc, initiator, target, isnsServer, hostIoCard,
+	// cacheBackupDevice, cacheMemDimm, host, hostPort, drawer, relative, schedule, asyncMirrorGroup, diskPool, pit,
+	// pitConsistencyGroup, cgpit, cgview, flashCache, snmpCommunity, snmpTrapDestination, fcTarget, blankOne,
+	// blankTwo, fanOnlyCru, psuCru, nvmeInitiator, remoteTargetGrp, snmpUsmUser.
+	EventComponentType string `json:"eventComponentType,omitempty"`
+	// This field is valid only when the eventComponentType value is equal to EVENT_COMP_TYPE_ALARM.
+	AlarmLocation TraySlot `json:"alarmLocation,omitzero"`
+	// The tray ID of the tray containing the battery for which the MEL entry was generated. This field is valid
+	// only when the eventComponentType value is equal to EVENT_COMP_TYPE_BATTERY.
+	BatteryTray int `json:"batteryTray,omitempty"`
+	// This field is valid only when the eventComponentType value is equal toEVENT_COMP_TYPE_CACHE_BACKUP_DEVICE. It
+	// identifies the location of the cache backup device to which the event applies.
+	CacheBackupDeviceLocation CacheBackupDeviceLocation `json:"cacheBackupDeviceLocation,omitzero"`
+	// This field is only valid when the eventComponentType value is equal to EVENT_COMP_TYPE_CACHE_MEM_DIMM. It
+	// identifies the location of the cache memory DIMM to which the event applies.
+	CacheMemoryDimmLocation CacheMemoryDimmLocation `json:"cacheMemoryDimmLocation,omitzero"`
+	// The location of the channel. This field is valid only when the eventComponentType value is equal to
+	// EVENT_COMP_TYPE_CHANNEL.
+	ChannelLoc ChannelLocation `json:"channelLoc,omitzero"`
+	// This field is valid only when the eventComponentType value is equal to EVENT_COMP_TYPE_CHANNEL_PORT.
+	ChannelPort int `json:"channelPort,omitempty"`
+	// The physical location of the controller that owns the chip for which the MEL entry was generated. This field
+	// is valid only when the eventComponentType value is equal to EVENT_COMP_TYPE_CHIP.
+	ChipLoc TraySlot `json:"chipLoc,omitzero"`
+	// The physical location of the controller for which the MEL entry was generated. This field is valid only when
+	// the eventComponentType value is equal to EVENT_COMP_TYPE_CONTROLLER.
+	ControllerLoc TraySlot `json:"controllerLoc,omitzero"`
+	// This field is valid only when the eventComponentType value is equal to EVENT_COMP_TYPE_CTLR_SFP.
+	CtlrSfpLocation ControllerSfpLocation `json:"ctlrSfpLocation,omitzero"`
+	// This field is valid only when the eventComponentType value is equal to EVENT_COMP_TYPE_CTLR_SOC.
+	SocLocation SocLocation `json:"socLocation,omitzero"`
+	// The physical location of the drive for which the MEL entry was generated. This field is valid only when the
+	// eventComponentType value is equal to EVENT_COMP_TYPE_DRIVE.
+	DriveLoc TraySlot `json:"driveLoc,omitzero"`
+	// The tray ID of the tray for which the MEL entry was generated. This field is valid only when the
+	// eventComponentType value is equal to EVENT_COMP_TYPE_ENCLOSURE.
+	EnclosureTray int `json:"enclosureTray,omitempty"`
+	// This field has been deprecated and should not be used.
+	EsmTray int `json:"esmTray,omitempty"`
+	// The tray ID of the tray containing the fan for which the MEL entry was generated. This field is valid only
+	// when the eventComponentType value is equal to EVENT_COMP_TYPE_FAN.
+	FanTray int `json:"fanTray,omitempty"`
+	// This field is valid only when the eventComponentType value is equal to EVENT_COMP_TYPE_HOST_IO_CARD. It
+	// identifies the location of the host I/O card to which the event applies.
+	HostIoCardLocation HostIoCardLocation `json:"hostIoCardLocation,omitzero"`
+	// This field is valid only when the eventComponentType value is equal to EVENT_COMP_TYPE_INITIATOR. It
+	// identifies the iSCSI initiator to which the event applies.
+	InitiatorLocation InitiatorLocation `json:"initiatorLocation,omitzero"`
+	// No information is returned
+	InterconnectCruLocation TraySlot `json:"interconnectCruLocation,omitzero"`
+	// This field is valid only when the eventComponentType value is equal to EVENT_COMP_TYPE_ISNS_SERVER. It
+	// identifies the iSNS server to which the event applies.
+	IsnsServerLocation IsnsServerLocation `json:"isnsServerLocation,omitzero"`
+	// The location of the minihub. This filed is valid only when the eventComponentType value is equal to
+	// EVENT_COMP_TYPE_MINIHUB.
+	MinihubLoc MinihubLocation `json:"minihubLoc,omitzero"`
+	// This field has been deprecated and should not be used.
+	PortCruTray int `json:"portCruTray,omitempty"`
+	// The tray ID of the tray containing the power supply for which the MEL entry was generated. This field is
+	// valid only when the eventComponentType value is equal to EVENT_COMP_TYPE_POWER_SPLY.
+	PowerSupplyTray int `json:"powerSupplyTray,omitempty"`
+	// This field is valid only when the eventComponentType value is equal to EVENT_COMP_TYPE_SFP_PORT.
+	SfpLocation TraySlot `json:"sfpLocation,omitzero"`
+	// This field is valid only when the eventComponentType value is equal to EVENT_COMP_TYPE_SUPPORT_CRU.
+	SupportCruLocation TraySlot `json:"supportCruLocation,omitzero"`
+	// This field is valid only when the eventComponentType value is equal to EVENT_COMP_TYPE_TARGET. It identifies
+	// the iSCSI target to which the event applies.
+	TargetLocation TargetLocation `json:"targetLocation,omitzero"`
+	// The tray ID of the tray containing the temperature sensor for which the MEL entry was generated. This field
+	// is valid only when the eventComponentType value is equal to EVENT_COMP_TYPE_TEMP_SENSOR.
+	TempSensorTray int `json:"tempSensorTray,omitempty"`
+	// The user-assigned label of the volume for which the MEL entry was generated. This field is valid only when
+	// the eventComponentType value is equal to EVENT_COMP_TYPE_VOLUME
+	Label string `json:"label,omitempty"`
+	// The volume group sequence number of the volume group for which the MEL entry was generated. This field is
+	// valid only when the eventComponentType value is equal to EVENT_COMP_TYPE_VOLUME_GRP.
+	GroupNumber int `json:"groupNumber,omitempty"`
+	// This field is only valid when the eventComponentType field is equal to EVENT_COMP_TYPE_HOST. It is the
+	// user-assigned label of the host for which the MEL entry is reporting a problem.
+	HostLocation string `json:"hostLocation,omitempty"`
+	// This field is only valid when the eventComponentType field is equal to EVENT_COMP_TYPE_HOST_PORT. It
+	// identifies the host port to which the event applies.
+	HostPortLocation HostPortLocation `json:"hostPortLocation,omitzero"`
+	// The physical location of the drawer for which the MEL event was generated. This field is only valid when the
+	// eventComponentType field is equal to EVENT_COMP_TYPE_DRAWER.
+	DrawerLocation TraySlot `json:"drawerLocation,omitzero"`
+	// The component type and location are described in this field. This field is only valid when the
+	// eventComponentType field is equal to EVENT_COMP_TYPE_RELATIVE.
+	ComponentRelativeLocation ComponentRelativeLocation `json:"componentRelativeLocation,omitzero"`
+	// The user-assigned label of the schedule. This field is only valid when the eventComponentType field is equal
+	// to EVENT_COMP_TYPE_SCHEDULE.
+	Schedule string `json:"schedule,omitempty"`
+	// This field is only valid when the eventComponentType field is equal to EVENT_COMP_TYPE_DISK_POOL.
+	DiskPool int `json:"diskPool,omitempty"`
+	// This field is only valid when the eventComponentType field is equal to EVENT_COMP_TYPE_ASYNC_MIRROR_GROUP.
+	AsyncMirrorGroup string `json:"asyncMirrorGroup,omitempty"`
+	// This field is only valid when the eventComponentType field is equal to EVENT_COMP_TYPE_PIT. Note that events
+	// for PITs also include extended component location information beyond just the associated PiT Group label
+	// (PITs themselves are identified by a combination of the label of the associated PiT Group plus a sequence
+	// number).
+	PitLabel string `json:"pitLabel,omitempty"`
+	// This field is only valid when the eventComponentType field is equal to EVENT_COMP_TYPE_PIT_CONSISTENCY_GROUP.
+	CgLabel string `json:"cgLabel,omitempty"`
+	// This field is only valid when the eventComponentType field is equal to EVENT_COMP_TYPE_CGPIT. Note that
+	// events for CGPITs also include extended component location information beyond just the associated CG label
+	// (CGPITs themselves are identified by a combination of the label of the associated Consistency Group plus a
+	// sequence number).
+	CgPitLabel string `json:"cgPitLabel,omitempty"`
+	// This field is only valid when the eventComponentType field is equal to EVENT_COMP_TYPE_CGVIEW.
+	CgViewLabel string `json:"cgViewLabel,omitempty"`
+	// This field is only valid when the eventComponentType field is equal to EVENT_COMP_TYPE_FLASH_CACHE. It
+	// identifies the flash cache device to which this event applies.
+	FlashCacheLabel string `json:"flashCacheLabel,omitempty"`
+	// This field is only valid when the eventComponentType field is equal to EVENT_COMP_TYPE_SNMP_COMMUNITY. The
+	// community name string can contain up to 31 characters.
+	CommunityNameString string `json:"communityNameString,omitempty"`
+	// This field is only valid when the eventComponentType field is equal to EVENT_COMP_TYPE_SNMP_TRAP_DESTINATION.
+	// It contains an ASCII representation of the destination address (the maximum number of characters is 40 for
+	// IPV6, 15 characters for IPV4.
+	DestinationIPAddress string `json:"destinationIpAddress,omitempty"`
+	// This field is only valid when the eventComponentType field is equal to EVENT_COMP_TYPE_FC_TARGET. It contains
+	// location data that is an 8-byte binary SCSI name.
+	FcTargetLocation FibreChannelTargetLocation `json:"fcTargetLocation,omitzero"`
+	// Location information for fan CRU.
+	FanCruLocation TraySlot `json:"fanCruLocation,omitzero"`
+	// Power supply CRU location.
+	PowerSupplyCruLocation TraySlot `json:"powerSupplyCruLocation,omitzero"`
+	// This field is valid only when the eventComponentType value is equal to EVENT_COMP_TYPE_NVME_INITIATOR. It
+	// identifies the NVMe initiator to which the event applies.
+	NvmeInitiatorLocation InitiatorLocation `json:"nvmeInitiatorLocation,omitzero"`
+	// The label of the affected Remote Target Group.
+	RtgLabel string `json:"rtgLabel,omitempty"`
+	// The user name of the USM user.
+	UsmUserNameString string `json:"usmUserNameString,omitempty"`
+}
+
+// ComponentRelativeLocation is the ComponentRelativeLocation model.
+type ComponentRelativeLocation struct {
+	// The type of component. Values: unknown, drive, powerSply, fan, minihub, tempSensor, channel, esm, controller,
+	// battery, enclosure, ups, chip, volume, volumeGrp, portCru, interconnectCru, supportCru, alarm, channelPort,
+	// sfpPort, hostBoard, newFormat, ctlrSfp, ctlrSoc, initiator, target, isnsServer, hostIoCard,
+	// cacheBackupDevice, cacheMemDimm, host, hostPort, drawer, relative, schedule, asyncMirrorGroup, diskPool, pit,
+	// pitConsistencyGroup, cgpit, cgview, flashCache, snmpCommunity, snmpTrapDestination, fcTarget, blankOne,
+	// blankTwo, fanOnlyCru, psuCru, nvmeInitiator, remoteTargetGrp, snmpUsmUser.
+	ComponentType string `json:"componentType,omitempty"`
+	// The tray ID of the tray containing the component.
+	TrayID int `json:"trayId,omitempty"`
+	// The relative position within the component's parent.
+	RelativePosition int `json:"relativePosition,omitempty"`
+	// The size of the parent array that follows.
+	ParentSize int `json:"parentSize,omitempty"`
+	// This is an array containing additional parent-relative location information. The first element in the array
+	// will contain the parent's component type and its relative position within it's parent.
+	Parent []ParentRelativeLocation `json:"parent,omitempty"`
+	// Contains the label of the component.
+	ComponentLabel string `json:"componentLabel,omitempty"`
+}
+
+// ComponentRemovalData is the ComponentRemovalData model.
+type ComponentRemovalData struct {
+	// This enumeration defines the removal methods that apply to any component. Values: parent, self.
+	RemovalMethod string `json:"removalMethod,omitempty"`
+	// This field holds the component's ready-to-remove attributes. It is only present when the removalMethod is
+	// equal to COMP_RMV_METHOD_REMOVE_SELF.
+	RtrAttributes RTRAttributeData `json:"rtrAttributes,omitzero"`
+}
+
+// ConcatRepositoryVolume is the ConcatRepositoryVolume model.
+type ConcatRepositoryVolume struct {
+	// A reference (key) for ConcatVolume.
+	ConcatVolRef string `json:"concatVolRef,omitempty"`
+	// The status/state of the concatenated volume. This will be the worst status among the member volumes. Values:
+	// unknown, optimal, degraded, failed.
+	Status string `json:"status,omitempty"`
+	// The number of actual storage volumes comprising this volume. Note that this is just for convenience, this
+	// information can be derived from member objects.
+	MemberCount int `json:"memberCount,omitempty"`
+	// The aggregate capacity in bytes of all member volumes.
+	AggregateCapacity string `json:"aggregateCapacity,omitempty"`
+	// Media scan parameters.
+	MediaScanParams VolumeMediaScanParams `json:"mediaScanParams,omitzero"`
+	// The volume ssid. This is provided primarily for debug purposes.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// Operations allowed on the ConcatVolume. This can be used if ConcatVolume is ever exposed as a
+	// host-addressable volume to specify whether the volume is host-mappable or not (repository volumes would never
+	// be mappable).
+	AllowedOperations VolumePerms `json:"allowedOperations,omitzero"`
+	MemberRefs        []string    `json:"memberRefs,omitempty"`
+	// Values: unknown, volume, pool, host, lunMapping, hostGroup, thinVolume, drive, volumeCopy, pit, pitView,
+	// snapshotGroup, snapshot, accessVolume, legacySnapshot, hostType, metadataTag, managementUrl, folder,
+	// asyncMirrorGroup, asyncMirrorGroupMember, asyncMirrorGroupIncompleteMember, consistencyGroup,
+	// consistencyGroupView, fan, battery, storageSystem, controller, powerSupply, minihub, esm, drawer, hostBoard,
+	// interconnectCRU, cacheBackupDevice, tray, supportCRU, hostPort, initiator, snapshotSchedule, thermalSensor,
+	// sfp, flashCache, featureAttribute, featureState, lockKeyId, remoteVolume, mirrorVolume, vaultMirrorVolume,
+	// vaultMirrorGroup, metadataVolume, sasPort, sasExpander, channelPort, speedNegError, snmpAgentBundle,
+	// stagedFirmware, workload, ekmsCertRevocatSettings, databaseMetadata, directoryServicesDomain,
+	// kmsCertificateExpirationDetails, externalKMSEndpoint, notOnPreferredPath, remoteStorageVolume, remoteStorage,
+	// alarm.
+	BaseObjectType string `json:"baseObjectType,omitempty"`
+	BaseObjectID   string `json:"baseObjectId,omitempty"`
+	ID             string `json:"id,omitempty"`
+}
+
+// ConcatVolume is the ConcatVolume model.
+type ConcatVolume struct {
+	// A reference (key) for ConcatVolume.
+	ConcatVolRef string `json:"concatVolRef,omitempty"`
+	// The status/state of the concatenated volume. This will be the worst status among the member volumes. Values:
+	// unknown, optimal, degraded, failed.
+	Status string `json:"status,omitempty"`
+	// The number of actual storage volumes comprising this volume. Note that this is just for convenience, this
+	// information can be derived from member objects.
+	MemberCount int `json:"memberCount,omitempty"`
+	// The aggregate capacity in bytes of all member volumes.
+	AggregateCapacity string `json:"aggregateCapacity,omitempty"`
+	// Media scan parameters.
+	MediaScanParams VolumeMediaScanParams `json:"mediaScanParams,omitzero"`
+	// The volume ssid. This is provided primarily for debug purposes.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// Operations allowed on the ConcatVolume. This can be used if ConcatVolume is ever exposed as a
+	// host-addressable volume to specify whether the volume is host-mappable or not (repository volumes would never
+	// be mappable).
+	AllowedOperations VolumePerms `json:"allowedOperations,omitzero"`
+	ID                string      `json:"id,omitempty"`
+}
+
+// ConcatVolumeCandidate is the ConcatVolumeCandidate model.
+type ConcatVolumeCandidate struct {
+	// This enumeration is used to specify the Concatenated Volume candidate type. Values: unknown, newVol,
+	// existingVols, expansion.
+	CandType string `json:"candType,omitempty"`
+	// This field is present only if the ConcatVolumeCandidateType value is equal to
+	// CONCAT_VOL_CANDIDATE_TYPE_NEW_VOL.
+	NewVolCandidate ConcatVolumeNewVolumeCandidate `json:"newVolCandidate,omitzero"`
+	// This field is present only if the ConcatVolumeCandidateType value is equal to
+	// CONCAT_VOL_CANDIDATE_TYPE_EXISTING_VOLS.
+	ExistVolCandidate ConcatVolumeExistingVolumeCandidate `json:"existVolCandidate,omitzero"`
+	// This field is present only if the ConcatVolumeCandidateType value is equal to
+	// CONCAT_VOL_CANDIDATE_TYPE_EXPANSION.
+	ExpansionDescriptor ConcatVolumeDirectExpansionDescriptor `json:"expansionDescriptor,omitzero"`
+}
+
+// ConcatVolumeCandidateRequest is the ConcatVolumeCandidateRequest model.
+type ConcatVolumeCandidateRequest struct {
+	BaseVolumeRef string `json:"baseVolumeRef"`
+	// Percent capacity of the baseVolume to use for the repository.
+	PercentCapacity float64 `json:"percentCapacity,omitempty"`
+	// Define the type of object the repository will be utilized for. This will allow the capacity to be adjusted to
+	// meet required minimums. Values: snapshot, thinVolume, asyncMirror, other.
+	ConcatVolumeType string `json:"concatVolumeType,omitempty"`
+}
+
+// ConcatVolumeDefaultsResponse is the ConcatVolumeDefaultsResponse model.
+// The concat volume defaults.
+type ConcatVolumeDefaultsResponse struct {
+	// The capacity of a concat volume member that will be utilized by the associated concat volume will be the
+	// nearest multiple of this value that is less than or equal to the total concat volume member capacity.
+	ConcatVolumeMemberCapacityAlignmentSizeInBytes string `json:"concatVolumeMemberCapacityAlignmentSizeInBytes"`
+}
+
+// ConcatVolumeDirectExpansionDescriptor is the ConcatVolumeDirectExpansionDescriptor model.
+type ConcatVolumeDirectExpansionDescriptor struct {
+	// Additional capacity to add to the last member of the ConcatVolume.
+	AdditionalCapacity string `json:"additionalCapacity,omitempty"`
+}
+
+// ConcatVolumeExistingVolumeCandidate is the ConcatVolumeExistingVolumeCandidate model.
+type ConcatVolumeExistingVolumeCandidate struct {
+	// This enumeration is used to specify the Concatenated Volume Member reference type. Values: unknown, volref,
+	// label.
+	RefType string `json:"refType,omitempty"`
+	// A list of member volumes specified using volume references. This field is present only if the value of
+	// refType is equal to CONCAT_VOL_MEMBER_REF_TYPE_VOLREF.
+	MemberVolumeRef []string `json:"memberVolumeRef,omitempty"`
+	// A list of member volumes specified using volume labels. This field is present only if the value of refType is
+	// equal to CONCAT_VOL_MEMBER_REF_TYPE_LABEL.
+	MemberLabel []string `json:"memberLabel,omitempty"`
+}
+
+// ConcatVolumeExpansionRequest is the ConcatVolumeExpansionRequest model.
+// Expand the repository of a Snapshot Group, Snapshot Volume, AsyncMirror Member, or ThinVolume.
+type ConcatVolumeExpansionRequest struct {
+	// Identifier of the concatVolume to expand.
+	RepositoryRef string `json:"repositoryRef"`
+	// An expansion candidate to use for expanding the capacity of the repository.
+	ExpansionCandidate ConcatVolumeCandidate `json:"expansionCandidate"`
+}
+
+// ConcatVolumeMember is the ConcatVolumeMember model.
+type ConcatVolumeMember struct {
+	// A reference (key) for ConcatVolumeMember.
+	MemberRef string `json:"memberRef,omitempty"`
+	// A reference to the parent ConcatVolume.
+	ConcatVolRef string `json:"concatVolRef,omitempty"`
+	// A reference to the member RAID volume providing storage capacity.
+	StorageVolumeRef string `json:"storageVolumeRef,omitempty"`
+	// The index (order) of the member in the aggregated parent volume capacity.
+	ParentIndex int `json:"parentIndex,omitempty"`
+}
+
+// ConcatVolumeNewVolumeCandidate is the ConcatVolumeNewVolumeCandidate model.
+type ConcatVolumeNewVolumeCandidate struct {
+	// The label for the new member volume.
+	MemberVolumeLabel string `json:"memberVolumeLabel,omitempty"`
+	// An optional volume group label if creating a new volume group.
+	MemberVolumeGroupLabel string `json:"memberVolumeGroupLabel,omitempty"`
+	// The candidate to be used to create the member volume.
+	MemberCandidate VolumeCandidate `json:"memberCandidate,omitzero"`
+	// The capacity of the new volume to create, in bytes.
+	MemberCapacity string `json:"memberCapacity,omitempty"`
+}
+
+// ConfigDowngradeRequest is the ConfigDowngradeRequest model.
+type ConfigDowngradeRequest struct {
+	// Current administrator password
+	CurrentAdminPassword string `json:"currentAdminPassword"`
+	// Configuration file type to be downgraded Values: keystore, ldapConfig, arrayData.
+	ConfigType string `json:"configType,omitempty"`
+}
+
+// ConfigDowngradeResponse is the ConfigDowngradeResponse model.
+type ConfigDowngradeResponse struct {
+	// Configuration file type that was downgraded Values: keystore, ldapConfig, arrayData.
+	ConfigType string `json:"configType,omitempty"`
+	// Filename of the downgraded configuration file created in the working directory
+	OutputFilename string `json:"outputFilename,omitempty"`
+}
+
+// ConfigurationChangeEvent is the ConfigurationChangeEvent model.
+type ConfigurationChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType string `json:"eventType,omitempty"`
+}
+
+// ConfigurationDbValidationCheck is the ConfigurationDbValidationCheck model.
+type ConfigurationDbValidationCheck struct {
+	// Values: ok, notCompleted, failedDataRetrieval, failed.
+	Result string `json:"result"`
+	// Values: melEventCheck, cntrlSyncCheck, storageDeviceAccessible, spmDatabaseVerification, validPassword,
+	// configurationDatabaseCheck, objectGraphSyncCheck, nvsramMatches, volumeGroupsComplete,
+	// controllerStatusOptimal, hotSparesInUse, failedDrivesPresent, driveCheck, missingVolumes,
+	// exclusiveOperations, dbSubRecordsValidation, driveSideShipping, nvsramDisableCfwDownloads, needsAttention,
+	// integratedHealthCheck, altCommLinkFailure, ptlDisallowDownloads.
+	Type string `json:"type"`
+	// Values: unknown, low, medium, high, fatal.
+	SeverityLevel string `json:"severityLevel"`
+	// Results data to diagnose failures
+	ResultsData string `json:"resultsData"`
+	// Status of the database validation check Values: ok, noHeap, ioError, earlyExecution, fatalFsInvalid,
+	// fatalRootOutOfBounds, fatalDupBlockPointers, fatalDirOutOfBounds, fatalCorruptMetadata, fatalMissingMetadata,
+	// fatalRecOutOfBounds, fatalDupRecPointers, fatalRecordUnallocated, fatalOrphanBlock, fatalFsOffline, noDrives,
+	// lockError, fatalMirrorMismatch, mirrorNotReady.
+	Status     string `json:"status"`
+	Successful bool   `json:"successful,omitempty"`
+}
+
+// ConsistencyGroup is the ConsistencyGroup model.
+type ConsistencyGroup struct {
+	// The consistency group reference.
+	GroupRef string `json:"groupRef,omitempty"`
+}
+
+// ConsistencyGroupBundle is the ConsistencyGroupBundle model.
+type ConsistencyGroupBundle struct {
+	// Bundle of information about the write order consistency group objects.
+	ConsistencyGroup []ConsistencyGroup `json:"consistencyGroup,omitempty"`
+}
+
+// ConsistencyGroupCreateRequest is the ConsistencyGroupCreateRequest model.
+// Used to create a consistency group
+type ConsistencyGroupCreateRequest struct {
+	// The user-label to assign to the new consistency group
+	Name string `json:"name"`
+	// The full warning threshold percent
+	FullWarnThresholdPercent *int `json:"fullWarnThresholdPercent,omitempty"`
+	// The auto-delete threshold. Automatically delete snapshots after this many.
+	AutoDeleteThreshold *int `json:"autoDeleteThreshold,omitempty"`
+	// The repository full policy. Values: unknown, failbasewrites, purgepit.
+	RepositoryFullPolicy string `json:"repositoryFullPolicy,omitempty"`
+	// Roll-back priority Values: highest, high, medium, low, lowest.
+	RollbackPriority string `json:"rollbackPriority,omitempty"`
+}
+
+// ConsistencyGroupUpdateRequest is the ConsistencyGroupUpdateRequest model.
+// Updates a consistency group
+type ConsistencyGroupUpdateRequest struct {
+	// The user-label to assign to the new consistency group
+	Name string `json:"name,omitempty"`
+	// The full warning threshold percent
+	FullWarnThresholdPercent *int `json:"fullWarnThresholdPercent,omitempty"`
+	// The auto-delete threshold. Automatically delete snapshots after this many.
+	AutoDeleteThreshold *int `json:"autoDeleteThreshold,omitempty"`
+	// The repository full policy. Values: unknown, failbasewrites, purgepit.
+	RepositoryFullPolicy string `json:"repositoryFullPolicy,omitempty"`
+	// Roll-back priority Values: highest, high, medium, low, lowest.
+	RollbackPriority string `json:"rollbackPriority,omitempty"`
+}
+
+// ContainerServicesConfiguration is the ContainerServicesConfiguration model.
+type ContainerServicesConfiguration struct {
+	// True is this controller is capable of running container based applications.
+	ContainerServicesCapable bool `json:"containerServicesCapable,omitempty"`
+	// True if this controller is configured to run container based applications.
+	ContainerServicesEnabled bool `json:"containerServicesEnabled,omitempty"`
+}
+
+// Controller is the Controller model.
+type Controller struct {
+	// True if the controller is in active mode; false otherwise.
+	Active bool `json:"active,omitempty"`
+	// True if the controller is quiesced.
+	Quiesced bool `json:"quiesced,omitempty"`
+	// An indication of the current status of the controller. Values: unknown, optimal, failed, removed, rpaParErr,
+	// serviceMode, suspended, degraded.
+	Status string `json:"status,omitempty"`
+	// The identifier value for this controller. Other objects may use this reference value to refer to the
+	// controller.
+	ControllerRef string `json:"controllerRef,omitempty"`
+	// The physical location of the controller. The parent reference in Location identifies the tray that physically
+	// houses the controller, and the position field is the parent-relative/like-component relative slot number of
+	// the controller, starting at one.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// The variable-length ASCII text string that identifies the controller manufacturer.
+	Manufacturer string `json:"manufacturer,omitempty"`
+	// The date of manufacture of the controller, represented in seconds since midnight GMT on January 1, 1970.
+	ManufacturerDate string `json:"manufacturerDate,omitempty"`
+	// This field has been deprecated and should not be used.
+	AppVersion string `json:"appVersion,omitempty"`
+	// This field has been deprecated and should not be used.
+	BootVersion string `json:"bootVersion,omitempty"`
+	// The variable-length ASCII text string that identifies the product by name, as assigned by the manufacturer.
+	ProductID string `json:"productID,omitempty"`
+	// The product revision level.
+	ProductRevLevel string `json:"productRevLevel,omitempty"`
+	// The variable-length ASCII text string for the serial number of the controller.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// The variable-length ASCII text string that represents the board identifier (i.e. board type) of the
+	// controller.
+	BoardID string `json:"boardID,omitempty"`
+	// The memory size, in MB, that will be used by the controller as cache memory.
+	CacheMemorySize int `json:"cacheMemorySize,omitempty"`
+	// The size of the controller's CPU memory, in MB.
+	ProcessorMemorySize int `json:"processorMemorySize,omitempty"`
+	// This field has been deprecated and should not be used.
+	HostInterfaces []IOInterfaceTypeData `json:"hostInterfaces,omitempty"`
+	// This field has been deprecated and should not be used.
+	DriveInterfaces []IOInterfaceTypeData `json:"driveInterfaces,omitempty"`
+	// This field has been deprecated and should not be used.
+	NetInterfaces []NetInterfaceTypeData `json:"netInterfaces,omitempty"`
+	// This field has been deprecated and should not be used.
+	Inventory []InventoryItem `json:"inventory,omitempty"`
+	Reserved1 string          `json:"reserved1,omitempty"`
+	Reserved2 string          `json:"reserved2,omitempty"`
+	// This field has been deprecated and should not be used.
+	HostBoardID string `json:"hostBoardID,omitempty"`
+	// The physical cache memory size in MB
+	PhysicalCacheMemorySize int `json:"physicalCacheMemorySize,omitempty"`
+	// This field has been deprecated and should not be used.
+	ReadyToRemove bool `json:"readyToRemove,omitempty"`
+	// The board sub-model ID of the controller card
+	BoardSubmodelID string `json:"boardSubmodelID,omitempty"`
+	// Set to true if the sub-model feature is supported
+	SubmodelSupported bool `json:"submodelSupported,omitempty"`
+	// The OEM-specified part number of the controller canister
+	OemPartNumber string `json:"oemPartNumber,omitempty"`
+	// The part number of the controller board itself.
+	PartNumber string `json:"partNumber,omitempty"`
+	// This field has been deprecated and should not be used.
+	RtrAttributes RTRAttributes `json:"rtrAttributes,omitzero"`
+	// The timestamp value for the time at which the controller last booted. Note that this value may be different
+	// for the other controller in the storage array. This value is defined as the number of seconds since midnight
+	// GMT on January 1, 1970.
+	BootTime string `json:"bootTime,omitempty"`
+	// The model name associated with the controller. A model name is an identifier that is associated with each
+	// unique combination of base controller board and host interface card(s).
+	ModelName string `json:"modelName,omitempty"`
+	// A collection of network-related settings that apply to this controller.
+	NetworkSettings ControllerNetworkSettings `json:"networkSettings,omitzero"`
+	// The repair policy for the controller.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+	// The total flash cache memory size (in GB) currently in use on the controller.
+	FlashCacheMemorySize int `json:"flashCacheMemorySize,omitempty"`
+	// This structure contains information regarding the state of an IOC data dump -- if it needs to be retrieved,
+	// and the tag and timestamp of the dump.
+	CtrlIocDumpData CtrlIOCDumpData `json:"ctrlIocDumpData,omitzero"`
+	// Indicates that a locate operation for the device is currently active. The device will show a visual
+	// indication to aid an operator in locating the tray.
+	LocateInProgress bool `json:"locateInProgress,omitempty"`
+	// The device contains 7-segment indicators used to display the Tray Identity.
+	HasTrayIdentityIndicator bool `json:"hasTrayIdentityIndicator,omitempty"`
+	// Indicates the error mode in which the controller is operating. A value of 0 indicates the controller is not
+	// operating in an error mode. Values: notInErrorMode, unknown, t10PiServiceMode, t10PiLockdown.
+	ControllerErrorMode string `json:"controllerErrorMode,omitempty"`
+	// Version descriptors for loaded code modules
+	CodeVersions []VersionDescriptor `json:"codeVersions,omitempty"`
+	// Container services configuration information for this controller.
+	ContainerServicesConfiguration ContainerServicesConfiguration `json:"containerServicesConfiguration,omitzero"`
+	ID                             string                         `json:"id,omitempty"`
+}
+
+// ControllerDNSProperties is the ControllerDNSProperties model.
+type ControllerDNSProperties struct {
+	// DNS server acquisition properties
+	AcquisitionProperties DNSAcquisitionDescriptor `json:"acquisitionProperties,omitzero"`
+	// DNS servers provided by the DHCP responses. Will be non-empty only if at least one of the Controller's
+	// network ports is set to acquire its address via DHCP and the DHCP response included one or more DNS servers
+	// to use. The presence of these servers does not imply their use. These DNS servers will only be used if the
+	// acquisition type is set to DHCP.
+	DhcpAcquiredDNSServers []IPVxAddress `json:"dhcpAcquiredDnsServers,omitempty"`
+}
+
+// ControllerGBIC is the ControllerGBIC model.
+type ControllerGBIC struct {
+	// Interface (host/drive) to which the GBIC belongs. Values: hostside, driveside.
+	ControllerGBICType string `json:"controllerGBICType,omitempty"`
+	// Information on the controller associated with the GBIC.
+	ParentController string `json:"parentController,omitempty"`
+	// The channel number that this GBIC is associated with.
+	Channel   int    `json:"channel,omitempty"`
+	Reserved1 string `json:"reserved1,omitempty"`
+	Reserved2 string `json:"reserved2,omitempty"`
+}
+
+// ControllerNTPProperties is the ControllerNTPProperties model.
+type ControllerNTPProperties struct {
+	// NTP server acquisition properties
+	AcquisitionProperties NtpAcquisitionDescriptor `json:"acquisitionProperties,omitzero"`
+	// NTP servers provided by the DHCP responses. Will be non-empty only if at least one of the Controller's
+	// network ports is set to acquire its address via DHCP and the DHCP response included one or more NTP servers.
+	// The presence of these servers does not imply their use. These NTP servers will only be used if the NTP
+	// acquisition type is set to DHCP.
+	DhcpAcquiredNtpServers []IPVxAddress `json:"dhcpAcquiredNtpServers,omitempty"`
+}
+
+// ControllerNetworkSettings is the ControllerNetworkSettings model.
+type ControllerNetworkSettings struct {
+	// The IPV4 static default router address for the controller (sometimes referred to as the "gateway address").
+	Ipv4DefaultRouterAddress string `json:"ipv4DefaultRouterAddress,omitempty"`
+	// The IPV6 static default router address and associated data for the controller.
+	Ipv6DefaultRouterAddress IPV6AddressData `json:"ipv6DefaultRouterAddress,omitzero"`
+	// The set of IPV6 candidate default router addresses for the controller.
+	Ipv6CandidateDefaultRouterAddresses []IPV6AddressData `json:"ipv6CandidateDefaultRouterAddresses,omitempty"`
+	// True if enabled for remote access
+	RemoteAccessEnabled bool `json:"remoteAccessEnabled,omitempty"`
+	// DNS configuration information.
+	DNSProperties ControllerDNSProperties `json:"dnsProperties,omitzero"`
+	// NTP configuration information.
+	NtpProperties ControllerNTPProperties `json:"ntpProperties,omitzero"`
+	// If true, both an SSH key and a password are required for remote access. If false, remote login via password
+	// will be permitted, as well as remote login via any authorized keys. If 'remoteAccessEnabled' is false, this
+	// setting has no effect.
+	SshKeyAndPasswordRequired bool `json:"sshKeyAndPasswordRequired,omitempty"`
+}
+
+// ControllerNvsramRegionData is the ControllerNvsramRegionData model.
+// The NVSRAM region data.
+type ControllerNvsramRegionData struct {
+	// The NVSRAM region id. Values: allRegions, nonUserConfigData, subsystemId, subsystemFaultData, driveFaultData,
+	// hostManagedData, hostInterfaceData, userConfigData, bootpData, extUserConfigData, hostTypeDependentData,
+	// userConfig2Data.
+	RegionID string `json:"regionId"`
+	// The NVSRAM region data.
+	RegionData string `json:"regionData"`
+}
+
+// ControllerNvsramRegionDataRequest is the ControllerNvsramRegionDataRequest model.
+// The controller NVSRAM region data request.
+type ControllerNvsramRegionDataRequest struct {
+	// The optional desired NVSRAM region id. Values: allRegions, nonUserConfigData, subsystemId,
+	// subsystemFaultData, driveFaultData, hostManagedData, hostInterfaceData, userConfigData, bootpData,
+	// extUserConfigData, hostTypeDependentData, userConfig2Data.
+	NvsramRegionID string `json:"nvsramRegionId,omitempty"`
+}
+
+// ControllerNvsramRegionDataResponse is the ControllerNvsramRegionDataResponse model.
+// The controller NVSRAM region data response.
+type ControllerNvsramRegionDataResponse struct {
+	// The list of NVSRAM region data for the requested controller.
+	NvsramRegionData []ControllerNvsramRegionData `json:"nvsramRegionData"`
+}
+
+// ControllerSFP is the ControllerSFP model.
+type ControllerSFP struct {
+	// The interface (host/drive) to which the SFP belongs. Values: hostside, driveside.
+	ControllerSFPType string `json:"controllerSFPType,omitempty"`
+	// The controller to which the SFP belongs.
+	ParentController string `json:"parentController,omitempty"`
+	// The channel the SFP is associated with.
+	Channel   int    `json:"channel,omitempty"`
+	Reserved1 string `json:"reserved1,omitempty"`
+	Reserved2 string `json:"reserved2,omitempty"`
+}
+
+// ControllerSfpLocation is the ControllerSfpLocation model.
+type ControllerSfpLocation struct {
+	// The channel type. Values: hostside, driveside, management.
+	ChannelType string `json:"channelType,omitempty"`
+	// The channel type.
+	Channel int `json:"channel,omitempty"`
+	// The number of the SFP port on the channel, 1-based.
+	SfpPort int `json:"sfpPort,omitempty"`
+	// The slot number of the controller, 1 or 2
+	ControllerSlot int `json:"controllerSlot,omitempty"`
+}
+
+// ControllerStats is the ControllerStats model.
+// Controller I/O performance statistics.
+type ControllerStats struct {
+	// End time for this collection as measured by the number of seconds since baseTime.
+	ObservedTime      string `json:"observedTime"`
+	ObservedTimeInMS  string `json:"observedTimeInMS"`
+	LastResetTime     string `json:"lastResetTime"`
+	LastResetTimeInMS string `json:"lastResetTimeInMS"`
+	// Controller that generated this group.
+	ControllerID string `json:"controllerId"`
+	ArrayID      string `json:"arrayId"`
+	ArrayWWN     string `json:"arrayWwn"`
+	// CPU utilization statistics structure.
+	CPUUtilizationStats []CPUUtilizationStats `json:"cpuUtilizationStats"`
+	// Total number of IO operations serviced by the controller.
+	TotalIopsServiced float64 `json:"totalIopsServiced"`
+	// Total number of Bytes serviced by the controller.
+	TotalBytesServiced float64 `json:"totalBytesServiced"`
+	// Total number of IO operations that hit cache.
+	CacheHitsIopsTotal float64 `json:"cacheHitsIopsTotal"`
+	// Total number of bytes that hit cache.
+	CacheHitsBytesTotal float64 `json:"cacheHitsBytesTotal"`
+	// Total number of IOs that are categorized as random.
+	RandomIosTotal float64 `json:"randomIosTotal"`
+	// Total number of Bytes that are categorized as random.
+	RandomBytesTotal float64 `json:"randomBytesTotal"`
+	// Total number of Read IO operations.
+	ReadIopsTotal float64 `json:"readIopsTotal"`
+	// Total number of Bytes read.
+	ReadBytesTotal float64 `json:"readBytesTotal"`
+	// Total number of Write IO operations.
+	WriteIopsTotal float64 `json:"writeIopsTotal"`
+	// Total number of Bytes written.
+	WriteBytesTotal float64 `json:"writeBytesTotal"`
+	// Total number of IO operations serviced by the controller that are characterized as cache mirroring related.
+	// If cache mirroring is disabled, this counter will not have any value.
+	MirrorIopsTotal float64 `json:"mirrorIopsTotal"`
+	// Total number of Bytes serviced by the controller that are characterized as cache mirroring related. If cache
+	// mirroring is disabled, this counter will not have any value.
+	MirrorBytesTotal float64 `json:"mirrorBytesTotal"`
+	// Bytes written that are categorized as Full stripe writes.
+	FullStripeWritesBytes float64 `json:"fullStripeWritesBytes"`
+	// Bytes transferred that are categorized as RAID 0 transfers.
+	Raid0BytesTransferred float64 `json:"raid0BytesTransferred"`
+	// Bytes transferred that are categorized as RAID 1 transfers.
+	Raid1BytesTransferred float64 `json:"raid1BytesTransferred"`
+	// Bytes transferred that are categorized as RAID 5 transfers.
+	Raid5BytesTransferred float64 `json:"raid5BytesTransferred"`
+	// Bytes transferred that are categorized as RAID 6 transfers.
+	Raid6BytesTransferred float64 `json:"raid6BytesTransferred"`
+	// Bytes transferred that are categorized as DDP transfers.
+	DdpBytesTransferred float64 `json:"ddpBytesTransferred"`
+	// Theoretical maximum possible Bytes per second under current load.
+	MaxPossibleBpsUnderCurrentLoad float64 `json:"maxPossibleBpsUnderCurrentLoad"`
+	// Theoretical maximum possible IO per second under current load.
+	MaxPossibleIopsUnderCurrentLoad float64 `json:"maxPossibleIopsUnderCurrentLoad"`
+}
+
+// CouplingDriverNVMeInterface is the CouplingDriverNVMeInterface model.
+type CouplingDriverNVMeInterface struct {
+	// Interface ref
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// Interface channel
+	Channel int `json:"channel,omitempty"`
+	// Physical location, in this case internal.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// Is PI capable.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// One way max transfer rate
+	OneWayMaxRate string `json:"oneWayMaxRate,omitempty"`
+	// bi-directional max tranfer rate
+	BidirectionalMaxRate string `json:"bidirectionalMaxRate,omitempty"`
+}
+
+// CPUUtilizationStats is the CpuUtilizationStats model.
+type CPUUtilizationStats struct {
+	// Max utilization stats value from CPU.
+	MaxCPUUtilization int `json:"maxCpuUtilization,omitempty"`
+	// Sum of utilization stats values from CPU.
+	SumCPUUtilization int `json:"sumCpuUtilization,omitempty"`
+	// Sum of squares of utilization stats values from CPU.
+	SsqCPUUtilization int `json:"ssqCpuUtilization,omitempty"`
+}
+
+// CreateCGSnapshotViewManualRequest is the CreateCGSnapshotViewManualRequest model.
+type CreateCGSnapshotViewManualRequest struct {
+	Name string `json:"name"`
+	// The sequence number of the PITs to create Views for. All provided PITs/Snapshots must have a matching
+	// sequenceNumber
+	PitSequenceNumber string `json:"pitSequenceNumber"`
+	// A list of Snapshots to include in the view.
+	Requests []CGSnapshotViewRequest `json:"requests"`
+}
+
+// CreateConsistencyGroupSnapshotViewRequest is the CreateConsistencyGroupSnapshotViewRequest model.
+type CreateConsistencyGroupSnapshotViewRequest struct {
+	Name string `json:"name"`
+	// The reference to the pool that will be used for the repository creation.
+	RepositoryPoolID string `json:"repositoryPoolId,omitempty"`
+	// The id of the PIT to create a View for. Either the pitSequenceNumber or a pitId is required.
+	PitID string `json:"pitId,omitempty"`
+	// The sequence number of the PITs to create Views for. Either the pitSequenceNumber or a pitId is required.
+	PitSequenceNumber string `json:"pitSequenceNumber,omitempty"`
+	// The access mode determines whether or not a repository volume should be created. Values: modeUnknown,
+	// readWrite, readOnly.
+	AccessMode        string   `json:"accessMode,omitempty"`
+	RepositoryPercent *float64 `json:"repositoryPercent,omitempty"`
+	ScanMedia         *bool    `json:"scanMedia,omitempty"`
+	ValidateParity    *bool    `json:"validateParity,omitempty"`
+}
+
+// CriticalMelChangeEvent is the CriticalMelChangeEvent model.
+type CriticalMelChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType               string `json:"eventType"`
+	StorageSystemID         string `json:"storageSystemId"`
+	LastCriticalMEL         string `json:"lastCriticalMEL"`
+	CurrentCriticalMEL      string `json:"currentCriticalMEL"`
+	CurrentASUPEnabledState bool   `json:"currentASUPEnabledState"`
+	SystemWWN               string `json:"systemWWN"`
+}
+
+// CtrlIOCDumpData is the CtrlIOCDumpData model.
+type CtrlIOCDumpData struct {
+	// If true, an IOC Dump currently exists in controller memory and has not been retrieved.
+	IocDumpNeedsRetrieved bool `json:"iocDumpNeedsRetrieved,omitempty"`
+	// The tag value of the IOC Dump currently in controller memory. Each dump has a unique tag value. A value of
+	// IOC_DUMP_NULL_TAG indicates there is no IOC Dump to retrieve.
+	IocDumpTag int `json:"iocDumpTag,omitempty"`
+	// The time that the dump was collected measured in seconds since midnight GMT on January 1, 1970.
+	TimeStamp string `json:"timeStamp,omitempty"`
+}
+
+// CumulativeStatisticsDefaultsResponse is the CumulativeStatisticsDefaultsResponse model.
+// The statistics defaults.
+type CumulativeStatisticsDefaultsResponse struct {
+	// True if controller firmware supports the low and high resolution sampling, otherwise false.
+	LowHighSamplingSupported bool `json:"lowHighSamplingSupported"`
+	// The duration (in seconds) for low resolution statistics samples to be saved.
+	LowResolutionPeriodInSeconds int `json:"lowResolutionPeriodInSeconds"`
+	// The duration (in seconds) for high resolution statistics samples to be saved.
+	HighResolutionPeriodInSeconds int `json:"highResolutionPeriodInSeconds"`
+}
+
+// CurrentFirmwareResponse is the CurrentFirmwareResponse model.
+// Class that holds details on the Firmware on the array.
+type CurrentFirmwareResponse struct {
+	// Version descriptors for code modules
+	CodeVersions []VersionDescriptor `json:"codeVersions"`
+}
+
+// CurrentPowerInputDataResponse is the CurrentPowerInputDataResponse model.
+// The current power input and temperature data reported by the storage system
+type CurrentPowerInputDataResponse struct {
+	// The timestamp (in ISO-8601 format) representing the time the power input data collection was triggered.
+	CollectionTimestamp      string                          `json:"collectionTimestamp,omitempty"`
+	DriveTemperatureInfo     DriveTemperatureInformation     `json:"driveTemperatureInfo,omitzero"`
+	EnclosureTemperatureInfo EnclosureTemperatureInformation `json:"enclosureTemperatureInfo,omitzero"`
+	PowerInputInfo           PowerInputData                  `json:"powerInputInfo,omitzero"`
+}
+
+// DDPRAIDCapacity is the DDPRAIDCapacity model.
+type DDPRAIDCapacity struct {
+	// The supported DDPVolRAIDLevel Values: raidAll, raid6, raid1.
+	DdpVolRAIDLevel string `json:"ddpVolRAIDLevel,omitempty"`
+	// The usable capacity of the free extent based on the current DDPVolRAIDLevel. This is also the maximum
+	// capacity that the DDP volume with the current DDPVolRAIDLevel can be created on the free extent
+	UsableCapacity string `json:"usableCapacity,omitempty"`
+	// The DDPVolRAIDLevel specific allocation granularity (in bytes).
+	AllocGranularity string `json:"allocGranularity,omitempty"`
+}
+
+// DPLCoreDumpData is the DPLCoreDumpData model.
+type DPLCoreDumpData struct {
+	// The tag value of the DPL core dump currently in controller memory. Each core dump has a unique tag value. A
+	// value of DPL_CORE_DUMP_NULL_TAG indicates there is no DPL core dump to retrieve.
+	DplCoreDumpTag int `json:"dplCoreDumpTag,omitempty"`
+	// If true, a DPL core dump currently exists in controller memory and has not been retrieved.
+	DplCoreDumpNeedsRetrieved bool `json:"dplCoreDumpNeedsRetrieved,omitempty"`
+	// If true, DPL Core Dump is enabled in the controller.
+	DplCoreDumpEnabled bool `json:"dplCoreDumpEnabled,omitempty"`
+	// The time that the core dump was collected measured in seconds since midnight GMT on January 1, 1970.
+	TimeStamp string `json:"timeStamp,omitempty"`
+}
+
+// DVEVolume is the DVEVolume model.
+type DVEVolume struct {
+	// The reference to the volume.
+	Volume string `json:"volume,omitempty"`
+	// The amount of capacity increase for the volume.
+	AmountOfIncrease string `json:"amountOfIncrease,omitempty"`
+}
+
+// DailySchedule is the DailySchedule model.
+type DailySchedule struct {
+	// The number of seconds after midnight local time for the first occurrence during the day.
+	TimeOfDay int `json:"timeOfDay,omitempty"`
+	// Interval between schedules if timesPerDay is greater than 1.
+	EveryNMinutes int `json:"everyNMinutes,omitempty"`
+	// The number of occurrences during the day based on everyNMinutes, including the first occurrence.
+	TimesPerDay int `json:"timesPerDay,omitempty"`
+}
+
+// DataParityRepairVolumeAsyncResponse is the DataParityRepairVolumeAsyncResponse model.
+// The data parity repair volume response. Use the 'volumes/data-parity-repair-volume/jobs endpoint' to retrieve
+// volume parity job status.
+type DataParityRepairVolumeAsyncResponse struct {
+	// The data parity repair volume job id.
+	JobID string `json:"jobId,omitempty"`
+	// The volume's id.
+	VolumeID string `json:"volumeId,omitempty"`
+}
+
+// DataParityRepairVolumeJobResponse is the DataParityRepairVolumeJobResponse model.
+// The data parity repair volume response.
+type DataParityRepairVolumeJobResponse struct {
+	// The data parity repair volume job id.
+	JobID string `json:"jobId,omitempty"`
+	// The volume's id.
+	VolumeID string `json:"volumeId,omitempty"`
+	// The job's percentage complete.
+	PercentComplete int `json:"percentComplete,omitempty"`
+	// Values: notStarted, inProgress, complete, failed.
+	Status string `json:"status,omitempty"`
+}
+
+// DataParityRepairVolumeRequest is the DataParityRepairVolumeRequest model.
+// The data parity repair volume request. The starting and ending LBA fields are optional. If these fields are not
+// present in the request, the default will be to perform the operation on the entire volume.
+type DataParityRepairVolumeRequest struct {
+	// Optional. The starting volume LBA for the repair operation.
+	StartingLba string `json:"startingLba,omitempty"`
+	// Optional. The ending volume LBA for the repair operation.
+	EndingLba string `json:"endingLba,omitempty"`
+	// Optional. True to fix by reconstructing.
+	RepairByReconstruct *bool `json:"repairByReconstruct,omitempty"`
+	// Optional. True to fix using unmap. Note that this is only applicable when if there are more unmapped than
+	// mapped segments in a stripe.
+	RepairByUnmapping *bool `json:"repairByUnmapping,omitempty"`
+	// Optional. True to fix by updating P parity.
+	RepairByUpdatingPParity *bool `json:"repairByUpdatingPParity,omitempty"`
+	// Optional. True to fix by updating Q parity.
+	RepairByUpdatingQParity *bool `json:"repairByUpdatingQParity,omitempty"`
+	// Optional. True to fix by updating the data drive.
+	RepairByUpdatingData *bool `json:"repairByUpdatingData,omitempty"`
+	// Optional. True to fix by writing zeros and updating parity.
+	RepairByWritingZeros *bool `json:"repairByWritingZeros,omitempty"`
+	// Optional. True to correct issues with PI.
+	RepairPi *bool `json:"repairPi,omitempty"`
+	// Optional. The list of drives' unique identifiers to be reconstructed.
+	SuspectDrives []string `json:"suspectDrives,omitempty"`
+}
+
+// DaylightSavingsTime is the DaylightSavingsTime model.
+type DaylightSavingsTime struct {
+	// Time of day for DST change measured as seconds past midnight.
+	Time int `json:"time,omitempty"`
+	// Reference for time. Values: unknown, wallClockTime, standardTime, utcTime.
+	ClockMode string `json:"clockMode,omitempty"`
+	// Month value: 0 to 12 (and negatives).
+	Month int `json:"month,omitempty"`
+	// Day of month: 0 to 31 (and negatives).
+	DayOfMonth int `json:"dayOfMonth,omitempty"`
+	// Day of week: 0 to 7 (and negatives).
+	DayOfWeek int `json:"dayOfWeek,omitempty"`
+}
+
+// DbmBackupSpec is the DbmBackupSpec model.
+type DbmBackupSpec struct {
+	// The DBM source location. Values: controllerACache, controllerBCache, disk.
+	SourceLocation string `json:"sourceLocation"`
+}
+
+// DeviceAlertConfiguration is the DeviceAlertConfiguration model.
+// API object that shows the e-mail alert configuration for the storage system.
+type DeviceAlertConfiguration struct {
+	// If set to true and a recipient e-mail address has been specified, then e-mails will be sent to the recipient
+	// any time an 'alertable' event occurs on the storage system.
+	AlertingEnabled bool `json:"alertingEnabled"`
+	// The address for the server that will send the alert e-mails. The address can be specified as a DNS name, an
+	// IPv4 address or an IPv6 address. If a DNS name is provided, then the DNS confirmation must be properly
+	// configured on both controllers in the storage system.
+	EmailServerAddress string `json:"emailServerAddress"`
+	// The encryption to be used to communicate with the server. May be "none" (unencrypted), "smtps" (SSL/TLS
+	// encrypted connection), or "starttls" (connection upgraded to SSL/TLS encryption). Defaults to "none". Values:
+	// none, smtps, starttls.
+	EmailServerEncryption string `json:"emailServerEncryption,omitempty"`
+	// The port to be used to connect to the server. Default is encryption dependent: "none" defaults to 25, "smtps"
+	// defaults to 465, and "starttls" defaults to 587
+	EmailServerPort int `json:"emailServerPort,omitempty"`
+	// The user name to authenticate a user that is authorized to send e-mails through the e-mail server.
+	EmailServerUsername string `json:"emailServerUsername,omitempty"`
+	// The password to authenticate a user that is authorized to send e-mails through the e-mail server.
+	EmailServerPassword string `json:"emailServerPassword,omitempty"`
+	// The e-mail address that the alert e-mails will come from. This doesn't need to be a real e-mail address but
+	// it does have to be formatted as a valid e-mail address.
+	EmailSenderAddress string `json:"emailSenderAddress"`
+	// If set to true, the additional contact information provided will be included in all alert e-mails that get
+	// sent out.
+	SendAdditionalContactInformation bool `json:"sendAdditionalContactInformation"`
+	// Any additional contact information that should be included in all alert e-mails that get sent out. If
+	// sendAdditionalContactInformation is set to false, this value is ignored.
+	AdditionalContactInformation string `json:"additionalContactInformation,omitempty"`
+	// An array of e-mail addresses that the alert e-mails should be sent to.
+	RecipientEmailAddresses []string `json:"recipientEmailAddresses"`
+}
+
+// DeviceAlertTestResponse is the DeviceAlertTestResponse model.
+type DeviceAlertTestResponse struct {
+	// Alert email test result code Values: emailSentOK, invalidEmailServerConfigured,
+	// invalidEmailSenderAddressConfigured, invalidRecipientAddressConfigured, smtpConnectionError,
+	// smtpTransportError, smtpAuthenticationError, smtpSslError, smtpResponseError, smtpUnknownError.
+	Response string `json:"response"`
+	// Alert email error string
+	EmailSendErrorString string `json:"emailSendErrorString"`
+}
+
+// DeviceAsupDelivery is the DeviceAsupDelivery model.
+// Information required to describe an ASUP delivery device
+type DeviceAsupDelivery struct {
+	// ASUP delivery method Values: https, smtp.
+	Method string `json:"method"`
+	// The encryption to be used to communicate with the server. May be "none" (unencrypted), "smtps" (SSL/TLS
+	// encrypted connection), or "starttls" (connection upgraded to SSL/TLS encryption). Defaults to "none" if no
+	// encryption type has been set previously. Values: none, smtps, starttls.
+	EmailServerEncryption string `json:"emailServerEncryption,omitempty"`
+	// The port to be used to connect to the server. A value of 0 may be specified to use the default port based on
+	// the encryption type set. Default is encryption dependent: "none" defaults to 25, "smtps" defaults to 465, and
+	// "starttls" defaults to 587
+	EmailServerPort int `json:"emailServerPort,omitempty"`
+	// The user name to authenticate a user that is authorized to send e-mails through the e-mail server.
+	EmailServerUsername string `json:"emailServerUsername,omitempty"`
+	// The password to authenticate a user that is authorized to send e-mails through the e-mail server.
+	EmailServerPassword string `json:"emailServerPassword,omitempty"`
+	// ASUP routing type Values: none, direct, proxyServer, proxyScript.
+	RoutingType string `json:"routingType,omitempty"`
+	// ASUP proxy host
+	ProxyHost string `json:"proxyHost,omitempty"`
+	// ASUP proxy port
+	ProxyPort int `json:"proxyPort,omitempty"`
+	// ASUP proxy user name
+	ProxyUserName string `json:"proxyUserName,omitempty"`
+	// ASUP proxy password
+	ProxyPassword string `json:"proxyPassword,omitempty"`
+	// ASUP proxy PAC script
+	ProxyScript string `json:"proxyScript,omitempty"`
+	// ASUP e-mail relay server
+	MailRelayServer string `json:"mailRelayServer,omitempty"`
+	// ASUP e-mail sender address
+	MailSenderAddress string `json:"mailSenderAddress,omitempty"`
+	// List of destination address details
+	DestinationAddressList []MailAddressDetails `json:"destinationAddressList,omitempty"`
+	// The configured maximum HTTPS dispatch size in bytes. If the maximum size is not configured, the default is 50
+	// MiB. A value of 0 allows for no size restriction. Otherwise, the minimum size allowed is 2 MiB.
+	MaxSizeLimitHTTPS int `json:"maxSizeLimitHttps,omitempty"`
+	// The configured maximum SMTP dispatch size in bytes. If the maximum size is not configured, the default is 5
+	// MiB. A value of 0 allows for no size restriction. Otherwise, the minimum size allowed is 2 MiB.
+	MaxSizeLimitSmtp int `json:"maxSizeLimitSmtp,omitempty"`
+}
+
+// DeviceAsupDevice is the DeviceAsupDevice model.
+// API response object that includes ASUP information for a storage device.
+type DeviceAsupDevice struct {
+	// Device's world-wide-name
+	WWN string `json:"wwn"`
+	// Device's name
+	Name string `json:"name"`
+	// ASUP capable
+	AsupCapable bool `json:"asupCapable"`
+	// ASUP enabled
+	AsupEnabled bool `json:"asupEnabled"`
+	// ASUP OnDemand capable
+	OnDemandCapable bool `json:"onDemandCapable"`
+	// Device has ASUP daily/weekly schedule
+	Scheduled bool `json:"scheduled"`
+	// ASUP daily scheduled time
+	DailyScheduleTime int `json:"dailyScheduleTime"`
+	// ASUP weekly scheduled time
+	WeeklyScheduleTime int `json:"weeklyScheduleTime"`
+	// Day of the week Values: notSpecified, sunday, monday, tuesday, wednesday, thursday, friday, saturday.
+	WeeklyDayOfWeek string `json:"weeklyDayOfWeek"`
+	// Transport method Values: https, http, smtp.
+	Method string `json:"method"`
+	// Routing type Values: none, direct, proxyServer, proxyScript.
+	RoutingType string `json:"routingType"`
+}
+
+// DeviceAsupResponse is the DeviceAsupResponse model.
+// API response object that includes basic information about storage system ASUP.
+type DeviceAsupResponse struct {
+	// AutoSupport is supported
+	AsupCapable bool `json:"asupCapable"`
+	// AutoSupport OnDemand is supported
+	OnDemandCapable bool `json:"onDemandCapable"`
+	// AutoSupport is enabled or disabled
+	AsupEnabled bool `json:"asupEnabled"`
+	// ASUP OnDemand is enabled or disabled
+	OnDemandEnabled bool `json:"onDemandEnabled"`
+	// ASUP RemoteDiags is enabled or disabled
+	RemoteDiagsEnabled bool `json:"remoteDiagsEnabled"`
+	// ASUP delivery configuration
+	Delivery DeviceAsupDelivery `json:"delivery"`
+	// ASUP destination address
+	DestinationAddress string `json:"destinationAddress"`
+	// ASUP schedule configuration
+	Schedule DeviceAsupSchedule `json:"schedule"`
+}
+
+// DeviceAsupSchedule is the DeviceAsupSchedule model.
+type DeviceAsupSchedule struct {
+	// ASUP daily minimum time
+	DailyMinTime int `json:"dailyMinTime"`
+	// ASUP daily maximum time
+	DailyMaxTime int `json:"dailyMaxTime"`
+	// ASUP weekly minimum time
+	WeeklyMinTime int `json:"weeklyMinTime"`
+	// ASUP weekly maximum time
+	WeeklyMaxTime int `json:"weeklyMaxTime"`
+	// ASUP days of week
+	DaysOfWeek []string `json:"daysOfWeek"`
+}
+
+// DeviceAsupUpdateRequest is the DeviceAsupUpdateRequest model.
+// Used to update asup settings.
+type DeviceAsupUpdateRequest struct {
+	// AutoSupoprt enabled or disabled
+	AsupEnabled *bool `json:"asupEnabled,omitempty"`
+	// ASUP OnDemand is enabled or disabled
+	OnDemandEnabled *bool `json:"onDemandEnabled,omitempty"`
+	// ASUP RemoteDiags is enabled or disabled
+	RemoteDiagsEnabled *bool `json:"remoteDiagsEnabled,omitempty"`
+	// ASUP delivery configuration
+	Delivery DeviceAsupDelivery `json:"delivery,omitzero"`
+	// ASUP schedule configuration
+	Schedule DeviceAsupSchedule `json:"schedule,omitzero"`
+}
+
+// DeviceAsupVerifyRequest is the DeviceAsupVerifyRequest model.
+// Used to verify ASUP settings.
+type DeviceAsupVerifyRequest struct {
+	// ASUP delivery configuration
+	Delivery DeviceAsupDelivery `json:"delivery"`
+	// E-Mail reply address for SMTP
+	MailReplyAddress string `json:"mailReplyAddress,omitempty"`
+}
+
+// DeviceAsupVerifyResponse is the DeviceAsupVerifyResponse model.
+// Used to return results of ASUP settings verification.
+type DeviceAsupVerifyResponse struct {
+	// Indicates if verification was successful
+	Successful bool `json:"successful,omitempty"`
+	// Error message if verification failed
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	// Details about the error if verification failed. This is intended to be used for diagnosing errors and is not
+	// localized. Only populated if verification failed and there are additional details available.
+	ErrorDetails string `json:"errorDetails,omitempty"`
+	// Indicates if AOD verification was attempted
+	AodAttempted bool `json:"aodAttempted,omitempty"`
+	// Indicates if AOD verification was successful
+	AodSuccessful bool `json:"aodSuccessful,omitempty"`
+	// Error message if AOD verification failed
+	AodErrorMessage string `json:"aodErrorMessage,omitempty"`
+}
+
+// DeviceDataResponse is the DeviceDataResponse model.
+type DeviceDataResponse struct {
+	// The string representation of the data requested.
+	ResponseData string `json:"responseData,omitempty"`
+}
+
+// DiagnosticDataRequest is the DiagnosticDataRequest model.
+type DiagnosticDataRequest struct {
+	// Data to request Values: deviceProfile, firmwareInventory.
+	RequestType string `json:"requestType,omitempty"`
+}
+
+// DiagnosticRawDataRequest is the DiagnosticRawDataRequest model.
+type DiagnosticRawDataRequest struct {
+	// Controller to retrieve raw data Values: local, a, b.
+	Controller string `json:"controller,omitempty"`
+	// Raw data types to be retrieved
+	RawDataTypes []string `json:"rawDataTypes,omitempty"`
+}
+
+// DiagnosticRawDataStatus is the DiagnosticRawDataStatus model.
+type DiagnosticRawDataStatus struct {
+	// Indicates the raw data types being retrieved.
+	RawDataTypes []string `json:"rawDataTypes,omitempty"`
+	// Indicates the percentage of the data collected.
+	PercentComplete int `json:"percentComplete,omitempty"`
+	// Indicates if the diagnostic raw data retrieval is complete.
+	Complete bool `json:"complete,omitempty"`
+	// Indicates if the diagnostic raw data retrieval was cancelled.
+	Cancelled bool `json:"cancelled,omitempty"`
+}
+
+// DiscoverResponse is the DiscoverResponse model.
+// API response object that includes data for the current discover process
+type DiscoverResponse struct {
+	// Unique request id
+	RequestID string `json:"requestId"`
+	// The number of addresses checked
+	CurrentCount int `json:"currentCount"`
+	// total number of addresses to check
+	Total int `json:"total"`
+	// Time when the last discovery was started null if a process has never been started
+	LastStart string `json:"lastStart"`
+	// Indicates if a discovery process is running
+	DiscoverProcessRunning bool `json:"discoverProcessRunning"`
+	// List of storage systems discovered so far
+	StorageSystems []DiscoveredStorageSystem `json:"storageSystems"`
+}
+
+// DiscoveredController is the DiscoveredController model.
+// Information discovered for a storage system controller during a discover process
+type DiscoveredController struct {
+	// A unique identifier for this controller
+	ControllerID string `json:"controllerId"`
+	// The IP addresses that can be used to access this controller
+	IPAddresses []string `json:"ipAddresses"`
+	// SSL certificate status (applicable if this controller supports management via HTTP Values: trusted,
+	// selfSigned, expired, incompleteTrustChain, commonNameMismatch, unknown.
+	CertificateStatus string `json:"certificateStatus,omitempty"`
+}
+
+// DiscoveredStorageSystem is the DiscoveredStorageSystem model.
+// Information discovered for a storage system during a discover process
+type DiscoveredStorageSystem struct {
+	// Serial number for the chassis
+	SerialNumber string `json:"serialNumber"`
+	// WWN for the storage system
+	WWN string `json:"wwn"`
+	// Label for the storage system
+	Label string `json:"label"`
+	// Firmware version for the storage system
+	Firmware string `json:"firmware"`
+	// NVSRAM version for the storage system
+	Nvsram string `json:"nvsram"`
+	// Discovered controller IP addresses for the storage system
+	IPAddresses []string `json:"ipAddresses"`
+	// Needs attention alert for the storage system
+	NeedsAttention bool `json:"needsAttention"`
+	// Discovered controllers for the storage system
+	Controllers []DiscoveredController `json:"controllers,omitempty"`
+	// Management port types that are supported on this storage system
+	SupportedManagementPorts []string `json:"supportedManagementPorts,omitempty"`
+}
+
+// DiscoveryStartRequest is the DiscoveryStartRequest model.
+// Used to to start a storage system discover cycle
+type DiscoveryStartRequest struct {
+	// Starting IP address
+	StartIP string `json:"startIP"`
+	// Ending IP Address
+	EndIP string `json:"endIP"`
+	// In-band management agents will be queried if this value is true. If you are not sure, its best to leave this
+	// as default
+	UseAgents *bool `json:"useAgents,omitempty"`
+	// Optional parameter. This defaults to 30 seconds. Longer times may be needed for WAN discoveries, but will
+	// slow down the whole process. Shorter times speed up the process. This is only the timeout value for the
+	// initial TCP connection
+	ConnectionTimeout *int `json:"connectionTimeout,omitempty"`
+	// Set an explicit limit on the number of ports to use for discovery. A port is a file handle in most operating
+	// systems. It may be necessary to increase your ulimit values for open file handles. More ports available will
+	// significantly speed up the process, however, if available file handles are exceeded, then this operation will
+	// handle it gracefully, but other processes on the server may be affected as well as client connections.
+	MaxPortsToUse *int `json:"maxPortsToUse,omitempty"`
+}
+
+// DiskIOStats is the DiskIOStats model.
+// Drive I/O performance statistics. Note: the DELETE method clears all statistics for all drives.
+type DiskIOStats struct {
+	// End time for this collection as measured by the number of seconds since baseTime.
+	ObservedTime        string  `json:"observedTime"`
+	ObservedTimeInMS    string  `json:"observedTimeInMS"`
+	LastResetTime       string  `json:"lastResetTime"`
+	LastResetTimeInMS   string  `json:"lastResetTimeInMS"`
+	DiskID              string  `json:"diskId"`
+	SerialNumber        string  `json:"serialNumber"`
+	ArrayID             string  `json:"arrayId"`
+	ArrayWWN            string  `json:"arrayWWN"`
+	DriveWWN            string  `json:"driveWWN"`
+	VolGroupID          string  `json:"volGroupId"`
+	VolGroupName        string  `json:"volGroupName"`
+	VolGroupWWN         string  `json:"volGroupWWN"`
+	TrayID              string  `json:"trayId"`
+	Slot                int     `json:"slot"`
+	DiskManufacture     string  `json:"diskManufacture"`
+	DiskSoftwareVersion string  `json:"diskSoftwareVersion"`
+	IdleTime            float64 `json:"idleTime"`
+	OtherOps            float64 `json:"otherOps"`
+	OtherTimeMax        float64 `json:"otherTimeMax"`
+	OtherTimeTotal      float64 `json:"otherTimeTotal"`
+	OtherTimeTotalSq    float64 `json:"otherTimeTotalSq"`
+	ReadBytes           float64 `json:"readBytes"`
+	ReadOps             float64 `json:"readOps"`
+	ReadTimeMax         float64 `json:"readTimeMax"`
+	ReadTimeTotal       float64 `json:"readTimeTotal"`
+	ReadTimeTotalSq     float64 `json:"readTimeTotalSq"`
+	RecoveredErrors     float64 `json:"recoveredErrors"`
+	RetriedIos          float64 `json:"retriedIos"`
+	Timeouts            float64 `json:"timeouts"`
+	UnrecoveredErrors   float64 `json:"unrecoveredErrors"`
+	WriteBytes          float64 `json:"writeBytes"`
+	WriteOps            float64 `json:"writeOps"`
+	WriteTimeMax        float64 `json:"writeTimeMax"`
+	WriteTimeTotal      float64 `json:"writeTimeTotal"`
+	WriteTimeTotalSq    float64 `json:"writeTimeTotalSq"`
+	QueueDepthTotal     float64 `json:"queueDepthTotal"`
+	QueueDepthMax       float64 `json:"queueDepthMax"`
+	RandomIosTotal      float64 `json:"randomIosTotal"`
+	RandomBytesTotal    float64 `json:"randomBytesTotal"`
+	CumulativeLBA       float64 `json:"cumulativeLBA"`
+	CumulativeDeltaLBA  float64 `json:"cumulativeDeltaLBA"`
+}
+
+// DiskPoolData is the DiskPoolData model.
+type DiskPoolData struct {
+	// The number of drives reserved for reconstruction of failed drives.
+	ReconstructionReservedDriveCount int `json:"reconstructionReservedDriveCount,omitempty"`
+	// The pool capacity reserved to hold rebuild data (in bytes). This value is calculated from the
+	// reconstructionReservedDriveCount.
+	ReconstructionReservedAmt string `json:"reconstructionReservedAmt,omitempty"`
+	// The reserved capacity that is currently available to hold rebuilt data (dynamic). If all of the reserved
+	// capacity is available, then this value will equal the reconstructionReservedDriveCount.
+	ReconstructionReservedDriveCountCurrent int `json:"reconstructionReservedDriveCountCurrent,omitempty"`
+	// The pool utilization warning threshold as a percentage of the total user configurable capacity. A MEL event
+	// is generated when the configured capacity exceeds the specified level of the configurable capacity.
+	PoolUtilizationWarningThreshold int `json:"poolUtilizationWarningThreshold,omitempty"`
+	// The pool utilization critical threshold as a percentage of the total user configurable capacity. A MEL event
+	// is generated when the configured capacity exceeds the specified level of the configurable capacity.
+	PoolUtilizationCriticalThreshold int `json:"poolUtilizationCriticalThreshold,omitempty"`
+	// The Disk pool state set based on state of the threshold. Values: utilizationInvalid, utilizationOptimal,
+	// utilizationWarning, utilizationCritical, capacityDepleted.
+	PoolUtilizationState string `json:"poolUtilizationState,omitempty"`
+	// This value identifies how much capacity (in bytes) is being wasted due to a mix of drive sizes. This value
+	// will be zero as long as the pool consists of like drive sizes.
+	UnusableCapacity string `json:"unusableCapacity,omitempty"`
+	// One failed drive degraded reconstruction priority. Values: invalid, lowest, low, medium, high, highest,
+	// useDefault.
+	DegradedReconstructPriority string `json:"degradedReconstructPriority,omitempty"`
+	// Two failed drive reconstruction critical priority. Values: invalid, lowest, low, medium, high, highest,
+	// useDefault.
+	CriticalReconstructPriority string `json:"criticalReconstructPriority,omitempty"`
+	// Priority of other background operations (DVE,DCE,DCR, and IAF). Values: invalid, lowest, low, medium, high,
+	// highest, useDefault.
+	BackgroundOperationPriority string `json:"backgroundOperationPriority,omitempty"`
+	// The allocation granularity (in bytes) for volumes with default RAIDLevel in a disk pool.
+	AllocGranularity string `json:"allocGranularity,omitempty"`
+	// Minimum number of drives required for this disk pool
+	MinimumDriveCount int `json:"minimumDriveCount,omitempty"`
+	// Version of the disk pool. Indicates which internal pool policies and/or algorithms the pool is operating
+	// under.
+	PoolVersion int `json:"poolVersion,omitempty"`
+}
+
+// DiskPoolDefaultsResponse is the DiskPoolDefaultsResponse model.
+// The storage pool defaults.
+type DiskPoolDefaultsResponse struct {
+	// The pool utilization warning threshold as a percentage of the total user configurable capacity. A MEL event
+	// is generated when the configured capacity exceeds the specified level of the configurable capacity.
+	PoolUtilizationWarnThreshold int `json:"poolUtilizationWarnThreshold"`
+	// The pool utilization critical threshold as a percentage of the total user configurable capacity. A MEL event
+	// is generated when the configured capacity exceeds the specified level of the configurable capacity.
+	PoolUtilizationCriticalThreshold int `json:"poolUtilizationCriticalThreshold"`
+	// One failed drive degraded reconstruction priority. Values: invalid, lowest, low, medium, high, highest,
+	// useDefault.
+	DegradedReconstructPriority string `json:"degradedReconstructPriority"`
+	// Two failed drive reconstruction critical priority. Values: invalid, lowest, low, medium, high, highest,
+	// useDefault.
+	CriticalReconstructPriority string `json:"criticalReconstructPriority"`
+	// The priority of other background operations (DVE, DCE, DCR, and IAF). Values: invalid, lowest, low, medium,
+	// high, highest, useDefault.
+	BackgroundOperationPriority string `json:"backgroundOperationPriority"`
+	// The maximum volume size (in megabytes) for disk pools.
+	MaxVolumeSizeInMb string `json:"maxVolumeSizeInMb"`
+	// The maximum number of drives that can be used for Dynamic Capacity Expansion (DCE).
+	MaxDceDriveCount int `json:"maxDceDriveCount"`
+	// The maximum total size for all disk pools.
+	MaxTotalDiskPoolSupportInMb string `json:"maxTotalDiskPoolSupportInMb"`
+	// Volumes with a capacity with this size or smaller are created synchronously. Larger volumes are completed as
+	// a background operation.
+	MaxVolumeSynchronousCreationInMb string `json:"maxVolumeSynchronousCreationInMb"`
+	// The maximum number of drives that can be removed in a Disk Capacity Reduction (DCR) operation.
+	MaxDcrDriveCount int `json:"maxDcrDriveCount"`
+	// The minimum number of drives required for defining a disk pool with HDD drives. This also defines the minimum
+	// number of drives required to create RAID 6 volumes regardless of media type.
+	MinimumDriveCount int `json:"minimumDriveCount"`
+	// The minimum SSD drive count required for defining a disk pool.
+	MinimumSsdDriveCount int `json:"minimumSsdDriveCount"`
+}
+
+// DiskPoolPriorityUpdateRequest is the DiskPoolPriorityUpdateRequest model.
+type DiskPoolPriorityUpdateRequest struct {
+	// Pool operation priority Values: invalid, lowest, low, medium, high, highest, useDefault.
+	Priority string `json:"priority"`
+	// The priority type Values: invalid, degraded, critical, background.
+	PriorityType string `json:"priorityType"`
+}
+
+// DiskPoolReductionRequest is the DiskPoolReductionRequest model.
+// Reduce the number of drives in a VolumeGroup.
+type DiskPoolReductionRequest struct {
+	// Id list of drives to remove.
+	Drives []string `json:"drives"`
+}
+
+// DiskPoolThresholdUpdateRequest is the DiskPoolThresholdUpdateRequest model.
+type DiskPoolThresholdUpdateRequest struct {
+	// The threshold type to configure Values: invalid, warning, critical.
+	ThresholdType string `json:"thresholdType"`
+	// New threshold setting (0-100). 0 to disable the threshold.
+	Value int `json:"value"`
+}
+
+// DiskPoolVolumeCandidateData is the DiskPoolVolumeCandidateData model.
+type DiskPoolVolumeCandidateData struct {
+	// The number of drives reserved for reconstruction of failed drives.
+	ReconstructionReservedDriveCount int `json:"reconstructionReservedDriveCount,omitempty"`
+	// The pool capacity reserved to hold rebuild data (in bytes). This value is calculated from the
+	// reconstructionReservedDriveCount.
+	ReconstructionReservedAmt string `json:"reconstructionReservedAmt,omitempty"`
+	// This value identifies how much capacity (in bytes) is being wasted due to a mix of drive sizes. This value
+	// will be zero as long as the pool consists of like drive sizes.
+	UnusableCapacity string `json:"unusableCapacity,omitempty"`
+}
+
+// DNSAcquisitionDescriptor is the DnsAcquisitionDescriptor model.
+type DNSAcquisitionDescriptor struct {
+	// How the DNS addresses were acquired. Values: unknown, stat, dhcp.
+	DNSAcquisitionType string `json:"dnsAcquisitionType,omitempty"`
+	// This field is used if the method is set to DNS_ACQUISITION_METHOD_STATIC. If more than one address is
+	// specified, the first (lowest index) item is given highest precedence and the last item (highest index) is
+	// given the lowest precedence.
+	DNSServers []IPVxAddress `json:"dnsServers,omitempty"`
+}
+
+// Drawer is the Drawer model.
+type Drawer struct {
+	// The component reference associated with the drawer.
+	DrawerRef string `json:"drawerRef,omitempty"`
+	// This value is set to true when the drawer is open; otherwise it is set to false.
+	IsOpen bool `json:"isOpen,omitempty"`
+	// This field contains the drawer status. Values: unknown, optimal, failed, opened, removed, degraded, invalid.
+	Status string `json:"status,omitempty"`
+	// This field contains the drawer location. This will be a tray reference to the tray containing the drawer.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// This field contains the repair policy for the drawer component.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+	// This data is a variable length ASCII text string. It contains the firmware version for the DCM (Drawer
+	// Control and Monitor) chip on the drawer component.
+	SoftwareVersion string `json:"softwareVersion,omitempty"`
+	// This data is a variable length ASCII text string. It contains the DCM (Drawer Control and Monitor) chip part
+	// number on the drawer component. This part number is from VPD data.
+	PartNumber string `json:"partNumber,omitempty"`
+	// This data is a variable length ASCII text string. It contains the DCM (Drawer Control and Monitor) chip
+	// serial number on the drawer component. This serial number is from VPD data.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// This data is a variable length ASCII text string. It contains the DCM (Drawer Control and Monitor) chip
+	// manufacturer name, obtained from VPD data.
+	VendorName string `json:"vendorName,omitempty"`
+	// This data is a variable length ASCII text string. It contains the DCM (Drawer Control and Monitor)
+	// manufacture date, obtained from VPD data.
+	ManufactureDate string `json:"manufactureDate,omitempty"`
+	// This data is a variable length ASCII text string. It contains the Field Replaceable Unit (FRU) type, obtained
+	// from VPD data.
+	FruType string `json:"fruType,omitempty"`
+	// This data is a variable length ASCII text string describing the type of drawer.
+	DrawerType string `json:"drawerType,omitempty"`
+	ID         string `json:"id,omitempty"`
+}
+
+// Drive is the Drive model.
+type Drive struct {
+	// True if the drive is in the offline state, false otherwise. This field is valid for missing drives.
+	Offline bool `json:"offline,omitempty"`
+	// True if the drive has been designated as a hot spare; false otherwise. This field is valid for missing
+	// drives.
+	HotSpare bool `json:"hotSpare,omitempty"`
+	// True if no drive information (serial #, capacity, etc.) can be obtained. This field is always true for
+	// missing drives.
+	InvalidDriveData bool `json:"invalidDriveData,omitempty"`
+	// True if the drive is available for use in creating a new volume group; false otherwise. This field is valid
+	// for missing drives.
+	Available bool `json:"available,omitempty"`
+	// True if the drive is drive Predicted Failure analysis predicts a drive failure. This field is valid for
+	// missing drives.
+	Pfa bool `json:"pfa,omitempty"`
+	// The identifier value for this drive. Other objects may use this reference value to refer to the drive. This
+	// field is valid for missing drives.
+	DriveRef string `json:"driveRef,omitempty"`
+	// An indication of the current status of the drive. This field is valid for missing drives. Values: optimal,
+	// failed, replaced, bypassed, unresponsive, removed, incompatible, dataRelocation, preFailCopy,
+	// preFailCopyPending, sanitizing.
+	Status string `json:"status,omitempty"`
+	// An indication of the reason for the current status of the drive. This field is most useful when the current
+	// status is something other than optimal; it identifies the event that caused the status to change to its
+	// current value. This field is valid for missing drives. Values: none, userOp, writeOp, uncertified,
+	// speedMismatch, bypassUnknown, wrongDriveType, incompatibleUprevDacstore, incompatibleDownrevDacstore,
+	// incompatibleInternalError, incompatibleSectorSizeMismatch, incompatibleNativeVgRefersToForeignDrive,
+	// incompatibleForeignVgRefersToNativeDrive, incompatibleNativeVgForeignDriveMutualRef, incompatibleVgCloned,
+	// incompatibleForeignDriveInconsistent, incompatibleVgHasDrivePartOfMultipleVgs,
+	// incompatibleForeignDriveHasInconsistentRole, incompatibleVgDrivePartOfMultipleVgs,
+	// incompatibleFailedLegacyDrive, incompatibleDdfOtherVendor, incompatibleDbAdoptionFailure, locked,
+	// insufficientDriveCapacity, supercapacitorFailure, unsupportedProtocolConnection, unsupportedCapacity,
+	// invalidLockKeyPresented, incompatiblePiType, incompatibleDatabaseValidationFailure,
+	// incompatibleUnsupportedInterposerFwVersion, incompatibleSataDriveSctUnsupported,
+	// incompatibleModelNumberUnsupported, incompatibleAlignmentForEmulationDrive, srcRelocation, dstRelocation,
+	// incompatibleDriveMetadataUnusable, pfa, spfa, incompatibleFastFormatNeeded, incompatibleFastFormatFailed,
+	// incompatibleUserAssignmentFailed, sanitizeFailed, incompatibleSanitized, incompatibleSanitizing,
+	// pendingFwVerification, flashTechUnsupported.
+	Cause string `json:"cause,omitempty"`
+	// Information about the I/O interface between the controller and the drive. For example, for a SCSI-attached
+	// drive, this field will contain information about the drive's channel and SCSI ID values. For other interface
+	// types, different data will be available in this field.
+	InterfaceType DriveTypeData `json:"interfaceType,omitzero"`
+	// The physical location of the drive. Beginning with the Allegheny release, the parent reference in Location
+	// identifies the tray or drawer containing the drive, and the position field is the
+	// parent-relative/like-component relative slot number of the drive, starting at 1. For missing drives this
+	// field contains the last known location.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// The variable-length ASCII text string that identifies the drive manufacturer. Note that this information is
+	// not returned for most Serial ATA (SATA) drives.
+	Manufacturer string `json:"manufacturer,omitempty"`
+	// The date of manufacture of the drive, represented in seconds since midnight GMT on January 1, 1970. Note that
+	// this information is not returned for most Serial ATA (SATA) drives.
+	ManufacturerDate string `json:"manufacturerDate,omitempty"`
+	// The variable-length ASCII text string that identifies the product by name, as assigned by the manufacturer.
+	ProductID string `json:"productID,omitempty"`
+	// The variable-length ASCII text string for the serial number of the drive.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// This data is a variable-length ASCII text string. If an interposer is present in front of SATA drives, the
+	// string identifies the version of the interposer package. In this case, the SATA drive firmware version can be
+	// found in the physicalDriveTypeData field. If an interposer is not present (always the case for Fibre Channel
+	// drives) this field describes the drive firmware version.
+	SoftwareVersion string `json:"softwareVersion,omitempty"`
+	// The block (i.e. sector) size used by the drive, represented in bytes. This field is valid for missing drives.
+	BlkSize int `json:"blkSize,omitempty"`
+	// The usable capacity of the drive, in bytes, after accounting for any space that is reserved for use by the
+	// array controller for overhead information. This field is valid for missing drives.
+	UsableCapacity string `json:"usableCapacity,omitempty"`
+	// The raw capacity of the drive, in bytes. This field is valid for missing drives.
+	RawCapacity string `json:"rawCapacity,omitempty"`
+	// A variable-length, opaque field that contains the drive's world-wide name, or globally unique identifier
+	// value. This field is valid for missing drives.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The reference value of the volume group, disk pool, or flash cache to which this drive is currently assigned.
+	// If a hot spare drive is actively sparing for a failed drive, this field will identify the volume group, disk
+	// pool, or flash cache that is using the hot spare drive. If the drive is not currently assigned, or is an idle
+	// hot spare, the reference value will be null. This field is valid for missing drives.
+	CurrentVolumeGroupRef string `json:"currentVolumeGroupRef,omitempty"`
+	// The reference value of the drive for which this drive is acting as a hot spare. This field is only used if
+	// the Drive object represents a global hot spare that is actively sparing for a failed drive. This field is
+	// valid for missing drives.
+	SparedForDriveRef string `json:"sparedForDriveRef,omitempty"`
+	// This field is only used if the Drive object represents a drive that is part of a RAID-1 volume group. It
+	// identifies the drive that is tied to this one for RAID-1 mirroring operations. This field is valid for
+	// missing drives.
+	MirrorDrive string `json:"mirrorDrive,omitempty"`
+	// True if the drive does not have redundant access.
+	NonRedundantAccess bool `json:"nonRedundantAccess,omitempty"`
+	// The channel to the tray that is still operational (if nonRedundantAccess is True).
+	WorkingChannel int `json:"workingChannel,omitempty"`
+	// The volume group index. This is the zero-relative position of the drive with respect to the stripe-ordering
+	// for the volume group. A value of -1 indicates that the drive does not belong to any group. This field is
+	// valid for missing drives.
+	VolumeGroupIndex int `json:"volumeGroupIndex,omitempty"`
+	// The speed of the drive. Will be unknown for a SCSI drive. Values: speedUnknown, speed1gig, speed2gig,
+	// speed4gig, speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig,
+	// speed30gig, speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig,
+	// speed32gig, speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	CurrentSpeed string `json:"currentSpeed,omitempty"`
+	// The maximum speed of the drive. Currently not used. Values: speedUnknown, speed1gig, speed2gig, speed4gig,
+	// speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig,
+	// speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig,
+	// speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	MaxSpeed string `json:"maxSpeed,omitempty"`
+	// True, if the drive has the DR_UNCERTIFIED flag set. This field is valid for missing drives.
+	Uncertified bool `json:"uncertified,omitempty"`
+	// True, if a path to this drive is degraded.
+	HasDegradedChannel bool `json:"hasDegradedChannel,omitempty"`
+	// The IDs of degraded channels.
+	DegradedChannels []int `json:"degradedChannels,omitempty"`
+	// The physical drive type. Values: all, scsi, fibre, sata, pata, fibre520b, sas, unknown, sas4k, nvme4k.
+	PhyDriveType string `json:"phyDriveType,omitempty"`
+	// The drive reported spindle speed. Solid State Disk (SSD) drives report zero.
+	SpindleSpeed int `json:"spindleSpeed,omitempty"`
+	// This field has been deprecated and should not be used.
+	RtrAttributes RTRAttributes `json:"rtrAttributes,omitzero"`
+	// reserved.
+	Reserved string `json:"reserved,omitempty"`
+	// Information specific to a physical drive type.
+	PhyDriveTypeData PhysicalDriveTypeData `json:"phyDriveTypeData,omitzero"`
+	// The reason a PFA condition has been raised for this drive. Values: unknown, none, driveReported, synthesized,
+	// ssdEndOfLife, dataLoss.
+	PfaReason string `json:"pfaReason,omitempty"`
+	// A list of object references where each reference identifies the source of a drive bypass condition. Possible
+	// referenced objects include the drive itself, a controller, or the ESM(s). When the bypass source is a
+	// controller or the drive, the list will typically hold just one reference; when the bypass source is the
+	// ESM(s), the list can hold up to two references, indicating either one or both ESMs. The list normally holds
+	// valid data whenever the drive is in the bypassed state (DRIVE_STAT_BYPASSED) , however it may be empty if the
+	// source of the bypass is unknown. It is also possible for the list to hold valid data when the drive is
+	// optimal, if only one ESM has bypassed the drive.
+	BypassSource []ObjectReference `json:"bypassSource,omitempty"`
+	// This field contains the repair policy for the Drive.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+	// True if the drive supports FDE, false otherwise
+	FdeCapable bool `json:"fdeCapable,omitempty"`
+	// true if drive security is enabled; false if disabled
+	FdeEnabled bool `json:"fdeEnabled,omitempty"`
+	// True if the drive is locked, false if the drive is unlocked
+	FdeLocked bool `json:"fdeLocked,omitempty"`
+	// The lock key ID as read from the drive.
+	LockKeyID string `json:"lockKeyID,omitempty"`
+	// Contains wear life data for the SSD drive.
+	SsdWearLife SSDWearLife `json:"ssdWearLife,omitzero"`
+	// This field is set to the drive media type of the drive. Values: all, unknown, hdd, ssd.
+	DriveMediaType string `json:"driveMediaType,omitempty"`
+	// This data is a variable-length ASCII text string that identifies the version of the FPGA package.
+	FpgaVersion string `json:"fpgaVersion,omitempty"`
+	// This structure contains a protectionInformationCapable field which is set to true when the drive is formatted
+	// to support type 2 protection with a 512-byte logical block size, or if the drive is a type 0 drive formatted
+	// with a 520-byte logical block size. It also contains a field that is set to the protection type provided for
+	// the drive.
+	ProtectionInformationCapabilities ProtectionInformationCapabilities `json:"protectionInformationCapabilities,omitzero"`
+	// This field has been deprecated and should not be used.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// This field has been deprecated and should not be used. Values: type0Protection, type1Protection,
+	// type2Protection, type3Protection.
+	ProtectionType string `json:"protectionType,omitempty"`
+	// A value of true for this property means the drive is behind an interposer.
+	InterposerPresent bool `json:"interposerPresent,omitempty"`
+	// The drive interposer identifier. This field is valid only if the interposerPresent field is set to True.
+	InterposerRef string `json:"interposerRef,omitempty"`
+	// The current command aging timeout value in hundreds of milliseconds. If this value is 0 the default command
+	// aging timeout value will be used, otherwise this value overrides the default value.
+	CurrentCommandAgingTimeout int `json:"currentCommandAgingTimeout,omitempty"`
+	// The default command aging timeout value in hundreds of milliseconds as specified by the drive. If this value
+	// is 0, the drive command aging timeout cannot be changed.
+	DefaultCommandAgingTimeout int `json:"defaultCommandAgingTimeout,omitempty"`
+	// This field has been deprecated and should not be used.
+	DriveTemperature DriveTemperature `json:"driveTemperature,omitzero"`
+	// The physical block (i.e., sector) size for the drive, represented in bytes. This field is valid for missing
+	// drives.
+	BlkSizePhysical int `json:"blkSizePhysical,omitempty"`
+	// The lowest aligned LBA for the physical sector. Typically this will be 0, but could be non-zero for emulation
+	// drives where LBA 0 starts at some logical sector offset within the physical sector.
+	LowestAlignedLBA string `json:"lowestAlignedLBA,omitempty"`
+	// True if the drive is in the process of being removed from the volume group. Only applies to Disk Pools
+	Removed bool `json:"removed,omitempty"`
+	// Indicates that a locate operation for the device is currently active. The device will show a visual
+	// indication to aid an operator in locating the drive.
+	LocateInProgress bool `json:"locateInProgress,omitempty"`
+	// This flag is true if the drive supports FIPS (Federal Information Processing Standard). FIPS 140-2
+	// specification.
+	FipsCapable bool `json:"fipsCapable,omitempty"`
+	// An indication as to whether the drive supports DULBE
+	DulbeCapable bool `json:"dulbeCapable,omitempty"`
+	// An indication as to whether the drive supports Sanitize operations.
+	SanitizeCapable bool `json:"sanitizeCapable,omitempty"`
+	// This enumeration distinguishes between different security capabilities of a drive. Values: notCapable,
+	// capableNonFips, capableFips1402, capableFips1403, capableFipsUnknown.
+	DriveSecurityType string `json:"driveSecurityType,omitempty"`
+	ID                string `json:"id,omitempty"`
+}
+
+// DriveChannel is the DriveChannel model.
+type DriveChannel struct {
+	// The channel number.
+	Channel int `json:"channel,omitempty"`
+	// The operational state of the channel. Values: optimal, degraded, hwFailed.
+	State string `json:"state,omitempty"`
+	// The reason the channel was degraded. Values: none, thresholdExceeded, adminRequest, socNotOperational,
+	// socEepromFailure, clearHwFailure, controllerAction.
+	Reason string `json:"reason,omitempty"`
+	// If auto degraded, the degraded controller.
+	DegradingController string `json:"degradingController,omitempty"`
+	// The statistics for Controller A.
+	StatsControllerA DriveChannelStatistics `json:"statsControllerA,omitzero"`
+	// The statistics for Controller B.
+	StatsControllerB DriveChannelStatistics `json:"statsControllerB,omitzero"`
+}
+
+// DriveChannelErrorCounts is the DriveChannelErrorCounts model.
+type DriveChannelErrorCounts struct {
+	// The number of non channel errors.
+	NonChannelErrors int `json:"nonChannelErrors,omitempty"`
+	// The number of timeout errors.
+	TimeoutErrors int `json:"timeoutErrors,omitempty"`
+	// The number of controller detected errors.
+	ControllerDetectedErrors int `json:"controllerDetectedErrors,omitempty"`
+	// The number of drive detected errors.
+	DriveDetectedErrors int `json:"driveDetectedErrors,omitempty"`
+	// The number of link down errors.
+	LinkDownErrors int `json:"linkDownErrors,omitempty"`
+}
+
+// DriveChannelParent is the DriveChannelParent model.
+type DriveChannelParent struct {
+	// This enumeration object is used to specify the Drive Channel parent. Values: parentUnknown, minihub,
+	// controller.
+	DriveChannelParent string `json:"driveChannelParent,omitempty"`
+	// This field is present only if the DriveChannelParentType is CONTROLLER.
+	ControllerRef string `json:"controllerRef,omitempty"`
+	// This field is present only if the DriveChanelParentType is MINIHUB.
+	MinihubRef string `json:"minihubRef,omitempty"`
+}
+
+// DriveChannelPort is the DriveChannelPort model.
+type DriveChannelPort struct {
+	// A reference to the drive channel port.
+	PortRef string `json:"portRef,omitempty"`
+	// The Fibre Channel number of the port.
+	Channel int `json:"channel,omitempty"`
+	// The type of port (XBB/expansion/internal). Values: portUnknown, port1, port2, port3, port4, port12, port34,
+	// port7, minihubInout, expansionOnly, expansionAndInternal.
+	PortType string `json:"portType,omitempty"`
+	// The parent type (minihub/controller).
+	ParentType DriveChannelParent `json:"parentType,omitzero"`
+}
+
+// DriveChannelStatistics is the DriveChannelStatistics model.
+type DriveChannelStatistics struct {
+	// The time stamp of when the error tracking began.
+	StartTimeStamp string `json:"startTimeStamp,omitempty"`
+	// The time stamp of when the error tracking ended.
+	EndTimeStamp string `json:"endTimeStamp,omitempty"`
+	// The total number of I/Os.
+	TotalIoCount int `json:"totalIoCount,omitempty"`
+	// The total number of I/O errors.
+	TotalIoErrorCount int `json:"totalIoErrorCount,omitempty"`
+	// The status of the link - up, down, failed Values: none, up, down, failed.
+	LinkStatus string `json:"linkStatus,omitempty"`
+	// The error category counts.
+	ErrorCounts DriveChannelErrorCounts `json:"errorCounts,omitzero"`
+}
+
+// DriveDownloadDriveStatus is the DriveDownloadDriveStatus model.
+type DriveDownloadDriveStatus struct {
+	// The drive reference.
+	DriveRef string `json:"driveRef,omitempty"`
+	// The drive download status. Values: okay, invalidDriveref, duplicateDrive, notAttempted, invalidState,
+	// inProgress, partialUpdate, failedUpdate, inProgressRecon, pending.
+	Status string `json:"status,omitempty"`
+	// Will be set to zero unless the DriveDownloadStatus is equal to DRIVE_DL_STAT_IN_PROGRESS_RECON (meaning a
+	// reconstruction is in progress).
+	PercentComplete int `json:"percentComplete,omitempty"`
+}
+
+// DriveEraseEstimateResponse is the DriveEraseEstimateResponse model.
+type DriveEraseEstimateResponse struct {
+	// Estimated time to completion in minutes
+	TimeToCompletion int `json:"timeToCompletion"`
+}
+
+// DriveEraseProgressResponse is the DriveEraseProgressResponse model.
+type DriveEraseProgressResponse struct {
+	// A list of drives undergoing erase operation
+	DriveOperations []DriveOpProgress `json:"driveOperations"`
+}
+
+// DriveEx is the DriveEx model.
+type DriveEx struct {
+	// True if the drive is in the offline state, false otherwise. This field is valid for missing drives.
+	Offline bool `json:"offline,omitempty"`
+	// True if the drive has been designated as a hot spare; false otherwise. This field is valid for missing
+	// drives.
+	HotSpare bool `json:"hotSpare,omitempty"`
+	// True if no drive information (serial #, capacity, etc.) can be obtained. This field is always true for
+	// missing drives.
+	InvalidDriveData bool `json:"invalidDriveData,omitempty"`
+	// True if the drive is available for use in creating a new volume group; false otherwise. This field is valid
+	// for missing drives.
+	Available bool `json:"available,omitempty"`
+	// True if the drive is drive Predicted Failure analysis predicts a drive failure. This field is valid for
+	// missing drives.
+	Pfa bool `json:"pfa,omitempty"`
+	// The identifier value for this drive. Other objects may use this reference value to refer to the drive. This
+	// field is valid for missing drives.
+	DriveRef string `json:"driveRef,omitempty"`
+	// An indication of the current status of the drive. This field is valid for missing drives. Values: optimal,
+	// failed, replaced, bypassed, unresponsive, removed, incompatible, dataRelocation, preFailCopy,
+	// preFailCopyPending, sanitizing.
+	Status string `json:"status,omitempty"`
+	// An indication of the reason for the current status of the drive. This field is most useful when the current
+	// status is something other than optimal; it identifies the event that caused the status to change to its
+	// current value. This field is valid for missing drives. Values: none, userOp, writeOp, uncertified,
+	// speedMismatch, bypassUnknown, wrongDriveType, incompatibleUprevDacstore, incompatibleDownrevDacstore,
+	// incompatibleInternalError, incompatibleSectorSizeMismatch, incompatibleNativeVgRefersToForeignDrive,
+	// incompatibleForeignVgRefersToNativeDrive, incompatibleNativeVgForeignDriveMutualRef, incompatibleVgCloned,
+	// incompatibleForeignDriveInconsistent, incompatibleVgHasDrivePartOfMultipleVgs,
+	// incompatibleForeignDriveHasInconsistentRole, incompatibleVgDrivePartOfMultipleVgs,
+	// incompatibleFailedLegacyDrive, incompatibleDdfOtherVendor, incompatibleDbAdoptionFailure, locked,
+	// insufficientDriveCapacity, supercapacitorFailure, unsupportedProtocolConnection, unsupportedCapacity,
+	// invalidLockKeyPresented, incompatiblePiType, incompatibleDatabaseValidationFailure,
+	// incompatibleUnsupportedInterposerFwVersion, incompatibleSataDriveSctUnsupported,
+	// incompatibleModelNumberUnsupported, incompatibleAlignmentForEmulationDrive, srcRelocation, dstRelocation,
+	// incompatibleDriveMetadataUnusable, pfa, spfa, incompatibleFastFormatNeeded, incompatibleFastFormatFailed,
+	// incompatibleUserAssignmentFailed, sanitizeFailed, incompatibleSanitized, incompatibleSanitizing,
+	// pendingFwVerification, flashTechUnsupported.
+	Cause string `json:"cause,omitempty"`
+	// Information about the I/O interface between the controller and the drive. For example, for a SCSI-attached
+	// drive, this field will contain information about the drive's channel and SCSI ID values. For other interface
+	// types, different data will be available in this field.
+	InterfaceType DriveTypeData `json:"interfaceType,omitzero"`
+	// The physical location of the drive. Beginning with the Allegheny release, the parent reference in Location
+	// identifies the tray or drawer containing the drive, and the position field is the
+	// parent-relative/like-component relative slot number of the drive, starting at 1. For missing drives this
+	// field contains the last known location.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// The variable-length ASCII text string that identifies the drive manufacturer. Note that this information is
+	// not returned for most Serial ATA (SATA) drives.
+	Manufacturer string `json:"manufacturer,omitempty"`
+	// The date of manufacture of the drive, represented in seconds since midnight GMT on January 1, 1970. Note that
+	// this information is not returned for most Serial ATA (SATA) drives.
+	ManufacturerDate string `json:"manufacturerDate,omitempty"`
+	// The variable-length ASCII text string that identifies the product by name, as assigned by the manufacturer.
+	ProductID string `json:"productID,omitempty"`
+	// The variable-length ASCII text string for the serial number of the drive.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// This data is a variable-length ASCII text string. If an interposer is present in front of SATA drives, the
+	// string identifies the version of the interposer package. In this case, the SATA drive firmware version can be
+	// found in the physicalDriveTypeData field. If an interposer is not present (always the case for Fibre Channel
+	// drives) this field describes the drive firmware version.
+	SoftwareVersion string `json:"softwareVersion,omitempty"`
+	// The block (i.e. sector) size used by the drive, represented in bytes. This field is valid for missing drives.
+	BlkSize int `json:"blkSize,omitempty"`
+	// The usable capacity of the drive, in bytes, after accounting for any space that is reserved for use by the
+	// array controller for overhead information. This field is valid for missing drives.
+	UsableCapacity string `json:"usableCapacity,omitempty"`
+	// The raw capacity of the drive, in bytes. This field is valid for missing drives.
+	RawCapacity string `json:"rawCapacity,omitempty"`
+	// A variable-length, opaque field that contains the drive's world-wide name, or globally unique identifier
+	// value. This field is valid for missing drives.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The reference value of the volume group, disk pool, or flash cache to which this drive is currently assigned.
+	// If a hot spare drive is actively sparing for a failed drive, this field will identify the volume group, disk
+	// pool, or flash cache that is using the hot spare drive. If the drive is not currently assigned, or is an idle
+	// hot spare, the reference value will be null. This field is valid for missing drives.
+	CurrentVolumeGroupRef string `json:"currentVolumeGroupRef,omitempty"`
+	// The reference value of the drive for which this drive is acting as a hot spare. This field is only used if
+	// the Drive object represents a global hot spare that is actively sparing for a failed drive. This field is
+	// valid for missing drives.
+	SparedForDriveRef string `json:"sparedForDriveRef,omitempty"`
+	// This field is only used if the Drive object represents a drive that is part of a RAID-1 volume group. It
+	// identifies the drive that is tied to this one for RAID-1 mirroring operations. This field is valid for
+	// missing drives.
+	MirrorDrive string `json:"mirrorDrive,omitempty"`
+	// True if the drive does not have redundant access.
+	NonRedundantAccess bool `json:"nonRedundantAccess,omitempty"`
+	// The channel to the tray that is still operational (if nonRedundantAccess is True).
+	WorkingChannel int `json:"workingChannel,omitempty"`
+	// The volume group index. This is the zero-relative position of the drive with respect to the stripe-ordering
+	// for the volume group. A value of -1 indicates that the drive does not belong to any group. This field is
+	// valid for missing drives.
+	VolumeGroupIndex int `json:"volumeGroupIndex,omitempty"`
+	// The speed of the drive. Will be unknown for a SCSI drive. Values: speedUnknown, speed1gig, speed2gig,
+	// speed4gig, speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig,
+	// speed30gig, speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig,
+	// speed32gig, speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	CurrentSpeed string `json:"currentSpeed,omitempty"`
+	// The maximum speed of the drive. Currently not used. Values: speedUnknown, speed1gig, speed2gig, speed4gig,
+	// speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig,
+	// speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig,
+	// speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	MaxSpeed string `json:"maxSpeed,omitempty"`
+	// True, if the drive has the DR_UNCERTIFIED flag set. This field is valid for missing drives.
+	Uncertified bool `json:"uncertified,omitempty"`
+	// True, if a path to this drive is degraded.
+	HasDegradedChannel bool `json:"hasDegradedChannel,omitempty"`
+	// The IDs of degraded channels.
+	DegradedChannels []int `json:"degradedChannels,omitempty"`
+	// The physical drive type. Values: all, scsi, fibre, sata, pata, fibre520b, sas, unknown, sas4k, nvme4k.
+	PhyDriveType string `json:"phyDriveType,omitempty"`
+	// The drive reported spindle speed. Solid State Disk (SSD) drives report zero.
+	SpindleSpeed int `json:"spindleSpeed,omitempty"`
+	// This field has been deprecated and should not be used.
+	RtrAttributes RTRAttributes `json:"rtrAttributes,omitzero"`
+	// reserved.
+	Reserved string `json:"reserved,omitempty"`
+	// Information specific to a physical drive type.
+	PhyDriveTypeData PhysicalDriveTypeData `json:"phyDriveTypeData,omitzero"`
+	// The reason a PFA condition has been raised for this drive. Values: unknown, none, driveReported, synthesized,
+	// ssdEndOfLife, dataLoss.
+	PfaReason string `json:"pfaReason,omitempty"`
+	// A list of object references where each reference identifies the source of a drive bypass condition. Possible
+	// referenced objects include the drive itself, a controller, or the ESM(s). When the bypass source is a
+	// controller or the drive, the list will typically hold just one reference; when the bypass source is the
+	// ESM(s), the list can hold up to two references, indicating either one or both ESMs. The list normally holds
+	// valid data whenever the drive is in the bypassed state (DRIVE_STAT_BYPASSED) , however it may be empty if the
+	// source of the bypass is unknown. It is also possible for the list to hold valid data when the drive is
+	// optimal, if only one ESM has bypassed the drive.
+	BypassSource []ObjectReference `json:"bypassSource,omitempty"`
+	// This field contains the repair policy for the Drive.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+	// True if the drive supports FDE, false otherwise
+	FdeCapable bool `json:"fdeCapable,omitempty"`
+	// true if drive security is enabled; false if disabled
+	FdeEnabled bool `json:"fdeEnabled,omitempty"`
+	// True if the drive is locked, false if the drive is unlocked
+	FdeLocked bool `json:"fdeLocked,omitempty"`
+	// The lock key ID as read from the drive.
+	LockKeyID string `json:"lockKeyID,omitempty"`
+	// Contains wear life data for the SSD drive.
+	SsdWearLife SSDWearLife `json:"ssdWearLife,omitzero"`
+	// This field is set to the drive media type of the drive. Values: all, unknown, hdd, ssd.
+	DriveMediaType string `json:"driveMediaType,omitempty"`
+	// This data is a variable-length ASCII text string that identifies the version of the FPGA package.
+	FpgaVersion string `json:"fpgaVersion,omitempty"`
+	// This structure contains a protectionInformationCapable field which is set to true when the drive is formatted
+	// to support type 2 protection with a 512-byte logical block size, or if the drive is a type 0 drive formatted
+	// with a 520-byte logical block size. It also contains a field that is set to the protection type provided for
+	// the drive.
+	ProtectionInformationCapabilities ProtectionInformationCapabilities `json:"protectionInformationCapabilities,omitzero"`
+	// This field has been deprecated and should not be used.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// This field has been deprecated and should not be used. Values: type0Protection, type1Protection,
+	// type2Protection, type3Protection.
+	ProtectionType string `json:"protectionType,omitempty"`
+	// A value of true for this property means the drive is behind an interposer.
+	InterposerPresent bool `json:"interposerPresent,omitempty"`
+	// The drive interposer identifier. This field is valid only if the interposerPresent field is set to True.
+	InterposerRef string `json:"interposerRef,omitempty"`
+	// The current command aging timeout value in hundreds of milliseconds. If this value is 0 the default command
+	// aging timeout value will be used, otherwise this value overrides the default value.
+	CurrentCommandAgingTimeout int `json:"currentCommandAgingTimeout,omitempty"`
+	// The default command aging timeout value in hundreds of milliseconds as specified by the drive. If this value
+	// is 0, the drive command aging timeout cannot be changed.
+	DefaultCommandAgingTimeout int `json:"defaultCommandAgingTimeout,omitempty"`
+	// This field has been deprecated and should not be used.
+	DriveTemperature DriveTemperature `json:"driveTemperature,omitzero"`
+	// The physical block (i.e., sector) size for the drive, represented in bytes. This field is valid for missing
+	// drives.
+	BlkSizePhysical int `json:"blkSizePhysical,omitempty"`
+	// The lowest aligned LBA for the physical sector. Typically this will be 0, but could be non-zero for emulation
+	// drives where LBA 0 starts at some logical sector offset within the physical sector.
+	LowestAlignedLBA string `json:"lowestAlignedLBA,omitempty"`
+	// True if the drive is in the process of being removed from the volume group. Only applies to Disk Pools
+	Removed bool `json:"removed,omitempty"`
+	// Indicates that a locate operation for the device is currently active. The device will show a visual
+	// indication to aid an operator in locating the drive.
+	LocateInProgress bool `json:"locateInProgress,omitempty"`
+	// This flag is true if the drive supports FIPS (Federal Information Processing Standard). FIPS 140-2
+	// specification.
+	FipsCapable bool `json:"fipsCapable,omitempty"`
+	// An indication as to whether the drive supports DULBE
+	DulbeCapable bool `json:"dulbeCapable,omitempty"`
+	// An indication as to whether the drive supports Sanitize operations.
+	SanitizeCapable bool `json:"sanitizeCapable,omitempty"`
+	// This enumeration distinguishes between different security capabilities of a drive. Values: notCapable,
+	// capableNonFips, capableFips1402, capableFips1403, capableFipsUnknown.
+	DriveSecurityType string `json:"driveSecurityType,omitempty"`
+	FirmwareVersion   string `json:"firmwareVersion,omitempty"`
+	LockKeyIDValue    string `json:"lockKeyIDValue,omitempty"`
+	ID                string `json:"id,omitempty"`
+}
+
+// DriveFirmwareCompatabilityEntry is the DriveFirmwareCompatabilityEntry model.
+// Drive compatibility entry
+type DriveFirmwareCompatabilityEntry struct {
+	DriveRef             string `json:"driveRef"`
+	OnlineUpgradeCapable bool   `json:"onlineUpgradeCapable"`
+}
+
+// DriveFirmwareCompatibilityControllerInfo is the DriveFirmwareCompatibilityControllerInfo model.
+// The controller information associated with drive firmware compatibility.
+type DriveFirmwareCompatibilityControllerInfo struct {
+	// The controller's unique identifier.
+	ControllerID string `json:"controllerId"`
+	// Values: unknown, optimal, failed, removed, rpaParErr, serviceMode, suspended, degraded.
+	ControllerStatus string `json:"controllerStatus"`
+}
+
+// DriveFirmwareCompatibilityResponse is the DriveFirmwareCompatibilityResponse model.
+// The list of drives compatible with the downloaded firmware files
+type DriveFirmwareCompatibilityResponse struct {
+	// The list of controllers and their associated details.
+	ControllerInfo []DriveFirmwareCompatibilityControllerInfo `json:"controllerInfo"`
+	// Deprecated since 05.10: True if the controller is offline; otherwise, false.
+	ControllerOffline bool `json:"controllerOffline"`
+	// Deprecated since 05.10: True if the controller is missing; otherwise, false.
+	ControllerMissing bool `json:"controllerMissing"`
+	// Deprecated since 05.10: True if the controller is in service mode; otherwise, false.
+	ControllerServiceMode bool `json:"controllerServiceMode"`
+	// True if the controller is in simplex mode; otherwise, false.
+	SimplexMode bool `json:"simplexMode"`
+	// The list of drives compatible with the downloaded firmware files.
+	Compatibilities []DriveFirmwareCompatiblitySet `json:"compatibilities"`
+}
+
+// DriveFirmwareCompatiblitySet is the DriveFirmwareCompatiblitySet model.
+// Drive firmware file to drive compatibility
+type DriveFirmwareCompatiblitySet struct {
+	Filename string `json:"filename"`
+	// The version of firmware in file
+	FirmwareVersion string `json:"firmwareVersion"`
+	// Drive firmware versions supported by firmware file
+	SupportedFirmwareVersions []string `json:"supportedFirmwareVersions"`
+	// List of drives that are compatible with firmware file
+	CompatibleDrives []DriveFirmwareCompatabilityEntry `json:"compatibleDrives"`
+}
+
+// DriveFirmwareStatus is the DriveFirmwareStatus model.
+type DriveFirmwareStatus struct {
+	// The overall status. Values: uninitialized, ok, error, busy, illegalParam, noHeap, driveNotExist,
+	// driveNotUnassigned, noSparesAssigned, someSparesAssigned, volumeNotExist, volumeReconfiguring, notDualActive,
+	// tryAlternate, background, notImplemented, reservationConflict, volumeDead, internalError, invalidRequest,
+	// iconFailure, volumeFormatting, altRemoved, cacheSyncFailure, invalidFile, reconfigSmallDacstore,
+	// reconfigFailure, nvramError, flashError, authFailParam, authFailPassword, memParityError,
+	// invalidControllerref, invalidVolumegroupref, invalidVolumeref, invalidDriveref, invalidFreeextentref,
+	// volumeOffline, volumeNotOptimal, modesenseError, invalidSegmentsize, invalidCacheblksize,
+	// invalidFlushThreshold, invalidFlushAmount, invalidLabel, invalidCacheModifier, invalidReadahead,
+	// invalidReconpriority, invalidScanperiod, invalidTrayposLength, invalidRegionid, invalidFibreid,
+	// invalidEncryption, invalidRaidlevel, invalidExpansionList, noSparesDeassigned, someSparesDeassigned,
+	// partDupId, partLabelInvalid, partNodeNonexistent, partPortIdInvalid, partVolumeNonexistent, partLunCollision,
+	// maxVolMappingExceeded, partMappingNonexistent, partNoHostports, imageTransferred, fileTooLarge,
+	// invalidOffset, overrun, invalidChunksize, invalidTotalsize, downloadNotPermitted, spawnError,
+	// voltransferError, invalidDlstate, cacheconfigError, downloadInProgress, driveNotOptimal, driveRemoved,
+	// duplicateDrives, numdrivesAdditional, numdrivesGroup, driveTooSmall, capacityConstrained, maxVolumesExceeded,
+	// partIsUtmLun, someSparesTooSmall, sparesSmallUnassigned, tooManyPartitions, parityScanInProgress,
+	// invalidSafeId, invalidSafeKey, invalidSafeCapability, invalidSafeVersion, partitionsDisabled,
+	// driveDownloadFailed, esmDownloadFailed, esmPartialUpdate, utmConflict, noVolumes, authFailReadpassword,
+	// partCrteFailTblFull, attemptToSetLocal, invalidHostTypeIndex, failVolumeVisible, noDeleteUtmInUse,
+	// invalidLun, utmTooManyMaps, diagReadFailure, diagSrcLinkDown, diagWriteFailure, diagLoopbackError,
+	// diagTimeout, diagInProgress, diagNoAlt, diagIconSendErr, diagInitErr, diagModeErr, diagInvalidTestId,
+	// diagDriveErr, diagLockErr, diagConfigErr, diagNoCacheMem, diagNotQuiesced, diagUtmNotEnabled,
+	// invalidModeSwitch, invalidPortname, duplicateVolMapping, maxSnapsPerBaseExceeded, maxSnapsExceeded,
+	// invalidBasevol, snapNotAvailable, notDisabled, snapshotFeatureDisabled, repositoryOffline,
+	// repositoryReconfiguring, rollbackInProgress, numVolumesGroup, ghostVolume, repositoryMissing,
+	// invalidRepositoryLabel, invalidSnapLabel, invalidRollbackPriority, invalidWarnThreshold, cannotMapVolume,
+	// cannotFormatVolume, dstNotFibre, repositoryTooSmall, repositoryFailed, baseVolumeFailed, baseVolumeOffline,
+	// baseVolumeFormatting, metadataVolNonexistent, rvmFeatureDisabled, mirrorsPresent, rvmFeatureDeactivated,
+	// maxMirrorsExceeded, invalidMirrorCandidateVol, invalidMirrorvol, metadataAlreadyExists, metadataMissing,
+	// metadataOffline, metadataReconfiguring, localRoleChangeFailed, remoteRoleChangeFailed,
+	// localRoleChangeSuccessful, onlyLocalMirrorDeleted, noValidMirrorCandidate, remoteMaxMirrorsExceeded,
+	// remoteRvmFeatureDisabled, remoteMetadataVolNonexistent, notRegistered, remoteInvalidCfgGen,
+	// localRoleChangedNotForced, remoteRoleChangedLocalFailed, rvmSpmError, remoteAuthFailPassword,
+	// rvmVersionMismatch, rvmRemoteArrayError, rvmCommunicationError, rvmFibreError, mirrorVolNotPrimary,
+	// secNotPromoteable, priNotDemoteable, metadataChildDeletion, rmtvolOrphanDeletion, rvmActivateDisallowed,
+	// invalidTrayref, partialDeletion, defaultUtmCollision, invalidCopyPriority, invalidVolumecopyref,
+	// copyChangeFailed, copyActive, copyInactive, copyIncompatibleSource, copyIncompatibleTarget, copyGhostSource,
+	// copyGhostTarget, copyInvalidSourceRef, copyInvalidTargetRef, copyInvalidSourceState, copyInvalidTargetState,
+	// copySourceReconfig, copyTargetReconfig, copyTargetTooSmall, copyTargetLimit, maxVolumeCopysExceeded,
+	// copySourceReservation, copyTargetReservation, copySourceFormat, copyTargetFormat, copyStartFailed,
+	// copyStopFailed, volcopyFeatureDisabled, writeLock, cannotReconfigure, authFailContLockout,
+	// prReservationConflict, regDeleteFailed, batteryNotInConfig, batteryMissing, noChannel,
+	// rvmOperNotAllowedOnSec, dataRedundancyRequired, copySourceZeroCapacity, invHostlunDefineMapping,
+	// invHostlunMoveMapping, invHostlunDefineHosttype, invHostlunMoveHostport, fwIncompatible,
+	// mirrorAlreadySuspended, insuffLocalMirRepResources, insuffRemtMirRepResources, ghostHasUnreadableSectors,
+	// rvmCommStatRecoveredTimeout, rvmCommStatRecoveredDelay, rvmCommStatNotReady, rvmCommStatTimeout,
+	// rvmCommStatChannelFailure, rvmCommStatNetworkFailure, rvmCommStatDeviceMissing, rvmCommStatLoginRejected,
+	// rvmCommStatLoginFailure, rvmCommStatInvNumSamplesReqd, rvmQuiescenceInProgress, rvmInvalidRemotevol,
+	// sodInProgress, invalidDrives, invalidSetid, invalidSetsize, missingData, quiescenceFailed, validationError,
+	// downloadHalted, allFailed, partialOk, obsolete, usmClearFailed, controllerInServiceMode, invalidDrive,
+	// databaseError, backgroundAutocfg, autocfgInprogress, unsupportedLhaSataEsm, parityScanFailed,
+	// parityRepairFailed, mediaRepairFailed, mirrorDegraded, prohibitedByMdtRestrictions,
+	// prohibitedByGoldKeyRestrictions, safeControllerNotSubjectToGoldKey, safeMdtNotPremiumFeature,
+	// alarmNotPresent, dltNotCompleted, dependancyError, cdmDatabaseFull, requiredConditionNotPresent, ddcUnavail,
+	// ddcIllegalParam, invalidDdcTag, hosttypeConflict, portConflict, invalidHosttypeString, invalidProtocol,
+	// portRemoved, disableNotPermitted, prohibitedByDriveTrayLimit, invalidEsmref, invalidBundleMigration,
+	// invalidBundleKey, noSparesNeeded, prohibitedByFeatureBundleViolation, invalidAuthMethod, invalidSecret,
+	// secretAlreadyInUse, manualConfigModeSet, noIscsiSessions, invalidInterfaceref, initiatorConflict,
+	// initiatorRemoved, basevolSizeChanged, volumeGroupNotExist, volumeGroupNotOnline, volumeGroupHasHotspare,
+	// volumeGroupReconfiguring, volumeGroupStateNotValid, controllerNotOptimal, insufficientCapacity,
+	// volumeGroupExported, volumeNotConfigurable, volumeGroupNotConfigurable, invalidDriveState,
+	// volumeGroupReconstructing, volumeGroupUndergoingCopyback, volumeGroupNotComplete, volumeGroupHasFailedDrives,
+	// volumeGroupHasNonOptimalVols, volumeGroupHasMirrorRelationship, volumeGroupHasVolcopyRelationship,
+	// volumeGroupHasMirroringMetadata, volumeGroupHasMappedVols, volumeGroupHasReservations,
+	// volumeGroupHasIncompatibleDacstores, volumeLimitExceeded, volumeGroupHasUnknownRaidLevel,
+	// volumeGroupHasUnsupportedRaidLevel, volumeGroupHasCloneOpportunity, volumeGroupHasInsufficientDrives,
+	// volumeGroupHasFailedVols, perfTierSafeUpgradeDisabled, raid6FeatureUnsupported, raid6FeatureDisabled,
+	// safeControllerNotSubjectToRaid6, volumeGroupNotContingent, channelDiagsRunning, channelDiagsResultsPartial,
+	// volumeGroupHasSnapshotRelationship, prohibitedBySafeViolation, legacyVg, vgNotForceable, channelDiagsLockErr,
+	// channelDiagsNotQuiesced, channelDiagsAltCommFailed, channelDiagsChanSetupFailed,
+	// channelDiagsDeviceBypassFailed, channelDiagsResultsNotAvailable, driveSpinUpError, driveTypeMismatch,
+	// localRemoteArrayHasSameWwn, volumeGroupHasIncompatibleDrive, volumeGroupVolumeEncroachesOnDacstore,
+	// volumeGroupImportInProgress, drivesNeedToBeSpunUp, noNativeSstor, noSuchDebugChunk, debugInfoConfigChanged,
+	// lockdown, drivesDacstoresOverlap, volumeHasAsyncMirror, reconfigLogSpaceError, volumeGroupInaccessible,
+	// volumeInitializing, insufficientCache, volumeInaccessible, noDrivesAdopted, someDrivesAdopted,
+	// exportingDrivesDatabaseResynchronizing, exportingDrivesDatabaseFailed, exportingDrivesQuiesced,
+	// learnActiveTryLater, noLockedDrives, driveSecurityEnabledFailed, lockkeyFailed, invalidSecurity, noFdeDrives,
+	// volumeGroupSecure, invalidBlob, unlockFailed, noKeySet, rekeyInProgress, defaultHostGroupMappingNotAllowed,
+	// ssdMediaScanNotAllowed, premiumFeatureLimitExceedsMaximum, disableEvaluationFeatureNotPermitted,
+	// requestFailedDueToLun0Restrictions, externalKmsEnabled, externalKmsFailed, externalKmsNotEnabled,
+	// keyNotNeeded, keyInvalidSequence, diagNotRunning, ctrlNotInServiceMode, invalidFeatureref,
+	// cacheBackupDevNotExist, noMatchingLockKeyIdFound, lockKeyValidationFailed, lockKeyValidationDisabled,
+	// externalKmsNotCompliant, externalKmsTimeout, cannotDisableNoKey, previouslyEnabledForEval, featureNotKeyable,
+	// evalNotSupported, rawdataTransferBadType, rawdataTransferNotStarted, rawdataTransferAlreadyStarted,
+	// rawdataTransferPreparing, rawdataTransferReadError, rawdataTransferNoDrives, rawdataTransferInvalidImage,
+	// rawdataTransferCrcError, dbmRestoreWriteError, dbmRestoreNoDrives, rawdataBadSeqNum, invalidCapability,
+	// externalKeyNotInMemory, invalidLockKeyId, invalidProtection, volumeHasSnapshotRelationship,
+	// volumeHasMirrorRelationship, externalKmsDisabledNoKey, dbmRestoreAltCtlNotOffline, copyApptagMismatch,
+	// invalidRequestForEnclosure, dqRetrieveNothingToTransfer, invalidIscsiConfiguration,
+	// volumeHasVolcopyRelationship, partPiIncapable, requestFailedDueToPiRestrictions,
+	// rawdataTransferUserCancelled, duplicateIscsiIpAddress, portSpeedConflict, factoryDefaultDownloadFailed,
+	// errorWritingToEeprom, factoryDefaultPartialUpdate, snapshotNotActive, cannotRollback, mirrorSyncNotPossible,
+	// psuFirmwareDownloadFailed, psuFirmwareUpdateMfgDeviceCodeMismatch, psuFirmwareUpdateNotAllRedundant,
+	// psuFirmwareUpdateNotAllOptimal, insufficientRepositoryCapacity, rollbackStartFailure, csbReserveFailed,
+	// csbReleaseFailedNoLock, csbReleaseFailedInvalidKey, flashcacheAlreadyExists, flashcacheFeatureDisabled,
+	// flashcacheAlreadySuspended, flashcacheNotSuspended, flashcacheInvalidConfigType, invalidPitGroupLabel,
+	// invalidPitConsistencyGroupLabel, invalidPitAutoDeleteLimit, invalidPitRepositoryFullPolicy,
+	// invalidConcatVolMemberLabel, concatVolMemberTooSmall, invalidPitGroupRef, invalidPitRef, dveNotAllowed,
+	// dssNotAllowed, dplCoreDumpInvalidTag, invalidPitViewLabel, invalidPitViewRef, invalidConcatVolRef,
+	// notFlashcacheVol, flashcacheDeleted, flashcacheEnabled, flashcacheNotEnabled, noRepDeletion,
+	// maxPitsPerGroupExceeded, maxPitsExceeded, maxPitGroupsPerBaseExceeded, maxPitGroupsExceeded,
+	// maxViewsPerPitExceeded, maxViewsExceeded, maxConsistencyGroupsExceeded, maxConsistencyGroupMembersExceeded,
+	// maxMappableVolumesExceeded, notOldestPit, viewStopped, concatMemberLimitExceeded, invalidMemberVol,
+	// memberVolMapped, invalidMemberVolState, invalidTrimCount, pitGroupInConsistencyGroup, pitInConsistencyGroup,
+	// pitViewInConsistencyGroup, incompatibleMemberVol, volumeInUse, rvmOverIscsiNotSupported,
+	// arvmGroupUserLabelExists, arvmGroupDoesNotExist, arvmGroupNotEmpty, concatVolumeFailed,
+	// invalidPitConsistencyGroupRef, invalidPitConsistencyGroupViewRef, invalidPitConsistencyGroupViewLabel,
+	// alternateRequiredForOperation, invalidPitForView, consistencyGroupArvmBindingConflict, attributeFixedByArvm,
+	// operationFailedVolumeCopyClone, pitCreatePending, dbmDbSourceUnavailable, dbmRestoreSourceMismatch,
+	// invalidCriticalThreshold, volumeGroupHasArvmRelationship, arvmRecoveryPointDeletionRequired,
+	// volumeGroupHasPitgroupRelationship, volumeGroupHasPitviewRelationship, volumeGroupHasConcatRelationship,
+	// flashcacheSuspended, flashcacheAlreadyEnabled, dbmDbImageCorrupt, illegalVolume, invalidRepositoryCapacity,
+	// invalidProvisionedCapacityQuota, invalidExpansionPolicy, invalidVirtualCapacity, cannotExpandConcatMember,
+	// thresholdBelowUsedCapacity, invalidExpansionOperation, repositoryFull, insufficientExpansionSpace,
+	// invalidExpansionSize, invalidReinitAction, invalidReinitCapacity, invalidIncompleteMemberRef,
+	// arvmGroupNotPrimary, arvmGroupNotSecondary, arvmMemberFailed, arvmGroupNotSuspended, arvmInvalidMirrorState,
+	// arvmVolumeAlreadyInMirrorRelationship, arvmMemberLimitExceeded, arvmSuspendFailure, arvmResumeFailure,
+	// arvmSynchronizeFailure, remoteTargetNotFound, arvmMirrorMemberDoesNotExist, snapConversionTooManySnaps,
+	// snapConversionMissingLabel, arvmFeatureDeactivated, incompatibleRepositorySecurity,
+	// incompatibleSecondarySecurity, mirrorProtocolMismatch, arvmAsyncMirrorGroupPresent,
+	// cacheParametersNotChangeable, flashcacheMaxCapacityExceeded, flashcacheFailed, dplCoreDumpRestoreInProgress,
+	// arvmGroupHasIncompleteMember, arvmConnectivityTestAlreadyInProgress, arvmConnectivityTestNetworkError,
+	// arvmConnectivityTestRemoteTimeout, arvmConnectivityTestLoginFailure, arvmConnectivityTestNameServiceError,
+	// arvmConnectivityTestTurError, arvmConnectivityTestMissingRemoteAmg, arvmConnectivityTestAmgMemberMismatch,
+	// invalidSyncPriority, invalidRecoveryPointAlertThreshold, invalidSyncAlertThreshold,
+	// mustSpecifyExistingVolumes, arvmConnectivityTestTimeoutExceeded, flashcacheMaxLimitExceeded,
+	// volsInVgUsingNonSecureCapableFlashcache, volsInVgUsingSecureDisabledFlashcache, invalidSubmodelId,
+	// premiumFeatureLimitMismatch, volumeGroupNotImportable, primaryCacheSizeMismatch, flashcacheUserLabelExists,
+	// maxThinVolumesExceeded, arvmInvalidSecondaryCapacity, arvmOnlyPrimaryMemberRemoved,
+	// arvmOnlySecondaryMemberRemoved, arvmInvalidAmgRequestWhileSuspended, arvmManualSyncAlreadyInProgress,
+	// arvmManualSyncRetryTooSoon, diskPoolNotEmpty, flashCacheInvalidBaseVol, flashCacheFdeEnablementDisallowed,
+	// remoteArvmFeatureDeactivated, remoteArvmFeatureDisabled, arvmOrphanGroup, arvmOrphanMember,
+	// volumeNotAvailable, volumeHasUnreadableSectors, thinProvisioningFeatureDisabled, pitGroupsFeatureDisabled,
+	// exceedDiskPoolLimit, flashcacheDegradedState, flashcacheNonDaCapableDriveDisallowed,
+	// arvmMaxAsyncMirrorGroupsExceeded, arvmMaxMirrorsPerArrayExceeded, maxTotalMirrorsPerArrayExceeded,
+	// exceedDiskPoolCapacity, exceedMaxVolumeCapacity, arvmRemoteMaxAsyncMirrorGroupsExceeded,
+	// arvmRemoteMaxMirrorsPerArrayExceeded, remoteMaxTotalMirrorsPerArrayExceeded, arvmInvalidSyncInterval,
+	// remoteNoHeap, remoteInternalError, remoteRvmSpmError, arvmRemoteMirrorMemberDoesNotExist,
+	// arvmRemoteGroupUserLabelExists, arvmRemoteGroupNotSecondary, arvmRemoteGroupDoesNotExist,
+	// remoteInvalidProtection, remoteDatabaseError, arvmRemoteGroupNotEmpty, arvmRemoteSuspendFailure,
+	// arvmRemoteResumeFailure, arvmRemoteSynchronizeFailure, flashcacheInvalidAnalyticsState,
+	// arvmExpansionSynchronizationInProgress, arvmRemoteExpansionSynchronizationInProgress,
+	// faultConditionStillExists, remoteTryAlternate, arvmOnlyLocalAmgDeleted, arvmRoleChangePending,
+	// arvmRoleChangeInProgress, arvmMemberStopped, reconstructionInProgress, copybackInProgress,
+	// adminPasswordNotSet, keyDoesNotExist, takeRecoveryActionsFirst, coredumpBackupInProgress,
+	// legacyRvmAsyncModeUnsupported, arvmIncorrectVolumeType, thinVolumeParametersCannotBeModified,
+	// arvmRemoteThinNotSupported, snmpInvalidCommunityName, snmpInvalidCommunityPermission,
+	// snmpInvalidCommunityRef, snmpInvalidTrapDestinationRef, invalidIpAddress, snmpMaxCommunitiesExceeded,
+	// snmpMaxTrapDestinationsExceeded, snmpCommunityNameInUse, snmpTrapDestinationAddressInUse,
+	// snmpUnknownSystemVariable, snmpInvalidSystemVariableValue, snmpIncompatibleFirmware, snmpAgentDisabled,
+	// snmpAgentInitFailed, arvmThinVolInitError, arvmRemoteThinVolInitError, snmpIncompatibleIpv4Address,
+	// snmpIncompatibleIpv6Address, drivesNotAvailableForRemoval, snmpCannotDisableIpv4, snmpCannotDisableIpv6,
+	// snmpIpv4ConfigError, iocDumpInProgress, iocRestoreInProgress, iocDumpInvalidTag, unsupportedEsmRequest,
+	// isnsDhcpNotSupported, dpcVolumeGroupNotRedundant, dpcVolumeNotInitialized, dpcExclusiveOperationActive,
+	// dpcUnableToPowerUpDrive, dpcFormatActive, dpcUnreadableSectorsPresent, dpcPowerCycleAlreadyInProgress,
+	// dpcEnclosureHardwareUnsupported, dpcEnclosureFwDownlevel, evacInProgress, noEvacFound, noHotspareAvailable,
+	// driveServiceInProgress, hdd4kbSegmentsizeNotAllowed, diskPoolNoSpareDrives, diskPoolExceedSpareCapacity,
+	// autoLoadBalanceUserDisabled, autoLoadBalanceInsufficientStatistics, invalidLoadBalanceAction,
+	// invalidLoadBalanceDelay, reservedAddress, volumeCreationInProgress, keyValueTagInvalidRef,
+	// keyValueTagInvalidDuplicate, keyValueTagInUse, workloadInvalidRef, invalidKeyValueTagObjectReference,
+	// mappingInvalidDuplicate, downloadCompleteNoReboot, downloadCompleteMswOnlyReboot, workloadInvalidDuplicate,
+	// mappingInvalidRef, workloadInUse, embeddedExternalKeyManagementEnabled,
+	// embeddedExternalKeyManagementCertificatesNotInstalled, illegalOperationOnAllConfigDrives,
+	// databaseResyncInProgress, embeddedEkmsKeyserverInfoMissing, embeddedEkmsClientKeyMissing,
+	// embeddedEkmsClientCertMissing, embeddedEkmsClientCertMismatch, embeddedEkmsCertExpired,
+	// embeddedEkmsProxyNotResponding, embeddedEkmsAuthenticationFailure, embeddedEkmsKeyOwnerRequired,
+	// embeddedEkmsServerError, embeddedEkmsServerCertMissing, embeddedEkmsCertDuplicate, embeddedEkmsCertInvalid,
+	// embeddedEkmsConnectionFailure, invalidPasswordLength, certRevoked, missingSignature, sigValidationFailed,
+	// unableToConnectToOcspResponder, requestFailedDueToAlbEnabled, driveLacksPathRedundancy,
+	// driveSecurityErasePsidFailed, driveSecurityEraseInvalidPsid, driveDulbeIncompatible,
+	// setControllerTimeInvalidNtpEnabled, remoteTargetVolumeFeatureDisabled, remoteTargetVolumesOverallMaxExceeded,
+	// remoteTargetGroupDoesNotExist, remoteTargetGroupsOverallMaxExceeded, remoteTargetGroupNotEmpty,
+	// remoteTargetGroupNotFound, remoteTargetVolumeNotFound, remoteTargetVolumeConnectionFailure,
+	// essErrSecretKeyCollision, essErrSecretKeyUnknown, essErrSecretKeyInvalid, incompatibleBlockSizes,
+	// invalidBlockSize, essErrSecretDataSizeInvalid, essErrSecretGroupSizeInvalid, essErrSecretInUse,
+	// essErrSecretGroupNotFound, ioTransportSecretValidationDisabled, alternateLockdown, speedFecModeConflict,
+	// remoteTargetGroupNotOptimal, snmpInvalidUsmUserEngineIdType, snmpInvalidUsmUserEngineId,
+	// snmpInvalidUsmUserName, snmpInvalidUsmUserPermission, snmpInvalidUsmUserSecurityLevel,
+	// snmpInvalidUsmUserAuthProtocol, snmpInvalidUsmUserAuthPassword, snmpInvalidUsmUserPrivProtocol,
+	// snmpInvalidUsmUserPrivPassword, snmpInvalidUsmUserRef, snmpCommunityExists, snmpUsmUserExists,
+	// snmpMaxUsmUsersExceeded, snmpMissingUsmUserEngineId, snmpMissingUsmUserPrivPassword,
+	// snmpMissingUsmUserAuthPassword, snmpUsmUserEngineIdExists, externalKmsOldKeyNotDeleted,
+	// targetPortLunMappingUnsupported, diskPoolUseOnly.
+	OverallStatus string `json:"overallStatus,omitempty"`
+	// The drive status.
+	DriveStatus []DriveDownloadDriveStatus `json:"driveStatus,omitempty"`
+}
+
+// DriveFirmwareUpdateEntry is the DriveFirmwareUpdateEntry model.
+type DriveFirmwareUpdateEntry struct {
+	Filename                  string   `json:"filename"`
+	CompatibilityCheckEnabled bool     `json:"compatibilityCheckEnabled"`
+	DriveRefList              []string `json:"driveRefList"`
+}
+
+// DriveLayout is the DriveLayout model.
+type DriveLayout struct {
+	// The drive orientation in the drawer or tray. Values: none, horizontal, vertical.
+	DriveOrientation string `json:"driveOrientation,omitempty"`
+	// The number of rows in the drive layout. If driveOrientation field is set to "None", the value of this field
+	// should be zero.
+	NumRows int `json:"numRows,omitempty"`
+	// The number of columns in the drive layout. If driveOrientation field is set to "None", the value of this
+	// field should be zero.
+	NumColumns int `json:"numColumns,omitempty"`
+	// The primary traversal method for the drawer or tray. If driveOrientation field is set to "None", the value of
+	// this field should be TRAVERSAL_NONE Values: none, leftToRight, rightToLeft, topToBottom, frontToBack.
+	PrimaryTraversal string `json:"primaryTraversal,omitempty"`
+	// The secondary traversal method for the drawer or tray. If driveOrientation field is set to "None", the value
+	// of this field should be TRAVERSAL_NONE Values: none, leftToRight, rightToLeft, topToBottom, frontToBack.
+	SecondaryTraversal string `json:"secondaryTraversal,omitempty"`
+}
+
+// DriveLocation is the DriveLocation model.
+// Uniquely specifies a drive location
+type DriveLocation struct {
+	// Tray containing the drive
+	Tray int `json:"tray"`
+	// Optional drawer containing the drive. -1 indicates the drive is not in a drawer.
+	Drawer int `json:"drawer,omitempty"`
+	// Slot of drive
+	Slot int `json:"slot"`
+}
+
+// DriveOpProgress is the DriveOpProgress model.
+type DriveOpProgress struct {
+	// Reference to the drive
+	DriveRef string `json:"driveRef"`
+	// Completion percentage of current operation
+	PercentComplete int `json:"percentComplete"`
+	// Estimated time to completion in minutes
+	TimeToCompletion int `json:"timeToCompletion"`
+}
+
+// DriveRefList is the DriveRefList model.
+type DriveRefList struct {
+	// A variable-length array of DriveRef objects.
+	DriveRef []string `json:"driveRef,omitempty"`
+}
+
+// DriveReferenceList is the DriveReferenceList model.
+type DriveReferenceList struct {
+	// A list of drive references
+	DriveRefs []string `json:"driveRefs"`
+}
+
+// DriveSecuritySettingsRequest is the DriveSecuritySettingsRequest model.
+// Updates drive security settings.
+type DriveSecuritySettingsRequest struct {
+	// The drive's physical security id.
+	PhysicalSecurityID string `json:"physicalSecurityId"`
+}
+
+// DriveSecuritySettingsResponse is the DriveSecuritySettingsResponse model.
+// Reports security settings for a given drive.
+type DriveSecuritySettingsResponse struct {
+	// The drive's id.
+	DriveID string `json:"driveId"`
+	// The drive's physical security id.
+	PhysicalSecurityID string `json:"physicalSecurityId"`
+}
+
+// DriveSelectionRequest is the DriveSelectionRequest model.
+type DriveSelectionRequest struct {
+	DriveCount int `json:"driveCount"`
+	// Only select drives with these spindle speeds
+	SelectedSpindleSpeeds []int `json:"selectedSpindleSpeeds,omitempty"`
+	// Only select FDE capable drives
+	FdeCapableOnly *bool `json:"fdeCapableOnly,omitempty"`
+	// True to filter out FDE enabled drives, otherwise false.
+	FdeDisabledOnly *bool `json:"fdeDisabledOnly,omitempty"`
+	// Only consider drives with at least this minimum raw capacity in SizeUnits
+	DriveCapacityMin string `json:"driveCapacityMin,omitempty"`
+	// Only consider drives of this media type Values: ssd, hdd.
+	DriveType string `json:"driveType,omitempty"`
+	// Only consider drives of this interface type Values: fibre, sas, sata.
+	InterfaceType string `json:"interfaceType,omitempty"`
+	// Only consider drives that have Data Assurance capability.
+	ProtectionInformationCapable *bool `json:"protectionInformationCapable,omitempty"`
+	// Use this unit for any capacity based parameters Values: bytes, b, kb, mb, gb, tb, pb, eb, zb, yb.
+	SizeUnit string `json:"sizeUnit,omitempty"`
+	// The intended RAID level for the storage-pool. Values: raidUnsupported, raidAll, raid0, raid1, raid3, raid5,
+	// raid6, raidDiskPool, raid1p.
+	RaidLevel string `json:"raidLevel,omitempty"`
+	// The aggregate capacity of the selected drives must be at least this many SizeUnits
+	TargetUsableCapacity *int `json:"targetUsableCapacity,omitempty"`
+}
+
+// DriveTemperature is the DriveTemperature model.
+type DriveTemperature struct {
+	// The current drive temperature (in degrees Celsius).
+	CurrentTemp int `json:"currentTemp,omitempty"`
+	// Reference temperature (maximum safe operating temperature in degrees Celsius).
+	RefTemp int `json:"refTemp,omitempty"`
+}
+
+// DriveTemperatureInformation is the DriveTemperatureInformation model.
+// Drive temperature information
+type DriveTemperatureInformation struct {
+	DriveTemperatureInformationData []DriveTemperatureInformationData `json:"driveTemperatureInformationData,omitempty"`
+}
+
+// DriveTemperatureInformationData is the DriveTemperatureInformationData model.
+// Contains the temperature data for the given drive in the storage system
+type DriveTemperatureInformationData struct {
+	// The drive's id
+	ID string `json:"id,omitempty"`
+	// The drive's current temperature in degrees Celsius
+	CurrentTempInCelsius int `json:"currentTempInCelsius,omitempty"`
+	// The drive's reference temperature (maximum safe operating temperature) in degrees Celsius
+	ReferenceTempInCelsius int `json:"referenceTempInCelsius,omitempty"`
+}
+
+// DriveTypeData is the DriveTypeData model.
+type DriveTypeData struct {
+	// This enumeration type is used to distinguish between the different I/O protocols used by the controller to
+	// communicate with drives. This is not necessarily the same as the physical drive interface type
+	// (PhysicalDriveType). Values: scsi, fibre, unknown, sas, sata, nvmeDirectPcie.
+	DriveType string `json:"driveType,omitempty"`
+	// This field is present only if the driveType value is DRIVE_TYPE_FIBRE. It contains the address information
+	// for a Fibre Channel drive. Such drives are typically multi-ported, so there will be one element in this
+	// variable-length array for each I/O port on the drive. The first element in the array is the drive address on
+	// the preferred channel, and the second element is the drive address on the redundant channel.
+	Fibre []FibreDriveAddress `json:"fibre,omitempty"`
+	// This field is present only if the driveType value is DRIVE_TYPE_SAS. It contains the address information for
+	// a serial-attached SCSI drive.
+	Sas SasDriveAddress `json:"sas,omitzero"`
+	// This field is present only if the driveType value is DRIVE_TYPE_SCSI. It contains the address information for
+	// a SCSI drive.
+	Scsi SCSIDriveAddress `json:"scsi,omitzero"`
+	// This field is present only if the driveType value is DRIVE_TYPE_NVME_DIRECT_PCIE. It contains the address
+	// information for NVMe drives connected to a PCIe interface.
+	Nvme NVMeDriveAddress `json:"nvme,omitzero"`
+}
+
+// DriveWearStatisticsCollection is the DriveWearStatisticsCollection model.
+type DriveWearStatisticsCollection struct {
+	// "The timestamp (in ISO-8601 format) representing the time the drive wear statistics collection was
+	// triggered."
+	CollectionTimestamp string `json:"collectionTimestamp,omitempty"`
+	// The drive wear statistics collection types.
+	CollectionTypes        []string                 `json:"collectionTypes,omitempty"`
+	SsdDriveWearStatistics []SsdDriveWearStatistics `json:"ssdDriveWearStatistics,omitempty"`
+	MSataWearStatistics    []MSataWearStatistics    `json:"mSataWearStatistics,omitempty"`
+}
+
+// DriveWearStatisticsResponse is the DriveWearStatisticsResponse model.
+type DriveWearStatisticsResponse struct {
+	// The SSD and mSata wear life statistics collections.
+	Collections []DriveWearStatisticsCollection `json:"collections,omitempty"`
+}
+
+// DriveWithHotSpareInfo is the DriveWithHotSpareInfo model.
+type DriveWithHotSpareInfo struct {
+	Capacity string `json:"capacity,omitempty"`
+	DriveRef string `json:"driveRef,omitempty"`
+	// Values: scsi, fibre, sata, pata, fibre520b, sas, sas4k, nvme4k, unknown.
+	InterfaceType string `json:"interfaceType,omitempty"`
+	// Values: hdd, ssd, unknown.
+	MediaType     string `json:"mediaType,omitempty"`
+	ProductID     string `json:"productId,omitempty"`
+	IsFdeCapable  bool   `json:"isFdeCapable,omitempty"`
+	IsFipsCapable bool   `json:"isFipsCapable,omitempty"`
+}
+
+// EKMSCommunicationResponse is the EKMSCommunicationResponse model.
+// Indicates whether the symbol command executed successfully along with the return code from the symbol command.
+type EKMSCommunicationResponse struct {
+	// Indicates if the symbol command executed successfully.
+	Successful bool `json:"successful"`
+	// The return code returned by the symbol command. Values: uninitialized, ok, error, busy, illegalParam, noHeap,
+	// driveNotExist, driveNotUnassigned, noSparesAssigned, someSparesAssigned, volumeNotExist, volumeReconfiguring,
+	// notDualActive, tryAlternate, background, notImplemented, reservationConflict, volumeDead, internalError,
+	// invalidRequest, iconFailure, volumeFormatting, altRemoved, cacheSyncFailure, invalidFile,
+	// reconfigSmallDacstore, reconfigFailure, nvramError, flashError, authFailParam, authFailPassword,
+	// memParityError, invalidControllerref, invalidVolumegroupref, invalidVolumeref, invalidDriveref,
+	// invalidFreeextentref, volumeOffline, volumeNotOptimal, modesenseError, invalidSegmentsize,
+	// invalidCacheblksize, invalidFlushThreshold, invalidFlushAmount, invalidLabel, invalidCacheModifier,
+	// invalidReadahead, invalidReconpriority, invalidScanperiod, invalidTrayposLength, invalidRegionid,
+	// invalidFibreid, invalidEncryption, invalidRaidlevel, invalidExpansionList, noSparesDeassigned,
+	// someSparesDeassigned, partDupId, partLabelInvalid, partNodeNonexistent, partPortIdInvalid,
+	// partVolumeNonexistent, partLunCollision, maxVolMappingExceeded, partMappingNonexistent, partNoHostports,
+	// imageTransferred, fileTooLarge, invalidOffset, overrun, invalidChunksize, invalidTotalsize,
+	// downloadNotPermitted, spawnError, voltransferError, invalidDlstate, cacheconfigError, downloadInProgress,
+	// driveNotOptimal, driveRemoved, duplicateDrives, numdrivesAdditional, numdrivesGroup, driveTooSmall,
+	// capacityConstrained, maxVolumesExceeded, partIsUtmLun, someSparesTooSmall, sparesSmallUnassigned,
+	// tooManyPartitions, parityScanInProgress, invalidSafeId, invalidSafeKey, invalidSafeCapability,
+	// invalidSafeVersion, partitionsDisabled, driveDownloadFailed, esmDownloadFailed, esmPartialUpdate,
+	// utmConflict, noVolumes, authFailReadpassword, partCrteFailTblFull, attemptToSetLocal, invalidHostTypeIndex,
+	// failVolumeVisible, noDeleteUtmInUse, invalidLun, utmTooManyMaps, diagReadFailure, diagSrcLinkDown,
+	// diagWriteFailure, diagLoopbackError, diagTimeout, diagInProgress, diagNoAlt, diagIconSendErr, diagInitErr,
+	// diagModeErr, diagInvalidTestId, diagDriveErr, diagLockErr, diagConfigErr, diagNoCacheMem, diagNotQuiesced,
+	// diagUtmNotEnabled, invalidModeSwitch, invalidPortname, duplicateVolMapping, maxSnapsPerBaseExceeded,
+	// maxSnapsExceeded, invalidBasevol, snapNotAvailable, notDisabled, snapshotFeatureDisabled, repositoryOffline,
+	// repositoryReconfiguring, rollbackInProgress, numVolumesGroup, ghostVolume, repositoryMissing,
+	// invalidRepositoryLabel, invalidSnapLabel, invalidRollbackPriority, invalidWarnThreshold, cannotMapVolume,
+	// cannotFormatVolume, dstNotFibre, repositoryTooSmall, repositoryFailed, baseVolumeFailed, baseVolumeOffline,
+	// baseVolumeFormatting, metadataVolNonexistent, rvmFeatureDisabled, mirrorsPresent, rvmFeatureDeactivated,
+	// maxMirrorsExceeded, invalidMirrorCandidateVol, invalidMirrorvol, metadataAlreadyExists, metadataMissing,
+	// metadataOffline, metadataReconfiguring, localRoleChangeFailed, remoteRoleChangeFailed,
+	// localRoleChangeSuccessful, onlyLocalMirrorDeleted, noValidMirrorCandidate, remoteMaxMirrorsExceeded,
+	// remoteRvmFeatureDisabled, remoteMetadataVolNonexistent, notRegistered, remoteInvalidCfgGen,
+	// localRoleChangedNotForced, remoteRoleChangedLocalFailed, rvmSpmError, remoteAuthFailPassword,
+	// rvmVersionMismatch, rvmRemoteArrayError, rvmCommunicationError, rvmFibreError, mirrorVolNotPrimary,
+	// secNotPromoteable, priNotDemoteable, metadataChildDeletion, rmtvolOrphanDeletion, rvmActivateDisallowed,
+	// invalidTrayref, partialDeletion, defaultUtmCollision, invalidCopyPriority, invalidVolumecopyref,
+	// copyChangeFailed, copyActive, copyInactive, copyIncompatibleSource, copyIncompatibleTarget, copyGhostSource,
+	// copyGhostTarget, copyInvalidSourceRef, copyInvalidTargetRef, copyInvalidSourceState, copyInvalidTargetState,
+	// copySourceReconfig, copyTargetReconfig, copyTargetTooSmall, copyTargetLimit, maxVolumeCopysExceeded,
+	// copySourceReservation, copyTargetReservation, copySourceFormat, copyTargetFormat, copyStartFailed,
+	// copyStopFailed, volcopyFeatureDisabled, writeLock, cannotReconfigure, authFailContLockout,
+	// prReservationConflict, regDeleteFailed, batteryNotInConfig, batteryMissing, noChannel,
+	// rvmOperNotAllowedOnSec, dataRedundancyRequired, copySourceZeroCapacity, invHostlunDefineMapping,
+	// invHostlunMoveMapping, invHostlunDefineHosttype, invHostlunMoveHostport, fwIncompatible,
+	// mirrorAlreadySuspended, insuffLocalMirRepResources, insuffRemtMirRepResources, ghostHasUnreadableSectors,
+	// rvmCommStatRecoveredTimeout, rvmCommStatRecoveredDelay, rvmCommStatNotReady, rvmCommStatTimeout,
+	// rvmCommStatChannelFailure, rvmCommStatNetworkFailure, rvmCommStatDeviceMissing, rvmCommStatLoginRejected,
+	// rvmCommStatLoginFailure, rvmCommStatInvNumSamplesReqd, rvmQuiescenceInProgress, rvmInvalidRemotevol,
+	// sodInProgress, invalidDrives, invalidSetid, invalidSetsize, missingData, quiescenceFailed, validationError,
+	// downloadHalted, allFailed, partialOk, obsolete, usmClearFailed, controllerInServiceMode, invalidDrive,
+	// databaseError, backgroundAutocfg, autocfgInprogress, unsupportedLhaSataEsm, parityScanFailed,
+	// parityRepairFailed, mediaRepairFailed, mirrorDegraded, prohibitedByMdtRestrictions,
+	// prohibitedByGoldKeyRestrictions, safeControllerNotSubjectToGoldKey, safeMdtNotPremiumFeature,
+	// alarmNotPresent, dltNotCompleted, dependancyError, cdmDatabaseFull, requiredConditionNotPresent, ddcUnavail,
+	// ddcIllegalParam, invalidDdcTag, hosttypeConflict, portConflict, invalidHosttypeString, invalidProtocol,
+	// portRemoved, disableNotPermitted, prohibitedByDriveTrayLimit, invalidEsmref, invalidBundleMigration,
+	// invalidBundleKey, noSparesNeeded, prohibitedByFeatureBundleViolation, invalidAuthMethod, invalidSecret,
+	// secretAlreadyInUse, manualConfigModeSet, noIscsiSessions, invalidInterfaceref, initiatorConflict,
+	// initiatorRemoved, basevolSizeChanged, volumeGroupNotExist, volumeGroupNotOnline, volumeGroupHasHotspare,
+	// volumeGroupReconfiguring, volumeGroupStateNotValid, controllerNotOptimal, insufficientCapacity,
+	// volumeGroupExported, volumeNotConfigurable, volumeGroupNotConfigurable, invalidDriveState,
+	// volumeGroupReconstructing, volumeGroupUndergoingCopyback, volumeGroupNotComplete, volumeGroupHasFailedDrives,
+	// volumeGroupHasNonOptimalVols, volumeGroupHasMirrorRelationship, volumeGroupHasVolcopyRelationship,
+	// volumeGroupHasMirroringMetadata, volumeGroupHasMappedVols, volumeGroupHasReservations,
+	// volumeGroupHasIncompatibleDacstores, volumeLimitExceeded, volumeGroupHasUnknownRaidLevel,
+	// volumeGroupHasUnsupportedRaidLevel, volumeGroupHasCloneOpportunity, volumeGroupHasInsufficientDrives,
+	// volumeGroupHasFailedVols, perfTierSafeUpgradeDisabled, raid6FeatureUnsupported, raid6FeatureDisabled,
+	// safeControllerNotSubjectToRaid6, volumeGroupNotContingent, channelDiagsRunning, channelDiagsResultsPartial,
+	// volumeGroupHasSnapshotRelationship, prohibitedBySafeViolation, legacyVg, vgNotForceable, channelDiagsLockErr,
+	// channelDiagsNotQuiesced, channelDiagsAltCommFailed, channelDiagsChanSetupFailed,
+	// channelDiagsDeviceBypassFailed, channelDiagsResultsNotAvailable, driveSpinUpError, driveTypeMismatch,
+	// localRemoteArrayHasSameWwn, volumeGroupHasIncompatibleDrive, volumeGroupVolumeEncroachesOnDacstore,
+	// volumeGroupImportInProgress, drivesNeedToBeSpunUp, noNativeSstor, noSuchDebugChunk, debugInfoConfigChanged,
+	// lockdown, drivesDacstoresOverlap, volumeHasAsyncMirror, reconfigLogSpaceError, volumeGroupInaccessible,
+	// volumeInitializing, insufficientCache, volumeInaccessible, noDrivesAdopted, someDrivesAdopted,
+	// exportingDrivesDatabaseResynchronizing, exportingDrivesDatabaseFailed, exportingDrivesQuiesced,
+	// learnActiveTryLater, noLockedDrives, driveSecurityEnabledFailed, lockkeyFailed, invalidSecurity, noFdeDrives,
+	// volumeGroupSecure, invalidBlob, unlockFailed, noKeySet, rekeyInProgress, defaultHostGroupMappingNotAllowed,
+	// ssdMediaScanNotAllowed, premiumFeatureLimitExceedsMaximum, disableEvaluationFeatureNotPermitted,
+	// requestFailedDueToLun0Restrictions, externalKmsEnabled, externalKmsFailed, externalKmsNotEnabled,
+	// keyNotNeeded, keyInvalidSequence, diagNotRunning, ctrlNotInServiceMode, invalidFeatureref,
+	// cacheBackupDevNotExist, noMatchingLockKeyIdFound, lockKeyValidationFailed, lockKeyValidationDisabled,
+	// externalKmsNotCompliant, externalKmsTimeout, cannotDisableNoKey, previouslyEnabledForEval, featureNotKeyable,
+	// evalNotSupported, rawdataTransferBadType, rawdataTransferNotStarted, rawdataTransferAlreadyStarted,
+	// rawdataTransferPreparing, rawdataTransferReadError, rawdataTransferNoDrives, rawdataTransferInvalidImage,
+	// rawdataTransferCrcError, dbmRestoreWriteError, dbmRestoreNoDrives, rawdataBadSeqNum, invalidCapability,
+	// externalKeyNotInMemory, invalidLockKeyId, invalidProtection, volumeHasSnapshotRelationship,
+	// volumeHasMirrorRelationship, externalKmsDisabledNoKey, dbmRestoreAltCtlNotOffline, copyApptagMismatch,
+	// invalidRequestForEnclosure, dqRetrieveNothingToTransfer, invalidIscsiConfiguration,
+	// volumeHasVolcopyRelationship, partPiIncapable, requestFailedDueToPiRestrictions,
+	// rawdataTransferUserCancelled, duplicateIscsiIpAddress, portSpeedConflict, factoryDefaultDownloadFailed,
+	// errorWritingToEeprom, factoryDefaultPartialUpdate, snapshotNotActive, cannotRollback, mirrorSyncNotPossible,
+	// psuFirmwareDownloadFailed, psuFirmwareUpdateMfgDeviceCodeMismatch, psuFirmwareUpdateNotAllRedundant,
+	// psuFirmwareUpdateNotAllOptimal, insufficientRepositoryCapacity, rollbackStartFailure, csbReserveFailed,
+	// csbReleaseFailedNoLock, csbReleaseFailedInvalidKey, flashcacheAlreadyExists, flashcacheFeatureDisabled,
+	// flashcacheAlreadySuspended, flashcacheNotSuspended, flashcacheInvalidConfigType, invalidPitGroupLabel,
+	// invalidPitConsistencyGroupLabel, invalidPitAutoDeleteLimit, invalidPitRepositoryFullPolicy,
+	// invalidConcatVolMemberLabel, concatVolMemberTooSmall, invalidPitGroupRef, invalidPitRef, dveNotAllowed,
+	// dssNotAllowed, dplCoreDumpInvalidTag, invalidPitViewLabel, invalidPitViewRef, invalidConcatVolRef,
+	// notFlashcacheVol, flashcacheDeleted, flashcacheEnabled, flashcacheNotEnabled, noRepDeletion,
+	// maxPitsPerGroupExceeded, maxPitsExceeded, maxPitGroupsPerBaseExceeded, maxPitGroupsExceeded,
+	// maxViewsPerPitExceeded, maxViewsExceeded, maxConsistencyGroupsExceeded, maxConsistencyGroupMembersExceeded,
+	// maxMappableVolumesExceeded, notOldestPit, viewStopped, concatMemberLimitExceeded, invalidMemberVol,
+	// memberVolMapped, invalidMemberVolState, invalidTrimCount, pitGroupInConsistencyGroup, pitInConsistencyGroup,
+	// pitViewInConsistencyGroup, incompatibleMemberVol, volumeInUse, rvmOverIscsiNotSupported,
+	// arvmGroupUserLabelExists, arvmGroupDoesNotExist, arvmGroupNotEmpty, concatVolumeFailed,
+	// invalidPitConsistencyGroupRef, invalidPitConsistencyGroupViewRef, invalidPitConsistencyGroupViewLabel,
+	// alternateRequiredForOperation, invalidPitForView, consistencyGroupArvmBindingConflict, attributeFixedByArvm,
+	// operationFailedVolumeCopyClone, pitCreatePending, dbmDbSourceUnavailable, dbmRestoreSourceMismatch,
+	// invalidCriticalThreshold, volumeGroupHasArvmRelationship, arvmRecoveryPointDeletionRequired,
+	// volumeGroupHasPitgroupRelationship, volumeGroupHasPitviewRelationship, volumeGroupHasConcatRelationship,
+	// flashcacheSuspended, flashcacheAlreadyEnabled, dbmDbImageCorrupt, illegalVolume, invalidRepositoryCapacity,
+	// invalidProvisionedCapacityQuota, invalidExpansionPolicy, invalidVirtualCapacity, cannotExpandConcatMember,
+	// thresholdBelowUsedCapacity, invalidExpansionOperation, repositoryFull, insufficientExpansionSpace,
+	// invalidExpansionSize, invalidReinitAction, invalidReinitCapacity, invalidIncompleteMemberRef,
+	// arvmGroupNotPrimary, arvmGroupNotSecondary, arvmMemberFailed, arvmGroupNotSuspended, arvmInvalidMirrorState,
+	// arvmVolumeAlreadyInMirrorRelationship, arvmMemberLimitExceeded, arvmSuspendFailure, arvmResumeFailure,
+	// arvmSynchronizeFailure, remoteTargetNotFound, arvmMirrorMemberDoesNotExist, snapConversionTooManySnaps,
+	// snapConversionMissingLabel, arvmFeatureDeactivated, incompatibleRepositorySecurity,
+	// incompatibleSecondarySecurity, mirrorProtocolMismatch, arvmAsyncMirrorGroupPresent,
+	// cacheParametersNotChangeable, flashcacheMaxCapacityExceeded, flashcacheFailed, dplCoreDumpRestoreInProgress,
+	// arvmGroupHasIncompleteMember, arvmConnectivityTestAlreadyInProgress, arvmConnectivityTestNetworkError,
+	// arvmConnectivityTestRemoteTimeout, arvmConnectivityTestLoginFailure, arvmConnectivityTestNameServiceError,
+	// arvmConnectivityTestTurError, arvmConnectivityTestMissingRemoteAmg, arvmConnectivityTestAmgMemberMismatch,
+	// invalidSyncPriority, invalidRecoveryPointAlertThreshold, invalidSyncAlertThreshold,
+	// mustSpecifyExistingVolumes, arvmConnectivityTestTimeoutExceeded, flashcacheMaxLimitExceeded,
+	// volsInVgUsingNonSecureCapableFlashcache, volsInVgUsingSecureDisabledFlashcache, invalidSubmodelId,
+	// premiumFeatureLimitMismatch, volumeGroupNotImportable, primaryCacheSizeMismatch, flashcacheUserLabelExists,
+	// maxThinVolumesExceeded, arvmInvalidSecondaryCapacity, arvmOnlyPrimaryMemberRemoved,
+	// arvmOnlySecondaryMemberRemoved, arvmInvalidAmgRequestWhileSuspended, arvmManualSyncAlreadyInProgress,
+	// arvmManualSyncRetryTooSoon, diskPoolNotEmpty, flashCacheInvalidBaseVol, flashCacheFdeEnablementDisallowed,
+	// remoteArvmFeatureDeactivated, remoteArvmFeatureDisabled, arvmOrphanGroup, arvmOrphanMember,
+	// volumeNotAvailable, volumeHasUnreadableSectors, thinProvisioningFeatureDisabled, pitGroupsFeatureDisabled,
+	// exceedDiskPoolLimit, flashcacheDegradedState, flashcacheNonDaCapableDriveDisallowed,
+	// arvmMaxAsyncMirrorGroupsExceeded, arvmMaxMirrorsPerArrayExceeded, maxTotalMirrorsPerArrayExceeded,
+	// exceedDiskPoolCapacity, exceedMaxVolumeCapacity, arvmRemoteMaxAsyncMirrorGroupsExceeded,
+	// arvmRemoteMaxMirrorsPerArrayExceeded, remoteMaxTotalMirrorsPerArrayExceeded, arvmInvalidSyncInterval,
+	// remoteNoHeap, remoteInternalError, remoteRvmSpmError, arvmRemoteMirrorMemberDoesNotExist,
+	// arvmRemoteGroupUserLabelExists, arvmRemoteGroupNotSecondary, arvmRemoteGroupDoesNotExist,
+	// remoteInvalidProtection, remoteDatabaseError, arvmRemoteGroupNotEmpty, arvmRemoteSuspendFailure,
+	// arvmRemoteResumeFailure, arvmRemoteSynchronizeFailure, flashcacheInvalidAnalyticsState,
+	// arvmExpansionSynchronizationInProgress, arvmRemoteExpansionSynchronizationInProgress,
+	// faultConditionStillExists, remoteTryAlternate, arvmOnlyLocalAmgDeleted, arvmRoleChangePending,
+	// arvmRoleChangeInProgress, arvmMemberStopped, reconstructionInProgress, copybackInProgress,
+	// adminPasswordNotSet, keyDoesNotExist, takeRecoveryActionsFirst, coredumpBackupInProgress,
+	// legacyRvmAsyncModeUnsupported, arvmIncorrectVolumeType, thinVolumeParametersCannotBeModified,
+	// arvmRemoteThinNotSupported, snmpInvalidCommunityName, snmpInvalidCommunityPermission,
+	// snmpInvalidCommunityRef, snmpInvalidTrapDestinationRef, invalidIpAddress, snmpMaxCommunitiesExceeded,
+	// snmpMaxTrapDestinationsExceeded, snmpCommunityNameInUse, snmpTrapDestinationAddressInUse,
+	// snmpUnknownSystemVariable, snmpInvalidSystemVariableValue, snmpIncompatibleFirmware, snmpAgentDisabled,
+	// snmpAgentInitFailed, arvmThinVolInitError, arvmRemoteThinVolInitError, snmpIncompatibleIpv4Address,
+	// snmpIncompatibleIpv6Address, drivesNotAvailableForRemoval, snmpCannotDisableIpv4, snmpCannotDisableIpv6,
+	// snmpIpv4ConfigError, iocDumpInProgress, iocRestoreInProgress, iocDumpInvalidTag, unsupportedEsmRequest,
+	// isnsDhcpNotSupported, dpcVolumeGroupNotRedundant, dpcVolumeNotInitialized, dpcExclusiveOperationActive,
+	// dpcUnableToPowerUpDrive, dpcFormatActive, dpcUnreadableSectorsPresent, dpcPowerCycleAlreadyInProgress,
+	// dpcEnclosureHardwareUnsupported, dpcEnclosureFwDownlevel, evacInProgress, noEvacFound, noHotspareAvailable,
+	// driveServiceInProgress, hdd4kbSegmentsizeNotAllowed, diskPoolNoSpareDrives, diskPoolExceedSpareCapacity,
+	// autoLoadBalanceUserDisabled, autoLoadBalanceInsufficientStatistics, invalidLoadBalanceAction,
+	// invalidLoadBalanceDelay, reservedAddress, volumeCreationInProgress, keyValueTagInvalidRef,
+	// keyValueTagInvalidDuplicate, keyValueTagInUse, workloadInvalidRef, invalidKeyValueTagObjectReference,
+	// mappingInvalidDuplicate, downloadCompleteNoReboot, downloadCompleteMswOnlyReboot, workloadInvalidDuplicate,
+	// mappingInvalidRef, workloadInUse, embeddedExternalKeyManagementEnabled,
+	// embeddedExternalKeyManagementCertificatesNotInstalled, illegalOperationOnAllConfigDrives,
+	// databaseResyncInProgress, embeddedEkmsKeyserverInfoMissing, embeddedEkmsClientKeyMissing,
+	// embeddedEkmsClientCertMissing, embeddedEkmsClientCertMismatch, embeddedEkmsCertExpired,
+	// embeddedEkmsProxyNotResponding, embeddedEkmsAuthenticationFailure, embeddedEkmsKeyOwnerRequired,
+	// embeddedEkmsServerError, embeddedEkmsServerCertMissing, embeddedEkmsCertDuplicate, embeddedEkmsCertInvalid,
+	// embeddedEkmsConnectionFailure, invalidPasswordLength, certRevoked, missingSignature, sigValidationFailed,
+	// unableToConnectToOcspResponder, requestFailedDueToAlbEnabled, driveLacksPathRedundancy,
+	// driveSecurityErasePsidFailed, driveSecurityEraseInvalidPsid, driveDulbeIncompatible,
+	// setControllerTimeInvalidNtpEnabled, remoteTargetVolumeFeatureDisabled, remoteTargetVolumesOverallMaxExceeded,
+	// remoteTargetGroupDoesNotExist, remoteTargetGroupsOverallMaxExceeded, remoteTargetGroupNotEmpty,
+	// remoteTargetGroupNotFound, remoteTargetVolumeNotFound, remoteTargetVolumeConnectionFailure,
+	// essErrSecretKeyCollision, essErrSecretKeyUnknown, essErrSecretKeyInvalid, incompatibleBlockSizes,
+	// invalidBlockSize, essErrSecretDataSizeInvalid, essErrSecretGroupSizeInvalid, essErrSecretInUse,
+	// essErrSecretGroupNotFound, ioTransportSecretValidationDisabled, alternateLockdown, speedFecModeConflict,
+	// remoteTargetGroupNotOptimal, snmpInvalidUsmUserEngineIdType, snmpInvalidUsmUserEngineId,
+	// snmpInvalidUsmUserName, snmpInvalidUsmUserPermission, snmpInvalidUsmUserSecurityLevel,
+	// snmpInvalidUsmUserAuthProtocol, snmpInvalidUsmUserAuthPassword, snmpInvalidUsmUserPrivProtocol,
+	// snmpInvalidUsmUserPrivPassword, snmpInvalidUsmUserRef, snmpCommunityExists, snmpUsmUserExists,
+	// snmpMaxUsmUsersExceeded, snmpMissingUsmUserEngineId, snmpMissingUsmUserPrivPassword,
+	// snmpMissingUsmUserAuthPassword, snmpUsmUserEngineIdExists, externalKmsOldKeyNotDeleted,
+	// targetPortLunMappingUnsupported, diskPoolUseOnly.
+	ReturnCode string `json:"returnCode"`
+}
+
+// ESMInterfaceTypeData is the ESMInterfaceTypeData model.
+type ESMInterfaceTypeData struct {
+	// This enumeration defines the different I/O interface types that may be reported as part of the configuration
+	// information associated with a controller. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib, fcoe,
+	// nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	IoInterfaceType string `json:"ioInterfaceType,omitempty"`
+	// A list of detailed information for each port.
+	PortList PortList `json:"portList,omitzero"`
+}
+
+// EmbeddedCompatibilityCheckResponse is the EmbeddedCompatibilityCheckResponse model.
+// Reports the compatibility of the firmware/nvsram file and whether a signature is present on the file
+type EmbeddedCompatibilityCheckResponse struct {
+	// Indicates if the initial signature validation test passed or not. Full validation will still be performed by
+	// the controller before the download can complete.
+	SignatureTestingPassed bool `json:"signatureTestingPassed"`
+	// If the file is compatible
+	FileCompatible bool `json:"fileCompatible"`
+	// Indicates whether the download is a downgrade
+	Downgrade bool `json:"downgrade"`
+	// Indicates whether the download is an advanced downgrade. The CLI or Rest API must be used to perform an
+	// advanced downgrade. It is recommended to contact your Technical Support Engineer before attempting an
+	// advanced downgrade
+	AdvancedDowngrade bool `json:"advancedDowngrade"`
+	// Enumerates all the versions on the bundle/nvsram file and what is on the controller
+	VersionContents []VersionContent `json:"versionContents"`
+}
+
+// EmbeddedExternalKMSInformation is the EmbeddedExternalKMSInformation model.
+type EmbeddedExternalKMSInformation struct {
+	// The list of KMS Certificate Files currently installed on the storage array. Only one certificate of each type
+	// can ever be installed at a time although not necessarily each possible type will be installed. The specific
+	// certificates that need to be installed will depend on the KMIP server, how it is configured, and the CA used
+	// to sign certificates. The signed client certificate will always be necessary.
+	InstalledKMSCertificateFile []string `json:"installedKMSCertificateFile,omitempty"`
+	// This field has been deprecated and should not be used.
+	KmsEndpoint ExternalKMSEndpoint `json:"kmsEndpoint,omitzero"`
+	// The external key management server endpoint information.
+	KmsEndpointList []ExternalKMSEndpoint `json:"kmsEndpointList,omitempty"`
+}
+
+// EmbeddedSnmpAgentDefaultsResponse is the EmbeddedSnmpAgentDefaultsResponse model.
+// The embedded SNMP agent defaults.
+type EmbeddedSnmpAgentDefaultsResponse struct {
+	// The maximum number of communities that can be added when adding an SNMP community.
+	MaxCommunities int `json:"maxCommunities"`
+	// The maximum number of trap destinations that can be added when adding an SNMP trap destination.
+	MaxTrapDestinations int `json:"maxTrapDestinations"`
+	// The maximum length of a community name.
+	MaxCommunityNameStringLength int `json:"maxCommunityNameStringLength"`
+	// The maximum length of the user-settable system variables.
+	MaxSystemVariableStringLength int `json:"maxSystemVariableStringLength"`
+	// The default value for system variables that are settable.
+	DefaultSystemVariableValue string `json:"defaultSystemVariableValue"`
+	// The maximum number of USM users that can be added when adding a USM user.
+	MaxUsmUsers int `json:"maxUsmUsers"`
+}
+
+// EnableDisableEkmsRequest is the EnableDisableEkmsRequest model.
+// Used to enable/disable EKMS and generate new security keys
+type EnableDisableEkmsRequest struct {
+	// The passPhrase for the security key that will be created
+	PassPhrase string `json:"passPhrase"`
+	// Indicates if EKMS should be enabled, disabled or the key should be changed Values: enable, disable,
+	// changeKey, changeKeyAndDelete.
+	EkmsOption string `json:"ekmsOption"`
+	// If true, will skip creating a backup of the security key. This step can only be skipped for external key
+	// management.
+	SkipBackup *bool `json:"skipBackup,omitempty"`
+}
+
+// EnableExternalKeyServerRequest is the EnableExternalKeyServerRequest model.
+// Used to set the parameters necessary to communicate with an external key management server.
+type EnableExternalKeyServerRequest struct {
+	// This field has been deprecated and should not be used.
+	KeyServerAddress NetworkAddress `json:"keyServerAddress,omitzero"`
+	// This field has been deprecated and should not be used.
+	Port *int `json:"port,omitempty"`
+	// Array of KeyServers to send requests
+	KeyServers []KeyServer `json:"keyServers,omitempty"`
+}
+
+// EnableSshMfaRequest is the EnableSshMfaRequest model.
+type EnableSshMfaRequest struct {
+	// Settings for enabling SSH and SSH MFA.
+	EnableSshMfa []SshMfaEnablement `json:"enableSshMfa,omitempty"`
+	// Authorized SSH keys for the storage device.
+	AuthorizedKeys string `json:"authorizedKeys,omitempty"`
+}
+
+// EnableSshMfaResponse is the EnableSshMfaResponse model.
+type EnableSshMfaResponse struct {
+	// Settings for enabling SSH and SSH MFA.
+	EnableSshMfa []SshMfaEnablement `json:"enableSshMfa,omitempty"`
+	// Authorized SSH keys for the storage device.
+	AuthorizedKeys string `json:"authorizedKeys,omitempty"`
+}
+
+// EnclosureTemperatureInformation is the EnclosureTemperatureInformation model.
+// Contains the list of enclosures and associated temperature data
+type EnclosureTemperatureInformation struct {
+	ThermalSensorInfoData []ThermalSensorInfoData `json:"thermalSensorInfoData,omitempty"`
+}
+
+// EncryptedPassword is the EncryptedPassword model.
+type EncryptedPassword struct {
+	// This enumeration object is used to identify the type of encryption used for a particular operation or object.
+	// Values: pkcs5Sha1Des, unspecified, pkcs5Sha2Aes.
+	Type string `json:"type,omitempty"`
+	// The PKCS #5 data associated with the encrypted password. This field is valid only if the encryption type is
+	// ENCRYPT_PKCS5_SHA1_DES.
+	Pkcs5Data PKCS5EncryptionData `json:"pkcs5Data,omitzero"`
+	// The PKCS#5 data associated with an AES encrypted password. This field is valid only if the encryption type is
+	// ENCRYPT_PKCS5_SHA2_AES.
+	Pkcs5AesData PKCS5EncryptionData `json:"pkcs5AesData,omitzero"`
+}
+
+// Esm is the Esm model.
+type Esm struct {
+	// The reference for this physical ESM.
+	EsmRef string `json:"esmRef,omitempty"`
+	// The operational status for this ESM. Values: optimal, failed, removed, unknown, unsupported, uncertified.
+	Status string `json:"status,omitempty"`
+	// The physical location of the ESM. The parent reference in Location identifies the tray containing the ESM,
+	// and the position field is the parent-relative/like-component relative slot number of the ESM, starting at 1.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// True if the ESM does not have redundant access.
+	NonRedundantAccess bool `json:"nonRedundantAccess,omitempty"`
+	// The part number of the ESM.
+	PartNumber string `json:"partNumber,omitempty"`
+	// The serial number of the ESM.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// The date the ESM was manufactured.
+	ManufacturerDate string `json:"manufacturerDate,omitempty"`
+	// The date the ESM was manufactured.
+	Manufacturer string `json:"manufacturer,omitempty"`
+	// The field replaceable unit type of the ESM.
+	FruType string `json:"fruType,omitempty"`
+	// The firmware version of the ESM.
+	SoftwareVersion string `json:"softwareVersion,omitempty"`
+	// This field has been deprecated and should not be used.
+	EsmInterfaceData ESMInterfaceTypeData `json:"esmInterfaceData,omitzero"`
+	// The product identifier of the ESM.
+	ProductID string `json:"productID,omitempty"`
+	// The channel to the tray that is still operational (if nonRedundantAccess is True).
+	WorkingChannel int `json:"workingChannel,omitempty"`
+	// The current speed of the ESM. Values: speedUnknown, speed1gig, speed2gig, speed4gig, speed10gig, speed15gig,
+	// speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig, speed60gig, speed8gig,
+	// speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig, speed100gig, speed50gig,
+	// speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	CurrentSpeed string `json:"currentSpeed,omitempty"`
+	// The maximum speed of the ESM. Values: speedUnknown, speed1gig, speed2gig, speed4gig, speed10gig, speed15gig,
+	// speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig, speed60gig, speed8gig,
+	// speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig, speed100gig, speed50gig,
+	// speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	MaxSpeed  string `json:"maxSpeed,omitempty"`
+	Reserved1 string `json:"reserved1,omitempty"`
+	Reserved2 string `json:"reserved2,omitempty"`
+	// This field has been deprecated and should not be used.
+	FibreEsm FibreESMAddress `json:"fibreEsm,omitzero"`
+	// This field has been deprecated and should not be used.
+	RtrAttributes RTRAttributes `json:"rtrAttributes,omitzero"`
+	// Attributes that are specific to the ESM's I/O interface type.
+	EsmInterfaceAttributes EsmInterfaceAttributes `json:"esmInterfaceAttributes,omitzero"`
+	// The board ID of the ESM card.
+	BoardID string `json:"boardId,omitempty"`
+	// This field has been deprecated and should not be used.
+	FactoryDefaultsData EsmFactoryDefaultsVersionData `json:"factoryDefaultsData,omitzero"`
+	// The repair policy for the ESM component.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+	// True when ESM is trunk capable.
+	IsTrunkCapable bool `json:"isTrunkCapable,omitempty"`
+	// True only when ESM is trunk capable and cabled incorrectly, or not trunk capable but connected in trunk mode.
+	TrunkMiswire bool `json:"trunkMiswire,omitempty"`
+	// Indicates that a locate operation for the device is currently active. The device will show a visual
+	// indication to aid an operator in locating the device.
+	LocateInProgress bool `json:"locateInProgress,omitempty"`
+	// The device contains 7-segment indicators used to display the Tray Identity.
+	HasTrayIdentityIndicator bool `json:"hasTrayIdentityIndicator,omitempty"`
+	// Type of ESM or IOM. Values: unidentified, badger, devil, cyclone, polecat, otter, ermine, localDagger2, mink.
+	EsmType string `json:"esmType,omitempty"`
+	ID      string `json:"id,omitempty"`
+}
+
+// EsmFactoryDefaultsVersionData is the EsmFactoryDefaultsVersionData model.
+type EsmFactoryDefaultsVersionData struct {
+	// This field is set to true if the ESM supports version reporting and downloading of factory default settings,
+	// and false otherwise. A setting of false in this field can mean either (1) the ESM is a legacy ESM for which
+	// the version-reporting feature is unavailable or (2) the ESM is capable of supporting version-reporting, but
+	// is not running the correct firmware version to do so.
+	IsSupported bool `json:"isSupported,omitempty"`
+	// The factory default version string. The format of the string is:
+	FactoryDefaultsVersion string `json:"factoryDefaultsVersion,omitempty"`
+}
+
+// EsmFibrePortConnection is the EsmFibrePortConnection model.
+// Represents a Fibre connection between a Tray/Esm and another storageDevice component
+type EsmFibrePortConnection struct {
+	// The tray that the connection originates from
+	Tray Tray `json:"tray,omitzero"`
+	// The esm that the connection originates from
+	Esm Esm `json:"esm,omitzero"`
+	// The port that the Esm is connected to.
+	ChannelPort ChannelPort `json:"channelPort,omitzero"`
+	// The controller (if applicable) that the Esm is connected to
+	AttachedController Controller `json:"attachedController,omitzero"`
+	// Values: sas, fibre.
+	Type string `json:"type,omitempty"`
+}
+
+// EsmInterfaceAttributes is the EsmInterfaceAttributes model.
+type EsmInterfaceAttributes struct {
+	// This enumeration defines the different I/O interface types that may be reported as part of the configuration
+	// information associated with a controller. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib, fcoe,
+	// nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	IoInterfaceType string `json:"ioInterfaceType,omitempty"`
+	// This field is only present if the ioInterfaceType value is IO_IF_FC. It contains attributes that are specific
+	// to fibre channel ESMs.
+	FibreEsmAttributes FibreEsmAttributes `json:"fibreEsmAttributes,omitzero"`
+	// This field is only present if the ioInterfaceType value is IO_IF_SAS. It contains attributes that are
+	// specific serial-attached SCSI ESMs.
+	SasEsmAttributes SasEsmAttributes `json:"sasEsmAttributes,omitzero"`
+}
+
+// EsmPortConnectionResponse is the EsmPortConnectionResponse model.
+// Provides information on the connections between ESMs/Trays and other components
+type EsmPortConnectionResponse struct {
+	SasConnections   []EsmSasPortConnection   `json:"sasConnections,omitempty"`
+	FibreConnections []EsmFibrePortConnection `json:"fibreConnections,omitempty"`
+}
+
+// EsmSasPortConnection is the EsmSasPortConnection model.
+// Represents a SAS connection between a Tray/Esm and another storageDevice component
+type EsmSasPortConnection struct {
+	// The tray that the connection originates from
+	Tray Tray `json:"tray,omitzero"`
+	// The ESM that the connection originates from
+	Esm Esm `json:"esm,omitzero"`
+	// The SAS expander on the ESM
+	SasExpander SasExpander `json:"sasExpander,omitzero"`
+	// The SAS port associated with the SAS expander on the ESM
+	SasPort SasPort `json:"sasPort,omitzero"`
+	// This field is deprecated. Using channelPorts field is preferred. The channel port associated with the SAS
+	// port and expander on the ESM
+	ChannelPort ChannelPort `json:"channelPort,omitzero"`
+	// The channel ports associated with the SAS port and expander on the ESM
+	ChannelPorts []ChannelPort `json:"channelPorts,omitempty"`
+	// The SAS expander that the connection ends to
+	AttachedSasExpander SasExpander `json:"attachedSasExpander,omitzero"`
+	// This field is deprecated. Using attachedChannelPorts field is preferred. The channel port that the connection
+	// ends to
+	AttachedChannelPort ChannelPort `json:"attachedChannelPort,omitzero"`
+	// The channel ports that the connection ends to. Has a one to one correspondence with the channelPorts field.
+	AttachedChannelPorts []ChannelPort `json:"attachedChannelPorts,omitempty"`
+	// The controller (if applicable) that the connection ends to
+	AttachedController Controller `json:"attachedController,omitzero"`
+	// Values: sas, fibre.
+	Type string `json:"type,omitempty"`
+}
+
+// EthernetInterface is the EthernetInterface model.
+type EthernetInterface struct {
+	// Name of the Ethernet port, as reported by the controller.
+	InterfaceName string `json:"interfaceName,omitempty"`
+	// The channel number of this Ethernet interface.
+	Channel int `json:"channel,omitempty"`
+	// This field has been deprecated and should not be used.
+	Speed int `json:"speed,omitempty"`
+	// This field has been deprecated and should not be used.
+	IP int `json:"ip,omitempty"`
+	// An ASCII string that identifies the alias name for the interface; this name is presumed to be associated with
+	// the IP protocol address.
+	Alias string `json:"alias,omitempty"`
+	// An ASCII string representation of the globally-unique 48-bit MAC address assigned to the Ethernet interface.
+	MacAddr string `json:"macAddr,omitempty"`
+	// This field has been deprecated and should not be used.
+	GatewayIP int `json:"gatewayIp,omitempty"`
+	// This field has been deprecated and should not be used.
+	SubnetMask int `json:"subnetMask,omitempty"`
+	// This field has been deprecated and should not be used.
+	BootpUsed bool `json:"bootpUsed,omitempty"`
+	// This field has been deprecated and should not be used.
+	RloginEnabled bool   `json:"rloginEnabled,omitempty"`
+	Reserved1     string `json:"reserved1,omitempty"`
+	// Set to true if there is a configuration error.
+	SetupError bool   `json:"setupError,omitempty"`
+	Reserved2  string `json:"reserved2,omitempty"`
+	// This field has been deprecated and should not be used.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// The status of the network link for this interface. Values: none, up, down, failed.
+	LinkStatus string `json:"linkStatus,omitempty"`
+	// True if IPV4 is enabled for this interface; otherwise false.
+	Ipv4Enabled bool `json:"ipv4Enabled,omitempty"`
+	// The IPV4 address for the interface.
+	Ipv4Address string `json:"ipv4Address,omitempty"`
+	// The IPV4 subnet mask for the interface.
+	Ipv4SubnetMask string `json:"ipv4SubnetMask,omitempty"`
+	// The method by which the IPV4 address information is configured. Values: configDhcp, configStatic.
+	Ipv4AddressConfigMethod string `json:"ipv4AddressConfigMethod,omitempty"`
+	// True if IPV6 is enabled for this interface; otherwise false.
+	Ipv6Enabled bool `json:"ipv6Enabled,omitempty"`
+	// The IPV6 local address for the interface and associated data.
+	Ipv6LocalAddress IPV6AddressData `json:"ipv6LocalAddress,omitzero"`
+	// The IPV6 static routable address for the interface and associated data.
+	Ipv6PortStaticRoutableAddress IPV6AddressData `json:"ipv6PortStaticRoutableAddress,omitzero"`
+	// The set of IPV6 port routable addresses for the interface.
+	Ipv6PortRoutableAddresses []IPV6AddressData `json:"ipv6PortRoutableAddresses,omitempty"`
+	// The method by which the IPV6 address information is configured for the interface. Values: configStatic,
+	// configStateless.
+	Ipv6AddressConfigMethod string `json:"ipv6AddressConfigMethod,omitempty"`
+	// If set to true, the interface is operating in full duplex mode; otherwise, it is operating in half-duplex
+	// mode.
+	FullDuplex bool `json:"fullDuplex,omitempty"`
+	// Support speed setting for interface
+	SupportedSpeedSettings []string `json:"supportedSpeedSettings,omitempty"`
+	// Configured setting for the interface. Values: speedNone, speedAutoNegotiated, speed10MbitHalfDuplex,
+	// speed10MbitFullDuplex, speed100MbitHalfDuplex, speed100MbitFullDuplex, speed1000MbitHalfDuplex,
+	// speed1000MbitFullDuplex.
+	ConfiguredSpeedSetting string `json:"configuredSpeedSetting,omitempty"`
+	// Current speed of the interface. Values: speedUnknown, speed1gig, speed2gig, speed4gig, speed10gig,
+	// speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig, speed60gig,
+	// speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig, speed100gig,
+	// speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	CurrentSpeed string `json:"currentSpeed,omitempty"`
+	// The physical location of the Ethernet interface. The parent reference in Location identifies the physical
+	// component (e.g., controller or host card) where the interface circuitry is located, and the position field is
+	// a firmware-assigned 1-relative number signifying "1st ethernet interface relative to the parent," "2nd
+	// ethernet interface relative to the parent," etc. This "interface number" is independent of the interface's
+	// channel association.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// Can capture network traces if true.
+	IsNetworkTraceCapable bool `json:"isNetworkTraceCapable,omitempty"`
+}
+
+// EthernetInterfaceData is the EthernetInterfaceData model.
+type EthernetInterfaceData struct {
+	// Vendor-supplied about the physical part.
+	PartData VendorPartData `json:"partData,omitzero"`
+	// The Ethernet MAC address associated with the interface.
+	MacAddress string `json:"macAddress,omitempty"`
+	// If set to true, the interface is operating in full duplex mode; otherwise, it is operating in half-duplex
+	// mode.
+	FullDuplex bool `json:"fullDuplex,omitempty"`
+	// The currently configured MTU for this interface
+	MaximumFramePayloadSize int `json:"maximumFramePayloadSize,omitempty"`
+	// The speed of the interface, as currently configured by the user. This differs from the operational speed only
+	// when the speed is set to SPEED_AUTO Values: speedUnknown, speed1gig, speed2gig, speed4gig, speed10gig,
+	// speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig, speed60gig,
+	// speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig, speed100gig,
+	// speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	CurrentInterfaceSpeed string `json:"currentInterfaceSpeed,omitempty"`
+	// The maximum speed supported by the interface. Values: speedUnknown, speed1gig, speed2gig, speed4gig,
+	// speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig,
+	// speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig,
+	// speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	MaximumInterfaceSpeed string `json:"maximumInterfaceSpeed,omitempty"`
+	// The status of the network link for this interface. Values: none, up, down, failed.
+	LinkStatus string `json:"linkStatus,omitempty"`
+	// A list of speeds supported by the Ethernet interface.
+	SupportedInterfaceSpeeds []string `json:"supportedInterfaceSpeeds,omitempty"`
+	// If set to true, autoconfiguration is supported.
+	AutoconfigSupport bool `json:"autoconfigSupport,omitempty"`
+	// If true, support is provided for copper cable diagnostics.
+	CopperCableDiagnosticsSupport bool `json:"copperCableDiagnosticsSupport,omitempty"`
+	// Indicates the speed that the interface is currently operating Values: speedUnknown, speed1gig, speed2gig,
+	// speed4gig, speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig,
+	// speed30gig, speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig,
+	// speed32gig, speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	OperationalInterfaceSpeed string `json:"operationalInterfaceSpeed,omitempty"`
+	// Supported FEC Modes for the interface
+	SupportedFECModes []string `json:"supportedFECModes,omitempty"`
+	// Configured FEC Mode for the interface Values: unknown, none, fireCode, reedSolomon, auto.
+	ConfiguredFECMode string `json:"configuredFECMode,omitempty"`
+	// Current FEC Mode for the interface Values: unknown, none, fireCode, reedSolomon, auto.
+	CurrentFECMode string `json:"currentFECMode,omitempty"`
+}
+
+// EthernetInterfacePort is the EthernetInterfacePort model.
+type EthernetInterfacePort struct {
+	// The opaque data by which an instance of EthernetIoInterface is uniquely identified.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// The physical location of the Ethernet interface. The parent reference in Location identifies the physical
+	// component (e.g., controller or host card) where the interface circuitry is located, and the position field is
+	// a firmware-assigned 1-relative number signifying "1st Ethernet interface relative to the parent," "2nd
+	// Ethernet interface relative to the parent," etc. This "interface number" is independent of the interface's
+	// channel association.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// The number of the channel controlled by this interface.
+	Channel int `json:"channel,omitempty"`
+	// A reference to the channel port object associated with the channel controlled by this interface.
+	ChannelPortRef string `json:"channelPortRef,omitempty"`
+	// Information about the physical interface (e.g., Ethernet).
+	InterfaceData PhysicalInterfaceTypeData `json:"interfaceData,omitzero"`
+	// This field indicates whether or not the I/O interface is PI capable.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// Maximum one way data rate in B/s
+	OneWayMaxRate string `json:"oneWayMaxRate,omitempty"`
+	// Maximum bi-directional data rate in B/s
+	BidirectionalMaxRate string `json:"bidirectionalMaxRate,omitempty"`
+	// Can capture network traces if true.
+	IsNetworkTraceCapable bool `json:"isNetworkTraceCapable,omitempty"`
+	// The controller id.
+	ControllerID string `json:"controllerId,omitempty"`
+	// List of properties that describe the command protocol.
+	CommandProtocolProperties []CommandProtocolProperties `json:"commandProtocolProperties,omitempty"`
+	InterfaceID               string                      `json:"interfaceId,omitempty"`
+	NiceAddressID             string                      `json:"niceAddressId,omitempty"`
+	AddressID                 string                      `json:"addressId,omitempty"`
+	ID                        string                      `json:"id,omitempty"`
+}
+
+// EthernetIoInterface is the EthernetIoInterface model.
+type EthernetIoInterface struct {
+	// The opaque data by which an instance of EthernetIoInterface is uniquely identified.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// The physical location of the Ethernet interface. The parent reference in Location identifies the physical
+	// component (e.g., controller or host card) where the interface circuitry is located, and the position field is
+	// a firmware-assigned 1-relative number signifying "1st Ethernet interface relative to the parent," "2nd
+	// Ethernet interface relative to the parent," etc. This "interface number" is independent of the interface's
+	// channel association.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// The number of the channel controlled by this interface.
+	Channel int `json:"channel,omitempty"`
+	// A reference to the channel port object associated with the channel controlled by this interface.
+	ChannelPortRef string `json:"channelPortRef,omitempty"`
+	// Information about the physical interface (e.g., Ethernet).
+	InterfaceData PhysicalInterfaceTypeData `json:"interfaceData,omitzero"`
+	// This field indicates whether or not the I/O interface is PI capable.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// Maximum one way data rate in B/s
+	OneWayMaxRate string `json:"oneWayMaxRate,omitempty"`
+	// Maximum bi-directional data rate in B/s
+	BidirectionalMaxRate string `json:"bidirectionalMaxRate,omitempty"`
+	// Can capture network traces if true.
+	IsNetworkTraceCapable bool   `json:"isNetworkTraceCapable,omitempty"`
+	ID                    string `json:"id,omitempty"`
+}
+
+// Event is the Event model.
+// Base event object
+type Event struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType string `json:"eventType,omitempty"`
+}
+
+// EventControl is the EventControl model.
+type EventControl struct {
+	// If this value if equal to EVENT_DEFAULT_PRIORITY then the management client should use the priority value
+	// from the MelEntry structure when filtering events for display, otherwise this value should be used. Values:
+	// priorityDefault, priorityCritical, priorityInfo, priorityEmergency, priorityAlert, priorityError,
+	// priorityWarning, priorityNotice, priorityDebug.
+	OemPriority string `json:"oemPriority,omitempty"`
+	// This is the default value for needs attention. Its value can be overridden by oemNeedsAttention.
+	DefaultNeedsAttention bool `json:"defaultNeedsAttention,omitempty"`
+	// This is the default value for sending an alert. Its value can be overridden by oemSendAlert.
+	DefaultSendAlert bool `json:"defaultSendAlert,omitempty"`
+	// This is the default value for visibility. Its value can be overridden by oemVisibility.
+	DefaultVisibility bool `json:"defaultVisibility,omitempty"`
+	// If this value is equal to EVENT_CONTROL_ACCEPT_DEFAULT then the value of defaultNeedsAttention determines
+	// whether Needs Attention is set on the array. Otherwise, this field determines whether Needs Attention is set
+	// on the array. Values: unknown, acceptDefault, overrideToTrue, overrideToFalse.
+	OemNeedsAttention string `json:"oemNeedsAttention,omitempty"`
+	// If this value is equal to EVENT_CONTROL_ACCEPT_DEFAULT then the value of defaultSendAlert determines whether
+	// an alert will be sent. Otherwise this field determines whether an alert will be sent. Values: unknown,
+	// acceptDefault, overrideToTrue, overrideToFalse.
+	OemSendAlert string `json:"oemSendAlert,omitempty"`
+	// If this value is equal to EVENT_CONTROL_ACCEPT_DEFAULT then the value of defaultVisibility determines whether
+	// the event will be displayed. Otherwise, this field determines whether the event will be displayed. Values:
+	// unknown, acceptDefault, overrideToTrue, overrideToFalse.
+	OemVisibility string `json:"oemVisibility,omitempty"`
+	// If this value is true, a support bundle will be collected.
+	CollectSupportBundle bool `json:"collectSupportBundle,omitempty"`
+	// This flag indicates whether or not this MEL event should be marked as the reason a customer case was
+	// automatically created. It's possible that multiple MEL events could be present in the MEL log that would
+	// trigger case creation. But the first MEL event in the log that has this flag set will be tagged as the event
+	// that caused the case to be created.
+	PriorityCaseCreationTrigger bool `json:"priorityCaseCreationTrigger,omitempty"`
+}
+
+// EventObjectIdentifier is the EventObjectIdentifier model.
+// This class identifies an object in the system. It is an Object Type and an Object Key. The get is usually the
+// fields named "id" or xxxRef in the object. The type field indicates the type of object
+type EventObjectIdentifier struct {
+	// Values: unknown, volume, pool, host, lunMapping, hostGroup, thinVolume, drive, volumeCopy, pit, pitView,
+	// snapshotGroup, snapshot, accessVolume, legacySnapshot, hostType, metadataTag, managementUrl, folder,
+	// asyncMirrorGroup, asyncMirrorGroupMember, asyncMirrorGroupIncompleteMember, consistencyGroup,
+	// consistencyGroupView, fan, battery, storageSystem, controller, powerSupply, minihub, esm, drawer, hostBoard,
+	// interconnectCRU, cacheBackupDevice, tray, supportCRU, hostPort, initiator, snapshotSchedule, thermalSensor,
+	// sfp, flashCache, featureAttribute, featureState, lockKeyId, remoteVolume, mirrorVolume, vaultMirrorVolume,
+	// vaultMirrorGroup, metadataVolume, sasPort, sasExpander, channelPort, speedNegError, snmpAgentBundle,
+	// stagedFirmware, workload, ekmsCertRevocatSettings, databaseMetadata, directoryServicesDomain,
+	// kmsCertificateExpirationDetails, externalKMSEndpoint, notOnPreferredPath, remoteStorageVolume, remoteStorage,
+	// alarm.
+	Type           string   `json:"type"`
+	ID             string   `json:"id"`
+	UserFriendlyID string   `json:"userFriendlyId"`
+	ModifiedFields []string `json:"modifiedFields"`
+}
+
+// ExclusiveOperationCheck is the ExclusiveOperationCheck model.
+type ExclusiveOperationCheck struct {
+	// Values: ok, notCompleted, failedDataRetrieval, failed.
+	Result string `json:"result"`
+	// Values: melEventCheck, cntrlSyncCheck, storageDeviceAccessible, spmDatabaseVerification, validPassword,
+	// configurationDatabaseCheck, objectGraphSyncCheck, nvsramMatches, volumeGroupsComplete,
+	// controllerStatusOptimal, hotSparesInUse, failedDrivesPresent, driveCheck, missingVolumes,
+	// exclusiveOperations, dbSubRecordsValidation, driveSideShipping, nvsramDisableCfwDownloads, needsAttention,
+	// integratedHealthCheck, altCommLinkFailure, ptlDisallowDownloads.
+	Type string `json:"type"`
+	// Values: unknown, low, medium, high, fatal.
+	SeverityLevel string `json:"severityLevel"`
+	// Results data to diagnose failures
+	ResultsData       string            `json:"resultsData"`
+	VolumeRefToAction map[string]string `json:"volumeRefToAction,omitempty"`
+	Successful        bool              `json:"successful,omitempty"`
+}
+
+// ExtendedComponentLocation is the ExtendedComponentLocation model.
+type ExtendedComponentLocation struct {
+	// This enumeration object contains a list of all possible extended component location types that can be
+	// returned in a MEL entry. Values: unknown, tray, trayslot, minihub, channel, volume, pit, controller, ref,
+	// label, volumeGrp, diskPool.
+	ExtLocType string `json:"extLocType,omitempty"`
+	// This field has been deprecated and should not be used.
+	CompChannelLoc ChannelLocation `json:"compChannelLoc,omitzero"`
+	// This field has been deprecated and should not be used.
+	CompMinihubLoc MinihubLocation `json:"compMinihubLoc,omitzero"`
+	// This field is present only if the extLocType value is EXT_COMP_LOCTYPE_TRAY
+	CompTray int `json:"compTray,omitempty"`
+	// This field is present only if the extLocType value is EXT_COMP_LOCTYPE_TRAYSLOT
+	CompTraySlot TraySlot `json:"compTraySlot,omitzero"`
+	// This field has been deprecated and should not be used.
+	CompLabel string `json:"compLabel,omitempty"`
+	// This field is present only if the ExtendedComponentLocationType value is EXT_COMP_LOCTYPE_PIT.
+	PitSequence string `json:"pitSequence,omitempty"`
+	// SYMbolRef of the component or channelPort
+	CompReference string `json:"compReference,omitempty"`
+	// Controller Reference
+	ControllerReference string `json:"controllerReference,omitempty"`
+	// Label associated with the physical location of the component or port.
+	LocationLabel string `json:"locationLabel,omitempty"`
+	// This field is present only if the ExtendedComponentLocationType value is EXT_COMP_LOCTYPE_VOLUME_GRP.
+	VolumeGroupLabel string `json:"volumeGroupLabel,omitempty"`
+	// This field is present only if the ExtendedComponentLocationType value is EXT_COMP_LOCTYPE_DISK_POOL.
+	DiskPoolLabel string `json:"diskPoolLabel,omitempty"`
+}
+
+// ExtendedSAData is the ExtendedSAData model.
+type ExtendedSAData struct {
+	Reserved1 string `json:"reserved1,omitempty"`
+	// This is a list of versions for the various firmware components (RAID, NVSRAM, hypervisor, ....). The list
+	// contains an entry for every code module applicable to the current platform.
+	CodeVersions []VersionDescriptor `json:"codeVersions,omitempty"`
+}
+
+// ExternalCertChangeEvent is the ExternalCertChangeEvent model.
+type ExternalCertChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType       string `json:"eventType"`
+	StorageSystemID string `json:"storageSystemId"`
+}
+
+// ExternalKMSEndpoint is the ExternalKMSEndpoint model.
+type ExternalKMSEndpoint struct {
+	// The IP or domain address of the key management server
+	KmsAddress NetworkAddress `json:"kmsAddress,omitzero"`
+	// The port number to be used for communicating KMIP requests with the key management server.
+	KmsPort int `json:"kmsPort,omitempty"`
+}
+
+// ExternalKeyManagerCSR is the ExternalKeyManagerCSR model.
+// This class describes the input for a Certificate Signing Request needed to create a client side certificate for
+// External Key Manager integration
+type ExternalKeyManagerCSR struct {
+	// Typically pre-populated with the storage array name
+	CommonName string `json:"commonName"`
+	// The full legal name of the organization
+	OrganizationName string `json:"organizationName"`
+	// The division of the organization handling the certificate
+	OrganizationalUnitName string `json:"organizationalUnitName,omitempty"`
+	// City or Locality for the organization
+	LocalityName string `json:"localityName"`
+	// The full name of the state or province for the organization
+	StateProvinceName string `json:"stateProvinceName,omitempty"`
+	// The two letter country ISO code for the organization
+	CountryName string `json:"countryName"`
+}
+
+// FactoryDefaultsVersionData is the FactoryDefaultsVersionData model.
+type FactoryDefaultsVersionData struct {
+	// The factory default version string
+	FactoryDefaultsVersion string `json:"factoryDefaultsVersion,omitempty"`
+	// This field is set to true when version reporting is supported and factory default settings may be downloaded;
+	// otherwise it is set to false.
+	IsSupported bool `json:"isSupported,omitempty"`
+}
+
+// FailureData is the FailureData model.
+// A failure currently active on the storage system
+type FailureData struct {
+	// The type of failure Values: unknownFailure, failedFan, failedPowerSupply, failedMinihub, failedEsm,
+	// batteryNearExpiration, failedBattery, nominalTempExceeded, maxTempExceeded, upsOnBattery, nonPreferredPath,
+	// memParityError, failedDriveScsiChannel, degradedVolume, failedVolumeInterruptedWrite, failedModifyingVolume,
+	// failedModifyingMultiRaid, failedVolume, failedVolumeMultiRaid, impairedVolume, failedVolumeAwaitingInit,
+	// volumeHotSpareInUse, offlineVolumeGroup, failedDrive, impendingDriveFailureRiskHigh,
+	// impendingDriveFailureRiskMed, impendingDriveFailureRiskLow, uncertifiedDrive, offlineCtl, passiveCtl,
+	// partitionsNotCompliant, esmCodeVersionMismatch, lostRedundancyDrive, lostRedundancyTray, lostRedundancyEsm,
+	// trayidMismatch, trayidConflict, failedTransceiverModule, channelMiswire, nonFruBatteryNearExpiration,
+	// nonFruFailedBattery, rpaErrCtl, repositoryOverThreshold, repositoryFull, snapshotFailed, unsupportedMinihub,
+	// esmMiswire, ghostVolume, snapshotNotCompliant, rvmNotCompliant, metadataOffline, mirrorDualPrimary,
+	// mirrorDualSecondary, mirrorUnsynchronized, remoteNoLun, remoteNoArray, remoteNoFabric, remoteWwnChangeFailed,
+	// fanUnknownStat, powerSupplyUnknownStat, esmUnknownStat, tempSensorUnknownStat, transModUnknownStat,
+	// driveBypassedSpeedMismatch, driveBypassedCauseUnknown, minihubSpeedMismatch, removedEsm, removedBattery,
+	// nonFruRemovedBattery, volcopyFailed, volcopyNotCompliant, channelDegraded, unsupportedTray, speedNegError,
+	// usmDatabaseFull, usmUnreadableSectorsExist, pathDegraded, netSetupError, mismatchedDriveType, ctlMismatch,
+	// failedDiscreteLine, channelFailed, linkSpeedDetectionMismatch, uncertifiedEsm, removedFan,
+	// removedPowerSupply, removedTempSensor, removedIccCru, removedSupportCru, failedIccCru, failedSupportCru,
+	// batteryUnknownStat, driveTraysNotGroupedTogether, goldKeyNotCompliant, mismatchedMdtSettings,
+	// mismatchedGoldKeySettings, ctlMiswire, supportCruNoinput, submodelNotSupported, submodelNotSet,
+	// submodelMismatch, failedBatterySystem, removedBatteryPack, batteryConfigMismatch, esmHardwareMismatch,
+	// hostBoardFault, ddcAvailable, replacedDriveWrongType, redundantPsRequired, driveTraysNotCompliant,
+	// sasDeviceLimitExceeded, sasPortMiswired, sasPortDegraded, sasPortFailed, corruptVpdEeprom, failedI2cBus,
+	// enclosureMisconfigured, featureBundleNotCompliant, driveBypassedSinglePort, esmFactoryDefaultsMismatch,
+	// hostBoardUnknownStat, failedHostIoCard, batteryWarn, volumeGroupPartiallyComplete, volumeGroupIncomplete,
+	// volumeGroupMissing, hotspareDriveMissing, driveIncompatibleUprevDacstore, driveIncompatibleDownrevDacstore,
+	// iccCruUnknownStat, removedAlarm, supportCruUnknownStat, performanceTierNotCompliant, raid6NotCompliant,
+	// driveIncompatibleSectorSize, foreignDriveInconsistent, foreignDriveRefersToNativeDrive,
+	// nativeVgRefersToForeignDrive, nativeVgForeignDriveMutualRef, vgCloned, foreignDriveHasInconsistentRole,
+	// vgDrivePartOfMultipleVgs, incompatibleFailedLegacyDrive, unsupportedCacheMemorySize,
+	// dedicatedMirrorChannelFailed, vgHasDrivePartOfMultipleVgs, ddfDriveOtherVendor,
+	// multipleConfigDatabasesDetected, adoptionFailedRaidLevelUnsupported, dbAdoptionHardLimitExceeded,
+	// legacyVgNotOptimal, cacheMemSizeMismatch, altCtlrBoardIdUnreadable, cacheBackupDeviceFailed,
+	// cacheBackupDeviceWriteProtected, cacheBackupDeviceInsufficientCapacity, expiredBattery,
+	// expiredIntegratedBattery, procMemTooSmallForCache, batteryOvertemp, invalidHostTypeIndex,
+	// insufficientProcMemory, ctlFailedCacheBackupDev, removedController, degradedHostIoCard, ldFwVersionMismatch,
+	// ldIncompatibleDatabase, hostBoardIdMismatch, iccMissing, linkSpeedMismatch, unsupportedHostBoard,
+	// writebackCachingDisabled, securityGetKey, securityKeyInconsistent, securityNotCompliant,
+	// mixedDriveEnclosureMiswire, unsuccessIsolationRedunMismatch, thresExcdedMismatchCorrected, inactiveHostPort,
+	// inactiveInitiator, protectionInformationNotCompliant, protectionInformationNotSupported,
+	// replacedInsufficientDriveCapacity, drawerFailed, drawerOpened, ssdNotCompliant, ssdAtEndOfLife,
+	// fibreTrunkMiswire, fibreTrunkIncompatibleEsm, driveSlotLimitNotCompliant, securityGetNewKey,
+	// externalKmsNotCompliant, sbbValidationFailure, invalidPowerSupply, enclosureThermalShutdown,
+	// wbCachingForciblyDisabled, driveUnsupportedProtocolConnection, failedTwiBus, mismatchedMidplaneEeproms,
+	// driveUnsupportedCapacity, evaluationLicenseExpirationImminent, externalKmsKeyInvalid,
+	// multipleMismatchedKeyIdsFound, securityKeyValidationLock, cacheDataLoss, baseControllerDiagFailed,
+	// featureNotCompliant, driveIncompatiblePiType, drawerDegraded, offlineCtlIocFail,
+	// invalidSataFlashConfiguration, iocDiagFail, sasPhyDisabledLocalWidePortDegraded,
+	// sasPhyDisabledSharedWidePortDegraded, drawerInvalid, drawerRemoved, driveUnsupportedInterposerFwVersion,
+	// redundancyGroupNotConsistentDuringReconfig, snapshotRollbackPaused, pitRollbackPaused,
+	// pitGroupRepositoryOverThreshold, pitViewRepositoryOverThreshold, pitGroupRepositoryFull,
+	// pitViewRepositoryFull, pitGroupFailed, pitViewFailed, pitPurged, arvmDegradedMirrorGroup, arvmFailedMirror,
+	// arvmSyncIntervalTimeOverThreshold, arvmRepositoryOverWarnThreshold, arvmMirrorGroupRoleConflict,
+	// incompatibleVolumeGroupSecurity, arvmOrphanGroup, arvmOrphanMember, arvmMirrorGroupRecoveryPointLost,
+	// diskPoolPartiallyPresent, diskPoolIncomplete, diskPoolMissing,
+	// diskPoolReconstructionDriveCountBelowThreshold, diskPoolUtilizationWarning, diskPoolUtilizationCritical,
+	// pendingPitCreationFailed, pendingCgpitCreationFailed, tpvRepositoryOverThreshold, tpvRepositoryFull,
+	// tpvFailed, arvmSecondaryRepositoryFull, arvmSyncInternallySuspended, databaseRecoveryMode,
+	// arvmPrimaryRepositoryFull, incompatibleSataDrive, diskPoolCapacityDepleted,
+	// driveIncompatibleModelNumberUnsupported, flashCacheNonOptimalDrives, flashCacheHotSpareInUse,
+	// allDrivesBypassedIncompatibleNvsram, diskPoolDriveFailure, diskPoolInsufficientMemory,
+	// arvmOrphanIncompleteMember, arvmSyncPausedAltState, arvmRoleChangePaused,
+	// driveIncompatibleAlignmentForEmulationDrive, lossOfExternalRedundancy, rvmWriteModeInconsistent,
+	// sasHostMiswire, sasCrossMiswire, sasLoopMiswire, copyThenFailWaitingOnHotSpare, missingDriveLockdown,
+	// hicConfigurationOoc, copyThenFailWaitingOnDdpCapacity, piErrorServiceMode, piErrorLockdown,
+	// sasPortDiscoveryError, netNtpResolutionFail, netNtpQueryFail, netNtpServiceUnavailable,
+	// multipathConfigurationError, hostRedundancyLost, excessiveRebootsDetected, cacheNotFlushedOnOnlyCtlr,
+	// securityAuditLogFull, securityAuditLogWarnThresh, directoryServicesConfigError,
+	// kmipServerCertificateNearingExpiration, kmipServerCertificateExpired, kmipServerDnsError,
+	// kmipServerCertificateError, kmipServerAuthenticationFailure, kmipServerConnectionFailure, kmipServerError,
+	// kmipServerKeyRequirementNotMet, ocspResponderUnreachable, certificateRevoked, ssdDataLoss,
+	// driveFastFormatFailed, driveFastFormatSuspendNoDatabase, slowInterruptedWriteLogging, driveLostPrimaryPath,
+	// nvmeDriveSpeedError, driveUserAssignmentFailed, cacheBackupDeviceAtEndOfLife, cbaSignatureFailed,
+	// nvsramMismatch, sasPathNonOptimal, auxAltCommLinkError, altCommLinkError, rtgLinkDown, rtvLinkDown,
+	// diskPoolInvalidVersion, driveFwUnverified.
+	FailureType string `json:"failureType,omitempty"`
+	// The object that has the failure
+	ObjectRef string `json:"objectRef,omitempty"`
+	// The type of object described in objectData Values: unknown, volume, pool, host, lunMapping, hostGroup,
+	// thinVolume, drive, volumeCopy, pit, pitView, snapshotGroup, snapshot, accessVolume, legacySnapshot, hostType,
+	// metadataTag, managementUrl, folder, asyncMirrorGroup, asyncMirrorGroupMember,
+	// asyncMirrorGroupIncompleteMember, consistencyGroup, consistencyGroupView, fan, battery, storageSystem,
+	// controller, powerSupply, minihub, esm, drawer, hostBoard, interconnectCRU, cacheBackupDevice, tray,
+	// supportCRU, hostPort, initiator, snapshotSchedule, thermalSensor, sfp, flashCache, featureAttribute,
+	// featureState, lockKeyId, remoteVolume, mirrorVolume, vaultMirrorVolume, vaultMirrorGroup, metadataVolume,
+	// sasPort, sasExpander, channelPort, speedNegError, snmpAgentBundle, stagedFirmware, workload,
+	// ekmsCertRevocatSettings, databaseMetadata, directoryServicesDomain, kmsCertificateExpirationDetails,
+	// externalKMSEndpoint, notOnPreferredPath, remoteStorageVolume, remoteStorage, alarm.
+	ObjectType string `json:"objectType,omitempty"`
+	// The data for the object, equivalent to fetching the object via its URL
+	ObjectData Serializable `json:"objectData,omitzero"`
+	// Supporting data used to debug the failure. This will vary by failureType
+	ExtraData Serializable `json:"extraData,omitzero"`
+}
+
+// Fan is the Fan model.
+type Fan struct {
+	// The reference for this physical fan.
+	FanRef string `json:"fanRef,omitempty"`
+	// The operational status of the fan Values: optimal, failed, removed, unknown.
+	Status string `json:"status,omitempty"`
+	// The physical location of the fan. The parent reference in Location identifies the CRU that houses the fan,
+	// and the position field is a firmware-assigned 1-relative number signifying "1st fan relative to parent CRU,"
+	// 2nd fan relative to parent CRU." etc.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	Reserved1        string   `json:"reserved1,omitempty"`
+	Reserved2        string   `json:"reserved2,omitempty"`
+	// This field has been deprecated and should not be used.
+	RtrAttributes RTRAttributes `json:"rtrAttributes,omitzero"`
+	// The repair policy for the fan component.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+	ID           string       `json:"id,omitempty"`
+}
+
+// FeatureAttribute is the FeatureAttribute model.
+type FeatureAttribute struct {
+	// This enumeration identifies the defined feature attributes. Values: shippedEnabled, shippedLimit, keyEnabled,
+	// keyLimit, supportedValues, subjectToGoldKey, evalDuration.
+	FeatureAttrID string `json:"featureAttrId,omitempty"`
+	// The boolean value for a "KEY ENABLED" attribute. This field is only present if featureAttrId is equal to
+	// FEATURE_ATTR_KEY_ENABLED.
+	KeyEnabled bool `json:"keyEnabled,omitempty"`
+	// The integer value for a "KEY LIMIT" attribute. This field is only present if featureAttrId is equal to
+	// FEAUTRE_ATTR_KEY_LIMIT.
+	KeyLimit int `json:"keyLimit,omitempty"`
+	// The boolean value for a "SHIPPED ENABLED" attribute. This field is only present if featureAttrId is equal to
+	// FEATURE_ATTR_SHIPPED_ENABLED.
+	ShippedEnabled bool `json:"shippedEnabled,omitempty"`
+	// The integer value for a "SHIPPED LIMIT" attribute. This field is only present if featurAttrId is equal to
+	// FEATURE_ATTR_SHIPPED_LIMIT.
+	ShippedLimit int `json:"shippedLimit,omitempty"`
+	// This field has been deprecated and should not be used.
+	SubjectToGoldKey bool `json:"subjectToGoldKey,omitempty"`
+	// The set of string values for a "SUPPORTED VALUES" attribute. This field is only present if featureAttrId is
+	// equal to FEATURE_ATTR_SUPPORTED_VALUES.
+	SupportedValues []string `json:"supportedValues,omitempty"`
+	// An unsigned integer representing the number of days for the "EVALUATION DURATION" attribute. This field is
+	// only present if featureAttrId is equal to FEATURE_ATTR_EVAL_DURATION.
+	EvaluationDuration int `json:"evaluationDuration,omitempty"`
+}
+
+// FeatureBundle is the FeatureBundle model.
+type FeatureBundle struct {
+	// The name of the feature bundle.
+	Name string `json:"name,omitempty"`
+	// A variable-length collection of BundledFeature instances, one for every feature comprising the bundle.
+	BundledFeatures []BundledFeature `json:"bundledFeatures,omitempty"`
+	// The submodel Identifier associated with the feature bundle.
+	BundleSubmodelID string `json:"bundleSubmodelId,omitempty"`
+}
+
+// FeatureParams is the FeatureParams model.
+type FeatureParams struct {
+	// This field has been deprecated and should not be used.
+	MaxHotSpares int `json:"maxHotSpares,omitempty"`
+	// A variable-length array in which each element specifies one of the supported cache block sizes that can be
+	// set on the controller. Cache block sizes are reported in bytes.
+	CacheBlockSizes []int `json:"cacheBlockSizes,omitempty"`
+	// The maximum number of drives that can be added, in a given operation, to an existing volume group to expand
+	// the capacity of the volume group.
+	MaxDCEDrives int `json:"maxDCEDrives,omitempty"`
+	// The maximum multiplier value that can be specified when configuring cache the read-ahead parameter for a
+	// volume. (Realize, however, that the volume read-ahead parameter is no longer treated as a quantify, but as a
+	// simple on (non-zero) / off (zero) indicator.
+	MaxReadAheadMultiplier int `json:"maxReadAheadMultiplier,omitempty"`
+	// The minimum supported period over which a complete media scan can be set to occur (measured in days).
+	MinMediaScanPeriod int `json:"minMediaScanPeriod,omitempty"`
+	// The maximum supported period over which a complete media scan can be set to occur (measured in days).
+	MaxMediaScanPeriod int `json:"maxMediaScanPeriod,omitempty"`
+	// A variable-length array in which each element specifies one of the supported segment size values, in bytes.
+	// This array should be consulted prior to attempting to create a volume modify a volume's segment size via the
+	// startVolumeSegmentSizing procedure.
+	SupportedSegSizes []int `json:"supportedSegSizes,omitempty"`
+	// The number of standard segment size values (at beginning of list).
+	NumStandardSegSizes int `json:"numStandardSegSizes,omitempty"`
+	// A variable-length array in which each element specifies one of the supported segment size transition options.
+	// The user of the SYMbol interface should consult this list to determine a valid new segment size for a volume
+	// before attempting to use the startVolumeSegmentSizing procedure.
+	SegTransitions []string `json:"segTransitions,omitempty"`
+	// The maximum number of LUN addresses that are available for volume mapping operations. The range of supported
+	// LUN values is thus 0 through mappableLUNCoun-1.
+	MappableLUNCount int `json:"mappableLUNCount,omitempty"`
+	// The number of partitions supported by the controller.
+	MaxPartitionCount int `json:"maxPartitionCount,omitempty"`
+	// The number of mappings allowed per volume.
+	MaxMappingsPerVolume int `json:"maxMappingsPerVolume,omitempty"`
+	// The maximum number of standard volumes allowed per array.
+	MaxVolumes int `json:"maxVolumes,omitempty"`
+	// This field has been deprecated and should not be used.
+	MaxSnapshots int `json:"maxSnapshots,omitempty"`
+	// This field has been deprecated and should not be used.
+	MaxSnapshotsPerBase int `json:"maxSnapshotsPerBase,omitempty"`
+	// The minimum size of a repository volume in bytes.
+	MinRepositorySize int `json:"minRepositorySize,omitempty"`
+	// The maximum number of mirrored volumes allowed per array assuming full size repositories.
+	MaxMirrors int `json:"maxMirrors,omitempty"`
+	// The maximum number of volume copies allowed per array.
+	MaxVolCopys int `json:"maxVolCopys,omitempty"`
+	// The maximum number of background copies that can be active at any one time. This maximum number applies to
+	// the entire array, not just one controller.
+	MaxConcurrentBackgroundCopys int `json:"maxConcurrentBackgroundCopys,omitempty"`
+	// The maximum value a UTM LUN can be set to.
+	MaxUtmLunValue int    `json:"maxUtmLunValue,omitempty"`
+	Reserved1      string `json:"reserved1,omitempty"`
+	Reserved2      string `json:"reserved2,omitempty"`
+	// The NVSRAM setting for optional controller battery.
+	Battery_not_in_configuration bool `json:"battery_not_in_configuration,omitempty"`
+	// A list of supported drive types.
+	SupportedDriveTypes []string `json:"supportedDriveTypes,omitempty"`
+	// The maximum number of mirrored volumes allowed per array for existing repositories.
+	CurrentMaxMirrors int `json:"currentMaxMirrors,omitempty"`
+	// The maximum number of volumes allowed per volume group.
+	MaxVolumesPerGroup int `json:"maxVolumesPerGroup,omitempty"`
+	// The maximum number of drive download files permitted.
+	MaxDriveDownloadFiles int `json:"maxDriveDownloadFiles,omitempty"`
+	// The maximum number of records allowed in the client data storage area.
+	MaxMgmtClientRecords int `json:"maxMgmtClientRecords,omitempty"`
+	// The maximum record size of a client data record.
+	MaxMgmtClientRecordSize int `json:"maxMgmtClientRecordSize,omitempty"`
+	// Indicates whether the system is Simplex or Duplex. Values: unknown, duplex, simplex.
+	RedundancyMode string `json:"redundancyMode,omitempty"`
+	// The maximum number of expansion drive trays allowed for this storage array.
+	MaxExpansionDriveTrays int `json:"maxExpansionDriveTrays,omitempty"`
+	// The iSCSI authentication methods supported by the storage array.
+	SupportedAuthMethods []string `json:"supportedAuthMethods,omitempty"`
+	// Max number of iSCSI IPV6 local addresses
+	MaxIscsiIpv6LocalAddressesPerPort int `json:"maxIscsiIpv6LocalAddressesPerPort,omitempty"`
+	// Max number of iSCSI IPV6 routable addresses
+	MaxIscsiIpv6RoutableAddressesPerPort int `json:"maxIscsiIpv6RoutableAddressesPerPort,omitempty"`
+	// The global upper bound, expressed in bytes, on the new segment size that may be requested on the
+	// startVolumeSegmentSizing procedure.
+	AbsoluteMaxDssSegmentSize int `json:"absoluteMaxDssSegmentSize,omitempty"`
+	// This field is set to true if the storage array uses smart battery technology, and false otherwise.
+	SmartBatteryTechnology bool `json:"smartBatteryTechnology,omitempty"`
+	// This field, which is only applicable if smartBatteryTechnology is true, is set to true if the charge level on
+	// a smart battery unit is expected to remain above the minimum level needed to support write caching.
+	WriteCachingEnabledDuringLearnCycle bool `json:"writeCachingEnabledDuringLearnCycle,omitempty"`
+	// The maximum number of SSDs supported on the array. If SSDs are not supported this value is 0.
+	MaxSSDsSupported int `json:"maxSSDsSupported,omitempty"`
+	// True if different SSD interface types (e.g. Fibre Channel, SAS) can be used in the same volume group.
+	SsdInterfaceTypeMixingSupported bool `json:"ssdInterfaceTypeMixingSupported,omitempty"`
+	// The maximum number of drive slots supported on the array.
+	MaxDriveSlots int `json:"maxDriveSlots,omitempty"`
+	// The protection types supported by the storage array.
+	SupportedProtectionTypes []string `json:"supportedProtectionTypes,omitempty"`
+	// An array listing the MEL event priorities that are configured for use in the current controller firmware
+	// build.
+	SupportedEventPriorities []string `json:"supportedEventPriorities,omitempty"`
+	// The maximum flash cache repository size measured in bytes.
+	MaxFlashCacheSize string `json:"maxFlashCacheSize,omitempty"`
+	// The default media scan period stored in the controller's NVSRAM. Units are days.
+	DefaultMediaScanPeriod int `json:"defaultMediaScanPeriod,omitempty"`
+	// Max number of NVMe ROCEv2 IPV6 local addresses
+	MaxNvmeofRoceV2Ipv6LocalAddressesPerPort int `json:"maxNvmeofRoceV2Ipv6LocalAddressesPerPort,omitempty"`
+	// Max number of NVMe ROCE IPV6 routable addresses
+	MaxNvmeofRoceV2RoutableAddressesPerPort int `json:"maxNvmeofRoceV2RoutableAddressesPerPort,omitempty"`
+	// The maximum cache pool ID allowed to be assigned to a volume, based on the number of cache pools supported by
+	// the controller.
+	MaxCachePoolID int `json:"maxCachePoolID,omitempty"`
+	// The maximum number of external key servers.
+	MaxExternalKeyServers int `json:"maxExternalKeyServers,omitempty"`
+	// Maximum allowed network trace duration in seconds.
+	MaxAllowedNetworkTraceDurationSeconds int `json:"maxAllowedNetworkTraceDurationSeconds,omitempty"`
+	// Maximum allowed network trace size (before compression) in MB (million bytes, not MiB).
+	MaxAllowedNetworkTraceSizeMB int `json:"maxAllowedNetworkTraceSizeMB,omitempty"`
+	// Maximum allowed packetLengthBytes in StartNetworkTraceDescriptor.
+	MaxAllowedNetworkTracePacketLengthBytes int `json:"maxAllowedNetworkTracePacketLengthBytes,omitempty"`
+	// Network trace packet size (in bytes) suggested for truncating most customer data while retaining most (but
+	// not all) iSCSI debug information. The best value varies depending on the network, but this represents a
+	// cautious, general starting point. For IO interfaces, the customer should be advised that small slices of
+	// customer data in flight can be captured.
+	SuggestedNetworkTracePacketLengthBytes int `json:"suggestedNetworkTracePacketLengthBytes,omitempty"`
+	// This field indicates approximately how long a trace will be available to download after the trace completes.
+	// Shortly after this duration the trace is automatically deleted.
+	NetworkTraceAvailabilityMinutes int `json:"networkTraceAvailabilityMinutes,omitempty"`
+}
+
+// FeatureState is the FeatureState model.
+type FeatureState struct {
+	// This field will contain the value of the premium feature being described. Values: none, sharedVolume,
+	// storagePoolsTo4, mixedRaidlevel, autoCodeSync, autoLunTransfer, subLunsAllowed, storagePoolsTo8,
+	// storagePoolsTo2, storagePoolsToMax, storagePoolsTo64, storagePoolsTo16, snapshots, remoteMirroring,
+	// volumeCopy, stagedDownload, mixedDriveTypes, goldKey, driveTrayExpansion, bundleMigration, storagePoolsTo128,
+	// storagePoolsTo256, raid6, performanceTier, storagePoolsTo32, storagePoolsTo96, storagePoolsTo192,
+	// storagePoolsTo512, remoteMirrorsTo16, remoteMirrorsTo32, remoteMirrorsTo64, remoteMirrorsTo128,
+	// snapshotsPerVolTo4, snapshotsPerVolTo8, snapshotsPerVolTo16, snapshotsPerVolTo2, secureVolume,
+	// protectionInformation, ssdSupport, driveSlotLimitTo112, driveSlotLimitTo120, driveSlotLimitTo256,
+	// driveSlotLimitTo448, driveSlotLimitTo480, driveSlotLimitToMax, driveSlotLimit, driveSlotLimitTo12,
+	// driveSlotLimitTo16, driveSlotLimitTo24, driveSlotLimitTo32, driveSlotLimitTo48, driveSlotLimitTo60,
+	// driveSlotLimitTo64, driveSlotLimitTo72, driveSlotLimitTo96, driveSlotLimitTo128, driveSlotLimitTo136,
+	// driveSlotLimitTo144, driveSlotLimitTo180, driveSlotLimitTo192, driveSlotLimitTo272, fdeProxyKeyManagement,
+	// remoteMirrorsTo8, driveSlotLimitTo384, driveSlotLimitTo300, driveSlotLimitTo360, flashReadCache,
+	// storagePoolsType2, remoteMirroringType2, totalNumberOfArvmMirrorsPerArray, totalNumberOfPitsPerArray,
+	// totalNumberOfThinVolumesPerArray, driveSlotLimitTo240, snapshotsType2, targetPortLunMapping,
+	// containerBasedApplication.
+	Capability string `json:"capability,omitempty"`
+	// A true value in this field indicates that the feature is enabled (regardless of compliance)
+	IsEnabled bool `json:"isEnabled,omitempty"`
+	// A true value in this field indicates that the feature has been purchased (in compliance). A false value
+	// indicates that the feature has not been purchased. The user will receive warning messages indicating that
+	// they are not in compliance. The warning message will continue until the feature is purchased or the feature
+	// is disabled.
+	IsCompliant bool `json:"isCompliant,omitempty"`
+	// This field has been deprecated and should not be used.
+	IsWithinLimits bool `json:"isWithinLimits,omitempty"`
+	// This field contains the value of the feature ID associated with the feature. Values: volumesPerPartition,
+	// totalNumberOfVolumes, storagePartitions, snapshot, volumeCopy, remoteMirroring, driveTrayExpansion,
+	// mixedDriveTypes, mgmtApplication, supportedDrives, supportedDriveTrays, performanceTier,
+	// totalNumberOfSnapshots, totalNumberOfVolCopies, goldKey, snapshotsPerVolume, totalNumberOfMirrors, raid6,
+	// stateCapture, sataStrLen, secureVolume, protectionInformation, solidStateDisk, driveSlotLimit,
+	// fdeProxyKeyManagement, supportedInterposer, vendorSupportedDrives, flashReadCache,
+	// totalNumberOfAsyncMirrorGroups, totalNumberOfAsyncMirrorsPerGroup, totalNumberOfArvmMirrorsPerArray,
+	// totalNumberOfPitsPerArray, pitGroupsPerVolume, totalNumberOfPitGroups, pitsPerPitGroup,
+	// memberVolsPerPitConsistencyGroup, totalNumberOfPitConsistencyGroups, totalNumberOfPitViews,
+	// totalNumberOfThinVolumesPerArray, nativeSataDriveSupport, solidStateDiskLimit,
+	// totalNumberOfRemoteMirrorsPerArray, asup, ectSelector, embeddedSnmpOid, asupOnDemand, dacstoreCompatId,
+	// samoaHicProtocol, targetPortLunMapping, hildaBaseboardProtocol, denali2Protocol, containerBasedApplication,
+	// fibreChannelHicProtocol, trayVpdSettable, ephemeralIscsiLoginSupport, foreignVolumeCopyIn,
+	// driveFlashTechnology.
+	FeatureID string `json:"featureId,omitempty"`
+	// This field contains a reference to the feature.
+	FeatureRef string `json:"featureRef,omitempty"`
+	// A numerical description associated with this FeatureState object, describing the level or tier for this
+	// feature. A zero value represents a feature that does not support tiering. Non-zero implies a tiering level.
+	Limit int `json:"limit,omitempty"`
+	// Describes the timeframe this feature will be available. A zero value describes an infinite duration. The unit
+	// of duration is in days.
+	Duration int `json:"duration,omitempty"`
+	// Describes the time when this feature was enabled.
+	EnabledTime string `json:"enabledTime,omitempty"`
+	// The supported Feature Bundle ID. When not in use this value will be zero.
+	SupportedFeatureBundleID int `json:"supportedFeatureBundleId,omitempty"`
+	// TRUE if a permanent license for this feature has been applied. FALSE if the feature is being evaluated or is
+	// not enabled at all.
+	PermanentLicenseApplied bool `json:"permanentLicenseApplied,omitempty"`
+}
+
+// FibreChannelTargetLocation is the FibreChannelTargetLocation model.
+type FibreChannelTargetLocation struct {
+	// The Fibre Channel node name of the target,a binary 8-byte SCSI name.
+	TargetName string `json:"targetName,omitempty"`
+}
+
+// FibreDriveAddress is the FibreDriveAddress model.
+type FibreDriveAddress struct {
+	// The controller drive-side channel number used to access the drive via this interface.
+	Channel int `json:"channel,omitempty"`
+	// The drive's Fibre Channel loop ID value on the given channel.
+	LoopID int `json:"loopID,omitempty"`
+}
+
+// FibreESMAddress is the FibreESMAddress model.
+type FibreESMAddress struct {
+	// This field has been deprecated and should not be used.
+	EsmPortRef string `json:"esmPortRef,omitempty"`
+	// The loop ID of the ESM.
+	LoopID int `json:"loopID,omitempty"`
+	// A reference to the controller drive side channel port associated with this ESM.
+	PortRef string `json:"portRef,omitempty"`
+}
+
+// FibreEsmAttributes is the FibreEsmAttributes model.
+type FibreEsmAttributes struct {
+	// True if the fibre channel components use switched technology.
+	IsSwitched bool `json:"isSwitched,omitempty"`
+	// Addressing information for the fibre channel ESM.
+	FibreEsmAddress FibreESMAddress `json:"fibreEsmAddress,omitzero"`
+}
+
+// FibreInterface is the FibreInterface model.
+type FibreInterface struct {
+	// This field has been deprecated and should not be used.
+	Channel int `json:"channel,omitempty"`
+	// The FC loop ID value used by the controller on this channel.
+	LoopID int `json:"loopID,omitempty"`
+	// This field has been deprecated and should not be used.
+	Speed int `json:"speed,omitempty"`
+	// The hard (preferred) address of the interface, in loop ID format.
+	HardAddress int `json:"hardAddress,omitempty"`
+	// The unique identifier of the storage array node associated with this interface.
+	NodeName string `json:"nodeName,omitempty"`
+	// The unique identifier of the Fibre Channel port associated with this interface.
+	PortName string `json:"portName,omitempty"`
+	// The NL_Port identifier assigned to this interface.
+	PortID string `json:"portId,omitempty"`
+	// The Fibre Channel topology type being used. Values: unknown, ptp, fabric, arbLoop, fabLoop.
+	Topology string `json:"topology,omitempty"`
+	// An ASCII text string that describes the Fibre Channel controller chip type.
+	Part string `json:"part,omitempty"`
+	// The revision level of the Fibre Channel controller chip.
+	Revision int `json:"revision,omitempty"`
+	// True, if this channel is joined with another one.
+	ChanMiswire bool `json:"chanMiswire,omitempty"`
+	// True, if an ESM miswire condition exists somewhere on this channel.
+	EsmMiswire bool `json:"esmMiswire,omitempty"`
+	// The status of the fibre channel link for this interface. Values: none, up, down, failed.
+	LinkStatus string `json:"linkStatus,omitempty"`
+	// True if the channel associated with this interface is degraded.
+	IsDegraded bool `json:"isDegraded,omitempty"`
+	// The speed of the interface in MB per sec. This field is deprecated. The fieldcurrentInterfaceSpeed should be
+	// used instead. Values: unknown, fixed, switched, auto.
+	SpeedControl string `json:"speedControl,omitempty"`
+	// This field has been deprecated and should not be used.
+	MaxSpeed int `json:"maxSpeed,omitempty"`
+	// True if speed negotiation could not complete.
+	SpeedNegError bool   `json:"speedNegError,omitempty"`
+	Reserved1     string `json:"reserved1,omitempty"`
+	Reserved2     string `json:"reserved2,omitempty"`
+	// The DDS state of this drive side channel: optimal, degraded, or failed.
+	DdsChannelState int `json:"ddsChannelState,omitempty"`
+	// DDS state reason.
+	DdsStateReason int `json:"ddsStateReason,omitempty"`
+	// Which controller placed the drive side channel in this DDS state.
+	DdsStateWho int `json:"ddsStateWho,omitempty"`
+	// Set to True if there are channel ports physically attached to this controller.
+	IsLocal bool `json:"isLocal,omitempty"`
+	// This field has been deprecated and should not be used.
+	ChannelPorts []ChannelPorts `json:"channelPorts,omitempty"`
+	// The current interface speed of the Fibre Channel interface. Values: speedUnknown, speed1gig, speed2gig,
+	// speed4gig, speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig,
+	// speed30gig, speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig,
+	// speed32gig, speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	CurrentInterfaceSpeed string `json:"currentInterfaceSpeed,omitempty"`
+	// The maximum interface speed of the Fibre Channel interface. Values: speedUnknown, speed1gig, speed2gig,
+	// speed4gig, speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig,
+	// speed30gig, speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig,
+	// speed32gig, speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	MaximumInterfaceSpeed string `json:"maximumInterfaceSpeed,omitempty"`
+	// This field has been deprecated and should not be used.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// The physical location of the Fibre Channel interface. The parent reference in location identifies the
+	// physical component (e.g., controller or host card) where the interface circuitry is located, and the position
+	// field is a firmware-assigned 1-relative number signifying "1st Fibre Channel interface relative to the
+	// parent," "2nd Fibre Channel interface relative to the parent," etc. This "interface number" is independent of
+	// the interface's channel association.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// This field is true if the Fibre interface on the controller board is trunk capable. This field is always
+	// false for host-side interfaces.
+	IsTrunkCapable bool `json:"isTrunkCapable,omitempty"`
+	// True only when ESM is trunk capable and cabled incorrectly, or not trunk capable but connected in trunk mode.
+	TrunkMiswire                 bool `json:"trunkMiswire,omitempty"`
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// Maximum one way data rate in B/s
+	OneWayMaxRate string `json:"oneWayMaxRate,omitempty"`
+	// Maximum bi-directional data rate in B/s
+	BidirectionalMaxRate string `json:"bidirectionalMaxRate,omitempty"`
+	// A list containing the currently supported FC-4 types for its associated channel port.
+	Fc4Types []string `json:"fc4Types,omitempty"`
+	ID       string   `json:"id,omitempty"`
+}
+
+// FibreInterfacePort is the FibreInterfacePort model.
+type FibreInterfacePort struct {
+	// This field has been deprecated and should not be used.
+	Channel int `json:"channel,omitempty"`
+	// The FC loop ID value used by the controller on this channel.
+	LoopID int `json:"loopID,omitempty"`
+	// This field has been deprecated and should not be used.
+	Speed int `json:"speed,omitempty"`
+	// The hard (preferred) address of the interface, in loop ID format.
+	HardAddress int `json:"hardAddress,omitempty"`
+	// The unique identifier of the storage array node associated with this interface.
+	NodeName string `json:"nodeName,omitempty"`
+	// The unique identifier of the Fibre Channel port associated with this interface.
+	PortName string `json:"portName,omitempty"`
+	// The NL_Port identifier assigned to this interface.
+	PortID string `json:"portId,omitempty"`
+	// The Fibre Channel topology type being used. Values: unknown, ptp, fabric, arbLoop, fabLoop.
+	Topology string `json:"topology,omitempty"`
+	// An ASCII text string that describes the Fibre Channel controller chip type.
+	Part string `json:"part,omitempty"`
+	// The revision level of the Fibre Channel controller chip.
+	Revision int `json:"revision,omitempty"`
+	// True, if this channel is joined with another one.
+	ChanMiswire bool `json:"chanMiswire,omitempty"`
+	// True, if an ESM miswire condition exists somewhere on this channel.
+	EsmMiswire bool `json:"esmMiswire,omitempty"`
+	// The status of the fibre channel link for this interface. Values: none, up, down, failed.
+	LinkStatus string `json:"linkStatus,omitempty"`
+	// True if the channel associated with this interface is degraded.
+	IsDegraded bool `json:"isDegraded,omitempty"`
+	// The speed of the interface in MB per sec. This field is deprecated. The fieldcurrentInterfaceSpeed should be
+	// used instead. Values: unknown, fixed, switched, auto.
+	SpeedControl string `json:"speedControl,omitempty"`
+	// This field has been deprecated and should not be used.
+	MaxSpeed int `json:"maxSpeed,omitempty"`
+	// True if speed negotiation could not complete.
+	SpeedNegError bool   `json:"speedNegError,omitempty"`
+	Reserved1     string `json:"reserved1,omitempty"`
+	Reserved2     string `json:"reserved2,omitempty"`
+	// The DDS state of this drive side channel: optimal, degraded, or failed.
+	DdsChannelState int `json:"ddsChannelState,omitempty"`
+	// DDS state reason.
+	DdsStateReason int `json:"ddsStateReason,omitempty"`
+	// Which controller placed the drive side channel in this DDS state.
+	DdsStateWho int `json:"ddsStateWho,omitempty"`
+	// Set to True if there are channel ports physically attached to this controller.
+	IsLocal bool `json:"isLocal,omitempty"`
+	// This field has been deprecated and should not be used.
+	ChannelPorts []ChannelPorts `json:"channelPorts,omitempty"`
+	// The current interface speed of the Fibre Channel interface. Values: speedUnknown, speed1gig, speed2gig,
+	// speed4gig, speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig,
+	// speed30gig, speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig,
+	// speed32gig, speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	CurrentInterfaceSpeed string `json:"currentInterfaceSpeed,omitempty"`
+	// The maximum interface speed of the Fibre Channel interface. Values: speedUnknown, speed1gig, speed2gig,
+	// speed4gig, speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig,
+	// speed30gig, speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig,
+	// speed32gig, speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	MaximumInterfaceSpeed string `json:"maximumInterfaceSpeed,omitempty"`
+	// This field has been deprecated and should not be used.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// The physical location of the Fibre Channel interface. The parent reference in location identifies the
+	// physical component (e.g., controller or host card) where the interface circuitry is located, and the position
+	// field is a firmware-assigned 1-relative number signifying "1st Fibre Channel interface relative to the
+	// parent," "2nd Fibre Channel interface relative to the parent," etc. This "interface number" is independent of
+	// the interface's channel association.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// This field is true if the Fibre interface on the controller board is trunk capable. This field is always
+	// false for host-side interfaces.
+	IsTrunkCapable bool `json:"isTrunkCapable,omitempty"`
+	// True only when ESM is trunk capable and cabled incorrectly, or not trunk capable but connected in trunk mode.
+	TrunkMiswire                 bool `json:"trunkMiswire,omitempty"`
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// Maximum one way data rate in B/s
+	OneWayMaxRate string `json:"oneWayMaxRate,omitempty"`
+	// Maximum bi-directional data rate in B/s
+	BidirectionalMaxRate string `json:"bidirectionalMaxRate,omitempty"`
+	// A list containing the currently supported FC-4 types for its associated channel port.
+	Fc4Types      []string `json:"fc4Types,omitempty"`
+	ControllerID  string   `json:"controllerId,omitempty"`
+	InterfaceID   string   `json:"interfaceId,omitempty"`
+	NiceAddressID string   `json:"niceAddressId,omitempty"`
+	AddressID     string   `json:"addressId,omitempty"`
+	ID            string   `json:"id,omitempty"`
+}
+
+// FibrePortLogin is the FibrePortLogin model.
+type FibrePortLogin struct {
+	// The switch port ID.
+	SwitchPortID string `json:"switchPortId,omitempty"`
+	// The target port name.
+	TargetPortName string `json:"targetPortName,omitempty"`
+}
+
+// FibreRemoteTargetConnections is the FibreRemoteTargetConnections model.
+type FibreRemoteTargetConnections struct {
+	// Remote node name.
+	RemoteNodeWWN string `json:"remoteNodeWWN,omitempty"`
+	// Associated (visible) switch ports.
+	AssociatedSwitchPorts []string `json:"associatedSwitchPorts,omitempty"`
+	// Connected remote target ports.
+	ConnectedRemoteTargetPorts []FibrePortLogin `json:"connectedRemoteTargetPorts,omitempty"`
+}
+
+// FileInfo is the FileInfo model.
+// File name and length
+type FileInfo struct {
+	FileSize string `json:"fileSize"`
+	FileName string `json:"fileName"`
+}
+
+// FileRequest is the FileRequest model.
+// Information for requesting a file
+type FileRequest struct {
+	// Optionally used to specify the filename
+	Filename string `json:"filename,omitempty"`
+}
+
+// FirmwareCompatibilityRequest is the FirmwareCompatibilityRequest model.
+type FirmwareCompatibilityRequest struct {
+	// List of storage devices to find compatible firmware for.
+	StorageDeviceIds []string `json:"storageDeviceIds,omitempty"`
+	// Only consider released firmware builds as valid firmware files
+	ReleasedBuildsOnly *bool `json:"releasedBuildsOnly,omitempty"`
+}
+
+// FirmwareCompatibilityResponse is the FirmwareCompatibilityResponse model.
+type FirmwareCompatibilityResponse struct {
+	// True if the compatibility check is currently running
+	CheckRunning bool `json:"checkRunning"`
+	// True if the check was cancelled by a user
+	Cancelled bool `json:"cancelled"`
+	// The last time that a compatibility check was started, null if it has not been initiated yet
+	LastStart string `json:"lastStart"`
+	// The list of devices that could not be contacted
+	InaccessibleStorageDevices []string `json:"inaccessibleStorageDevices"`
+	// The list of results
+	Results []FirmwareCompatibilitySet `json:"results"`
+	// The unique identifier for this request
+	RequestID string `json:"requestId"`
+	// The list of devices that the call failed on
+	FailedStorageDevices []string `json:"failedStorageDevices"`
+}
+
+// FirmwareCompatibilitySet is the FirmwareCompatibilitySet model.
+type FirmwareCompatibilitySet struct {
+	StorageDeviceID  string `json:"storageDeviceId"`
+	StorageDeviceWWN string `json:"storageDeviceWwn"`
+	// The list of compatible controller firmware files
+	CfwFiles []FirmwarePackageMetadata `json:"cfwFiles"`
+	// The list of compatible nvsram files
+	NvsramFiles []FirmwarePackageMetadata `json:"nvsramFiles"`
+}
+
+// FirmwareDownloadEvent is the FirmwareDownloadEvent model.
+type FirmwareDownloadEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType     string `json:"eventType,omitempty"`
+	CorrelationID string `json:"correlationId,omitempty"`
+	// Status of the firmware operation Values: started, alreadyRunning, success, error, transfer_success,
+	// activate_success, informational.
+	Status string `json:"status"`
+	// String message for the status
+	StatusMessage  string `json:"statusMessage"`
+	RebootExpected bool   `json:"rebootExpected,omitempty"`
+}
+
+// FirmwarePackageMetadata is the FirmwarePackageMetadata model.
+type FirmwarePackageMetadata struct {
+	Filename string `json:"filename"`
+	Version  string `json:"version"`
+	// Values: controller, nvsram, bundle, unknown.
+	FirmwarePackageType string `json:"firmwarePackageType"`
+	UploadedDate        string `json:"uploadedDate"`
+}
+
+// FirmwareUpgradeHealthCheckResult is the FirmwareUpgradeHealthCheckResult model.
+type FirmwareUpgradeHealthCheckResult struct {
+	StorageDeviceID   string `json:"storageDeviceId"`
+	StorageDeviceName string `json:"storageDeviceName"`
+	StorageDeviceWWN  string `json:"storageDeviceWWN"`
+	ProcessingTimeMS  string `json:"processingTimeMS"`
+	// Info about test run for internal use
+	Rerun bool `json:"rerun"`
+	// Recommended recovery action for failures Values: noRecommendation, noAction, callSupport, recoveryGuru,
+	// waitLongLivedOps.
+	RecommendedRecovery string `json:"recommendedRecovery"`
+	Successful          bool   `json:"successful"`
+	Finished            bool   `json:"finished"`
+	// Verify the storage-system is contactable.
+	StorageDeviceAccessible StorageDeviceHealthCheck `json:"storageDeviceAccessible"`
+	// Validate a database internal to the controller.
+	SpmDatabaseVerification SpmDatabaseHealthCheck `json:"spmDatabaseVerification"`
+	// Validate a database internal to the controller.
+	ConfigurationDatabaseCheck ConfigurationDbValidationCheck `json:"configurationDatabaseCheck"`
+	// Verify that ObjectGraph data is synchronized between the two controllers.
+	ObjectGraphSyncCheck ObjectGraphSyncCheck `json:"objectGraphSyncCheck"`
+	// Verify there are no volume groups that are not in a full state of redundancy.
+	VolumeGroupsComplete StorageDeviceHealthCheck `json:"volumeGroupsComplete"`
+	// Verify the state of both controllers is optimal.
+	ControllerStatusOptimal StorageDeviceHealthCheck `json:"controllerStatusOptimal"`
+	// Verify that hot spares are not in use (due to drives in a non-optimal state).
+	HotSparesInUse StorageDeviceHealthCheck `json:"hotSparesInUse"`
+	// Verify that there are no missing drives/volume-groups.
+	MissingVolumes StorageDeviceHealthCheck `json:"missingVolumes"`
+	// Verify that at least one optimal drive is present
+	DriveCheck StorageDeviceHealthCheck `json:"driveCheck"`
+	// An upgrade operation cannot proceed if an exclusive operation is in progress on a volume. Examples: volume
+	// expansion, RAID type migration.
+	ExclusiveOperations ExclusiveOperationCheck  `json:"exclusiveOperations"`
+	FailedDrivesPresent StorageDeviceHealthCheck `json:"failedDrivesPresent"`
+	ValidPasswordSet    StorageDeviceHealthCheck `json:"validPasswordSet"`
+	MelCheck            MelEventHealthCheck      `json:"melCheck"`
+	// Validation of the database sub-records
+	DbSubRecordsValidation StorageDeviceHealthCheck `json:"dbSubRecordsValidation"`
+	// Validation of drive side shipping
+	DriveSideShipping StorageDeviceHealthCheck `json:"driveSideShipping"`
+	// Determines whether CFW downloads are allowed while IO is active
+	NvsramDisableCfwDownloads StorageDeviceHealthCheck `json:"nvsramDisableCfwDownloads"`
+	// Determines whether CFW downloads are allowed with critical recovery failures
+	NeedsAttentionCheck NeedsAttentionCheck `json:"needsAttentionCheck"`
+	// Integrated tests not included in other health checks
+	IntegratedHealthCheck IntegratedHealthCheck `json:"integratedHealthCheck"`
+	// Verify whether any inter-controller interfaces have link down condition
+	AltCommLinkFailure StorageDeviceHealthCheck `json:"altCommLinkFailure"`
+	// Verify whether downloads are allowed when the array is in PTL switch-over mode
+	PtlDisallowDownloads StorageDeviceHealthCheck `json:"ptlDisallowDownloads"`
+}
+
+// FlashCache is the FlashCache model.
+type FlashCache struct {
+	// The WWN of the high level flash cache volume.
+	WWN string `json:"wwn,omitempty"`
+	// A reference to the high level flash cache volume.
+	FlashCacheRef string `json:"flashCacheRef,omitempty"`
+	// Contains the base-level Flash Cache attributes pertaining to all types of Flash Caches.
+	FlashCacheBase FlashCacheBase `json:"flashCacheBase,omitzero"`
+	// Drive information for the flash cache.
+	FcDriveInfo FlashCacheDriveInfo `json:"fcDriveInfo,omitzero"`
+	ID          string              `json:"id,omitempty"`
+}
+
+// FlashCacheBase is the FlashCacheBase model.
+type FlashCacheBase struct {
+	// The user-assigned label of the flash cache.
+	Label string `json:"label,omitempty"`
+	// The status of the flash cache. Values: unknown, optimal, degraded, failed, suspended.
+	Status string `json:"status,omitempty"`
+	// The flash cache configuration type. Values: unknown, filesystem, database, multimedia.
+	ConfigType string `json:"configType,omitempty"`
+	// The flash cache analytics status. Values: unknown, stopped, started.
+	AnalyticsStatus string `json:"analyticsStatus,omitempty"`
+	// The flash cache analytics status cause. Values: none, userStopped, userFcChanges, internalCtlrRebooted,
+	// internalCfgChanges.
+	AnalyticsCause string `json:"analyticsCause,omitempty"`
+	// The flash cache status cause. Values: none, hotspareInuse, drivesInaccessible, cacheSizeMismatch,
+	// missingDrives.
+	StatusCause string `json:"statusCause,omitempty"`
+}
+
+// FlashCacheBundle is the FlashCacheBundle model.
+type FlashCacheBundle struct {
+	// A list of structures that map between the cached volumes and their associated flash cache.
+	FlashCacheMap []FlashCacheMap `json:"flashCacheMap,omitempty"`
+	// A list of flash cache objects.
+	FlashCache []FlashCache `json:"flashCache,omitempty"`
+}
+
+// FlashCacheCreateRequest is the FlashCacheCreateRequest model.
+type FlashCacheCreateRequest struct {
+	// A list of one or more drive refs belonging to SSD drives that will be utilized in the FlashCache
+	DriveRefs []string `json:"driveRefs"`
+	// The user label for the FlashCache
+	Name string `json:"name,omitempty"`
+	// If true, all existing volumes that are mapped will have flash cache enabled
+	EnableExistingVolumes *bool `json:"enableExistingVolumes,omitempty"`
+}
+
+// FlashCacheDriveInfo is the FlashCacheDriveInfo model.
+type FlashCacheDriveInfo struct {
+	// This enumeration identifies the type of flash cache. Values: unknown, readOnlyCache, writeCache, virtual.
+	FlashCacheType string `json:"flashCacheType,omitempty"`
+	// Test results are returned if the flashCacheType does not equal FC_TYPE_VIRTUAL.
+	FcWithDrives FlashCacheWithDrives `json:"fcWithDrives,omitzero"`
+}
+
+// FlashCacheEx is the FlashCacheEx model.
+type FlashCacheEx struct {
+	// The WWN of the high level flash cache volume.
+	WWN string `json:"wwn,omitempty"`
+	// A reference to the high level flash cache volume.
+	FlashCacheRef string `json:"flashCacheRef,omitempty"`
+	// Contains the base-level Flash Cache attributes pertaining to all types of Flash Caches.
+	FlashCacheBase FlashCacheBase `json:"flashCacheBase,omitzero"`
+	// Drive information for the flash cache.
+	FcDriveInfo FlashCacheDriveInfo `json:"fcDriveInfo,omitzero"`
+	// A list of references of volumes and thin volumes that are associated with this FlashCache
+	CachedVolumes []string `json:"cachedVolumes,omitempty"`
+	// The list of drives associated with this FlashCache
+	DriveRefs []string `json:"driveRefs,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	ID        string   `json:"id,omitempty"`
+}
+
+// FlashCacheMap is the FlashCacheMap model.
+type FlashCacheMap struct {
+	// A reference to the associated flash cache.
+	FlashCache string `json:"flashCache,omitempty"`
+	// A reference to the cached volume.
+	CachedVolume string `json:"cachedVolume,omitempty"`
+	// A unique identifier for the map entry.
+	FlashCacheMapRef string `json:"flashCacheMapRef,omitempty"`
+	ID               string `json:"id,omitempty"`
+}
+
+// FlashCacheUpdateRequest is the FlashCacheUpdateRequest model.
+type FlashCacheUpdateRequest struct {
+	// The new name for the FlashCache
+	Name string `json:"name,omitempty"`
+	// The usage configuration for the flashCache Values: unknown, filesystem, database, multimedia.
+	ConfigType string `json:"configType,omitempty"`
+}
+
+// FlashCacheWithDrives is the FlashCacheWithDrives model.
+type FlashCacheWithDrives struct {
+	// The security type of the drives. Values: unknown, none, capable, enabled.
+	SecurityType string `json:"securityType,omitempty"`
+	// The protection information capabilities of the drives.
+	ProtectionInformationCapabilities ProtectionInformationCapabilities `json:"protectionInformationCapabilities,omitzero"`
+	// The used capacity of the drives (in bytes).
+	UsedCapacity string `json:"usedCapacity,omitempty"`
+	// Refines the information in the securityType field to describe the set of drives. Values: unknown, none,
+	// mixed, fde, fips.
+	SecurityLevel string `json:"securityLevel,omitempty"`
+}
+
+// Folder is the Folder model.
+// Folder used to represent storage system hierarchy.
+type Folder struct {
+	// The folder id.
+	FolderID string `json:"folderId"`
+	// The parent folder's id. This may be empty.
+	ParentFolderID string `json:"parentFolderId"`
+	// The name of the folder.
+	Name string `json:"name"`
+	// Determines whether the folder can be deleted.
+	Reserved bool `json:"reserved"`
+	// The list of rules for dynamic folders. Note that rules are ANDed together.
+	Rules []Rule `json:"rules"`
+	// The list of storage system ids for non-dynamic folders.
+	StorageSystemIds []string `json:"storageSystemIds"`
+}
+
+// FolderCreateRequest is the FolderCreateRequest model.
+// Used to create a folder.
+type FolderCreateRequest struct {
+	// The optional parent folder id for nested folders.
+	ParentID string `json:"parentId,omitempty"`
+	// The folder name.
+	Name string `json:"name"`
+	// The optional list of rules used to define the folder. The rules are ANDed together and typically either rules
+	// or a list of ids are supplied.
+	Rules []Rule `json:"rules,omitempty"`
+	// Optional list of storage system to include in the folder. typically either rules or a list of ids are
+	// supplied.
+	StorageSystemIds []string `json:"storageSystemIds,omitempty"`
+}
+
+// FolderEvent is the FolderEvent model.
+type FolderEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType string `json:"eventType"`
+	// List of objects added. Only certain objects that have rest endpoints will be represented in this list. See
+	// Event ObjectTypes for types of objects that have events
+	AddedObjects []EventObjectIdentifier `json:"addedObjects"`
+	// List of objects updated. Only certain objects that have rest endpoints will be represented in this list. See
+	// Event ObjectTypes for types of objects that have events
+	ModifiedObjects []EventObjectIdentifier `json:"modifiedObjects"`
+	// List of objects deleted. Only certain objects that have rest endpoints will be represented in this list. See
+	// Event ObjectTypes for types of objects that have events
+	DeletedObjects []EventObjectIdentifier `json:"deletedObjects"`
+}
+
+// FolderUpdateRequest is the FolderUpdateRequest model.
+// Used to update a folder.
+type FolderUpdateRequest struct {
+	// The optional parent folder id for nested folders.
+	ParentID string `json:"parentId,omitempty"`
+	// The folder name.
+	Name string `json:"name,omitempty"`
+	// The optional list of rules used to define the folder. These rules are ANDed together and typically either
+	// rules or a list of ids are supplied. This must be a complete list.
+	Rules []Rule `json:"rules,omitempty"`
+	// The optional list of storage system to include in the folder. Typically either rules or a list of ids are
+	// supplied. This must be a complete list
+	StorageSystemIds []string `json:"storageSystemIds,omitempty"`
+}
+
+// FreeExtent is the FreeExtent model.
+type FreeExtent struct {
+	// The sector offset of this free extent within its volume group. This value is provided only to allow the
+	// client application to determine the relative positions of free extents and volumes within a given volume
+	// group. No other assumptions or computations should be made with this value.
+	SectorOffset string `json:"sectorOffset,omitempty"`
+	// The total usable capacity of this free extent, accounting for parity requirement based on the RAID level of
+	// the volume group. If the storage array supports dual-RAID disk pools, this value will represent the usable
+	// capacity of the free extent in the disk pool accounting for the "default" RAID6 parity requirements. An
+	// additional field (ddpRAIDCapacities) is introduced to the FreeExent object graph to provide an array of
+	// DDPRAIDCapacity objects showing the supported RAID levels in the disk pool and the usable capacities of the
+	// free extent based on those RAID levels.
+	RawCapacity string `json:"rawCapacity,omitempty"`
+	// The RAID level of this free extent, which governs the allowed configuration of volumes that can be created on
+	// it. Values: raidUnsupported, raidAll, raid0, raid1, raid3, raid5, raid6, raidDiskPool, raid1p.
+	RaidLevel string `json:"raidLevel,omitempty"`
+	// The volume group reference value of the volume group on which this free extent resides.
+	VolumeGroupRef string `json:"volumeGroupRef,omitempty"`
+	// The unique identification value for this free extent. Other objects may use this reference value to refer to
+	// the free extent.
+	FreeExtentRef string `json:"freeExtentRef,omitempty"`
+	Reserved1     string `json:"reserved1,omitempty"`
+	Reserved2     string `json:"reserved2,omitempty"`
+	// An array of DDPRAIDCapacity objects showing the supported DDPVolRAIDLevels and the usable capacities of the
+	// free extent based on those DDPVolRAIDLevels
+	DdpRAIDCapacities []DDPRAIDCapacity `json:"ddpRAIDCapacities,omitempty"`
+}
+
+// GBICParentTypeData is the GBICParentTypeData model.
+type GBICParentTypeData struct {
+	// This enumeration is used to identify the parent type of a GBIC component. Values: esm, minihub, controller.
+	GbicParentType string `json:"gbicParentType,omitempty"`
+	// This field is present only if the gbicParentType value is GBIC_PARENT_TYPE_CONTROLLER. It contains the
+	// detailed information about the controller channel.
+	ControllerGBIC ControllerGBIC `json:"controllerGBIC,omitzero"`
+	// No information is returned
+	ParentEsm string `json:"parentEsm,omitempty"`
+	// This field is present only if the gbicParentType value is GBIC_PARENT_TYPE_MINIHUB. It contains the detailed
+	// information about the minihub the GBIC belongs to.
+	ParentMinihub string `json:"parentMinihub,omitempty"`
+}
+
+// Gbic is the Gbic model.
+type Gbic struct {
+	// The reference for this physical GBIC.
+	GbicRef string `json:"gbicRef,omitempty"`
+	// The operational status of the GBIC. Values: optimal, failed, removed, unknown.
+	Status string `json:"status,omitempty"`
+	// The physical location of the GBIC. Note that the tray reference identifies the enclosure containing the GBIC,
+	// but the slot information does not apply to this component.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// This field is the discriminator value, which identifies the type of GBIC. Values: notPresent, cs12Active,
+	// cs12Passive, opticalLw, serialModule, opticalSw, opticalLwLxSingleMode, opticalSwSx.
+	GbicType string `json:"gbicType,omitempty"`
+	// This field provides information about the port that the GBIC is associated with. Values: port1, port2.
+	GbicPort string `json:"gbicPort,omitempty"`
+	// Information returned is based on the type of the GBIC parent. The data returned describes the component or
+	// controller that is associated with the GBIC.
+	ParentData GBICParentTypeData `json:"parentData,omitzero"`
+	Reserved1  string             `json:"reserved1,omitempty"`
+	Reserved2  string             `json:"reserved2,omitempty"`
+}
+
+// GenerateAccessTokenRequest is the GenerateAccessTokenRequest model.
+type GenerateAccessTokenRequest struct {
+	// Maximum amount of time in seconds that the access token may be active.
+	Duration float64 `json:"duration"`
+}
+
+// GenerateAccessTokenResponse is the GenerateAccessTokenResponse model.
+type GenerateAccessTokenResponse struct {
+	// The generated access token.
+	AccessToken string `json:"accessToken"`
+	// Amount of time in seconds that the access token may be active. This number may be less than the requested
+	// duration depending upon the maxDuration setting of the system.
+	Duration float64 `json:"duration"`
+}
+
+// GhostVolBundle is the GhostVolBundle model.
+type GhostVolBundle struct {
+	// An array of GhostVolume objects.
+	GhostVol []GhostVolume `json:"ghostVol,omitempty"`
+}
+
+// GhostVolume is the GhostVolume model.
+type GhostVolume struct {
+	// The World Wide Name of the volume.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The reference for this volume.
+	VolumeRef string `json:"volumeRef,omitempty"`
+	Reserved1 string `json:"reserved1,omitempty"`
+	Reserved2 string `json:"reserved2,omitempty"`
+}
+
+// GroupMapping is the GroupMapping model.
+type GroupMapping struct {
+	// specifies the DN of the group. The wildcard .* can optionally be used to allow specifying only a partial DN.
+	GroupRegex string `json:"groupRegex,omitempty"`
+	// Whether or not to ignore case for the group mappings
+	Ignorecase bool `json:"ignorecase,omitempty"`
+	// Specifies the role of the group Values: security.admin, none, support.admin, storage.monitor, storage.admin.
+	Name string `json:"name"`
+}
+
+// HLVolumeBundle is the HLVolumeBundle model.
+type HLVolumeBundle struct {
+	// This field has been deprecated and should not be used.
+	SnapshotVol []SnapshotVolume `json:"snapshotVol,omitempty"`
+	// An array of MirrorProxyVolume objects.
+	MirrorVol []MirrorProxyVolume `json:"mirrorVol,omitempty"`
+	// An array of MetadataVolume objects.
+	MetadataVol []MetadataVolume `json:"metadataVol,omitempty"`
+	// An array of RemoteVolume objects.
+	RemoteVol []RemoteVolume `json:"remoteVol,omitempty"`
+	// An array of VolumeCopy objects.
+	VolumeCopy []VolumeCopy `json:"volumeCopy,omitempty"`
+	// An array of PITGroup objects.
+	PitGroup []PITGroup `json:"pitGroup,omitempty"`
+	// An array of PiT objects.
+	Pit []PIT `json:"pit,omitempty"`
+	// An array of PITView objects.
+	PitView []PITView `json:"pitView,omitempty"`
+	// An array of PITConsistencyGroup objects.
+	PitConsistencyGroup []PITConsistencyGroup `json:"pitConsistencyGroup,omitempty"`
+	// An array of ConcatVolume objects.
+	ConcatVolume []ConcatVolume `json:"concatVolume,omitempty"`
+	// An array of ConcatVolumeMember objects.
+	ConcatVolMember []ConcatVolumeMember `json:"concatVolMember,omitempty"`
+	// An array of PITConsistencyGroupView objects.
+	PitConsistencyGroupView []PITConsistencyGroupView `json:"pitConsistencyGroupView,omitempty"`
+	// An array of ThinVolume objects.
+	ThinVolume []ThinVolume `json:"thinVolume,omitempty"`
+	// Async Mirror Groups.
+	AsyncMirrorGroup []AsyncMirrorGroup `json:"asyncMirrorGroup,omitempty"`
+	// Async Mirror Group Members.
+	AsyncMirrorGroupMember []AsyncMirrorGroupMember `json:"asyncMirrorGroupMember,omitempty"`
+	// "Incomplete" Async Mirror Group Members.
+	AsyncMirrorGroupIncompleteMember []AsyncMirrorGroupIncompleteMember `json:"asyncMirrorGroupIncompleteMember,omitempty"`
+	// Array of remote target groups
+	RemoteTargetGroups []RemoteTargetGroup `json:"remoteTargetGroups,omitempty"`
+	// Array of remote target volumes
+	RemoteTargetVolumes []RemoteTargetVolume `json:"remoteTargetVolumes,omitempty"`
+}
+
+// HardwareInventoryResponse is the HardwareInventoryResponse model.
+// Provides summary information for the physical components of the storage system.
+type HardwareInventoryResponse struct {
+	// A list of the disk drives in the storage system.
+	Drives []DriveEx `json:"drives"`
+	// IB Ports
+	IbPorts []IBInterfacePort `json:"ibPorts"`
+	// ISCSI Port
+	IscsiPorts []IScsiInterfacePort `json:"iscsiPorts"`
+	// Fibre Ports
+	FibrePorts []FibreInterfacePort `json:"fibrePorts"`
+	// SAS Ports
+	SasPorts []SasInterfacePort `json:"sasPorts"`
+	// The Ethernet ports
+	EthernetPorts []EthernetInterfacePort `json:"ethernetPorts"`
+	// SAS Expanders
+	SasExpanders []SasExpander `json:"sasExpanders"`
+	// Channel Ports
+	ChannelPorts []ChannelPort `json:"channelPorts"`
+	// A list of the disk trays in the storage system.
+	Trays []TrayEx `json:"trays"`
+	// A list of the disk drawers
+	Drawers []Drawer `json:"drawers"`
+	// A list of the controllers in the storage system.
+	Controllers []Controller `json:"controllers"`
+	// A list of the batteries in the storage system.
+	Batteries []BatteryEx `json:"batteries"`
+	// A list of the fans in the storage system.
+	Fans []Fan `json:"fans"`
+	// A list of the host interface cards in the storage system.
+	HostBoards []HostBoard `json:"hostBoards"`
+	// A list of the power supplies in the storage system.
+	PowerSupplies []PowerSupply `json:"powerSupplies"`
+	// The version of NVSRAM settings installed on the storage system.
+	NvsramVersion string `json:"nvsramVersion"`
+	// The list of processor memory DIMMs installed on the storage system.
+	CacheMemoryDimms []CacheMemoryDimm `json:"cacheMemoryDimms"`
+	// The list of cache backup modules installed on the storage system.
+	CacheBackupDevices []CacheBackupDevice `json:"cacheBackupDevices"`
+	// A list of the support CRUs in the storage system.
+	SupportCRUs []SupportCRU `json:"supportCRUs"`
+	// A list of the ESMs in the storage system.
+	Esms []Esm `json:"esms"`
+	// A list of the SFPs in the storage system.
+	Sfps []Sfp `json:"sfps"`
+	// A list of the thermal sensors in the storage system.
+	ThermalSensors []ThermalSensor `json:"thermalSensors"`
+}
+
+// HealthCheckFailureResponse is the HealthCheckFailureResponse model.
+// Provided as a response when the operation failed because of a failure in the health check.
+type HealthCheckFailureResponse struct {
+	// The error message.
+	ErrorMessage string `json:"errorMessage"`
+	// An optional developer message providing further error details.
+	DeveloperMessage string `json:"developerMessage"`
+	// The localized message.
+	LocalizedMessage string `json:"localizedMessage"`
+	// The value of retcode can vary by the code type. To be unique, it must be combined with code type. It is a
+	// string. Even if the returned value looks like a number, don't assume its a number. It is a short label that
+	// will not change based on Internationalization.
+	Retcode string `json:"retcode"`
+	// The API error code type Values: symbol, systemerror, devicemgrerror.
+	CodeType string `json:"codeType"`
+	// The list of invalid fields. The list will be populated with known invalid fields. In the case no invalid
+	// fields are known, the list will be empty.
+	InvalidFieldsIfKnown []string           `json:"invalidFieldsIfKnown"`
+	RequestID            string             `json:"requestId,omitempty"`
+	Result               CfwUpgradeResponse `json:"result,omitzero"`
+}
+
+// HealthCheckRequest is the HealthCheckRequest model.
+type HealthCheckRequest struct {
+	// Only run health checks on online storage-systems
+	OnlineOnly *bool `json:"onlineOnly,omitempty"`
+	// An optional list of device ids (if not provided, all available devices will be used)
+	StorageDeviceIds []string `json:"storageDeviceIds,omitempty"`
+}
+
+// HealthCheckResponse is the HealthCheckResponse model.
+// A list of requested health check results
+type HealthCheckResponse struct {
+	// The unique request id
+	RequestID string `json:"requestId"`
+	// Indicates if a health check is currently running
+	HealthCheckRunning bool `json:"healthCheckRunning"`
+	// The operation was cancelled while performing the pre-flight checks.
+	Cancelled bool `json:"cancelled"`
+	// Approximate number of devices that are still processing the health checks.
+	Remaining int                                `json:"remaining"`
+	Results   []FirmwareUpgradeHealthCheckResult `json:"results"`
+	// The time that this operation was started.
+	Start string `json:"start"`
+}
+
+// HistoricalStatsResponse is the HistoricalStatsResponse model.
+// The collection of stats that are returned from a call to get historical statistics Each list is sorted by capture
+// timetsamp (earlier to later).
+type HistoricalStatsResponse struct {
+	VolumeStats      []AnalysedVolumeStatistics        `json:"volumeStats"`
+	ControllerStats  []AnalysedControllerStatistics    `json:"controllerStats"`
+	SystemStats      []AnalysedStorageSystemStatistics `json:"systemStats"`
+	InterfaceStats   []AnalyzedInterfaceStatistics     `json:"interfaceStats"`
+	DiskStats        []AnalysedDiskStatistics          `json:"diskStats"`
+	PoolStats        []AnalyzedPoolStatistics          `json:"poolStats"`
+	WorkloadStats    []AnalyzedWorkloadStatistics      `json:"workloadStats"`
+	ApplicationStats []AnalyzedApplicationStatistics   `json:"applicationStats"`
+	Volumes          []AnalysedVolumeStatistics        `json:"volumes,omitempty"`
+}
+
+// Host is the Host model.
+type Host struct {
+	// The unique identification value for this object. Other objects may use this reference value to refer to the
+	// host.
+	HostRef string `json:"hostRef,omitempty"`
+	// The cluster reference value of the cluster in which this host resides. A NULL reference value indicates that
+	// the host has not been assigned to a cluster.
+	ClusterRef string `json:"clusterRef,omitempty"`
+	// The user-assigned, descriptive label string for the host.
+	Label string `json:"label,omitempty"`
+	// If true, indicates that I/O accesses from this host are subject to the storage array's default LUN-to-volume
+	// mappings. If false, indicates that I/O accesses from the host are subject to host-specific or
+	// cluster-specific LUN-to-volume mappings.
+	IsSAControlled bool `json:"isSAControlled,omitempty"`
+	// This field has been deprecated and should not be used.
+	ConfirmLUNMappingCreation bool `json:"confirmLUNMappingCreation,omitempty"`
+	// An integer index indicating the type of host.
+	HostTypeIndex int `json:"hostTypeIndex,omitempty"`
+	// This field is true if the host has a PI capable access method.
+	ProtectionInformationCapableAccessMethod bool `json:"protectionInformationCapableAccessMethod,omitempty"`
+	// If true, the host is capable of supporting large block formats.
+	IsLargeBlockFormatHost bool `json:"isLargeBlockFormatHost,omitempty"`
+	// Provides an indication as to whether LUN 0 is restricted (i.e., disallowed) for the associated host.
+	IsLun0Restricted bool   `json:"isLun0Restricted,omitempty"`
+	Name             string `json:"name,omitempty"`
+	ID               string `json:"id,omitempty"`
+}
+
+// HostBoard is the HostBoard model.
+type HostBoard struct {
+	// This field has been deprecated and should not be used.
+	ParentController string `json:"parentController,omitempty"`
+	// The host board's part number, from VPD.
+	PartNumber string `json:"partNumber,omitempty"`
+	// The host board's serial number, from VPD.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// The host board's vendor name, from VPD.
+	VendorName string `json:"vendorName,omitempty"`
+	// The host board's date of manufacture, from VPD.
+	ManufacturerDate string `json:"manufacturerDate,omitempty"`
+	// The field replaceable unit type string, from VPD.
+	FruType string `json:"fruType,omitempty"`
+	// The board ID of the controller's host card.
+	HostBoardID string `json:"hostBoardId,omitempty"`
+	// The host board's status. Values: unknown, optimal, needsAttention, notPresent, degraded, failed,
+	// diagInProgress.
+	Status string `json:"status,omitempty"`
+	// The host board's type. Values: typeUnknown, type4portFc, type20portSwitchedFc, type1portSas, type2portFc,
+	// type2portIscsi, type2portSas, type2portIb, type8portFc, type4portIscsi, type4portSas, type4portSasGlacier,
+	// type2portFcIscsiSamoa, type4portFcIscsiSamoa, type2portIscsiZion, type2portSasAcadia, type4portSasAcadia,
+	// type2portIbDenali, type2portFcSamoa, type4portFcSamoa, type2portIscsiSamoa, type4portIscsiSamoa,
+	// type4portSas3, type2portIb100gb, type4portEthernet25gb, type4portFc32gb, type2portEth100gb, type2portIb200gb,
+	// type2portEth200gb, typeIbAux, typeEthAux, type4portFc64gb.
+	Type string `json:"type,omitempty"`
+	// The reference for this physical host board.
+	HostBoardRef string `json:"hostBoardRef,omitempty"`
+	// The number of ports provided by the host board.
+	NumberOfPorts int `json:"numberOfPorts,omitempty"`
+	// Data that is specific to the type of host board.
+	HbTypeData HostBoardTypeData `json:"hbTypeData,omitzero"`
+	// The OEM part number of the host board.
+	OemPartNumber string `json:"oemPartNumber,omitempty"`
+	// This field has been deprecated and should not be used.
+	HostBoardControllerSlot int `json:"hostBoardControllerSlot,omitempty"`
+	// The repair policy for the host board component.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+	// The physical location of the host board. The parent reference in Location identifies the controller where the
+	// host board is physically located, and the position field is the parent-relative/like-component relative slot
+	// number of the host card, starting at 1.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	ID               string   `json:"id,omitempty"`
+}
+
+// HostBoard2PortIbTypeData is the HostBoard2PortIbTypeData model.
+type HostBoard2PortIbTypeData struct {
+	// The globally unique identifier (GUID) assigned to the host board.
+	TcaGuid string `json:"tcaGuid,omitempty"`
+	// The number of queue pairs supported by the host board.
+	QueuePairsSupported int `json:"queuePairsSupported,omitempty"`
+	// The number of completion queues supported by the host board.
+	CompletionQueuesSupported int `json:"completionQueuesSupported,omitempty"`
+	// The number of shared receive queues supported by the host board.
+	SharedReceiveQueuesSupported int `json:"sharedReceiveQueuesSupported,omitempty"`
+}
+
+// HostBoardTypeData is the HostBoardTypeData model.
+type HostBoardTypeData struct {
+	// This enumeration object is used to indicate the type of a controller's host board. Values: typeUnknown,
+	// type4portFc, type20portSwitchedFc, type1portSas, type2portFc, type2portIscsi, type2portSas, type2portIb,
+	// type8portFc, type4portIscsi, type4portSas, type4portSasGlacier, type2portFcIscsiSamoa, type4portFcIscsiSamoa,
+	// type2portIscsiZion, type2portSasAcadia, type4portSasAcadia, type2portIbDenali, type2portFcSamoa,
+	// type4portFcSamoa, type2portIscsiSamoa, type4portIscsiSamoa, type4portSas3, type2portIb100gb,
+	// type4portEthernet25gb, type4portFc32gb, type2portEth100gb, type2portIb200gb, type2portEth200gb, typeIbAux,
+	// typeEthAux, type4portFc64gb.
+	Type string `json:"type,omitempty"`
+	// Host board data for two-port InfiniBand host board. This field is only present if type is equal to
+	// HB_TYPE_2PORT_IB.
+	DualPortIbTypeData HostBoard2PortIbTypeData `json:"dualPortIbTypeData,omitzero"`
+	// If the host board type is denali with two port infiniband, return the two port ib type data struct.
+	DualPortDenaliIbTypeData HostBoard2PortIbTypeData `json:"dualPortDenaliIbTypeData,omitzero"`
+}
+
+// HostCreateRequest is the HostCreateRequest model.
+// Creates a host in the storage system
+type HostCreateRequest struct {
+	// The user-label to assign to the new host
+	Name string `json:"name"`
+	// The host type index as returned from /host-types
+	HostType HostTypeByIndex `json:"hostType,omitzero"`
+	// The host group identifier (optional)
+	GroupID string `json:"groupId,omitempty"`
+	// A list of host ports (optional)
+	Ports []HostPortCreateRequest `json:"ports,omitempty"`
+}
+
+// HostEx is the HostEx model.
+type HostEx struct {
+	// The unique identification value for this object. Other objects may use this reference value to refer to the
+	// host.
+	HostRef string `json:"hostRef,omitempty"`
+	// The cluster reference value of the cluster in which this host resides. A NULL reference value indicates that
+	// the host has not been assigned to a cluster.
+	ClusterRef string `json:"clusterRef,omitempty"`
+	// The user-assigned, descriptive label string for the host.
+	Label string `json:"label,omitempty"`
+	// If true, indicates that I/O accesses from this host are subject to the storage array's default LUN-to-volume
+	// mappings. If false, indicates that I/O accesses from the host are subject to host-specific or
+	// cluster-specific LUN-to-volume mappings.
+	IsSAControlled bool `json:"isSAControlled,omitempty"`
+	// This field has been deprecated and should not be used.
+	ConfirmLUNMappingCreation bool `json:"confirmLUNMappingCreation,omitempty"`
+	// An integer index indicating the type of host.
+	HostTypeIndex int `json:"hostTypeIndex,omitempty"`
+	// This field is true if the host has a PI capable access method.
+	ProtectionInformationCapableAccessMethod bool `json:"protectionInformationCapableAccessMethod,omitempty"`
+	// If true, the host is capable of supporting large block formats.
+	IsLargeBlockFormatHost bool `json:"isLargeBlockFormatHost,omitempty"`
+	// Provides an indication as to whether LUN 0 is restricted (i.e., disallowed) for the associated host.
+	IsLun0Restricted bool           `json:"isLun0Restricted,omitempty"`
+	Ports            []HostPort     `json:"ports,omitempty"`
+	Initiators       []Initiator    `json:"initiators,omitempty"`
+	HostSidePorts    []HostSidePort `json:"hostSidePorts,omitempty"`
+	ID               string         `json:"id,omitempty"`
+	Name             string         `json:"name,omitempty"`
+}
+
+// HostGroup is the HostGroup model.
+type HostGroup struct {
+	// The unique identification value for this object. Other objects may use this reference value to refer to the
+	// cluster.
+	ClusterRef string `json:"clusterRef,omitempty"`
+	// The user-assigned, descriptive label string for the cluster.
+	Label string `json:"label,omitempty"`
+	// If true, indicates that I/O accesses from this cluster are subject to the storage array's default
+	// LUN-to-volume mappings. If false, indicates that I/O accesses from the cluster are subject to
+	// cluster-specific LUN-to-volume mappings.
+	IsSAControlled bool `json:"isSAControlled,omitempty"`
+	// This field has been deprecated and should not be used.
+	ConfirmLUNMappingCreation bool `json:"confirmLUNMappingCreation,omitempty"`
+	// This field is true if the host has a PI capable access method.
+	ProtectionInformationCapableAccessMethod bool `json:"protectionInformationCapableAccessMethod,omitempty"`
+	// Provides an indication as to whether LUN 0 is restricted (i.e., disallowed) for the associated cluster.
+	IsLun0Restricted bool   `json:"isLun0Restricted,omitempty"`
+	ID               string `json:"id,omitempty"`
+	Name             string `json:"name,omitempty"`
+}
+
+// HostGroupCreateRequest is the HostGroupCreateRequest model.
+// Creates a host group in the storage system
+type HostGroupCreateRequest struct {
+	// The user-label to assign to the new host group.
+	Name string `json:"name"`
+	// List of hostRefs to assign to the HostGroup
+	Hosts []string `json:"hosts,omitempty"`
+}
+
+// HostGroupUpdateRequest is the HostGroupUpdateRequest model.
+// Creates a host group in the storage system
+type HostGroupUpdateRequest struct {
+	// The user-label to assign to the new host group.
+	Name string `json:"name,omitempty"`
+	// List of hostRefs to add to the HostGroup
+	Hosts []string `json:"hosts,omitempty"`
+}
+
+// HostIoCardLocation is the HostIoCardLocation model.
+type HostIoCardLocation struct {
+	// The tray ID of the controller that houses the host card.
+	ControllerTray int `json:"controllerTray,omitempty"`
+	// The slot number of the controller that houses the host card.
+	ControllerSlot int `json:"controllerSlot,omitempty"`
+	// The number of the slot on the controller where the host I/O card (a.k.a., host board) resides. Host I/O card
+	// slots are numbered starting at one, independent of other types of slots (e.g., cache backup device slots) on
+	// the controller.
+	HostIoCardControllerSlot int `json:"hostIoCardControllerSlot,omitempty"`
+}
+
+// HostMoveRequest is the HostMoveRequest model.
+type HostMoveRequest struct {
+	// The identifier of the host group to move the host under. If null, move the host to the default group.
+	GroupID string `json:"groupId,omitempty"`
+}
+
+// HostPort is the HostPort model.
+type HostPort struct {
+	// The unique identification value for this object. Other objects may use this reference value to refer to the
+	// host port.
+	HostPortRef string `json:"hostPortRef,omitempty"`
+	// This field has been deprecated and should not be used.
+	HostPortName string `json:"hostPortName,omitempty"`
+	// The user-assigned, descriptive label string for the host port.
+	Label string `json:"label,omitempty"`
+	// The host reference value of the host in which this port resides.
+	HostRef string `json:"hostRef,omitempty"`
+	// This field has been deprecated and should not be used.
+	HostPortTypeIndex int `json:"hostPortTypeIndex,omitempty"`
+	// This field has been deprecated and should not be used.
+	IsSAControlled bool `json:"isSAControlled,omitempty"`
+	// This field has been deprecated and should not be used.
+	ConfirmLUNMappingCreation bool `json:"confirmLUNMappingCreation,omitempty"`
+	// The I/O-protocol-based unique port identifier. This field replaces the deprecated hostPortName field.
+	PortID SCSIInitiatorPortID `json:"portId,omitzero"`
+	// This field has been deprecated and should not be used.
+	PortInactive bool   `json:"portInactive,omitempty"`
+	ID           string `json:"id,omitempty"`
+}
+
+// HostPortCreateRequest is the HostPortCreateRequest model.
+// Creates a Host Port in the Storage System
+type HostPortCreateRequest struct {
+	// The type of host port to create Values: fc, ib, nvmeof, ethernet, scsiFc, fcp, nvmeRoce, nvmeIb, nvmeFc, sas,
+	// ibSrp, ibIser, iscsi.
+	Type string `json:"type"`
+	// The host port identifier such as the iSCSI IQN or fibre channel WWN
+	Port string `json:"port"`
+	// The user-label for the host port
+	Label string `json:"label"`
+	// Required password if host-side CHAP is enabled
+	IscsiChapSecret string `json:"iscsiChapSecret,omitempty"`
+}
+
+// HostPortLocation is the HostPortLocation model.
+type HostPortLocation struct {
+	// This enumeration defines the different I/O interface types that may be reported as part of the configuration
+	// information associated with a controller. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib, fcoe,
+	// nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	IoInterfaceType string `json:"ioInterfaceType,omitempty"`
+	// This field is present only if the type value is equal to IO_IF_FC.
+	FcPortName string `json:"fcPortName,omitempty"`
+	// This field is present only if the type value is equal to IO_IF_IB.
+	IbPortName string `json:"ibPortName,omitempty"`
+	// This field is present only if the type value is equal to IO_IF_SAS.
+	SasPortName string `json:"sasPortName,omitempty"`
+}
+
+// HostPortUpdateRequest is the HostPortUpdateRequest model.
+// Update a HostPort
+type HostPortUpdateRequest struct {
+	// The ref/id of the HostPort or Initiator
+	PortRef string `json:"portRef"`
+	// The new owning host.
+	HostRef string `json:"hostRef,omitempty"`
+	// The host port identifier such as the iSCSI IQN or fibre channel WWN
+	Port string `json:"port,omitempty"`
+	// The user-label for the host port
+	Label string `json:"label,omitempty"`
+	// Required password if host-side CHAP is enabled
+	IscsiChapSecret string `json:"iscsiChapSecret,omitempty"`
+}
+
+// HostSidePort is the HostSidePort model.
+type HostSidePort struct {
+	// The host side port id.
+	ID string `json:"id"`
+	// The host side port's SCSI transport protocol. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib, fcoe,
+	// nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	Type string `json:"type"`
+	// The host side port's address.
+	Address string `json:"address"`
+	// The host side port's label.
+	Label string `json:"label"`
+	// The host side port's media transport protocol. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib, fcoe,
+	// nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	MtpIoInterfaceType string `json:"mtpIoInterfaceType"`
+	Name               string `json:"name,omitempty"`
+}
+
+// HostSpecificValues is the HostSpecificValues model.
+type HostSpecificValues struct {
+	// The download microcode status control.
+	WaitForDownload bool `json:"waitForDownload,omitempty"`
+	// This value determines the response of the controller to commands received during the 'controller quiesced'
+	// state.
+	NotReadySenseForQuiescenceInProgress bool `json:"notReadySenseForQuiescenceInProgress,omitempty"`
+	// This value determines the response of the controller to commands received during the 'controller quiesced'
+	// state.
+	NotReadySenseForQuiescenceInProgress2 bool `json:"notReadySenseForQuiescenceInProgress2,omitempty"`
+	// This value determines if the controller will report a vendor unique Unit Attention condition as a Check
+	// Condition status.
+	CheckConditionForVendorUniqueUAConditions bool `json:"checkConditionForVendorUniqueUAConditions,omitempty"`
+	// This value is used to determine how deferred errors are reported to the host.
+	ReportDeferredErrors bool `json:"reportDeferredErrors,omitempty"`
+	// This value determines controller treatment of the Force Unit Access Option in the SCSI Read(10) and Write(10)
+	// commands.
+	IgnoreForceUnitAccess bool `json:"ignoreForceUnitAccess,omitempty"`
+	// This value determines if the controller will allow the resetting of the WCE bit in the Mode Select Caching
+	// Page to disable write caching.
+	IgnoreWriteCacheDisable bool `json:"ignoreWriteCacheDisable,omitempty"`
+	// This value determines the controller response to Reservation/Release commands that are received for LUNs that
+	// are not owned by the controller.
+	AllowReservationOnUnownedLUNs bool `json:"allowReservationOnUnownedLUNs,omitempty"`
+	// Set to true if the WWN is in the standard inquiry data.
+	WorldWideNameInStandardInquiry bool `json:"worldWideNameInStandardInquiry,omitempty"`
+	// This value determines whether the controller will propagate a Host Bus Reset/Target Reset/Logical Unit Reset
+	// to the other controller in a dual controller subsystem to support Microsoft Clustering Services.
+	PropagateHostBusResets bool `json:"propagateHostBusResets,omitempty"`
+	// Report SCC Device Enable.
+	ReportSCCDeviceEnable bool `json:"reportSCCDeviceEnable,omitempty"`
+	// Conditional Fibre Channel Logout.
+	ConditionalFCLogout bool `json:"conditionalFCLogout,omitempty"`
+	// Report SCSI-2 Through Fibre Channel Host Interface.
+	ForceSCSI2 bool `json:"forceSCSI2,omitempty"`
+	// This value determines the LUN data returned by the Report LUNs command.
+	ReportUnconfiguredLUNs bool `json:"reportUnconfiguredLUNs,omitempty"`
+	// Ignore Cache Sync Command.
+	IgnoreSyncCache bool `json:"ignoreSyncCache,omitempty"`
+	// Ignore UTM LUN Ownership.
+	IgnoreUTMLunOwnership bool `json:"ignoreUTMLunOwnership,omitempty"`
+	// Report LUN Preferred Path in Standard Inquiry Data.
+	ReportLUNPreferredPathInStandardInquiry bool `json:"reportLUNPreferredPathInStandardInquiry,omitempty"`
+	// Auto Volume Transfer.
+	AutoLUNTransfer bool `json:"autoLUNTransfer,omitempty"`
+	// The value returned in the Inquiry Device Type Field when an unconfigured LUN is accessed.
+	InquiryUnconfiguredDeviceType int `json:"inquiryUnconfiguredDeviceType,omitempty"`
+	// This value is used to define the device type the controller should return in the first byte of the Inquiry
+	// data, if the addressed logical unit is not owned by the addressed controller.
+	InquiryUnownedDeviceType int `json:"inquiryUnownedDeviceType,omitempty"`
+	// This value is used to define how the controller handles a read request to Block 0 of an unowned volume. When
+	// this is enabled, the non-owning controller sends a request for the block 0 data to the owning controller and
+	// the owning controller sends the requested block 0 data back to the non-owning controller. If disabled, the
+	// controller will do a volume transfer (if AVT is enabled) and then a read of the now owned volume.
+	UnownedBlock0Read int `json:"unownedBlock0Read,omitempty"`
+	// This field has been deprecated and should not be used.
+	RetainLogins bool `json:"retainLogins,omitempty"`
+	// This field has been deprecated and should not be used.
+	HostPortType string `json:"hostPortType,omitempty"`
+	// Maximum number of LUNs allowed for host type.
+	MaxRestrictedLUNs int    `json:"maxRestrictedLUNs,omitempty"`
+	Reserved1         string `json:"reserved1,omitempty"`
+	// Enable the AVT exclusion extents for this heterogeneous host region.
+	AvtExclusionExtentsEnabled bool `json:"avtExclusionExtentsEnabled,omitempty"`
+	// If true, then volume-off-preferred events are not considered critical.
+	SuppressVolOffPreferredPathAlert bool `json:"suppressVolOffPreferredPathAlert,omitempty"`
+	// The host type string. A zero-length string is allowed and indicates that the associated host-specific NVSRAM
+	// region has been cleared. This field should be used in place of the deprecated hostPortType field.
+	HostType string `json:"hostType,omitempty"`
+	// This field is set to true if Target Port Group Support is provided; otherwise it is false.
+	TpgsSupported bool `json:"tpgsSupported,omitempty"`
+	// If true, a format unit request will interrupt any in-progress IAF and start a write-zeros initialization. If
+	// false, the format unit will be returned with no action.
+	VolumeFormatOptions bool `json:"volumeFormatOptions,omitempty"`
+	// If true, LUN 0 is restricted and volume mapping to LUN 0 will not be allowed or be reported in the REPORT
+	// LUNS data. If false, a volume-to-LUN mapping can be created to LUN 0 and will be reported in a REPORT LUNS
+	// response.
+	RestrictedLun0 bool `json:"restrictedLun0,omitempty"`
+	// The quiescence timeout is the amount of time the controller should wait to achieve a "no I/Os active" state
+	// before invoking a forced transfer of LUN ownership. When this field is set to true, the timeout parameter
+	// provided by the host initiator in a mode select Page 2C will be used. When this field is set to false, the
+	// timeout value applied will be calculated by the controller.
+	UsePage2CQuiescenceTimeout bool `json:"usePage2CQuiescenceTimeout,omitempty"`
+	// If true, Asymmetric Logical Unit Access is enabled.
+	AsymmetricLUAccess bool `json:"asymmetricLUAccess,omitempty"`
+	// If true, the array iSCSI target driver will not use the session identifier as part of its internal I_T Nexus
+	// identifiers. This reduces churn in I_T Nexus identifiers if the initiator does not re-use iSCSI session
+	// identifiers when re-opening a session.
+	IgnoreISCSISessionIDInITN bool `json:"ignoreISCSISessionIDInITN,omitempty"`
+	// If true, array may return multiple CHECK CONDITION responses simultaneously on a given logical unit.
+	EnableLUCheckConditionParallelResponses bool `json:"enableLUCheckConditionParallelResponses,omitempty"`
+	// If true, the standard INQUIRY data reporting TPGS support to the initiator will only indicate support for
+	// implicit mode (providing TPGS support is enabled, see tpgsSupported field).
+	DisableExplicitTPGS bool `json:"disableExplicitTPGS,omitempty"`
+	// If true, controller will return a LUN NOT OWNED (05h/94h/01h) CHECK CONDITION when unable to handle I/O
+	// during firmware activation.
+	UnownedCheckConditionDuringFWActivation bool `json:"unownedCheckConditionDuringFWActivation,omitempty"`
+	// If true, the controller will suppress reporting the preferred owner in REPORT TARGET PORT GROUPS or INQUIRY
+	// VPD data in conditions when the preferred controller cannot process I/O.
+	AllowPreferredOwnerReportingSuppression bool `json:"allowPreferredOwnerReportingSuppression,omitempty"`
+	// If true, controller initiates fail-back of volumes to the preferred owner .
+	EnableTargetFailback bool `json:"enableTargetFailback,omitempty"`
+	// If true, the controller may issue volume ownership changes to balance IO load between controllers.
+	EnableAutoLoadBalance bool `json:"enableAutoLoadBalance,omitempty"`
+	// Sense Key returned to host for Quiescence In Progress Check Conditions.
+	QuiescenceInProgressSenseKey int `json:"quiescenceInProgressSenseKey,omitempty"`
+	// Additional Sense Code returned to host for Quiescence In Progress Check Conditions.
+	QuiescenceInProgressASC int `json:"quiescenceInProgressASC,omitempty"`
+	// Additional Sense Code Qualifier returned to host for Quiscence In Progress Check Conditions.
+	QuiescenceInProgressASCQ int `json:"quiescenceInProgressASCQ,omitempty"`
+	// Unique identifier for this specific host type. This value is stored in the controller configuration database
+	// when persisting Host objects in order to uniquely identify this specific host type across all NVSRAM builds.
+	PersistentID int `json:"persistentID,omitempty"`
+	// If true, a vendor unique (VU) NVMe failover solution is supported on the host.
+	NvmeVUFailoverSupported bool `json:"nvmeVUFailoverSupported,omitempty"`
+	// If true, the NVMe Asymmetric Namespace Access (ANA) failover solution is supported on the host.
+	NvmeStandardFailoverSupported bool `json:"nvmeStandardFailoverSupported,omitempty"`
+	// Whether the host type supports issuing unmap commands to a volume
+	HostUnmapEnabled bool `json:"hostUnmapEnabled,omitempty"`
+	// The host type category is used along with the host type priority to order the different host types available
+	// for selection when creating hosts. Values: uninitialized, imtCertifiedCommon, imtCertifiedUncommon,
+	// pvrCertified.
+	HostTypeCategory string `json:"hostTypeCategory,omitempty"`
+	// The host type priority is used along with the host type category to order the different host types available
+	// for selection when creating hosts.
+	HostTypePriority int `json:"hostTypePriority,omitempty"`
+	// If true, this host type supports the host connectivity reporting feature within the array.
+	HostConnectivityReportingSupported bool `json:"hostConnectivityReportingSupported,omitempty"`
+}
+
+// HostType is the HostType model.
+// A Host Type is a definition of the operating system of the Host computer that accesses the storage system, for
+// example VmWare, Windows, Solaris, Linux, etc. The Host Type is used by the storage system to customize the I/O
+// protocol based on the implementation of the host I/O drivers.
+type HostType struct {
+	// Descriptive name of host type, not localized
+	Name string `json:"name,omitempty"`
+	// The unique identifying index value which comes from the /host-types APIs
+	Index int `json:"index,omitempty"`
+	// The host type code as defined in NVSRAM
+	Code string `json:"code,omitempty"`
+	// If true, indicates that the host type is currently used by a host
+	Used bool `json:"used,omitempty"`
+	// If true, indicates that hosts created without a host type default to this host type
+	Default bool `json:"default,omitempty"`
+	// If true, indicates that the host type supports NVMe
+	NvmeSupported bool `json:"nvmeSupported,omitempty"`
+}
+
+// HostTypeByIndex is the HostTypeByIndex model.
+// A Host Type is a definition of the operating system of the Host computer that accesses the storage system, for
+// example VmWare, Windows, Solaris, Linux, etc. The Host Type is used by the storage system to customize the I/O
+// protocol based on the implementation of the host I/O drivers.
+type HostTypeByIndex struct {
+	// The unique identifying index value which comes from the /host-types APIs
+	Index int `json:"index"`
+}
+
+// HostTypeValues is the HostTypeValues model.
+type HostTypeValues struct {
+	// The download microcode status control.
+	WaitForDownload bool `json:"waitForDownload,omitempty"`
+	// This value determines the response of the controller to commands received during the 'controller quiesced'
+	// state.
+	NotReadySenseForQuiescenceInProgress bool `json:"notReadySenseForQuiescenceInProgress,omitempty"`
+	// This value determines the response of the controller to commands received during the 'controller quiesced'
+	// state.
+	NotReadySenseForQuiescenceInProgress2 bool `json:"notReadySenseForQuiescenceInProgress2,omitempty"`
+	// This value determines if the controller will report a vendor unique Unit Attention condition as a Check
+	// Condition status.
+	CheckConditionForVendorUniqueUAConditions bool `json:"checkConditionForVendorUniqueUAConditions,omitempty"`
+	// This value is used to determine how deferred errors are reported to the host.
+	ReportDeferredErrors bool `json:"reportDeferredErrors,omitempty"`
+	// This value determines controller treatment of the Force Unit Access Option in the SCSI Read(10) and Write(10)
+	// commands.
+	IgnoreForceUnitAccess bool `json:"ignoreForceUnitAccess,omitempty"`
+	// This value determines if the controller will allow the resetting of the WCE bit in the Mode Select Caching
+	// Page to disable write caching.
+	IgnoreWriteCacheDisable bool `json:"ignoreWriteCacheDisable,omitempty"`
+	// This value determines the controller response to Reservation/Release commands that are received for LUNs that
+	// are not owned by the controller.
+	AllowReservationOnUnownedLUNs bool `json:"allowReservationOnUnownedLUNs,omitempty"`
+	// Set to true if the WWN is in the standard inquiry data.
+	WorldWideNameInStandardInquiry bool `json:"worldWideNameInStandardInquiry,omitempty"`
+	// This value determines whether the controller will propagate a Host Bus Reset/Target Reset/Logical Unit Reset
+	// to the other controller in a dual controller subsystem to support Microsoft Clustering Services.
+	PropagateHostBusResets bool `json:"propagateHostBusResets,omitempty"`
+	// Report SCC Device Enable.
+	ReportSCCDeviceEnable bool `json:"reportSCCDeviceEnable,omitempty"`
+	// Conditional Fibre Channel Logout.
+	ConditionalFCLogout bool `json:"conditionalFCLogout,omitempty"`
+	// Report SCSI-2 Through Fibre Channel Host Interface.
+	ForceSCSI2 bool `json:"forceSCSI2,omitempty"`
+	// This value determines the LUN data returned by the Report LUNs command.
+	ReportUnconfiguredLUNs bool `json:"reportUnconfiguredLUNs,omitempty"`
+	// Ignore Cache Sync Command.
+	IgnoreSyncCache bool `json:"ignoreSyncCache,omitempty"`
+	// Ignore UTM LUN Ownership.
+	IgnoreUTMLunOwnership bool `json:"ignoreUTMLunOwnership,omitempty"`
+	// Report LUN Preferred Path in Standard Inquiry Data.
+	ReportLUNPreferredPathInStandardInquiry bool `json:"reportLUNPreferredPathInStandardInquiry,omitempty"`
+	// Auto Volume Transfer.
+	AutoLUNTransfer bool `json:"autoLUNTransfer,omitempty"`
+	// The value returned in the Inquiry Device Type Field when an unconfigured LUN is accessed.
+	InquiryUnconfiguredDeviceType int `json:"inquiryUnconfiguredDeviceType,omitempty"`
+	// This value is used to define the device type the controller should return in the first byte of the Inquiry
+	// data, if the addressed logical unit is not owned by the addressed controller.
+	InquiryUnownedDeviceType int `json:"inquiryUnownedDeviceType,omitempty"`
+	// This value is used to define how the controller handles a read request to Block 0 of an unowned volume. When
+	// this is enabled, the non-owning controller sends a request for the block 0 data to the owning controller and
+	// the owning controller sends the requested block 0 data back to the non-owning controller. If disabled, the
+	// controller will do a volume transfer (if AVT is enabled) and then a read of the now owned volume.
+	UnownedBlock0Read int `json:"unownedBlock0Read,omitempty"`
+	// This field has been deprecated and should not be used.
+	RetainLogins bool `json:"retainLogins,omitempty"`
+	// This field has been deprecated and should not be used.
+	HostPortType string `json:"hostPortType,omitempty"`
+	// Maximum number of LUNs allowed for host type.
+	MaxRestrictedLUNs int    `json:"maxRestrictedLUNs,omitempty"`
+	Reserved1         string `json:"reserved1,omitempty"`
+	// Enable the AVT exclusion extents for this heterogeneous host region.
+	AvtExclusionExtentsEnabled bool `json:"avtExclusionExtentsEnabled,omitempty"`
+	// If true, then volume-off-preferred events are not considered critical.
+	SuppressVolOffPreferredPathAlert bool `json:"suppressVolOffPreferredPathAlert,omitempty"`
+	// The host type string. A zero-length string is allowed and indicates that the associated host-specific NVSRAM
+	// region has been cleared. This field should be used in place of the deprecated hostPortType field.
+	HostType string `json:"hostType,omitempty"`
+	// This field is set to true if Target Port Group Support is provided; otherwise it is false.
+	TpgsSupported bool `json:"tpgsSupported,omitempty"`
+	// If true, a format unit request will interrupt any in-progress IAF and start a write-zeros initialization. If
+	// false, the format unit will be returned with no action.
+	VolumeFormatOptions bool `json:"volumeFormatOptions,omitempty"`
+	// If true, LUN 0 is restricted and volume mapping to LUN 0 will not be allowed or be reported in the REPORT
+	// LUNS data. If false, a volume-to-LUN mapping can be created to LUN 0 and will be reported in a REPORT LUNS
+	// response.
+	RestrictedLun0 bool `json:"restrictedLun0,omitempty"`
+	// The quiescence timeout is the amount of time the controller should wait to achieve a "no I/Os active" state
+	// before invoking a forced transfer of LUN ownership. When this field is set to true, the timeout parameter
+	// provided by the host initiator in a mode select Page 2C will be used. When this field is set to false, the
+	// timeout value applied will be calculated by the controller.
+	UsePage2CQuiescenceTimeout bool `json:"usePage2CQuiescenceTimeout,omitempty"`
+	// If true, Asymmetric Logical Unit Access is enabled.
+	AsymmetricLUAccess bool `json:"asymmetricLUAccess,omitempty"`
+	// If true, the array iSCSI target driver will not use the session identifier as part of its internal I_T Nexus
+	// identifiers. This reduces churn in I_T Nexus identifiers if the initiator does not re-use iSCSI session
+	// identifiers when re-opening a session.
+	IgnoreISCSISessionIDInITN bool `json:"ignoreISCSISessionIDInITN,omitempty"`
+	// If true, array may return multiple CHECK CONDITION responses simultaneously on a given logical unit.
+	EnableLUCheckConditionParallelResponses bool `json:"enableLUCheckConditionParallelResponses,omitempty"`
+	// If true, the standard INQUIRY data reporting TPGS support to the initiator will only indicate support for
+	// implicit mode (providing TPGS support is enabled, see tpgsSupported field).
+	DisableExplicitTPGS bool `json:"disableExplicitTPGS,omitempty"`
+	// If true, controller will return a LUN NOT OWNED (05h/94h/01h) CHECK CONDITION when unable to handle I/O
+	// during firmware activation.
+	UnownedCheckConditionDuringFWActivation bool `json:"unownedCheckConditionDuringFWActivation,omitempty"`
+	// If true, the controller will suppress reporting the preferred owner in REPORT TARGET PORT GROUPS or INQUIRY
+	// VPD data in conditions when the preferred controller cannot process I/O.
+	AllowPreferredOwnerReportingSuppression bool `json:"allowPreferredOwnerReportingSuppression,omitempty"`
+	// If true, controller initiates fail-back of volumes to the preferred owner .
+	EnableTargetFailback bool `json:"enableTargetFailback,omitempty"`
+	// If true, the controller may issue volume ownership changes to balance IO load between controllers.
+	EnableAutoLoadBalance bool `json:"enableAutoLoadBalance,omitempty"`
+	// Sense Key returned to host for Quiescence In Progress Check Conditions.
+	QuiescenceInProgressSenseKey int `json:"quiescenceInProgressSenseKey,omitempty"`
+	// Additional Sense Code returned to host for Quiescence In Progress Check Conditions.
+	QuiescenceInProgressASC int `json:"quiescenceInProgressASC,omitempty"`
+	// Additional Sense Code Qualifier returned to host for Quiscence In Progress Check Conditions.
+	QuiescenceInProgressASCQ int `json:"quiescenceInProgressASCQ,omitempty"`
+	// Unique identifier for this specific host type. This value is stored in the controller configuration database
+	// when persisting Host objects in order to uniquely identify this specific host type across all NVSRAM builds.
+	PersistentID int `json:"persistentID,omitempty"`
+	// If true, a vendor unique (VU) NVMe failover solution is supported on the host.
+	NvmeVUFailoverSupported bool `json:"nvmeVUFailoverSupported,omitempty"`
+	// If true, the NVMe Asymmetric Namespace Access (ANA) failover solution is supported on the host.
+	NvmeStandardFailoverSupported bool `json:"nvmeStandardFailoverSupported,omitempty"`
+	// Whether the host type supports issuing unmap commands to a volume
+	HostUnmapEnabled bool `json:"hostUnmapEnabled,omitempty"`
+	// The host type category is used along with the host type priority to order the different host types available
+	// for selection when creating hosts. Values: uninitialized, imtCertifiedCommon, imtCertifiedUncommon,
+	// pvrCertified.
+	HostTypeCategory string `json:"hostTypeCategory,omitempty"`
+	// The host type priority is used along with the host type category to order the different host types available
+	// for selection when creating hosts.
+	HostTypePriority int `json:"hostTypePriority,omitempty"`
+	// If true, this host type supports the host connectivity reporting feature within the array.
+	HostConnectivityReportingSupported bool   `json:"hostConnectivityReportingSupported,omitempty"`
+	Index                              int    `json:"index,omitempty"`
+	Name                               string `json:"name,omitempty"`
+	Default                            bool   `json:"default,omitempty"`
+}
+
+// HostUpdateRequest is the HostUpdateRequest model.
+// Defines the supported host update parameters
+type HostUpdateRequest struct {
+	// The user label to assign to the host
+	Name string `json:"name,omitempty"`
+	// The host group identifier.
+	GroupID string `json:"groupId,omitempty"`
+	// The list of host ports to create
+	Ports []HostPortCreateRequest `json:"ports,omitempty"`
+	// The list of host ports to be updated
+	PortsToUpdate []HostPortUpdateRequest `json:"portsToUpdate,omitempty"`
+	// The list of host ports and/or initiators to remove
+	PortsToRemove []string `json:"portsToRemove,omitempty"`
+	// The host type index as returned from /host-types
+	HostType HostTypeByIndex `json:"hostType,omitzero"`
+}
+
+// HotSpare is the HotSpare model.
+type HotSpare struct {
+	Capacity string `json:"capacity,omitempty"`
+	DriveRef string `json:"driveRef,omitempty"`
+	// Values: scsi, fibre, sata, pata, fibre520b, sas, sas4k, nvme4k, unknown.
+	InterfaceType string `json:"interfaceType,omitempty"`
+	// Values: hdd, ssd, unknown.
+	MediaType       string `json:"mediaType,omitempty"`
+	ProductID       string `json:"productId,omitempty"`
+	IsFdeCapable    bool   `json:"isFdeCapable,omitempty"`
+	IsFipsCapable   bool   `json:"isFipsCapable,omitempty"`
+	IsHotSpareInUse bool   `json:"isHotSpareInUse,omitempty"`
+}
+
+// HotSpareCoverageResponse is the HotSpareCoverageResponse model.
+type HotSpareCoverageResponse struct {
+	// The list of available volume groups.
+	VolumeGroups []VolumeGroupHotSpareCoverage `json:"volumeGroups,omitempty"`
+}
+
+// HotSpareDriveCoverage is the HotSpareDriveCoverage model.
+type HotSpareDriveCoverage struct {
+	// The drive reference used to identify the drive.
+	DriveRef string   `json:"driveRef"`
+	GroupRef []string `json:"groupRef,omitempty"`
+	InUse    bool     `json:"inUse,omitempty"`
+}
+
+// HotSpareResponse is the HotSpareResponse model.
+type HotSpareResponse struct {
+	// The list of drive refs currently assigned as hot spares.
+	DriveRefs []string `json:"driveRefs"`
+	// The hot spare coverage for the storage pools in the storage system.
+	HotSpareDriveCoverage []HotSpareDriveCoverage `json:"hotSpareDriveCoverage"`
+}
+
+// HTTPResponseHeader is the HttpResponseHeader model.
+// Customized HTTP response headers. HTTP response headers can be customized on specific base URL paths and will
+// override the default HTTP response header if it is already present.
+type HTTPResponseHeader struct {
+	// HTTP response header. Only a subset of HTTP response headers are supported. Values: xFrameOptions.
+	Header string `json:"header,omitempty"`
+	// Value of the HTTP response header. A null or empty string will cause this header to be omitted from the HTTP
+	// response.
+	Value string `json:"value,omitempty"`
+	// Base URL path in which to include this HTTP response header. A "/", null or empty string will cause this
+	// header to be added to every response.
+	BaseURLPath string `json:"baseUrlPath,omitempty"`
+}
+
+// IBInterfacePort is the IBInterfacePort model.
+type IBInterfacePort struct {
+	// This field has been deprecated and should not be used.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// The number of the host channel associated with this interface.
+	Channel int `json:"channel,omitempty"`
+	// A reference to the channel port for the channel associated with this interface.
+	ChannelPortRef string `json:"channelPortRef,omitempty"`
+	// The InfiniBand local identifier associated with this interface.
+	LocalIdentifier int `json:"localIdentifier,omitempty"`
+	// The InfiniBand global identifier associated with this interface.
+	GlobalIdentifier string `json:"globalIdentifier,omitempty"`
+	// The state of the InfiniBand link. Values: initialize, linkArm, active, defer, down.
+	LinkState string `json:"linkState,omitempty"`
+	// The state of the InfiniBand port. Values: unknown, initialize, arm, active, defer, down.
+	PortState string `json:"portState,omitempty"`
+	// The size in bytes of the largest packet that the interface can transmit.
+	MaximumTransmissionUnit int `json:"maximumTransmissionUnit,omitempty"`
+	// The speed at which the interface is currently operating. Values: speedUnknown, speed1gig, speed2gig,
+	// speed4gig, speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig,
+	// speed30gig, speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig,
+	// speed32gig, speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	CurrentSpeed string `json:"currentSpeed,omitempty"`
+	// An array containing the different speeds at which the interface is capable of operating.
+	SupportedSpeed []string `json:"supportedSpeed,omitempty"`
+	// The width at which the link is currently operating, e.g., 1 means "1X," 4 means "4X,".etc. Values: width1x,
+	// width4x, width8x, width12x.
+	CurrentLinkWidth string `json:"currentLinkWidth,omitempty"`
+	// An array containing the different link widths at which the link is capable of operating.
+	SupportedLinkWidth []string `json:"supportedLinkWidth,omitempty"`
+	// The number of data virtual lanes that are currently active for this interface.
+	CurrentDataVirtualLanes int `json:"currentDataVirtualLanes,omitempty"`
+	// The maximum number of data virtual lanes supported by the interface.
+	MaximumDataVirtualLanes int `json:"maximumDataVirtualLanes,omitempty"`
+	// The physical location of the Infiniband interface. The parent reference in Location identifies the physical
+	// component (e.g., controller or host card) where the interface circuitry is located, and the position field is
+	// a firmware-assigned 1-relative number signifying "1st Infiniband interface relative to the parent," "2nd
+	// Infiniband interface relative to the parent," etc. This "interface number" is independent of the interface's
+	// channel association.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// This field indicates whether or not the I/O interface is PI capable.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// This flag is true if SRP (SCSI RDMA Protocol) is currently supported.
+	IsSRPSupported bool `json:"isSRPSupported,omitempty"`
+	// This flag is true if iSER (iSCSI Extensions for RDMA) is currently supported.
+	IsISERSupported bool `json:"isISERSupported,omitempty"`
+	// This element contains the current state of the physical Infiniband port. Values: unknown, sleep, polling,
+	// disabled, cfgTrain, linkUp, linkErrRec, phyTest.
+	PhysPortState string `json:"physPortState,omitempty"`
+	// Maximum one way data rate in B/s
+	OneWayMaxRate string `json:"oneWayMaxRate,omitempty"`
+	// Maximum bi-directional data rate in B/s
+	BidirectionalMaxRate string `json:"bidirectionalMaxRate,omitempty"`
+	// Indicates if the interface is configured to support NVMe over Fabrics protocol
+	IsNVMeSupported bool   `json:"isNVMeSupported,omitempty"`
+	ControllerID    string `json:"controllerId,omitempty"`
+	// List of properties that describe the command protocol.
+	CommandProtocolProperties []CommandProtocolProperties `json:"commandProtocolProperties,omitempty"`
+	InterfaceID               string                      `json:"interfaceId,omitempty"`
+	NiceAddressID             string                      `json:"niceAddressId,omitempty"`
+	AddressID                 string                      `json:"addressId,omitempty"`
+	ID                        string                      `json:"id,omitempty"`
+}
+
+// IOCDumpData is the IOCDumpData model.
+type IOCDumpData struct {
+	// If true, IOC Dump is enabled in the controller.
+	IocDumpEnabled bool `json:"iocDumpEnabled,omitempty"`
+	// This field has been deprecated and should not be used.
+	IocDumpNeedsRetrieved bool `json:"iocDumpNeedsRetrieved,omitempty"`
+	// This field has been deprecated and should not be used.
+	IocDumpTag int `json:"iocDumpTag,omitempty"`
+	// This field has been deprecated and should not be used.
+	TimeStamp string `json:"timeStamp,omitempty"`
+}
+
+// IOInterfaceTypeData is the IOInterfaceTypeData model.
+type IOInterfaceTypeData struct {
+	// This enumeration defines the different I/O interface types that may be reported as part of the configuration
+	// information associated with a controller. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib, fcoe,
+	// nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	InterfaceType string `json:"interfaceType,omitempty"`
+	// This field is present only if the interfaceType value is equal to IO_IF_FC. It contains the detailed
+	// interface information for a Fibre Channel I/O interface.
+	Fibre FibreInterface `json:"fibre,omitzero"`
+	// This field is present only if the interfaceType value is equal to IO_IF_IB. It contains the detailed
+	// interface information for an Infiniband interface.
+	Ib IbInterface `json:"ib,omitzero"`
+	// This field is present only if the interface type value is equal to IO_IF_ISCSI. It contains the detailed
+	// interface information for an iSCSI I/O interface.
+	Iscsi IscsiInterface `json:"iscsi,omitzero"`
+	// This field is present only if the interfaceType value is equal to IO_IF_SAS. It contains the detailed
+	// interface information for a serial-attached SCSI I/O interface.
+	Sas SasInterface `json:"sas,omitzero"`
+	// This field is present only if the interfaceType value is equal to IO_IF_SATA. It contains the detailed
+	// interface information for a SATA I/O interface.
+	Sata SATAInterface `json:"sata,omitzero"`
+	// This field has been deprecated and should not be used.
+	Scsi SCSIInterface `json:"scsi,omitzero"`
+	// InterfaceTypeData for the NVMe based coupling driver used for our containerized product.
+	CouplingDriverNvme CouplingDriverNVMeInterface `json:"couplingDriverNvme,omitzero"`
+	// This field is present only if the interfaceType value is equal to IO_IF_ETHERNET. It contains the detailed
+	// interface information for an Ethernet I/O interface.
+	Ethernet EthernetIoInterface `json:"ethernet,omitzero"`
+	// This field is present only if the interfaceType value is equal to IO_IF_PCIE. It contains the detailed
+	// interface information for a PCIe IO interface.
+	Pcie PCIeInterface `json:"pcie,omitzero"`
+}
+
+// IScsiInterfacePort is the IScsiInterfacePort model.
+type IScsiInterfacePort struct {
+	// The number of the channel controlled by this interface.
+	Channel int `json:"channel,omitempty"`
+	// A reference to the channel port object associated with the channel controlled by this interface.
+	ChannelPortRef string `json:"channelPortRef,omitempty"`
+	// The number of the TCP port on which the target listens for incoming connections.
+	TCPListenPort int `json:"tcpListenPort,omitempty"`
+	// True if IPV4 is enabled for this interface; always true for iSER interface.
+	Ipv4Enabled bool `json:"ipv4Enabled,omitempty"`
+	// IPV4-related information for the interface.
+	Ipv4Data InternetProtocolV4Data `json:"ipv4Data,omitzero"`
+	// Information about the physical interface (e.g., Ethernet).
+	InterfaceData PhysicalInterfaceTypeData `json:"interfaceData,omitzero"`
+	// This field has been deprecated and should not be used.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// True if IPV6 is enabled for this interface; otherwise false.
+	Ipv6Enabled bool `json:"ipv6Enabled,omitempty"`
+	// IPV6-related information for the interface.
+	Ipv6Data InternetProtocolV6Data `json:"ipv6Data,omitzero"`
+	// The physical location of the iSCSI interface. The parent reference in Location identifies the physical
+	// component (e.g., controller or host card) where the interface circuitry is located, and the position field is
+	// a firmware-assigned 1-relative number signifying "1st iSCSI interface relative to the parent," "2nd iSCSI
+	// interface relative to the parent," etc. This "interface number" is independent of the interface's channel
+	// association.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// This field indicates whether or not the I/O interface is PI capable.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// This flag is true if the interface is capable of IPv6 functionality.
+	IsIPv6Capable bool `json:"isIPv6Capable,omitempty"`
+	// Maximum one way data rate in B/s
+	OneWayMaxRate string `json:"oneWayMaxRate,omitempty"`
+	// Maximum bi-directional data rate in B/s
+	BidirectionalMaxRate string `json:"bidirectionalMaxRate,omitempty"`
+	// Can capture network traces if true.
+	IsNetworkTraceCapable bool `json:"isNetworkTraceCapable,omitempty"`
+	// Indicates whether this interface supports the remote storage volume capability.
+	IsRemoteStorageVolumeCapable bool   `json:"isRemoteStorageVolumeCapable,omitempty"`
+	Iqn                          string `json:"iqn,omitempty"`
+	ControllerID                 string `json:"controllerId,omitempty"`
+	InterfaceID                  string `json:"interfaceId,omitempty"`
+	NiceAddressID                string `json:"niceAddressId,omitempty"`
+	AddressID                    string `json:"addressId,omitempty"`
+	ID                           string `json:"id,omitempty"`
+}
+
+// IbInterface is the IbInterface model.
+type IbInterface struct {
+	// This field has been deprecated and should not be used.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// The number of the host channel associated with this interface.
+	Channel int `json:"channel,omitempty"`
+	// A reference to the channel port for the channel associated with this interface.
+	ChannelPortRef string `json:"channelPortRef,omitempty"`
+	// The InfiniBand local identifier associated with this interface.
+	LocalIdentifier int `json:"localIdentifier,omitempty"`
+	// The InfiniBand global identifier associated with this interface.
+	GlobalIdentifier string `json:"globalIdentifier,omitempty"`
+	// The state of the InfiniBand link. Values: initialize, linkArm, active, defer, down.
+	LinkState string `json:"linkState,omitempty"`
+	// The state of the InfiniBand port. Values: unknown, initialize, arm, active, defer, down.
+	PortState string `json:"portState,omitempty"`
+	// The size in bytes of the largest packet that the interface can transmit.
+	MaximumTransmissionUnit int `json:"maximumTransmissionUnit,omitempty"`
+	// The speed at which the interface is currently operating. Values: speedUnknown, speed1gig, speed2gig,
+	// speed4gig, speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig,
+	// speed30gig, speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig,
+	// speed32gig, speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	CurrentSpeed string `json:"currentSpeed,omitempty"`
+	// An array containing the different speeds at which the interface is capable of operating.
+	SupportedSpeed []string `json:"supportedSpeed,omitempty"`
+	// The width at which the link is currently operating, e.g., 1 means "1X," 4 means "4X,".etc. Values: width1x,
+	// width4x, width8x, width12x.
+	CurrentLinkWidth string `json:"currentLinkWidth,omitempty"`
+	// An array containing the different link widths at which the link is capable of operating.
+	SupportedLinkWidth []string `json:"supportedLinkWidth,omitempty"`
+	// The number of data virtual lanes that are currently active for this interface.
+	CurrentDataVirtualLanes int `json:"currentDataVirtualLanes,omitempty"`
+	// The maximum number of data virtual lanes supported by the interface.
+	MaximumDataVirtualLanes int `json:"maximumDataVirtualLanes,omitempty"`
+	// The physical location of the Infiniband interface. The parent reference in Location identifies the physical
+	// component (e.g., controller or host card) where the interface circuitry is located, and the position field is
+	// a firmware-assigned 1-relative number signifying "1st Infiniband interface relative to the parent," "2nd
+	// Infiniband interface relative to the parent," etc. This "interface number" is independent of the interface's
+	// channel association.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// This field indicates whether or not the I/O interface is PI capable.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// This flag is true if SRP (SCSI RDMA Protocol) is currently supported.
+	IsSRPSupported bool `json:"isSRPSupported,omitempty"`
+	// This flag is true if iSER (iSCSI Extensions for RDMA) is currently supported.
+	IsISERSupported bool `json:"isISERSupported,omitempty"`
+	// This element contains the current state of the physical Infiniband port. Values: unknown, sleep, polling,
+	// disabled, cfgTrain, linkUp, linkErrRec, phyTest.
+	PhysPortState string `json:"physPortState,omitempty"`
+	// Maximum one way data rate in B/s
+	OneWayMaxRate string `json:"oneWayMaxRate,omitempty"`
+	// Maximum bi-directional data rate in B/s
+	BidirectionalMaxRate string `json:"bidirectionalMaxRate,omitempty"`
+	// Indicates if the interface is configured to support NVMe over Fabrics protocol
+	IsNVMeSupported bool   `json:"isNVMeSupported,omitempty"`
+	ID              string `json:"id,omitempty"`
+}
+
+// IbNetworkSettings is the IbNetworkSettings model.
+type IbNetworkSettings struct {
+	// The IPv4 address.
+	Ipv4Address string `json:"ipv4Address,omitempty"`
+}
+
+// IbSettings is the IbSettings model.
+type IbSettings struct {
+	// The Infinband interface network settings
+	NetworkSettings IbNetworkSettings `json:"networkSettings,omitzero"`
+}
+
+// IdentificationRequest is the IdentificationRequest model.
+type IdentificationRequest struct {
+	// A list of channel ids. There must be an expansion drive tray attached to identify a channel.
+	Channels []int `json:"channels,omitempty"`
+	// A list of tray ids.
+	Trays []string `json:"trays,omitempty"`
+	// A list of drive ids.
+	Drives []string `json:"drives,omitempty"`
+	// A list of storage pool ids. Associated, optimal drives will be identified.
+	Pools []string `json:"pools,omitempty"`
+	// A list of volume ids. Associated, optimal drives will be identified.
+	Volumes []string `json:"volumes,omitempty"`
+	// Set to "true" to identify the StorageSystem.
+	StorageSystem *bool `json:"storageSystem,omitempty"`
+}
+
+// InProgressJobInfo is the InProgressJobInfo model.
+// Information about an in progress replication job
+type InProgressJobInfo struct {
+	// Job identifier
+	ID string `json:"id"`
+	// Time the job was started
+	StartTime string `json:"startTime"`
+	// Requested types to configure
+	ConfigTypes []string `json:"configTypes"`
+	// Amount of time in milliseconds the job has been running
+	DurationMs string `json:"durationMs"`
+	// Percentage complete of job. Valid values are 0 - 100 (inclusive)
+	PercentComplete int `json:"percentComplete"`
+	// Number of steps performed
+	NumberSteps int `json:"numberSteps"`
+	// Number of steps that failed
+	NumberFailures int `json:"numberFailures"`
+}
+
+// InfinibandInterfaceData is the InfinibandInterfaceData model.
+type InfinibandInterfaceData struct {
+	// If true, iSER (iSCSI Extensions for RDMA) is active and a corresponding IBInterface object exists
+	IsIser bool `json:"isIser,omitempty"`
+}
+
+// InitialAsyncResponse is the InitialAsyncResponse model.
+// Provides a unique identifier for requesting status of an asynchronous call.
+type InitialAsyncResponse struct {
+	// A unique value that identifies the request for later retrieval.
+	RequestID string `json:"requestId"`
+}
+
+// Initiator is the Initiator model.
+type Initiator struct {
+	// The reference that uniquely identifies an instance of this object.
+	InitiatorRef string `json:"initiatorRef,omitempty"`
+	// This field has been deprecated and should not be used.
+	NodeName ScsiNodeName `json:"nodeName,omitzero"`
+	// An additional name that can be assigned to an initiator, which is usually "friendlier" than the node name.
+	Alias ScsiAlias `json:"alias,omitzero"`
+	// An additional "friendly" name that can be assigned to an initiator through the Storage Manager application.
+	Label string `json:"label,omitempty"`
+	// The authentication methods that have been configured for the initiator. Associated secrets are not exposed.
+	// instead the type field of the EncryptedPassword is set to ENCRYPT_UNSPECIFIED.
+	ConfiguredAuthMethods AuthenticationBundle `json:"configuredAuthMethods,omitzero"`
+	// A reference to the Host object to which the initiator belongs.
+	HostRef string `json:"hostRef,omitempty"`
+	// This field has been deprecated and should not be used.
+	InitiatorInactive bool `json:"initiatorInactive,omitempty"`
+	// The unique identifier for this initiator and its properties.
+	InitiatorNodeName InitiatorNodeName `json:"initiatorNodeName,omitzero"`
+	ID                string            `json:"id,omitempty"`
+}
+
+// InitiatorLocation is the InitiatorLocation model.
+type InitiatorLocation struct {
+	// The iSCSI node name of the initiator, left-truncated if necessary. (Left-truncation preserves the part of the
+	// name most likely to vary.)
+	InitiatorName string `json:"initiatorName,omitempty"`
+}
+
+// InitiatorNodeName is the InitiatorNodeName model.
+type InitiatorNodeName struct {
+	// The unique node name associated with the initiator
+	NodeName ScsiNodeName `json:"nodeName,omitzero"`
+	// The Media Transport Protocol in use by the initiator. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib,
+	// fcoe, nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	InterfaceType string `json:"interfaceType,omitempty"`
+}
+
+// IntegratedHealthCheck is the IntegratedHealthCheck model.
+type IntegratedHealthCheck struct {
+	// Values: ok, notCompleted, failedDataRetrieval, failed.
+	Result string `json:"result"`
+	// Values: melEventCheck, cntrlSyncCheck, storageDeviceAccessible, spmDatabaseVerification, validPassword,
+	// configurationDatabaseCheck, objectGraphSyncCheck, nvsramMatches, volumeGroupsComplete,
+	// controllerStatusOptimal, hotSparesInUse, failedDrivesPresent, driveCheck, missingVolumes,
+	// exclusiveOperations, dbSubRecordsValidation, driveSideShipping, nvsramDisableCfwDownloads, needsAttention,
+	// integratedHealthCheck, altCommLinkFailure, ptlDisallowDownloads.
+	Type string `json:"type"`
+	// Values: unknown, low, medium, high, fatal.
+	SeverityLevel string `json:"severityLevel"`
+	// Results data to diagnose failures
+	ResultsData string `json:"resultsData"`
+	// List of tests from the integrated health check.
+	HealthCheckTests []IntegratedHealthCheckTest `json:"healthCheckTests"`
+	Successful       bool                        `json:"successful,omitempty"`
+}
+
+// IntegratedHealthCheckTest is the IntegratedHealthCheckTest model.
+type IntegratedHealthCheckTest struct {
+	// Test passed indicator.
+	TestPassed bool `json:"testPassed"`
+	// Health check test name that was executed.
+	TestName string `json:"testName"`
+	// Results data from the test.
+	ResultsData string `json:"resultsData"`
+}
+
+// InterconnectCRU is the InterconnectCRU model.
+type InterconnectCRU struct {
+	// The reference for this physical interconnect CRU
+	InterconnectCRURef string `json:"interconnectCRURef,omitempty"`
+	// The operational status of the interconnect CRU Values: optimal, failed, removed.
+	Status string `json:"status,omitempty"`
+	// The physical location of the interconnect CRU. The parent reference in Location identifies the tray
+	// containing the CRU, and the position field is always one.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// VPD Manufacture Date
+	ManufacturerDate string `json:"manufacturerDate,omitempty"`
+	// The VPD vendor name
+	VendorName string `json:"vendorName,omitempty"`
+	// The VPD part number
+	VendorPN string `json:"vendorPN,omitempty"`
+	// The VPD serial number
+	VendorSN string `json:"vendorSN,omitempty"`
+	// The VPD field replaceable unit type
+	FruType string `json:"fruType,omitempty"`
+	// This field has been deprecated and should not be used.
+	ReadyToRemove bool `json:"readyToRemove,omitempty"`
+	// This field has been deprecated and should not be used.
+	RtrAttributes RTRAttributes `json:"rtrAttributes,omitzero"`
+	// The repair policy for the interconnect CRU component.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+}
+
+// InterfaceStats is the InterfaceStats model.
+// Interface I/O performance statistics.
+type InterfaceStats struct {
+	// End time for this collection as measured by the number of seconds since baseTime.
+	ObservedTime      string `json:"observedTime"`
+	ObservedTimeInMS  string `json:"observedTimeInMS"`
+	LastResetTime     string `json:"lastResetTime"`
+	LastResetTimeInMS string `json:"lastResetTimeInMS"`
+	// Interface that generated this group.
+	InterfaceID string `json:"interfaceId"`
+	// Controller this interface belongs to
+	ControllerID string `json:"controllerId"`
+	ArrayID      string `json:"arrayId"`
+	ArrayWWN     string `json:"arrayWwn"`
+	// Channel Type enumeration Drive side, host side, or management. Values: hostside, driveside, management.
+	ChannelType string `json:"channelType"`
+	// Channel numerical ID.
+	ChannelNumber int `json:"channelNumber"`
+	// Number of read operations.
+	ReadOps float64 `json:"readOps"`
+	// Number of bytes read.
+	ReadBytes float64 `json:"readBytes"`
+	// Total time in microseconds spent in read operations.
+	ReadTimeTotal float64 `json:"readTimeTotal"`
+	// Max time in microseconds spent processing one read operation.
+	ReadTimeMax float64 `json:"readTimeMax"`
+	// Number of write operations.
+	WriteOps float64 `json:"writeOps"`
+	// Number of bytes write.
+	WriteBytes float64 `json:"writeBytes"`
+	// Total time in microseconds spent in write operations.
+	WriteTimeTotal float64 `json:"writeTimeTotal"`
+	// Max time in microseconds spent processing one write operation.
+	WriteTimeMax float64 `json:"writeTimeMax"`
+	// Number of non-read-write operations.
+	OtherOps float64 `json:"otherOps"`
+	// Total time in microseconds spent in non-read-write ops.
+	OtherTimeTotal float64 `json:"otherTimeTotal"`
+	// Max time in microseconds spent processing one non-read-write op.
+	OtherTimeMax float64 `json:"otherTimeMax"`
+	// Sum of the squares of microseconds spent in read operations.
+	ReadTimeTotalSq float64 `json:"readTimeTotalSq"`
+	// Sum of the squares of microseconds spent in write operations.
+	WriteTimeTotalSq float64 `json:"writeTimeTotalSq"`
+	// Sum of the squares of microseconds spent in non-read-write operations.
+	OtherTimeTotalSq float64 `json:"otherTimeTotalSq"`
+	// Total channel queue depth.
+	QueueDepthTotal float64 `json:"queueDepthTotal"`
+	// Maximum channel queue depth.
+	QueueDepthMax float64 `json:"queueDepthMax"`
+	// The total number of errors detected on this channel.
+	ChannelErrorCount float64 `json:"channelErrorCount"`
+}
+
+// InternetProtocolV4Data is the InternetProtocolV4Data model.
+type InternetProtocolV4Data struct {
+	// The various IPV4 address-related elements (IP address, gateway IP address, and subnet mask), along with the
+	// state of their configuration.
+	Ipv4Address string `json:"ipv4Address,omitempty"`
+	// IPV4 configuration method for the endpoint; always STATIC for iSER interfaces. Values: configDhcp,
+	// configStatic.
+	Ipv4AddressConfigMethod string `json:"ipv4AddressConfigMethod,omitempty"`
+	// Priority associate with outbound IPV4 packets; always disabled for iSER interfaces.
+	Ipv4OutboundPacketPriority SettingControl `json:"ipv4OutboundPacketPriority,omitzero"`
+	// Value of IPV4 VLAN id for the interface; always disabled for iSER interfaces.
+	Ipv4VlanID SettingControl `json:"ipv4VlanId,omitzero"`
+	// The various IPV4 address-related elements (IP address, gateway IP address, and subnet mask), along with the
+	// state of their configuration.
+	Ipv4AddressData IPV4AddressData `json:"ipv4AddressData,omitzero"`
+}
+
+// InternetProtocolV6Data is the InternetProtocolV6Data model.
+type InternetProtocolV6Data struct {
+	// The set of IPV6 local addresses that have been assigned to the iSCSI Ethernet port.
+	Ipv6LocalAddresses []IPV6AddressData `json:"ipv6LocalAddresses,omitempty"`
+	// The set of IPV6 routable addresses that have been assigned to the iSCSI Ethernet port
+	Ipv6RoutableAddresses []IPV6AddressData `json:"ipv6RoutableAddresses,omitempty"`
+	// The IPV6 address of the port router.
+	Ipv6PortRouterAddress IPV6AddressData `json:"ipv6PortRouterAddress,omitzero"`
+	// The method by which the IPV6 address information is configured for the endpoint. Values: configStatic,
+	// configStateless.
+	Ipv6AddressConfigMethod string `json:"ipv6AddressConfigMethod,omitempty"`
+	// Settings that govern the priority to associate with outbound IPV6 packets sent over the interface
+	Ipv6OutboundPacketPriority SettingControl `json:"ipv6OutboundPacketPriority,omitzero"`
+	// Settings that govern the value of the IPV6 VLAN identifier for the interface. VLAN identifiers are in the
+	// range 1 through 4094.
+	Ipv6VlanID SettingControl `json:"ipv6VlanId,omitzero"`
+	// The hop limit to use in IPV6 packets sent over the interface.
+	Ipv6HopLimit int `json:"ipv6HopLimit,omitempty"`
+	// A "neighbor discovery" parameter - the amount of time in milliseconds, within which a neighbor is assumed to
+	// be reachable.
+	Ipv6NdReachableTime int `json:"ipv6NdReachableTime,omitempty"`
+	// A "neighbor discovery" parameter - the number of milliseconds between neighbor solicitation probes.
+	Ipv6NdRetransmitTime int `json:"ipv6NdRetransmitTime,omitempty"`
+	// A "neighbor discovery" parameter - the time in milliseconds after which information for a neighbor that
+	// cannot be verified as reachable will be considered "stale."
+	Ipv6NdStaleTimeout int `json:"ipv6NdStaleTimeout,omitempty"`
+	// The number of neighbor-solicitation messages to send in trying to determine IP address uniqueness.
+	Ipv6DuplicateAddressDetectionAttempts int `json:"ipv6DuplicateAddressDetectionAttempts,omitempty"`
+}
+
+// Interposer is the Interposer model.
+type Interposer struct {
+	// The drive interposer identifier.
+	InterposerRef string `json:"interposerRef,omitempty"`
+	// The vendor product data associated with the interposer.
+	InterposerTypeData InterposerTypeData `json:"interposerTypeData,omitzero"`
+}
+
+// InterposerData is the InterposerData model.
+type InterposerData struct {
+	// This data is a variable length ASCII text string containing the vendor identification of the interposer.
+	VendorID string `json:"vendorID,omitempty"`
+	// This data is a variable length ASCII text string containing the product identification of the interposer.
+	ProductID string `json:"productID,omitempty"`
+	// This data is a variable length ASCII text string that represents the current version, or revision level, of
+	// the interposer.
+	Revision string `json:"revision,omitempty"`
+	// This data represents the device name of the interposer.
+	DeviceWWN string `json:"deviceWWN,omitempty"`
+	// This data is a variable length ASCII text string that represents the serial number of the interposer.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// This data is a variable length ASCII text string that represents the part number of the interposer.
+	PartNumber string `json:"partNumber,omitempty"`
+	// 4 bytes left-justified ASCII drive firmware version as retrieved from the drive's standard INQUIRY data,
+	// followed by 4 blank bytes.
+	DriveFwVersion string `json:"driveFwVersion,omitempty"`
+}
+
+// InterposerTypeData is the InterposerTypeData model.
+type InterposerTypeData struct {
+	// This enumeration defines the different interposer types. Values: unknown, fcToSas.
+	InterposerType string `json:"interposerType,omitempty"`
+	// This field is available when the interfaceTypeData is equal to INTERPOSER_TYPE_FC_TO_SAS
+	FcSasInterposer InterposerData `json:"fcSasInterposer,omitzero"`
+	// Reserved.
+	Reserved string `json:"reserved,omitempty"`
+}
+
+// InventoryItem is the InventoryItem model.
+type InventoryItem struct {
+	// An ASCII string that provides the symbolic name of the item.
+	ItemName string `json:"itemName,omitempty"`
+	// An ASCII string that represents the current version, or revision level, of the item.
+	ItemVersion string `json:"itemVersion,omitempty"`
+}
+
+// IoInterface is the IoInterface model.
+type IoInterface struct {
+	// The SYMbol array-wide unique name for the interface object.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// The type of channel (e.g., host-side, drive-side) that the interface is associated with. Values: hostside,
+	// driveside, management.
+	ChannelType string `json:"channelType,omitempty"`
+	// A field whose contents vary depending on the I/O protocol type of the interface.
+	IoInterfaceTypeData IOInterfaceTypeData `json:"ioInterfaceTypeData,omitzero"`
+	// Uniquely identifies a particular physical RAID controller within the storage array.
+	ControllerRef string `json:"controllerRef,omitempty"`
+	// The list of properties that describe the command protocol.
+	CommandProtocolPropertiesList CommandProtocolPropertiesList `json:"commandProtocolPropertiesList,omitzero"`
+	// This field has been deprecated and should not be used.
+	ChannelPortRef string `json:"channelPortRef,omitempty"`
+	// List of channel port refs associated with IoInterface object.
+	ChannelPortRefs []string `json:"channelPortRefs,omitempty"`
+}
+
+// IoInterfaceHicMap is the IoInterfaceHicMap model.
+type IoInterfaceHicMap struct {
+	// A reference to the I/O interface.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// The reference to the associated HIC.
+	HostBoardRef string `json:"hostBoardRef,omitempty"`
+}
+
+// IomServiceInfoResponse is the IomServiceInfoResponse model.
+// API object that includes information about the IOM service.
+type IomServiceInfoResponse struct {
+	// Reports whether the IOM service is enabled.
+	IomServiceEnabled bool `json:"iomServiceEnabled"`
+	// Reports whether there are any trays for which an IOM firmware update would be disruptive.
+	DisruptiveIomFirmwareUpdate bool `json:"disruptiveIomFirmwareUpdate"`
+}
+
+// IomServiceUpdateRequest is the IomServiceUpdateRequest model.
+// API object for an IOM service change request.
+type IomServiceUpdateRequest struct {
+	// true to enable the service, false to disable.
+	Enabled bool `json:"enabled"`
+}
+
+// IPRange is the IpRange model.
+// Range of IP addresses. startIP and endIP must be of the same IP version (IPv4 or IPv6)
+type IPRange struct {
+	// Starting IP address
+	StartIP string `json:"startIP"`
+	// Ending IP address
+	EndIP string `json:"endIP"`
+}
+
+// IPV4AddressData is the IpV4AddressData model.
+type IPV4AddressData struct {
+	// Configuration state for the various address elements; always IP_CONFIG_STATE_CONFIGURED for iSER interfaces.
+	// Values: unconfigured, acquiring, configured, invalid.
+	ConfigState string `json:"configState,omitempty"`
+	// The IPV4 address associated with the endpoint.
+	Ipv4Address string `json:"ipv4Address,omitempty"`
+	// IPV4 subnet mask associated w/ the endpoint; used for iSER interfaces.
+	Ipv4SubnetMask string `json:"ipv4SubnetMask,omitempty"`
+	// IPV4 gateway address associated w/ the endpoint; used for iSER interfaces.
+	Ipv4GatewayAddress string `json:"ipv4GatewayAddress,omitempty"`
+}
+
+// IPV6AddressData is the IpV6AddressData model.
+type IPV6AddressData struct {
+	// The IPV6 address.
+	Address string `json:"address,omitempty"`
+	// The state of the IPV6 address.
+	AddressState IPV6AddressState `json:"addressState,omitzero"`
+}
+
+// IPV6AddressState is the IpV6AddressState model.
+type IPV6AddressState struct {
+	// This enumeration defines the two different types of IPV6 addresses - interface or router. Values:
+	// typeInterface, typeRouter.
+	AddressType string `json:"addressType,omitempty"`
+	// The interface address state. This field is only valid if addressType is equal to IPV6_ADDR_TYPE_INTERFACE.
+	// Values: unconfigured, acquiring, configured, invalid.
+	InterfaceAddressState string `json:"interfaceAddressState,omitempty"`
+	// The router address state. This field is only valid if addressType is equal to IPV6_ADDR_TYPE_ROUTER. Values:
+	// unknown, configured, advertised, stale.
+	RouterAddressState string `json:"routerAddressState,omitempty"`
+}
+
+// IPVxAddress is the IpVxAddress model.
+type IPVxAddress struct {
+	// This enumeration defines the different types of IP addresses, corresponding to different versions of the
+	// Internet protocol. Values: ipv4, ipv6.
+	AddressType string `json:"addressType,omitempty"`
+	// An IP address formatted according to version 4 of the Internet protocol. This field is only present if
+	// addressType is equal to IPV4.
+	Ipv4Address string `json:"ipv4Address,omitempty"`
+	// An IP address formatted according to version 6 of the Internet protocol. This field is only present if
+	// addressType is equal to IPV6.
+	Ipv6Address string `json:"ipv6Address,omitempty"`
+}
+
+// IPVxAddressData is the IpVxAddressData model.
+type IPVxAddressData struct {
+	// This enumeration defines the different types of IP addresses, corresponding to different versions of the
+	// Internet protocol. Values: ipv4, ipv6.
+	AddressType string `json:"addressType,omitempty"`
+	// Contains the various IPV4 address elements.
+	Ipv4Data IPV4AddressData `json:"ipv4Data,omitzero"`
+	// contains the various IPV6 address elements.
+	Ipv6Data IPV6AddressData `json:"ipv6Data,omitzero"`
+}
+
+// IscsiChapSecretValidationResponse is the IscsiChapSecretValidationResponse model.
+type IscsiChapSecretValidationResponse struct {
+	// Validation result
+	IsValid bool `json:"isValid"`
+}
+
+// IscsiEntityData is the IscsiEntityData model.
+type IscsiEntityData struct {
+	// True if the storage array is enabled to respond to ICMP ping messages.
+	IcmpPingResponseEnabled bool `json:"icmpPingResponseEnabled,omitempty"`
+	// True if the storage array is enabled to participate in unnamed discovery sessions.
+	UnnamedDiscoverySessionsEnabled bool `json:"unnamedDiscoverySessionsEnabled,omitempty"`
+	// The number of the TCP port on which the iSNS server listens for incoming connections.
+	IsnsServerTCPListenPort int `json:"isnsServerTcpListenPort,omitempty"`
+	// The method by which the storage array determines the IPV4 address of the iSNS server. Values: configDhcp,
+	// configStatic.
+	Ipv4IsnsServerAddressConfigMethod string `json:"ipv4IsnsServerAddressConfigMethod,omitempty"`
+	// The method by which the storage array determines the IPV4 address of the iSNS server.
+	Ipv4IsnsServerAddress string `json:"ipv4IsnsServerAddress,omitempty"`
+	// The method by which the storage array determines the IPV6 address of the iSNS server. This is always set to
+	// "static" and cannot be changed. Values: configStatic, configStateless.
+	Ipv6IsnsServerAddressConfigMethod string `json:"ipv6IsnsServerAddressConfigMethod,omitempty"`
+	// The method by which the storage array determines the IPV6 address of the iSNS server. This is always set to
+	// "static" and cannot be changed.
+	Ipv6IsnsServerAddress string `json:"ipv6IsnsServerAddress,omitempty"`
+	// The state of the iSCSI entity with respect to iSNS server registration. Values: disabled, locatingServer,
+	// connectingToServer, completed.
+	IsnsRegistrationState string `json:"isnsRegistrationState,omitempty"`
+	// True if the storage array is enabled to register with the iSNS (Internet Storage Name Service) server.
+	IsnsServerRegistrationEnabled bool `json:"isnsServerRegistrationEnabled,omitempty"`
+	// True if all the host ports have DHCP enabled, regardless of the configuration state.
+	HostPortsConfiguredDHCP bool `json:"hostPortsConfiguredDHCP,omitempty"`
+}
+
+// IscsiEntityResponse is the IscsiEntityResponse model.
+// Provides information about the storage array in its role as "iSCSI entity."
+type IscsiEntityResponse struct {
+	IcmpPingResponseEnabled         bool   `json:"icmpPingResponseEnabled,omitempty"`
+	UnnamedDiscoverySessionsEnabled bool   `json:"unnamedDiscoverySessionsEnabled,omitempty"`
+	IsnsServerTCPListenPort         int    `json:"isnsServerTcpListenPort,omitempty"`
+	Ipv4IsnsServerAddress           string `json:"ipv4IsnsServerAddress,omitempty"`
+	Ipv6IsnsServerAddress           string `json:"ipv6IsnsServerAddress,omitempty"`
+	IsnsServerRegistrationEnabled   bool   `json:"isnsServerRegistrationEnabled,omitempty"`
+	HostPortsConfiguredDHCP         bool   `json:"hostPortsConfiguredDHCP,omitempty"`
+	// Values: configDhcp, configStatic.
+	Ipv4IsnsServerAddressConfigMethod string `json:"ipv4IsnsServerAddressConfigMethod,omitempty"`
+	// Values: configStatic, configStateless.
+	Ipv6IsnsServerAddressConfigMethod string `json:"ipv6IsnsServerAddressConfigMethod,omitempty"`
+	// Values: disabled, locatingServer, connectingToServer, completed.
+	IsnsRegistrationState string `json:"isnsRegistrationState,omitempty"`
+}
+
+// IscsiEntityUpdateRequest is the IscsiEntityUpdateRequest model.
+// Updates the iSCSI entity data.
+type IscsiEntityUpdateRequest struct {
+	// The setting for enabling or disabling the ICMP (Internet Control Message Protocol) ping messages.
+	IcmpPingResponseEnabled *bool `json:"icmpPingResponseEnabled,omitempty"`
+	// The setting for enabling or disabling unnamed discovery sessions.
+	UnnamedDiscoverySessionsEnabled *bool `json:"unnamedDiscoverySessionsEnabled,omitempty"`
+	// The number of the TCP port on which the iSNS server listens for incoming connections.
+	IsnsServerTCPListenPort *int `json:"isnsServerTcpListenPort,omitempty"`
+	// The IPV4 configuration method to use for determining the IP address of the iSNS server. Whenever there is a
+	// transition of the configuration method from IPV4_CONFIG_STATIC to IPV4_CONFIG_DHCP, the storage array
+	// performs the equivalent of a refreshIsnsServerLocation operation. Values: configDhcp, configStatic.
+	Ipv4IsnsServerAddressConfigMethod string `json:"ipv4IsnsServerAddressConfigMethod,omitempty"`
+	// The IPV4 configuration method to use for determining the IP address of the iSNS server. Whenever there is a
+	// transition of the configuration method from IPV4_CONFIG_STATIC to IPV4_CONFIG_DHCP, the storage array
+	// performs the equivalent of a refreshIsnsServerLocation operation.
+	Ipv4IsnsServerAddress string `json:"ipv4IsnsServerAddress,omitempty"`
+	// The IPV6 address of the iSNS server.
+	Ipv6IsnsServerAddress string `json:"ipv6IsnsServerAddress,omitempty"`
+	// The setting is for enabling or disabling storage array registration with the iSNS server.
+	IsnsServerRegistrationEnabled *bool `json:"isnsServerRegistrationEnabled,omitempty"`
+}
+
+// IscsiInitiatorChapSecretValidationRequest is the IscsiInitiatorChapSecretValidationRequest model.
+type IscsiInitiatorChapSecretValidationRequest struct {
+	// The initiator to validate against
+	InitiatorRef string `json:"initiatorRef"`
+	// The value to be validated
+	TestValue string `json:"testValue"`
+}
+
+// IscsiInitiatorPortID is the IscsiInitiatorPortId model.
+type IscsiInitiatorPortID struct {
+	// The iSCSI node name of the initiator.
+	InitiatorNodeName string `json:"initiatorNodeName,omitempty"`
+	// The initiator session ID associated with the initiator port.
+	InitiatorSessionID string `json:"initiatorSessionId,omitempty"`
+}
+
+// IscsiInitiatorTargetBaseParameters is the IscsiInitiatorTargetBaseParameters model.
+type IscsiInitiatorTargetBaseParameters struct {
+	// The IP addresses, portal group numbers, and port number for the initiator or target.
+	Portals []TargetPortal `json:"portals,omitempty"`
+}
+
+// IscsiInterface is the IscsiInterface model.
+type IscsiInterface struct {
+	// The number of the channel controlled by this interface.
+	Channel int `json:"channel,omitempty"`
+	// A reference to the channel port object associated with the channel controlled by this interface.
+	ChannelPortRef string `json:"channelPortRef,omitempty"`
+	// The number of the TCP port on which the target listens for incoming connections.
+	TCPListenPort int `json:"tcpListenPort,omitempty"`
+	// True if IPV4 is enabled for this interface; always true for iSER interface.
+	Ipv4Enabled bool `json:"ipv4Enabled,omitempty"`
+	// IPV4-related information for the interface.
+	Ipv4Data InternetProtocolV4Data `json:"ipv4Data,omitzero"`
+	// Information about the physical interface (e.g., Ethernet).
+	InterfaceData PhysicalInterfaceTypeData `json:"interfaceData,omitzero"`
+	// This field has been deprecated and should not be used.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// True if IPV6 is enabled for this interface; otherwise false.
+	Ipv6Enabled bool `json:"ipv6Enabled,omitempty"`
+	// IPV6-related information for the interface.
+	Ipv6Data InternetProtocolV6Data `json:"ipv6Data,omitzero"`
+	// The physical location of the iSCSI interface. The parent reference in Location identifies the physical
+	// component (e.g., controller or host card) where the interface circuitry is located, and the position field is
+	// a firmware-assigned 1-relative number signifying "1st iSCSI interface relative to the parent," "2nd iSCSI
+	// interface relative to the parent," etc. This "interface number" is independent of the interface's channel
+	// association.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// This field indicates whether or not the I/O interface is PI capable.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// This flag is true if the interface is capable of IPv6 functionality.
+	IsIPv6Capable bool `json:"isIPv6Capable,omitempty"`
+	// Maximum one way data rate in B/s
+	OneWayMaxRate string `json:"oneWayMaxRate,omitempty"`
+	// Maximum bi-directional data rate in B/s
+	BidirectionalMaxRate string `json:"bidirectionalMaxRate,omitempty"`
+	// Can capture network traces if true.
+	IsNetworkTraceCapable bool `json:"isNetworkTraceCapable,omitempty"`
+	// Indicates whether this interface supports the remote storage volume capability.
+	IsRemoteStorageVolumeCapable bool   `json:"isRemoteStorageVolumeCapable,omitempty"`
+	ID                           string `json:"id,omitempty"`
+}
+
+// IscsiInterfaceUpdateRequest is the IscsiInterfaceUpdateRequest model.
+// Used to update iSCSI interface settings.
+type IscsiInterfaceUpdateRequest struct {
+	// The configured Forward Error Correction mode. Values: none, fireCode, reedSolomon, auto.
+	FecMode string `json:"fecMode,omitempty"`
+}
+
+// IscsiRemoteTargetConnections is the IscsiRemoteTargetConnections model.
+type IscsiRemoteTargetConnections struct {
+	// Connected remote target ports.
+	ConnectedRemoteTargetPortals []TargetPortal `json:"connectedRemoteTargetPortals,omitempty"`
+}
+
+// IscsiTargetChapSecretValidationRequest is the IscsiTargetChapSecretValidationRequest model.
+type IscsiTargetChapSecretValidationRequest struct {
+	// The value to be validated
+	TestValue string `json:"testValue"`
+}
+
+// IscsiTargetResponse is the IscsiTargetResponse model.
+// Provides information for the iSCSI target.
+type IscsiTargetResponse struct {
+	// Authentication type (None or CHAP) Values: none, chap.
+	AuthMethod string `json:"authMethod"`
+	// CHAP secret/password.
+	ChapSecret string `json:"chapSecret"`
+	// iSCSI Qualified Name (iqn)
+	Iqn string `json:"iqn"`
+	// The iSCSI target alias.
+	Alias string `json:"alias"`
+}
+
+// IscsiTargetUpdateRequest is the IscsiTargetUpdateRequest model.
+// Updates the iSCSI target data.
+type IscsiTargetUpdateRequest struct {
+	// The iSCSI target alias.
+	Alias string `json:"alias,omitempty"`
+	// Enable Challenge-Handshake Authentication Protocol (CHAP), defaults to false.
+	EnableChapAuthentication *bool `json:"enableChapAuthentication,omitempty"`
+	// Enable Challenge-Handshake Authentication Protocol (CHAP) using the provided password. A secure password will
+	// be generated and returned if CHAP is enabled and this field is not provided.
+	ChapSecret string `json:"chapSecret,omitempty"`
+}
+
+// IsnsServerLocation is the IsnsServerLocation model.
+type IsnsServerLocation struct {
+	// The IP address of the iSNS server.
+	IPAddress IPVxAddress `json:"ipAddress,omitzero"`
+	// The number of the TCP port on which the iSNS server listens for incoming connections.
+	TCPListenPort int `json:"tcpListenPort,omitempty"`
+}
+
+// JobDescriptor is the JobDescriptor model.
+type JobDescriptor struct {
+	// Designates the type of HTTP request to be made (GET, POST, etc.). Values: GET, POST, PUT, DELETE.
+	HTTPVerb string `json:"httpVerb"`
+	// The URL for the operation that is to be called. For paths behind "storage-systems" that have a system ID, use
+	// a place holder for the ID {id}. This value will be substituted before making the call. Any other query
+	// parameters or path values should be specified directly in the URL.
+	APIURL string `json:"apiURL"`
+	// A list of storage system ID's (or WWN's) that the operation is performed against.
+	SystemIds []string `json:"systemIds"`
+	// The number of times a given job will be retried if a timeout or failure occurs.
+	NumberRetries int `json:"numberRetries,omitempty"`
+	// The number of seconds to wait on an API call against a target system before timing out.
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+	// The JSON payload body for this request (POST, PUT). Blank if there is no body.
+	RequestBodyDocument string `json:"requestBodyDocument,omitempty"`
+}
+
+// JobInfo is the JobInfo model.
+// Information about a replication job
+type JobInfo struct {
+	// Job identifier
+	ID string `json:"id"`
+	// Time the job was started
+	StartTime string `json:"startTime"`
+	// Requested types to configure
+	ConfigTypes []string `json:"configTypes"`
+	// Requested configuration model
+	ConfigModel SystemConfig `json:"configModel"`
+	// Total duration of the job in milliseconds.
+	DurationMs string `json:"durationMs"`
+	// State of the job Values: running, canceled, completed, failed.
+	State string `json:"state"`
+	// Percentage complete of Job. Valid values are 0 - 100 (inclusive)
+	PercentComplete int `json:"percentComplete"`
+	// Number of steps performed
+	NumberSteps int `json:"numberSteps"`
+	// Number of steps that failed
+	NumberFailures int `json:"numberFailures"`
+	// Information on the job's executed steps
+	Steps []JobStep `json:"steps"`
+}
+
+// JobProgress is the JobProgress model.
+// A Volume Copy Job Control provides the start-stop operation for a <a href="VolumeCopyPair.html">Volume Copy
+// Job</a> and reports the copy progress of the volume copy job. <br/><br/> The valid values for the PUT control
+// attribute are "start" and "stop"
+type JobProgress struct {
+	ObjectID        string `json:"objectId"`
+	PercentComplete int    `json:"percentComplete"`
+}
+
+// JobStep is the JobStep model.
+// Information about a replication job step
+type JobStep struct {
+	// Sequence number of the this job step within the job
+	SequenceNumber int `json:"sequenceNumber"`
+	// Type of the job step Values: createVolumeGroup, createPool, createVolume, configureVolumeSettings,
+	// configureVolumeCacheSettings, configureVolumeMediaScanSettings, assignHotSpare, configureSystemCacheSettings,
+	// configureSystemCacheMirroringProtectionSettings, configureSystemMediaScanSettings,
+	// configureSystemAutoLoadBalancingSettings, undefinedStep.
+	Type string `json:"type"`
+	// Name of the affected object
+	ObjectName string `json:"objectName"`
+	// Time the job step was started
+	StartTime string `json:"startTime"`
+	// Total duration of the job step in milliseconds.
+	DurationMs string `json:"durationMs"`
+	// State of the job step Values: success, failedAndHalted, failedAndContinued.
+	State string `json:"state"`
+	// Type of error reported Values: none, internal, symbol, http.
+	ErrorType string `json:"errorType"`
+	// Error code for additional info on failures
+	ErrorCode string `json:"errorCode"`
+}
+
+// KeyServer is the KeyServer model.
+// External key server.
+type KeyServer struct {
+	// The address of the key server.
+	KmsAddress string `json:"kmsAddress"`
+	// The port number of the key server.
+	KmsPort int `json:"kmsPort"`
+}
+
+// KeyValue is the KeyValue model.
+// The key/value pair.
+type KeyValue struct {
+	// The key.
+	Key string `json:"key,omitempty"`
+	// Provide a list of values.
+	ValueList []string `json:"valueList,omitempty"`
+}
+
+// KeyValuePair is the KeyValuePair model.
+// Simple structure to hold key value pairs
+type KeyValuePair struct {
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// KeyValueTag is the KeyValueTag model.
+type KeyValueTag struct {
+	// The unique handle for a key-value object contained within this structure.
+	KeyValueTagRef string `json:"keyValueTagRef,omitempty"`
+	// The key is a fixed length, opaque byte sequence that stores user-defined data.
+	Key string `json:"key,omitempty"`
+	// The value is a fixed length opaque byte sequence that stores user-defined data.
+	Value string `json:"value,omitempty"`
+}
+
+// KeyValueTagMapping is the KeyValueTagMapping model.
+type KeyValueTagMapping struct {
+	// This field identifies the key-value tag to be associated with the Volume or Workload.
+	KeyValueTagRef string `json:"keyValueTagRef,omitempty"`
+	// This element identifies the object that is associated with the key-value tag.
+	AssociatedObject KeyValueTagObjectReference `json:"associatedObject,omitzero"`
+	// The unique identifier for the specific mapping between the KeyValueTag and the KeyValueTagObjectReference
+	// identified in this structure.
+	MappingRef string `json:"mappingRef,omitempty"`
+}
+
+// KeyValueTagObjectReference is the KeyValueTagObjectReference model.
+type KeyValueTagObjectReference struct {
+	// Enumeration used to define the different type of object that can be associated with a key value tag. Values:
+	// unknown, volume, workload.
+	ObjectType string `json:"objectType,omitempty"`
+	// This field is returned for an object of type Volume.
+	Volume string `json:"volume,omitempty"`
+	// This field is returned for an object of type Workload.
+	Workload string `json:"workload,omitempty"`
+}
+
+// KeystoreChangeEvent is the KeystoreChangeEvent model.
+type KeystoreChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType       string `json:"eventType"`
+	StorageSystemID string `json:"storageSystemId"`
+}
+
+// LUNMapping is the LUNMapping model.
+type LUNMapping struct {
+	// The unique identification value for this object. Other objects may use this reference value to refer to the
+	// mapping definition.
+	LunMappingRef string `json:"lunMappingRef,omitempty"`
+	// The unique identification value for this object. Other objects may use this reference value to refer to the
+	// mapping definition.
+	Lun int `json:"lun,omitempty"`
+	// An internal volume identifier value that is not meaningful outside the firmware environment.
+	Ssid int `json:"ssid,omitempty"`
+	// The permission values associated with this mapping definition. This field is not currently used, but is
+	// provided for future extensions.
+	Perms int `json:"perms,omitempty"`
+	// The unique identification value of the volume that is accessed via the LUN value associated with this mapping
+	// definition.
+	VolumeRef string `json:"volumeRef,omitempty"`
+	// The type of the LUN mapping. Values: none, hostport, host, cluster, saport, saportgroup, hostsaconnection,
+	// all.
+	Type string `json:"type,omitempty"`
+	// The unique identifier of the entity for which the mapping applies. The type of the entity, and thus of the
+	// SYMbol reference, depends on the mapping type. For example, if the LUNMapping object represents a
+	// cluster-wide mapping, this field will contain the ClusterRef of the cluster for which the mapping applies.
+	// Similarly, if the object represents a host-specific mapping, this field will contain the HostRef of the host
+	// for which the mapping applies. Note that if the object represents a default mapping, this field will contain
+	// a NULL SYMbolRef.
+	MapRef string `json:"mapRef,omitempty"`
+	ID     string `json:"id,omitempty"`
+}
+
+// LdapConfiguration is the LdapConfiguration model.
+// Structure that contains information about an LDAP Configuration
+type LdapConfiguration struct {
+	// The defined LDAP domains
+	LdapDomains []LdapDomain `json:"ldapDomains"`
+}
+
+// LdapConfigurationChangeEvent is the LdapConfigurationChangeEvent model.
+type LdapConfigurationChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType       string `json:"eventType"`
+	StorageSystemID string `json:"storageSystemId"`
+}
+
+// LdapDomain is the LdapDomain model.
+// Structure that contains information about an LDAP Domain
+type LdapDomain struct {
+	// Unique ID linked to this domain
+	ID string `json:"id,omitempty"`
+	// If needed, you can configure a specific user to use when looking up the group membership for users.
+	// Typically, you would configure this whenever regular users might lack reader permissions to view their own
+	// group membership. The bind user information must be specified as a full DN.
+	BindLookupUser BindLookupUser `json:"bindLookupUser,omitzero"`
+	// A list of group attributes on the user that will be searched to for group to role mapping
+	GroupAttributes []string `json:"groupAttributes"`
+	// The LDAP URL entry must be specified as either ldap or ldaps protocol and contain the IP address. In
+	// addition, the port for the LDAP URL must be specified (typically 389 for ldap and 636 for ldaps).
+	LdapURL string `json:"ldapUrl"`
+	// Each domain will have one or multiple names and it is presumed the name will match the DNS domain for the
+	// LDAP server but it is not required. Domains can be named anything as long as they are valid DNS names.
+	Names []string `json:"names"`
+	// A list of regular expression patterns to match to the user's group attributes to match to roles.
+	RoleMapCollection []GroupMapping `json:"roleMapCollection,omitempty"`
+	// The search base is used to find group memberships of the user. The search base is the DN in the directory of
+	// a container object of users. The filter base is used to find the user object within this container. After the
+	// user object is located, any associated group membership is identified.
+	SearchBase string `json:"searchBase,omitempty"`
+	// The user attribute is used match the username supplied to an object on the server
+	UserAttribute string `json:"userAttribute,omitempty"`
+}
+
+// LdapDomainTestResponse is the LdapDomainTestResponse model.
+// Result of a test against an LDAP domain
+type LdapDomainTestResponse struct {
+	// ID of the configured domain
+	ID     string                        `json:"id,omitempty"`
+	Result LdapDomainTestResponseResults `json:"result,omitzero"`
+}
+
+// LdapDomainTestResponseResults is the LdapDomainTestResponseResults model.
+// Result of a test against an LDAP domain
+type LdapDomainTestResponseResults struct {
+	// Results of the connection test Values: ok, failed, notRun.
+	ConnectionTestResult string `json:"connectionTestResult,omitempty"`
+	// Results of the authentication test Values: ok, failed, notRun.
+	AuthenticationTestResult string `json:"authenticationTestResult,omitempty"`
+	// Results of the search test Values: ok, failed, notRun.
+	SearchTestResult string `json:"searchTestResult,omitempty"`
+}
+
+// LdapRequest is the LdapRequest model.
+// Request to update the LDAP configuration.
+type LdapRequest struct {
+	// The LDAP domain configurations.
+	LdapDomains []LdapDomain `json:"ldapDomains"`
+}
+
+// LegacySnapshotCreateRequest is the LegacySnapshotCreateRequest model.
+// Creates a legacy Snapshot (non-PiT).
+type LegacySnapshotCreateRequest struct {
+	// The identifier of the volume or thin volume for the new snapshot group.
+	BaseVolumeID string `json:"baseVolumeId"`
+	// The name of the new snapshot.
+	Name string `json:"name"`
+	// Size of the snapshot repository in relation to the base volume capacity
+	RepositoryPercentage *float64 `json:"repositoryPercentage,omitempty"`
+	// Warn the user when the repository is % full.
+	WarningThreshold int `json:"warningThreshold"`
+	// The behavior on when the data repository becomes full. Values: failwrites, failsnap.
+	FullPolicy string `json:"fullPolicy,omitempty"`
+	// The identifier of the storage pool to allocate the repository volume.
+	StoragePoolID string `json:"storagePoolId,omitempty"`
+}
+
+// LegacySnapshotEx is the LegacySnapshotEx model.
+type LegacySnapshotEx struct {
+	// The World Wide Name of the snapshot.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// Reference (key) for the snapshot volume.
+	SnapshotRef string `json:"snapshotRef,omitempty"`
+	// Name of the snapshot.
+	Label string `json:"label,omitempty"`
+	// Indicates status of the snapshot. Values: active, failed, disabled, offline.
+	Status string `json:"status,omitempty"`
+	// Long running action. Values: none, rollback.
+	Action string `json:"action,omitempty"`
+	// Current owner of the snapshot.
+	CurrentManager string `json:"currentManager,omitempty"`
+	// Base volume for this snapshot.
+	BaseVolume string `json:"baseVolume,omitempty"`
+	// Repository volume for this snapshot.
+	RepositoryVolume string `json:"repositoryVolume,omitempty"`
+	// Repository utilization warning threshold.
+	FullWarnThreshold int `json:"fullWarnThreshold,omitempty"`
+	// True if the repository utilization is over the warning threshold.
+	OverWarnThreshold bool `json:"overWarnThreshold,omitempty"`
+	// True, if the repository is full.
+	RepositoryFull bool `json:"repositoryFull,omitempty"`
+	// Indication of the volume behavior when the repository is full. Values: failwrites, failsnap.
+	RepFullPolicy string `json:"repFullPolicy,omitempty"`
+	// The importance of the rollback operation (0-4). Not used in this release of software.
+	RollbackPriority int `json:"rollbackPriority,omitempty"`
+	// The start position of the extent in the base volume (bytes). Not used in this release of software.
+	ExtentStart string `json:"extentStart,omitempty"`
+	// The size of the extent in bytes. Not used in this release of software.
+	ExtentSize string `json:"extentSize,omitempty"`
+	// The controller time when the snapshot was created.
+	SnapshotTime string `json:"snapshotTime,omitempty"`
+	// Permissions allowed on volume.
+	Perms VolumePerms `json:"perms,omitzero"`
+	// True if all child volumes are offline or failed. Child volumes are the base volume and the REPOSITORY volume.
+	ChildrenInactive bool `json:"childrenInactive,omitempty"`
+	// Volume SSID.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// For use by management client.
+	MgmtClientAttribute int `json:"mgmtClientAttribute,omitempty"`
+	// Status of current action being performed on snapshot. Values: none, inProgress, pending, paused.
+	RollbackStatus        string `json:"rollbackStatus,omitempty"`
+	BaseVolumeSizeInBytes string `json:"baseVolumeSizeInBytes,omitempty"`
+	TotalSizeInBytes      string `json:"totalSizeInBytes,omitempty"`
+	PreferredControllerID string `json:"preferredControllerId,omitempty"`
+	RepositoryCapacity    string `json:"repositoryCapacity,omitempty"`
+	VolumeCopyTarget      bool   `json:"volumeCopyTarget,omitempty"`
+	VolumeCopySource      bool   `json:"volumeCopySource,omitempty"`
+	PitBaseVolume         bool   `json:"pitBaseVolume,omitempty"`
+	// True if the volume is a target in an Asynchronous Mirror relationship.
+	AsyncMirrorTarget bool `json:"asyncMirrorTarget,omitempty"`
+	// True if the volume is a source in an Asynchronous Mirror relationship.
+	AsyncMirrorSource bool `json:"asyncMirrorSource,omitempty"`
+	// Values: type0Protection, type1Protection, type2Protection, type3Protection.
+	ProtectionType string `json:"protectionType,omitempty"`
+	// True if the volume is a source (primary), in an Remote Volume Mirror relationship.
+	RemoteMirrorSource bool `json:"remoteMirrorSource,omitempty"`
+	// True if the volume is a target (secondary), in an Remote Volume Mirror relationship.
+	RemoteMirrorTarget bool   `json:"remoteMirrorTarget,omitempty"`
+	Name               string `json:"name,omitempty"`
+	ID                 string `json:"id,omitempty"`
+	// Values: unknown, volume, pool, host, lunMapping, hostGroup, thinVolume, drive, volumeCopy, pit, pitView,
+	// snapshotGroup, snapshot, accessVolume, legacySnapshot, hostType, metadataTag, managementUrl, folder,
+	// asyncMirrorGroup, asyncMirrorGroupMember, asyncMirrorGroupIncompleteMember, consistencyGroup,
+	// consistencyGroupView, fan, battery, storageSystem, controller, powerSupply, minihub, esm, drawer, hostBoard,
+	// interconnectCRU, cacheBackupDevice, tray, supportCRU, hostPort, initiator, snapshotSchedule, thermalSensor,
+	// sfp, flashCache, featureAttribute, featureState, lockKeyId, remoteVolume, mirrorVolume, vaultMirrorVolume,
+	// vaultMirrorGroup, metadataVolume, sasPort, sasExpander, channelPort, speedNegError, snmpAgentBundle,
+	// stagedFirmware, workload, ekmsCertRevocatSettings, databaseMetadata, directoryServicesDomain,
+	// kmsCertificateExpirationDetails, externalKMSEndpoint, notOnPreferredPath, remoteStorageVolume, remoteStorage,
+	// alarm.
+	ObjectType          string       `json:"objectType,omitempty"`
+	CurrentControllerID string       `json:"currentControllerId,omitempty"`
+	WWN                 string       `json:"wwn,omitempty"`
+	Mapped              bool         `json:"mapped"`
+	ListOfMappings      []LUNMapping `json:"listOfMappings,omitempty"`
+	OnlineVolumeCopy    bool         `json:"onlineVolumeCopy,omitempty"`
+}
+
+// LegacySnapshotUpdateRequest is the LegacySnapshotUpdateRequest model.
+// Updates the legacy Snapshot (non-PiT) data.
+type LegacySnapshotUpdateRequest struct {
+	// Warn the user when the repository is % full.
+	WarningThreshold *int `json:"warningThreshold,omitempty"`
+	// The behavior on when the data repository becomes full. Values: failwrites, failsnap.
+	FullPolicy string `json:"fullPolicy,omitempty"`
+	// New name for the snapshot.
+	NewName string `json:"newName,omitempty"`
+	// Recreate the snapshot.
+	RecreateSnapshot *bool `json:"recreateSnapshot,omitempty"`
+}
+
+// LocalUserInfoResponse is the LocalUserInfoResponse model.
+// Local admin password
+type LocalUserInfoResponse struct {
+	// True if the admin password is set
+	AdminPasswordSet bool `json:"adminPasswordSet"`
+	// The minimum required length
+	MinimumPasswordLength int `json:"minimumPasswordLength"`
+}
+
+// LocalUserPasswordRequest is the LocalUserPasswordRequest model.
+// Request to change the local user account password
+type LocalUserPasswordRequest struct {
+	// The local account user to update
+	UserName string `json:"userName"`
+	// The new password to use for local account.
+	NewPassword string `json:"newPassword,omitempty"`
+}
+
+// LocalUserRequest is the LocalUserRequest model.
+// Request to change one or more local user account password
+type LocalUserRequest struct {
+	// Password for the admin user
+	CurrentAdminPassword string `json:"currentAdminPassword,omitempty"`
+	// List of updates to perform to local users
+	Updates []LocalUserPasswordRequest `json:"updates,omitempty"`
+}
+
+// LocalUserResponse is the LocalUserResponse model.
+// Information about a local user account
+type LocalUserResponse struct {
+	// The username for this local account
+	UserName string `json:"userName"`
+	// The list of roles assigned to this user
+	Roles []string `json:"roles"`
+	// True if the password is not the default
+	PasswordSet bool `json:"passwordSet"`
+}
+
+// LocalUsersMinPasswordRequest is the LocalUsersMinPasswordRequest model.
+// Set the minimum password length
+type LocalUsersMinPasswordRequest struct {
+	// The minimum password length required
+	MinimumPasswordLength int `json:"minimumPasswordLength"`
+}
+
+// LocalizedLogMessage is the LocalizedLogMessage model.
+type LocalizedLogMessage struct {
+	// Logger type of this message Values: cfwUpgradeLogger, driveUpgradeLogger, iomServiceLogger,
+	// nvsramUpgradeLogger, cfwUpgradeTimestamp, driveUpgradeTimestamp, iomServiceTimestamp, nvsramUpgradeTimestamp,
+	// batchLogger, systemReplLogger, cfwBatchUpgradeLogger, systemConfigLogger, auditLogLogger.
+	LoggerType string `json:"loggerType"`
+	// Timestamp for this log message
+	Timestamp string `json:"timestamp"`
+	// Localized message text
+	MessageText string `json:"messageText,omitempty"`
+	// Log message context
+	Context string `json:"context"`
+	// ReplicatedRecord ID for this message
+	RecordID string `json:"recordId"`
+}
+
+// Location is the Location model.
+type Location struct {
+	// This field has been deprecated and should not be used.
+	TrayRef string `json:"trayRef,omitempty"`
+	// This field has been deprecated and should not be used.
+	Slot int `json:"slot,omitempty"`
+	// An object reference that identifies the parent physical component (e.g., Tray, CRU, etc.) of the object
+	// containing the Location attribute.
+	LocationParent ObjectReference `json:"locationParent,omitzero"`
+	// The parent-relative/like-component relative position of the object containing the Location attribute.
+	LocationPosition int `json:"locationPosition,omitempty"`
+	// Label associated with the location (i.e., label printed on the canister or the enclosure).
+	Label string `json:"label,omitempty"`
+}
+
+// LockKeyID is the LockKeyID model.
+type LockKeyID struct {
+	// The unique reference to a lockKeyID.
+	LockKeyIDRef string `json:"lockKeyIDRef,omitempty"`
+	// The lock key ID.
+	LockKeyID string `json:"lockKeyID,omitempty"`
+}
+
+// LockdownStatusResponse is the LockdownStatusResponse model.
+// The lockdown status response for the storage system.
+type LockdownStatusResponse struct {
+	// True if the storage system is in lockdown mode, otherwise false.
+	IsLockdown bool `json:"isLockdown"`
+	// The storage system label.
+	StorageSystemLabel string `json:"storageSystemLabel"`
+	// The lockdown type. Values: unknown, dacstoreMismatch, dacstoreUprev, databaseRecovery, missingDrives,
+	// cacheRestoreRecovery, invalidMemoryConfig, t10PiLockdown, ctrlTypeMismatch, hicUnsupp, sasPersistentDe,
+	// procMultiEccErr, procSingleEccErr, cacheMultiEccErr, iocDiagFailed, genHwErr, softPiThreshExceed,
+	// hardPiThreshExceed, acsFailure, unsuppHostCardMix, smidMismatch, dimmLayoutInvalid,
+	// insuffMemBackupDevMetadata, cantCreateBackupVol, cantCreateBackupVolGroup, mixedMemDimms, uncertDimmSizes,
+	// invalidMemSize, cfwMismatchAfterAcs, simplexWrongSlot, crushInvalidMemCfg, eepromAccessErr,
+	// sbbFailValidation, unexpectedEnclosureVpd, cantReadAltCtlBoardId, cantReadAltHostBoardId, unsuppEnclosure,
+	// drvportMapTablesMissing, rebootLimitExceeded, nonFruComponentReplaced, preserveAltBackupImage, dontPullCtrl,
+	// cbaCfgSyncAlt, cbaCfgRemoved, bmcUnrecoverable, removableIccErr, ctrlBoardIdMismatch, cacheMismatch,
+	// lowPowerSupplyInputVoltage, sasExpanderError, invalidPoolVersion.
+	LockdownType string `json:"lockdownType"`
+	// The codes associated with the lockdown that would be shown on the seven segment display. This will only be
+	// populated when using the embedded API on the controller. This may be null.
+	SevenSegmentCodes string `json:"sevenSegmentCodes,omitempty"`
+	// Deprecated since 07.00 - use limitedAccessState. True if the system has drives available to create the
+	// primary database, otherwise false.
+	HasDrives bool `json:"hasDrives"`
+	// The limited access state. If the storage system has accessible drives, a value of none will be set. If the
+	// storage system does not have any accessible drives and a drives key server error is detected, a value of
+	// drivesSecurityKeyError will be set. If there are no accessible drives and no key server error is detected,
+	// then a value of drivesUnavailable will be set. If there are any errors retrieving data from the storage
+	// system to determine the appropriate value of this field, then a value of unknown will be set. Values:
+	// drivesSecurityKeyError, drivesUnavailable, none, unknown.
+	LimitedAccessState string `json:"limitedAccessState,omitempty"`
+	// True if the storage system is in lockdown mode and the lockdown is clearable, otherwise false. This will only
+	// be populated when using the embedded API on the controller.
+	LockdownClearable bool `json:"lockdownClearable"`
+}
+
+// LockoutSettings is the LockoutSettings model.
+// Lockout settings.
+type LockoutSettings struct {
+	// Values: ip, user.
+	LockoutMode          string `json:"lockoutMode,omitempty"`
+	LockoutTime          int    `json:"lockoutTime,omitempty"`
+	MaximumLoginAttempts int    `json:"maximumLoginAttempts,omitempty"`
+}
+
+// LoggerRecordResponse is the LoggerRecordResponse model.
+type LoggerRecordResponse struct {
+	// Type of log records returned Values: cfwUpgradeLogger, driveUpgradeLogger, iomServiceLogger,
+	// nvsramUpgradeLogger, cfwUpgradeTimestamp, driveUpgradeTimestamp, iomServiceTimestamp, nvsramUpgradeTimestamp,
+	// batchLogger, systemReplLogger, cfwBatchUpgradeLogger, systemConfigLogger, auditLogLogger.
+	RecordType string `json:"recordType,omitempty"`
+	// A Map of log records requested
+	LogRecords []LocalizedLogMessage `json:"logRecords,omitempty"`
+	// A Map of timestamps for each logger type
+	TimeStamps map[string]string `json:"timeStamps"`
+}
+
+// MSataDeviceWearStatistics is the MSataDeviceWearStatistics model.
+// The drive wear statistics for a given mSata device.
+type MSataDeviceWearStatistics struct {
+	// The device type. Values: unknown, usbFlash, sdFlash, sataFlash.
+	DeviceType string `json:"deviceType,omitempty"`
+	// The block size of device.
+	BlockSize int `json:"blockSize,omitempty"`
+	// The average PE cycle count.
+	AveragePECycleCount string `json:"averagePECycleCount,omitempty"`
+	// The maximum PE cycle count.
+	MaxPECycleCount string `json:"maxPECycleCount,omitempty"`
+	// The software version.
+	SoftwareVersion string `json:"softwareVersion,omitempty"`
+	// The total host write block count.
+	TotalHostWriteBlockCount string `json:"totalHostWriteBlockCount,omitempty"`
+	// The total NAND write block count.
+	TotalNandWriteBlockCount string `json:"totalNandWriteBlockCount,omitempty"`
+	// The model number.
+	ModelNumber string `json:"modelNumber,omitempty"`
+	// The wear life percentage used.
+	WearLifePercentageUsed int `json:"wearLifePercentageUsed,omitempty"`
+	// The hours since the last power cycle.
+	PowerOnHours string `json:"powerOnHours,omitempty"`
+	// The reallocated block count.
+	ReallocatedBlockCount string `json:"reallocatedBlockCount,omitempty"`
+	// The reported error count.
+	ReportedErrorCount string `json:"reportedErrorCount,omitempty"`
+	// The serial number.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// The drive capacity in bytes.
+	DriveCapacityInBytes string `json:"driveCapacityInBytes,omitempty"`
+	// The vendor.
+	Vendor string `json:"vendor,omitempty"`
+}
+
+// MSataWearStatistics is the MSataWearStatistics model.
+// The mSata device's drive wear statistics for the given controller.
+type MSataWearStatistics struct {
+	// The timestamp of the wear life collection in ISO-8601 format.
+	TimeStamp string `json:"timeStamp,omitempty"`
+	// The owning controller label of the mSata device. Values: a, b.
+	OwningControllerLabel string `json:"owningControllerLabel,omitempty"`
+	// The drive wear statistics for each device.
+	Device []MSataDeviceWearStatistics `json:"device,omitempty"`
+}
+
+// MailAddressDetails is the MailAddressDetails model.
+// Contains data required to send an ASUP bundle via email
+type MailAddressDetails struct {
+	// Destination email address
+	DestinationAddress string `json:"destinationAddress,omitempty"`
+	// Mail relay server address
+	MailRelayServer string `json:"mailRelayServer,omitempty"`
+	// Email address of sender
+	MailSenderAddress string `json:"mailSenderAddress,omitempty"`
+}
+
+// ManagementCertificates is the ManagementCertificates model.
+// Provides information for the discovered certificates
+type ManagementCertificates struct {
+	// Indicates if the certificate is associated with an already added array.
+	StorageArrayAssociation bool `json:"storageArrayAssociation"`
+	// The IP associated with the certificate
+	AssociatedIP string                  `json:"associatedIP"`
+	Controller   StorageSystemController `json:"controller,omitzero"`
+	Certificate  X509CertInfo            `json:"certificate"`
+}
+
+// ManagementConfigurationRequest is the ManagementConfigurationRequest model.
+type ManagementConfigurationRequest struct {
+	ControllerRef string `json:"controllerRef"`
+	// If set to true, the controller is enabled for establishment of a remote access session. Depending on the
+	// controller platform, the method for remote access could be rlogin or telnet
+	EnableRemoteAccess *bool `json:"enableRemoteAccess,omitempty"`
+	// Manually specify the address of the gateway.
+	Ipv4GatewayAddress        string `json:"ipv4GatewayAddress,omitempty"`
+	Ipv6GatewayAddress        string `json:"ipv6GatewayAddress,omitempty"`
+	Ipv6StaticRoutableAddress string `json:"ipv6StaticRoutableAddress,omitempty"`
+	// Reference to the Ethernet interface to configure
+	InterfaceRef string `json:"interfaceRef"`
+	// Name of Ethernet port
+	InterfaceName string `json:"interfaceName,omitempty"`
+	// True if 'ipv4' is to be enabled for this interface; otherwise false.
+	Ipv4Enabled *bool `json:"ipv4Enabled,omitempty"`
+	// The 'ipv4' address for the interface. Required for static configuration.
+	Ipv4Address string `json:"ipv4Address,omitempty"`
+	// The 'ipv4' subnet mask for the interface. Required for static configuration.
+	Ipv4SubnetMask string `json:"ipv4SubnetMask,omitempty"`
+	// Setting that determines how the 'ipv4' address is configured. Required if ipv4 is enabled. Values:
+	// configDhcp, configStatic.
+	Ipv4AddressConfigMethod string `json:"ipv4AddressConfigMethod,omitempty"`
+	// True if 'ipv6' is to be enabled for this interface; otherwise false.
+	Ipv6Enabled *bool `json:"ipv6Enabled,omitempty"`
+	// The 'ipv6' local address for the interface.
+	Ipv6LocalAddress string `json:"ipv6LocalAddress,omitempty"`
+	// The method by which the 'ipv6' address information is configured for the interface. Values: configStatic,
+	// configStateless.
+	Ipv6AddressConfigMethod string `json:"ipv6AddressConfigMethod,omitempty"`
+	// The configured speed setting for the Ethernet interface. Values: speedNone, speedAutoNegotiated,
+	// speed10MbitHalfDuplex, speed10MbitFullDuplex, speed100MbitHalfDuplex, speed100MbitFullDuplex,
+	// speed1000MbitHalfDuplex, speed1000MbitFullDuplex.
+	SpeedSetting string `json:"speedSetting,omitempty"`
+	// The configuration for the DNS on this management interface
+	DNSAcquisitionDescriptor DNSAcquisitionDescriptor `json:"dnsAcquisitionDescriptor,omitzero"`
+	// The configuration for the NTP on this management interface
+	NtpAcquisitionDescriptor NtpAcquisitionDescriptor `json:"ntpAcquisitionDescriptor,omitzero"`
+}
+
+// ManagementInterface is the ManagementInterface model.
+type ManagementInterface struct {
+	// Name of the Ethernet port, as reported by the controller.
+	InterfaceName string `json:"interfaceName,omitempty"`
+	// The channel number of this Ethernet interface.
+	Channel int `json:"channel,omitempty"`
+	// This field has been deprecated and should not be used.
+	Speed int `json:"speed,omitempty"`
+	// This field has been deprecated and should not be used.
+	IP int `json:"ip,omitempty"`
+	// An ASCII string that identifies the alias name for the interface; this name is presumed to be associated with
+	// the IP protocol address.
+	Alias string `json:"alias,omitempty"`
+	// An ASCII string representation of the globally-unique 48-bit MAC address assigned to the Ethernet interface.
+	MacAddr string `json:"macAddr,omitempty"`
+	// This field has been deprecated and should not be used.
+	GatewayIP int `json:"gatewayIp,omitempty"`
+	// This field has been deprecated and should not be used.
+	SubnetMask int `json:"subnetMask,omitempty"`
+	// This field has been deprecated and should not be used.
+	BootpUsed bool `json:"bootpUsed,omitempty"`
+	// This field has been deprecated and should not be used.
+	RloginEnabled bool   `json:"rloginEnabled,omitempty"`
+	Reserved1     string `json:"reserved1,omitempty"`
+	// Set to true if there is a configuration error.
+	SetupError bool   `json:"setupError,omitempty"`
+	Reserved2  string `json:"reserved2,omitempty"`
+	// This field has been deprecated and should not be used.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// The status of the network link for this interface. Values: none, up, down, failed.
+	LinkStatus string `json:"linkStatus,omitempty"`
+	// True if IPV4 is enabled for this interface; otherwise false.
+	Ipv4Enabled bool `json:"ipv4Enabled,omitempty"`
+	// The IPV4 address for the interface.
+	Ipv4Address string `json:"ipv4Address,omitempty"`
+	// The IPV4 subnet mask for the interface.
+	Ipv4SubnetMask string `json:"ipv4SubnetMask,omitempty"`
+	// The method by which the IPV4 address information is configured. Values: configDhcp, configStatic.
+	Ipv4AddressConfigMethod string `json:"ipv4AddressConfigMethod,omitempty"`
+	// True if IPV6 is enabled for this interface; otherwise false.
+	Ipv6Enabled bool `json:"ipv6Enabled,omitempty"`
+	// The IPV6 local address for the interface and associated data.
+	Ipv6LocalAddress IPV6AddressData `json:"ipv6LocalAddress,omitzero"`
+	// The IPV6 static routable address for the interface and associated data.
+	Ipv6PortStaticRoutableAddress IPV6AddressData `json:"ipv6PortStaticRoutableAddress,omitzero"`
+	// The set of IPV6 port routable addresses for the interface.
+	Ipv6PortRoutableAddresses []IPV6AddressData `json:"ipv6PortRoutableAddresses,omitempty"`
+	// The method by which the IPV6 address information is configured for the interface. Values: configStatic,
+	// configStateless.
+	Ipv6AddressConfigMethod string `json:"ipv6AddressConfigMethod,omitempty"`
+	// If set to true, the interface is operating in full duplex mode; otherwise, it is operating in half-duplex
+	// mode.
+	FullDuplex bool `json:"fullDuplex,omitempty"`
+	// Support speed setting for interface
+	SupportedSpeedSettings []string `json:"supportedSpeedSettings,omitempty"`
+	// Configured setting for the interface. Values: speedNone, speedAutoNegotiated, speed10MbitHalfDuplex,
+	// speed10MbitFullDuplex, speed100MbitHalfDuplex, speed100MbitFullDuplex, speed1000MbitHalfDuplex,
+	// speed1000MbitFullDuplex.
+	ConfiguredSpeedSetting string `json:"configuredSpeedSetting,omitempty"`
+	// Current speed of the interface. Values: speedUnknown, speed1gig, speed2gig, speed4gig, speed10gig,
+	// speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig, speed60gig,
+	// speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig, speed100gig,
+	// speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	CurrentSpeed string `json:"currentSpeed,omitempty"`
+	// The physical location of the Ethernet interface. The parent reference in Location identifies the physical
+	// component (e.g., controller or host card) where the interface circuitry is located, and the position field is
+	// a firmware-assigned 1-relative number signifying "1st ethernet interface relative to the parent," "2nd
+	// ethernet interface relative to the parent," etc. This "interface number" is independent of the interface's
+	// channel association.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// Can capture network traces if true.
+	IsNetworkTraceCapable bool                    `json:"isNetworkTraceCapable,omitempty"`
+	Ipv4GatewayAddress    string                  `json:"ipv4GatewayAddress,omitempty"`
+	ControllerRef         string                  `json:"controllerRef,omitempty"`
+	ControllerSlot        int                     `json:"controllerSlot,omitempty"`
+	DNSProperties         ControllerDNSProperties `json:"dnsProperties,omitzero"`
+	NtpProperties         ControllerNTPProperties `json:"ntpProperties,omitzero"`
+	ID                    string                  `json:"id,omitempty"`
+}
+
+// MappableObject is the MappableObject model.
+type MappableObject struct {
+	Name string `json:"name,omitempty"`
+	ID   string `json:"id,omitempty"`
+	// Values: unknown, volume, pool, host, lunMapping, hostGroup, thinVolume, drive, volumeCopy, pit, pitView,
+	// snapshotGroup, snapshot, accessVolume, legacySnapshot, hostType, metadataTag, managementUrl, folder,
+	// asyncMirrorGroup, asyncMirrorGroupMember, asyncMirrorGroupIncompleteMember, consistencyGroup,
+	// consistencyGroupView, fan, battery, storageSystem, controller, powerSupply, minihub, esm, drawer, hostBoard,
+	// interconnectCRU, cacheBackupDevice, tray, supportCRU, hostPort, initiator, snapshotSchedule, thermalSensor,
+	// sfp, flashCache, featureAttribute, featureState, lockKeyId, remoteVolume, mirrorVolume, vaultMirrorVolume,
+	// vaultMirrorGroup, metadataVolume, sasPort, sasExpander, channelPort, speedNegError, snmpAgentBundle,
+	// stagedFirmware, workload, ekmsCertRevocatSettings, databaseMetadata, directoryServicesDomain,
+	// kmsCertificateExpirationDetails, externalKMSEndpoint, notOnPreferredPath, remoteStorageVolume, remoteStorage,
+	// alarm.
+	ObjectType            string       `json:"objectType,omitempty"`
+	CurrentControllerID   string       `json:"currentControllerId,omitempty"`
+	WWN                   string       `json:"wwn,omitempty"`
+	PreferredControllerID string       `json:"preferredControllerId,omitempty"`
+	TotalSizeInBytes      string       `json:"totalSizeInBytes,omitempty"`
+	Mapped                bool         `json:"mapped"`
+	ListOfMappings        []LUNMapping `json:"listOfMappings,omitempty"`
+}
+
+// MelClearEvent is the MelClearEvent model.
+type MelClearEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType string `json:"eventType,omitempty"`
+}
+
+// MelDefaultsResponse is the MelDefaultsResponse model.
+// The MEL defaults.
+type MelDefaultsResponse struct {
+	// The maximum number of MEL events to be transmitted at a time.
+	MaxTransferCount int `json:"maxTransferCount"`
+	// The maximum number of MEL events that can be logged.
+	MaxRecordCount int `json:"maxRecordCount"`
+}
+
+// MelEntryEx is the MelEntryEx model.
+type MelEntryEx struct {
+	// The sequence number of the MEL entry. The controller maintains a unique, monotonically increasing 64-bit
+	// sequence number value and stamps each MEL entry with the next value of this counter.
+	SequenceNumber string `json:"sequenceNumber,omitempty"`
+	// An integer value that indicates the specific type of event being reported.
+	EventType int `json:"eventType,omitempty"`
+	// The time at which the MEL entry was generated. This value is defined in terms of the number of seconds since
+	// midnight GMT on January 1, 1970.
+	TimeStamp string `json:"timeStamp,omitempty"`
+	// This field has been deprecated and should not be used. Values: error, failure, command, notification,
+	// stateChange, hostEntry, general.
+	Category string `json:"category,omitempty"`
+	// The type of component that is associated with this entry. Values: unknown, drive, powerSply, fan, minihub,
+	// tempSensor, channel, esm, controller, battery, enclosure, ups, chip, volume, volumeGrp, portCru,
+	// interconnectCru, supportCru, alarm, channelPort, sfpPort, hostBoard, newFormat, ctlrSfp, ctlrSoc, initiator,
+	// target, isnsServer, hostIoCard, cacheBackupDevice, cacheMemDimm, host, hostPort, drawer, relative, schedule,
+	// asyncMirrorGroup, diskPool, pit, pitConsistencyGroup, cgpit, cgview, flashCache, snmpCommunity,
+	// snmpTrapDestination, fcTarget, blankOne, blankTwo, fanOnlyCru, psuCru, nvmeInitiator, remoteTargetGrp,
+	// snmpUsmUser.
+	ComponentType string `json:"componentType,omitempty"`
+	// The location, or other identifying value, of the component associated with this entry.
+	ComponentLocation ComponentLocation `json:"componentLocation,omitzero"`
+	// A true/false indication of whether the ComponentLocation field is valid for this entry.
+	LocationValid bool `json:"locationValid,omitempty"`
+	// The priority code associated with this event. Values: priorityDefault, priorityCritical, priorityInfo,
+	// priorityEmergency, priorityAlert, priorityError, priorityWarning, priorityNotice, priorityDebug.
+	Priority string `json:"priority,omitempty"`
+	// The controller that is the source of the event (Controller 1 or 2).
+	EventSourceController int `json:"eventSourceController,omitempty"`
+	// The Sense Key value associated with this event, or zero if no key is applicable.
+	SenseKey int `json:"senseKey,omitempty"`
+	// The raw data stored for this event. This information may be useful for debugging or troubleshooting problems
+	// with the assistance of a field support or development representative.
+	RawData string `json:"rawData,omitempty"`
+	// Extended component location information for this entry. This data type of this field is a union with
+	// discriminator extLocType. If the discriminator is set to something other than EXT_COMP_LOCTYPE_UNKNOWN, then
+	// the location information in this field overrides what is in the componentLocation field.
+	ExtComponentLocation ExtendedComponentLocation `json:"extComponentLocation,omitzero"`
+	// This field allows customized control over the handling of MEL events.
+	ControlParams EventControl `json:"controlParams,omitzero"`
+	Description   string       `json:"description,omitempty"`
+	Location      string       `json:"location,omitempty"`
+	ID            string       `json:"id,omitempty"`
+	Critical      bool         `json:"critical,omitempty"`
+	Asc           int          `json:"asc,omitempty"`
+	Ascq          int          `json:"ascq,omitempty"`
+}
+
+// MelEventHealthCheck is the MelEventHealthCheck model.
+type MelEventHealthCheck struct {
+	// Values: ok, notCompleted, failedDataRetrieval, failed.
+	Result string `json:"result"`
+	// Values: melEventCheck, cntrlSyncCheck, storageDeviceAccessible, spmDatabaseVerification, validPassword,
+	// configurationDatabaseCheck, objectGraphSyncCheck, nvsramMatches, volumeGroupsComplete,
+	// controllerStatusOptimal, hotSparesInUse, failedDrivesPresent, driveCheck, missingVolumes,
+	// exclusiveOperations, dbSubRecordsValidation, driveSideShipping, nvsramDisableCfwDownloads, needsAttention,
+	// integratedHealthCheck, altCommLinkFailure, ptlDisallowDownloads.
+	Type string `json:"type"`
+	// Values: unknown, low, medium, high, fatal.
+	SeverityLevel string `json:"severityLevel"`
+	// Results data to diagnose failures
+	ResultsData     string   `json:"resultsData"`
+	WarningMessages []string `json:"warningMessages"`
+	Successful      bool     `json:"successful,omitempty"`
+}
+
+// MelExtent is the MelExtent model.
+type MelExtent struct {
+	// This is the sequence number of the entry at the low end of the extent. So, when this value is returned in
+	// getMelExtents, it is the sequence number of the first MEL event that can be returned in getMelEntries. When
+	// specified as an input to getMelEntries, it is the sequence number of the first MEL event to be returned.
+	StartingSeqNum string `json:"startingSeqNum,omitempty"`
+	// When this value is returned by getMelExtents, it is the sequence number that is one beyond the entry at the
+	// high end of the extent. For example, if the ending sequence number is 3232, then MEL entries up to sequence
+	// 3231 are retrievable. When this value is input to getMelEntries, it is the sequence number of the last MEL
+	// event to be returned.
+	EndingSeqNum string `json:"endingSeqNum,omitempty"`
+}
+
+// MetadataChangeEvent is the MetadataChangeEvent model.
+type MetadataChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType string `json:"eventType"`
+	// List of objects added. Only certain objects that have rest endpoints will be represented in this list. See
+	// Event ObjectTypes for types of objects that have events
+	AddedObjects []EventObjectIdentifier `json:"addedObjects"`
+	// List of objects updated. Only certain objects that have rest endpoints will be represented in this list. See
+	// Event ObjectTypes for types of objects that have events
+	ModifiedObjects []EventObjectIdentifier `json:"modifiedObjects"`
+	// List of objects deleted. Only certain objects that have rest endpoints will be represented in this list. See
+	// Event ObjectTypes for types of objects that have events
+	DeletedObjects  []EventObjectIdentifier `json:"deletedObjects"`
+	StorageSystemID string                  `json:"storageSystemId"`
+}
+
+// MetadataVolume is the MetadataVolume model.
+type MetadataVolume struct {
+	// This object identifies the metadata volume.
+	MetadataVolRef string `json:"metadataVolRef,omitempty"`
+	// This object specifies the metadata volume type. Values: allMdatTypes, remoteMirror.
+	MetadataVolType string `json:"metadataVolType,omitempty"`
+	// Volume that contains data
+	AssociatedVolume string `json:"associatedVolume,omitempty"`
+	// The controller that created the metadata volume.
+	PreferredManager string `json:"preferredManager,omitempty"`
+	// The permissions allowed.
+	Perms VolumePerms `json:"perms,omitzero"`
+	// True, if the volume is offline.
+	Offline   bool   `json:"offline,omitempty"`
+	Reserved1 string `json:"reserved1,omitempty"`
+}
+
+// Minihub is the Minihub model.
+type Minihub struct {
+	// The reference for this physical minihub.
+	MinihubRef string `json:"minihubRef,omitempty"`
+	// The operational status of the minihub. Values: optimal, failed, unsupported, unknown.
+	Status string `json:"status,omitempty"`
+	// The physical location of the minihub. Note that the tray reference identifies the enclosure containing the
+	// minihub, but the slot information does not apply to this component.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// Information returned is based on the minihub type.
+	MinihubData MinihubTypeData `json:"minihubData,omitzero"`
+	// The current speed of the minihub. Values: speedUnknown, speed1gig, speed2gig, speed4gig, speed10gig,
+	// speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig, speed60gig,
+	// speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig, speed100gig,
+	// speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	CurrentSpeed string `json:"currentSpeed,omitempty"`
+	// The maximum speed of the minihub. Values: speedUnknown, speed1gig, speed2gig, speed4gig, speed10gig,
+	// speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig, speed60gig,
+	// speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig, speed100gig,
+	// speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	MaxSpeed string `json:"maxSpeed,omitempty"`
+	// The channel number that this minihub is associated with.
+	Channel int `json:"channel,omitempty"`
+	// This field has been deprecated and should not be used.
+	PortList PortList `json:"portList,omitzero"`
+	// The vendor name of the minihub.
+	VendorName string `json:"vendorName,omitempty"`
+	// The part number of the minihub.
+	PartNumber string `json:"partNumber,omitempty"`
+	// The serial number of the minihub.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// The field replaceable unit type of the minihub.
+	FruType string `json:"fruType,omitempty"`
+	// The date the minihub was manufactured.
+	ManufacturerDate string `json:"manufacturerDate,omitempty"`
+	Reserved1        string `json:"reserved1,omitempty"`
+	Reserved2        string `json:"reserved2,omitempty"`
+	// The CRU type of the minihub plus its ready-to-remove attributes, which are based on the CRU type
+	RtrAttributes RTRAttributes `json:"rtrAttributes,omitzero"`
+}
+
+// MinihubLocation is the MinihubLocation model.
+type MinihubLocation struct {
+	// Information returned is based on the minihub type. Values: hostside, driveside.
+	MinihubType string `json:"minihubType,omitempty"`
+	// The channel number that this minihub is associated with.
+	Channel int `json:"channel,omitempty"`
+	// Controller number 1 if in top slot. Controller number 2 if in bottom slot.
+	ControllerSlot int `json:"controllerSlot,omitempty"`
+	// This field has been deprecated and should not be used.
+	Tray int `json:"tray,omitempty"`
+}
+
+// MinihubTypeData is the MinihubTypeData model.
+type MinihubTypeData struct {
+	// This enumeration is used to identify the type of a minihub. Values: hostside, driveside.
+	MinihubType string `json:"minihubType,omitempty"`
+	// This field is present only if the minihubType value is equal to MINIHUB_TYPE_HOSTSIDE. It contains the
+	// detailed information about the controller the minihub is associated with.
+	ParentController string `json:"parentController,omitempty"`
+}
+
+// MirrorProxyVolume is the MirrorProxyVolume model.
+type MirrorProxyVolume struct {
+	// The WWN of the local mirror volume.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The reference to the local mirror volume.
+	MirrorProxyRef string `json:"mirrorProxyRef,omitempty"`
+	// The ssid of the local mirror volume.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// The status of the local mirror volume. Values: optimal, unsynchronized, synchronizing, notready, suspended,
+	// failed, failedsuspended, degradedSynchronizing, degradedUnsynchronized.
+	LocalMirrorStatus string `json:"localMirrorStatus,omitempty"`
+	// The status of the remote mirror volume. Values: optimal, unsynchronized, synchronizing, notready, suspended,
+	// failed, failedsuspended, degradedSynchronizing, degradedUnsynchronized.
+	RemoteMirrorStatus string `json:"remoteMirrorStatus,omitempty"`
+	// The role of the local mirror volume. Values: primary, secondary, invalid.
+	LocalRole string `json:"localRole,omitempty"`
+	// The role of the remote mirror volume. Values: primary, secondary, invalid.
+	RemoteRole string `json:"remoteRole,omitempty"`
+	// The synchronization priority for the local mirror volume. Values: priority0, priority1, priority2, priority3,
+	// priority4, unknown.
+	LocalPriority string `json:"localPriority,omitempty"`
+	// The synchronization priority for the remote mirror volume. Values: priority0, priority1, priority2,
+	// priority3, priority4, unknown.
+	PeerPriority string `json:"peerPriority,omitempty"`
+	// The amount of capacity being used by the mirror.
+	UsableCapacity string `json:"usableCapacity,omitempty"`
+	// The controller reference that owns the local mirror volume.
+	CurrentManager string `json:"currentManager,omitempty"`
+	// The reference to the base volume of the local mirror.
+	BaseVolume string `json:"baseVolume,omitempty"`
+	// The reference to the remote volume.
+	RemoteVolRef string `json:"remoteVolRef,omitempty"`
+	// An indication of what current long-running activity, if any, is currently in progress on the volume. Values:
+	// none, copyback, initializing, reconstructing, remappingDce, remappingDrm, remappingDcedrm, remappingDseg,
+	// remappingDve, remappingDcedve, remappingInternal, remappingDefrag, formatting, synchronizing, parityScan,
+	// volumeCopy, snapshotRollback, pitRollback, asyncMirrorGroupInitialSync, reconstructingCritical, rebalancing,
+	// copyThenFail, copyThenFailPending, copyThenReplace, copyThenReplaceAndFail, thinDefrag, creating, deleting,
+	// offlineFormatting, driveSpecific.
+	Action    string `json:"action,omitempty"`
+	Reserved1 string `json:"reserved1,omitempty"`
+	Reserved2 string `json:"reserved2,omitempty"`
+	// The local mirror volume's write mode. Values: synchronousMode, asynchronousMode, consistentAsyncMode.
+	LocalWriteMode string `json:"localWriteMode,omitempty"`
+	// Indicates whether auto resynchronization is allowed.
+	AutoResync bool `json:"autoResync,omitempty"`
+	// The write consistency group reference.
+	ConsistencyGroupRef string `json:"consistencyGroupRef,omitempty"`
+}
+
+// NVMeCommandProtocolProperties is the NVMeCommandProtocolProperties model.
+type NVMeCommandProtocolProperties struct {
+	// This enumeration describes the command set type (e.g. to descriminate between the NVMe and NVMe over Fabrics
+	// command sets). Values: unknown, nvme, nvmeof.
+	CommandSet string `json:"commandSet,omitempty"`
+	// This describes the NVMe over Fabrics protocol properties.
+	NvmeofProperties NVMeoFProtocolProperties `json:"nvmeofProperties,omitzero"`
+	// This describes the NVMe protocol properties.
+	NvmeProperties NVMeProtocolProperties `json:"nvmeProperties,omitzero"`
+}
+
+// NVMeDriveAddress is the NVMeDriveAddress model.
+type NVMeDriveAddress struct {
+	// The NVMe Qualified Name (NQN) representing the drive itself.
+	DeviceName string `json:"deviceName,omitempty"`
+	// A list of the NVMe drive port addresses associated with the drive.
+	DrivePortAddresses []NVMeDrivePortAddress `json:"drivePortAddresses,omitempty"`
+}
+
+// NVMeDrivePortAddress is the NVMeDrivePortAddress model.
+type NVMeDrivePortAddress struct {
+	// The number of the NVMe interface channel that the drive port is connected to.
+	Channel int `json:"channel,omitempty"`
+	// Contains the NVMe subsystem unique controller identifier associated with this NVMe drive port.
+	NvmeControllerIdentifier int `json:"nvmeControllerIdentifier,omitempty"`
+}
+
+// NVMeInitiatorPortID is the NVMeInitiatorPortId model.
+type NVMeInitiatorPortID struct {
+	// NVMe Qualified Name (NQN) of the host
+	Name string `json:"name,omitempty"`
+	// 128-bit Host Identifier value
+	HostIdentifier string `json:"hostIdentifier,omitempty"`
+	// The unique identifier for the host port.
+	PortIdentifier HostPortLocation `json:"portIdentifier,omitzero"`
+}
+
+// NVMeProtocolProperties is the NVMeProtocolProperties model.
+type NVMeProtocolProperties struct {
+	// The revision of the NVMe command protocol supported by the RAID controller. This value is reported in a
+	// major/minor/tertiary format, with the 16 most significant bits representing the major version number, the
+	// next 8 bits representing the secondary version number, and the 8 least significant bits representing the
+	// tertiary version number.
+	Revision int `json:"revision,omitempty"`
+}
+
+// NVMeoFFibreChannelProperties is the NVMeoFFibreChannelProperties model.
+type NVMeoFFibreChannelProperties struct {
+	// Indicates whether first burst data is supported or not.
+	FirstBurstDataSupported bool `json:"firstBurstDataSupported,omitempty"`
+	// The length of the first burst data in bytes.
+	FirstBurstDataLength int `json:"firstBurstDataLength,omitempty"`
+}
+
+// NVMeoFInfinibandProperties is the NVMeoFInfinibandProperties model.
+type NVMeoFInfinibandProperties struct {
+	// The IP address data associated with the NVMe over Fabrics over Infiniband transport.
+	IPAddressData IPVxAddressData `json:"ipAddressData,omitzero"`
+	// The listening port associated with the NVMe over Fabrics over Infiniband transport.
+	ListeningPort int `json:"listeningPort,omitempty"`
+}
+
+// NVMeoFProtocolProperties is the NVMeoFProtocolProperties model.
+type NVMeoFProtocolProperties struct {
+	// This enumeration is used to identify the provider (i.e. intermediate transport protocol) of the RDMA
+	// transport. Values: providerUnknown, providerUnspecified, providerInfiniband, providerRoce, providerRocev2,
+	// providerIwarp, noneFc, providerCouplingDriver.
+	Provider string `json:"provider,omitempty"`
+	// This defines the protocol properties for NVMe over IB.
+	IbProperties NVMeoFInfinibandProperties `json:"ibProperties,omitzero"`
+	// This defines the the protocol properties for ROCEv2 NVMe-oF Interfaces
+	RoceV2Properties NVMeoFRoceV2Properties `json:"roceV2Properties,omitzero"`
+	// This defines the protocol properties for NVMe-oF Fibre Channel Interfaces.
+	FcProperties NVMeoFFibreChannelProperties `json:"fcProperties,omitzero"`
+}
+
+// NVMeoFRoceV2Properties is the NVMeoFRoceV2Properties model.
+type NVMeoFRoceV2Properties struct {
+	// Indicates whether IPV4 addressing should be enabled for this interface.
+	Ipv4Enabled bool `json:"ipv4Enabled,omitempty"`
+	// Indicates whether IPV6 addressing should be enabled for this interface.
+	Ipv6Enabled bool `json:"ipv6Enabled,omitempty"`
+	// IPV4-related information for the interface
+	Ipv4Data InternetProtocolV4Data `json:"ipv4Data,omitzero"`
+	// IPV6-related information for the interface.
+	Ipv6Data InternetProtocolV6Data `json:"ipv6Data,omitzero"`
+	// The port on which the target listens for incoming connections.
+	ListeningPort int `json:"listeningPort,omitempty"`
+}
+
+// NeedsAttentionCheck is the NeedsAttentionCheck model.
+// Needs attention health check test results
+type NeedsAttentionCheck struct {
+	// Values: ok, notCompleted, failedDataRetrieval, failed.
+	Result string `json:"result"`
+	// Values: melEventCheck, cntrlSyncCheck, storageDeviceAccessible, spmDatabaseVerification, validPassword,
+	// configurationDatabaseCheck, objectGraphSyncCheck, nvsramMatches, volumeGroupsComplete,
+	// controllerStatusOptimal, hotSparesInUse, failedDrivesPresent, driveCheck, missingVolumes,
+	// exclusiveOperations, dbSubRecordsValidation, driveSideShipping, nvsramDisableCfwDownloads, needsAttention,
+	// integratedHealthCheck, altCommLinkFailure, ptlDisallowDownloads.
+	Type string `json:"type"`
+	// Values: unknown, low, medium, high, fatal.
+	SeverityLevel string `json:"severityLevel"`
+	// Results data to diagnose failures
+	ResultsData string `json:"resultsData"`
+	// List of failure types
+	FailureTypes []string `json:"failureTypes"`
+	Successful   bool     `json:"successful,omitempty"`
+}
+
+// NetInterfaceTypeData is the NetInterfaceTypeData model.
+type NetInterfaceTypeData struct {
+	// This enumeration defines the different network interface types that may be reported as part of the interface
+	// information associated with a controller. Values: ethernet.
+	InterfaceType string `json:"interfaceType,omitempty"`
+	// This field is present only if the interfaceType value is equal to NET_IF_ETHERNET. It contains the detailed
+	// interface information for an Ethernet network interface.
+	Ethernet EthernetInterface `json:"ethernet,omitzero"`
+}
+
+// NetworkAddress is the NetworkAddress model.
+type NetworkAddress struct {
+	// This enum defines the possible network address types. Values: none, domainName, ipvx.
+	AddrType string `json:"addrType,omitempty"`
+	// Fully qualified domain name. This should be considered an IDN-unaware domain name slot as per RFC3490.
+	DomainName string `json:"domainName,omitempty"`
+	// IPv4 or IPv6 address
+	IpvxAddress IPVxAddress `json:"ipvxAddress,omitzero"`
+}
+
+// NetworkInterface is the NetworkInterface model.
+type NetworkInterface struct {
+	// The SYMbol array-wide unique name for the interface object.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// The type of channel that the interface is associated with. Values: hostside, driveside, management.
+	ChannelType string `json:"channelType,omitempty"`
+	// A field whose contents vary depending on the type of the interface.
+	NetInterfaceTypeData NetInterfaceTypeData `json:"netInterfaceTypeData,omitzero"`
+}
+
+// NtpAcquisitionDescriptor is the NtpAcquisitionDescriptor model.
+type NtpAcquisitionDescriptor struct {
+	// How the NTP server addresses are acquired. Values: unknown, stat, dhcp, disabled.
+	NtpAcquisitionType string `json:"ntpAcquisitionType,omitempty"`
+	// This field is used if the method is set to NTP_ACQUISITION_METHOD_STATIC. If more than one address is
+	// specified, the first (lowest index) item is given highest precedence and the last item (highest index) is
+	// given the lowest precedence.
+	NtpServers []NetworkAddress `json:"ntpServers,omitempty"`
+}
+
+// NvmeofInterfaceSettings is the NvmeofInterfaceSettings model.
+type NvmeofInterfaceSettings struct {
+	// The Infiniband settings
+	IbSettings IbSettings `json:"ibSettings,omitzero"`
+	// The RoCEV2 settings
+	RoceV2Settings RoceV2Settings `json:"roceV2Settings,omitzero"`
+}
+
+// NvmeofInterfaceSettingsUpdateRequest is the NvmeofInterfaceSettingsUpdateRequest model.
+type NvmeofInterfaceSettingsUpdateRequest struct {
+	// The NVMeoF interface settings.
+	Settings NvmeofInterfaceSettings `json:"settings"`
+}
+
+// ObjectBundle is the ObjectBundle model.
+type ObjectBundle struct {
+	// The configuration generation number that was in effect at the time this object bundle was constructed by the
+	// controller. The client can use this value to determine if it currently holds the latest version of the object
+	// graph.
+	ConfigGeneration string `json:"configGeneration,omitempty"`
+	// A StorageArray object that contains base information about the storage array as a whole.
+	Sa StorageArray `json:"sa,omitzero"`
+	// A variable-length array of Tray structures. This array contains one element for each tray object that exists
+	// within the storage array.
+	Tray []Tray `json:"tray,omitempty"`
+	// A variable-length array of Component structures. This array contains one element for each physical component
+	// object that exists within the storage array.
+	ComponentBundle ComponentBundle `json:"componentBundle,omitzero"`
+	// A variable-length array of Controller structures. This array contains one element for each RAID controller
+	// object that exists within the storage array.
+	Controller []Controller `json:"controller,omitempty"`
+	// A variable-length array of Drive structures. This array contains one element for each physical disk drive
+	// object that exists within the storage array.
+	Drive []Drive `json:"drive,omitempty"`
+	// A variable-length array of VolumeGroup structures. This array contains one element for each volume group
+	// object that exists within the storage array.
+	VolumeGroup []VolumeGroup `json:"volumeGroup,omitempty"`
+	// A variable-length array of VolumeGroup structures. This array contains one element for each volume group
+	// object that exists within the storage array.
+	Volume []Volume `json:"volume,omitempty"`
+	// A variable-length array of FreeExtent structures. This array contains one element for each free extent object
+	// that exists within the storage array.
+	FreeExtent []FreeExtent `json:"freeExtent,omitempty"`
+	// A variable-length array of DVE structures. This array contains one element for each volume being expanded.
+	DveVol []DVEVolume `json:"dveVol,omitempty"`
+	// An embedded object bundle that contains all configuration information relative to the "Storage Partitions"
+	// feature.
+	StoragePoolBundle StoragePoolBundle `json:"storagePoolBundle,omitzero"`
+	// An embedded object bundle that contains all the information relative to the Higher-Level Volumes.
+	HighLevelVolBundle HLVolumeBundle `json:"highLevelVolBundle,omitzero"`
+	// An embedded object bundle that contains all the information relative to missing volumes.
+	GhostVolBundle GhostVolBundle `json:"ghostVolBundle,omitzero"`
+	// Write consistency group bundle.
+	ConsistencyGroupBundle ConsistencyGroupBundle `json:"consistencyGroupBundle,omitzero"`
+	// A variable-length array of IoInterface structures. This array contains one element for each I/O interface
+	// object that exists within the storage array
+	IoInterface []IoInterface `json:"ioInterface,omitempty"`
+	// A variable-length array of NetworkInterface structures. This array contains one element for each network
+	// interface object that exists within the storage array
+	NetworkInterface []NetworkInterface `json:"networkInterface,omitempty"`
+	// A variable length array of LockKeyID structures. Contains lock key information for the array.
+	LockKeyIDs []LockKeyID `json:"lockKeyIDs,omitempty"`
+	// A variable length array of drive interposer data. An entry will appear in this array only if the
+	// interposerPresent field in the corresponding Drive structure is set to True.
+	Interposer []Interposer `json:"interposer,omitempty"`
+	// This is a list of structures that provides a mapping from I/O interfaces to their associated HICs.
+	IoInterfaceHicMap []IoInterfaceHicMap `json:"ioInterfaceHicMap,omitempty"`
+	// A list of current schedules.
+	Schedule []ScheduleInstance `json:"schedule,omitempty"`
+	// This is a list of remote arrays which this array has visibility to for monitoring.
+	RemoteArray []RemoteArray `json:"remoteArray,omitempty"`
+	// An embedded object bundle that contains all of the information related to flash cache objects.
+	FlashCacheBundle FlashCacheBundle `json:"flashCacheBundle,omitzero"`
+	// SNMP-related objects
+	SnmpBundle SNMPAgentBundle `json:"snmpBundle,omitzero"`
+	// This reports the key-value pairs and their references, and the mappings between Volumes and these key-value
+	// pairs. The data is used by the application and is opaque to the CFW.
+	ApplicationAwarenessBundle ApplicationAwarenessBundle `json:"applicationAwarenessBundle,omitzero"`
+}
+
+// ObjectChangeEvent is the ObjectChangeEvent model.
+type ObjectChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType string `json:"eventType,omitempty"`
+	// List of objects added. Only certain objects that have rest endpoints will be represented in this list. See
+	// Event ObjectTypes for types of objects that have events
+	AddedObjects []EventObjectIdentifier `json:"addedObjects"`
+	// List of objects updated. Only certain objects that have rest endpoints will be represented in this list. See
+	// Event ObjectTypes for types of objects that have events
+	ModifiedObjects []EventObjectIdentifier `json:"modifiedObjects"`
+	// List of objects deleted. Only certain objects that have rest endpoints will be represented in this list. See
+	// Event ObjectTypes for types of objects that have events
+	DeletedObjects []EventObjectIdentifier `json:"deletedObjects"`
+}
+
+// ObjectGraphChangeEvent is the ObjectGraphChangeEvent model.
+type ObjectGraphChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType string `json:"eventType"`
+	// List of objects added. Only certain objects that have rest endpoints will be represented in this list. See
+	// Event ObjectTypes for types of objects that have events
+	AddedObjects []EventObjectIdentifier `json:"addedObjects"`
+	// List of objects updated. Only certain objects that have rest endpoints will be represented in this list. See
+	// Event ObjectTypes for types of objects that have events
+	ModifiedObjects []EventObjectIdentifier `json:"modifiedObjects"`
+	// List of objects deleted. Only certain objects that have rest endpoints will be represented in this list. See
+	// Event ObjectTypes for types of objects that have events
+	DeletedObjects  []EventObjectIdentifier `json:"deletedObjects"`
+	StorageSystemID string                  `json:"storageSystemId"`
+	// The config gen number associated to the with these events.
+	CurrentConfigGen string `json:"currentConfigGen"`
+	// The config gen number that was currently known. It is possible for there to be a gap between currentConfig
+	// and previous Don't expect them to be sequential
+	PreviousConfigGen string `json:"previousConfigGen"`
+	// The storage systems World Wide Name
+	SystemWWN string `json:"systemWWN"`
+}
+
+// ObjectGraphSyncCheck is the ObjectGraphSyncCheck model.
+type ObjectGraphSyncCheck struct {
+	// Values: ok, notCompleted, failedDataRetrieval, failed.
+	Result string `json:"result"`
+	// Values: melEventCheck, cntrlSyncCheck, storageDeviceAccessible, spmDatabaseVerification, validPassword,
+	// configurationDatabaseCheck, objectGraphSyncCheck, nvsramMatches, volumeGroupsComplete,
+	// controllerStatusOptimal, hotSparesInUse, failedDrivesPresent, driveCheck, missingVolumes,
+	// exclusiveOperations, dbSubRecordsValidation, driveSideShipping, nvsramDisableCfwDownloads, needsAttention,
+	// integratedHealthCheck, altCommLinkFailure, ptlDisallowDownloads.
+	Type string `json:"type"`
+	// Values: unknown, low, medium, high, fatal.
+	SeverityLevel string `json:"severityLevel"`
+	// Results data to diagnose failures
+	ResultsData string `json:"resultsData"`
+	CfwMatch    bool   `json:"cfwMatch"`
+	NvsramMatch bool   `json:"nvsramMatch"`
+	Successful  bool   `json:"successful,omitempty"`
+}
+
+// ObjectReference is the ObjectReference model.
+type ObjectReference struct {
+	// This enumeration identifies the different types of object references. Values: generic, controller,
+	// genericTyped.
+	RefType string `json:"refType,omitempty"`
+	// A ControllerRef that refers to the object. Controllers are identified separately from other SYMbol objects
+	// because their refs require special handling by the firmware. This field is only valid if refType is equal to
+	// OBJ_REF_CONTROLLER.
+	ControllerRef string `json:"controllerRef,omitempty"`
+	// This field has been deprecated and should not be used.
+	SymbolRef string `json:"symbolRef,omitempty"`
+	// A reference to a SYMbol object that includes object type information. This value is only present if the
+	// discriminator refType is set to OBJ_REF_GENERIC_TYPED.
+	TypedReference TypedSYMbolReference `json:"typedReference,omitzero"`
+}
+
+// OperationProgress is the OperationProgress model.
+type OperationProgress struct {
+	VolumeRef string `json:"volumeRef"`
+	// Values: none, copyback, initializing, reconstructing, remappingDce, remappingDrm, remappingDcedrm,
+	// remappingDseg, remappingDve, remappingDcedve, remappingInternal, remappingDefrag, formatting, synchronizing,
+	// parityScan, volumeCopy, snapshotRollback, pitRollback, asyncMirrorGroupInitialSync, reconstructingCritical,
+	// rebalancing, copyThenFail, copyThenFailPending, copyThenReplace, copyThenReplaceAndFail, thinDefrag,
+	// creating, deleting, offlineFormatting, driveSpecific.
+	CurrentAction             string `json:"currentAction"`
+	ProgressPercentage        int    `json:"progressPercentage"`
+	EstimatedTimeToCompletion int    `json:"estimatedTimeToCompletion"`
+}
+
+// PCIeInterface is the PCIeInterface model.
+type PCIeInterface struct {
+	// The number of the channel corresponding to this interface.
+	Channel int `json:"channel,omitempty"`
+	// The unique identifier for a given instance of this structure.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// The physical location of the PCIe interface. The parent reference in Location identifies the physical
+	// component (e.g., controller or host card) where the interface circuitry is located, and the position field is
+	// a firmware-assigned 1-relative number signifying "1st PCIe interface relative to the parent," "2nd PCIe
+	// interface relative to the parent," etc. This "interface number" is independent of the interface's channel
+	// association.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// This field indicates whether or not the I/O interface is PI capable.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// Maximum one way data rate in Bytes/second
+	OneWayMaxRate string `json:"oneWayMaxRate,omitempty"`
+	// Maximum bi-directional data rate in Bytes/second
+	BidirectionalMaxRate string `json:"bidirectionalMaxRate,omitempty"`
+	// The current interface speed of the PCIe interface. Values: speedUnknown, speed1gig, speed2gig, speed4gig,
+	// speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig,
+	// speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig,
+	// speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	CurrentInterfaceSpeed string `json:"currentInterfaceSpeed,omitempty"`
+	// The maximum interface speed of the PCIe interface. Values: speedUnknown, speed1gig, speed2gig, speed4gig,
+	// speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig,
+	// speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig,
+	// speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	MaximumInterfaceSpeed string `json:"maximumInterfaceSpeed,omitempty"`
+}
+
+// PIT is the PIT model.
+type PIT struct {
+	// The reference (key) for this PiT.
+	PitRef string `json:"pitRef,omitempty"`
+	// A reference to the associated PiT Group.
+	PitGroupRef string `json:"pitGroupRef,omitempty"`
+	// The PiT creation method. Values: unknown, user, schedule.
+	CreationMethod string `json:"creationMethod,omitempty"`
+	// The controller-time (measured in seconds since January 1, 1970) when the PiT was created.
+	PitTimestamp string `json:"pitTimestamp,omitempty"`
+	// The sequence number within PITGroup. This is a monotonically increasing sequence value that can be used to
+	// determine the absolute order of the PiTs within a PiT group. Using this sequence number avoids issues with
+	// timestamps when real-time clocks are changed. Note that this sequence number will be the same on all PiTs
+	// taken in multiple PITGroups at the same time under a single IO suspension, either as part of a consistency
+	// group operation (createCGPIT) or when a list of PITGroups is passed to createPIT.
+	PitSequenceNumber string `json:"pitSequenceNumber,omitempty"`
+	// The state of this PiT. Values: unknown, optimal, failed, purged.
+	Status string `json:"status,omitempty"`
+	// If true, the PiT is actively recording copy-on-write data.
+	ActiveCOW bool `json:"activeCOW,omitempty"`
+	// If true, the PiT is a rollback source.
+	IsRollbackSource bool `json:"isRollbackSource,omitempty"`
+	// The capacity in bytes of this PiT (capacity of the base at the time the PiT was created).
+	PitCapacity string `json:"pitCapacity,omitempty"`
+	// Repository utilization in bytes (only reported if activeCOW = false).
+	RepositoryCapacityUtilization string `json:"repositoryCapacityUtilization,omitempty"`
+	ID                            string `json:"id,omitempty"`
+}
+
+// PITCGMember is the PITCGMember model.
+type PITCGMember struct {
+	ConsistencyGroupID string `json:"consistencyGroupId"`
+	VolumeID           string `json:"volumeId"`
+	VolumeWWN          string `json:"volumeWwn"`
+	BaseVolumeName     string `json:"baseVolumeName"`
+	// The cluster size (in bytes).
+	ClusterSize int `json:"clusterSize"`
+	// Total number of repository volumes in use by the underlying snapshotGroup
+	TotalRepositoryVolumes int `json:"totalRepositoryVolumes"`
+	// Total repository capacity
+	TotalRepositoryCapacity string `json:"totalRepositoryCapacity"`
+	// The amount of repository capacity that is being utilized
+	UsedRepositoryCapacity string `json:"usedRepositoryCapacity"`
+	// The capacity threshold at which the user will be warned about running out of capacity for the repository
+	FullWarnThreshold int `json:"fullWarnThreshold"`
+	// The total number of snapshot images defined for this member
+	TotalSnapshotImages int `json:"totalSnapshotImages"`
+	// The total number of snapshot volumes defined for this member
+	TotalSnapshotVolumes int `json:"totalSnapshotVolumes"`
+	// Automatically purge all snapshots over this value
+	AutoDeleteSnapshots bool `json:"autoDeleteSnapshots"`
+	// If autoDeleteSnapshots is enabled, the maximum number allowed before autoDeletion occurs
+	AutoDeleteLimit int `json:"autoDeleteLimit"`
+	// Reference to the snapshotGroup
+	PitGroupID string `json:"pitGroupId"`
+	// Reference to the repository volume of the pitGroup/snapshotGroup
+	RepositoryVolume string `json:"repositoryVolume"`
+}
+
+// PITConsistencyGroup is the PITConsistencyGroup model.
+type PITConsistencyGroup struct {
+	// The reference (key) for this PITConsistencyGroup.
+	CgRef string `json:"cgRef,omitempty"`
+	// The name of the Consistency Group.
+	Label string `json:"label,omitempty"`
+	// The behavior on repository full condition. Values: unknown, failbasewrites, purgepit.
+	RepFullPolicy string `json:"repFullPolicy,omitempty"`
+	// The repository utilization warning threshold percentage.
+	FullWarnThreshold int `json:"fullWarnThreshold,omitempty"`
+	// The auto-delete indicator. If non-zero, the oldest PiT will be automatically deleted when creating a new one
+	// to keep the total number of PiTs limited to the number specified.
+	AutoDeleteLimit int `json:"autoDeleteLimit,omitempty"`
+	// The importance of the rollback operation. Values: highest, high, medium, low, lowest.
+	RollbackPriority string `json:"rollbackPriority,omitempty"`
+	// A list of all unique sequence numbers for all existing PiTs of members in this consistency group.
+	UniqueSequenceNumber []string `json:"uniqueSequenceNumber,omitempty"`
+	// The status of Pending PiT Creation. Values: unknown, none, waiting, failed.
+	CreationPendingStatus string `json:"creationPendingStatus,omitempty"`
+	Name                  string `json:"name,omitempty"`
+	ID                    string `json:"id,omitempty"`
+}
+
+// PITConsistencyGroupView is the PITConsistencyGroupView model.
+type PITConsistencyGroupView struct {
+	// The reference (key) for this PITConsistencyGroupView.
+	CgViewRef string `json:"cgViewRef,omitempty"`
+	// The PiT Consistency Group to which the Consistency Group View belongs.
+	GroupRef string `json:"groupRef,omitempty"`
+	// The name of the Consistency Group View.
+	Label string `json:"label,omitempty"`
+	// The controller-time (measured in seconds since January 1, 1970) when the Consistency Group View was created
+	// or re-attached to another PiT. All member Views that are part of this Consistency Group View have this value
+	// in their corresponding fields.
+	ViewTime string `json:"viewTime,omitempty"`
+	// The view sequence number. This is a monotonically increasing sequence value that is common across all member
+	// Views that are part of this Consistency Group View.
+	ViewSequenceNumber string `json:"viewSequenceNumber,omitempty"`
+	Name               string `json:"name,omitempty"`
+	ID                 string `json:"id,omitempty"`
+}
+
+// PITGroup is the PITGroup model.
+type PITGroup struct {
+	// The reference (key) for the PiT Group.
+	PitGroupRef string `json:"pitGroupRef,omitempty"`
+	// The name of the PiT Group. If this PiT group is part of a consistency group, then this label does not have to
+	// be present or unique as the parent consistency group label will be used.
+	Label string `json:"label,omitempty"`
+	// The status of the PiT Group. Values: unknown, optimal, full, failed, overThreshold.
+	Status string `json:"status,omitempty"`
+	// The base volume for this PiT Group.
+	BaseVolume string `json:"baseVolume,omitempty"`
+	// The copy-on-write (CoW) Repository for this PiT group.
+	RepositoryVolume string `json:"repositoryVolume,omitempty"`
+	// The cluster size (in bytes).
+	ClusterSize int `json:"clusterSize,omitempty"`
+	// The maximum allowable repository size (in bytes) based on current cluster size. Only applicable if there is
+	// at least one PiT in the PiT group.
+	MaxRepositoryCapacity string `json:"maxRepositoryCapacity,omitempty"`
+	// The maximum allowable base volume size (in bytes) based on current cluster size. Only applicable if there is
+	// at least one PiT in the PiT group.
+	MaxBaseCapacity string `json:"maxBaseCapacity,omitempty"`
+	// The amount of repository capacity that is unusable due to limited addressability of the current cluster size.
+	UnusableRepositoryCapacity string `json:"unusableRepositoryCapacity,omitempty"`
+	// The behavior on repository full condition. Overridden by consistency group setting if this PITGroup is
+	// associated with a consistency group. Values: unknown, failbasewrites, purgepit.
+	RepFullPolicy string `json:"repFullPolicy,omitempty"`
+	// The repository utilization warning threshold percentage.
+	FullWarnThreshold int `json:"fullWarnThreshold,omitempty"`
+	// The auto-delete indicator. If non-zero, the oldest PiT will be automatically deleted when creating a new one
+	// to keep the total number of PiTs limited to the number specified. This value is overridden by the consistency
+	// group setting if this PITGroup is associated with a consistency group.
+	AutoDeleteLimit int `json:"autoDeleteLimit,omitempty"`
+	// Long running operation. Values: none, copyback, initializing, reconstructing, remappingDce, remappingDrm,
+	// remappingDcedrm, remappingDseg, remappingDve, remappingDcedve, remappingInternal, remappingDefrag,
+	// formatting, synchronizing, parityScan, volumeCopy, snapshotRollback, pitRollback,
+	// asyncMirrorGroupInitialSync, reconstructingCritical, rebalancing, copyThenFail, copyThenFailPending,
+	// copyThenReplace, copyThenReplaceAndFail, thinDefrag, creating, deleting, offlineFormatting, driveSpecific.
+	Action string `json:"action,omitempty"`
+	// The status of the rollback (if rollback is indicated in VolumeAction above). Values: unknown, none,
+	// inProgress, pending, paused.
+	RollbackStatus string `json:"rollbackStatus,omitempty"`
+	// The importance of the rollback operation. Values: highest, high, medium, low, lowest.
+	RollbackPriority string `json:"rollbackPriority,omitempty"`
+	// If true, this PiT group is part of a consistency group.
+	ConsistencyGroup bool `json:"consistencyGroup,omitempty"`
+	// A reference to the parent consistency group, if applicable. This value will be NULL if this PiT group is not
+	// part of a consistency group.
+	ConsistencyGroupRef string `json:"consistencyGroupRef,omitempty"`
+	// The status of Pending PiT Creation. Values: unknown, none, waiting, failed.
+	CreationPendingStatus string `json:"creationPendingStatus,omitempty"`
+	// The PiT group SSID. This is provided primarily for debug purposes.
+	VolumeHandle int    `json:"volumeHandle,omitempty"`
+	Name         string `json:"name,omitempty"`
+	ID           string `json:"id,omitempty"`
+}
+
+// PITGroupRepositoryUtilization is the PITGroupRepositoryUtilization model.
+type PITGroupRepositoryUtilization struct {
+	// The total number of bytes used in the repository for all PiTs in the group.
+	PitGroupBytesUsed string `json:"pitGroupBytesUsed,omitempty"`
+	// The total number of bytes available for ongoing copy-on-write operations.
+	PitGroupBytesAvailable string `json:"pitGroupBytesAvailable,omitempty"`
+	// The PiT Group for which this utilization information applies.
+	GroupRef string `json:"groupRef,omitempty"`
+	// The repository utilization of each specific PiT in the PiT group.
+	PitUtilization []PITRepositoryUtilization `json:"pitUtilization,omitempty"`
+}
+
+// PITRepositoryUtilization is the PITRepositoryUtilization model.
+type PITRepositoryUtilization struct {
+	// A reference to the specific PiT for which utilization is being reported.
+	PitRef string `json:"pitRef,omitempty"`
+	// The total number of bytes in use in the repository for this specific PiTs metadata, copy-on-write indexes,
+	// and copy-on-write data.
+	BytesUsed string `json:"bytesUsed,omitempty"`
+}
+
+// PITView is the PITView model.
+type PITView struct {
+	// The reference (key) for this view.
+	ViewRef string `json:"viewRef,omitempty"`
+	// The WWN of the view.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// A reference to the base volume with which this view is associated.
+	BaseVol string `json:"baseVol,omitempty"`
+	// A reference to the particular PiT of the base for which this view applies. This field is only valid if the
+	// boundToPIT field is true.
+	BasePIT string `json:"basePIT,omitempty"`
+	// If true, this flag indicates that the basePIT field is a valid reference to an associated PIT. If false, the
+	// view is disassociated from the PiT so the basePIT reference is invalid.
+	BoundToPIT bool `json:"boundToPIT,omitempty"`
+	// The view access mode. Values: modeUnknown, readWrite, readOnly.
+	AccessMode string `json:"accessMode,omitempty"`
+	// The name of the view.
+	Label string `json:"label,omitempty"`
+	// The view status. Values: unknown, optimal, stopped, failed, overThreshold, full.
+	Status string `json:"status,omitempty"`
+	// The current owner of the view.
+	CurrentManager string `json:"currentManager,omitempty"`
+	// The preferred owner of the view.
+	PreferredManager string `json:"preferredManager,omitempty"`
+	// The copy-on-write repository for this view.
+	RepositoryVolume string `json:"repositoryVolume,omitempty"`
+	// The repository utilization warning threshold percentage.
+	FullWarnThreshold int `json:"fullWarnThreshold,omitempty"`
+	// The controller-time (measured in seconds since January 1, 1970) when view was created or re-attached to
+	// another PiT.
+	ViewTime string `json:"viewTime,omitempty"`
+	// Sequence number. This is a monotonically increasing sequence value that can be used to determine related
+	// views when the view was created as part of a consistency group operation.
+	ViewSequenceNumber string `json:"viewSequenceNumber,omitempty"`
+	// The permissions for the view.
+	Perms VolumePerms `json:"perms,omitzero"`
+	// The volume ssid. This is provided primarily for debug purposes.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// The cluster size in bytes.
+	ClusterSize int `json:"clusterSize,omitempty"`
+	// The maximum allowable repository size in bytes based on current cluster size.
+	MaxRepositoryCapacity string `json:"maxRepositoryCapacity,omitempty"`
+	// The amount of repository capacity in bytes that is unusable due to limited addressability of the current
+	// cluster size.
+	UnusableRepositoryCapacity string `json:"unusableRepositoryCapacity,omitempty"`
+	// This view's relationship (if any) to a Consistency Group View.
+	Membership PITViewMembership `json:"membership,omitzero"`
+	// The management client attribute.
+	MgmtClientAttribute int `json:"mgmtClientAttribute,omitempty"`
+	// The view is offline due to the associated repository volume state.
+	Offline bool `json:"offline,omitempty"`
+	// A variable-length opaque field that provides the volume's extended unique identification value.
+	ExtendedUniqueIdentifier string `json:"extendedUniqueIdentifier,omitempty"`
+	Name                     string `json:"name,omitempty"`
+	ID                       string `json:"id,omitempty"`
+}
+
+// PITViewMembership is the PITViewMembership model.
+type PITViewMembership struct {
+	// This enumeration identifies the possible types for a PITView. Values: unknown, individual, member.
+	ViewType string `json:"viewType,omitempty"`
+	// A reference to the PITConsistencyGroupView is returned if the value of viewType is equal to
+	// PIT_VIEW_TYPE_MEMBER.
+	CgViewRef string `json:"cgViewRef,omitempty"`
+}
+
+// PITViewRepositoryUtilization is the PITViewRepositoryUtilization model.
+type PITViewRepositoryUtilization struct {
+	// The number of bytes used in the repository for the view metadata, copy-on-write indexes, and copy-on-write
+	// data.
+	ViewBytesUsed string `json:"viewBytesUsed,omitempty"`
+	// The total number of bytes available for ongoing copy-on-write operations.
+	ViewBytesAvailable string `json:"viewBytesAvailable,omitempty"`
+	// The ViewRef for which this utilization applies.
+	ViewRef string `json:"viewRef,omitempty"`
+}
+
+// PKCS5EncryptionData is the PKCS5EncryptionData model.
+type PKCS5EncryptionData struct {
+	// The number of message digest iterations to be performed when generating the encryption key and initialization
+	// vector from the passphrase and salt values.
+	Iterations int `json:"iterations,omitempty"`
+	// A byte array containing the salt, or random data, selected by the encryption code for this particular
+	// encryption operation.
+	Salt string `json:"salt,omitempty"`
+	// A variable-length array containing the encrypted data, or cipher text. The length of this array must be a
+	// multiple of 8 in order to conform to the PKCS #5 standard. The standard specifies the appropriate technique
+	// for adding pad bytes to ensure that this constraint is met.
+	CipherText string `json:"cipherText,omitempty"`
+}
+
+// PagedAnalyzedControllerStats is the PagedAnalyzedControllerStats model.
+// the latest controller statistics organized from latest to oldest. There is no guarantee that the all items from
+// the same time period will be on the same page
+type PagedAnalyzedControllerStats struct {
+	// The token to retrieve the next page of statistics. If this value is null, the original request has been
+	// completed.
+	TokenID string `json:"tokenId"`
+	// List of controller statistics. The size of this list is not guaranteed to be backwards compatible. This list
+	// will currently contain a max of 15 time periods worth of statistics.
+	Statistics []AnalysedControllerStatistics `json:"statistics"`
+}
+
+// PagedAnalyzedDriveStats is the PagedAnalyzedDriveStats model.
+// the latest drive statistics organized from latest to oldest. There is no guarantee that the all items from the
+// same time period will be on the same page
+type PagedAnalyzedDriveStats struct {
+	// The token to retrieve the next page of statistics. If this value is null, the original request has been
+	// completed.
+	TokenID string `json:"tokenId"`
+	// List of interface statistics. The size of this list is not guaranteed to be backwards compatible. This list
+	// will currently contain a max of 50 items in the list.
+	Statistics []AnalysedDiskStatistics `json:"statistics"`
+}
+
+// PagedAnalyzedInterfaceStats is the PagedAnalyzedInterfaceStats model.
+// the latest interface statistics organized from latest to oldest. There is no guarantee that the all items from
+// the same time period will be on the same page
+type PagedAnalyzedInterfaceStats struct {
+	// The token to retrieve the next page of statistics. If this value is null, the original request has been
+	// completed.
+	TokenID string `json:"tokenId"`
+	// List of interface statistics. The size of this list is not guaranteed to be backwards compatible. This list
+	// will currently contain a max of 5 time periods worth of statistics.
+	Statistics []AnalyzedInterfaceStatistics `json:"statistics"`
+}
+
+// PagedAnalyzedSystemStats is the PagedAnalyzedSystemStats model.
+// the latest storage-system statistics organized from latest to oldest. There is no guarantee that the all items
+// from the same time period will be on the same page
+type PagedAnalyzedSystemStats struct {
+	// The token to retrieve the next page of statistics. If this value is null, the original request has been
+	// completed.
+	TokenID string `json:"tokenId"`
+	// List of storage-system statistics. The size of this list is not guaranteed to be backwards compatible. This
+	// list will currently contain a max of 30 time periods worth of statistics.
+	Statistics []AnalysedStorageSystemStatistics `json:"statistics"`
+}
+
+// PagedAnalyzedVolumeStats is the PagedAnalyzedVolumeStats model.
+// the latest volume statistics organized from latest to oldest. There is no guarantee that the all items from the
+// same time period will be on the same page
+type PagedAnalyzedVolumeStats struct {
+	// The token to retrieve the next page of statistics. If this value is null, the original request has been
+	// completed.
+	TokenID string `json:"tokenId"`
+	// List of interface statistics. The size of this list is not guaranteed to be backwards compatible. This list
+	// will currently contain a max of 3 time periods worth of statistics.
+	Statistics []AnalysedVolumeStatistics `json:"statistics"`
+}
+
+// Pair is the Pair model.
+type Pair struct {
+	// The key for this pair.
+	Key string `json:"key,omitempty"`
+	// The value for this pair.
+	Value string `json:"value,omitempty"`
+}
+
+// ParentCru is the ParentCru model.
+type ParentCru struct {
+	// This field has been deprecated and should not be used. Values: controller, esm, fan, iccCru, supportCru,
+	// drawer.
+	Type string `json:"type,omitempty"`
+	// This field contains a reference to the parent controller. It is only valid if the type field is equal to
+	// PARENT_CRU_TYPE_CONTROLLER.
+	ParentController string `json:"parentController,omitempty"`
+	// This field contains a reference to the parent ESM. It is only valid if the type field is equal to
+	// PARENT_CRU_TYPE_ESM.
+	ParentEsm string `json:"parentEsm,omitempty"`
+	// This field contains a reference to the parent Fan. It is only valid if the type field is equal to
+	// PARENT_CRU_TYPE_FAN.
+	ParentFan string `json:"parentFan,omitempty"`
+	// This field contains a reference to the parent interconnect CRU. It is only valid if the type field is equal
+	// to PARENT_CRU_TYPE_ICC_CRU.
+	ParentInterconnectCru string `json:"parentInterconnectCru,omitempty"`
+	// This field contains a reference to the parent support CRU. It is only valid if the type field is equal to
+	// PARENT_CRU_TYPE_SUPPORT_CRU.
+	ParentSupportCru string `json:"parentSupportCru,omitempty"`
+	// This field contains a reference to the parent drawer. It is only valid if the type field is equal to
+	// PARENT_CRU_TYPE_DRAWER.
+	ParentDrawer string `json:"parentDrawer,omitempty"`
+}
+
+// ParentRelativeLocation is the ParentRelativeLocation model.
+type ParentRelativeLocation struct {
+	// The type of component. Values: unknown, drive, powerSply, fan, minihub, tempSensor, channel, esm, controller,
+	// battery, enclosure, ups, chip, volume, volumeGrp, portCru, interconnectCru, supportCru, alarm, channelPort,
+	// sfpPort, hostBoard, newFormat, ctlrSfp, ctlrSoc, initiator, target, isnsServer, hostIoCard,
+	// cacheBackupDevice, cacheMemDimm, host, hostPort, drawer, relative, schedule, asyncMirrorGroup, diskPool, pit,
+	// pitConsistencyGroup, cgpit, cgview, flashCache, snmpCommunity, snmpTrapDestination, fcTarget, blankOne,
+	// blankTwo, fanOnlyCru, psuCru, nvmeInitiator, remoteTargetGrp, snmpUsmUser.
+	ComponentType string `json:"componentType,omitempty"`
+	// The relative position within the component's parent.
+	RelativePosition int `json:"relativePosition,omitempty"`
+}
+
+// ParityCheckOnMediaType is the ParityCheckOnMediaType model.
+type ParityCheckOnMediaType struct {
+	// Indicate whether or not P and Q parity validation is performed on HDD drives during reconstruction of RAID 6
+	// and disk pool volumes.
+	EnabledOnHDD bool `json:"enabledOnHDD,omitempty"`
+	// Indicate whether or not P and Q parity validation is performed on SSD drives during reconstruction of RAID 6
+	// and disk pool volumes.
+	EnabledOnSSD bool `json:"enabledOnSSD,omitempty"`
+}
+
+// PasswordSetRequest is the PasswordSetRequest model.
+// Passed in to set the passwords. The security on this call is a little different. If the admin password is
+// <b>not</b> set and adminPassword is true, than this call is allowed. If the admin password is set, then the user
+// must have a valid login session as admin
+type PasswordSetRequest struct {
+	// The current admin password of the storage system
+	CurrentAdminPassword string `json:"currentAdminPassword"`
+	// If this is true, this call will set the admin password, if false, it sets the RO password
+	AdminPassword bool `json:"adminPassword"`
+	// The new password for the storage system
+	NewPassword string `json:"newPassword"`
+}
+
+// PasswordStatusEvent is the PasswordStatusEvent model.
+type PasswordStatusEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType string `json:"eventType"`
+	// Values: unknown, invalid, valid, securityLockout.
+	PreviousStatus string `json:"previousStatus"`
+	// Values: unknown, invalid, valid, securityLockout.
+	CurrentStatus   string `json:"currentStatus"`
+	StorageSystemID string `json:"storageSystemId"`
+}
+
+// PasswordStatusResponse is the PasswordStatusResponse model.
+// Indicate if the passwords are set on the storage array
+type PasswordStatusResponse struct {
+	AdminPasswordSet    bool `json:"adminPasswordSet"`
+	ReadOnlyPasswordSet bool `json:"readOnlyPasswordSet"`
+}
+
+// PhysicalDriveTypeData is the PhysicalDriveTypeData model.
+type PhysicalDriveTypeData struct {
+	// This enumeration object is used to describe the physical interface of the drive. This is not necessarily the
+	// same as the I/O protocol interface used by the controller to communicate with the drive (DriveType). In
+	// addition, the supportedDriveTypes array in the FeatureParams structure will contain values from this
+	// enumeration identifying which drive types are supported on the storage array. Values: all, scsi, fibre, sata,
+	// pata, fibre520b, sas, unknown, sas4k, nvme4k.
+	PhyDriveType string `json:"phyDriveType,omitempty"`
+	// This field identifies the attributes of SATA drives It is only present if the phyDriveType field is equal to
+	// PHY_DRIVE_TYPE_SATA.
+	SataDriveAttributes SataDriveAttributes `json:"sataDriveAttributes,omitzero"`
+}
+
+// PhysicalInterfaceTypeData is the PhysicalInterfaceTypeData model.
+type PhysicalInterfaceTypeData struct {
+	// This enumeration object is used to indicate the physical type of an I/O interface, in contrast to the logical
+	// protocol used by the interface (iSCSI is a logical protocol; Ethernet is a physical interface). Values:
+	// unknown, ethernet, infiniband.
+	Type string `json:"type,omitempty"`
+	// This field contains information about an Ethernet physical interface It is only present if the type field is
+	// equal to PHYSICAL_INTERFACE_ETHERNET.
+	EthernetData EthernetInterfaceData `json:"ethernetData,omitzero"`
+	// This field indicates iSCSI/iSER interface.
+	InfinibandData InfinibandInterfaceData `json:"infinibandData,omitzero"`
+}
+
+// PitGroupsDefaultsResponse is the PitGroupsDefaultsResponse model.
+// The PIT groups defaults.
+type PitGroupsDefaultsResponse struct {
+	// The maximum number of views per PIT.
+	MaxViewsPerPit int `json:"maxViewsPerPit"`
+	// The minimum repository size (in bytes) for the PIT group or PIT view repository. Note that this is the
+	// absolute floor on the repository capacity, but a slightly higher minimum may be imposed based on the capacity
+	// of the base volume. This is necessary to ensure rollback operations have sufficient repository capacity for
+	// metadata.
+	MinRepositoryCapacityInBytes string `json:"minRepositoryCapacityInBytes"`
+	// The maximum repository size for a PIT group as a percentage of the base volume capacity.
+	MaxPitGroupRepositoryCapacityAsPercentOfBaseCapacity int `json:"maxPitGroupRepositoryCapacityAsPercentOfBaseCapacity"`
+	// The maximum repository size for a PIT view as a percentage of the base volume capacity.
+	MaxPitViewRepositoryCapacityAsPercentOfBaseCapacity int `json:"maxPitViewRepositoryCapacityAsPercentOfBaseCapacity"`
+	// The default repository capacity for a PIT group or PIT view as a percentage of the base volume capacity.
+	DefaultRepositoryCapacityAsPercentOfBaseCapacity int `json:"defaultRepositoryCapacityAsPercentOfBaseCapacity"`
+	// The default repository utilization warning threshold percentage.
+	DefaultRepositoryUtilizationWarnThreshold int `json:"defaultRepositoryUtilizationWarnThreshold"`
+	// The minimum capacity (in bytes) of a volume to be added to a concat volume for expansion.
+	MinConcatVolumeCapacityExpansion string `json:"minConcatVolumeCapacityExpansion"`
+}
+
+// PitViewEx is the PitViewEx model.
+type PitViewEx struct {
+	// The reference (key) for this view.
+	ViewRef string `json:"viewRef,omitempty"`
+	// The WWN of the view.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// A reference to the base volume with which this view is associated.
+	BaseVol string `json:"baseVol,omitempty"`
+	// A reference to the particular PiT of the base for which this view applies. This field is only valid if the
+	// boundToPIT field is true.
+	BasePIT string `json:"basePIT,omitempty"`
+	// If true, this flag indicates that the basePIT field is a valid reference to an associated PIT. If false, the
+	// view is disassociated from the PiT so the basePIT reference is invalid.
+	BoundToPIT bool `json:"boundToPIT,omitempty"`
+	// The view access mode. Values: modeUnknown, readWrite, readOnly.
+	AccessMode string `json:"accessMode,omitempty"`
+	// The name of the view.
+	Label string `json:"label,omitempty"`
+	// The view status. Values: unknown, optimal, stopped, failed, overThreshold, full.
+	Status string `json:"status,omitempty"`
+	// The current owner of the view.
+	CurrentManager string `json:"currentManager,omitempty"`
+	// The preferred owner of the view.
+	PreferredManager string `json:"preferredManager,omitempty"`
+	// The copy-on-write repository for this view.
+	RepositoryVolume string `json:"repositoryVolume,omitempty"`
+	// The repository utilization warning threshold percentage.
+	FullWarnThreshold int `json:"fullWarnThreshold,omitempty"`
+	// The controller-time (measured in seconds since January 1, 1970) when view was created or re-attached to
+	// another PiT.
+	ViewTime string `json:"viewTime,omitempty"`
+	// Sequence number. This is a monotonically increasing sequence value that can be used to determine related
+	// views when the view was created as part of a consistency group operation.
+	ViewSequenceNumber string `json:"viewSequenceNumber,omitempty"`
+	// The permissions for the view.
+	Perms VolumePerms `json:"perms,omitzero"`
+	// The volume ssid. This is provided primarily for debug purposes.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// The cluster size in bytes.
+	ClusterSize int `json:"clusterSize,omitempty"`
+	// The maximum allowable repository size in bytes based on current cluster size.
+	MaxRepositoryCapacity string `json:"maxRepositoryCapacity,omitempty"`
+	// The amount of repository capacity in bytes that is unusable due to limited addressability of the current
+	// cluster size.
+	UnusableRepositoryCapacity string `json:"unusableRepositoryCapacity,omitempty"`
+	// This view's relationship (if any) to a Consistency Group View.
+	Membership PITViewMembership `json:"membership,omitzero"`
+	// The management client attribute.
+	MgmtClientAttribute int `json:"mgmtClientAttribute,omitempty"`
+	// The view is offline due to the associated repository volume state.
+	Offline bool `json:"offline,omitempty"`
+	// A variable-length opaque field that provides the volume's extended unique identification value.
+	ExtendedUniqueIdentifier string `json:"extendedUniqueIdentifier,omitempty"`
+	VolumeFull               bool   `json:"volumeFull,omitempty"`
+	RepositoryCapacity       string `json:"repositoryCapacity,omitempty"`
+	BaseVolumeCapacity       string `json:"baseVolumeCapacity,omitempty"`
+	TotalSizeInBytes         string `json:"totalSizeInBytes,omitempty"`
+	ConsistencyGroupID       string `json:"consistencyGroupId,omitempty"`
+	VolumeCopyTarget         bool   `json:"volumeCopyTarget,omitempty"`
+	CloneCopy                bool   `json:"cloneCopy,omitempty"`
+	VolumeCopySource         bool   `json:"volumeCopySource,omitempty"`
+	PitBaseVolume            bool   `json:"pitBaseVolume,omitempty"`
+	// True if the volume is a target in an Asynchronous Mirror relationship.
+	AsyncMirrorTarget bool `json:"asyncMirrorTarget,omitempty"`
+	// True if the volume is a source in an Asynchronous Mirror relationship.
+	AsyncMirrorSource bool `json:"asyncMirrorSource,omitempty"`
+	// Values: type0Protection, type1Protection, type2Protection, type3Protection.
+	ProtectionType string `json:"protectionType,omitempty"`
+	// True if the volume is a source (primary), in an Remote Volume Mirror relationship.
+	RemoteMirrorSource bool `json:"remoteMirrorSource,omitempty"`
+	// True if the volume is a target (secondary), in an Remote Volume Mirror relationship.
+	RemoteMirrorTarget bool   `json:"remoteMirrorTarget,omitempty"`
+	Name               string `json:"name,omitempty"`
+	ID                 string `json:"id,omitempty"`
+	// Values: unknown, volume, pool, host, lunMapping, hostGroup, thinVolume, drive, volumeCopy, pit, pitView,
+	// snapshotGroup, snapshot, accessVolume, legacySnapshot, hostType, metadataTag, managementUrl, folder,
+	// asyncMirrorGroup, asyncMirrorGroupMember, asyncMirrorGroupIncompleteMember, consistencyGroup,
+	// consistencyGroupView, fan, battery, storageSystem, controller, powerSupply, minihub, esm, drawer, hostBoard,
+	// interconnectCRU, cacheBackupDevice, tray, supportCRU, hostPort, initiator, snapshotSchedule, thermalSensor,
+	// sfp, flashCache, featureAttribute, featureState, lockKeyId, remoteVolume, mirrorVolume, vaultMirrorVolume,
+	// vaultMirrorGroup, metadataVolume, sasPort, sasExpander, channelPort, speedNegError, snmpAgentBundle,
+	// stagedFirmware, workload, ekmsCertRevocatSettings, databaseMetadata, directoryServicesDomain,
+	// kmsCertificateExpirationDetails, externalKMSEndpoint, notOnPreferredPath, remoteStorageVolume, remoteStorage,
+	// alarm.
+	ObjectType            string       `json:"objectType,omitempty"`
+	CurrentControllerID   string       `json:"currentControllerId,omitempty"`
+	WWN                   string       `json:"wwn,omitempty"`
+	PreferredControllerID string       `json:"preferredControllerId,omitempty"`
+	Mapped                bool         `json:"mapped"`
+	ListOfMappings        []LUNMapping `json:"listOfMappings,omitempty"`
+	OnlineVolumeCopy      bool         `json:"onlineVolumeCopy,omitempty"`
+}
+
+// PoolConfig is the PoolConfig model.
+// Storage pool for configuring volumes
+type PoolConfig struct {
+	// List of drives
+	Drives []DriveLocation `json:"drives"`
+	// A unique label
+	Label string `json:"label"`
+	// The type of security used during creation Values: none, capable, enabled.
+	SecurityType string `json:"securityType"`
+	// Indicates whether reserved space is allocated on the drives during volume creation
+	ReservedSpaceAllocated bool `json:"reservedSpaceAllocated"`
+	// The pool utilization warning threshold as a percentage of the total user configurable capacity
+	UtilizationWarningThreshold int `json:"utilizationWarningThreshold"`
+	// The pool utilization critical threshold as a percentage of the total user configurable capacity
+	UtilizationCriticalThreshold int `json:"utilizationCriticalThreshold"`
+	// Priority of operations that are not reconstruct, such as volume expansion Values: defaultPriority, highest,
+	// high, medium, low, lowest.
+	BackgroundOperationPriority string `json:"backgroundOperationPriority"`
+	// Priority of critical reconstruction operations (two failed drives) Values: defaultPriority, highest, high,
+	// medium, low, lowest.
+	CriticalReconstructionPriority string `json:"criticalReconstructionPriority"`
+	// Priority of degraded reconstruction operations (one failed drive) Values: defaultPriority, highest, high,
+	// medium, low, lowest.
+	DegradedReconstructionPriority string `json:"degradedReconstructionPriority"`
+	// The number of drives reserved for reconstruction of failed drives
+	ReservedDriveCount int `json:"reservedDriveCount"`
+}
+
+// PoolQosResponse is the PoolQosResponse model.
+type PoolQosResponse struct {
+	// The pool unique identifier
+	PoolID string `json:"poolId,omitempty"`
+	// The pool name
+	Name string        `json:"name,omitempty"`
+	Pool VolumeGroupEx `json:"pool,omitzero"`
+	// True if the pool supports flashCaching created volumes
+	FlashCacheCapable bool `json:"flashCacheCapable,omitempty"`
+	// True if created volumes can have dataAssurance enabled
+	DataAssuranceCapable bool `json:"dataAssuranceCapable,omitempty"`
+	// True if the pool supports encryption
+	Encrypted bool `json:"encrypted,omitempty"`
+	// True if thin provisioned volumes can be defined on this pool
+	ThinProvisioningCapable bool `json:"thinProvisioningCapable,omitempty"`
+	// The normalized spindle speed for the drives on this pool Values: spindleSpeedUnknown, spindleSpeedSSD,
+	// spindleSpeed5400, spindleSpeed7200, spindleSpeed10k, spindleSpeed15k.
+	SpindleSpeed string `json:"spindleSpeed,omitempty"`
+	// The normalized RAID Level Values: raid0, raid1, raid5, raid6, raidDiskPool, unknown.
+	RaidLevel string `json:"raidLevel,omitempty"`
+	// The available sections of freeSpace available, in descending order
+	AvailableFreeExtentCapacities []string `json:"availableFreeExtentCapacities,omitempty"`
+}
+
+// PoolStatistics is the PoolStatistics model.
+// Raw volume statistics for storage pools.
+type PoolStatistics struct {
+	// End time for this collection as measured by the number of seconds since baseTime.
+	ObservedTime      string `json:"observedTime"`
+	ObservedTimeInMS  string `json:"observedTimeInMS"`
+	LastResetTime     string `json:"lastResetTime"`
+	LastResetTimeInMS string `json:"lastResetTimeInMS"`
+	ArrayID           string `json:"arrayId"`
+	ArrayWWN          string `json:"arrayWwn"`
+	// Hash of member volume ids.
+	MemberIdsHash string `json:"memberIdsHash"`
+	// Number of read operations
+	ReadOps float64 `json:"readOps"`
+	// Number of read operations that hit cache
+	ReadHitOps float64 `json:"readHitOps"`
+	// Number of bytes read from cache
+	ReadHitBytes     float64 `json:"readHitBytes"`
+	ReadTimeTotal    float64 `json:"readTimeTotal"`
+	ReadHitTimeTotal float64 `json:"readHitTimeTotal"`
+	// Number of write operations
+	WriteOps                           float64 `json:"writeOps"`
+	WriteTimeTotal                     float64 `json:"writeTimeTotal"`
+	WriteHitTimeTotal                  float64 `json:"writeHitTimeTotal"`
+	ErrRedundancyChkIndeterminateReads float64 `json:"errRedundancyChkIndeterminateReads"`
+	ErrRedundancyChkRecoveredReads     float64 `json:"errRedundancyChkRecoveredReads"`
+	ErrRedundancyChkUnrecoveredReads   float64 `json:"errRedundancyChkUnrecoveredReads"`
+	IdleTime                           float64 `json:"idleTime"`
+	OtherOps                           float64 `json:"otherOps"`
+	OtherTimeMax                       float64 `json:"otherTimeMax"`
+	OtherTimeTotal                     float64 `json:"otherTimeTotal"`
+	ReadBytes                          float64 `json:"readBytes"`
+	ReadHitTimeMax                     float64 `json:"readHitTimeMax"`
+	ReadTimeMax                        float64 `json:"readTimeMax"`
+	WriteBytes                         float64 `json:"writeBytes"`
+	// Number of bytes written to cache
+	WriteHitBytes float64 `json:"writeHitBytes"`
+	// Number of write operations that hit cache
+	WriteHitOps     float64 `json:"writeHitOps"`
+	WriteHitTimeMax float64 `json:"writeHitTimeMax"`
+	WriteTimeMax    float64 `json:"writeTimeMax"`
+	// Total channel queue depth.
+	QueueDepthTotal float64 `json:"queueDepthTotal"`
+	// Maximum channel queue depth.
+	QueueDepthMax float64 `json:"queueDepthMax"`
+	// The number of flash cache read hit operations.
+	FlashCacheReadHitOps float64 `json:"flashCacheReadHitOps"`
+	// The number of flash cache read hit bytes.
+	FlashCacheReadHitBytes float64 `json:"flashCacheReadHitBytes"`
+	// The sum of all response times for all reads that have cache hits, in microseconds
+	FlashCacheReadHitTimeTotal float64 `json:"flashCacheReadHitTimeTotal"`
+	// Maximum I/O time in microseconds for any one read I/O that has a cache hit
+	FlashCacheReadHitTimeMax float64 `json:"flashCacheReadHitTimeMax"`
+	// The storage pool or volume group the volume is a member
+	PoolID string `json:"poolId"`
+}
+
+// Port is the Port model.
+type Port struct {
+	// An indication of the status of the fibre channel port. Values: bypassed, notBypassed.
+	PortStatus string `json:"portStatus,omitempty"`
+	// An indication of the type of fibre channel port. Values: gbic, db9, sfp.
+	PortType  string `json:"portType,omitempty"`
+	Reserved1 string `json:"reserved1,omitempty"`
+	Reserved2 string `json:"reserved2,omitempty"`
+}
+
+// PortList is the PortList model.
+type PortList struct {
+	// A variable-length array of Port objects.
+	Ports []Port `json:"ports,omitempty"`
+}
+
+// PowerInputData is the PowerInputData model.
+// Current representation of the power input data
+type PowerInputData struct {
+	// The total measured power drawn by the storage system in watts
+	TotalPowerInWatts int `json:"totalPowerInWatts,omitempty"`
+	// The number of trays reporting power input data
+	NumberOfTrays      int                  `json:"numberOfTrays,omitempty"`
+	TrayPowerInputData []TrayPowerInputData `json:"trayPowerInputData,omitempty"`
+}
+
+// PowerSupply is the PowerSupply model.
+type PowerSupply struct {
+	// The physical location of the power supply. The parent reference in Location identifies the tray or CRU that
+	// physically houses the power supply, and the position field is the parent-relative/like-component relative
+	// number of the power supply, starting at one.
+	PowerSupplyRef string `json:"powerSupplyRef,omitempty"`
+	// The operation status of the power supply. Values: optimal, failed, removed, unknown, noinput.
+	Status string `json:"status,omitempty"`
+	// The physical location of the power supply. Note that the tray reference identifies the enclosure containing
+	// the power supply. For non-XBB controller enclosures, the slot information does not apply. For XBB controller
+	// enclosures, the slot information is an ordinal identifier (a value of 1 means the first (left) power supply
+	// and a value of 2 means the second (right) power supply).
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// The part number of the power supply from VPD data.
+	PartNumber string `json:"partNumber,omitempty"`
+	// The serial number of the power supply.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// The name of the manufacturer of the power supply.
+	VendorName string `json:"vendorName,omitempty"`
+	// The date the power supply was manufactured.
+	ManufacturerDate string `json:"manufacturerDate,omitempty"`
+	// The field replaceable unit type of the power supply.
+	FruType   string `json:"fruType,omitempty"`
+	Reserved1 string `json:"reserved1,omitempty"`
+	Reserved2 string `json:"reserved2,omitempty"`
+	// This field has been deprecated and should not be used.
+	RtrAttributes RTRAttributes `json:"rtrAttributes,omitzero"`
+	// The repair policy for the power supply component.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+	// An ASCII character string that identifies the version of the firmware.
+	FirmwareRevision string `json:"firmwareRevision,omitempty"`
+	// An integer value that specifies the Manufacturing Device Code.
+	ManufacturingDeviceCode int    `json:"manufacturingDeviceCode,omitempty"`
+	ID                      string `json:"id,omitempty"`
+}
+
+// PremiumFeature is the PremiumFeature model.
+type PremiumFeature struct {
+	// This field will contain the value of the premium feature being described. Values: none, sharedVolume,
+	// storagePoolsTo4, mixedRaidlevel, autoCodeSync, autoLunTransfer, subLunsAllowed, storagePoolsTo8,
+	// storagePoolsTo2, storagePoolsToMax, storagePoolsTo64, storagePoolsTo16, snapshots, remoteMirroring,
+	// volumeCopy, stagedDownload, mixedDriveTypes, goldKey, driveTrayExpansion, bundleMigration, storagePoolsTo128,
+	// storagePoolsTo256, raid6, performanceTier, storagePoolsTo32, storagePoolsTo96, storagePoolsTo192,
+	// storagePoolsTo512, remoteMirrorsTo16, remoteMirrorsTo32, remoteMirrorsTo64, remoteMirrorsTo128,
+	// snapshotsPerVolTo4, snapshotsPerVolTo8, snapshotsPerVolTo16, snapshotsPerVolTo2, secureVolume,
+	// protectionInformation, ssdSupport, driveSlotLimitTo112, driveSlotLimitTo120, driveSlotLimitTo256,
+	// driveSlotLimitTo448, driveSlotLimitTo480, driveSlotLimitToMax, driveSlotLimit, driveSlotLimitTo12,
+	// driveSlotLimitTo16, driveSlotLimitTo24, driveSlotLimitTo32, driveSlotLimitTo48, driveSlotLimitTo60,
+	// driveSlotLimitTo64, driveSlotLimitTo72, driveSlotLimitTo96, driveSlotLimitTo128, driveSlotLimitTo136,
+	// driveSlotLimitTo144, driveSlotLimitTo180, driveSlotLimitTo192, driveSlotLimitTo272, fdeProxyKeyManagement,
+	// remoteMirrorsTo8, driveSlotLimitTo384, driveSlotLimitTo300, driveSlotLimitTo360, flashReadCache,
+	// storagePoolsType2, remoteMirroringType2, totalNumberOfArvmMirrorsPerArray, totalNumberOfPitsPerArray,
+	// totalNumberOfThinVolumesPerArray, driveSlotLimitTo240, snapshotsType2, targetPortLunMapping,
+	// containerBasedApplication.
+	Capability string `json:"capability,omitempty"`
+	// A true value in this field indicates that the feature is enabled (regardless of compliance)
+	IsEnabled bool `json:"isEnabled,omitempty"`
+	// A true value in this field indicates that the feature has been purchased (in compliance). A false value
+	// indicates that the feature has not been purchased. The user will receive warning messages indicating that
+	// they are not in compliance. The warning message will continue until the feature is purchased or the feature
+	// is disabled.
+	IsCompliant bool `json:"isCompliant,omitempty"`
+	// A true value in this field indicates that the feature has been purchased and the subsystem configuration does
+	// not exceed the feature options specified at purchase. A false value in this field indicates that the feature
+	// has been purchased but new subsystem configuration has caused the user to exceed the feature options that
+	// were purchased. The user will receive warning messages indicating that they are no longer in compliance. The
+	// user can change the subsystem configuration so that it is in compliance with feature options or the user can
+	// purchase additional feature options so that the subsystem is again in compliance. The warning messages will
+	// continue until the feature is again in compliance or is disabled.
+	IsWithinLimits bool `json:"isWithinLimits,omitempty"`
+	// This field contains the value of the feature ID associated with the feature. Values: volumesPerPartition,
+	// totalNumberOfVolumes, storagePartitions, snapshot, volumeCopy, remoteMirroring, driveTrayExpansion,
+	// mixedDriveTypes, mgmtApplication, supportedDrives, supportedDriveTrays, performanceTier,
+	// totalNumberOfSnapshots, totalNumberOfVolCopies, goldKey, snapshotsPerVolume, totalNumberOfMirrors, raid6,
+	// stateCapture, sataStrLen, secureVolume, protectionInformation, solidStateDisk, driveSlotLimit,
+	// fdeProxyKeyManagement, supportedInterposer, vendorSupportedDrives, flashReadCache,
+	// totalNumberOfAsyncMirrorGroups, totalNumberOfAsyncMirrorsPerGroup, totalNumberOfArvmMirrorsPerArray,
+	// totalNumberOfPitsPerArray, pitGroupsPerVolume, totalNumberOfPitGroups, pitsPerPitGroup,
+	// memberVolsPerPitConsistencyGroup, totalNumberOfPitConsistencyGroups, totalNumberOfPitViews,
+	// totalNumberOfThinVolumesPerArray, nativeSataDriveSupport, solidStateDiskLimit,
+	// totalNumberOfRemoteMirrorsPerArray, asup, ectSelector, embeddedSnmpOid, asupOnDemand, dacstoreCompatId,
+	// samoaHicProtocol, targetPortLunMapping, hildaBaseboardProtocol, denali2Protocol, containerBasedApplication,
+	// fibreChannelHicProtocol, trayVpdSettable, ephemeralIscsiLoginSupport, foreignVolumeCopyIn,
+	// driveFlashTechnology.
+	FeatureID string `json:"featureId,omitempty"`
+}
+
+// PrivateFileInfo is the PrivateFileInfo model.
+type PrivateFileInfo struct {
+	// The size of the file
+	FileSize string `json:"fileSize"`
+	// The GUID for the file
+	FileID string `json:"fileID"`
+	// The URL for the file
+	FileURL string `json:"fileURL"`
+}
+
+// ProcessorMemoryDimm is the ProcessorMemoryDimm model.
+type ProcessorMemoryDimm struct {
+	// The SYMbol reference value that uniquely identifies an instance of a cache memory DIMM object.
+	ProcessorMemoryDimmRef string `json:"processorMemoryDimmRef,omitempty"`
+	// This field has been deprecated and should not be used.
+	ParentController string `json:"parentController,omitempty"`
+	// This field has been deprecated and should not be used.
+	DimmControllerSlot int `json:"dimmControllerSlot,omitempty"`
+	// The operational status of the DIMM. Values: unknown, optimal, empty, failed.
+	Status string `json:"status,omitempty"`
+	// The capacity of the DIMM expressed in megabytes.
+	CapacityInMegabytes int `json:"capacityInMegabytes,omitempty"`
+	// The serial number of the DIMM.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// The LSI Logic part number of the DIMM.
+	PartNumber string `json:"partNumber,omitempty"`
+	// The OEM part number of the DIMM.
+	OemPartNumber string `json:"oemPartNumber,omitempty"`
+	// The manufacturer part number of the DIMM.
+	ManufacturerPartNumber string `json:"manufacturerPartNumber,omitempty"`
+	// The manufacturer part number of the DIMM.
+	Manufacturer string `json:"manufacturer,omitempty"`
+	// The manufacture date of the DIMM
+	ManufactureDate string `json:"manufactureDate,omitempty"`
+	// The repair policy for the processor memory DIMM component.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+	// The physical location of the processor memory DIMM. The parent field of Location is set to the controller
+	// where the DIMM physically resides, and the position field is the parent-relative/like-component-relative slot
+	// number of the DIMM, starting at one.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// The module revision code of the DIMM.
+	RevisionCode string `json:"revisionCode,omitempty"`
+}
+
+// Progress is the Progress model.
+type Progress struct {
+	// Has the operation completed?
+	Complete bool `json:"complete,omitempty"`
+	// The approximate progress to completion of the operation.
+	Percentage int `json:"percentage,omitempty"`
+}
+
+// ProtectionInformationCapabilities is the ProtectionInformationCapabilities model.
+type ProtectionInformationCapabilities struct {
+	// True when all drive(s) are PI capable.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// This field is set to the protection type provided for the drive(s). Values: type0Protection, type1Protection,
+	// type2Protection, type3Protection.
+	ProtectionType string `json:"protectionType,omitempty"`
+}
+
+// RTRAttributeData is the RTRAttributeData model.
+type RTRAttributeData struct {
+	// True if the component has a ready-to-remove LED.
+	HasReadyToRemoveIndicator bool `json:"hasReadyToRemoveIndicator,omitempty"`
+	// True if the component is ready to remove.
+	ReadyToRemove bool `json:"readyToRemove,omitempty"`
+}
+
+// RTRAttributes is the RTRAttributes model.
+type RTRAttributes struct {
+	// This field has been deprecated and should not be used. Values: unknown, dedicated, shared, aggregate.
+	CruType string `json:"cruType,omitempty"`
+	// This field has been deprecated and should not be used.
+	ParentCru ParentCru `json:"parentCru,omitzero"`
+	// This field contains the actual settings for the ready-to-remove data. It is only present when the cruType
+	// field is set to CRU_TYPE_DEDICATED.
+	RtrAttributeData RTRAttributeData `json:"rtrAttributeData,omitzero"`
+}
+
+// RaidMigrationRequest is the RaidMigrationRequest model.
+type RaidMigrationRequest struct {
+	// Values: raidUnsupported, raidAll, raid0, raid1, raid3, raid5, raid6, raidDiskPool, raid1p.
+	RaidLevel string `json:"raidLevel"`
+}
+
+// RateLimitSettings is the RateLimitSettings model.
+type RateLimitSettings struct {
+	// Enable rate limiting.
+	Enabled bool `json:"enabled,omitempty"`
+	// Rate limit maximum requests per seconds to allow. The value must be between 50 and 200, inclusive.
+	MaxRequestsPerSec int `json:"maxRequestsPerSec,omitempty"`
+	// List of IP addresses to be excluded from rate limits.
+	IPExcludeList []string `json:"ipExcludeList,omitempty"`
+}
+
+// RawStatsResponse is the RawStatsResponse model.
+// The collection of stats that are returned from a call to get live statistics.
+type RawStatsResponse struct {
+	VolumeStats      []VolumeIOStats         `json:"volumeStats"`
+	ControllerStats  []ControllerStats       `json:"controllerStats"`
+	SystemStats      []StorageSystemStats    `json:"systemStats"`
+	InterfaceStats   []InterfaceStats        `json:"interfaceStats"`
+	DiskStats        []DiskIOStats           `json:"diskStats"`
+	PoolStats        []PoolStatistics        `json:"poolStats"`
+	ApplicationStats []ApplicationStatistics `json:"applicationStats"`
+	WorkloadStats    []WorkloadStatistics    `json:"workloadStats"`
+}
+
+// Recurrence is the Recurrence model.
+type Recurrence struct {
+	// This enumeration is used to specify the type of schedule recurrence. Values: unknown, unlimited, count,
+	// endDate.
+	RecurrenceType string `json:"recurrenceType,omitempty"`
+	// This field is present only if recurrenceType is equal to RECURRENCE_COUNT.
+	RecurrenceCount int `json:"recurrenceCount,omitempty"`
+	// This field is present only if recurrenceType is equal to RECURRENCE_END_DATE.
+	RecurrenceEndDate string `json:"recurrenceEndDate,omitempty"`
+}
+
+// RelativeDistinguishedName is the RelativeDistinguishedName model.
+type RelativeDistinguishedName struct {
+	// The attributes for the relative distinguished name.
+	Attributes []RelativeDistinguishedNameAttribute `json:"attributes,omitempty"`
+}
+
+// RelativeDistinguishedNameAttribute is the RelativeDistinguishedNameAttribute model.
+type RelativeDistinguishedNameAttribute struct {
+	// The attribute name.
+	Name string `json:"name,omitempty"`
+	// The attribute value.
+	Value string `json:"value,omitempty"`
+}
+
+// RemoteArray is the RemoteArray model.
+type RemoteArray struct {
+	// A unique identifier for this RemoteArray object.
+	RemoteArrayRef string `json:"remoteArrayRef,omitempty"`
+	// The storage array ID of the remote array.
+	SaID SAIdentifier `json:"saId,omitzero"`
+	// One or more paths to the remote array. Note these could be differing protocols.
+	RemoteTargets []string `json:"remoteTargets,omitempty"`
+}
+
+// RemoteCandidate is the RemoteCandidate model.
+// A storage-device candidate for use with ARVM.
+type RemoteCandidate struct {
+	WWN  string `json:"wwn"`
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Values: fibre, fibreAndIscsi, iscsi, none.
+	Type string `json:"type"`
+	// Values: unknown, disabled, enabledLegacyRvm, enabledArvm, enabledAll.
+	FcMirroringState string `json:"fcMirroringState"`
+}
+
+// RemoteCommunicationData is the RemoteCommunicationData model.
+type RemoteCommunicationData struct {
+	Samples []int `json:"samples"`
+	Timeout int   `json:"timeout"`
+}
+
+// RemoteMirrorCandidate is the RemoteMirrorCandidate model.
+type RemoteMirrorCandidate struct {
+	SourceVolume    VolumeEx   `json:"sourceVolume"`
+	TargetVolumes   []VolumeEx `json:"targetVolumes"`
+	TargetArrayName string     `json:"targetArrayName"`
+	TargetArrayID   string     `json:"targetArrayId"`
+}
+
+// RemoteMirrorPair is the RemoteMirrorPair model.
+type RemoteMirrorPair struct {
+	Base             VolumeEx `json:"base"`
+	Target           VolumeEx `json:"target"`
+	BaseArray        string   `json:"baseArray"`
+	TargetArray      string   `json:"targetArray"`
+	ID               string   `json:"id"`
+	LastStartTime    string   `json:"lastStartTime"`
+	LastCompleteTime string   `json:"lastCompleteTime"`
+	State            int      `json:"state"`
+	Priority         int      `json:"priority"`
+	WriteMode        int      `json:"writeMode"`
+	AutoResync       bool     `json:"autoResync"`
+	FeatureActive    bool     `json:"featureActive"`
+	// Values: optimal, unsynchronized, synchronizing, notready, suspended, failed, failedsuspended,
+	// degradedSynchronizing, degradedUnsynchronized.
+	Status string `json:"status"`
+	// Values: optimal, unsynchronized, synchronizing, notready, suspended, failed, failedsuspended,
+	// degradedSynchronizing, degradedUnsynchronized.
+	BaseStatus string `json:"baseStatus"`
+	// Values: optimal, unsynchronized, synchronizing, notready, suspended, failed, failedsuspended,
+	// degradedSynchronizing, degradedUnsynchronized.
+	TargetStatus string `json:"targetStatus"`
+}
+
+// RemoteStorageControllerInfo is the RemoteStorageControllerInfo model.
+type RemoteStorageControllerInfo struct {
+	// The local controller id.
+	ControllerID string `json:"controllerId"`
+	// The connection status between the local controller and the associated remote storage system. A status of
+	// "online" indicates that the connection is valid and fully operational. A status of "failedToConnect"
+	// indicates that a connection could not be established to the remote storage system. A status of
+	// "lossOfConnection" indicates that the connection to the remote storage system was lost. A status of "unknown"
+	// indicates that the process for getting the status has not yet completed. Values: online, failedToConnect,
+	// lossOfConnection, unknown.
+	ConnectionStatus string `json:"connectionStatus"`
+}
+
+// RemoteStorageGenericTargetParameters is the RemoteStorageGenericTargetParameters model.
+// Configuration details for the remote storage configuration.
+type RemoteStorageGenericTargetParameters struct {
+	// The connection type for the remote storage configuration. Values: iscsi.
+	ConnectionType string `json:"connectionType"`
+	// iSCSI connection information for the remote storage configuration. Only applicable if the connectionType is
+	// iscsi.
+	Iscsi RemoteStorageIscsiProperties `json:"iscsi,omitzero"`
+}
+
+// RemoteStorageGenericTargetResponse is the RemoteStorageGenericTargetResponse model.
+// Configuration details for the remote storage configuration.
+type RemoteStorageGenericTargetResponse struct {
+	// The connection type for the remote storage configuration. Values: iscsi.
+	ConnectionType string `json:"connectionType,omitempty"`
+	// iSCSI connection information for the remote storage configuration. Only applicable if the connectionType is
+	// iscsi.
+	Iscsi RemoteStorageIscsiResponse `json:"iscsi,omitzero"`
+}
+
+// RemoteStorageGenericTargetUpdateParameters is the RemoteStorageGenericTargetUpdateParameters model.
+// Updated configuration details for the remote storage configuration.
+type RemoteStorageGenericTargetUpdateParameters struct {
+	// iSCSI connection information for the remote storage configuration.
+	Iscsi RemoteStorageIscsiUpdateProperties `json:"iscsi,omitzero"`
+}
+
+// RemoteStorageIscsiInterface is the RemoteStorageIscsiInterface model.
+// The properties for a network interface on the remote storage system.
+type RemoteStorageIscsiInterface struct {
+	// The IP address of the network interface on the remote storage system.
+	IPAddress string `json:"ipAddress"`
+	// The port on which the remote storage system listens for incoming connections.
+	Port int `json:"port,omitempty"`
+}
+
+// RemoteStorageIscsiProperties is the RemoteStorageIscsiProperties model.
+// The iSCSI configuration for the remote storage configuration.
+type RemoteStorageIscsiProperties struct {
+	// The iSCSI initiator node name (IQN).
+	Iqn string `json:"iqn"`
+	// The list of network interfaces on the remote storage system.
+	IscsiInterfaces []RemoteStorageIscsiInterface `json:"iscsiInterfaces"`
+}
+
+// RemoteStorageIscsiResponse is the RemoteStorageIscsiResponse model.
+// The iSCSI configuration for the remote storage configuration.
+type RemoteStorageIscsiResponse struct {
+	// The iSCSI initiator node name (IQN).
+	Iqn string `json:"iqn,omitempty"`
+	// Network interface properties for the remote storage system.
+	IscsiInterfaces []RemoteStorageIscsiInterface `json:"iscsiInterfaces,omitempty"`
+}
+
+// RemoteStorageIscsiUpdateProperties is the RemoteStorageIscsiUpdateProperties model.
+// The updated iSCSI configuration for the remote storage configuration.
+type RemoteStorageIscsiUpdateProperties struct {
+	// The list of network interfaces on the remote storage system.
+	IscsiInterfaces []RemoteStorageIscsiInterface `json:"iscsiInterfaces,omitempty"`
+}
+
+// RemoteStorageRequest is the RemoteStorageRequest model.
+type RemoteStorageRequest struct {
+	// The user label to assign to the remote storage configuration.
+	Name string `json:"name"`
+	// Values: genericTarget.
+	RemoteStorageType string `json:"remoteStorageType"`
+	// Configuration details for the remote storage configuration.
+	GenericTarget RemoteStorageGenericTargetParameters `json:"genericTarget,omitzero"`
+}
+
+// RemoteStorageResponse is the RemoteStorageResponse model.
+type RemoteStorageResponse struct {
+	// The unique identifier for the remote storage configuration.
+	ID string `json:"id,omitempty"`
+	// The user label for the remote storage configuration.
+	Name string `json:"name,omitempty"`
+	// The overall status of the remote storage connection. A status of "optimal" indicates that the remote storage
+	// configuration is valid and fully operational. A status of "nonOptimal" indicates that there are connection
+	// issues with one or both of the local controllers. A status of "unknown" indicates that the process for
+	// getting the status has not yet completed. Values: optimal, nonOptimal, unknown.
+	Status string `json:"status,omitempty"`
+	// The list of local controllers and their associated details.
+	ControllerInfo                    []RemoteStorageControllerInfo                          `json:"controllerInfo,omitempty"`
+	RemoteStorageManagementProperties RemoteStorageResponseRemoteStorageManagementProperties `json:"remoteStorageManagementProperties,omitzero"`
+}
+
+// RemoteStorageResponseRemoteStorageManagementProperties is the RemoteStorageResponseRemoteStorageManagementProperties model.
+type RemoteStorageResponseRemoteStorageManagementProperties struct {
+	// Values: genericTarget.
+	RemoteStorageType string `json:"remoteStorageType,omitempty"`
+	// Configuration details for the remote storage configuration.
+	GenericTarget RemoteStorageGenericTargetResponse `json:"genericTarget,omitzero"`
+}
+
+// RemoteStorageUpdateRequest is the RemoteStorageUpdateRequest model.
+type RemoteStorageUpdateRequest struct {
+	// Configuration details for the remote storage configuration.
+	GenericTarget RemoteStorageGenericTargetUpdateParameters `json:"genericTarget,omitzero"`
+}
+
+// RemoteStorageUsableInterface is the RemoteStorageUsableInterface model.
+type RemoteStorageUsableInterface struct {
+	// The interface reference.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// The interface type. Values: iscsi.
+	InterfaceType string `json:"interfaceType,omitempty"`
+	// The controller reference.
+	ControllerRef string `json:"controllerRef,omitempty"`
+	// The list of channel port references.
+	ChannelPortRefs []string `json:"channelPortRefs,omitempty"`
+	// The maximum one way data transfer rate in bytes/s.
+	OneWayMaxRate string `json:"oneWayMaxRate,omitempty"`
+	// The maximum bi-directional data transfer rate in bytes/s.
+	BidirectionalMaxRate string `json:"bidirectionalMaxRate,omitempty"`
+	// The current speed of the interface. Values: speedUnknown, speed1gig, speed2gig, speed4gig, speed10gig,
+	// speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig, speed60gig,
+	// speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig, speed100gig,
+	// speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	CurrentInterfaceSpeed string `json:"currentInterfaceSpeed,omitempty"`
+	// The maximum speed of the interface. Values: speedUnknown, speed1gig, speed2gig, speed4gig, speed10gig,
+	// speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig, speed60gig,
+	// speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig, speed100gig,
+	// speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	MaximumInterfaceSpeed string `json:"maximumInterfaceSpeed,omitempty"`
+	// The link status of the interface. Values: none, up, down, failed.
+	LinkStatus string `json:"linkStatus,omitempty"`
+}
+
+// RemoteStorageVolumeRequest is the RemoteStorageVolumeRequest model.
+type RemoteStorageVolumeRequest struct {
+	// The user label to assign to the remote storage volume.
+	Name string `json:"name"`
+	// The identifier of the remote storage configuration to be associated with the remote storage volume.
+	RemoteStorageID string `json:"remoteStorageId"`
+	// The id for the preferred owning controller. By default this field will alternate between available
+	// controllers.
+	OwningControllerID string `json:"owningControllerId,omitempty"`
+	// The logical unit number (LUN) of the volume on the remote storage system.
+	LunNumber int `json:"lunNumber"`
+}
+
+// RemoteStorageVolumeResponse is the RemoteStorageVolumeResponse model.
+type RemoteStorageVolumeResponse struct {
+	// The id for the remote storage volume.
+	ID string `json:"id,omitempty"`
+	// The user label for the remote storage volume.
+	Name string `json:"name,omitempty"`
+	// The SSID of the remote storage volume. This is the numerical value that uniquely identifies the remote
+	// storage volume on this storage system.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// The logical unit number (LUN) associated with the remote storage volume.
+	LunNumber string `json:"lunNumber,omitempty"`
+	// The world wide unique identification value of the remote storage volume.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The world wide unique identifier of the volume on the remote storage system that is associated with the
+	// remote storage volume.
+	RemoteVolumeWorldWideName string `json:"remoteVolumeWorldWideName,omitempty"`
+	// The reference value of the controller that currently owns this remote storage volume.
+	CurrentControllerID string `json:"currentControllerId,omitempty"`
+	// The reference value of the controller that is the preferred owner of the remote storage volume.
+	PreferredControllerID string `json:"preferredControllerId,omitempty"`
+	// The block size (in bytes) of the remote storage volume.
+	BlkSize int `json:"blkSize,omitempty"`
+	// The capacity (in bytes) of the remote storage volume.
+	Capacity string `json:"capacity,omitempty"`
+	// The status of the remote storage volume. Unknown indicates the remote storage volume is being initialized or
+	// not ready. Values: online, offline, unknown.
+	Status string `json:"status,omitempty"`
+	// Indicates why the remote storage volume is reporting a status of "offline". A status of "none" indicates that
+	// the remote storage volume is not currently "offline". A status of "failedToConnect" indicates that a
+	// connection could not be established to the remote volume. A status of "lossOfConnection" indicates that the
+	// connection to the remote volume was lost. A status of "inquiryDataMismatch" indicates that the current
+	// inquiry data for the remote volume does not match the original inquiry data. A status of "invalidBlockSize"
+	// indicates that the current block size of the remote volume does not match the original block size. A status
+	// of "invalidCapacity" indicates that the current capacity of the remote volume does not match the original
+	// capacity. Values: none, failedToConnect, lossOfConnection, inquiryDataMismatch, invalidBlockSize,
+	// invalidCapacity.
+	OfflineReason string `json:"offlineReason,omitempty"`
+	// The identifier of the remote storage configuration associated with the remote storage volume.
+	RemoteStorageID string `json:"remoteStorageId,omitempty"`
+	// The PI protection type of the remote storage volume. Values: type0Protection, type1Protection,
+	// type2Protection, type3Protection.
+	ProtectionType string `json:"protectionType,omitempty"`
+	// The volume-specific caching parameters currently in effect for the remote storage volume.
+	Cache VolumeCache `json:"cache,omitzero"`
+	// The operations allowed on the remote storage volume.
+	Perms VolumePerms `json:"perms,omitzero"`
+}
+
+// RemoteStorageVolumeUpdateRequest is the RemoteStorageVolumeUpdateRequest model.
+type RemoteStorageVolumeUpdateRequest struct {
+	// The id for the preferred owning controller.
+	OwningControllerID string `json:"owningControllerId,omitempty"`
+}
+
+// RemoteTarget is the RemoteTarget model.
+type RemoteTarget struct {
+	// Unique identifier for this initiator/target object.
+	RemoteRef string `json:"remoteRef,omitempty"`
+	// Node name associated with the local initiator.
+	NodeName ScsiNodeName `json:"nodeName,omitzero"`
+	// The user-assigned, descriptive label string for the remote target. Note: this is auto-generated for ARVM.
+	Label string `json:"label,omitempty"`
+	// The authentication methods that have been configured for the remote target. Associated secrets are not
+	// exposed. The type field of the EncryptedPassword is set to ENCRYPT_UNSPECIFIED.
+	ConfiguredAuthMethods             AuthenticationBundle              `json:"configuredAuthMethods,omitzero"`
+	ScsiinitiatorTargetBaseProperties ScsiInitiatorTargetBaseProperties `json:"scsiinitiatorTargetBaseProperties,omitzero"`
+}
+
+// RemoteTargetGroup is the RemoteTargetGroup model.
+type RemoteTargetGroup struct {
+	// SYMbol ref of the remote target group
+	GroupRef string `json:"groupRef,omitempty"`
+	// User label for the remote target group
+	Label string `json:"label,omitempty"`
+	// Management properties for the remote target group
+	MgmtProperties RemoteTargetGroupMgmtProperties `json:"mgmtProperties,omitzero"`
+	// SYMbol ref of the remote target
+	RemoteRef string `json:"remoteRef,omitempty"`
+	// The current status of the Remote Target Group for each controller.
+	RemoteTargetGroupControllerData []RemoteTargetGroupControllerData `json:"remoteTargetGroupControllerData,omitempty"`
+}
+
+// RemoteTargetGroupAWSGatewayMgmtData is the RemoteTargetGroupAWSGatewayMgmtData model.
+type RemoteTargetGroupAWSGatewayMgmtData struct {
+	// Amazon Resource Name of the gateway
+	GatewayARN string `json:"gatewayARN,omitempty"`
+	// Amazon region of the gateway (i.e. us-east-1)
+	Region string `json:"region,omitempty"`
+	// Used to retrieve secrets for the AWS management APIs
+	SecretKeyGroup string `json:"secretKeyGroup,omitempty"`
+}
+
+// RemoteTargetGroupControllerData is the RemoteTargetGroupControllerData model.
+type RemoteTargetGroupControllerData struct {
+	// A reference to the controller that is reporting its status.
+	ControllerRef string `json:"controllerRef,omitempty"`
+	// The status for the Remote Target Group for the given controller. Values: unknown, online, failedToConnect,
+	// lostConnection.
+	RemoteTargetGroupStatus string `json:"remoteTargetGroupStatus,omitempty"`
+}
+
+// RemoteTargetGroupEseriesMgmtData is the RemoteTargetGroupEseriesMgmtData model.
+type RemoteTargetGroupEseriesMgmtData struct {
+	// ESeries array WWN/unique identifier
+	ArrayIdentifier string `json:"arrayIdentifier,omitempty"`
+	// Management IP addresses
+	MgmtIPAddresses []IPVxAddress `json:"mgmtIpAddresses,omitempty"`
+	// Used to retrieve secrets for the ESeries management APIs
+	SecretKeyGroup string `json:"secretKeyGroup,omitempty"`
+}
+
+// RemoteTargetGroupMgmtProperties is the RemoteTargetGroupMgmtProperties model.
+type RemoteTargetGroupMgmtProperties struct {
+	// This enumeration specifies the remote target group type Values: unknown, awsGateway, eseries, genericTarget.
+	Type string `json:"type,omitempty"`
+	// Mgmt data for AWS Gateway
+	AwsGatewayData RemoteTargetGroupAWSGatewayMgmtData `json:"awsGatewayData,omitzero"`
+	// Eseries management data
+	EseriesData RemoteTargetGroupEseriesMgmtData `json:"eseriesData,omitzero"`
+}
+
+// RemoteTargetVolume is the RemoteTargetVolume model.
+type RemoteTargetVolume struct {
+	// The SSID of the volume
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// A variable-length opaque field that provides the volume's world wide unique identification value
+	WorldWidename string `json:"worldWidename,omitempty"`
+	// The unique identification value for this volume. Other objects may use this reference value to refer to the
+	// volume
+	RemoteTargetVolRef string `json:"remoteTargetVolRef,omitempty"`
+	// The remote volume object identifier this object uses
+	RemoteVolumeRef string `json:"remoteVolumeRef,omitempty"`
+	// A variable-length opaque field that provides the volume's extended unique identification value
+	ExtendedUniqueIdentifier string `json:"extendedUniqueIdentifier,omitempty"`
+	// The user assigned label of the volume
+	Label string `json:"label,omitempty"`
+	// The controller that owns the volume
+	CurrentManager string `json:"currentManager,omitempty"`
+	// The controller that is preferred to own the volume
+	PreferredManager string `json:"preferredManager,omitempty"`
+	// The actual capacity of the volume in bytes
+	Capacity string `json:"capacity,omitempty"`
+	// Operations allowed on this volume
+	Perms VolumePerms `json:"perms,omitzero"`
+	// The block size (in bytes) of the volume
+	BlkSize int `json:"blkSize,omitempty"`
+	// The volume-specific caching parameters currently in effect for the volume
+	Cache VolumeCache `json:"cache,omitzero"`
+	// For use by management client
+	MgmtClientAttribute int `json:"mgmtClientAttribute,omitempty"`
+	// The properties of the volume, e.g. string up to 224 characters used to manage the volume remotely
+	Properties RemoteTargetVolumeProperties `json:"properties,omitzero"`
+	// The status of the volume Values: unknown, online, offline.
+	RemoteTargetVolStatus string `json:"remoteTargetVolStatus,omitempty"`
+	// T10 PI protection type of the volume Values: type0Protection, type1Protection, type2Protection,
+	// type3Protection.
+	ProtectionType string `json:"protectionType,omitempty"`
+	// Expected Protection Information Application Tag for the volume
+	ExpectedProtectionInformationAppTag int `json:"expectedProtectionInformationAppTag,omitempty"`
+	// True if checking of protection information during cache mirroring is enabled for this volume, false otherwise
+	CacheMirroringValidateProtectionInformation bool `json:"cacheMirroringValidateProtectionInformation,omitempty"`
+	// The remote target group the remote target volume uses to access the remote volume
+	RemoteTargetGroupRef string `json:"remoteTargetGroupRef,omitempty"`
+	// The reason for the volume's status. Values: unknown, online, incorrectInquiryData, invalidBlockSize,
+	// failedToConnect, lostConnection, invalidCapacity.
+	RemoteTargetVolStatusReason string `json:"remoteTargetVolStatusReason,omitempty"`
+}
+
+// RemoteTargetVolumeDefaultsResponse is the RemoteTargetVolumeDefaultsResponse model.
+// The remote target volume defaults.
+type RemoteTargetVolumeDefaultsResponse struct {
+	// The maximum number of remote target groups that can exist within the storage system at one time.
+	MaxRemoteTargetGroups int `json:"maxRemoteTargetGroups"`
+	// The maximum number of remote target volumes that can exist within the storage system at one time.
+	MaxRemoteTargetVolumes int `json:"maxRemoteTargetVolumes"`
+	// The maximum number of remote target volume copies that can be established at one time.
+	MaxVolumeCopies int `json:"maxVolumeCopies"`
+}
+
+// RemoteTargetVolumeProperties is the RemoteTargetVolumeProperties model.
+type RemoteTargetVolumeProperties struct {
+	// This enumeration specifies the remote target group type Values: unknown, awsGateway, eseries, genericTarget.
+	Type string `json:"type,omitempty"`
+	// The AWS specific volume properties
+	AwsVolumeProperties RemoteTargetVolumePropertiesAWSGateway `json:"awsVolumeProperties,omitzero"`
+	// Eseries specific volume properties
+	EseriesVolumeProperties RemoteTargetVolumePropertiesEseries `json:"eseriesVolumeProperties,omitzero"`
+	// Generic target type volume properties
+	GenericTargetVolumeProperties RemoteTargetVolumePropertiesGenericTarget `json:"genericTargetVolumeProperties,omitzero"`
+}
+
+// RemoteTargetVolumePropertiesAWSGateway is the RemoteTargetVolumePropertiesAWSGateway model.
+type RemoteTargetVolumePropertiesAWSGateway struct {
+	// Node name of target device
+	NodeName ScsiNodeName `json:"nodeName,omitzero"`
+	// Amazon Resource Name of the remote volume
+	VolumeARN string `json:"volumeARN,omitempty"`
+}
+
+// RemoteTargetVolumePropertiesEseries is the RemoteTargetVolumePropertiesEseries model.
+type RemoteTargetVolumePropertiesEseries struct {
+	// The lun number of the remote target volume
+	LunNumber int `json:"lunNumber,omitempty"`
+}
+
+// RemoteTargetVolumePropertiesGenericTarget is the RemoteTargetVolumePropertiesGenericTarget model.
+type RemoteTargetVolumePropertiesGenericTarget struct {
+	// The lun number of the remote target volume
+	LunNumber int `json:"lunNumber,omitempty"`
+}
+
+// RemoteVolume is the RemoteVolume model.
+type RemoteVolume struct {
+	// The WWN of the remote volume.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The identifier of the remote volume.
+	RemoteVolRef string `json:"remoteVolRef,omitempty"`
+	// The controller that owns the remote volume.
+	Manager string `json:"manager,omitempty"`
+	// The identifier of the remote array.
+	RemoteArrayID SAIdentifier `json:"remoteArrayId,omitzero"`
+	// This field has been deprecated and should not be used.
+	RemoteNodeWWN string `json:"remoteNodeWWN,omitempty"`
+	// The WWN of the volume on the remote array.
+	RemoteVolWWN string `json:"remoteVolWWN,omitempty"`
+	// The user assigned label of the remote volume.
+	RemoteVolUserLabel string `json:"remoteVolUserLabel,omitempty"`
+	// The state of the current remote volume. Values: optimal, noLun, noArray, noFabric, initializing,
+	// wwnChangeFailed.
+	RemoteVolState string `json:"remoteVolState,omitempty"`
+	// The actual capacity of the volume on the remote array.
+	RemoteVolCapacity string `json:"remoteVolCapacity,omitempty"`
+	// The SSID of the remote volume.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// The permissions of the volume.
+	Perms     VolumePerms `json:"perms,omitzero"`
+	Reserved1 string      `json:"reserved1,omitempty"`
+	Reserved2 string      `json:"reserved2,omitempty"`
+	// The name of the RemoteVolume.
+	RemoteNodeName ScsiNodeName `json:"remoteNodeName,omitzero"`
+	// The security type of the volume on the remote array. Note this may be SECURITY_TYPE_UNKNOWN if connection to
+	// remote array is down. Values: unknown, none, capable, enabled.
+	RemoteVolSecurityType string `json:"remoteVolSecurityType,omitempty"`
+}
+
+// RemoteVolumeImport is the RemoteVolumeImport model.
+// Denotes details of the remote volume import operation request status and parameters.
+type RemoteVolumeImport struct {
+	// The remote storage configuration associated with this remote volume import operation.
+	RemoteStorageID string `json:"remoteStorageId,omitempty"`
+	// The remote storage volume being used as a source for the remote volume import operation.
+	SourceVolumeID string `json:"sourceVolumeId,omitempty"`
+	// The local volume being used as a target/destination for the remote volume import operation.
+	TargetVolumeID string `json:"targetVolumeId,omitempty"`
+	// The volumeCopy associated with the remote volume import operation. Not required for most users, but available
+	// if needed to reference the associated VolumeCopy object.
+	CopyRef string `json:"copyRef,omitempty"`
+	// The percentage of progress.
+	ProgressPercent float64 `json:"progressPercent,omitempty"`
+	// The estimated rate of data transfer in bytes/s.
+	TransferRate float64 `json:"transferRate,omitempty"`
+	// The approximate date and time the data transfer is expected to complete based on the observed rate of the
+	// import in RFC3339 format. Value will be null if it is unavailable.
+	EstimatedCompletionTime string `json:"estimatedCompletionTime,omitempty"`
+	// The estimated remaining time for the data transfer to complete in seconds. Value will be -1 if it is
+	// unavailable. This is based on the rate of the data transfer observed so far.
+	EstimatedRemainingTimeInSeconds string `json:"estimatedRemainingTimeInSeconds,omitempty"`
+	// The date and time that the remote volume import operation started in RFC3339 format. Value will be null if it
+	// is unavailable.
+	StartTime string `json:"startTime,omitempty"`
+	// The priority of the remote volume import operation relative to other copies/imports and other I/O operations
+	// running on the system. Values: lowest, low, medium, high, highest.
+	Priority string `json:"priority,omitempty"`
+	// The current status of the remote volume import operation. Values: inProgress, complete, halted, failed,
+	// pending.
+	Status string `json:"status,omitempty"`
+}
+
+// RemoteVolumeImportControlRequest is the RemoteVolumeImportControlRequest model.
+// Modify an existing remote volume import operation.
+type RemoteVolumeImportControlRequest struct {
+	// The priority of the remote volume import operation relative to other copies/imports and other I/O operations
+	// running on the system. Values: lowest, low, medium, high, highest.
+	Priority string `json:"priority,omitempty"`
+	// The action to perform on the remote volume import operation. Values: start, stop.
+	Operation string `json:"operation,omitempty"`
+}
+
+// RemoteVolumeImportRequest is the RemoteVolumeImportRequest model.
+// Define a new volume import operation. Upon the completion of this operation, the contents of the volume on the
+// remote storage system referenced by the remote storage volume object will be copied to the target volume. While
+// this operation is in progress, all data on the source volume will be accessible (read/write capable) via the
+// target volume. It is imperative that the source volume not be mounted or utilized while this import operation is
+// in progress, as data/file-system corruption may occur.
+type RemoteVolumeImportRequest struct {
+	// A reference to an existing remote storage volume to be used as the source of the remote volume import
+	// operation.
+	SourceVolumeID string `json:"sourceVolumeId"`
+	// A reference to an existing local volume to be used as the target/destination of the remote volume import
+	// operation.
+	TargetVolumeID string `json:"targetVolumeId"`
+	// The priority of the remote volume import operation relative to other copies/imports and other I/O operations
+	// running on the system. If not specified, the value will default to medium. Values: lowest, low, medium, high,
+	// highest.
+	Priority string `json:"priority,omitempty"`
+}
+
+// RemoteVolumeMirrorCreateRequest is the RemoteVolumeMirrorCreateRequest model.
+// Creates new Remote Volume Mirror
+type RemoteVolumeMirrorCreateRequest struct {
+	// Remote Volume Mirror source volume ID.
+	SrcVolID      string `json:"srcVolID"`
+	TgtVolWWN     string `json:"tgtVolWWN"`
+	RemoteArrayID string `json:"remoteArrayId"`
+	CopyType      int    `json:"copyType"`
+	Priority      int    `json:"priority"`
+	AutoResync    bool   `json:"autoResync"`
+}
+
+// RemoteVolumeMirrorUpdateRequest is the RemoteVolumeMirrorUpdateRequest model.
+// Updates the RemoteVolumeMirror Data.
+type RemoteVolumeMirrorUpdateRequest struct {
+	// Remote Volume Mirror base volume.
+	BaseVolumeID string `json:"baseVolumeId"`
+	// Remote Volume Mirror remote volume.
+	RemoteVolumeID string `json:"remoteVolumeId"`
+	// Remote Volume Mirror role. 0, set role to primary. 1, set role to secondary
+	Role *int `json:"role,omitempty"`
+	// Remote Volume Mirror update type request. Values: suspend, resume, resync, roleChange, updateParams.
+	UpdateType string `json:"updateType"`
+	Priority   *int   `json:"priority,omitempty"`
+	WriteMode  *int   `json:"writeMode,omitempty"`
+	AutoResync *bool  `json:"autoResync,omitempty"`
+}
+
+// RemovableDriveResponse is the RemovableDriveResponse model.
+type RemovableDriveResponse struct {
+	// The id of the StoragePool.
+	PoolID string `json:"poolId"`
+	// Maximum number of drives that can be removed from the StoragePool in order to shrink its capacity and disk
+	// usage.
+	RemovableDriveCount int `json:"removableDriveCount"`
+}
+
+// RepairPolicy is the RepairPolicy model.
+type RepairPolicy struct {
+	// This field contains the removal data for the component. This primary piece of data is a removal method.
+	// Additional "ready-to-remove" attributes may be defined, depending on the removal method.
+	RemovalData ComponentRemovalData `json:"removalData,omitzero"`
+	// This field defines the "replacement method" for the component. Values: parent, self.
+	ReplacementMethod string `json:"replacementMethod,omitempty"`
+}
+
+// RepositoryUtilization is the RepositoryUtilization model.
+type RepositoryUtilization struct {
+	// This field contains the number of bytes used in the repository.
+	RepositoryUsage string `json:"repositoryUsage,omitempty"`
+	// The snapshot volume to which this utilization refers.
+	SnapRef string `json:"snapRef,omitempty"`
+}
+
+// ResourceProvisionedVolumesRequest is the ResourceProvisionedVolumesRequest model.
+// The resource provisioned volumes request.
+type ResourceProvisionedVolumesRequest struct {
+	// Optional. True if volume resource provisioning is enabled when an SSD volume is created that supports this
+	// capability. If set to false, resource provisioning will be disabled when a volume is created. Note that for
+	// NVMe drives, this capability is supported if and only if all drives in the volume group or storage pool
+	// support the DULBE feature. If the field is not set in the request, then no change to this setting will occur.
+	EnableResourceProvisionedVolumes *bool `json:"enableResourceProvisionedVolumes,omitempty"`
+}
+
+// ResourceProvisionedVolumesResponse is the ResourceProvisionedVolumesResponse model.
+// The resource provisioned volumes response.
+type ResourceProvisionedVolumesResponse struct {
+	// True if volume resource provisioning is enabled when an SSD volume is created that supports this capability.
+	// If set to false, resource provisioning will be disabled when a volume is created. Note that for NVMe drives,
+	// this capability is supported if and only if all drives in the volume group or storage pool support the DULBE
+	// feature.
+	EnableResourceProvisionedVolumes bool `json:"enableResourceProvisionedVolumes"`
+}
+
+// RoceV2Ipv4Settings is the RoceV2Ipv4Settings model.
+type RoceV2Ipv4Settings struct {
+	// The IPv4 configuration method. Values: configDhcp, configStatic.
+	ConfigurationMethod string `json:"configurationMethod,omitempty"`
+	// The IPv4 address.
+	Address string `json:"address,omitempty"`
+	// The subnet mask.
+	SubnetMask string `json:"subnetMask,omitempty"`
+	// The gateway address.
+	GatewayAddress string `json:"gatewayAddress,omitempty"`
+}
+
+// RoceV2Ipv6Settings is the RoceV2Ipv6Settings model.
+type RoceV2Ipv6Settings struct {
+	// The IPv6 configuration method. Values: configStatic, configStateless.
+	ConfigurationMethod string `json:"configurationMethod,omitempty"`
+	// The list of IPv6 local addresses
+	LocalAddresses []string `json:"localAddresses,omitempty"`
+	// The list of IPv6 routable addresses
+	RoutableAddresses []string `json:"routableAddresses,omitempty"`
+	// The IPv6 router address
+	RouterAddress string `json:"routerAddress,omitempty"`
+}
+
+// RoceV2NetworkSettings is the RoceV2NetworkSettings model.
+type RoceV2NetworkSettings struct {
+	// The interface speed. Values: speedUnknown, speed1gig, speed2gig, speed4gig, speed10gig, speed15gig,
+	// speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig, speed60gig, speed8gig,
+	// speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig, speed100gig, speed50gig,
+	// speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	InterfaceSpeed string `json:"interfaceSpeed,omitempty"`
+	// The interface Media Transport Unit (MTU).
+	InterfaceMtu int `json:"interfaceMtu,omitempty"`
+	// Determines whether IPv4 is enabled.
+	Ipv4Enabled bool `json:"ipv4Enabled,omitempty"`
+	// The IPv4 network settings
+	Ipv4Settings RoceV2Ipv4Settings `json:"ipv4Settings,omitzero"`
+	// Determines whether IPv6 is enabled.
+	Ipv6Enabled bool `json:"ipv6Enabled,omitempty"`
+	// The IPv6 network settings
+	Ipv6Settings RoceV2Ipv6Settings `json:"ipv6Settings,omitzero"`
+}
+
+// RoceV2Settings is the RoceV2Settings model.
+type RoceV2Settings struct {
+	// The RoCEV2 interface network settings
+	NetworkSettings RoceV2NetworkSettings `json:"networkSettings,omitzero"`
+}
+
+// RolePermissionData is the RolePermissionData model.
+// Role permissions for an endpoint.
+type RolePermissionData struct {
+	// HTTP method
+	HTTPMethod string `json:"httpMethod"`
+	// Endpoint path
+	Endpoint string `json:"endpoint"`
+	// List of roles allowed to access this endpoint
+	Roles []string `json:"roles"`
+}
+
+// RolesResponse is the RolesResponse model.
+// API response object that includes current user roles and list of role permissions.
+type RolesResponse struct {
+	// Current user roles
+	UserRoles []string `json:"userRoles"`
+	// List of endpoints and their role permissions
+	Endpoints []RolePermissionData `json:"endpoints"`
+}
+
+// Rule is the Rule model.
+// Represents the rules associated to a folder.
+type Rule struct {
+	// Values: attribute, metadata.
+	RuleType string `json:"ruleType"`
+	// The folder's rule key. Note that the supported keys when the rule type is set to "attribute" are as follows:
+	// "ip", "status", "firmware", and "model".
+	Key string `json:"key"`
+	// The folder's rule value.
+	Value string `json:"value"`
+}
+
+// SACache is the SACache model.
+type SACache struct {
+	// The characteristic size, in bytes, of a cache block.
+	CacheBlkSize int `json:"cacheBlkSize,omitempty"`
+	// Specifies the amount of cache data that will be flushed to disk when a cache flush operation is initiated.
+	// This value is specified as an integer in the range 0-100 that represents the percentage of the controller's
+	// write cache data that can remain in the "dirty", or unflushed state. When demand flush operations are
+	// started, data will be flushed from cache to disk until the overall amount of dirty data drops to or below the
+	// percentage specified by this value.
+	DemandFlushAmount int `json:"demandFlushAmount,omitempty"`
+	// Specifies the "level of fullness" of "dirty" data in the controller's write cache which, when reached, will
+	// cause the controller to begin flushing data from cache to disk. This value is specified as a percentage, in
+	// the range 0-100, of the overall write cache capacity of the controller. After flushing begins, it will
+	// continue until enough data has been flushed to drop the percentage of dirty data to or below the value
+	// specified in the demandFlushAmount parameter described above.
+	DemandFlushThreshold int    `json:"demandFlushThreshold,omitempty"`
+	Reserved1            string `json:"reserved1,omitempty"`
+	Reserved2            string `json:"reserved2,omitempty"`
+	// True if checking of protection information during cache mirroring is enabled, false otherwise.
+	CacheMirroringProtectionInformation bool `json:"cacheMirroringProtectionInformation,omitempty"`
+}
+
+// SAData is the SAData model.
+type SAData struct {
+	// An indication of whether the array needs attention. A value of true is returned if there are any components
+	// that have been marked failed for any reason. Otherwise, if all components are operating properly, a value of
+	// false is returned.
+	NeedsAttention bool `json:"needsAttention,omitempty"`
+	// An indication of whether the array is in the process of recovering from a Needs Attention condition. A value
+	// of true indicates that no physical components are currently marked failed, but that some residual recovery
+	// activity (such as reconstruction, copyback, etc.) is still in progress.
+	Fixing bool `json:"fixing,omitempty"`
+	// The globally-unique identifier value for this storage array.
+	SaID SAIdentifier `json:"saId,omitzero"`
+	// The name of the application-level entity required to manage configuration operations for the storage array.
+	ManagementClassName string `json:"managementClassName,omitempty"`
+	// The user-assigned label for the storage array.
+	StorageArrayLabel string `json:"storageArrayLabel,omitempty"`
+	// The timestamp value for the time at which the responding controller last booted. Note that this value may
+	// differ between the individual controllers of a storage array. This value is defined in terms of the number of
+	// seconds since midnight GMT on January 1, 1970.
+	BootTime string `json:"bootTime,omitempty"`
+	// This field has been deprecated and should not be used.
+	FwVersion string `json:"fwVersion,omitempty"`
+	// This field has been deprecated and should not be used.
+	AppVersion string `json:"appVersion,omitempty"`
+	// This field has been deprecated and should not be used.
+	BootVersion string `json:"bootVersion,omitempty"`
+	// This field has been deprecated and should not be used.
+	NvsramVersion string `json:"nvsramVersion,omitempty"`
+	// An ASCII string that describes the firmware prefix.
+	FwPrefix string `json:"fwPrefix,omitempty"`
+	// An ASCII string that describes the chassis serial number.
+	ChassisSerialNumber string `json:"chassisSerialNumber,omitempty"`
+	// An ASCII string that identifies the version of the MEL event configuration data. This is used for the event
+	// logging enhancements in Chessie 2.
+	EventConfigurationDataVersion string `json:"eventConfigurationDataVersion,omitempty"`
+	// SAAttributeID attribute-value pairs.
+	ArrayAttributes []int  `json:"arrayAttributes,omitempty"`
+	Res4            []int  `json:"res4,omitempty"`
+	Res5            []int  `json:"res5,omitempty"`
+	Res6            []int  `json:"res6,omitempty"`
+	Res7            string `json:"res7,omitempty"`
+	// This element allows additional content to be added to SAData with actually adding any new elements to the
+	// struct. The element replaces a reserved field that was of variable length.
+	ExtendedSAData ExtendedSAData `json:"extendedSAData,omitzero"`
+}
+
+// SAIdentifier is the SAIdentifier model.
+type SAIdentifier struct {
+	// A variable-length, opaque byte array whose value is guaranteed to be globally unique, and thus serves as the
+	// unique identifier for the storage array. No assumptions may be made outside the controller firmware
+	// environment about the meaning of the various bytes within this array. The only operation that a SYMbol client
+	// is allowed to perform on this field is a comparison for equality with the same field of a different storage
+	// array object.
+	WorldWideName string `json:"worldWideName,omitempty"`
+}
+
+// SAPort is the SAPort model.
+type SAPort struct {
+	// This object uniquely identifies an SAPort.
+	SaPortRef string `json:"saPortRef,omitempty"`
+	// This object uniquely identifies an SAPortGroup.
+	SaPortGroupRef string `json:"saPortGroupRef,omitempty"`
+	// The reference value of the controller.
+	ControllerRef string `json:"controllerRef,omitempty"`
+	// The host-side I/O port identifier of the port on the controller.
+	PortID int `json:"portId,omitempty"`
+}
+
+// SAPortGroup is the SAPortGroup model.
+type SAPortGroup struct {
+	// This object uniquely identifies a specific SAPortGroup.
+	SaPortGroupRef string `json:"saPortGroupRef,omitempty"`
+	// The user assigned label for the SAPortGroup.
+	Label string `json:"label,omitempty"`
+}
+
+// SATAInterface is the SATAInterface model.
+type SATAInterface struct {
+	// The channel number of this channel
+	Channel int `json:"channel,omitempty"`
+	// The current interface speed of the SATA interface. Values: speedUnknown, speed1gig, speed2gig, speed4gig,
+	// speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig,
+	// speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig,
+	// speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	CurrentInterfaceSpeed string `json:"currentInterfaceSpeed,omitempty"`
+	// The maximum interface speed of the SATA interface. Values: speedUnknown, speed1gig, speed2gig, speed4gig,
+	// speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig,
+	// speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig,
+	// speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	MaximumInterfaceSpeed string `json:"maximumInterfaceSpeed,omitempty"`
+	// An indicator of how the device speed is controlled. Values: unknown, fixed, switched, auto.
+	SpeedControl string `json:"speedControl,omitempty"`
+	// A string indicating the chip type.
+	Part string `json:"part,omitempty"`
+	// The revision level of the SATA part.
+	Revision int `json:"revision,omitempty"`
+	// This field has been deprecated and should not be used.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// The physical location of the SATA interface. The parent reference in Location identifies the physical
+	// component (e.g., controller or host card) where the interface circuitry is located, and the position field is
+	// a firmware-assigned 1-relative number signifying "1st SATA interface relative to the parent," "2nd SATA
+	// interface relative to the parent," etc. This "interface number" is independent of the interface's channel
+	// association.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// This field indicates whether or not the I/O interface is PI capable.
+	ProtectionInformationCapable bool   `json:"protectionInformationCapable,omitempty"`
+	ID                           string `json:"id,omitempty"`
+}
+
+// SCSICommandProtocolProperties is the SCSICommandProtocolProperties model.
+type SCSICommandProtocolProperties struct {
+	// This enumeration type identifies the various supported transport-specific SCSI Command Protocols. Values:
+	// unknown, sas, fcp, iscsi, srp, iser.
+	ScsiProtocolType string `json:"scsiProtocolType,omitempty"`
+	// This defines the protocol properties for iSER.
+	IserProperties SCSIIserProperties `json:"iserProperties,omitzero"`
+}
+
+// SCSIDriveAddress is the SCSIDriveAddress model.
+type SCSIDriveAddress struct {
+	// The controller drive-side SCSI channel on which the drive resides.
+	Channel int `json:"channel,omitempty"`
+	// The drive's SCSI ID value.
+	ID int `json:"id,omitempty"`
+}
+
+// SCSIInitiatorPortID is the SCSIInitiatorPortId model.
+type SCSIInitiatorPortID struct {
+	// This enumeration defines the different I/O interface types that may be reported as part of the configuration
+	// information associated with a controller. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib, fcoe,
+	// nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	IoInterfaceType string `json:"ioInterfaceType,omitempty"`
+	// This field is present only if the type value is equal to IO_IF_FC.
+	PortName string `json:"portName,omitempty"`
+	// This field is present only if the type value is equal to IO_IF_IB.
+	IbPortName string `json:"ibPortName,omitempty"`
+	// This field is present only if the type value is equal to IO_IF_ISCSI.
+	IscsiPortName IscsiInitiatorPortID `json:"iscsiPortName,omitzero"`
+	// This field is present only if the type value is equal to IO_IF_SAS.
+	SasPortName string `json:"sasPortName,omitempty"`
+	// Initiator Port ID for an NVMe over Fabrics host
+	NvmeInitiatorPortID NVMeInitiatorPortID `json:"nvmeInitiatorPortId,omitzero"`
+}
+
+// SCSIInterface is the SCSIInterface model.
+type SCSIInterface struct {
+	// The channel number of this interface.
+	Channel int `json:"channel,omitempty"`
+	// The SCSI ID value used by the controller on this interface.
+	ScsiID int `json:"scsiID,omitempty"`
+	// The speed of the interface in MB/sec.
+	Speed int `json:"speed,omitempty"`
+	// The type of SCSI line driver (e.g. SE, LVD, etc.) being used. Values: se, hvd, lvd.
+	ScsiType string `json:"scsiType,omitempty"`
+	// The transfer width of the SCSI bus, in bits.
+	Width int `json:"width,omitempty"`
+	// An ASCII text string that describes the SCSI controller chip type.
+	Part string `json:"part,omitempty"`
+	// The revision level of the SCSI controller chip.
+	Revision  int    `json:"revision,omitempty"`
+	Reserved1 string `json:"reserved1,omitempty"`
+	Reserved2 string `json:"reserved2,omitempty"`
+}
+
+// SCSIIserProperties is the SCSIIserProperties model.
+type SCSIIserProperties struct {
+	// The IPv4 related information for the interface.
+	Ipv4Data InternetProtocolV4Data `json:"ipv4Data,omitzero"`
+}
+
+// SFPParentTypeData is the SFPParentTypeData model.
+type SFPParentTypeData struct {
+	// This field has been deprecated and should not be used. Values: unknown, esm, minihub, controller.
+	SfpParentType string `json:"sfpParentType,omitempty"`
+	// This field is present only if the sfpParentType is equal to SFP_PARENT_TYPE_CONTROLLER. It contains the
+	// parent type of a SFP.
+	ControllerSFP ControllerSFP `json:"controllerSFP,omitzero"`
+	// No information is returned
+	ParentEsm string `json:"parentEsm,omitempty"`
+	// This field is present only if the sfpParentType is equal to SFP_PARENT_TYPE_MINIHUB. It contains the parent
+	// type of a SFP.
+	ParentMinihub string `json:"parentMinihub,omitempty"`
+}
+
+// SFPType is the SFPType model.
+type SFPType struct {
+	// Information about the SFP type. Values: unknown, gbic, soldered, sfp.
+	SfpIdentType string `json:"sfpIdentType,omitempty"`
+	// Information about the SFP connector type. Values: unknown, sc, fcs1cc, fcs2cc, bncortnc, fcCoax, fJack, lc,
+	// mtRj, mu, sg, optPigtail, hssdcii, copPigtail, rj45, noSeparableConnector.
+	SfpConnectType string `json:"sfpConnectType,omitempty"`
+	// Information about the SFP link length. Values: typeUnknown, typeVlong, typeShort, typeIntermediate, typeLong,
+	// typeMedium, typeNa.
+	SfpLinkLType string `json:"sfpLinkLType,omitempty"`
+	// Information about the SFP transmitter type. Values: unknown, lwlLc, eiee, eiae, swlwoofc, swlwofc, lwlLl, na.
+	SfpTransmitterType string `json:"sfpTransmitterType,omitempty"`
+	// Information about the SFP speed.
+	SfpSpeed []string `json:"sfpSpeed,omitempty"`
+	// Information about the SFP transmission media.
+	SfpTransmissionMedia []string `json:"sfpTransmissionMedia,omitempty"`
+	// The date when the SFP was manufactured.
+	ManufacturerDate string `json:"manufacturerDate,omitempty"`
+	// The vendor name of the SFP.
+	VendorName string `json:"vendorName,omitempty"`
+	// The vendor part name of the SFP.
+	VendorPN string `json:"vendorPN,omitempty"`
+	// The vendor revision of the SFP
+	VendorRev string `json:"vendorRev,omitempty"`
+	// The vendor serial number of the SFP.
+	VendorSN string `json:"vendorSN,omitempty"`
+	// The vendor unique OUI field of the SFP.
+	VendorOUI string `json:"vendorOUI,omitempty"`
+	Reserved1 string `json:"reserved1,omitempty"`
+	Reserved2 string `json:"reserved2,omitempty"`
+}
+
+// SNMPAgentBundle is the SNMPAgentBundle model.
+type SNMPAgentBundle struct {
+	// All configured SNMP communities
+	Community []SNMPCommunity `json:"community,omitempty"`
+	// SNMP Trap Destinations. Traps will be delivered to these destinations when an alertable MEL event is
+	// generated.
+	TrapDestination []SNMPTrapDestination `json:"trapDestination,omitempty"`
+	// Current settings for SNMP "system" variables
+	SystemVariables SNMPSystemVariables `json:"systemVariables,omitzero"`
+	// All configured SNMP USM users.
+	UsmUser []SNMPUsmUser `json:"usmUser,omitempty"`
+}
+
+// SNMPCommunity is the SNMPCommunity model.
+type SNMPCommunity struct {
+	// A reference to the SNMP community.
+	CommunityRef string `json:"communityRef,omitempty"`
+	// Community name
+	CommunityName string `json:"communityName,omitempty"`
+	// Community permissions Values: unknown, readOnly, readWrite.
+	CommunityPermissions string `json:"communityPermissions,omitempty"`
+}
+
+// SNMPPrincipalTypeData is the SNMPPrincipalTypeData model.
+type SNMPPrincipalTypeData struct {
+	// This enumeration defines the SNMP principal security model being used. Values: none, community, usmUser.
+	Principal string `json:"principal,omitempty"`
+	// A ref to the community being used.
+	CommunityRef string `json:"communityRef,omitempty"`
+	// A ref to the USM user being used.
+	UsmUserRef string `json:"usmUserRef,omitempty"`
+}
+
+// SNMPSystemVariables is the SNMPSystemVariables model.
+type SNMPSystemVariables struct {
+	// Value to be returned for system.sysName variable
+	SysName string `json:"sysName,omitempty"`
+	// Value to be returned for system.sysContact variable
+	SysContact string `json:"sysContact,omitempty"`
+	// Value to be returned for system.sysLocation variable
+	SysLocation string `json:"sysLocation,omitempty"`
+}
+
+// SNMPTrapDestination is the SNMPTrapDestination model.
+type SNMPTrapDestination struct {
+	// SNMP trap destination reference.
+	TrapDestinationRef string `json:"trapDestinationRef,omitempty"`
+	// IPVx address of the trap receiver
+	TrapReceiverIPAddress IPVxAddress `json:"trapReceiverIpAddress,omitzero"`
+	// This field has been deprecated and should not be used.
+	CommunityRef string `json:"communityRef,omitempty"`
+	// Send authentication failure traps to this destination.
+	SendAuthenticationFailureTraps bool `json:"sendAuthenticationFailureTraps,omitempty"`
+	// Union to specify principal associated with the trap destination.
+	Principal SNMPPrincipalTypeData `json:"principal,omitzero"`
+}
+
+// SNMPUsmUser is the SNMPUsmUser model.
+type SNMPUsmUser struct {
+	// A reference to the SNMP USM user.
+	UsmUserRef string `json:"usmUserRef,omitempty"`
+	// The USM User's engine ID type. Values: none, local, custom.
+	EngineIDType string `json:"engineIdType,omitempty"`
+	// The USM user's authoritative engine ID.
+	EngineID string `json:"engineId,omitempty"`
+	// The USM user's user name.
+	UserName string `json:"userName,omitempty"`
+	// The USM user's user permissions. Values: unknown, readOnly, readWrite.
+	UserPermissions string `json:"userPermissions,omitempty"`
+	// The USM user's security level. Values: noauthNopriv, authNopriv, authPriv.
+	SecurityLevel string `json:"securityLevel,omitempty"`
+	// The USM user's authentication protocol. Values: none, hmacSha, hmacSha256, hmacSha512.
+	AuthProtocol string `json:"authProtocol,omitempty"`
+	// The USM user's privacy protocol. Values: none, aes128.
+	PrivProtocol string `json:"privProtocol,omitempty"`
+}
+
+// SSDWearLife is the SSDWearLife model.
+type SSDWearLife struct {
+	// The percentage of average erase count.
+	AverageEraseCountPercent int `json:"averageEraseCountPercent,omitempty"`
+	// The percentage of spare blocks remaining in the solid state drive. Controller firmware checks and updates
+	// this percentage approximately once per hour.
+	SpareBlocksRemainingPercent int `json:"spareBlocksRemainingPercent,omitempty"`
+	// This field is set to true if wear life monitoring is supported on the drive. If true then the values in the
+	// other fields in this structure are valid, otherwise they are not valid.
+	IsWearLifeMonitoringSupported bool `json:"isWearLifeMonitoringSupported,omitempty"`
+	// The percentage of endurance used for this drive. The value is obtained from the Solid State Media log page. A
+	// value of PERCENTAGE_ENDURANCE_USED_UNKNOWN indicates that the information is inaccessible or not populated in
+	// this release.
+	PercentEnduranceUsed int `json:"percentEnduranceUsed,omitempty"`
+}
+
+// SSLCertConfiguration is the SSLCertConfiguration model.
+// Configuration information to setup certificates.
+type SSLCertConfiguration struct {
+	// The distinguished name for the certificate. This is used by the LDAP API to reference the LDAP object. An
+	// example value would be "uid=john.doe,ou=People,dc=example,dc=com". If this value is provided then any values
+	// provided for the rdns will be ignored.
+	Dn string `json:"dn,omitempty"`
+	// List of relative distinguished names that can be combined to create the distinguished name (dn). These values
+	// will be ignored if a domain name (dn) is provided.
+	Rdns []RelativeDistinguishedName `json:"rdns,omitempty"`
+	// If provided, this will attach a Subject Alternate Name extension to the certificate to allow the user to bind
+	// additional properties (email address, DNS name, etc) to the subject of the certificate.
+	SubjectAlternateNames []SubjectAlternateName `json:"subjectAlternateNames,omitempty"`
+	// Key size to generate Values: keySize2048, keySize3072, keySize4096.
+	KeySize string `json:"keySize,omitempty"`
+}
+
+// SamlAuthenticationRedirectResponse is the SamlAuthenticationRedirectResponse model.
+// Response that contains the redirect URL for authentication
+type SamlAuthenticationRedirectResponse struct {
+	// The URL with encoded SAML authentication request
+	RedirectURL string `json:"redirectUrl"`
+}
+
+// SamlConfiguration is the SamlConfiguration model.
+// The SAML configuration
+type SamlConfiguration struct {
+	// True if SAML enabled, false otherwise
+	SamlEnabled bool `json:"samlEnabled"`
+	// List of configured Identity Providers
+	IdentityProviders []SamlIdentityProvider `json:"identityProviders"`
+	// List of Service Providers
+	ServiceProviders []SamlServiceProvider `json:"serviceProviders"`
+}
+
+// SamlConfigurationChangeEvent is the SamlConfigurationChangeEvent model.
+type SamlConfigurationChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType       string `json:"eventType"`
+	StorageSystemID string `json:"storageSystemId"`
+}
+
+// SamlIdentityProvider is the SamlIdentityProvider model.
+// Identity Provider instance
+type SamlIdentityProvider struct {
+	// Identity Provider Id
+	ID string `json:"id"`
+	// Globally unique name for SAML entity
+	EntityID string `json:"entityId"`
+	// Single sign-on endpoint users will be redirected to for authentication
+	SingleSignOnEndpoint string `json:"singleSignOnEndpoint"`
+	// Single sign-on endpoint users will be redirected to for logout
+	SingleLogoutEndpoint string `json:"singleLogoutEndpoint"`
+	// List of named Id formats
+	NamedIDFormats []string `json:"namedIdFormats"`
+	// List of role mappings for this identity provider
+	Roles []SamlRole `json:"roles"`
+	// List of X.509 certificates used by the identity provider to sign messages
+	SigningCertificates []string `json:"signingCertificates"`
+}
+
+// SamlLoginRequest is the SamlLoginRequest model.
+// Payload for a SAML authentication request
+type SamlLoginRequest struct {
+	// The URL to return to after successful authentication
+	RedirectURL string `json:"redirectUrl"`
+}
+
+// SamlRole is the SamlRole model.
+// SAML role instance
+type SamlRole struct {
+	// Group attributes from the Identity Provider
+	Attribute string `json:"attribute"`
+	// Regex to match against
+	Match string `json:"match"`
+	// Local storage array roles
+	Roles []string `json:"roles"`
+}
+
+// SamlRolesRequest is the SamlRolesRequest model.
+// Payload to map roles to an identity provider
+type SamlRolesRequest struct {
+	// SAML role configuration
+	Roles []SamlRole `json:"roles"`
+}
+
+// SamlServiceProvider is the SamlServiceProvider model.
+// Service provider information
+type SamlServiceProvider struct {
+	// Service Provider domain name
+	DomainName string `json:"domainName"`
+	// Managing controller position
+	ControllerPosition int `json:"controllerPosition"`
+}
+
+// SamlTestResponse is the SamlTestResponse model.
+// Response for SAML test
+type SamlTestResponse struct {
+	// The status of the test Values: ok, failed, notRun, running, missingRequiredRoles.
+	Status string `json:"status"`
+	// Start time of the test
+	StartTime string `json:"startTime"`
+}
+
+// SasAttachedDevice is the SasAttachedDevice model.
+type SasAttachedDevice struct {
+	// The number of the channel where the SAS-attached device is located.
+	Channel int `json:"channel,omitempty"`
+	// An indication of which "side" the channel is on - host or drive. Values: hostside, driveside, management.
+	ChannelType string `json:"channelType,omitempty"`
+	// This field contains information that explicitly identifies the SAS-attached device.
+	SasAttachedDeviceData SasAttachedDeviceTypeData `json:"sasAttachedDeviceData,omitzero"`
+}
+
+// SasAttachedDeviceTypeData is the SasAttachedDeviceTypeData model.
+type SasAttachedDeviceTypeData struct {
+	// This enumeration specifies the different kinds of devices that may be attached to a SAS port. Values:
+	// unknown, drive, expander, localController, alternateController, host.
+	Type string `json:"type,omitempty"`
+	// A reference to the alternate controller that is the attached device. This field is only present if type
+	// equals SAS_ATTACHED_DEV_ALTERNATE_CONTROLLER.
+	AlternateController string `json:"alternateController,omitempty"`
+	// A reference to a drive that is the attached device. This field is only present if type equals
+	// SAS_ATTACHED_DEV_DRIVE.
+	Drive string `json:"drive,omitempty"`
+	// A reference to the SAS expander that is the attached device. This field is only present if type equals
+	// SAS_ATTACHED_DEV_EXPANDER.
+	Expander string `json:"expander,omitempty"`
+	// The SAS address of the port in the host computer that is the attached device. This field is only present if
+	// type equals SAS_ATTACHED_DEV_HOST.
+	RemoteHostPortAddress string `json:"remoteHostPortAddress,omitempty"`
+	// A reference to the local controller that is the attached device. This field is only present if type equals
+	// SAS_ATTACHED_DEV_LOCAL_CONTROLLER.
+	LocalController string `json:"localController,omitempty"`
+	// The tray-slot location of a device of unknown type. This field is only present if type equals
+	// SAS_ATTACHED_DEV_UNKNOWN.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+}
+
+// SasDriveAddress is the SasDriveAddress model.
+type SasDriveAddress struct {
+	// The SAS address representing the device itself.
+	DeviceName string `json:"deviceName,omitempty"`
+	// The SAS addresses of drive ports 0 and 1. The first element in the array is the drive address on the
+	// preferred channel, and the second element is the drive address on the redundant channel.
+	DrivePortAddresses []SasDrivePortAddress `json:"drivePortAddresses,omitempty"`
+}
+
+// SasDrivePortAddress is the SasDrivePortAddress model.
+type SasDrivePortAddress struct {
+	// The number of the channel where the drive port is located.
+	Channel int `json:"channel,omitempty"`
+	// The SAS address of the drive port.
+	PortIdentifier string `json:"portIdentifier,omitempty"`
+}
+
+// SasEsmAttributes is the SasEsmAttributes model.
+type SasEsmAttributes struct {
+	// This field is a collection of references to objects representing the SAS expanders that physically reside on
+	// the ESM.
+	SasExpander []string `json:"sasExpander,omitempty"`
+}
+
+// SasExpander is the SasExpander model.
+type SasExpander struct {
+	// The reference value that uniquely identifies the SAS expander.
+	ExpanderRef string `json:"expanderRef,omitempty"`
+	// The reference value that uniquely identifies the parent (controller or ESM) of the SAS expander.
+	Parent SasExpanderParent `json:"parent,omitzero"`
+	// The drive channel location of the expander
+	Channel int `json:"channel,omitempty"`
+	// The SAS address that is the expander device name
+	DeviceName string `json:"deviceName,omitempty"`
+	// The Inquiry vendor identification string for the SAS expander.
+	VendorID string `json:"vendorId,omitempty"`
+	// The Inquiry product identification string for the SAS expander.
+	ProductID string `json:"productId,omitempty"`
+	// The version of the firmware on the SAS expander.
+	FwVersion string `json:"fwVersion,omitempty"`
+	// List of ports that are part of this expander.
+	ExpanderPorts []SasPort `json:"expanderPorts,omitempty"`
+	// The domain number identifies which SAS domain this element is a part of. Domain numbers are arbitrarily
+	// assigned by the firmware and are consistent on a per controller basis -- but not across controllers. So, the
+	// contents of domains will be consistent across controllers but the number identifying a particular domain may
+	// not be.
+	DomainNumber int    `json:"domainNumber,omitempty"`
+	ID           string `json:"id,omitempty"`
+}
+
+// SasExpanderParent is the SasExpanderParent model.
+type SasExpanderParent struct {
+	// This enumeration specifies the different types of parent elements a SAS expander may have. Values: unknown,
+	// controller, esm, drawer.
+	ExpanderParentType string `json:"expanderParentType,omitempty"`
+	// A reference to the parent controller of the SAS expander. This field is present only if the
+	// expanderParentType value is SAS_EXPANDER_PARENT_CONTROLLER.
+	ParentController string `json:"parentController,omitempty"`
+	// A reference to the parent ESM of the SAS expander. This field is present only if the expanderParentType value
+	// is SAS_EXPANDER_PARENT_ESM.
+	ParentEsm string `json:"parentEsm,omitempty"`
+	// A reference to the parent drawer of the SAS expander. This field is present only if the expanderParentType
+	// value is SAS_EXPANDER_PARENT_DRAWER.
+	ParentDrawer string `json:"parentDrawer,omitempty"`
+}
+
+// SasInterface is the SasInterface model.
+type SasInterface struct {
+	// The number of the channel corresponding to this interface.
+	Channel int `json:"channel,omitempty"`
+	// The current interface speed of the SAS interface. Values: speedUnknown, speed1gig, speed2gig, speed4gig,
+	// speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig,
+	// speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig,
+	// speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	CurrentInterfaceSpeed string `json:"currentInterfaceSpeed,omitempty"`
+	// The maximum interface speed of the SAS interface. Values: speedUnknown, speed1gig, speed2gig, speed4gig,
+	// speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig,
+	// speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig,
+	// speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	MaximumInterfaceSpeed string `json:"maximumInterfaceSpeed,omitempty"`
+	// A string indicating the chip type.
+	Part string `json:"part,omitempty"`
+	// The revision level of the firmware on the SAS I/O controller.
+	Revision int `json:"revision,omitempty"`
+	// True if the channel corresponding to this interface is degraded.
+	IsDegraded bool `json:"isDegraded,omitempty"`
+	// Information about the SAS I/O controller port corresponding to this interface.
+	IocPort SasPort `json:"iocPort,omitzero"`
+	// This field has been deprecated and should not be used.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// The physical location of the SAS interface. The parent reference in Location identifies the physical
+	// component (e.g., controller or host card) where the interface circuitry is located, and the position field is
+	// a firmware-assigned 1-relative number signifying "1st SAS interface relative to the parent," "2nd SAS
+	// interface relative to the parent," etc. This "interface number" is independent of the interface's channel
+	// association.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// This field indicates whether or not the I/O interface is PI capable.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// Maximum one way data rate in B/s
+	OneWayMaxRate string `json:"oneWayMaxRate,omitempty"`
+	// Maximum bi-directional data rate in B/s
+	BidirectionalMaxRate string `json:"bidirectionalMaxRate,omitempty"`
+	ID                   string `json:"id,omitempty"`
+}
+
+// SasInterfacePort is the SasInterfacePort model.
+type SasInterfacePort struct {
+	// The number of the channel corresponding to this interface.
+	Channel int `json:"channel,omitempty"`
+	// The current interface speed of the SAS interface. Values: speedUnknown, speed1gig, speed2gig, speed4gig,
+	// speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig,
+	// speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig,
+	// speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	CurrentInterfaceSpeed string `json:"currentInterfaceSpeed,omitempty"`
+	// The maximum interface speed of the SAS interface. Values: speedUnknown, speed1gig, speed2gig, speed4gig,
+	// speed10gig, speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig,
+	// speed60gig, speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig,
+	// speed100gig, speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	MaximumInterfaceSpeed string `json:"maximumInterfaceSpeed,omitempty"`
+	// A string indicating the chip type.
+	Part string `json:"part,omitempty"`
+	// The revision level of the firmware on the SAS I/O controller.
+	Revision int `json:"revision,omitempty"`
+	// True if the channel corresponding to this interface is degraded.
+	IsDegraded bool `json:"isDegraded,omitempty"`
+	// Information about the SAS I/O controller port corresponding to this interface.
+	IocPort SasPort `json:"iocPort,omitzero"`
+	// This field has been deprecated and should not be used.
+	InterfaceRef string `json:"interfaceRef,omitempty"`
+	// The physical location of the SAS interface. The parent reference in Location identifies the physical
+	// component (e.g., controller or host card) where the interface circuitry is located, and the position field is
+	// a firmware-assigned 1-relative number signifying "1st SAS interface relative to the parent," "2nd SAS
+	// interface relative to the parent," etc. This "interface number" is independent of the interface's channel
+	// association.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// This field indicates whether or not the I/O interface is PI capable.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// Maximum one way data rate in B/s
+	OneWayMaxRate string `json:"oneWayMaxRate,omitempty"`
+	// Maximum bi-directional data rate in B/s
+	BidirectionalMaxRate string `json:"bidirectionalMaxRate,omitempty"`
+	ControllerID         string `json:"controllerId,omitempty"`
+	InterfaceID          string `json:"interfaceId,omitempty"`
+	NiceAddressID        string `json:"niceAddressId,omitempty"`
+	AddressID            string `json:"addressId,omitempty"`
+	BasePortAddress      string `json:"basePortAddress,omitempty"`
+	ID                   string `json:"id,omitempty"`
+}
+
+// SasPhy is the SasPhy model.
+type SasPhy struct {
+	// A number in the range 0-127 that is the PHY identifier.
+	PhyIdentifier int `json:"phyIdentifier,omitempty"`
+	// True if the PHY is capable of normal operation.
+	IsOperational bool `json:"isOperational,omitempty"`
+}
+
+// SasPort is the SasPort model.
+type SasPort struct {
+	// The device on which the port resides.
+	Parent SasPortProviderDevice `json:"parent,omitzero"`
+	// An indication of what device is attached to this port.
+	AttachedDevice SasAttachedDevice `json:"attachedDevice,omitzero"`
+	// The state of the SAS port. Values: unknown, optimal, degraded, failed.
+	State string `json:"state,omitempty"`
+	// The type of miswire involving this port (or "none"). Values: none, topology, ctlrHostPort,
+	// ctlrDrivePortToHost, esm, ctlrDrivePortToEndDevice, topologyDirect.
+	MiswireType string `json:"miswireType,omitempty"`
+	// This field has been deprecated and should not be used.
+	ChannelPortRef string `json:"channelPortRef,omitempty"`
+	// A list of PHYs associated with this port.
+	SasPhys []SasPhy `json:"sasPhys,omitempty"`
+	// Information about a SAS port that is type-dependent.
+	PortTypeData SasPortTypeData `json:"portTypeData,omitzero"`
+	// The SAS port connection mode. Values: unknown, internal, externalIn, externalOut, endDevice, open.
+	PortMode string `json:"portMode,omitempty"`
+	// The domain number identifies which SAS domain this element is a part of. Domain numbers are arbitrarily
+	// assigned by the firmware and are consistent on a per controller basis -- but not across controllers. So, the
+	// contents of domains will be consistent across controllers but the number identifying a particular domain may
+	// not be.
+	DomainNumber int `json:"domainNumber,omitempty"`
+	// This field has been deprecated and should not be used.
+	AttachedChannelPortRef string `json:"attachedChannelPortRef,omitempty"`
+	// This element contains the status of the SAS discovery - the status is a bit field and could indicate a
+	// successful discovery or could indicate one or more failure conditions (indicated by one or more error flags
+	// being set).
+	DiscoveryStatus int `json:"discoveryStatus,omitempty"`
+	// This value indicates the non optimal cabling type associated with this port object. Values: none,
+	// reducedExpPorts, channelReorder.
+	NonOptimalCablingType string `json:"nonOptimalCablingType,omitempty"`
+	// A list of channel port refs for external connections associated with this SasPort Object.
+	ChannelPortRefs []string `json:"channelPortRefs,omitempty"`
+	// A list of attached channel port refs associated with this SasPort object where index <x> is associated with
+	// index <x> of channelPortRefs in this same SasPort object.
+	AttachedChannelPortRefs []string `json:"attachedChannelPortRefs,omitempty"`
+}
+
+// SasPortProviderDevice is the SasPortProviderDevice model.
+type SasPortProviderDevice struct {
+	// This enumeration object is used to identify the specific type of "port provider" device. Values: controller,
+	// drive, expander, hostboard.
+	Type string `json:"type,omitempty"`
+	// A reference to a controller that is the port provider. This field is present only if the type field is equal
+	// to SAS_PORT_PROVIDER_CONTROLLER.
+	Controller string `json:"controller,omitempty"`
+	// A reference to a drive that is the port provider. This field is present only if the type field is equal to
+	// SAS_PORT_PROVIDER_DRIVE.
+	Drive string `json:"drive,omitempty"`
+	// A reference to a SAS expander that is the port provider. This field is present only if the type field is
+	// equal to SAS_PORT_PROVIDER_EXPANDER.
+	Expander string `json:"expander,omitempty"`
+	// A reference to a SAS host board that is the port provider. This field is present only if the type field is
+	// equal to SAS_PORT_PROVIDER_HOSTBOARD.
+	HostBoardRef string `json:"hostBoardRef,omitempty"`
+}
+
+// SasPortTypeData is the SasPortTypeData model.
+type SasPortTypeData struct {
+	// This enumeration specifies the different types of SAS ports. Values: endDevice, expander.
+	PortType string `json:"portType,omitempty"`
+	// This field is the SAS address that is the port identifier. It is present only if the portType value is equal
+	// to SAS_PORT_TYPE_END_DEVICE.
+	PortIdentifier string `json:"portIdentifier,omitempty"`
+	// This field indicates the routing method used on this port. It is present only if the portType value is equal
+	// to SAS_PORT_TYPE_EXPANDER. Values: direct, table, subtractive.
+	RoutingType string `json:"routingType,omitempty"`
+}
+
+// SataDriveAttributes is the SataDriveAttributes model.
+type SataDriveAttributes struct {
+	// This field provides information about the SCSI-to-ATA translator hardware.
+	TranslatorData ScsiToAtaTranslatorData `json:"translatorData,omitzero"`
+	// This field is the disk model number as reported in the ATA protocol.
+	AtaDiskModelNumber string `json:"ataDiskModelNumber,omitempty"`
+	// This field is the disk firmware revision number as reported in the ATA protocol.
+	AtaDiskFwRevision string `json:"ataDiskFwRevision,omitempty"`
+}
+
+// SaveConfigSpec is the SaveConfigSpec model.
+type SaveConfigSpec struct {
+	// Set true to save general storage array settings.
+	StorageArraySettings bool `json:"storageArraySettings,omitempty"`
+	// Set true to save storage array volume configuration.
+	VolumeConfiguration bool `json:"volumeConfiguration,omitempty"`
+	// Set true to save host and host port configuration.
+	HostConfiguration bool `json:"hostConfiguration,omitempty"`
+	// Set true to save volume mappings.
+	VolumeMappings bool `json:"volumeMappings,omitempty"`
+}
+
+// Schedule is the Schedule model.
+type Schedule struct {
+	// Daily, weekly, or monthly calendar information.
+	Calendar ScheduleCalendar `json:"calendar,omitzero"`
+	// The schedule will not run until this date/time, measured as seconds since midnight, January 1st, 1970 local
+	// time.
+	StartDate string `json:"startDate,omitempty"`
+	// The number of days, weeks, or months to run.
+	Recurrence Recurrence `json:"recurrence,omitzero"`
+	// Specification of the time zone.
+	Timezone TimeZoneDescription `json:"timezone,omitzero"`
+}
+
+// ScheduleCalendar is the ScheduleCalendar model.
+type ScheduleCalendar struct {
+	// This enumeration specifies the different types of scheduling methods. Values: unknown, daily, weekly,
+	// monthlyDate, monthlyDay.
+	ScheduleMethod string `json:"scheduleMethod,omitempty"`
+	// This field is present only if scheduleMethod is equal to SCHEDULE_METHOD_DAILY.
+	Daily ScheduleDaily `json:"daily,omitzero"`
+	// This field is present only if scheduleMethod is equal to SCHEDULE_METHOD_WEEKLY.
+	Weekly ScheduleWeekly `json:"weekly,omitzero"`
+	// This field is present only if scheduleMethod is equal to SCHEDULE_METHOD_MONTHLY_DATE.
+	MonthlyByDate ScheduleMonthlyByDate `json:"monthlyByDate,omitzero"`
+	// This field is present only if scheduleMethod is equal to SCHEDULE_METHOD_MONTHLY_DAY.
+	MonthlyByDay ScheduleMonthlyByDay `json:"monthlyByDay,omitzero"`
+}
+
+// ScheduleCreateRequest is the ScheduleCreateRequest model.
+// Creates a Schedule for a Snapshot (PiT) or Legacy Snapshot.
+type ScheduleCreateRequest struct {
+	// The schedule action type. Values: unknown, resnap, newpit, newcgpit.
+	Action string `json:"action"`
+	// The SYMbol reference of the target object.
+	TargetObject string `json:"targetObject,omitempty"`
+	// The schedule calendar type. Values: unknown, daily, weekly, monthlyDate, monthlyDay.
+	ScheduleMethod string `json:"scheduleMethod"`
+	// The daily schedule times for all schedule methods.
+	DailySchedule DailySchedule `json:"dailySchedule"`
+	// The days of the week for the weekly schedule method.
+	DaysOfWeek []string `json:"daysOfWeek,omitempty"`
+	// The months of the year for the monthly by date schedule method.
+	MonthsOfYear []string `json:"monthsOfYear,omitempty"`
+	// The days of the month for the monthly by date schedule method.
+	DaysOfMonth []string `json:"daysOfMonth,omitempty"`
+	// The start date for the schedule.
+	StartDate string `json:"startDate"`
+	// The end date for the schedule, 0 for no end date.
+	EndDate string `json:"endDate"`
+	// The schedule time zone information.
+	Timezone TimeZoneDescription `json:"timezone"`
+}
+
+// ScheduleDaily is the ScheduleDaily model.
+type ScheduleDaily struct {
+	// Conveys time information, including time of day, recurrence and occurrence frequency.
+	DailySchedule DailySchedule `json:"dailySchedule,omitzero"`
+}
+
+// ScheduleInstance is the ScheduleInstance model.
+type ScheduleInstance struct {
+	// A reference to the schedule object.
+	SchedRef string `json:"schedRef,omitempty"`
+	// The current status of the schedule. Values: unknown, active, disabled, completed.
+	ScheduleStatus string `json:"scheduleStatus,omitempty"`
+	// The type of action to be taken. Values: unknown, resnap, newpit, newcgpit.
+	Action string `json:"action,omitempty"`
+	// The object associated with schedule.
+	TargetObject string `json:"targetObject,omitempty"`
+	// The schedule information.
+	Schedule Schedule `json:"schedule,omitzero"`
+	// The schedule creation time (local time) measured as seconds since January 1, 1970.
+	CreationTime string `json:"creationTime,omitempty"`
+	// The last run time (local time) measured as seconds since January 1, 1970.
+	LastRunTime string `json:"lastRunTime,omitempty"`
+	// The next run time (local time) measured as seconds since January 1, 1970.
+	NextRunTime string `json:"nextRunTime,omitempty"`
+	// The local time after which schedule is no longer active, measured as seconds since January 1, 1970.
+	StopTime string `json:"stopTime,omitempty"`
+	ID       string `json:"id,omitempty"`
+}
+
+// ScheduleMonthlyByDate is the ScheduleMonthlyByDate model.
+type ScheduleMonthlyByDate struct {
+	// This is a bit mask. Values from the DayOfMonth enumeration should be added (or OR'ed) together to set this
+	// value.
+	DaysOfMonth []string `json:"daysOfMonth,omitempty"`
+	// This is a bit mask. Values from the MonthOfYear enumeration should be added (or OR'ed) together to set this
+	// value.
+	MonthsOfYear []string `json:"monthsOfYear,omitempty"`
+	// Conveys time information, including time of day, recurrence and occurrence frequency.
+	DailySchedule DailySchedule `json:"dailySchedule,omitzero"`
+}
+
+// ScheduleMonthlyByDay is the ScheduleMonthlyByDay model.
+type ScheduleMonthlyByDay struct {
+	// The day of the week. This is NOT a bit mask. Values: notSpecified, sunday, monday, tuesday, wednesday,
+	// thursday, friday, saturday.
+	DayOfWeek string `json:"dayOfWeek,omitempty"`
+	// Conveys time information, including time of day, recurrence and occurrence frequency.
+	DailySchedule DailySchedule `json:"dailySchedule,omitzero"`
+	// The week number during the month. For example, 1 = first, 2 = second, etc., and 5 = last.
+	WeekNumber int `json:"weekNumber,omitempty"`
+	// This is a bit mask. Values from the MonthOfYear enumeration should be added (or OR'ed) together to set this
+	// value.
+	MonthsOfYear []string `json:"monthsOfYear,omitempty"`
+}
+
+// ScheduleWeekly is the ScheduleWeekly model.
+type ScheduleWeekly struct {
+	// This is a bit mask. Values from the DayOfWeek enumeration should be added (or OR'ed) together to set this
+	// value.
+	DaysOfWeek []string `json:"daysOfWeek,omitempty"`
+	// Conveys time information, including time of day, recurrence and occurrence frequency.
+	DailySchedule DailySchedule `json:"dailySchedule,omitzero"`
+}
+
+// ScsiAlias is the ScsiAlias model.
+type ScsiAlias struct {
+	// This enumeration defines the different I/O interface types that may be reported as part of the configuration
+	// information associated with a controller. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib, fcoe,
+	// nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	IoInterfaceType string `json:"ioInterfaceType,omitempty"`
+	// The SCSI alias name as defined under iSCSI. This field is only valid if the I/O interface type is equal to
+	// IO_IF_ISCSI.
+	IscsiAlias string `json:"iscsiAlias,omitempty"`
+}
+
+// ScsiInitiatorTargetBaseProperties is the ScsiInitiatorTargetBaseProperties model.
+type ScsiInitiatorTargetBaseProperties struct {
+	// This enumeration defines the different I/O interface types that may be reported as part of the configuration
+	// information associated with a controller. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib, fcoe,
+	// nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	IoInterfaceType                    string                             `json:"ioInterfaceType,omitempty"`
+	IscsiinitiatorTargetBaseParameters IscsiInitiatorTargetBaseParameters `json:"iscsiinitiatorTargetBaseParameters,omitzero"`
+}
+
+// ScsiNodeName is the ScsiNodeName model.
+type ScsiNodeName struct {
+	// This enumeration defines the different I/O interface types that may be reported as part of the configuration
+	// information associated with a controller. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib, fcoe,
+	// nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	IoInterfaceType string `json:"ioInterfaceType,omitempty"`
+	// The SCSI node name as defined under iSCSI. This field is only valid if the I/O interface type is equal to
+	// IO_IF_ISCSI.
+	IscsiNodeName string `json:"iscsiNodeName,omitempty"`
+	// The FC WWN for the remote node. This field is only valid if the I/O interface type is equal to IO_IF_FC.
+	RemoteNodeWWN string `json:"remoteNodeWWN,omitempty"`
+	// Host NVMe Qualified Name (NQN)
+	NvmeNodeName string `json:"nvmeNodeName,omitempty"`
+}
+
+// ScsiRemoteTargetConnections is the ScsiRemoteTargetConnections model.
+type ScsiRemoteTargetConnections struct {
+	// This enumeration defines the different I/O interface types that may be reported as part of the configuration
+	// information associated with a controller. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib, fcoe,
+	// nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	IoInterfaceType string `json:"ioInterfaceType,omitempty"`
+	// This field is returned if the value of ioInterfaceType is equal to IO_IF_ISCSI.
+	IscsiConnectionDetail IscsiRemoteTargetConnections `json:"iscsiConnectionDetail,omitzero"`
+	// This field is returned if the value of ioInterfaceType is equal to IO_IF_FC.
+	FibreConnectionDetail FibreRemoteTargetConnections `json:"fibreConnectionDetail,omitzero"`
+}
+
+// ScsiToAtaTranslatorData is the ScsiToAtaTranslatorData model.
+type ScsiToAtaTranslatorData struct {
+	// The vendor identification string.
+	VendorID string `json:"vendorId,omitempty"`
+	// The product identification string.
+	ProductID string `json:"productId,omitempty"`
+	// The product revision level string. When no interposer card is available, this string is set to "----" (four
+	// dashes).
+	ProductRevLevel string `json:"productRevLevel,omitempty"`
+	// This field identifies the type of the SCSI-to-ATA translation hardware. Note that if this field is set to
+	// SAT_TYPE_UNKNOWN, the string in the productId field will have zero length, and the string in the
+	// productRevLevel field will be set to "----" (four dashes). Values: unknown, driveCruResident,
+	// controllerResident, enclosureSlotResident.
+	SatType string `json:"satType,omitempty"`
+}
+
+// SecureVolumeExternalKeyResponse is the SecureVolumeExternalKeyResponse model.
+type SecureVolumeExternalKeyResponse struct {
+	// The size of the file
+	FileSize string `json:"fileSize"`
+	// The GUID for the file
+	FileID string `json:"fileID"`
+	// The URL for the file
+	FileURL string `json:"fileURL"`
+	// The id of the lock key.
+	LockKeyID string `json:"lockKeyId"`
+	// The id of the old lock key if a delete operation on the KMS server was attempted but failed.
+	OldLockKeyID string `json:"oldLockKeyId"`
+}
+
+// SecureVolumeKeyRequest is the SecureVolumeKeyRequest model.
+// Creates a secure volume (FDE) key.
+type SecureVolumeKeyRequest struct {
+	// An id used to identify the generated lock key.
+	LockKeyID string `json:"lockKeyId,omitempty"`
+	// Passphrase for the key.
+	PassPhrase string `json:"passPhrase"`
+	// Apply the generated lock key.
+	SetLockKey *bool `json:"setLockKey,omitempty"`
+}
+
+// SecureVolumeKeyResponse is the SecureVolumeKeyResponse model.
+// File name and length
+type SecureVolumeKeyResponse struct {
+	// The id of the lock key.
+	LockKeyID string `json:"lockKeyId"`
+	FileSize  string `json:"fileSize"`
+	FileName  string `json:"fileName"`
+}
+
+// SecurityKeyFileRequest is the SecurityKeyFileRequest model.
+// This class describes the input for a obtaining a security key file
+type SecurityKeyFileRequest struct {
+	// The name that the exported file should be given
+	FileName string `json:"fileName"`
+	// The passphrase for the security key
+	PassPhrase string `json:"passPhrase"`
+}
+
+// SecuritySettings is the SecuritySettings model.
+type SecuritySettings struct {
+	// The certificate revocation settings.
+	CertificateRevocationSettings CertificateRevocationSettings `json:"certificateRevocationSettings,omitzero"`
+}
+
+// Serializable is the Serializable model.
+type Serializable struct {
+}
+
+// ServerSettings is the ServerSettings model.
+// Server settings.
+type ServerSettings struct {
+	// Customized HTTP response headers. HTTP response headers can be customized on specific base URL paths and will
+	// override the default HTTP response header if it is already present.
+	HTTPResponseHeaders []HTTPResponseHeader `json:"httpResponseHeaders,omitempty"`
+	// Allow relative redirect for responses with HTTP status 302. True to allow only the path for the LOCATION
+	// response header, false to require the LOCATION header to be the full URL.
+	RelativeRedirectAllowed bool `json:"relativeRedirectAllowed,omitempty"`
+	// Disable TLSv1.3 protocol and ciphers.
+	Tls13Disabled bool `json:"tls13Disabled,omitempty"`
+	// Rate limit settings.
+	RateLimit RateLimitSettings `json:"rateLimit,omitzero"`
+	// List of allowed management client addresses. Used to restrict HTTPS and SYMbol clients to the ranges
+	// provided. An empty list means allow all addresses. WARNING: Incorrectly changing this setting may cause loss
+	// of HTTPS and SYMbol access that may only be corrected through the serial port or remote shell (disabled by
+	// default.)
+	AllowedManagementClients []IPRange `json:"allowedManagementClients,omitempty"`
+}
+
+// SessionSettings is the SessionSettings model.
+// Server session settings.
+type SessionSettings struct {
+	// Session inactivity period, in seconds, before the session times out. A value of zero indicates the session
+	// should never timeout.
+	SessionInactivePeriod int `json:"sessionInactivePeriod"`
+}
+
+// SessionSettingsResponse is the SessionSettingsResponse model.
+// Server session settings response.
+type SessionSettingsResponse struct {
+	// Minimum session inactivity period, in seconds.
+	MinimumSessionInactivePeriod int `json:"minimumSessionInactivePeriod"`
+	// Session inactivity period, in seconds, before the session times out. A value of zero indicates the session
+	// should never timeout.
+	SessionInactivePeriod int `json:"sessionInactivePeriod"`
+}
+
+// SessionTimeoutChangeEvent is the SessionTimeoutChangeEvent model.
+type SessionTimeoutChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType       string `json:"eventType"`
+	StorageSystemID string `json:"storageSystemId"`
+}
+
+// SettingControl is the SettingControl model.
+type SettingControl struct {
+	// True when the property is enabled.
+	IsEnabled bool `json:"isEnabled,omitempty"`
+	// The value of the property
+	Value int `json:"value,omitempty"`
+}
+
+// Settings is the Settings model.
+// Settings that apply to the entire Web Services instance.
+type Settings struct {
+	ServerSettings ServerSettings `json:"serverSettings,omitzero"`
+}
+
+// Sfp is the Sfp model.
+type Sfp struct {
+	// The reference for this physical SFP.
+	SfpRef string `json:"sfpRef,omitempty"`
+	// The operational status of the SFP. Values: optimal, failed, removed, unknown.
+	Status string `json:"status,omitempty"`
+	// The physical location of the SFP.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// The type of the SFP.
+	SfpType SFPType `json:"sfpType,omitzero"`
+	// This field has been deprecated and should not be used. Values: portUnknown, port1, port2, port3, port4.
+	SfpPort string `json:"sfpPort,omitempty"`
+	// This field has been deprecated and should not be used.
+	ParentData SFPParentTypeData `json:"parentData,omitzero"`
+	Reserved1  string            `json:"reserved1,omitempty"`
+	Reserved2  string            `json:"reserved2,omitempty"`
+	// This field has been deprecated and should not be used.
+	SfpPortRef string `json:"sfpPortRef,omitempty"`
+	// The repair policy for the SFP component.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+	ID           string       `json:"id,omitempty"`
+}
+
+// SingleNumberValue is the SingleNumberValue model.
+// Object to represent a single number (int).
+type SingleNumberValue struct {
+	// A numeric value
+	Value int `json:"value"`
+}
+
+// SmartBatteryData is the SmartBatteryData model.
+type SmartBatteryData struct {
+	// The time of the last completed learn cycle, expressed in seconds since midnight GMT, January 1, 1970.
+	LastBatteryLearnCycle string `json:"lastBatteryLearnCycle,omitempty"`
+	// The time of the next scheduled learn cycle, expressed in seconds since midnight GMT, January 1, 1970.
+	NextBatteryLearnCycle string `json:"nextBatteryLearnCycle,omitempty"`
+	// The time between automatic learn cycles, expressed in weeks.
+	BatteryLearnCycleInterval int `json:"batteryLearnCycleInterval,omitempty"`
+}
+
+// Snapshot is the Snapshot model.
+type Snapshot struct {
+	// The reference (key) for this PiT.
+	PitRef string `json:"pitRef,omitempty"`
+	// A reference to the associated PiT Group.
+	PitGroupRef string `json:"pitGroupRef,omitempty"`
+	// The PiT creation method. Values: unknown, user, schedule.
+	CreationMethod string `json:"creationMethod,omitempty"`
+	// The controller-time (measured in seconds since January 1, 1970) when the PiT was created.
+	PitTimestamp string `json:"pitTimestamp,omitempty"`
+	// The sequence number within PITGroup. This is a monotonically increasing sequence value that can be used to
+	// determine the absolute order of the PiTs within a PiT group. Using this sequence number avoids issues with
+	// timestamps when real-time clocks are changed. Note that this sequence number will be the same on all PiTs
+	// taken in multiple PITGroups at the same time under a single IO suspension, either as part of a consistency
+	// group operation (createCGPIT) or when a list of PITGroups is passed to createPIT.
+	PitSequenceNumber string `json:"pitSequenceNumber,omitempty"`
+	// The state of this PiT. Values: unknown, optimal, failed, purged.
+	Status string `json:"status,omitempty"`
+	// If true, the PiT is actively recording copy-on-write data.
+	ActiveCOW bool `json:"activeCOW,omitempty"`
+	// If true, the PiT is a rollback source.
+	IsRollbackSource bool `json:"isRollbackSource,omitempty"`
+	// The capacity in bytes of this PiT (capacity of the base at the time the PiT was created).
+	PitCapacity string `json:"pitCapacity,omitempty"`
+	// Repository utilization in bytes (only reported if activeCOW = false).
+	RepositoryCapacityUtilization string `json:"repositoryCapacityUtilization,omitempty"`
+	BaseVol                       string `json:"baseVol,omitempty"`
+	ConsistencyGroupID            string `json:"consistencyGroupId,omitempty"`
+	ID                            string `json:"id,omitempty"`
+}
+
+// SnapshotCreateRequest is the SnapshotCreateRequest model.
+// Creates a Snapshot (PiT) within the specified Snapshot Group (PiT Group).
+type SnapshotCreateRequest struct {
+	// The identifier of the snapshot group for the new snapshot image.
+	GroupID string `json:"groupId"`
+}
+
+// SnapshotGroup is the SnapshotGroup model.
+type SnapshotGroup struct {
+	// The reference (key) for the PiT Group.
+	PitGroupRef string `json:"pitGroupRef,omitempty"`
+	// The name of the PiT Group. If this PiT group is part of a consistency group, then this label does not have to
+	// be present or unique as the parent consistency group label will be used.
+	Label string `json:"label,omitempty"`
+	// The status of the PiT Group. Values: unknown, optimal, full, failed, overThreshold.
+	Status string `json:"status,omitempty"`
+	// The base volume for this PiT Group.
+	BaseVolume string `json:"baseVolume,omitempty"`
+	// The copy-on-write (CoW) Repository for this PiT group.
+	RepositoryVolume string `json:"repositoryVolume,omitempty"`
+	// The cluster size (in bytes).
+	ClusterSize int `json:"clusterSize,omitempty"`
+	// The maximum allowable repository size (in bytes) based on current cluster size. Only applicable if there is
+	// at least one PiT in the PiT group.
+	MaxRepositoryCapacity string `json:"maxRepositoryCapacity,omitempty"`
+	// The maximum allowable base volume size (in bytes) based on current cluster size. Only applicable if there is
+	// at least one PiT in the PiT group.
+	MaxBaseCapacity string `json:"maxBaseCapacity,omitempty"`
+	// The amount of repository capacity that is unusable due to limited addressability of the current cluster size.
+	UnusableRepositoryCapacity string `json:"unusableRepositoryCapacity,omitempty"`
+	// The behavior on repository full condition. Overridden by consistency group setting if this PITGroup is
+	// associated with a consistency group. Values: unknown, failbasewrites, purgepit.
+	RepFullPolicy string `json:"repFullPolicy,omitempty"`
+	// The repository utilization warning threshold percentage.
+	FullWarnThreshold int `json:"fullWarnThreshold,omitempty"`
+	// The auto-delete indicator. If non-zero, the oldest PiT will be automatically deleted when creating a new one
+	// to keep the total number of PiTs limited to the number specified. This value is overridden by the consistency
+	// group setting if this PITGroup is associated with a consistency group.
+	AutoDeleteLimit int `json:"autoDeleteLimit,omitempty"`
+	// Long running operation. Values: none, copyback, initializing, reconstructing, remappingDce, remappingDrm,
+	// remappingDcedrm, remappingDseg, remappingDve, remappingDcedve, remappingInternal, remappingDefrag,
+	// formatting, synchronizing, parityScan, volumeCopy, snapshotRollback, pitRollback,
+	// asyncMirrorGroupInitialSync, reconstructingCritical, rebalancing, copyThenFail, copyThenFailPending,
+	// copyThenReplace, copyThenReplaceAndFail, thinDefrag, creating, deleting, offlineFormatting, driveSpecific.
+	Action string `json:"action,omitempty"`
+	// The status of the rollback (if rollback is indicated in VolumeAction above). Values: unknown, none,
+	// inProgress, pending, paused.
+	RollbackStatus string `json:"rollbackStatus,omitempty"`
+	// The importance of the rollback operation. Values: highest, high, medium, low, lowest.
+	RollbackPriority string `json:"rollbackPriority,omitempty"`
+	// If true, this PiT group is part of a consistency group.
+	ConsistencyGroup bool `json:"consistencyGroup,omitempty"`
+	// A reference to the parent consistency group, if applicable. This value will be NULL if this PiT group is not
+	// part of a consistency group.
+	ConsistencyGroupRef string `json:"consistencyGroupRef,omitempty"`
+	// The status of Pending PiT Creation. Values: unknown, none, waiting, failed.
+	CreationPendingStatus string `json:"creationPendingStatus,omitempty"`
+	// The PiT group SSID. This is provided primarily for debug purposes.
+	VolumeHandle  int    `json:"volumeHandle,omitempty"`
+	VolcopyID     string `json:"volcopyId,omitempty"`
+	SnapshotCount int    `json:"snapshotCount,omitempty"`
+	// The aggregate capacity of the repository in bytes
+	RepositoryCapacity string `json:"repositoryCapacity,omitempty"`
+	ID                 string `json:"id,omitempty"`
+	Name               string `json:"name,omitempty"`
+}
+
+// SnapshotGroupCreateRequest is the SnapshotGroupCreateRequest model.
+// Creates a Snapshot Group (PiT Group).
+type SnapshotGroupCreateRequest struct {
+	// The identifier of the volume or thin volume to use as the base for the new snapshot group.
+	BaseMappableObjectID string `json:"baseMappableObjectId"`
+	// The name of the new snapshot group.
+	Name string `json:"name"`
+	// The size of the repository in relation to the size of the base volume.
+	RepositoryPercentage *float64 `json:"repositoryPercentage,omitempty"`
+	// The repository utilization warning threshold, as a percentage of the repository volume capacity.
+	WarningThreshold *int `json:"warningThreshold,omitempty"`
+	// The automatic deletion indicator. If non-zero, the oldest snapshot image will be automatically deleted when
+	// creating a new snapshot image to keep the total number of snapshot images limited to the number specified.
+	// This value is overridden by the consistency group setting if this snapshot group is associated with a
+	// consistency group.
+	AutoDeleteLimit *int `json:"autoDeleteLimit,omitempty"`
+	// The behavior on when the data repository becomes full. This value is overridden by consistency group setting
+	// if this snapshot group is associated with a consistency group. Values: unknown, failbasewrites, purgepit.
+	FullPolicy string `json:"fullPolicy,omitempty"`
+	// The identifier of the storage pool on which to allocate the repository volume. Ignored if a
+	// repositoryCandidate is provided.
+	StoragePoolID string `json:"storagePoolId,omitempty"`
+	// Allows a repository candidate to be manually specified for use in the creation. By default, the best
+	// candidate will be selected.
+	RepositoryCandidate ConcatVolumeCandidate `json:"repositoryCandidate,omitzero"`
+	// An optional schedule to be used to automatically create snapshot images.
+	Schedule ScheduleCreateRequest `json:"schedule,omitzero"`
+}
+
+// SnapshotGroupUpdateRequest is the SnapshotGroupUpdateRequest model.
+// Updates a Snapshot Group (PiT Group).
+type SnapshotGroupUpdateRequest struct {
+	// The name of the new snapshot group.
+	Name string `json:"name,omitempty"`
+	// The repository utilization warning threshold, as a percentage of the repository volume capacity.
+	WarningThreshold *int `json:"warningThreshold,omitempty"`
+	// The automatic deletion indicator. If non-zero, the oldest snapshot image will be automatically deleted when
+	// creating a new snapshot image to keep the total number of snapshot images limited to the number specified.
+	AutoDeleteLimit *int `json:"autoDeleteLimit,omitempty"`
+	// The behavior on when the data repository becomes full. This value is overridden by consistency group setting
+	// if this snapshot group is associated with a consistency group. Values: unknown, failbasewrites, purgepit.
+	FullPolicy string `json:"fullPolicy,omitempty"`
+	// The importance of the rollback operation. This value is overridden by consistency group setting if this
+	// snapshot group is associated with a consistency group. Values: highest, high, medium, low, lowest.
+	RollbackPriority string `json:"rollbackPriority,omitempty"`
+}
+
+// SnapshotViewCreateRequest is the SnapshotViewCreateRequest model.
+// Creates a Snapshot (PiT) View.
+type SnapshotViewCreateRequest struct {
+	// The identifier of the snapshot image used to create the new snapshot volume.
+	SnapshotImageID string `json:"snapshotImageId"`
+	// The repository utilization warning threshold percentage.
+	FullThreshold *int `json:"fullThreshold,omitempty"`
+	// The name of the new snapshot volume.
+	Name string `json:"name"`
+	// The snapshot volume access mode. Values: modeUnknown, readWrite, readOnly.
+	ViewMode string `json:"viewMode,omitempty"`
+	// The size of the view in relation to the size of the base volume.
+	RepositoryPercentage *float64 `json:"repositoryPercentage,omitempty"`
+	// The identifier of the storage pool on which to allocate the repository volume. Ignored if a
+	// repositoryCandidate is provided.
+	RepositoryPoolID string `json:"repositoryPoolId,omitempty"`
+	// Allows a repository candidate to be manually specified for use in the creation. By default, the best
+	// candidate will be selected.
+	RepositoryCandidate ConcatVolumeCandidate `json:"repositoryCandidate,omitzero"`
+}
+
+// SnapshotViewUpdateRequest is the SnapshotViewUpdateRequest model.
+// Updates a Snapshot (PiT) View.
+type SnapshotViewUpdateRequest struct {
+	// The name of the new snapshot volume.
+	Name string `json:"name,omitempty"`
+	// The repository utilization warning threshold percentage.
+	FullThreshold *int `json:"fullThreshold,omitempty"`
+}
+
+// SnapshotVolume is the SnapshotVolume model.
+type SnapshotVolume struct {
+	// The World Wide Name of the snapshot.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// Reference (key) for the snapshot volume.
+	SnapshotRef string `json:"snapshotRef,omitempty"`
+	// Name of the snapshot.
+	Label string `json:"label,omitempty"`
+	// Indicates status of the snapshot. Values: active, failed, disabled, offline.
+	Status string `json:"status,omitempty"`
+	// Long running action. Values: none, rollback.
+	Action string `json:"action,omitempty"`
+	// Current owner of the snapshot.
+	CurrentManager string `json:"currentManager,omitempty"`
+	// Base volume for this snapshot.
+	BaseVolume string `json:"baseVolume,omitempty"`
+	// Repository volume for this snapshot.
+	RepositoryVolume string `json:"repositoryVolume,omitempty"`
+	// Repository utilization warning threshold.
+	FullWarnThreshold int `json:"fullWarnThreshold,omitempty"`
+	// True if the repository utilization is over the warning threshold.
+	OverWarnThreshold bool `json:"overWarnThreshold,omitempty"`
+	// True, if the repository is full.
+	RepositoryFull bool `json:"repositoryFull,omitempty"`
+	// Indication of the volume behavior when the repository is full. Values: failwrites, failsnap.
+	RepFullPolicy string `json:"repFullPolicy,omitempty"`
+	// The importance of the rollback operation (0-4). Not used in this release of software.
+	RollbackPriority int `json:"rollbackPriority,omitempty"`
+	// The start position of the extent in the base volume (bytes). Not used in this release of software.
+	ExtentStart string `json:"extentStart,omitempty"`
+	// The size of the extent in bytes. Not used in this release of software.
+	ExtentSize string `json:"extentSize,omitempty"`
+	// The controller time when the snapshot was created.
+	SnapshotTime string `json:"snapshotTime,omitempty"`
+	// Permissions allowed on volume.
+	Perms VolumePerms `json:"perms,omitzero"`
+	// True if all child volumes are offline or failed. Child volumes are the base volume and the REPOSITORY volume.
+	ChildrenInactive bool `json:"childrenInactive,omitempty"`
+	// Volume SSID.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// For use by management client.
+	MgmtClientAttribute int `json:"mgmtClientAttribute,omitempty"`
+	// Status of current action being performed on snapshot. Values: none, inProgress, pending, paused.
+	RollbackStatus string `json:"rollbackStatus,omitempty"`
+	ID             string `json:"id,omitempty"`
+}
+
+// SnapshotVolumeModeConversionRequest is the SnapshotVolumeModeConversionRequest model.
+// Convert a Snapshot Volume from ReadOnly to ReadWrite mode.
+type SnapshotVolumeModeConversionRequest struct {
+	// Percentage of the base capacity to make the repository.
+	RepositoryPercentage *float64 `json:"repositoryPercentage,omitempty"`
+	// Allows a repository candidate to be manually specified for use in the conversion. By default, the best
+	// candidate will be selected.
+	RepositoryCandidate ConcatVolumeCandidate `json:"repositoryCandidate,omitzero"`
+	// The repository utilization warning threshold percentage.
+	FullThreshold *int `json:"fullThreshold,omitempty"`
+}
+
+// SnmpCommunity is the SnmpCommunity model.
+type SnmpCommunity struct {
+	// The reference ID for the community
+	ID string `json:"id,omitempty"`
+	// The name of the community
+	Name string `json:"name,omitempty"`
+	// The permission types for this SNMP Community Values: unknown, readOnly, readWrite.
+	Permissions string `json:"permissions,omitempty"`
+	// The trap destinations configured for this community
+	TrapDestinations []SnmpTrapDestination `json:"trapDestinations,omitempty"`
+}
+
+// SnmpCommunityRequest is the SnmpCommunityRequest model.
+type SnmpCommunityRequest struct {
+	// The name of the community
+	Name string `json:"name"`
+	// The permission types for this SNMP Community Values: unknown, readOnly, readWrite.
+	Permissions string `json:"permissions,omitempty"`
+	// The trap destinations to configure on this community
+	TrapDestinations []SnmpTrapDestinationRequest `json:"trapDestinations"`
+}
+
+// SnmpConfiguration is the SnmpConfiguration model.
+type SnmpConfiguration struct {
+	// The sysname of the storage system
+	SysName string `json:"sysName,omitempty"`
+	// The syslocation of the storage system
+	SysLocation string `json:"sysLocation,omitempty"`
+	// The syscontact of the storage system
+	SysContact string `json:"sysContact,omitempty"`
+	// The list of communities on the storage system
+	Communities []SnmpCommunity `json:"communities,omitempty"`
+	// The list of USM users on the storage system
+	UsmUsers []SnmpUsmUser `json:"usmUsers,omitempty"`
+}
+
+// SnmpConfigurationRequest is the SnmpConfigurationRequest model.
+type SnmpConfigurationRequest struct {
+	// The sysname of the storage system
+	SysName string `json:"sysName,omitempty"`
+	// The syslocation of the storage system
+	SysLocation string `json:"sysLocation,omitempty"`
+	// The syscontact of the storage system
+	SysContact string `json:"sysContact,omitempty"`
+	// The list of communities on the storage system
+	Communities []SnmpCommunityRequest `json:"communities"`
+	// The list of USM users on the storage system
+	UsmUsers []SnmpUsmUserRequest `json:"usmUsers,omitempty"`
+}
+
+// SnmpTrapDestination is the SnmpTrapDestination model.
+type SnmpTrapDestination struct {
+	// The reference ID for the trap destination
+	ID string `json:"id,omitempty"`
+	// IPV4 or IPV6 address for this trap destination
+	ReceiverAddress string `json:"receiverAddress,omitempty"`
+	// If authentication failure traps should be sent to this destination
+	SendAuthenticationFailureTraps bool `json:"sendAuthenticationFailureTraps,omitempty"`
+}
+
+// SnmpTrapDestinationRequest is the SnmpTrapDestinationRequest model.
+type SnmpTrapDestinationRequest struct {
+	// IPV4 or IPV6 address for this trap destination
+	ReceiverAddress string `json:"receiverAddress"`
+	// If authentication failure traps should be sent to this destination
+	SendAuthenticationFailureTraps bool `json:"sendAuthenticationFailureTraps,omitempty"`
+}
+
+// SnmpTrapDestinationTest is the SnmpTrapDestinationTest model.
+type SnmpTrapDestinationTest struct {
+	// IPV4 or IPV6 address for a configured trap destination
+	ReceiverAddress string `json:"receiverAddress"`
+}
+
+// SnmpUsmUser is the SnmpUsmUser model.
+type SnmpUsmUser struct {
+	// The reference ID for the USM user
+	ID string `json:"id,omitempty"`
+	// The name of the USM user
+	Name string `json:"name,omitempty"`
+	// The permission types for this SNMP USM user Values: unknown, readOnly, readWrite.
+	Permissions string `json:"permissions,omitempty"`
+	// The authoritative engine ID type of the USM user. May be "local" to use the local engine ID or "custom" to
+	// specify a remote engine ID to be used. Values: unknown, local, custom.
+	EngineIDType string `json:"engineIdType,omitempty"`
+	// The authoritative engine ID of the USM user for the custom engine id type.
+	EngineID string `json:"engineId,omitempty"`
+	// The SNMP authentication protocol for this SNMP USM user. Values: unknown, none, sha, sha256, sha512.
+	AuthProtocol string `json:"authProtocol,omitempty"`
+	// The password for the authentication protocol for the this SNMP USM user.
+	AuthPassword string `json:"authPassword,omitempty"`
+	// The SNMP privacy protocol for this SNMP USM user. Values: unknown, none, aes128.
+	PrivProtocol string `json:"privProtocol,omitempty"`
+	// The password for the privacy protocol for the this SNMP USM user.
+	PrivPassword string `json:"privPassword,omitempty"`
+	// The trap destinations configured for this USM user.
+	TrapDestinations []SnmpTrapDestination `json:"trapDestinations,omitempty"`
+}
+
+// SnmpUsmUserRequest is the SnmpUsmUserRequest model.
+type SnmpUsmUserRequest struct {
+	// The name of the USM user.
+	Name string `json:"name"`
+	// The permission types for this SNMP USM user. Values: unknown, readOnly, readWrite.
+	Permissions string `json:"permissions,omitempty"`
+	// The authoritative engine ID type of the USM user. May be "local" to use the local engine ID or "custom" to
+	// specify a remote engine ID to be used. Defaults to "local". Values: unknown, local, custom.
+	EngineIDType string `json:"engineIdType,omitempty"`
+	// The authoritative engine ID of the USM user for the custom engine id type.
+	EngineID string `json:"engineId,omitempty"`
+	// The SNMP authentication protocol for this SNMP USM user. Defaults to "none". Values: unknown, none, sha,
+	// sha256, sha512.
+	AuthProtocol string `json:"authProtocol,omitempty"`
+	// The SNMP authentication password for this SNMP USM user. The password must be specified when creating a new
+	// user with authentication enabled and for existing users if the authentication protocol is changed. If not
+	// specified (null or an empty string) for existing users, the password will not be changed. When specified, the
+	// password must be 8 to 128 characters in length.
+	AuthPassword string `json:"authPassword,omitempty"`
+	// The SNMP privacy protocol for this SNMP USM user. Defaults to "none". Values: unknown, none, aes128.
+	PrivProtocol string `json:"privProtocol,omitempty"`
+	// The SNMP privacy password for this SNMP USM user. The password must be specified when creating a new user
+	// with privacy enabled and for existing users if the privacy protocol is changed. If not specified (null or an
+	// empty string) for existing users, the password will not be changed. When specified, the password must be 8 to
+	// 128 characters in length.
+	PrivPassword string `json:"privPassword,omitempty"`
+	// The trap destinations to configure on this USM user.
+	TrapDestinations []SnmpTrapDestinationRequest `json:"trapDestinations"`
+}
+
+// SocLocation is the SocLocation model.
+type SocLocation struct {
+	// The channel type. Values: hostside, driveside, management.
+	ChannelType string `json:"channelType,omitempty"`
+	// The channel type.
+	Channel int `json:"channel,omitempty"`
+	// The SOC index, 0-based.
+	Soc int `json:"soc,omitempty"`
+	// The port number, 1-based
+	Port int `json:"port,omitempty"`
+	// The controller slot number, 1 or 2
+	ControllerSlot int `json:"controllerSlot,omitempty"`
+}
+
+// SoftwareReloadEvent is the SoftwareReloadEvent model.
+type SoftwareReloadEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType string `json:"eventType,omitempty"`
+	// Values: success, error.
+	Status        string `json:"status,omitempty"`
+	CorrelationID string `json:"correlationId,omitempty"`
+}
+
+// SoftwareVersion is the SoftwareVersion model.
+// Version information for a component/context in the server
+type SoftwareVersion struct {
+	// The context for the component
+	Context string `json:"context"`
+	// The version for the component
+	Version string `json:"version"`
+}
+
+// SoftwareVersions is the SoftwareVersions model.
+// Collection of version information for the current and staged versions of sotware
+type SoftwareVersions struct {
+	// The staged versions
+	StagedVersions []SoftwareVersion `json:"stagedVersions"`
+	// The current versions
+	CurrentVersions []SoftwareVersion `json:"currentVersions"`
+}
+
+// SpmDatabaseHealthCheck is the SpmDatabaseHealthCheck model.
+type SpmDatabaseHealthCheck struct {
+	// Values: ok, notCompleted, failedDataRetrieval, failed.
+	Result string `json:"result"`
+	// Values: melEventCheck, cntrlSyncCheck, storageDeviceAccessible, spmDatabaseVerification, validPassword,
+	// configurationDatabaseCheck, objectGraphSyncCheck, nvsramMatches, volumeGroupsComplete,
+	// controllerStatusOptimal, hotSparesInUse, failedDrivesPresent, driveCheck, missingVolumes,
+	// exclusiveOperations, dbSubRecordsValidation, driveSideShipping, nvsramDisableCfwDownloads, needsAttention,
+	// integratedHealthCheck, altCommLinkFailure, ptlDisallowDownloads.
+	Type string `json:"type"`
+	// Values: unknown, low, medium, high, fatal.
+	SeverityLevel string `json:"severityLevel"`
+	// Results data to diagnose failures
+	ResultsData string `json:"resultsData"`
+	// A description of the failure
+	FailureMessages []string `json:"failureMessages,omitempty"`
+	Successful      bool     `json:"successful,omitempty"`
+}
+
+// SscVolumeCreateRequest is the SscVolumeCreateRequest model.
+type SscVolumeCreateRequest struct {
+	// The id of the storage pool to create the volume on
+	PoolID string `json:"poolId"`
+	// The name of the volume
+	Name string `json:"name"`
+	// The unit for the request capacity Values: bytes, b, kb, mb, gb, tb, pb, eb, zb, yb.
+	SizeUnit string `json:"sizeUnit,omitempty"`
+	// The requested capacity of the volume in units
+	Size             string `json:"size"`
+	ReadCacheEnable  *bool  `json:"readCacheEnable,omitempty"`
+	WriteCacheEnable *bool  `json:"writeCacheEnable,omitempty"`
+	// Add this volume to a flashCache
+	FlashCacheEnable *bool `json:"flashCacheEnable,omitempty"`
+	// Enable the dataAssurance capability
+	DataAssuranceEnable *bool `json:"dataAssuranceEnable,omitempty"`
+	// Define a thinProvisioned volume
+	ThinProvision *bool `json:"thinProvision,omitempty"`
+	// Optional list of MetaData tags to assign to the volume.
+	MetaTags []VolumeMetadataItem `json:"metaTags,omitempty"`
+}
+
+// SscVolumeUpdateRequest is the SscVolumeUpdateRequest model.
+// Updates a volume.
+type SscVolumeUpdateRequest struct {
+	// The user-label to assign to the volume.
+	Name string `json:"name,omitempty"`
+	// Expand the volume to this capacity.
+	NewSize string `json:"newSize,omitempty"`
+	// Expand the volume to this capacity. Values: bytes, b, kb, mb, gb, tb, pb, eb, zb, yb.
+	SizeUnit string `json:"sizeUnit,omitempty"`
+	// If true, add the volume to the flashCache if one is defined. If false, remove from the flashCache if one
+	// exists.
+	FlashCache *bool `json:"flashCache,omitempty"`
+	// Set the preferred owning controller
+	OwningControllerID string `json:"owningControllerId,omitempty"`
+	ReadCacheEnable    *bool  `json:"readCacheEnable,omitempty"`
+	WriteCacheEnable   *bool  `json:"writeCacheEnable,omitempty"`
+	// Configure scan settings with regard to the controller's background media scan operation
+	ScanSettings VolumeMediaScanParams `json:"scanSettings,omitzero"`
+	// Optional list of MetaData tags to assign to the volume. This will replace any existing tags.
+	MetaTags []VolumeMetadataItem `json:"metaTags,omitempty"`
+}
+
+// SsdDriveWearStatistics is the SsdDriveWearStatistics model.
+type SsdDriveWearStatistics struct {
+	// The world wide name of the drive.
+	DriveWWN string `json:"driveWwn,omitempty"`
+	// The timestamp in ISO-8601 format.
+	Timestamp string `json:"timestamp,omitempty"`
+	// The total bytes read.
+	TotalBytesRead string `json:"totalBytesRead,omitempty"`
+	// The total bytes written.
+	TotalBytesWritten string `json:"totalBytesWritten,omitempty"`
+	// The drive temperature in Celsius.
+	TemperatureInCelsius int `json:"temperatureInCelsius,omitempty"`
+	// The wear life percentage used.
+	WearlifePercentageUsed int `json:"wearlifePercentageUsed,omitempty"`
+	// The hours since last power cycle.
+	PowerOnHours int `json:"powerOnHours,omitempty"`
+	// The average number of erased blocks.
+	AverageErasedBlockCount string `json:"averageErasedBlockCount,omitempty"`
+	// The percentage of spare blocks remaining.
+	SpareBlockRemainingPercentage int `json:"spareBlockRemainingPercentage,omitempty"`
+	// The total host write block count.
+	TotalHostWriteBlockCount string `json:"totalHostWriteBlockCount,omitempty"`
+	// The total NAND block write count.
+	TotalNandWriteBlockCount string `json:"totalNandWriteBlockCount,omitempty"`
+	// The unmap block estimate.
+	UnmapBlockEstimate string `json:"unmapBlockEstimate,omitempty"`
+	// The total host reads. This field is only applicable to NVMe drives.
+	TotalHostReads string `json:"totalHostReads,omitempty"`
+	// The total host writes. This field is only applicable to NVMe drives.
+	TotalHostWrites string `json:"totalHostWrites,omitempty"`
+	// The software version of the drive.
+	SoftwareVersion string `json:"softwareVersion,omitempty"`
+	// The drive capacity in bytes.
+	DriveCapacityInBytes string `json:"driveCapacityInBytes,omitempty"`
+	// The drive vendor.
+	DriveVendor string `json:"driveVendor,omitempty"`
+	// The drive product id.
+	DriveProductID string `json:"driveProductId,omitempty"`
+	// The drive block size in bytes.
+	BlockSize int `json:"blockSize,omitempty"`
+	// The volume group name.
+	VolumeGroupName string `json:"volumeGroupName,omitempty"`
+	// The drive usage type. Values: absent, flashCache, hotSpare, volumeGroup, unassigned.
+	DriveUsageType string `json:"driveUsageType,omitempty"`
+	// The physical drive type. Values: all, scsi, fibre, sata, pata, fibre520b, sas, unknown, sas4k, nvme4k.
+	PhysicalDriveType string `json:"physicalDriveType,omitempty"`
+	// The drive serial number.
+	SerialNumber string `json:"serialNumber,omitempty"`
+}
+
+// SshMfaEnablement is the SshMfaEnablement model.
+type SshMfaEnablement struct {
+	ControllerRef string `json:"controllerRef,omitempty"`
+	// Enable SSH MFA for this controller
+	MfaEnabled bool `json:"mfaEnabled,omitempty"`
+	// Enable SSH for this controller.
+	EnableSsh bool `json:"enableSsh,omitempty"`
+}
+
+// SSLReloadCompleteEvent is the SslReloadCompleteEvent model.
+type SSLReloadCompleteEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType       string `json:"eventType"`
+	StorageSystemID string `json:"storageSystemId"`
+}
+
+// StagedFirmware is the StagedFirmware model.
+type StagedFirmware struct {
+	// False, staged FW areas on the controllers are not valid and cannot be activated. True, the staged FW areas on
+	// the controllers are valid and can be activated.
+	StagedFwValid bool `json:"stagedFwValid,omitempty"`
+	// This field has been deprecated and should not be used.
+	FwVersion string `json:"fwVersion,omitempty"`
+	// This field has been deprecated and should not be used.
+	AppVersion string `json:"appVersion,omitempty"`
+	// This field has been deprecated and should not be used.
+	BootVersion string `json:"bootVersion,omitempty"`
+	// This field has been deprecated and should not be used.
+	NvsramVersion string `json:"nvsramVersion,omitempty"`
+	// Timestamp when the staged FW was downloaded
+	TimeStamp string `json:"timeStamp,omitempty"`
+	// This field has been deprecated and should not be used.
+	NvsramVersionString string `json:"nvsramVersionString,omitempty"`
+	// Version descriptors for staged code modules
+	CodeVersions []VersionDescriptor `json:"codeVersions,omitempty"`
+}
+
+// StagedFirmwareResponse is the StagedFirmwareResponse model.
+// Class that holds details on the Firmware staged on the array.
+type StagedFirmwareResponse struct {
+	// Timestamp when the staged FW was downloaded
+	TimeStamp string `json:"timeStamp"`
+	// Version descriptors for staged code modules
+	CodeVersions []VersionDescriptor `json:"codeVersions"`
+}
+
+// StartBatchRequest is the StartBatchRequest model.
+type StartBatchRequest struct {
+	// The name of the batch to be started
+	BatchName string `json:"batchName"`
+	// The optional group ID which can be used to group multiple batch operations together, if not specified a group
+	// id will be generated.
+	BatchGroupID   string          `json:"batchGroupId,omitempty"`
+	JobDescription []JobDescriptor `json:"jobDescription"`
+}
+
+// StartFullProvisioningRequest is the StartFullProvisioningRequest model.
+type StartFullProvisioningRequest struct {
+	// Optional. True to disable resource provisioning on the storage pool following provisioning completion. This
+	// will disable resource provisioning for all volumes in the storage pool and new volumes in the storage pool
+	// will not be resource provisioned. If false, volumes will be fully provisioned but will retain the resource
+	// provisioning capability and new volumes created in the volume storage pool will be resource provisioned.
+	DisableDulbe *bool `json:"disableDulbe,omitempty"`
+}
+
+// StartNetworkTraceDescriptor is the StartNetworkTraceDescriptor model.
+type StartNetworkTraceDescriptor struct {
+	// Maximum (uncompressed) trace size in MB (million bytes, not MiB).
+	MaxTraceSizeMB int `json:"maxTraceSizeMB,omitempty"`
+	// Maximum duration of trace in seconds.
+	MaxTraceDurationSeconds int `json:"maxTraceDurationSeconds,omitempty"`
+	// Number of bytes to capture for each packet (the remainder of the packet is discarded). This is a mechanism
+	// for reducing the amount of customer data in the trace file.
+	PacketLengthBytes int `json:"packetLengthBytes,omitempty"`
+	// Interfaces on which to start a trace. Must be interfaces on the controller receiving the descriptor for which
+	// isNetworkTraceCapable is true. Multiple interfaces can be selected only if they have an IP address associated
+	// because the IP is used to filter the trace (which means broadcasts such as ARPs/DHCP/... will be excluded).
+	// To debug lower level protocols and capture all packets, select only a single interface.
+	Interfaces []string `json:"interfaces,omitempty"`
+}
+
+// StatsCollectionEvent is the StatsCollectionEvent model.
+type StatsCollectionEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType       string `json:"eventType"`
+	StorageSystemID string `json:"storageSystemId"`
+	// Last collection timestamp
+	LastCollectionTime string `json:"lastCollectionTime"`
+	// Collection timestamp
+	CurrentCollectionTime string `json:"currentCollectionTime"`
+	// Values: historical, live.
+	CollectionType string `json:"collectionType"`
+}
+
+// StorageArray is the StorageArray model.
+type StorageArray struct {
+	// General information and characteristics of the storage array.
+	SaData SAData `json:"saData,omitzero"`
+	// This field contains a unique identifier maintained by SAFE for use by storage management software. Storage
+	// management software uses this data to enable SAFE features and perform Feature Bundle migration.
+	SafeID SAIdentifier `json:"safeId,omitzero"`
+	// Information about cache settings that apply to the storage array as a whole.
+	Cache SACache `json:"cache,omitzero"`
+	// Information about the access volume that is used to convey configuration commands (via the UTM protocol) to
+	// the controllers within the storage array.
+	AccessVolume AccessVolume `json:"accessVolume,omitzero"`
+	// An object that provides information about the parameters and limits of certain functions that are supported
+	// by the controllers that reside within the storage array.
+	FeatureParameters FeatureParams `json:"featureParameters,omitzero"`
+	// The current value of the media scan period parameter for the storage array. This value indicates the number
+	// of days over which a complete scan of the media will occur. The array controller(s) will attempt to achieve
+	// this configured period on a best-effort basis, but certain operational factors may cause slight deviations
+	// from the configured value.
+	MediaScanPeriod int `json:"mediaScanPeriod,omitempty"`
+	// A variable-length array that provides an enumeration of the high-level capabilities that are supported by the
+	// controllers that reside within the storage array.
+	Capabilities []string `json:"capabilities,omitempty"`
+	// This field has been deprecated and should not be used.
+	PremiumFeatures []PremiumFeature `json:"premiumFeatures,omitempty"`
+	// This field has been deprecated and should not be used.
+	TrayPositionList TrayPositionList `json:"trayPositionList,omitzero"`
+	// This field has been deprecated and should not be used.
+	HostPortType []string `json:"hostPortType,omitempty"`
+	// The values from the heterogeneous host regions. Each element in this array corresponds to a type in the
+	// hostPortType array, and the ordering of the types in this array matches that of the hostPortType array, i.e.,
+	// hostPortType[i] is equal to hostSpecificVals[i].hostPortType.
+	HostSpecificVals []HostSpecificValues `json:"hostSpecificVals,omitempty"`
+	// A variable-length array of firmware compatibility specifiers. These values generally identify certain levels
+	// of firmware that are allowed for downgrading the current storage array firmware.
+	Compatibility []CompatibleFirmware `json:"compatibility,omitempty"`
+	// The recommended usage parameter combinations.
+	UsageHints []VolumeUsageHint `json:"usageHints,omitempty"`
+	// The number of partitions calculated from the user's current mappings.
+	DefinedPartitionCount int `json:"definedPartitionCount,omitempty"`
+	// This field has been deprecated and should not be used.
+	DefaultHostPortTypeIndex int `json:"defaultHostPortTypeIndex,omitempty"`
+	// Array-specific ID used for remote device discovery.
+	RemoteAccessID string `json:"remoteAccessID,omitempty"`
+	// True if RVM is activated.
+	RemoteMirroringActive bool `json:"remoteMirroringActive,omitempty"`
+	// Volume transfer alert delay period.
+	VolXferAlertDelayPeriod int    `json:"volXferAlertDelayPeriod,omitempty"`
+	Reserved1               string `json:"reserved1,omitempty"`
+	Reserved2               string `json:"reserved2,omitempty"`
+	// Information on possible staged controller firmware.
+	StagedFirmware StagedFirmware `json:"stagedFirmware,omitzero"`
+	// Storage array unreadable sector count and max limit.
+	UnreadableSectors UnreadableSectors `json:"unreadableSectors,omitzero"`
+	// This field has been deprecated and should not be used.
+	DriveChannels []DriveChannel `json:"driveChannels,omitempty"`
+	// This field has been deprecated and should not be used.
+	PortLocations []DriveChannelPort `json:"portLocations,omitempty"`
+	// The tag value to be used in retrieving DDC log data. A value of DDC_NULL_TAG indicates there is no DDC log
+	// data to retrieve.
+	DdcTag int `json:"ddcTag,omitempty"`
+	// A structure describing the features and feature limits that are associated with this storage array product.
+	FeatureBundle FeatureBundle `json:"featureBundle,omitzero"`
+	// This field has been deprecated and should not be used.
+	FeatureState []FeatureState `json:"featureState,omitempty"`
+	// A list of all channel ports in the storage array, including host channel ports, drive channel ports, and ESM
+	// channel ports.
+	ChannelPortLocations []ChannelPort `json:"channelPortLocations,omitempty"`
+	// Information about the storage array pertaining to its role as an iSCSI entity.
+	IscsiEntityData IscsiEntityData `json:"iscsiEntityData,omitzero"`
+	// This variable-length array has label values that provide descriptive strings for each supported index value
+	// that can appear in the Host object. Zero-length strings are allowed and indicate that the associated
+	// host-specific NVSRAM regions have been cleared. This field should be used in place of the deprecated
+	// hostPortType field.
+	HostType []string `json:"hostType,omitempty"`
+	// The Host Type Index to use for undefined and newly created host ports.
+	DefaultHostTypeIndex int `json:"defaultHostTypeIndex,omitempty"`
+	// The number (in the range 1 through n, where n is the number of host ports on an individual controller) of the
+	// controller host channel that the firmware uses for remote volume mirroring. This field is set to zero when
+	// the data is unavailable or not applicable, including when the RVM feature is disabled,
+	RvmControllerHostChannel int `json:"rvmControllerHostChannel,omitempty"`
+	// This field has been deprecated and should not be used.
+	SmartBatteryData SmartBatteryData `json:"smartBatteryData,omitzero"`
+	// True if the storage array has an invalid system configuration; otherwise false.
+	InvalidSystemConfig bool `json:"invalidSystemConfig,omitempty"`
+	// A boolean which describes the current state of the lock key. True if the array lock key has been set,
+	// otherwise false.
+	SecurityLockKey bool `json:"securityLockKey,omitempty"`
+	// The lock key ID for the storage array.
+	LockKeyIDRef string `json:"lockKeyIDRef,omitempty"`
+	// This field contains the configuration information used by the storage array for writing MEL events to a
+	// syslog server.
+	SyslogConfiguration SyslogConfiguration `json:"syslogConfiguration,omitzero"`
+	// True if external key management is in effect and false if controller is using local key management.
+	SecurityExternalKmsEnable bool `json:"securityExternalKmsEnable,omitempty"`
+	// Sequence number used to serialize installNewKey requests from multiple proxy key management SYMbol servers.
+	NewKeySequence int `json:"newKeySequence,omitempty"`
+	// The controller's current key status. If external key management not enabled, set to
+	// EXTERNAL_KMS_KEY_STATUS_OK. Values: ok, keyNeeded, newKeyNeeded, validationNeeded, deleteKey.
+	SecurityExternalKmsKeyStatus string `json:"securityExternalKmsKeyStatus,omitempty"`
+	// Contains the lock key IDs of keys needed by the array controller.
+	LockKeyNeededList []string `json:"lockKeyNeededList,omitempty"`
+	// Information about a core dump in controller memory, if one exists.
+	DplCoreDumpData DPLCoreDumpData `json:"dplCoreDumpData,omitzero"`
+	// Indicator of whether fibre channel remote mirroring has been enabled, and if so, whether it is enabled for
+	// use by legacy (synchronous) mirroring or ARVM. If this is set to something other than
+	// FC_MIRROR_STATE_DISABLED or FC_MIRROR_STATE_UNKNOWN, then the rvmControllerHostChannel field can be used to
+	// determine the particular host channel reserved for remote mirroring. Values: unknown, disabled,
+	// enabledLegacyRvm, enabledArvm, enabledAll.
+	FcRVMState string `json:"fcRVMState,omitempty"`
+	// True if Autosupport is enabled.
+	AsupEnabled bool `json:"asupEnabled,omitempty"`
+	// This field has been deprecated and should not be used.
+	AsupOnDemandEnabled bool `json:"asupOnDemandEnabled,omitempty"`
+	// This field has been deprecated and should not be used.
+	AsupRemoteDiagsEnabled bool `json:"asupRemoteDiagsEnabled,omitempty"`
+	// Information about an IOC Dump in controller memory, if one exists.
+	IocDumpData IOCDumpData `json:"iocDumpData,omitzero"`
+	// True if one or more drive channel ports have been disabled by the controllers to protect against faulty
+	// expansion cabling. This could have been necessitated by a miswire condition or by a bad expansion cable.
+	// Check the Recovery Events for an indication of which connection(s) have been disabled and correct the cabling
+	// as required.
+	DriveChannelPortDisabled bool `json:"driveChannelPortDisabled,omitempty"`
+	// This boolean is true if direct expansion ports are in use by the controllers.
+	DirectExpansionPortInUse bool `json:"directExpansionPortInUse,omitempty"`
+	// Cumulative Performance stats high resolution indicator
+	HighResolutionStatisticsEnabled bool `json:"highResolutionStatisticsEnabled,omitempty"`
+	// Auto Load Balancing is user-enabled on this array
+	AutoLoadBalancingEnabled bool `json:"autoLoadBalancingEnabled,omitempty"`
+	// This reserved field is needed to maintain compatibility with host applications.
+	Reserved3 string `json:"reserved3,omitempty"`
+	// This structure contains the external key management information. See the structure definition for details.
+	EmbeddedExternalKMSInformation EmbeddedExternalKMSInformation `json:"embeddedExternalKMSInformation,omitzero"`
+	// Provides an indication of whether LUN 0 is restricted (disallowed) for the default storage partition.
+	IsLun0RestrictedForDefaultPartition bool `json:"isLun0RestrictedForDefaultPartition,omitempty"`
+	// Storage array security settings.
+	SecuritySettings SecuritySettings `json:"securitySettings,omitzero"`
+	// Indicate whether or not P and Q parity validation is performed on certain drive media types during
+	// reconstruction of RAID 6 and disk pool volumes on the Storage Array.
+	ParityCheckOnMediaType ParityCheckOnMediaType `json:"parityCheckOnMediaType,omitzero"`
+	// If host connectivity reporting is enabled/disabled for the the array.
+	HostConnectivityReportingEnabled bool `json:"hostConnectivityReportingEnabled,omitempty"`
+	// True if resource provisioning is enabled by default when a volume group or pool that supports this capability
+	// is created, false otherwise.
+	ResourceProvisionedVolumesEnabled bool `json:"resourceProvisionedVolumesEnabled,omitempty"`
+	// Determines the AutoSupport sanitize setting and whether content included in the AutoSupport bundle will be
+	// sanitized. Values: unknown, disabled, all.
+	SanitizeAsupSetting string `json:"sanitizeAsupSetting,omitempty"`
+	Vaaienabled         bool   `json:"vaaienabled,omitempty"`
+	Odxenabled          bool   `json:"odxenabled,omitempty"`
+}
+
+// StorageDeviceHealthCheck is the StorageDeviceHealthCheck model.
+type StorageDeviceHealthCheck struct {
+	// Values: ok, notCompleted, failedDataRetrieval, failed.
+	Result string `json:"result"`
+	// Values: melEventCheck, cntrlSyncCheck, storageDeviceAccessible, spmDatabaseVerification, validPassword,
+	// configurationDatabaseCheck, objectGraphSyncCheck, nvsramMatches, volumeGroupsComplete,
+	// controllerStatusOptimal, hotSparesInUse, failedDrivesPresent, driveCheck, missingVolumes,
+	// exclusiveOperations, dbSubRecordsValidation, driveSideShipping, nvsramDisableCfwDownloads, needsAttention,
+	// integratedHealthCheck, altCommLinkFailure, ptlDisallowDownloads.
+	Type string `json:"type"`
+	// Values: unknown, low, medium, high, fatal.
+	SeverityLevel string `json:"severityLevel"`
+	// Results data to diagnose failures
+	ResultsData string `json:"resultsData"`
+	Successful  bool   `json:"successful,omitempty"`
+}
+
+// StorageDeviceStatusEvent is the StorageDeviceStatusEvent model.
+type StorageDeviceStatusEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType string `json:"eventType"`
+	// Values: neverContacted, offline, optimal, needsAttn, removed, newDevice, lockDown.
+	PreviousStatus string `json:"previousStatus"`
+	// Values: neverContacted, offline, optimal, needsAttn, removed, newDevice, lockDown.
+	CurrentStatus   string `json:"currentStatus"`
+	StorageSystemID string `json:"storageSystemId"`
+	SystemWWN       string `json:"systemWWN"`
+}
+
+// StoragePoolBundle is the StoragePoolBundle model.
+type StoragePoolBundle struct {
+	// A variable-length array containing all SAPortGroup objects that are currently configured and available.
+	SaPortGroup []SAPortGroup `json:"saPortGroup,omitempty"`
+	// This field has been deprecated and should not be used.
+	SaPort []SAPort `json:"saPort,omitempty"`
+	// A variable-length array containing all defined Cluster objects for the storage array configuration.
+	Cluster []Cluster `json:"cluster,omitempty"`
+	// A variable-length array containing all defined Host objects for the storage array configuration.
+	Host []Host `json:"host,omitempty"`
+	// A variable-length array containing all defined HostPort objects for the storage array configuration.
+	HostPort []HostPort `json:"hostPort,omitempty"`
+	// A variable-length array containing all defined LUNMapping objects for the storage array configuration.
+	LunMapping []LUNMapping `json:"lunMapping,omitempty"`
+	// A variable-length array containing all Initiator objects defined in the storage array configuration.
+	Initiator []Initiator `json:"initiator,omitempty"`
+	// A variable-length array containing all Target objects defined in the storage array configuration.
+	Target []Target `json:"target,omitempty"`
+	// A variable-length array containing all Remote Target objects defined in the storage array configuration.
+	// Remote Target objects define the initiator/target on a remote array.
+	RemoteTarget []RemoteTarget `json:"remoteTarget,omitempty"`
+}
+
+// StoragePoolCreateRequest is the StoragePoolCreateRequest model.
+// Creates a Storage Pool.
+type StoragePoolCreateRequest struct {
+	// The RAID configuration for the new storage pool. Values: raidUnsupported, raidAll, raid0, raid1, raid3,
+	// raid5, raid6, raidDiskPool, raid1p.
+	RaidLevel string `json:"raidLevel"`
+	// The identifiers of the disk drives to use for creating the storage pool.
+	DiskDriveIds []string `json:"diskDriveIds"`
+	// Security-enabled drives that were previously part of a secured storage pool must be erased before they can be
+	// re-used. Enable to automatically erase such drives.
+	EraseSecuredDrives *bool `json:"eraseSecuredDrives,omitempty"`
+	// The user-label to assign to the new storage pool.
+	Name string `json:"name"`
+}
+
+// StoragePoolExpansionRequest is the StoragePoolExpansionRequest model.
+// Used for expanding the capacity of a Storage Pool.
+type StoragePoolExpansionRequest struct {
+	// The list of drives to add to the Pool. A maximum of 12 drives is allowed for Pools of type raidDiskPool and 2
+	// for others.
+	Drives []string `json:"drives"`
+}
+
+// StoragePoolUpdateRequest is the StoragePoolUpdateRequest model.
+// Updates a Storage Pool.
+type StoragePoolUpdateRequest struct {
+	// The new user-label to assign to the storage pool.
+	Name string `json:"name,omitempty"`
+	// Convert to a secure Storage Pool if all of the drives that constitute the Pool are security capable
+	SecurePool *bool `json:"securePool,omitempty"`
+	// Set the number of drives reserved by the storage pool for reconstruction operations (only for RAID Type:
+	// raidDiskPool)
+	ReservedDriveCount *int `json:"reservedDriveCount,omitempty"`
+	// Set the reconstruction and background operation priorities (only for RAID Type: raidDiskPool)
+	PoolPriority DiskPoolPriorityUpdateRequest `json:"poolPriority,omitzero"`
+	// Set the reconstruction and background operation priorities (only for RAID Type: raidDiskPool)
+	PoolThreshold DiskPoolThresholdUpdateRequest `json:"poolThreshold,omitzero"`
+}
+
+// StorageSystemAttributes is the StorageSystemAttributes model.
+// The storage system attributes.
+type StorageSystemAttributes struct {
+	// True to indicate that one or more host bus adapter (HBA) host ports in the default host group is using an I/O
+	// protocol that does not allow volumes to be mapped to LUN 0, otherwise false.
+	IsLun0RestrictedForDefaultPartition bool `json:"isLun0RestrictedForDefaultPartition"`
+}
+
+// StorageSystemCapabilitiesResponse is the StorageSystemCapabilitiesResponse model.
+// The storage system capabilities response.
+type StorageSystemCapabilitiesResponse struct {
+	FeatureBundle FeatureBundle `json:"featureBundle"`
+	// The list of storage system capabilities.
+	Capabilities []string `json:"capabilities"`
+	// The list of storage system product capabilities.
+	ProductCapabilities []string `json:"productCapabilities"`
+	// The list of storage system feature states.
+	FeatureStates           []FeatureState          `json:"featureStates"`
+	StorageSystemAttributes StorageSystemAttributes `json:"storageSystemAttributes"`
+	FeatureParameters       FeatureParams           `json:"featureParameters,omitzero"`
+}
+
+// StorageSystemConfigResponse is the StorageSystemConfigResponse model.
+type StorageSystemConfigResponse struct {
+	// Name of the storage system
+	Name string `json:"name,omitempty"`
+}
+
+// StorageSystemConfigUpdateRequest is the StorageSystemConfigUpdateRequest model.
+// For updating StorageSystem configuration.
+type StorageSystemConfigUpdateRequest struct {
+	// New name for the storage system
+	Name string `json:"name,omitempty"`
+}
+
+// StorageSystemController is the StorageSystemController model.
+// Provides information for the storage array associated with a given certificate
+type StorageSystemController struct {
+	// The identifier for the controller
+	ControllerID string `json:"controllerId,omitempty"`
+	// The serial number for the controller
+	ControllerSerialNumber string `json:"controllerSerialNumber,omitempty"`
+	// The storage system ID
+	ArrayID string `json:"arrayID,omitempty"`
+	// The storage system label
+	ArrayLabel string `json:"arrayLabel,omitempty"`
+	// The storage system world wide name
+	ArrayWWN string `json:"arrayWWN,omitempty"`
+}
+
+// StorageSystemControllerStats is the StorageSystemControllerStats model.
+// Controller statistics.
+type StorageSystemControllerStats struct {
+	// Controller id.
+	ControllerID string `json:"controllerId"`
+	// CPU utilization statistics.
+	CPUUtilizationStats []CPUUtilizationStats `json:"cpuUtilizationStats"`
+}
+
+// StorageSystemIdentificationResponse is the StorageSystemIdentificationResponse model.
+// The basic storage system information accessible to unauthenticated users. This is intended to provide the base
+// amount of information necessary for proper identification before authentication.
+type StorageSystemIdentificationResponse struct {
+	// The user-assigned label of the storage system.
+	Name string `json:"name,omitempty"`
+	// The world-wide name of the storage system. This acts as a unique identifier.
+	WWN string `json:"wwn,omitempty"`
+	// The serial number of the chassis.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// The managing controller position.
+	ControllerPosition int `json:"controllerPosition,omitempty"`
+}
+
+// StorageSystemResponse is the StorageSystemResponse model.
+// A Storage System is a collection of both physical components and logical components. Physical components include
+// disk drives, controllers, fans, and other hardware. The physical disk drives are grouped into Storage Pools. The
+// storage capacity of these Storage Pools is provisioned into logical Volumes. Some properties may be null if the
+// array is offline.
+type StorageSystemResponse struct {
+	// An identifier for the storage system used by the web service.
+	ID string `json:"id"`
+	// The user-assigned label of the storage system.
+	Name string `json:"name"`
+	// The world-wide name of the storage system. This acts as a unique identifier.
+	WWN string `json:"wwn"`
+	// The status for the stored password. Indicates the stored password's validity and if the array is in security
+	// lockout mode. Values: unknown, invalid, valid, securityLockout.
+	PasswordStatus string `json:"passwordStatus"`
+	// True if a stored password has been set and it is not an empty string.
+	PasswordSet bool `json:"passwordSet"`
+	// The status for the storage system. Values: neverContacted, offline, optimal, needsAttn, removed, newDevice,
+	// lockDown.
+	Status string `json:"status"`
+	// The consolidated certificate status of all management paths. Values: trusted, partiallyTrusted, untrusted,
+	// unknown.
+	CertificateStatus string `json:"certificateStatus"`
+	// The IP address for a management port on the slot A controller. If insufficient information is available to
+	// make this determination, then this field will contain a path from managementPaths.
+	Ip1 string `json:"ip1"`
+	// The IP address for a management port on the slot B controller. If insufficient information is available to
+	// make this determination, then this field will contain a path from managementPaths.
+	Ip2 string `json:"ip2"`
+	// A list of known paths that can be used to manage the system. These can be in the form of a hostname or an IP
+	// address.
+	ManagementPaths []string `json:"managementPaths"`
+	// The controllers for the storage system.
+	Controllers []DiscoveredController `json:"controllers"`
+	// The number of drives in the storage system.
+	DriveCount int `json:"driveCount"`
+	// The number of drive trays in the storage system.
+	TrayCount int `json:"trayCount"`
+	// True if trace logging for SYMbol calls to the storage system is enabled.
+	TraceEnabled bool `json:"traceEnabled"`
+	// The types of drive trays in the storage system.
+	Types string `json:"types"`
+	// The storage system model identifier.
+	Model string `json:"model"`
+	// The meta tags associated with the storage system.
+	MetaTags []KeyValue `json:"metaTags"`
+	// The size of the hot spare drives.
+	HotSpareSize string `json:"hotSpareSize"`
+	// The total capacity of all storage pools that have been provisioned into volumes.
+	UsedPoolSpace string `json:"usedPoolSpace"`
+	// The unused capacity of all storage pools.
+	FreePoolSpace string `json:"freePoolSpace"`
+	// The total capacity of drives not configured into storage pools.
+	UnconfiguredSpace string `json:"unconfiguredSpace"`
+	// The drive types present in the storage system.
+	DriveTypes []string `json:"driveTypes"`
+	// The number of hot spare drives that are not in use.
+	HostSpareCountInStandby int `json:"hostSpareCountInStandby"`
+	// The total number of hot spare drives in the storage system.
+	HotSpareCount int `json:"hotSpareCount"`
+	// The number of hot spare drives that are in use.
+	HostSparesUsed int `json:"hostSparesUsed"`
+	// True if resource provisioning is enabled by default when an SSD volume that supports this capability is
+	// created, false otherwise.
+	ResourceProvisionedVolumesEnabled bool `json:"resourceProvisionedVolumesEnabled"`
+	// The controller firmware version.
+	FwVersion string `json:"fwVersion"`
+	// The version identifier of the "application" component of the currently-installed array firmware.
+	AppVersion string `json:"appVersion"`
+	// The version identifier of the "boot" component of the currently-installed array firmware.
+	BootVersion string `json:"bootVersion"`
+	// The NVSRAM version.
+	NvsramVersion string `json:"nvsramVersion"`
+	// The chassis serial number.
+	ChassisSerialNumber string `json:"chassisSerialNumber"`
+	// The UTM (Universal Transport Mechanism) access volume. Used to convey configuration commands to the
+	// controllers.
+	AccessVolume AccessVolumeEx `json:"accessVolume"`
+	// The total capacity of drives not configured into storage pools for each drive type.
+	UnconfiguredSpaceByDriveType map[string]string `json:"unconfiguredSpaceByDriveType"`
+	// Selected media scan period, in days, 0 if media scan is suspended.
+	MediaScanPeriod int `json:"mediaScanPeriod"`
+	// True if one or more drive channel ports have been disabled by the controllers to protect against faulty
+	// expansion cabling.
+	DriveChannelPortDisabled bool `json:"driveChannelPortDisabled"`
+	// True if recovery mode is enabled on the storage system.
+	RecoveryModeEnabled bool `json:"recoveryModeEnabled"`
+	// True if auto load balance is enabled on the storage system.
+	AutoLoadBalancingEnabled bool `json:"autoLoadBalancingEnabled"`
+	// True if host connectivity reporting is enabled on the storage system.
+	HostConnectivityReportingEnabled bool `json:"hostConnectivityReportingEnabled"`
+	// True if remote mirroring is enabled on the storage system.
+	RemoteMirroringEnabled bool `json:"remoteMirroringEnabled"`
+	// The remote mirroring state on the storage system. Values: unknown, disabled, enabledLegacyRvm, enabledArvm,
+	// enabledAll.
+	FcRemoteMirroringState string `json:"fcRemoteMirroringState"`
+	// True if ASUP is enabled on the storage system.
+	AsupEnabled bool `json:"asupEnabled"`
+	// True if a drive security key has been set/created.
+	SecurityKeyEnabled bool `json:"securityKeyEnabled"`
+	// True if an External security key has been created.
+	ExternalKeyEnabled bool `json:"externalKeyEnabled"`
+	// The last time that the device was considered accessible.
+	LastContacted string `json:"lastContacted,omitempty"`
+	// The number of partitions calculated from the user's current mappings.
+	DefinedPartitionCount int `json:"definedPartitionCount,omitempty"`
+	// True if the redundancy mode of the system is simplex.
+	SimplexModeEnabled bool `json:"simplexModeEnabled,omitempty"`
+	// The management port types that are supported on the storage system. A type listed could be disabled even
+	// though it is supported.
+	SupportedManagementPorts []string `json:"supportedManagementPorts,omitempty"`
+	// True if the system has an invalid configuration preventing full functionality.
+	InvalidSystemConfig        bool   `json:"invalidSystemConfig,omitempty"`
+	FreePoolSpaceAsString      string `json:"freePoolSpaceAsString,omitempty"`
+	HotSpareSizeAsString       string `json:"hotSpareSizeAsString,omitempty"`
+	UnconfiguredSpaceAsStrings string `json:"unconfiguredSpaceAsStrings,omitempty"`
+	UsedPoolSpaceAsString      string `json:"usedPoolSpaceAsString,omitempty"`
+}
+
+// StorageSystemStats is the StorageSystemStats model.
+// Storage system performance statistics.
+type StorageSystemStats struct {
+	// End time for this collection as measured by the number of seconds since baseTime.
+	ObservedTime      string `json:"observedTime"`
+	ObservedTimeInMS  string `json:"observedTimeInMS"`
+	LastResetTime     string `json:"lastResetTime"`
+	LastResetTimeInMS string `json:"lastResetTimeInMS"`
+	ArrayID           string `json:"arrayId"`
+	ArrayWWN          string `json:"arrayWwn"`
+	// Hash of member controller ids.
+	MemberIdsHash string `json:"memberIdsHash"`
+	// Statistics for each controller.
+	ControllerStats []StorageSystemControllerStats `json:"controllerStats"`
+	// Total number of IO operations serviced by the controller.
+	TotalIopsServiced float64 `json:"totalIopsServiced"`
+	// Total number of Bytes serviced by the controller.
+	TotalBytesServiced float64 `json:"totalBytesServiced"`
+	// Total number of IO operations that hit cache.
+	CacheHitsIopsTotal float64 `json:"cacheHitsIopsTotal"`
+	// Total number of bytes that hit cache.
+	CacheHitsBytesTotal float64 `json:"cacheHitsBytesTotal"`
+	// Total number of IOs that are categorized as random.
+	RandomIosTotal float64 `json:"randomIosTotal"`
+	// Total number of Bytes that are categorized as random.
+	RandomBytesTotal float64 `json:"randomBytesTotal"`
+	// Total number of Read IO operations.
+	ReadIopsTotal float64 `json:"readIopsTotal"`
+	// Total number of Bytes read.
+	ReadBytesTotal float64 `json:"readBytesTotal"`
+	// Total number of Write IO operations.
+	WriteIopsTotal float64 `json:"writeIopsTotal"`
+	// Total number of Bytes written.
+	WriteBytesTotal float64 `json:"writeBytesTotal"`
+	// Total number of IO operations serviced by the controller that are characterized as cache mirroring related.
+	// If cache mirroring is disabled, this counter will not have any value.
+	MirrorIopsTotal float64 `json:"mirrorIopsTotal"`
+	// Total number of Bytes serviced by the controller that are characterized as cache mirroring related. If cache
+	// mirroring is disabled, this counter will not have any value.
+	MirrorBytesTotal float64 `json:"mirrorBytesTotal"`
+	// Bytes written that are categorized as Full stripe writes.
+	FullStripeWritesBytes float64 `json:"fullStripeWritesBytes"`
+	// Bytes transferred that are categorized as RAID 0 transfers.
+	Raid0BytesTransferred float64 `json:"raid0BytesTransferred"`
+	// Bytes transferred that are categorized as RAID 1 transfers.
+	Raid1BytesTransferred float64 `json:"raid1BytesTransferred"`
+	// Bytes transferred that are categorized as RAID 5 transfers.
+	Raid5BytesTransferred float64 `json:"raid5BytesTransferred"`
+	// Bytes transferred that are categorized as RAID 6 transfers.
+	Raid6BytesTransferred float64 `json:"raid6BytesTransferred"`
+	// Bytes transferred that are categorized as DDP transfers.
+	DdpBytesTransferred float64 `json:"ddpBytesTransferred"`
+	// Theoretical maximum possible Bytes per second under current load.
+	MaxPossibleBpsUnderCurrentLoad float64 `json:"maxPossibleBpsUnderCurrentLoad"`
+	// Theoretical maximum possible IO per second under current load.
+	MaxPossibleIopsUnderCurrentLoad float64 `json:"maxPossibleIopsUnderCurrentLoad"`
+}
+
+// StorageSystemTemperatureInformationResponse is the StorageSystemTemperatureInformationResponse model.
+// The storage system temperature information response
+type StorageSystemTemperatureInformationResponse struct {
+	DriveTemperatureInfo     DriveTemperatureInformation     `json:"driveTemperatureInfo,omitzero"`
+	EnclosureTemperatureInfo EnclosureTemperatureInformation `json:"enclosureTemperatureInfo,omitzero"`
+}
+
+// StoredPasswordValidationRequest is the StoredPasswordValidationRequest model.
+// Validation request for the stored password of the storage system.
+type StoredPasswordValidationRequest struct {
+	// The password to validate against the stored password of the storage system.
+	Password string `json:"password"`
+}
+
+// StoredPasswordValidationResponse is the StoredPasswordValidationResponse model.
+// Validation response for the stored password of the storage system.
+type StoredPasswordValidationResponse struct {
+	// True if the provided password matches the stored password of the storage system, otherwise false.
+	IsValidPassword bool `json:"isValidPassword,omitempty"`
+}
+
+// SubjectAlternateName is the SubjectAlternateName model.
+// A Subject Alternate entry for the x509 Certificate
+type SubjectAlternateName struct {
+	// Type of Subject Alternate Values: ip, dns.
+	SanType string `json:"sanType"`
+	// The value for the alternate
+	SanValue string `json:"sanValue"`
+}
+
+// SupportArtifact is the SupportArtifact model.
+// API object that includes information about a single support artifact.
+type SupportArtifact struct {
+	// Relative path to artifact.
+	FileName string `json:"fileName"`
+	// Timestamp of last modification.
+	LastModifiedTime string `json:"lastModifiedTime"`
+	// Size of artifact in bytes.
+	Size string `json:"size"`
+}
+
+// SupportArtifacts is the SupportArtifacts model.
+// API object that includes information about all support artifacts of a single type.
+type SupportArtifacts struct {
+	// Type of support artifacts. Values: recoveryProfile, supportBundle, dbCapture, ioStatistics, driveLogs, other.
+	Type string `json:"type"`
+	// Array of support artifacts of the specified type.
+	Artifacts []SupportArtifact `json:"artifacts"`
+}
+
+// SupportCRU is the SupportCRU model.
+type SupportCRU struct {
+	// The reference for this physical support CRU.
+	SupportCRURef string `json:"supportCRURef,omitempty"`
+	// The operational status of the support CRU. Values: unknown, optimal, failed, removed, noinput,
+	// incorrectConfig.
+	Status string `json:"status,omitempty"`
+	// The physical location of the support CRU. The parent reference in Location identifies the tray containing the
+	// CRU, and the position field is the parent-relative/like-component relative slot number of the CRU, starting
+	// at one.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// VPD manufacture date.
+	ManufacturerDate string `json:"manufacturerDate,omitempty"`
+	// VPD vendor name.
+	VendorName string `json:"vendorName,omitempty"`
+	// VPD part number.
+	VendorPN string `json:"vendorPN,omitempty"`
+	// VPD serial number.
+	VendorSN string `json:"vendorSN,omitempty"`
+	// VPD field replaceable unit type.
+	FruType string `json:"fruType,omitempty"`
+	// This field has been deprecated and should not be used.
+	ReadyToRemove bool `json:"readyToRemove,omitempty"`
+	// This field has been deprecated and should not be used.
+	RtrAttributes RTRAttributes `json:"rtrAttributes,omitzero"`
+	// A variable-length list of the types of components that occupy the support CRU.
+	ConfiguredComponents []string `json:"configuredComponents,omitempty"`
+	// The type of this support CRU (e.g., "power-fan" or "battery") Values: unknown, powerFan, battery, fan,
+	// powerSupply.
+	Type string `json:"type,omitempty"`
+	// The repair policy for the support CRU component.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+	ID           string       `json:"id,omitempty"`
+}
+
+// SupportDataRequest is the SupportDataRequest model.
+type SupportDataRequest struct {
+	// The type of the file to be retrieved. Values: driveData, traceBuffers, ioPathStatistics, supportBundle,
+	// coreDump, storageArrayConfiguration, deviceProfile, firmwareInventory, unreadableSectors,
+	// iscsiSessionsConnections, dbmBackup.
+	Type string `json:"type"`
+	// Specify the name of the file, which will be utilized if it doesn't already exist.
+	Filename string `json:"filename"`
+	// Specifies the controllers to retrieve data from for type=traceBuffers
+	TraceSpec TraceBufferSpec `json:"traceSpec,omitzero"`
+	// Specifies the drives to retrieve logs for for type=driveData
+	DriveSpec DriveRefList `json:"driveSpec,omitzero"`
+	// Specifies the settings for type=storageArrayConfiguration
+	SaveConfigSpec SaveConfigSpec `json:"saveConfigSpec,omitzero"`
+	// Specifies the support bundle type for type=supportBundle, default is complete if not specified Values: basic,
+	// weekly, event, daily, complete, onDemand, all, coreDump, dbmFile, traceBuffers.
+	SupportBundleSpec string `json:"supportBundleSpec,omitempty"`
+	// Specifies the support bundle type for type=dbmBackup
+	DbmBackupSpec DbmBackupSpec `json:"dbmBackupSpec,omitzero"`
+}
+
+// SupportDataResponse is the SupportDataResponse model.
+type SupportDataResponse struct {
+	// A unique identifier for the request
+	RequestID string `json:"requestId,omitempty"`
+	// The progress of the operation.
+	Progress Progress `json:"progress,omitzero"`
+	// The size of the support file
+	SizeInBytes string `json:"sizeInBytes,omitempty"`
+	// The type of support data being collected. Values: driveData, traceBuffers, ioPathStatistics, supportBundle,
+	// coreDump, storageArrayConfiguration, deviceProfile, firmwareInventory, unreadableSectors,
+	// iscsiSessionsConnections, dbmBackup.
+	Type string `json:"type,omitempty"`
+	// The name of the file to be retrieved.
+	Filename string `json:"filename,omitempty"`
+}
+
+// SymbolPortChangeEvent is the SymbolPortChangeEvent model.
+type SymbolPortChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType       string `json:"eventType"`
+	StorageSystemID string `json:"storageSystemId"`
+}
+
+// SymbolPortRequest is the SymbolPortRequest model.
+type SymbolPortRequest struct {
+	// Enable the SYMbol port
+	Enable bool `json:"enable"`
+}
+
+// SymbolPortResponse is the SymbolPortResponse model.
+type SymbolPortResponse struct {
+	// If the symbol port is enabled
+	SymbolPortEnabled bool `json:"symbolPortEnabled"`
+}
+
+// SysReplicationSystemChangeEvent is the SysReplicationSystemChangeEvent model.
+type SysReplicationSystemChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType      string   `json:"eventType"`
+	JobID          string   `json:"jobId"`
+	JobState       string   `json:"jobState"`
+	TotalSystems   int      `json:"totalSystems"`
+	FailedSystems  int      `json:"failedSystems"`
+	ConfigElements []string `json:"configElements"`
+}
+
+// SyslogComponent is the SyslogComponent model.
+// Syslog server component configuration.
+type SyslogComponent struct {
+	// Component type Values: auditLog.
+	Type string `json:"type"`
+}
+
+// SyslogConfiguration is the SyslogConfiguration model.
+type SyslogConfiguration struct {
+	// This field is set to true if the writing of MEL events to a syslog server is enabled; otherwise it is false.
+	SyslogEnabled bool `json:"syslogEnabled,omitempty"`
+	// This field holds the UDP port number of the syslog server. The default value of this field is
+	// SYSLOG_DEFAULT_UDP_PORT.
+	SyslogServerPortNumber int `json:"syslogServerPortNumber,omitempty"`
+	// This field holds the IPVx address of the syslog server. The default value of this field is zero (0.0.0.0).
+	SyslogServerIPAddress IPVxAddress `json:"syslogServerIpAddress,omitzero"`
+}
+
+// SyslogServer is the SyslogServer model.
+// Syslog server configuration.
+type SyslogServer struct {
+	// Syslog server configuration id
+	ID string `json:"id"`
+	// Fully qualified name or IP address of receiver
+	ServerAddress string `json:"serverAddress"`
+	// Port number
+	Port int `json:"port"`
+	// Transmission protocol Values: udp, tcp, tls.
+	Protocol string `json:"protocol"`
+	// Array of associated components
+	Components []SyslogComponent `json:"components"`
+}
+
+// SyslogServerRequest is the SyslogServerRequest model.
+// Create server configuration.
+type SyslogServerRequest struct {
+	// Fully qualified name or IP address
+	ServerAddress string `json:"serverAddress"`
+	// Port number.
+	Port *int `json:"port,omitempty"`
+	// Transmission protocol. Values: udp, tcp, tls.
+	Protocol string `json:"protocol,omitempty"`
+	// Associated components
+	Components []SyslogComponent `json:"components"`
+}
+
+// SyslogServerUpdateRequest is the SyslogServerUpdateRequest model.
+// Update syslog server configuration.
+type SyslogServerUpdateRequest struct {
+	// Fully qualified name or IP address
+	ServerAddress string `json:"serverAddress,omitempty"`
+	// Port number
+	Port *int `json:"port,omitempty"`
+	// Transmission protocol Values: udp, tcp, tls.
+	Protocol string `json:"protocol,omitempty"`
+	// Associated components
+	Components []SyslogComponent `json:"components,omitempty"`
+}
+
+// SystemCacheSettings is the SystemCacheSettings model.
+// Cache settings that apply at the system level
+type SystemCacheSettings struct {
+	// The characteristic size, in bytes, of a cache block
+	BlockSize int `json:"blockSize"`
+	// Specifies the level of fullness of dirty data in the controller's write cache which, when reached, will cause
+	// the controller to begin flushing data from cache to disk. This value is specified as a percentage, in the
+	// range 0-100, of the overall write cache capacity of the controller. After flushing begins, it will continue
+	// until enough data has been flushed to drop the percentage of dirty data to or below the value specified in
+	// the cacheFlushStop parameter.
+	FlushStart int `json:"flushStart"`
+	// Specifies the amount of cache data that will be flushed to disk when a cache flush operation is initiated.
+	// This value is specified as an integer in the range 0-100 that represents the percentage of the controller's
+	// write cache data that can remain in the dirty, or unflushed, state. When demand flush operations are started,
+	// data will be flushed from cache to disk until the overall amount of dirty data drops to or below the
+	// percentage specified by this value.
+	FlushStop int `json:"flushStop"`
+	// True if checking of protection information during cache mirroring is enabled, false otherwise.
+	MirroringProtectionInformationEnabled bool `json:"mirroringProtectionInformationEnabled"`
+}
+
+// SystemConfig is the SystemConfig model.
+// System configuration data
+type SystemConfig struct {
+	// List of volume groups
+	VolumeGroups []VolumeGroupConfig `json:"volumeGroups,omitempty"`
+	// List of pools
+	Pools []PoolConfig `json:"pools,omitempty"`
+	// List of volumes
+	Volumes []VolumeConfig `json:"volumes,omitempty"`
+	// List of hot spare drives
+	HotSpareDrives []DriveLocation `json:"hotSpareDrives,omitempty"`
+	// System settings
+	SystemSettings SystemSettings `json:"systemSettings,omitzero"`
+}
+
+// SystemConfigCapabilities is the SystemConfigCapabilities model.
+// List of system configuration operations supported on this system. Note that all is never returned in the list as
+// it is not an operation in itself but rather a collection of all supported operations.
+type SystemConfigCapabilities struct {
+	// The list of supported capabilities
+	Capabilities []string `json:"capabilities"`
+}
+
+// SystemConfigStartRequest is the SystemConfigStartRequest model.
+type SystemConfigStartRequest struct {
+	// List of volume groups
+	VolumeGroups []VolumeGroupConfig `json:"volumeGroups,omitempty"`
+	// List of pools
+	Pools []PoolConfig `json:"pools,omitempty"`
+	// List of volumes
+	Volumes []VolumeConfig `json:"volumes,omitempty"`
+	// List of hot spare drives
+	HotSpareDrives []DriveLocation `json:"hotSpareDrives,omitempty"`
+	// System settings
+	SystemSettings SystemSettings `json:"systemSettings,omitzero"`
+	// The identifier to use for the job. If not provided, an identifier will be generated.
+	JobID string `json:"jobId,omitempty"`
+}
+
+// SystemConfigStartResponse is the SystemConfigStartResponse model.
+type SystemConfigStartResponse struct {
+	// The job identifier
+	JobID string `json:"jobId"`
+}
+
+// SystemConfigStatus is the SystemConfigStatus model.
+// Status of an in progress array configuration operation
+type SystemConfigStatus struct {
+	// Reports whether a system config operation is currently in progress
+	OperationInProgress bool `json:"operationInProgress"`
+	// Information about an in progress replication job
+	Job InProgressJobInfo `json:"job,omitzero"`
+}
+
+// SystemInfo is the SystemInfo model.
+// Information describing the system being operated on.
+type SystemInfo struct {
+	// System Id of the array being upgraded.
+	SystemID string `json:"systemId,omitempty"`
+	// If false, do not proceed if health check fails with a non-fatal error.
+	AllowNonOptimalActivation bool `json:"allowNonOptimalActivation,omitempty"`
+}
+
+// SystemReplicationFailureEntry is the SystemReplicationFailureEntry model.
+type SystemReplicationFailureEntry struct {
+	// The time that this step started.
+	StartTime string `json:"startTime,omitempty"`
+	// The Id of the system that had the failure.
+	SystemID string `json:"systemId,omitempty"`
+	// The name of the system that had the failure.
+	SystemName string `json:"systemName,omitempty"`
+	// The sequence number of the operation that was performed on the system.
+	SequenceNum int `json:"sequenceNum,omitempty"`
+	// Job step type enumeration. Values: createVolumeGroup, createPool, createVolume, configureVolumeSettings,
+	// configureVolumeCacheSettings, configureVolumeMediaScanSettings, assignHotSpare, configureSystemCacheSettings,
+	// configureSystemCacheMirroringProtectionSettings, configureSystemMediaScanSettings,
+	// configureSystemAutoLoadBalancingSettings, undefinedStep.
+	JobStepType string `json:"jobStepType,omitempty"`
+	// Text description of the operation.
+	JobStepTypeText string `json:"jobStepTypeText,omitempty"`
+	// The duration that the step took in milliseconds.
+	StepDuration string `json:"stepDuration,omitempty"`
+	// The name of the object that was operated on.
+	ObjectName string `json:"objectName,omitempty"`
+	// A text description of the result status of the operation.
+	StatusDesc string `json:"statusDesc,omitempty"`
+	// The status code of the operation.
+	StatusCode string `json:"statusCode,omitempty"`
+	// The status code source type enumeration. Values: none, internal, symbol, http.
+	StatusCodeSource string `json:"statusCodeSource,omitempty"`
+}
+
+// SystemReplicationRequest is the SystemReplicationRequest model.
+type SystemReplicationRequest struct {
+	// The ID of the source system.
+	SourceSystemID string `json:"sourceSystemId,omitempty"`
+	// The IDs of the target systems.
+	TargetSystemIds []string `json:"targetSystemIds,omitempty"`
+	// A list of input document elements to apply to the target systems.
+	ConfigurationElements []string `json:"configurationElements,omitempty"`
+}
+
+// SystemReplicationResponseData is the SystemReplicationResponseData model.
+type SystemReplicationResponseData struct {
+	// The ID for this system replication job.
+	JobID string `json:"jobId,omitempty"`
+	// The ID of the source system.
+	SourceSystemID string `json:"sourceSystemId,omitempty"`
+	// Name of the source system.
+	SourceSystemName string `json:"sourceSystemName,omitempty"`
+	// The IDs of the target systems.
+	TargetSystemIds []string `json:"targetSystemIds,omitempty"`
+	// The current or final state of the job. Values: running, complete, failed, cancelled, timeout, notStarted.
+	State string `json:"state,omitempty"`
+	// A text reason that the overall process failed. Only set if the replication process failed, not if an
+	// individual array did not complete successfully.
+	ProcessFailureReason string `json:"processFailureReason,omitempty"`
+	// The overall percent complete for this job.
+	PercentComplete int `json:"percentComplete,omitempty"`
+	// The number of systems that incurred failures during processing.
+	SystemFailureCount int `json:"systemFailureCount,omitempty"`
+	// The start time for this job.
+	StartTime string `json:"startTime,omitempty"`
+	// Total runtime for the job in seconds. If the job is still in progress, depicts time thus far.
+	ElapsedTime string `json:"elapsedTime,omitempty"`
+	// The source input document describing the system that was replicated.
+	SourceDocument string `json:"sourceDocument,omitempty"`
+	// List of the types of configuration elements applied to the target systems.
+	ConfigTypes []string `json:"configTypes,omitempty"`
+	// A list of system failure records. Note, these values are only supplied if detailed results are requested.
+	FailureEntries []SystemReplicationFailureEntry `json:"failureEntries,omitempty"`
+}
+
+// SystemSettings is the SystemSettings model.
+// Storage system settings
+type SystemSettings struct {
+	// System cache settings
+	CacheSettings SystemCacheSettings `json:"cacheSettings,omitzero"`
+	// Media scan period
+	MediaScanPeriod int `json:"mediaScanPeriod,omitempty"`
+	// Indicates whether auto load balancing is enabled
+	AutoLoadBalancingEnabled bool `json:"autoLoadBalancingEnabled,omitempty"`
+}
+
+// TagEvent is the TagEvent model.
+type TagEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType string `json:"eventType"`
+	// List of objects added. Only certain objects that have rest endpoints will be represented in this list. See
+	// Event ObjectTypes for types of objects that have events
+	AddedObjects []EventObjectIdentifier `json:"addedObjects"`
+	// List of objects updated. Only certain objects that have rest endpoints will be represented in this list. See
+	// Event ObjectTypes for types of objects that have events
+	ModifiedObjects []EventObjectIdentifier `json:"modifiedObjects"`
+	// List of objects deleted. Only certain objects that have rest endpoints will be represented in this list. See
+	// Event ObjectTypes for types of objects that have events
+	DeletedObjects []EventObjectIdentifier `json:"deletedObjects"`
+}
+
+// Target is the Target model.
+type Target struct {
+	// The reference that uniquely identifies an instance of this object.
+	TargetRef string `json:"targetRef,omitempty"`
+	// The unique node name associated with the target.
+	NodeName ScsiNodeName `json:"nodeName,omitzero"`
+	// An additional name that can be assigned to a target, which is usually "friendlier" than the node name.
+	Alias ScsiAlias `json:"alias,omitzero"`
+	// The authentication methods that have been configured for the target. Associated secrets are not exposed.
+	// instead the type field of the EncryptedPassword is set to ENCRYPT_UNSPECIFIED.
+	ConfiguredAuthMethods AuthenticationBundle `json:"configuredAuthMethods,omitzero"`
+	// The set of configured (i.e., either in-use or listening for connections) portals associated with this target.
+	Portals []TargetPortal `json:"portals,omitempty"`
+}
+
+// TargetLocation is the TargetLocation model.
+type TargetLocation struct {
+	// The iSCSI node name of the target, left-truncated if necessary. (Left-truncation preserves the part of the
+	// name most likely to vary.)
+	TargetName string `json:"targetName,omitempty"`
+}
+
+// TargetPortal is the TargetPortal model.
+type TargetPortal struct {
+	// A value identifying the portal group to which this portal belongs.
+	GroupTag int `json:"groupTag,omitempty"`
+	// The IP address of the portal.
+	IPAddress IPVxAddress `json:"ipAddress,omitzero"`
+	// The number of the TCP port on which the target listens for incoming connections.
+	TCPListenPort int `json:"tcpListenPort,omitempty"`
+}
+
+// ThermalSensor is the ThermalSensor model.
+type ThermalSensor struct {
+	// The reference for this physical thermal sensor.
+	ThermalSensorRef string `json:"thermalSensorRef,omitempty"`
+	// The operational status of the thermal sensor. Values: optimal, nominalTempExceed, maxTempExceed, removed,
+	// unknown.
+	Status string `json:"status,omitempty"`
+	// The physical location of the thermal sensor. The parent reference in Location identifies the CRU that
+	// physically houses the thermal sensor, and the position field is the parent-relative/like-component relative
+	// number of the thermal sensor, starting at one.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	Reserved1        string   `json:"reserved1,omitempty"`
+	Reserved2        string   `json:"reserved2,omitempty"`
+	// This field has been deprecated and should not be used.
+	RtrAttributes RTRAttributes `json:"rtrAttributes,omitzero"`
+	// The repair policy for the thermal sensor component.
+	RepairPolicy RepairPolicy `json:"repairPolicy,omitzero"`
+	ID           string       `json:"id,omitempty"`
+}
+
+// ThermalSensorInfoData is the ThermalSensorInfoData model.
+// The thermal sensor information data
+type ThermalSensorInfoData struct {
+	// The thermal sensor id
+	ID string `json:"id,omitempty"`
+	// The thermal sensor label
+	Label string `json:"label,omitempty"`
+	// The current temperature in degrees Celsius
+	CurrentTempInCelsius int `json:"currentTempInCelsius,omitempty"`
+}
+
+// ThinVolume is the ThinVolume model.
+type ThinVolume struct {
+	// The volumeHandle (SSID) for this volume.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// The World wide name of the volume.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The name of the volume.
+	Label string `json:"label,omitempty"`
+	// The allocation cluster size for the Expandable Repository Volume.
+	AllocationGranularity int `json:"allocationGranularity,omitempty"`
+	// The virtual capacity of the volume in bytes.
+	Capacity string `json:"capacity,omitempty"`
+	// Importance of fixing the volume if failed (0-4).
+	ReconPriority int `json:"reconPriority,omitempty"`
+	// The reference (key) for this volume.
+	VolumeRef string `json:"volumeRef,omitempty"`
+	// The operational status of the volume. Values: unknown, optimal, full, overThresh, failed, initializing.
+	Status string `json:"status,omitempty"`
+	// The Expandable Repository Volume underlying this Thin Volume.
+	RepositoryRef string `json:"repositoryRef,omitempty"`
+	// The controller that currently manages the volume.
+	CurrentManager string `json:"currentManager,omitempty"`
+	// The controller that should manage the volume.
+	PreferredManager string `json:"preferredManager,omitempty"`
+	// Operations allowed on this volume.
+	Perms VolumePerms `json:"perms,omitzero"`
+	// For use by management client.
+	MgmtClientAttribute int `json:"mgmtClientAttribute,omitempty"`
+	// If true, verify RAID redundancy on read operations.
+	PreReadRedundancyCheckEnabled bool `json:"preReadRedundancyCheckEnabled,omitempty"`
+	// The protection type of the volume. Values: type0Protection, type1Protection, type2Protection,
+	// type3Protection.
+	ProtectionType string `json:"protectionType,omitempty"`
+	// True if application tag is owned by the storage array.
+	ApplicationTagOwned bool `json:"applicationTagOwned,omitempty"`
+	// The maximum virtual capacity to which this thin volume can grow (in bytes).
+	MaxVirtualCapacity string `json:"maxVirtualCapacity,omitempty"`
+	// The initial provisioned capacity for the Expandable Repository Volume for this thin volume (in bytes).
+	InitialProvisionedCapacity string `json:"initialProvisionedCapacity,omitempty"`
+	// The current provisioned capacity of the Expandable Repository Volume (in bytes).
+	CurrentProvisionedCapacity string `json:"currentProvisionedCapacity,omitempty"`
+	// The maximum capacity of the Expandable Repository Volume (in bytes).
+	ProvisionedCapacityQuota string `json:"provisionedCapacityQuota,omitempty"`
+	// The percent value at which an alert is generated.
+	GrowthAlertThreshold int `json:"growthAlertThreshold,omitempty"`
+	// The automatic Expandable Repository Volume expansion policy. Values: unknown, manual, automatic.
+	ExpansionPolicy string `json:"expansionPolicy,omitempty"`
+	// The cache parameters of the underlying repository.
+	VolumeCache VolumeCache `json:"volumeCache,omitzero"`
+	// The thin volume is offline due to the associated repository volume state.
+	Offline bool `json:"offline,omitempty"`
+	// Indicates how the thin volume is reported on the SCSI interface. Values: unknown, asThick, asThin.
+	ReportingPolicy string `json:"reportingPolicy,omitempty"`
+	// A variable-length opaque field that provides the volume's extended unique identification value.
+	ExtendedUniqueIdentifier string `json:"extendedUniqueIdentifier,omitempty"`
+	ID                       string `json:"id,omitempty"`
+}
+
+// ThinVolumeCacheSettings is the ThinVolumeCacheSettings model.
+type ThinVolumeCacheSettings struct {
+	// Indicates whether read caching should be enabled for the volume.
+	ReadCacheEnable bool `json:"readCacheEnable,omitempty"`
+	// Indicates whether write-back caching should be enabled for the volume.
+	WriteCacheEnable bool `json:"writeCacheEnable,omitempty"`
+	// The cache flush modifier value, which is used to specify the maximum amount of time that dirty data for this
+	// volume may be retained in the controller's write cache prior to being flushed to disk. Values:
+	// flushImmediate, flush250Msec, flush500Msec, flush750Msec, flush1Sec, flush1500Msec, flush2Sec, flush5Sec,
+	// flush10Sec, flush20Sec, flush60Sec, flush120Sec, flush300Sec, flush1200Sec, flush3600Sec, flushInfinite.
+	CacheFlushModifier string `json:"cacheFlushModifier,omitempty"`
+}
+
+// ThinVolumeCapacityProvisioningDetails is the ThinVolumeCapacityProvisioningDetails model.
+type ThinVolumeCapacityProvisioningDetails struct {
+	// The maximum capacity to which the Expandable Repository Volume can grow (in bytes).
+	CapacityQuota string `json:"capacityQuota,omitempty"`
+	// The alert threshold percent value for the Expandable Repository Volume.
+	GrowthAlertThreshold int `json:"growthAlertThreshold,omitempty"`
+	// The expansion policy for the Expandable Repository Volume. Values: unknown, manual, automatic.
+	RepositoryExpansionPolicy string `json:"repositoryExpansionPolicy,omitempty"`
+}
+
+// ThinVolumeCreateRequest is the ThinVolumeCreateRequest model.
+// Creates a Thin Volume.
+type ThinVolumeCreateRequest struct {
+	// The identifier of the storage pool from which the volume will be allocated.
+	PoolID string `json:"poolId"`
+	// The user-label to assign to the new volume.
+	Name string `json:"name"`
+	// Unit for "size" Values: bytes, b, kb, mb, gb, tb, pb, eb, zb, yb.
+	SizeUnit string `json:"sizeUnit"`
+	// Initial virtual capacity of the volume in units (See sizeUnit).
+	VirtualSize string `json:"virtualSize"`
+	// Number of units (See sizeUnit) to make the repository volume, which is the backing for the thin volume. This
+	// value must align with the allocation granularity of the disk pool. If it does not, it will be automatically
+	// adjusted upwards to align.
+	RepositorySize string `json:"repositorySize"`
+	// Maximum size (in sizeUnits) to which the thin volume repository can grow.
+	MaximumRepositorySize string `json:"maximumRepositorySize"`
+	// Set the preferred owning controller. By default this field will alternate between available controllers
+	OwningControllerID string `json:"owningControllerId,omitempty"`
+	// The repository utilization warning threshold (in percent). This parameter is only required for
+	// thin-provisioned volumes. Default: 95
+	GrowthAlertThreshold *int `json:"growthAlertThreshold,omitempty"`
+	// Create the default volume mapping. Defaults to false.
+	CreateDefaultMapping *bool `json:"createDefaultMapping,omitempty"`
+	// Thin Volume expansion policy. If automatic, the thin volume will be expanded automatically when capacity is
+	// exceeded, if manual, the volume must be expanded manually. Defaults to automatic. Values: unknown, manual,
+	// automatic.
+	ExpansionPolicy      string `json:"expansionPolicy,omitempty"`
+	DataAssuranceEnabled *bool  `json:"dataAssuranceEnabled,omitempty"`
+	// Optional array of Meta Data tags for the volume.
+	MetaTags []VolumeMetadataItem `json:"metaTags,omitempty"`
+}
+
+// ThinVolumeDefaultsResponse is the ThinVolumeDefaultsResponse model.
+// The thin volume defaults.
+type ThinVolumeDefaultsResponse struct {
+	// The maximum provisioned capacity in bytes.
+	MaxProvisionedCapacityInBytes string `json:"maxProvisionedCapacityInBytes"`
+	// The minimum virtual capacity in bytes.
+	MinVirtualCapacityInBytes string `json:"minVirtualCapacityInBytes"`
+	// The maximum virtual capacity in bytes.
+	MaxVirtualCapacityInBytes string `json:"maxVirtualCapacityInBytes"`
+	// The minimum alert threshold as a percentage.
+	MinAlertThreshold int `json:"minAlertThreshold"`
+	// The default alert threshold as a percentage.
+	DefaultAlertThreshold int `json:"defaultAlertThreshold"`
+}
+
+// ThinVolumeEx is the ThinVolumeEx model.
+type ThinVolumeEx struct {
+	// The volumeHandle (SSID) for this volume.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// The World wide name of the volume.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The name of the volume.
+	Label string `json:"label,omitempty"`
+	// The allocation cluster size for the Expandable Repository Volume.
+	AllocationGranularity int `json:"allocationGranularity,omitempty"`
+	// The virtual capacity of the volume in bytes.
+	Capacity string `json:"capacity,omitempty"`
+	// Importance of fixing the volume if failed (0-4).
+	ReconPriority int `json:"reconPriority,omitempty"`
+	// The reference (key) for this volume.
+	VolumeRef string `json:"volumeRef,omitempty"`
+	// The operational status of the volume. Values: unknown, optimal, full, overThresh, failed, initializing.
+	Status string `json:"status,omitempty"`
+	// The Expandable Repository Volume underlying this Thin Volume.
+	RepositoryRef string `json:"repositoryRef,omitempty"`
+	// The controller that currently manages the volume.
+	CurrentManager string `json:"currentManager,omitempty"`
+	// The controller that should manage the volume.
+	PreferredManager string `json:"preferredManager,omitempty"`
+	// Operations allowed on this volume.
+	Perms VolumePerms `json:"perms,omitzero"`
+	// For use by management client.
+	MgmtClientAttribute int `json:"mgmtClientAttribute,omitempty"`
+	// If true, verify RAID redundancy on read operations.
+	PreReadRedundancyCheckEnabled bool `json:"preReadRedundancyCheckEnabled,omitempty"`
+	// The protection type of the volume. Values: type0Protection, type1Protection, type2Protection,
+	// type3Protection.
+	ProtectionType string `json:"protectionType,omitempty"`
+	// True if application tag is owned by the storage array.
+	ApplicationTagOwned bool `json:"applicationTagOwned,omitempty"`
+	// The maximum virtual capacity to which this thin volume can grow (in bytes).
+	MaxVirtualCapacity string `json:"maxVirtualCapacity,omitempty"`
+	// The initial provisioned capacity for the Expandable Repository Volume for this thin volume (in bytes).
+	InitialProvisionedCapacity string `json:"initialProvisionedCapacity,omitempty"`
+	// The current provisioned capacity of the Expandable Repository Volume (in bytes).
+	CurrentProvisionedCapacity string `json:"currentProvisionedCapacity,omitempty"`
+	// The maximum capacity of the Expandable Repository Volume (in bytes).
+	ProvisionedCapacityQuota string `json:"provisionedCapacityQuota,omitempty"`
+	// The percent value at which an alert is generated.
+	GrowthAlertThreshold int `json:"growthAlertThreshold,omitempty"`
+	// The automatic Expandable Repository Volume expansion policy. Values: unknown, manual, automatic.
+	ExpansionPolicy string `json:"expansionPolicy,omitempty"`
+	// The cache parameters of the underlying repository.
+	VolumeCache VolumeCache `json:"volumeCache,omitzero"`
+	// The thin volume is offline due to the associated repository volume state.
+	Offline bool `json:"offline,omitempty"`
+	// Indicates how the thin volume is reported on the SCSI interface. Values: unknown, asThick, asThin.
+	ReportingPolicy string `json:"reportingPolicy,omitempty"`
+	// A variable-length opaque field that provides the volume's extended unique identification value.
+	ExtendedUniqueIdentifier string `json:"extendedUniqueIdentifier,omitempty"`
+	VolumeFull               bool   `json:"volumeFull,omitempty"`
+	VolumeGroupRef           string `json:"volumeGroupRef,omitempty"`
+	// This reports the physical block size (in bytes) used when sending I/O to the underlying drives.
+	BlkSizePhysical int `json:"blkSizePhysical,omitempty"`
+	// The RAID level for which this volume is configured. Values: raidUnsupported, raidAll, raid0, raid1, raid3,
+	// raid5, raid6, raidDiskPool, raid1p.
+	RaidLevel        string `json:"raidLevel,omitempty"`
+	BlkSize          int    `json:"blkSize,omitempty"`
+	StorageVolumeRef string `json:"storageVolumeRef,omitempty"`
+	VolumeCopyTarget bool   `json:"volumeCopyTarget,omitempty"`
+	VolumeCopySource bool   `json:"volumeCopySource,omitempty"`
+	PitBaseVolume    bool   `json:"pitBaseVolume,omitempty"`
+	// True if the volume is a target in an Asynchronous Mirror relationship.
+	AsyncMirrorTarget bool `json:"asyncMirrorTarget,omitempty"`
+	// True if the volume is a source in an Asynchronous Mirror relationship.
+	AsyncMirrorSource bool `json:"asyncMirrorSource,omitempty"`
+	// True if the volume is a source (primary), in an Remote Volume Mirror relationship.
+	RemoteMirrorSource bool `json:"remoteMirrorSource,omitempty"`
+	// True if the volume is a target (secondary), in an Remote Volume Mirror relationship.
+	RemoteMirrorTarget bool `json:"remoteMirrorTarget,omitempty"`
+	// True if this volume is added to a flashCache.
+	FlashCached bool                  `json:"flashCached,omitempty"`
+	MediaScan   VolumeMediaScanParams `json:"mediaScan,omitzero"`
+	// Metadata associated with the volume
+	Metadata      []VolumeMetadataItem `json:"metadata,omitempty"`
+	DataAssurance bool                 `json:"dataAssurance,omitempty"`
+	Name          string               `json:"name,omitempty"`
+	ID            string               `json:"id,omitempty"`
+	// Values: unknown, volume, pool, host, lunMapping, hostGroup, thinVolume, drive, volumeCopy, pit, pitView,
+	// snapshotGroup, snapshot, accessVolume, legacySnapshot, hostType, metadataTag, managementUrl, folder,
+	// asyncMirrorGroup, asyncMirrorGroupMember, asyncMirrorGroupIncompleteMember, consistencyGroup,
+	// consistencyGroupView, fan, battery, storageSystem, controller, powerSupply, minihub, esm, drawer, hostBoard,
+	// interconnectCRU, cacheBackupDevice, tray, supportCRU, hostPort, initiator, snapshotSchedule, thermalSensor,
+	// sfp, flashCache, featureAttribute, featureState, lockKeyId, remoteVolume, mirrorVolume, vaultMirrorVolume,
+	// vaultMirrorGroup, metadataVolume, sasPort, sasExpander, channelPort, speedNegError, snmpAgentBundle,
+	// stagedFirmware, workload, ekmsCertRevocatSettings, databaseMetadata, directoryServicesDomain,
+	// kmsCertificateExpirationDetails, externalKMSEndpoint, notOnPreferredPath, remoteStorageVolume, remoteStorage,
+	// alarm.
+	ObjectType          string `json:"objectType,omitempty"`
+	CurrentControllerID string `json:"currentControllerId,omitempty"`
+	WWN                 string `json:"wwn,omitempty"`
+	// The volume-specific caching parameters currently in effect for the volume.
+	CacheSettings         VolumeCache  `json:"cacheSettings"`
+	DiskPool              bool         `json:"diskPool,omitempty"`
+	PreferredControllerID string       `json:"preferredControllerId,omitempty"`
+	SegmentSize           int          `json:"segmentSize,omitempty"`
+	TotalSizeInBytes      string       `json:"totalSizeInBytes,omitempty"`
+	ThinProvisioned       bool         `json:"thinProvisioned,omitempty"`
+	Mapped                bool         `json:"mapped"`
+	ListOfMappings        []LUNMapping `json:"listOfMappings,omitempty"`
+	OnlineVolumeCopy      bool         `json:"onlineVolumeCopy,omitempty"`
+}
+
+// ThinVolumeExpansionRequest is the ThinVolumeExpansionRequest model.
+// Expands a Thin Volume.
+type ThinVolumeExpansionRequest struct {
+	// Unit for "size" Values: bytes, b, kb, mb, gb, tb, pb, eb, zb, yb.
+	SizeUnit string `json:"sizeUnit,omitempty"`
+	// The new virtual capacity for the thin volume. New virtual capacity cannot be smaller than original value!
+	NewVirtualSize string `json:"newVirtualSize,omitempty"`
+	// The new repository capacity of the thin volume. If expansion policy is Automatic, this will increase the
+	// maximum repository capacity. If the expansion policy is Manual, this will increase the capacity of the
+	// repository directly.
+	NewRepositorySize string `json:"newRepositorySize,omitempty"`
+}
+
+// ThinVolumeUpdateRequest is the ThinVolumeUpdateRequest model.
+// Updates a Thin Volume.
+type ThinVolumeUpdateRequest struct {
+	// The user-label to assign to the volume.
+	Name string `json:"name,omitempty"`
+	// The repository utilization warning threshold (in percent).
+	GrowthAlertThreshold *int `json:"growthAlertThreshold,omitempty"`
+	// If true, add the volume to the flashCache if one is defined. If false, remove from the flashCache if one
+	// exists.
+	FlashCache *bool `json:"flashCache,omitempty"`
+	// Optional array of Meta Data tags for the volume.
+	MetaTags []VolumeMetadataItem `json:"metaTags,omitempty"`
+	// Thin Volume expansion policy. If automatic, the thin volume will be expanded automatically when capacity is
+	// exceeded, if manual, the volume must be expanded manually. Values: unknown, manual, automatic.
+	ExpansionPolicy string `json:"expansionPolicy,omitempty"`
+	// Set the preferred owning controller
+	OwningControllerID string `json:"owningControllerId,omitempty"`
+	// Configure cache settings for the volume
+	CacheSettings ThinVolumeCacheSettings `json:"cacheSettings,omitzero"`
+	// Configure scan settings with regard to the controller's background media scan operation
+	ScanSettings VolumeMediaScanParams `json:"scanSettings,omitzero"`
+}
+
+// TimeZoneDescription is the TimeZoneDescription model.
+type TimeZoneDescription struct {
+	// The time zone identifier (ASCII).
+	TzLabel string `json:"tzLabel,omitempty"`
+	// The time zone offset measured in seconds +/- from GMT.
+	TzOffset int `json:"tzOffset,omitempty"`
+	// The DST start date and time.
+	DstStart DaylightSavingsTime `json:"dstStart,omitzero"`
+	// The DST end date and time.
+	DstEnd DaylightSavingsTime `json:"dstEnd,omitzero"`
+	// The number of seconds to adjust the clock during DST
+	DstAdjust int `json:"dstAdjust,omitempty"`
+}
+
+// TLSParamsResponse is the TlsParamsResponse model.
+// TLS parameters that are currently available for inbound and outbound connections.
+type TLSParamsResponse struct {
+	// List of TLS protocols available
+	Protocols []string `json:"protocols,omitempty"`
+	// List of TLS ciphers available
+	Ciphers []string `json:"ciphers,omitempty"`
+}
+
+// TraceBufferSpec is the TraceBufferSpec model.
+type TraceBufferSpec struct {
+	ControllerA bool `json:"controllerA"`
+	ControllerB bool `json:"controllerB"`
+}
+
+// Tray is the Tray model.
+type Tray struct {
+	// An indication of the type of the tray. Values: sym1000, sym2000, sym2772, pciraid, generic, sym2200, sym1200,
+	// sym2882, sym1500, fc4600, ss3600, md1000, md3000, xbb2, fc6900, md1200, md1220, de1600, de5600, de6600,
+	// de7600, de6650, sc12, sc24, de212c, de224c, de460c, fiji.
+	Type string `json:"type,omitempty"`
+	// An indication of the physical orientation (horizontal or vertical) of the tray. Values: horizontal, vertical.
+	Orientation string `json:"orientation,omitempty"`
+	// The number of slots available for holding RAID controller boards (i.e., set to zero for a drive-only tray).
+	NumControllerSlots int `json:"numControllerSlots,omitempty"`
+	// The total number of slots available for holding disk drives.
+	NumDriveSlots int `json:"numDriveSlots,omitempty"`
+	// The tray identifier value, which is typically set using physical switches on the tray itself.
+	TrayID int `json:"trayId,omitempty"`
+	// The identifier value for this tray. Other objects may use this reference value to refer to the tray.
+	TrayRef string `json:"trayRef,omitempty"`
+	// True, if the tray does not have redundant access.
+	NonRedundantAccess bool `json:"nonRedundantAccess,omitempty"`
+	// The part number of the tray from VPD data.
+	PartNumber string `json:"partNumber,omitempty"`
+	// The serial number of the tray.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// The name of the manufacturer of the tray.
+	VendorName string `json:"vendorName,omitempty"`
+	// The date the tray was manufactured.
+	ManufacturerDate string `json:"manufacturerDate,omitempty"`
+	// The field replaceable unit type of the tray.
+	FruType string `json:"fruType,omitempty"`
+	// True if the IDs on the ESMs do not match.
+	TrayIDMismatch bool `json:"trayIDMismatch,omitempty"`
+	// True if this tray ID conflicts with that of another tray.
+	TrayIDConflict bool `json:"trayIDConflict,omitempty"`
+	// True if the ESM firmware versions do not match.
+	EsmVersionMismatch bool `json:"esmVersionMismatch,omitempty"`
+	// True if this tray has an ESM join miswire.
+	EsmMiswire bool `json:"esmMiswire,omitempty"`
+	// True if this tray has a drive-side minihub speed mismatch.
+	DrvMHSpeedMismatch bool `json:"drvMHSpeedMismatch,omitempty"`
+	// True if this tray is an unrecognized/unsupported hardware type.
+	UnsupportedTray bool `json:"unsupportedTray,omitempty"`
+	// The channel to the tray that is still operational (if nonRedundantAccess is True).
+	WorkingChannel int `json:"workingChannel,omitempty"`
+	// The maximum speed of the drive channels, Values: speedUnknown, speed1gig, speed2gig, speed4gig, speed10gig,
+	// speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig, speed60gig,
+	// speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig, speed100gig,
+	// speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	MaxSpeed string `json:"maxSpeed,omitempty"`
+	// This field has been deprecated and should not be used. Values: unknown, scsiScsi, fibreFibre, fibreSata,
+	// fibrePata, nvme.
+	TrayTechnologyType string `json:"trayTechnologyType,omitempty"`
+	// True if SBOD ESM trays are not properly clustered.
+	EsmGroupError bool `json:"esmGroupError,omitempty"`
+	// True if this tray is an uncertified hardware type.
+	UncertifiedTray bool `json:"uncertifiedTray,omitempty"`
+	// True if this tray supports a locate operation.
+	LocateTray bool `json:"locateTray,omitempty"`
+	// True if the ESM hardware components in the tray are mismatched.
+	EsmHardwareMismatch bool `json:"esmHardwareMismatch,omitempty"`
+	// True if the tray ID can be set using the updateTray procedure.
+	HasConfigurableTrayID bool `json:"hasConfigurableTrayId,omitempty"`
+	// The front-end I/O interface technology of the tray. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib,
+	// fcoe, nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	FrontEndInterfaceTechnology string `json:"frontEndInterfaceTechnology,omitempty"`
+	// A list of drive technologies that are present in the tray.
+	DriveTechnologies []string `json:"driveTechnologies,omitempty"`
+	// The number of drive compartments in the tray. Some drive trays are subdivided into "compartments," each
+	// having the same number of drive slots. Looking at the front of such an enclosure, multiple compartments are
+	// arranged side by side along one dimension, while drives within a compartment are arranged side by side along
+	// the other dimension, allowing for greater overall drive density within the constraints of a given enclosure
+	// form factor. (Even when arranged as a series of compartments, drive slot numbering is still relative to the
+	// entire tray.)
+	NumDriveCompartments int `json:"numDriveCompartments,omitempty"`
+	// The number of drive slots within an individual drive compartment.
+	NumDriveSlotsPerCompartment int `json:"numDriveSlotsPerCompartment,omitempty"`
+	// The tray attribute data (see setTrayAttributes procedure).
+	TrayAttributes []TrayAttribute `json:"trayAttributes,omitempty"`
+	// True, if the tray has invalid configuration settings.
+	IsMisconfigured bool `json:"isMisconfigured,omitempty"`
+	// True, if the two ESMs in the tray are reporting different version information for their factory default
+	// settings.
+	EsmFactoryDefaultsMismatch bool `json:"esmFactoryDefaultsMismatch,omitempty"`
+	// The physical location of the tray. The parent reference in Location identifies the storage array itself, and
+	// the position field is the tray position number as identified in setSATrayPositions procedure call, or the
+	// constant TRAY_POSITION_UNKNOWN.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// The total number of drawers available in the tray.
+	NumDrawers int `json:"numDrawers,omitempty"`
+	// If the tray contains drawers, this field specifies the layout within the drawer. Otherwise, this field
+	// specifies the layout for the tray.
+	DriveLayout DriveLayout `json:"driveLayout,omitzero"`
+	// This field contains information about the version of the tray's factory default settings.
+	FactoryDefaultsData FactoryDefaultsVersionData `json:"factoryDefaultsData,omitzero"`
+	// Indicates that a locate operation for the device is currently active. The device will show a visual
+	// indication to aid an operator in locating the tray.
+	LocateInProgress bool `json:"locateInProgress,omitempty"`
+	// The device contains 7-segment indicators used to display the Tray Identity.
+	HasTrayIdentityIndicator bool `json:"hasTrayIdentityIndicator,omitempty"`
+	// The OEM part number of the tray or chassis.
+	OemPartNumber string `json:"oemPartNumber,omitempty"`
+	ID            string `json:"id,omitempty"`
+}
+
+// TrayAttribute is the TrayAttribute model.
+type TrayAttribute struct {
+	// An indication of which attribute this is. Values: assetTag, chassisName, serviceTag, spinUpDelay,
+	// spinUpCount.
+	AttributeID string `json:"attributeId,omitempty"`
+	// The value of the tray attribute.
+	AttributeValue string `json:"attributeValue,omitempty"`
+}
+
+// TrayEx is the TrayEx model.
+type TrayEx struct {
+	// An indication of the type of the tray. Values: sym1000, sym2000, sym2772, pciraid, generic, sym2200, sym1200,
+	// sym2882, sym1500, fc4600, ss3600, md1000, md3000, xbb2, fc6900, md1200, md1220, de1600, de5600, de6600,
+	// de7600, de6650, sc12, sc24, de212c, de224c, de460c, fiji.
+	Type string `json:"type,omitempty"`
+	// An indication of the physical orientation (horizontal or vertical) of the tray. Values: horizontal, vertical.
+	Orientation string `json:"orientation,omitempty"`
+	// The number of slots available for holding RAID controller boards (i.e., set to zero for a drive-only tray).
+	NumControllerSlots int `json:"numControllerSlots,omitempty"`
+	// The total number of slots available for holding disk drives.
+	NumDriveSlots int `json:"numDriveSlots,omitempty"`
+	// The tray identifier value, which is typically set using physical switches on the tray itself.
+	TrayID int `json:"trayId,omitempty"`
+	// The identifier value for this tray. Other objects may use this reference value to refer to the tray.
+	TrayRef string `json:"trayRef,omitempty"`
+	// True, if the tray does not have redundant access.
+	NonRedundantAccess bool `json:"nonRedundantAccess,omitempty"`
+	// The part number of the tray from VPD data.
+	PartNumber string `json:"partNumber,omitempty"`
+	// The serial number of the tray.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// The name of the manufacturer of the tray.
+	VendorName string `json:"vendorName,omitempty"`
+	// The date the tray was manufactured.
+	ManufacturerDate string `json:"manufacturerDate,omitempty"`
+	// The field replaceable unit type of the tray.
+	FruType string `json:"fruType,omitempty"`
+	// True if the IDs on the ESMs do not match.
+	TrayIDMismatch bool `json:"trayIDMismatch,omitempty"`
+	// True if this tray ID conflicts with that of another tray.
+	TrayIDConflict bool `json:"trayIDConflict,omitempty"`
+	// True if the ESM firmware versions do not match.
+	EsmVersionMismatch bool `json:"esmVersionMismatch,omitempty"`
+	// True if this tray has an ESM join miswire.
+	EsmMiswire bool `json:"esmMiswire,omitempty"`
+	// True if this tray has a drive-side minihub speed mismatch.
+	DrvMHSpeedMismatch bool `json:"drvMHSpeedMismatch,omitempty"`
+	// True if this tray is an unrecognized/unsupported hardware type.
+	UnsupportedTray bool `json:"unsupportedTray,omitempty"`
+	// The channel to the tray that is still operational (if nonRedundantAccess is True).
+	WorkingChannel int `json:"workingChannel,omitempty"`
+	// The maximum speed of the drive channels, Values: speedUnknown, speed1gig, speed2gig, speed4gig, speed10gig,
+	// speed15gig, speed3gig, speed10meg, speed100meg, speed2pt5Gig, speed5gig, speed20gig, speed30gig, speed60gig,
+	// speed8gig, speed6gig, speed40gig, speed16gig, speed56gig, speed12gig, speed25gig, speed32gig, speed100gig,
+	// speed50gig, speedAuto, speed200gig, speed64gig, speed22pt5Gig, speed24gig.
+	MaxSpeed string `json:"maxSpeed,omitempty"`
+	// This field has been deprecated and should not be used. Values: unknown, scsiScsi, fibreFibre, fibreSata,
+	// fibrePata, nvme.
+	TrayTechnologyType string `json:"trayTechnologyType,omitempty"`
+	// True if SBOD ESM trays are not properly clustered.
+	EsmGroupError bool `json:"esmGroupError,omitempty"`
+	// True if this tray is an uncertified hardware type.
+	UncertifiedTray bool `json:"uncertifiedTray,omitempty"`
+	// True if this tray supports a locate operation.
+	LocateTray bool `json:"locateTray,omitempty"`
+	// True if the ESM hardware components in the tray are mismatched.
+	EsmHardwareMismatch bool `json:"esmHardwareMismatch,omitempty"`
+	// True if the tray ID can be set using the updateTray procedure.
+	HasConfigurableTrayID bool `json:"hasConfigurableTrayId,omitempty"`
+	// The front-end I/O interface technology of the tray. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib,
+	// fcoe, nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	FrontEndInterfaceTechnology string `json:"frontEndInterfaceTechnology,omitempty"`
+	// A list of drive technologies that are present in the tray.
+	DriveTechnologies []string `json:"driveTechnologies,omitempty"`
+	// The number of drive compartments in the tray. Some drive trays are subdivided into "compartments," each
+	// having the same number of drive slots. Looking at the front of such an enclosure, multiple compartments are
+	// arranged side by side along one dimension, while drives within a compartment are arranged side by side along
+	// the other dimension, allowing for greater overall drive density within the constraints of a given enclosure
+	// form factor. (Even when arranged as a series of compartments, drive slot numbering is still relative to the
+	// entire tray.)
+	NumDriveCompartments int `json:"numDriveCompartments,omitempty"`
+	// The number of drive slots within an individual drive compartment.
+	NumDriveSlotsPerCompartment int `json:"numDriveSlotsPerCompartment,omitempty"`
+	// The tray attribute data (see setTrayAttributes procedure).
+	TrayAttributes []TrayAttribute `json:"trayAttributes,omitempty"`
+	// True, if the tray has invalid configuration settings.
+	IsMisconfigured bool `json:"isMisconfigured,omitempty"`
+	// True, if the two ESMs in the tray are reporting different version information for their factory default
+	// settings.
+	EsmFactoryDefaultsMismatch bool `json:"esmFactoryDefaultsMismatch,omitempty"`
+	// The physical location of the tray. The parent reference in Location identifies the storage array itself, and
+	// the position field is the tray position number as identified in setSATrayPositions procedure call, or the
+	// constant TRAY_POSITION_UNKNOWN.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	// The total number of drawers available in the tray.
+	NumDrawers int `json:"numDrawers,omitempty"`
+	// If the tray contains drawers, this field specifies the layout within the drawer. Otherwise, this field
+	// specifies the layout for the tray.
+	DriveLayout DriveLayout `json:"driveLayout,omitzero"`
+	// This field contains information about the version of the tray's factory default settings.
+	FactoryDefaultsData FactoryDefaultsVersionData `json:"factoryDefaultsData,omitzero"`
+	// Indicates that a locate operation for the device is currently active. The device will show a visual
+	// indication to aid an operator in locating the tray.
+	LocateInProgress bool `json:"locateInProgress,omitempty"`
+	// The device contains 7-segment indicators used to display the Tray Identity.
+	HasTrayIdentityIndicator bool `json:"hasTrayIdentityIndicator,omitempty"`
+	// The OEM part number of the tray or chassis.
+	OemPartNumber     string `json:"oemPartNumber,omitempty"`
+	TrayPositionIndex int    `json:"trayPositionIndex,omitempty"`
+	ID                string `json:"id,omitempty"`
+}
+
+// TrayPosition is the TrayPosition model.
+type TrayPosition struct {
+	// The tray ID of the tray.
+	TrayID int `json:"trayId,omitempty"`
+	// An identifier of the enclosure (i.e. rack) in which the tray resides. This is not supported in the current
+	// release of the firmware.
+	RackID int `json:"rackId,omitempty"`
+}
+
+// TrayPositionList is the TrayPositionList model.
+type TrayPositionList struct {
+	// A variable-length array of TrayPosition objects.
+	TrayPosition []TrayPosition `json:"trayPosition,omitempty"`
+}
+
+// TrayPowerInputData is the TrayPowerInputData model.
+// The power input data for a given tray
+type TrayPowerInputData struct {
+	// The tray id
+	TrayID int `json:"trayId,omitempty"`
+	// The number of power supplies in the tray
+	NumberOfPowerSupplies int `json:"numberOfPowerSupplies,omitempty"`
+	// The list of measurements for each power supply in watts. It is likely the values in the array wil be ordered
+	// by power supply. The aggregate power supply wattage is the most important when monitoring these values.
+	InputPowerInWatts []int `json:"inputPowerInWatts,omitempty"`
+}
+
+// TraySettingsUpdateRequest is the TraySettingsUpdateRequest model.
+type TraySettingsUpdateRequest struct {
+	// The tray's new part number.
+	PartNumber string `json:"partNumber,omitempty"`
+	// The tray's new serial serial number.
+	SerialNumber string `json:"serialNumber,omitempty"`
+}
+
+// TraySettingsUpdateResponse is the TraySettingsUpdateResponse model.
+type TraySettingsUpdateResponse struct {
+	// The tray's unique identifier.
+	ID string `json:"id,omitempty"`
+	// The tray's part number.
+	PartNumber string `json:"partNumber,omitempty"`
+	// The tray's serial number.
+	SerialNumber string `json:"serialNumber,omitempty"`
+}
+
+// TraySlot is the TraySlot model.
+type TraySlot struct {
+	// The tray ID of the tray in which the component resides. Note that a Tray ID of -1 means "the controller
+	// tray."
+	Tray int `json:"tray,omitempty"`
+	// The slot number, or ordinal identifier, of the component within its tray.
+	Slot int `json:"slot,omitempty"`
+}
+
+// TrustedCertificateChangeEvent is the TrustedCertificateChangeEvent model.
+type TrustedCertificateChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType       string `json:"eventType"`
+	StorageSystemID string `json:"storageSystemId"`
+}
+
+// TruststoreChangeEvent is the TruststoreChangeEvent model.
+type TruststoreChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType       string `json:"eventType"`
+	StorageSystemID string `json:"storageSystemId"`
+}
+
+// TypedSYMbolReference is the TypedSYMbolReference model.
+type TypedSYMbolReference struct {
+	// An enumeration value indicating the type of component that the reference refers to. Values: unknown, fan,
+	// battery, powerSupply, thermalSensor, esm, ups, minihub, gbic, sfp, interconnectCru, supportCru, alarm,
+	// hostboard, icSasExpander, hostIoCard, cacheBackupDevice, cacheMemDimm, procMemDimm, channelPort, drive,
+	// controller, ethernetInterface, fibreInterface, ibInterface, iscsiInterface, sasInterface, tray, storageArray,
+	// drawer.
+	ComponentType string `json:"componentType,omitempty"`
+	// A SYMbol reference that refers to an instance of an object of the type identified by componentType. If the
+	// component type is "storage array," this field is a null reference. This is because an object graph describes
+	// a single instance of a storage array, so there is no need to uniquely identify instances.
+	SymbolRef string `json:"symbolRef,omitempty"`
+}
+
+// UnassociatedHostPort is the UnassociatedHostPort model.
+// A Port seen on the Storage Area Network but not currently associated to a host
+type UnassociatedHostPort struct {
+	// The host port's IO interface type. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib, fcoe, nvmeof,
+	// ethernet, nvmeCouplingDriver, pcie.
+	Type string `json:"type"`
+	// The host port's id.
+	ID string `json:"id"`
+	// The host port's unique address identifier.
+	Address string `json:"address"`
+	// The host port's media transport protocol type. Values: notImplemented, scsi, fc, sata, sas, iscsi, ib, fcoe,
+	// nvmeof, ethernet, nvmeCouplingDriver, pcie.
+	MediaTransportProtocolType string `json:"mediaTransportProtocolType"`
+}
+
+// UnreadableSectorEntryResult is the UnreadableSectorEntryResult model.
+// Identifies the location of an unreable sector.
+type UnreadableSectorEntryResult struct {
+	DriveRef        string `json:"driveRef,omitempty"`
+	DriveBlockCount int    `json:"driveBlockCount,omitempty"`
+	TimeStamp       string `json:"timeStamp,omitempty"`
+	// Values: physical, logical, injected, edcError, inconsistent, piError.
+	RecordType string `json:"recordType,omitempty"`
+	VolumeRef  string `json:"volumeRef,omitempty"`
+	TrayNo     int    `json:"trayNo,omitempty"`
+	SlotNo     int    `json:"slotNo,omitempty"`
+	DriveLBA   string `json:"driveLBA,omitempty"`
+	VolumeLBA  string `json:"volumeLBA,omitempty"`
+}
+
+// UnreadableSectorResponse is the UnreadableSectorResponse model.
+// The list of unreadable drive sectors
+type UnreadableSectorResponse struct {
+	MaxLimit int                           `json:"maxLimit,omitempty"`
+	Database []UnreadableSectorEntryResult `json:"database,omitempty"`
+}
+
+// UnreadableSectors is the UnreadableSectors model.
+type UnreadableSectors struct {
+	// The current number of unreadable sectors persisted.
+	Count int `json:"count,omitempty"`
+	// The maximum number of unreadable sectors allowed.
+	MaxLimit int `json:"maxLimit,omitempty"`
+}
+
+// UntrustedCertificateChangeEvent is the UntrustedCertificateChangeEvent model.
+type UntrustedCertificateChangeEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType       string `json:"eventType"`
+	StorageSystemID string `json:"storageSystemId"`
+}
+
+// UpdateStorageSystemRequest is the UpdateStorageSystemRequest model.
+// The update storage system request.
+type UpdateStorageSystemRequest struct {
+	// True to allow the storage system to automatically accept any self-signed certificates, otherwise false.
+	AcceptCertificate *bool `json:"acceptCertificate,omitempty"`
+	// The new stored password. This only updates the stored password on the proxy. To update the password on the
+	// storage system, use the passwords endpoint.
+	StoredPassword string `json:"storedPassword,omitempty"`
+	// Optional meta tags to associate to the storage system. This must be a complete list. If the list of meta tags
+	// is not set or is empty no change will occur. If you want to remove all tags, set the removeAllTags field in
+	// this request to true. If all tags are set to be removed, that will be done first before this new list is
+	// processed.
+	MetaTags []KeyValue `json:"metaTags,omitempty"`
+	// True to remove all tags associated to the storage system, otherwise false.
+	RemoveAllTags *bool `json:"removeAllTags,omitempty"`
+	// True to enable trace logging for SYMbol calls to the storage system, otherwise false.
+	EnableTrace *bool `json:"enableTrace,omitempty"`
+	// The list of controller ip addresses or hostnames.
+	ControllerAddresses []string `json:"controllerAddresses,omitempty"`
+}
+
+// UpgradeDownloadEvent is the UpgradeDownloadEvent model.
+type UpgradeDownloadEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType string `json:"eventType,omitempty"`
+	// Values: started, alreadyRunning, success, error.
+	Status        string `json:"status,omitempty"`
+	CorrelationID string `json:"correlationId,omitempty"`
+	StatusMessage string `json:"statusMessage,omitempty"`
+}
+
+// UpgradeLogRecord is the UpgradeLogRecord model.
+// Class that holds details on the upgrade log messages
+type UpgradeLogRecord struct {
+	// The upgrade message
+	Message string `json:"message,omitempty"`
+	// The upgrade message timestamp
+	Timestamp string `json:"timestamp,omitempty"`
+}
+
+// UpgradeLogsResponse is the UpgradeLogsResponse model.
+// Class that holds details on the firmware upgrade timestamps and log messages.
+type UpgradeLogsResponse struct {
+	// Timestamp of the last successful controller firmware upgrade
+	CfwUpgradeTS string `json:"cfwUpgradeTS,omitempty"`
+	// Timestamp of the last successful iom firmware upgrade
+	IomUpgradeTS string `json:"iomUpgradeTS,omitempty"`
+	// Timestamp of the last successful drive firmware upgrade
+	DriveUpgradeTS string `json:"driveUpgradeTS,omitempty"`
+	// Timestamp of the last successful NVSRAM upgrade
+	NvsramUpgradeTS string `json:"nvsramUpgradeTS,omitempty"`
+	// Array of log records for controller firmware upgrades
+	CfwLog []UpgradeLogRecord `json:"cfwLog,omitempty"`
+	// Array of log records for iom firmware upgrades
+	IomLog []UpgradeLogRecord `json:"iomLog,omitempty"`
+	// Array of log records for drive firmware upgrades
+	DriveLog []UpgradeLogRecord `json:"driveLog,omitempty"`
+	// Array of log records for NVSRAM upgrades
+	NvsramLog []UpgradeLogRecord `json:"nvsramLog,omitempty"`
+}
+
+// UpgradeManagerResponse is the UpgradeManagerResponse model.
+// This response contains the correlation Id that can be used to correlate software download events from the global
+// event channel to this request
+type UpgradeManagerResponse struct {
+	// The correlation Id. This can be match to events from the event system to match events to this request
+	CorrelationID string `json:"correlationId"`
+}
+
+// Ups is the Ups model.
+type Ups struct {
+	// The reference for this physical UPS.
+	UpsRef string `json:"upsRef,omitempty"`
+	// The operational status of the UPS. Values: optimal, onBattery.
+	Status string `json:"status,omitempty"`
+	// The physical location of the UPS. Note that the tray reference identifies the enclosure containing the UPS,
+	// but the slot information does not apply to this component.
+	PhysicalLocation Location `json:"physicalLocation,omitzero"`
+	Reserved1        string   `json:"reserved1,omitempty"`
+	Reserved2        string   `json:"reserved2,omitempty"`
+}
+
+// UserVolume is the UserVolume model.
+type UserVolume struct {
+	Metadata []VolumeMetadataItem `json:"metadata,omitempty"`
+	// Values: raidUnsupported, raidAll, raid0, raid1, raid3, raid5, raid6, raidDiskPool, raid1p.
+	RaidLevel      string `json:"raidLevel,omitempty"`
+	VolumeGroupRef string `json:"volumeGroupRef,omitempty"`
+	FlashCached    bool   `json:"flashCached,omitempty"`
+	BlkSize        int    `json:"blkSize,omitempty"`
+	// The volume-specific caching parameters currently in effect for the volume.
+	CacheSettings VolumeCache `json:"cacheSettings"`
+	DiskPool      bool        `json:"diskPool,omitempty"`
+	SegmentSize   int         `json:"segmentSize,omitempty"`
+	// Values: type0Protection, type1Protection, type2Protection, type3Protection.
+	ProtectionType  string                `json:"protectionType,omitempty"`
+	ThinProvisioned bool                  `json:"thinProvisioned,omitempty"`
+	BlkSizePhysical int                   `json:"blkSizePhysical,omitempty"`
+	MediaScan       VolumeMediaScanParams `json:"mediaScan,omitzero"`
+	DataAssurance   bool                  `json:"dataAssurance,omitempty"`
+	Name            string                `json:"name,omitempty"`
+	ID              string                `json:"id,omitempty"`
+	// Values: unknown, volume, pool, host, lunMapping, hostGroup, thinVolume, drive, volumeCopy, pit, pitView,
+	// snapshotGroup, snapshot, accessVolume, legacySnapshot, hostType, metadataTag, managementUrl, folder,
+	// asyncMirrorGroup, asyncMirrorGroupMember, asyncMirrorGroupIncompleteMember, consistencyGroup,
+	// consistencyGroupView, fan, battery, storageSystem, controller, powerSupply, minihub, esm, drawer, hostBoard,
+	// interconnectCRU, cacheBackupDevice, tray, supportCRU, hostPort, initiator, snapshotSchedule, thermalSensor,
+	// sfp, flashCache, featureAttribute, featureState, lockKeyId, remoteVolume, mirrorVolume, vaultMirrorVolume,
+	// vaultMirrorGroup, metadataVolume, sasPort, sasExpander, channelPort, speedNegError, snmpAgentBundle,
+	// stagedFirmware, workload, ekmsCertRevocatSettings, databaseMetadata, directoryServicesDomain,
+	// kmsCertificateExpirationDetails, externalKMSEndpoint, notOnPreferredPath, remoteStorageVolume, remoteStorage,
+	// alarm.
+	ObjectType            string       `json:"objectType,omitempty"`
+	CurrentControllerID   string       `json:"currentControllerId,omitempty"`
+	WWN                   string       `json:"wwn,omitempty"`
+	PreferredControllerID string       `json:"preferredControllerId,omitempty"`
+	TotalSizeInBytes      string       `json:"totalSizeInBytes,omitempty"`
+	Mapped                bool         `json:"mapped"`
+	ListOfMappings        []LUNMapping `json:"listOfMappings,omitempty"`
+}
+
+// VendorPartData is the VendorPartData model.
+type VendorPartData struct {
+	// The name of the vendor.
+	VendorName string `json:"vendorName,omitempty"`
+	// The part number.
+	PartNumber string `json:"partNumber,omitempty"`
+	// The part revision number.
+	RevisionNumber string `json:"revisionNumber,omitempty"`
+	// The part serial number.
+	SerialNumber string `json:"serialNumber,omitempty"`
+}
+
+// VersionContent is the VersionContent model.
+// Object that contains definitions for on board and bundled code module versions
+type VersionContent struct {
+	// The code module described Values: unspecified, raid, hypervisor, management, iom, nvsram, bundle,
+	// bundleDisplay, backupNvsram.
+	Module string `json:"module"`
+	// Code bundled with firmware file
+	BundledVersion string `json:"bundledVersion"`
+	// Code active on storage system
+	OnboardVersion string `json:"onboardVersion"`
+}
+
+// VersionDescriptor is the VersionDescriptor model.
+type VersionDescriptor struct {
+	// The code module described Values: unspecified, raid, hypervisor, management, iom, nvsram, bundle,
+	// bundleDisplay, backupNvsram.
+	CodeModule string `json:"codeModule,omitempty"`
+	// Textual representation of version
+	VersionString string `json:"versionString,omitempty"`
+}
+
+// Volume is the Volume model.
+type Volume struct {
+	// An indication of whether the volume is currently offline.
+	Offline bool `json:"offline,omitempty"`
+	// This field has been deprecated and should not be used.
+	ExtremeProtection bool `json:"extremeProtection,omitempty"`
+	// The internal identifier value associated with this volume. When the Storage Partitions feature is NOT
+	// present, this value represents the logical unit number (LUN) that is used to access the volume via the host
+	// I/O path. If the Storage Partitions feature IS enabled, this field has no external meaning.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// The RAID level for which this volume is configured. Values: raidUnsupported, raidAll, raid0, raid1, raid3,
+	// raid5, raid6, raidDiskPool, raid1p.
+	RaidLevel string `json:"raidLevel,omitempty"`
+	// The sector offset of this volume within its volume group. This value is provided only to allow the client
+	// application to determine the relative positions of multiple volumes within a given volume group. No other
+	// assumptions or computations should be made with this value.
+	SectorOffset string `json:"sectorOffset,omitempty"`
+	// A variable-length opaque field that provides the volume's worldwide unique identification value.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The user-assigned symbol label associated with the volume.
+	Label string `json:"label,omitempty"`
+	// The block size (in bytes) of the volume.
+	BlkSize int `json:"blkSize,omitempty"`
+	// The capacity (in bytes) of the volume.
+	Capacity string `json:"capacity,omitempty"`
+	// The reconstruction priority value for the volume. This value is in the range 0-4, with 0 indicating that
+	// reconstruction is favored over any I/O, and 4 indicating that reconstruction should be performed with minimal
+	// impact to I/O. Intermediate values indicate various levels of compromise between these two extremes.
+	ReconPriority int `json:"reconPriority,omitempty"`
+	// The segment size (in bytes) of the volume. The segment size is, for the most part, an indication of the
+	// stripe depth used to lay out the volume's block addresses.
+	SegmentSize int `json:"segmentSize,omitempty"`
+	// An indication of what current long-running activity, if any, is currently in progress on the volume. Values:
+	// none, copyback, initializing, reconstructing, remappingDce, remappingDrm, remappingDcedrm, remappingDseg,
+	// remappingDve, remappingDcedve, remappingInternal, remappingDefrag, formatting, synchronizing, parityScan,
+	// volumeCopy, snapshotRollback, pitRollback, asyncMirrorGroupInitialSync, reconstructingCritical, rebalancing,
+	// copyThenFail, copyThenFailPending, copyThenReplace, copyThenReplaceAndFail, thinDefrag, creating, deleting,
+	// offlineFormatting, driveSpecific.
+	Action string `json:"action,omitempty"`
+	// The volume-specific caching parameters currently in effect for the volume.
+	Cache VolumeCache `json:"cache,omitzero"`
+	// The volume-specific media scan settings currently in effect for the volume.
+	MediaScan VolumeMediaScanParams `json:"mediaScan,omitzero"`
+	// The unique identification value for this volume. Other objects may use this reference value to refer to the
+	// volume.
+	VolumeRef string `json:"volumeRef,omitempty"`
+	// The current operational state of the volume. Values: optimal, degraded, failed, impaired, creating, deleting.
+	Status string `json:"status,omitempty"`
+	// The volume group reference value of the volume group on which this volume resides.
+	VolumeGroupRef string `json:"volumeGroupRef,omitempty"`
+	// The reference value of the controller that currently owns (i.e. manages) this volume. In non-AVT (Auto Volume
+	// Transfer) mode, host I/O access to the blocks in this volume is permitted only through the controller that is
+	// the current manager. In AVT mode, an I/O access to the controller that is not the current manager causes it
+	// to automatically become the current manager.
+	CurrentManager string `json:"currentManager,omitempty"`
+	// The reference value of the controller that is the preferred manager of the volume. The preferred manager is
+	// tracked independent of the current manager, since the current manager may be switched dynamically as a result
+	// of host-base multi-path transitions. Later, when the error conditions that cause a multi-path transition have
+	// been corrected it is still possible to determine the preferred manager and switch ownership back to it.
+	PreferredManager string `json:"preferredManager,omitempty"`
+	// Operations allowed on this volume.
+	Perms VolumePerms `json:"perms,omitzero"`
+	// For use by management client.
+	MgmtClientAttribute int `json:"mgmtClientAttribute,omitempty"`
+	// True if dynamic segment sizing pre-allocation is enabled for the volume.
+	DssPreallocEnabled bool `json:"dssPreallocEnabled,omitempty"`
+	// The maximum segment size (in bytes) that this volume could assume with the dynamic segment sizing feature.
+	// This field is only meaningful if dssPreallocEnabled is true.
+	DssMaxSegmentSize int `json:"dssMaxSegmentSize,omitempty"`
+	// If set to true, then the storage controller will, on READ operations, verify the consistency of RAID
+	// redundancy data for the stripe(s) containing the READ data.
+	PreReadRedundancyCheckEnabled bool `json:"preReadRedundancyCheckEnabled,omitempty"`
+	// This field has been deprecated and should not be used.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// The protection type of the volume. Values: type0Protection, type1Protection, type2Protection,
+	// type3Protection.
+	ProtectionType string `json:"protectionType,omitempty"`
+	// True if application tag is owned by the storage array.
+	ApplicationTagOwned bool `json:"applicationTagOwned,omitempty"`
+	// This element contains a count of the number of blocks in the volume that have had data assurance errors
+	// repaired.
+	RepairedBlockCount int `json:"repairedBlockCount,omitempty"`
+	// A variable-length opaque field that provides the volume's extended unique identification value.
+	ExtendedUniqueIdentifier string `json:"extendedUniqueIdentifier,omitempty"`
+	// True if checking of protection information during cache mirroring is enabled for this volume, false otherwise
+	CacheMirroringValidateProtectionInformation bool `json:"cacheMirroringValidateProtectionInformation,omitempty"`
+	// Expected Protection Information Application Tag for the volume
+	ExpectedProtectionInformationAppTag int `json:"expectedProtectionInformationAppTag,omitempty"`
+	// An indication as to whether a host is allowed to issue unmap commands to the volume
+	HostUnmapEnabled bool `json:"hostUnmapEnabled,omitempty"`
+	// The cache pool ID this volume is assigned to. If no specific cache pool ID is assigned to the volume, this
+	// field is set to 0. The range for cache pool ID is from 1 to maxCachePoolID, which can be obtained from the
+	// maxCachePoolID field in the FeatureParams struct based on the number of cache pools supported by the
+	// controller.
+	CachePoolID int `json:"cachePoolID,omitempty"`
+	// This reports the physical block size (in bytes) used when sending I/O to the underlying drives.
+	BlkSizePhysical int `json:"blkSizePhysical,omitempty"`
+	// Number of data drives in a RAID stripe.
+	DataDriveCount int `json:"dataDriveCount,omitempty"`
+	// Number of parity drives in a RAID stripe.
+	ParityDriveCount int `json:"parityDriveCount,omitempty"`
+	// Allocation granularity size (in bytes) of the volume. Use to determine how much physical capacity will be
+	// consumed during a volume expansion. Note that in a disk pool, different volumes may have different values
+	// (i.e. because of different RAID levels and/or different stripe widths).
+	AllocGranularity string `json:"allocGranularity,omitempty"`
+	Name             string `json:"name,omitempty"`
+	ID               string `json:"id,omitempty"`
+}
+
+// VolumeActionProgressResponse is the VolumeActionProgressResponse model.
+// The volume action progress response.
+type VolumeActionProgressResponse struct {
+	// The completion percentage of a long-running operation on a volume.
+	PercentComplete int `json:"percentComplete"`
+	// The estimated elapsed time to completion in minutes. Note that this field is always set to -1 for basic RAID
+	// volumes.
+	TimeToCompletion int `json:"timeToCompletion"`
+	// Values: none, copyback, initializing, reconstructing, remappingDce, remappingDrm, remappingDcedrm,
+	// remappingDseg, remappingDve, remappingDcedve, remappingInternal, remappingDefrag, formatting, synchronizing,
+	// parityScan, volumeCopy, snapshotRollback, pitRollback, asyncMirrorGroupInitialSync, reconstructingCritical,
+	// rebalancing, copyThenFail, copyThenFailPending, copyThenReplace, copyThenReplaceAndFail, thinDefrag,
+	// creating, deleting, offlineFormatting, driveSpecific.
+	Action string `json:"action"`
+}
+
+// VolumeCache is the VolumeCache model.
+type VolumeCache struct {
+	// A true value means that the controller is allowed to use write-back caching of dirty data even when the cache
+	// memory's battery-backup protection is not available or not operational. A false value means that any problems
+	// with the battery-backup protection of the write cache will cause the controller to suspend all write-back
+	// caching operations for the volume.
+	Cwob bool `json:"cwob,omitempty"`
+	// A true value means that all dirty data in the write cache will be flushed immediately and further write-back
+	// caching will be disabled if the controller detects that a UPS unit has switched from standard power to
+	// battery backup. A false value means that write-back caching is not impacted by the state of the UPS.
+	EnterpriseCacheDump bool `json:"enterpriseCacheDump,omitempty"`
+	// An indication of whether the cache mirroring option is active for the volume's data.
+	MirrorActive bool `json:"mirrorActive,omitempty"`
+	// An indication of whether the cache mirroring feature is currently enabled for this volume. It is possible
+	// that the mirrorEnable and mirrorActive values differ; this may occur, for example, if the alternate
+	// controller is removed or failed.
+	MirrorEnable bool `json:"mirrorEnable,omitempty"`
+	// An indication of whether read caching is active for the volume.
+	ReadCacheActive bool `json:"readCacheActive,omitempty"`
+	// An indication of whether read caching is enabled for the volume. Note that this value may not match the
+	// readCacheActive setting in cases where problems with the controller cache or its battery back-up have been
+	// detected.
+	ReadCacheEnable bool `json:"readCacheEnable,omitempty"`
+	// An indication of whether write-back caching is active for the volume.
+	WriteCacheActive bool `json:"writeCacheActive,omitempty"`
+	// An indication of whether write-back caching is enabled for the volume. Note that this value may not match the
+	// writeCacheActive setting in cases where problems with the controller cache or its battery back-up have been
+	// detected.
+	WriteCacheEnable bool `json:"writeCacheEnable,omitempty"`
+	// The cache flush modifier value, which is used to specify the maximum amount of time that dirty data for this
+	// volume may be retained in the controller's write cache prior to being flushed to disk. Values:
+	// flushImmediate, flush250Msec, flush500Msec, flush750Msec, flush1Sec, flush1500Msec, flush2Sec, flush5Sec,
+	// flush10Sec, flush20Sec, flush60Sec, flush120Sec, flush300Sec, flush1200Sec, flush3600Sec, flushInfinite.
+	CacheFlushModifier string `json:"cacheFlushModifier,omitempty"`
+	// A true (non-zero) / false (zero) indicator of whether or not automatic cache read-ahead is enabled.
+	ReadAheadMultiplier int `json:"readAheadMultiplier,omitempty"`
+}
+
+// VolumeCacheConfig is the VolumeCacheConfig model.
+// Cache settings for a volume
+type VolumeCacheConfig struct {
+	// The cache flush modifier value, which indicates the amount of time dirty data is retained in write cache
+	// prior to being flushed to disk Values: immediate, twoHundredFiftyMillis, fiveHundredMillis,
+	// sevenHundredFiftyMillis, fifteenHundredMillis, oneSecond, twoSeconds, fiveSeconds, tenSeconds, twentySeconds,
+	// sixtySeconds, oneHundredTwentySeconds, threeHundredSeconds, twelveHundredSeconds, thirtySixHundredSeconds,
+	// infinite.
+	CacheFlushModifier string `json:"cacheFlushModifier"`
+	// Indicates whether write-back caching of dirty data is allowed when the cache memory's battery is not
+	// available or not functioning
+	CachingWithoutBatteriesEnabled bool `json:"cachingWithoutBatteriesEnabled"`
+	// Indicates whether cache mirroring is enabled for the volume
+	MirrorEnabled bool `json:"mirrorEnabled"`
+	// Indicates whether automatic cache read-ahead is enabled for the volume
+	ReadAheadEnabled bool `json:"readAheadEnabled"`
+	// Indicates whether read caching is enabled for the volume
+	ReadCacheEnabled bool `json:"readCacheEnabled"`
+	// Indicates whether write-back caching is enabled for the volume
+	WriteCacheEnabled bool `json:"writeCacheEnabled"`
+}
+
+// VolumeCacheSettings is the VolumeCacheSettings model.
+// Change configured cache settings for a volume.
+type VolumeCacheSettings struct {
+	// Indicates whether read caching should be enabled for the volume.
+	ReadCacheEnable bool `json:"readCacheEnable,omitempty"`
+	// Indicates whether write-back caching should be enabled for the volume.
+	WriteCacheEnable bool `json:"writeCacheEnable,omitempty"`
+	// Indicates whether or not automatic cache read-ahead is enabled
+	ReadAheadEnable bool `json:"readAheadEnable,omitempty"`
+	// The cache flush modifier value, which is used to specify the maximum amount of time that dirty data for this
+	// volume may be retained in the controller's write cache prior to being flushed to disk. Values:
+	// flushImmediate, flush250Msec, flush500Msec, flush750Msec, flush1Sec, flush1500Msec, flush2Sec, flush5Sec,
+	// flush10Sec, flush20Sec, flush60Sec, flush120Sec, flush300Sec, flush1200Sec, flush3600Sec, flushInfinite.
+	CacheFlushModifier string `json:"cacheFlushModifier,omitempty"`
+	// Indicates whether cache mirroring should be enabled for this volume. If enabled, all cache data will be
+	// mirrored across controllers to provide increased resilience to potential controller failures.
+	MirrorEnable bool `json:"mirrorEnable,omitempty"`
+	// Indicates whether caching without batteries should be allowed for this volume. Note that setting this
+	// attribute to true may result in data loss if the array's cache hold-up battery fails, and then a disorderly
+	// power-down of the array occurs. This feature should be used only with extreme caution.
+	CacheWithoutBatteries bool `json:"cacheWithoutBatteries,omitempty"`
+}
+
+// VolumeCandidate is the VolumeCandidate model.
+type VolumeCandidate struct {
+	// The RAID level of the potential volume. Values: raidUnsupported, raidAll, raid0, raid1, raid3, raid5, raid6,
+	// raidDiskPool, raid1p.
+	RaidLevel string `json:"raidLevel,omitempty"`
+	// This field is set to true if the candidate has tray loss protection.
+	TrayLossProtection bool `json:"trayLossProtection,omitempty"`
+	// This value is the maximum allowed user data storage capacity for the volume if the smallest possible segment
+	// is used in creating the volume.
+	RawSize string `json:"rawSize,omitempty"`
+	// This value is the maximum allowed user data storage capacity for the volume if the largest possible segment
+	// is used in creating the volume.
+	UsableSize string `json:"usableSize,omitempty"`
+	// The number of drives in the potential volume's volume group.
+	DriveCount int `json:"driveCount,omitempty"`
+	// The reference value of the free extent on which the potential volume would be created. This field will be
+	// valid only if the volume candidate was obtained using a candidate selection type of
+	// CANDIDATE_SEL_FREE_EXTENT.
+	FreeExtentRef string `json:"freeExtentRef,omitempty"`
+	// A list of the reference values of the drives on which the volume group for the potential volume would be
+	// created. This field will be valid only if the volume candidate was obtained using a candidate selection type
+	// of CANDIDATE_SEL_MANUAL.
+	DriveRefList DriveRefList `json:"driveRefList,omitzero"`
+	// The type of candidate selection that was used to obtain this volume candidate. Values: freeExtent, manual,
+	// count.
+	CandidateSelectionType string `json:"candidateSelectionType,omitempty"`
+	// True, if all drives have matching spindle speed.
+	SpindleSpeedMatch bool `json:"spindleSpeedMatch,omitempty"`
+	// True, if all drives have matching spindle speed.
+	SpindleSpeed int `json:"spindleSpeed,omitempty"`
+	// The physical drive type. Values: all, scsi, fibre, sata, pata, fibre520b, sas, unknown, sas4k, nvme4k.
+	PhyDriveType string `json:"phyDriveType,omitempty"`
+	// True if dynamic segment sizing pre-allocation will be enabled for the volume created from this candidate,
+	// otherwise false.
+	DssPreallocEnabled bool `json:"dssPreallocEnabled,omitempty"`
+	// The desired security level of the drive group. Values: unknown, none, capable, enabled.
+	SecurityType string `json:"securityType,omitempty"`
+	// This field is set to true when the volume group has drawer loss protection; otherwise it is set to false
+	DrawerLossProtection bool `json:"drawerLossProtection,omitempty"`
+	// Drive media type (HDD or SSD) of the volume candidate. Values: all, unknown, hdd, ssd.
+	DriveMediaType string `json:"driveMediaType,omitempty"`
+	// This field has been deprecated and should not be used.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// This structure contains a protectionInformationCapable field which is set to true if all drives in the volume
+	// group where the volume candidate resides are Protection Information (PI) capable. It also contains the
+	// protection type for the volume group.
+	ProtectionInformationCapabilities ProtectionInformationCapabilities `json:"protectionInformationCapabilities,omitzero"`
+	// Information about the Volume Candidate.
+	VolumeCandidateData VolumeCandidateTypeData `json:"volumeCandidateData,omitzero"`
+	// Identifies the drive block format of the volume candidate. Values: unknown, allNative, allEmulated, mixed.
+	DriveBlockFormat string `json:"driveBlockFormat,omitempty"`
+	// Determines whether or not reserved space is allocated on the drives in the volume group.
+	AllocateReservedSpace bool `json:"allocateReservedSpace,omitempty"`
+	// Describes the overall security level of the drive set. Values can include: FDE only, FIPS only, mixed, or no
+	// security. Used along with the SecurityType field. Values: unknown, none, mixed, fde, fips.
+	SecurityLevel string `json:"securityLevel,omitempty"`
+	// If true the VolumeCandidate represents drives that are all DULBE capable.
+	DulbeCapable bool `json:"dulbeCapable,omitempty"`
+	// The recommended block size (in bytes) for this candidate. This is based on both the specific drives involved
+	// and global conditions (NVSRAM, HW limitations, etc).
+	BlkSizeRecommended int `json:"blkSizeRecommended,omitempty"`
+	// Lists the supported block sizes (in bytes) for this candidate.
+	BlkSizeSupported []int `json:"blkSizeSupported,omitempty"`
+	// The volume group reference value of the volume group or disk pool on which the volume will be created.
+	VolumeGroupRef string `json:"volumeGroupRef,omitempty"`
+	// This field is set to true if the paths to the drives used in this candidate are imbalanced.
+	DrivePathImbalance bool `json:"drivePathImbalance,omitempty"`
+}
+
+// VolumeCandidateTypeData is the VolumeCandidateTypeData model.
+type VolumeCandidateTypeData struct {
+	// This enumeration is used to indicate the type of the volume group. Values: unknown, traditional, diskPool.
+	Type string `json:"type,omitempty"`
+	// This field is present only if the value of type is equal to VG_TYPE_DISK_POOL.
+	DiskPoolVolumeCandidateData DiskPoolVolumeCandidateData `json:"diskPoolVolumeCandidateData,omitzero"`
+}
+
+// VolumeConfig is the VolumeConfig model.
+// Configuration data for volumes in volume groups and pools
+type VolumeConfig struct {
+	// A label for the volume
+	Label string `json:"label"`
+	// The label of the volume group or pool the volume is in
+	VolumeGroupLabel string `json:"volumeGroupLabel"`
+	// The capacity of the volume
+	Capacity string `json:"capacity"`
+	// The segment size of the volume
+	SegmentSize int `json:"segmentSize"`
+	// The controller that owns the volume Values: a, b.
+	OwningController string `json:"owningController"`
+	// T10 protection information type for the volume Values: none, type1, type2, type3.
+	T10ProtectionType string `json:"t10ProtectionType"`
+	// Indicates whether the DSS preallocation calculation is used for the volume
+	DssPreallocateEnabled bool `json:"dssPreallocateEnabled"`
+	// The RAID level of the volume group Values: raid0, raid1, raid3, raid5, raid6.
+	RaidLevel string `json:"raidLevel,omitempty"`
+	// Cache settings for the volume
+	CacheSettings VolumeCacheConfig `json:"cacheSettings"`
+	// Indicates the storage controller will, on read operations, verify the consistency of RAID redundancy data for
+	// the stripe(s) containing the READ data
+	PreReadRedundancyCheckEnabled bool `json:"preReadRedundancyCheckEnabled"`
+	// Background media scan settings for a volume
+	MediaScanSettings VolumeMediaScanSettings `json:"mediaScanSettings"`
+	// Reconstruction priority
+	ReconstructionPriority int `json:"reconstructionPriority"`
+	// Block size for the volume
+	BlockSize int `json:"blockSize,omitempty"`
+}
+
+// VolumeCopy is the VolumeCopy model.
+type VolumeCopy struct {
+	// The World Wide Name of the volume copy.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The Volume Copy ID(ssid of proxy).
+	VolcopyHandle int `json:"volcopyHandle,omitempty"`
+	// Reference (key) for copy.
+	VolcopyRef string `json:"volcopyRef,omitempty"`
+	// Indicates status of copy. Values: inProgress, complete, halted, failed, pending.
+	Status string `json:"status,omitempty"`
+	// Source volume reference.
+	SourceVolume string `json:"sourceVolume,omitempty"`
+	// Target volume reference.
+	TargetVolume string `json:"targetVolume,omitempty"`
+	// Current owner of copy.
+	CurrentManager string `json:"currentManager,omitempty"`
+	// Apply write protection to target volume when copy is idle (true/false).
+	IdleTargetWriteProt bool `json:"idleTargetWriteProt,omitempty"`
+	// Time copy operation completed.
+	CopyCompleteTime string `json:"copyCompleteTime,omitempty"`
+	// Time copy operation began.
+	CopyStartTime string `json:"copyStartTime,omitempty"`
+	// Importance of copy operation. Values: priority0, priority1, priority2, priority3, priority4.
+	CopyPriority string `json:"copyPriority,omitempty"`
+	Reserved1    string `json:"reserved1,omitempty"`
+	// The volume copy is a clone.
+	CloneCopy bool `json:"cloneCopy,omitempty"`
+	// For clones based on PiT Groups, this will identify the PiT Group.
+	PgRef string `json:"pgRef,omitempty"`
+	// Volume copy type Values: unknown, offline, online.
+	Type string `json:"type,omitempty"`
+	// Indicates if the relationship between the source and target volumes should automatically be cleared on
+	// completion of the volume copy operation.
+	AutoClearOnCompletion bool   `json:"autoClearOnCompletion,omitempty"`
+	ID                    string `json:"id,omitempty"`
+}
+
+// VolumeCopyCreateRequest is the VolumeCopyCreateRequest model.
+// Creates a Volume Copy.
+type VolumeCopyCreateRequest struct {
+	// The identifier of the source volume for the copy job.
+	SourceID string `json:"sourceId"`
+	// The identifier of the target volume for the copy job.
+	TargetID string `json:"targetId"`
+	// The priority of the copy job (0 is the lowest priority, 4 is the highest priority). Defaults to priority 2.
+	// Values: priority0, priority1, priority2, priority3, priority4.
+	CopyPriority string `json:"copyPriority,omitempty"`
+	// Specifies whether to block write I/O to the target volume while the copy job exists.
+	TargetWriteProtected *bool `json:"targetWriteProtected,omitempty"`
+	// If true, a snapshot will be created of the source volume, and the copy initiated on it to prevent any loss of
+	// use of the source volume.
+	OnlineCopy *bool `json:"onlineCopy,omitempty"`
+	// Allows a repository candidate to be manually specified for use in the creation of the snapshot. By default,
+	// the best candidate will be selected if onlineCopy is specified as true
+	RepositoryCandidate ConcatVolumeCandidate `json:"repositoryCandidate,omitzero"`
+}
+
+// VolumeCopyPair is the VolumeCopyPair model.
+type VolumeCopyPair struct {
+	// The World Wide Name of the volume copy.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The Volume Copy ID(ssid of proxy).
+	VolcopyHandle int `json:"volcopyHandle,omitempty"`
+	// Reference (key) for copy.
+	VolcopyRef string `json:"volcopyRef,omitempty"`
+	// Indicates status of copy. Values: inProgress, complete, halted, failed, pending.
+	Status string `json:"status,omitempty"`
+	// Source volume reference.
+	SourceVolume string `json:"sourceVolume,omitempty"`
+	// Target volume reference.
+	TargetVolume string `json:"targetVolume,omitempty"`
+	// Current owner of copy.
+	CurrentManager string `json:"currentManager,omitempty"`
+	// Apply write protection to target volume when copy is idle (true/false).
+	IdleTargetWriteProt bool `json:"idleTargetWriteProt,omitempty"`
+	// Time copy operation completed.
+	CopyCompleteTime string `json:"copyCompleteTime,omitempty"`
+	// Time copy operation began.
+	CopyStartTime string `json:"copyStartTime,omitempty"`
+	// Importance of copy operation. Values: priority0, priority1, priority2, priority3, priority4.
+	CopyPriority string `json:"copyPriority,omitempty"`
+	Reserved1    string `json:"reserved1,omitempty"`
+	// The volume copy is a clone.
+	CloneCopy bool `json:"cloneCopy,omitempty"`
+	// For clones based on PiT Groups, this will identify the PiT Group.
+	PgRef string `json:"pgRef,omitempty"`
+	// Volume copy type Values: unknown, offline, online.
+	Type string `json:"type,omitempty"`
+	// Indicates if the relationship between the source and target volumes should automatically be cleared on
+	// completion of the volume copy operation.
+	AutoClearOnCompletion bool `json:"autoClearOnCompletion,omitempty"`
+	// The source's base volume identifier (the same as the sourceVolume in the case of an offline copy).
+	BaseSourceVolumeID string `json:"baseSourceVolumeId,omitempty"`
+	ID                 string `json:"id,omitempty"`
+	OnlineCopy         bool   `json:"onlineCopy,omitempty"`
+}
+
+// VolumeCopyProgress is the VolumeCopyProgress model.
+// Object to represent the Volume Copy progress.
+type VolumeCopyProgress struct {
+	// The completion percentage of a "long-running" operation on a volume.
+	PercentComplete int `json:"percentComplete,omitempty"`
+	// The estimated elapsed time to completion in minutes. Note that this field is always set to -1 for basic RAID
+	// volumes.
+	TimeToCompletion int `json:"timeToCompletion,omitempty"`
+	// The fractional completion percentage of a "long-running" operation on a volume.
+	FractionalPercentComplete int    `json:"fractionalPercentComplete,omitempty"`
+	VolumeCopyID              string `json:"volumeCopyId"`
+}
+
+// VolumeCopyUpdateRequest is the VolumeCopyUpdateRequest model.
+// Updates a Volume Copy.
+type VolumeCopyUpdateRequest struct {
+	// The priority of the copy job (0 is the lowest priority, 4 is the highest priority). Defaults to priority 2.
+	// Values: priority0, priority1, priority2, priority3, priority4.
+	CopyPriority string `json:"copyPriority,omitempty"`
+	// Specifies whether to block write I/O to the target volume while the copy job exists.
+	TargetWriteProtected *bool `json:"targetWriteProtected,omitempty"`
+}
+
+// VolumeCreateRequest is the VolumeCreateRequest model.
+// Creates a volume.
+type VolumeCreateRequest struct {
+	// The identifier of the storage pool from which the volume will be allocated.
+	PoolID string `json:"poolId"`
+	// The user label to assign to the new volume.
+	Name string `json:"name"`
+	// Values: bytes, b, kb, mb, gb, tb, pb, eb, zb, yb.
+	SizeUnit string `json:"sizeUnit,omitempty"`
+	// The size of the new volume. See 'sizeUnit' field.
+	Size string `json:"size"`
+	// The desired segment size for the new volume in KiB. The supported segment sizes can be found by querying the
+	// storage systems capabilities endpoint and inspecting the feature parameter's supported segment sizes (the
+	// field is FeatureParameters.supportedSegSizes). A zero value will set a system-specific default value.
+	SegSize              int   `json:"segSize"`
+	DataAssuranceEnabled *bool `json:"dataAssuranceEnabled,omitempty"`
+	// Sets the preferred owning controller. By default this field will alternate between available controllers.
+	OwningControllerID string `json:"owningControllerId,omitempty"`
+	// Values: raidUnsupported, raidAll, raid0, raid1, raid3, raid5, raid6, raidDiskPool, raid1p.
+	RaidLevel string `json:"raidLevel,omitempty"`
+	// Optional array of meta data tags for the volume.
+	MetaTags []VolumeMetadataItem `json:"metaTags,omitempty"`
+	// Optional. The block size (in bytes) of the volume as seen by the initiator. The block size must be set to a
+	// supported value. If the block size is not set or is set to a value of 0, the controller will select the
+	// appropriate block size.
+	BlockSize *int `json:"blockSize,omitempty"`
+}
+
+// VolumeDeleteResponse is the VolumeDeleteResponse model.
+// The volume delete response.
+type VolumeDeleteResponse struct {
+	// True if deletion is done in the background, otherwise false.
+	BackgroundOperation bool `json:"backgroundOperation"`
+}
+
+// VolumeEx is the VolumeEx model.
+type VolumeEx struct {
+	// An indication of whether the volume is currently offline.
+	Offline bool `json:"offline,omitempty"`
+	// This field has been deprecated and should not be used.
+	ExtremeProtection bool `json:"extremeProtection,omitempty"`
+	// The internal identifier value associated with this volume. When the Storage Partitions feature is NOT
+	// present, this value represents the logical unit number (LUN) that is used to access the volume via the host
+	// I/O path. If the Storage Partitions feature IS enabled, this field has no external meaning.
+	VolumeHandle int `json:"volumeHandle,omitempty"`
+	// The RAID level for which this volume is configured. Values: raidUnsupported, raidAll, raid0, raid1, raid3,
+	// raid5, raid6, raidDiskPool, raid1p.
+	RaidLevel string `json:"raidLevel,omitempty"`
+	// The sector offset of this volume within its volume group. This value is provided only to allow the client
+	// application to determine the relative positions of multiple volumes within a given volume group. No other
+	// assumptions or computations should be made with this value.
+	SectorOffset string `json:"sectorOffset,omitempty"`
+	// A variable-length opaque field that provides the volume's worldwide unique identification value.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The user-assigned symbol label associated with the volume.
+	Label string `json:"label,omitempty"`
+	// The block size (in bytes) of the volume.
+	BlkSize int `json:"blkSize,omitempty"`
+	// The capacity (in bytes) of the volume.
+	Capacity string `json:"capacity,omitempty"`
+	// The reconstruction priority value for the volume. This value is in the range 0-4, with 0 indicating that
+	// reconstruction is favored over any I/O, and 4 indicating that reconstruction should be performed with minimal
+	// impact to I/O. Intermediate values indicate various levels of compromise between these two extremes.
+	ReconPriority int `json:"reconPriority,omitempty"`
+	// The segment size (in bytes) of the volume. The segment size is, for the most part, an indication of the
+	// stripe depth used to lay out the volume's block addresses.
+	SegmentSize int `json:"segmentSize,omitempty"`
+	// An indication of what current long-running activity, if any, is currently in progress on the volume. Values:
+	// none, copyback, initializing, reconstructing, remappingDce, remappingDrm, remappingDcedrm, remappingDseg,
+	// remappingDve, remappingDcedve, remappingInternal, remappingDefrag, formatting, synchronizing, parityScan,
+	// volumeCopy, snapshotRollback, pitRollback, asyncMirrorGroupInitialSync, reconstructingCritical, rebalancing,
+	// copyThenFail, copyThenFailPending, copyThenReplace, copyThenReplaceAndFail, thinDefrag, creating, deleting,
+	// offlineFormatting, driveSpecific.
+	Action string `json:"action,omitempty"`
+	// The volume-specific caching parameters currently in effect for the volume.
+	Cache VolumeCache `json:"cache,omitzero"`
+	// The volume-specific media scan settings currently in effect for the volume.
+	MediaScan VolumeMediaScanParams `json:"mediaScan,omitzero"`
+	// The unique identification value for this volume. Other objects may use this reference value to refer to the
+	// volume.
+	VolumeRef string `json:"volumeRef,omitempty"`
+	// The current operational state of the volume. Values: optimal, degraded, failed, impaired, creating, deleting.
+	Status string `json:"status,omitempty"`
+	// The volume group reference value of the volume group on which this volume resides.
+	VolumeGroupRef string `json:"volumeGroupRef,omitempty"`
+	// The reference value of the controller that currently owns (i.e. manages) this volume. In non-AVT (Auto Volume
+	// Transfer) mode, host I/O access to the blocks in this volume is permitted only through the controller that is
+	// the current manager. In AVT mode, an I/O access to the controller that is not the current manager causes it
+	// to automatically become the current manager.
+	CurrentManager string `json:"currentManager,omitempty"`
+	// The reference value of the controller that is the preferred manager of the volume. The preferred manager is
+	// tracked independent of the current manager, since the current manager may be switched dynamically as a result
+	// of host-base multi-path transitions. Later, when the error conditions that cause a multi-path transition have
+	// been corrected it is still possible to determine the preferred manager and switch ownership back to it.
+	PreferredManager string `json:"preferredManager,omitempty"`
+	// Operations allowed on this volume.
+	Perms VolumePerms `json:"perms,omitzero"`
+	// For use by management client.
+	MgmtClientAttribute int `json:"mgmtClientAttribute,omitempty"`
+	// True if dynamic segment sizing pre-allocation is enabled for the volume.
+	DssPreallocEnabled bool `json:"dssPreallocEnabled,omitempty"`
+	// The maximum segment size (in bytes) that this volume could assume with the dynamic segment sizing feature.
+	// This field is only meaningful if dssPreallocEnabled is true.
+	DssMaxSegmentSize int `json:"dssMaxSegmentSize,omitempty"`
+	// If set to true, then the storage controller will, on READ operations, verify the consistency of RAID
+	// redundancy data for the stripe(s) containing the READ data.
+	PreReadRedundancyCheckEnabled bool `json:"preReadRedundancyCheckEnabled,omitempty"`
+	// This field has been deprecated and should not be used.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// The protection type of the volume. Values: type0Protection, type1Protection, type2Protection,
+	// type3Protection.
+	ProtectionType string `json:"protectionType,omitempty"`
+	// True if application tag is owned by the storage array.
+	ApplicationTagOwned bool `json:"applicationTagOwned,omitempty"`
+	// This element contains a count of the number of blocks in the volume that have had data assurance errors
+	// repaired.
+	RepairedBlockCount int `json:"repairedBlockCount,omitempty"`
+	// A variable-length opaque field that provides the volume's extended unique identification value.
+	ExtendedUniqueIdentifier string `json:"extendedUniqueIdentifier,omitempty"`
+	// True if checking of protection information during cache mirroring is enabled for this volume, false otherwise
+	CacheMirroringValidateProtectionInformation bool `json:"cacheMirroringValidateProtectionInformation,omitempty"`
+	// Expected Protection Information Application Tag for the volume
+	ExpectedProtectionInformationAppTag int `json:"expectedProtectionInformationAppTag,omitempty"`
+	// An indication as to whether a host is allowed to issue unmap commands to the volume
+	HostUnmapEnabled bool `json:"hostUnmapEnabled,omitempty"`
+	// The cache pool ID this volume is assigned to. If no specific cache pool ID is assigned to the volume, this
+	// field is set to 0. The range for cache pool ID is from 1 to maxCachePoolID, which can be obtained from the
+	// maxCachePoolID field in the FeatureParams struct based on the number of cache pools supported by the
+	// controller.
+	CachePoolID int `json:"cachePoolID,omitempty"`
+	// This reports the physical block size (in bytes) used when sending I/O to the underlying drives.
+	BlkSizePhysical int `json:"blkSizePhysical,omitempty"`
+	// Number of data drives in a RAID stripe.
+	DataDriveCount int `json:"dataDriveCount,omitempty"`
+	// Number of parity drives in a RAID stripe.
+	ParityDriveCount int `json:"parityDriveCount,omitempty"`
+	// Allocation granularity size (in bytes) of the volume. Use to determine how much physical capacity will be
+	// consumed during a volume expansion. Note that in a disk pool, different volumes may have different values
+	// (i.e. because of different RAID levels and/or different stripe widths).
+	AllocGranularity string `json:"allocGranularity,omitempty"`
+	// Values: standardVolume, repositoryVolume, mirrorTargetVolume, mirrorSourceVolume, concatVolume,
+	// freeRepositoryVolume, asyncMirrorSourceVolume, asyncMirrorTargetVolume.
+	VolumeUse  string `json:"volumeUse,omitempty"`
+	VolumeFull bool   `json:"volumeFull,omitempty"`
+	// This value is true if the volume is currently the target of a volume copy pair.
+	VolumeCopyTarget bool `json:"volumeCopyTarget,omitempty"`
+	// This value is true if the volume is currently the source in a volume copy pair.
+	VolumeCopySource bool `json:"volumeCopySource,omitempty"`
+	PitBaseVolume    bool `json:"pitBaseVolume,omitempty"`
+	// This value is true if the volume is currently the target in an Asynchronous Mirror relationship.
+	AsyncMirrorTarget bool `json:"asyncMirrorTarget,omitempty"`
+	// This value is true if the volume is currently the source in an Asynchronous Mirror relationship.
+	AsyncMirrorSource bool `json:"asyncMirrorSource,omitempty"`
+	// True if the volume is a source (primary), in an Remote Volume Mirror relationship.
+	RemoteMirrorSource bool `json:"remoteMirrorSource,omitempty"`
+	// True if the volume is a target (secondary), in an Remote Volume Mirror relationship.
+	RemoteMirrorTarget bool `json:"remoteMirrorTarget,omitempty"`
+	// True if the volume was defined on a DiskPool.
+	DiskPool bool `json:"diskPool,omitempty"`
+	// True if this volume is added to a flashCache.
+	FlashCached bool `json:"flashCached,omitempty"`
+	// If a Dynamic Volume Expansion (DVE) is in progress, the total capacity the volume is being increased by,
+	// otherwise zero.
+	IncreasingBy string `json:"increasingBy,omitempty"`
+	// Metadata associated with the volume
+	Metadata      []VolumeMetadataItem `json:"metadata,omitempty"`
+	DataAssurance bool                 `json:"dataAssurance,omitempty"`
+	Name          string               `json:"name,omitempty"`
+	ID            string               `json:"id,omitempty"`
+	// Values: unknown, volume, pool, host, lunMapping, hostGroup, thinVolume, drive, volumeCopy, pit, pitView,
+	// snapshotGroup, snapshot, accessVolume, legacySnapshot, hostType, metadataTag, managementUrl, folder,
+	// asyncMirrorGroup, asyncMirrorGroupMember, asyncMirrorGroupIncompleteMember, consistencyGroup,
+	// consistencyGroupView, fan, battery, storageSystem, controller, powerSupply, minihub, esm, drawer, hostBoard,
+	// interconnectCRU, cacheBackupDevice, tray, supportCRU, hostPort, initiator, snapshotSchedule, thermalSensor,
+	// sfp, flashCache, featureAttribute, featureState, lockKeyId, remoteVolume, mirrorVolume, vaultMirrorVolume,
+	// vaultMirrorGroup, metadataVolume, sasPort, sasExpander, channelPort, speedNegError, snmpAgentBundle,
+	// stagedFirmware, workload, ekmsCertRevocatSettings, databaseMetadata, directoryServicesDomain,
+	// kmsCertificateExpirationDetails, externalKMSEndpoint, notOnPreferredPath, remoteStorageVolume, remoteStorage,
+	// alarm.
+	ObjectType          string `json:"objectType,omitempty"`
+	CurrentControllerID string `json:"currentControllerId,omitempty"`
+	WWN                 string `json:"wwn,omitempty"`
+	// The volume-specific caching parameters currently in effect for the volume.
+	CacheSettings         VolumeCache  `json:"cacheSettings"`
+	PreferredControllerID string       `json:"preferredControllerId,omitempty"`
+	TotalSizeInBytes      string       `json:"totalSizeInBytes,omitempty"`
+	ThinProvisioned       bool         `json:"thinProvisioned,omitempty"`
+	Mapped                bool         `json:"mapped"`
+	ListOfMappings        []LUNMapping `json:"listOfMappings,omitempty"`
+	OnlineVolumeCopy      bool         `json:"onlineVolumeCopy,omitempty"`
+}
+
+// VolumeExpansionRequest is the VolumeExpansionRequest model.
+// Expands a given volume.
+type VolumeExpansionRequest struct {
+	// Values: bytes, b, kb, mb, gb, tb, pb, eb, zb, yb.
+	SizeUnit string `json:"sizeUnit"`
+	// The expansion size. Note that the specified expansion size must be greater than the current capacity. Refer
+	// to the 'sizeUnit' field.
+	ExpansionSize string `json:"expansionSize"`
+}
+
+// VolumeGroup is the VolumeGroup model.
+type VolumeGroup struct {
+	// A sequence number that uniquely identifies this volume group within the array in which it resides. Sequence
+	// numbers are assigned to volume groups when they are created or imported into a storage array. The controller
+	// assigns the lowest unused sequence number during a creation or import operation.
+	SequenceNum int `json:"sequenceNum,omitempty"`
+	// An indication of whether the volume group is currently offline. A volume group can be taken offline by the
+	// operator in preparation for removing its drives and transporting them to another storage array.
+	Offline bool `json:"offline,omitempty"`
+	// The RAID level associated with this volume group. If the storage array supports multiple RAID levels per
+	// volume group, this value will be set to RAID_ALL. Otherwise, it will indicate the precise RAID level defined
+	// for the volume group. Values: raidUnsupported, raidAll, raid0, raid1, raid3, raid5, raid6, raidDiskPool,
+	// raid1p.
+	RaidLevel string `json:"raidLevel,omitempty"`
+	// A variable-length opaque field that provides the volume group's worldwide unique identification value.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The unique identification value for this volume group. Other objects may use this reference value to refer to
+	// the volume group.
+	VolumeGroupRef string `json:"volumeGroupRef,omitempty"`
+	Reserved1      string `json:"reserved1,omitempty"`
+	Reserved2      string `json:"reserved2,omitempty"`
+	// An indication as to whether the volume group has tray loss protection.
+	TrayLossProtection bool `json:"trayLossProtection,omitempty"`
+	// The volume group label, which can be set by the user.
+	Label string `json:"label,omitempty"`
+	// The state of the volume group. Values: contingent, exported, forced, complete, partial, incomplete, missing.
+	State string `json:"state,omitempty"`
+	// Set to true if all of the drives in the group have matching normalized spindle speeds. (Normalized spindle
+	// speeds are standardized norms (e.g., 5400, 7200, etc.) that are used for spindle speed matching, even though
+	// actual spindle speeds may be within a designated delta of the normalized speed.)
+	SpindleSpeedMatch bool `json:"spindleSpeedMatch,omitempty"`
+	// Set to true if all of the drives in the group have matching normalized spindle speeds. (Normalized spindle
+	// speeds are standardized norms (e.g., 5400, 7200, etc.) that are used for spindle speed matching, even though
+	// actual spindle speeds may be within a designated delta of the normalized speed.)
+	SpindleSpeed int `json:"spindleSpeed,omitempty"`
+	// True if the volume group contains incompatible drives that have been locked out.
+	IsInaccessible bool `json:"isInaccessible,omitempty"`
+	// security status of the drive group Values: unknown, none, capable, enabled.
+	SecurityType string `json:"securityType,omitempty"`
+	// This field is set to true when the volume group has drawer loss protection; otherwise it is set to false
+	DrawerLossProtection bool `json:"drawerLossProtection,omitempty"`
+	// This field has been deprecated and should not be used.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// This structure contains a protectionInformationCapable field which is set to true if all drives in the volume
+	// group are Protection Information (PI) capable. It also contains a field that is set to the protection type
+	// for the volume group.
+	ProtectionInformationCapabilities ProtectionInformationCapabilities `json:"protectionInformationCapabilities,omitzero"`
+	// Information about the Volume Group.
+	VolumeGroupData VolumeGroupTypeData `json:"volumeGroupData,omitzero"`
+	// the volume group usage. Values: unknown, standard, flashcache.
+	Usage string `json:"usage,omitempty"`
+	// Identifies the drive block format of the volume candidate. Values: unknown, allNative, allEmulated, mixed.
+	DriveBlockFormat string `json:"driveBlockFormat,omitempty"`
+	// This field will be used to report whether or not reserved space has been claimed on all drives in the volume
+	// group. The recovery-volume script will include this field for each volume group record it creates, so that
+	// during volume recovery the volume group is recreated correctly.
+	ReservedSpaceAllocated bool `json:"reservedSpaceAllocated,omitempty"`
+	// Refines the information in the securityType field to describe the set of drives. Values: unknown, none,
+	// mixed, fde, fips.
+	SecurityLevel string `json:"securityLevel,omitempty"`
+	// An indication as to whether DULBE is enabled on the drives in the volume group.
+	DulbeEnabled bool `json:"dulbeEnabled,omitempty"`
+	// Lists the block size(s) supported for volumes created in this volume group.
+	BlkSizeSupported []int `json:"blkSizeSupported,omitempty"`
+	// The recommended block size for volumes created within this volume group.
+	BlkSizeRecommended int    `json:"blkSizeRecommended,omitempty"`
+	Name               string `json:"name,omitempty"`
+	ID                 string `json:"id,omitempty"`
+}
+
+// VolumeGroupConfig is the VolumeGroupConfig model.
+// A RAID group for configuring volumes
+type VolumeGroupConfig struct {
+	// List of drives
+	Drives []DriveLocation `json:"drives"`
+	// A unique label
+	Label string `json:"label"`
+	// The type of security used during creation Values: none, capable, enabled.
+	SecurityType string `json:"securityType"`
+	// Indicates whether reserved space is allocated on the drives during volume creation
+	ReservedSpaceAllocated bool `json:"reservedSpaceAllocated"`
+	// The RAID level of the volume group Values: raid0, raid1, raid3, raid5, raid6.
+	RaidLevel string `json:"raidLevel"`
+}
+
+// VolumeGroupEx is the VolumeGroupEx model.
+type VolumeGroupEx struct {
+	// A sequence number that uniquely identifies this volume group within the array in which it resides. Sequence
+	// numbers are assigned to volume groups when they are created or imported into a storage array. The controller
+	// assigns the lowest unused sequence number during a creation or import operation.
+	SequenceNum int `json:"sequenceNum,omitempty"`
+	// An indication of whether the volume group is currently offline. A volume group can be taken offline by the
+	// operator in preparation for removing its drives and transporting them to another storage array.
+	Offline bool `json:"offline,omitempty"`
+	// The RAID level associated with this volume group. If the storage array supports multiple RAID levels per
+	// volume group, this value will be set to RAID_ALL. Otherwise, it will indicate the precise RAID level defined
+	// for the volume group. Values: raidUnsupported, raidAll, raid0, raid1, raid3, raid5, raid6, raidDiskPool,
+	// raid1p.
+	RaidLevel string `json:"raidLevel,omitempty"`
+	// A variable-length opaque field that provides the volume group's worldwide unique identification value.
+	WorldWideName string `json:"worldWideName,omitempty"`
+	// The unique identification value for this volume group. Other objects may use this reference value to refer to
+	// the volume group.
+	VolumeGroupRef string `json:"volumeGroupRef,omitempty"`
+	Reserved1      string `json:"reserved1,omitempty"`
+	Reserved2      string `json:"reserved2,omitempty"`
+	// An indication as to whether the volume group has tray loss protection.
+	TrayLossProtection bool `json:"trayLossProtection,omitempty"`
+	// The volume group label, which can be set by the user.
+	Label string `json:"label,omitempty"`
+	// The state of the volume group. Values: contingent, exported, forced, complete, partial, incomplete, missing.
+	State string `json:"state,omitempty"`
+	// Set to true if all of the drives in the group have matching normalized spindle speeds. (Normalized spindle
+	// speeds are standardized norms (e.g., 5400, 7200, etc.) that are used for spindle speed matching, even though
+	// actual spindle speeds may be within a designated delta of the normalized speed.)
+	SpindleSpeedMatch bool `json:"spindleSpeedMatch,omitempty"`
+	// The spindle speed of the drives composing the pool (RPM)
+	SpindleSpeed int `json:"spindleSpeed,omitempty"`
+	// True if the volume group contains incompatible drives that have been locked out.
+	IsInaccessible bool `json:"isInaccessible,omitempty"`
+	// security status of the drive group Values: unknown, none, capable, enabled.
+	SecurityType string `json:"securityType,omitempty"`
+	// This field is set to true when the volume group has drawer loss protection; otherwise it is set to false
+	DrawerLossProtection bool `json:"drawerLossProtection,omitempty"`
+	// This field has been deprecated and should not be used.
+	ProtectionInformationCapable bool `json:"protectionInformationCapable,omitempty"`
+	// This structure contains a protectionInformationCapable field which is set to true if all drives in the volume
+	// group are Protection Information (PI) capable. It also contains a field that is set to the protection type
+	// for the volume group.
+	ProtectionInformationCapabilities ProtectionInformationCapabilities `json:"protectionInformationCapabilities,omitzero"`
+	// Information about the Volume Group.
+	VolumeGroupData VolumeGroupTypeData `json:"volumeGroupData,omitzero"`
+	// the volume group usage. Values: unknown, standard, flashcache.
+	Usage string `json:"usage,omitempty"`
+	// Identifies the drive block format of the volume candidate. Values: unknown, allNative, allEmulated, mixed.
+	DriveBlockFormat string `json:"driveBlockFormat,omitempty"`
+	// This field will be used to report whether or not reserved space has been claimed on all drives in the volume
+	// group. The recovery-volume script will include this field for each volume group record it creates, so that
+	// during volume recovery the volume group is recreated correctly.
+	ReservedSpaceAllocated bool `json:"reservedSpaceAllocated,omitempty"`
+	// Refines the information in the securityType field to describe the set of drives. Values: unknown, none,
+	// mixed, fde, fips.
+	SecurityLevel string `json:"securityLevel,omitempty"`
+	// An indication as to whether DULBE is enabled on the drives in the volume group.
+	DulbeEnabled bool `json:"dulbeEnabled,omitempty"`
+	// Lists the block size(s) supported for volumes created in this volume group.
+	BlkSizeSupported []int `json:"blkSizeSupported,omitempty"`
+	// The recommended block size for volumes created within this volume group.
+	BlkSizeRecommended int    `json:"blkSizeRecommended,omitempty"`
+	UsedSpace          string `json:"usedSpace,omitempty"`
+	// Raw capacity of the volumeGroup
+	TotalRaidedSpace      string       `json:"totalRaidedSpace,omitempty"`
+	Extents               []FreeExtent `json:"extents,omitempty"`
+	LargestFreeExtentSize string       `json:"largestFreeExtentSize,omitempty"`
+	// The raid status reflects the worst case raid level of any volume on the group Values: optimal, degraded,
+	// failed, impaired, creating, deleting.
+	RaidStatus string `json:"raidStatus,omitempty"`
+	FreeSpace  string `json:"freeSpace,omitempty"`
+	// Defines the physical interface type of the underlying drives. Values: all, scsi, fibre, sata, pata,
+	// fibre520b, sas, unknown, sas4k, nvme4k.
+	DrivePhysicalType string `json:"drivePhysicalType,omitempty"`
+	// Defines whether is an SSD or a spinning drive. Values: all, unknown, hdd, ssd.
+	DriveMediaType string `json:"driveMediaType,omitempty"`
+	// The normalized spindle speed of the drives composing the pool. Ex: 7200, 5400, 15000. If drives have
+	// mismatched spindle speeds, this value may not be accurate. Values: spindleSpeedUnknown, spindleSpeedSSD,
+	// spindleSpeed5400, spindleSpeed7200, spindleSpeed10k, spindleSpeed15k.
+	NormalizedSpindleSpeed string `json:"normalizedSpindleSpeed,omitempty"`
+	ID                     string `json:"id,omitempty"`
+	// True if the RAID Level is defined as raidDiskPool.
+	DiskPool bool   `json:"diskPool,omitempty"`
+	Name     string `json:"name,omitempty"`
+}
+
+// VolumeGroupExpansionCandidate is the VolumeGroupExpansionCandidate model.
+type VolumeGroupExpansionCandidate struct {
+	// An array of references to drives comprising the set that can be used to expand the volume group.
+	Drives []string `json:"drives,omitempty"`
+	// Set to true if the expanded volume group will have tray loss protection.
+	TrayLossProtection bool `json:"trayLossProtection,omitempty"`
+	// The number of bytes of wasted capacity in the given drive set if is used to expand the volume. Wasting of
+	// capacity occurs when the drives in the set are larger than the drives in the volume group being expanded.
+	WastedCapacity string `json:"wastedCapacity,omitempty"`
+	// Set to true if the expanded volume group will have uniform spindle speed across its drives.
+	SpindleSpeedMatch bool `json:"spindleSpeedMatch,omitempty"`
+	// Set to true if the expanded volume group will have drawer loss protection.
+	DrawerLossProtection bool `json:"drawerLossProtection,omitempty"`
+	// The total usable capacity of the expansion candidate in bytes.
+	UsableCapacity string `json:"usableCapacity,omitempty"`
+	// Identifies the drive block format of the volume candidate. Values: unknown, allNative, allEmulated, mixed.
+	DriveBlockFormat string `json:"driveBlockFormat,omitempty"`
+}
+
+// VolumeGroupHotSpareCoverage is the VolumeGroupHotSpareCoverage model.
+type VolumeGroupHotSpareCoverage struct {
+	VolumeGroupRef string `json:"volumeGroupRef,omitempty"`
+	// List of stand by hot spares that can cover this volume group.
+	Coverage []HotSpare `json:"coverage,omitempty"`
+	// List of drives that can cover this volume group if converted to a hot spare.
+	Candidates []DriveWithHotSpareInfo `json:"candidates,omitempty"`
+}
+
+// VolumeGroupTypeData is the VolumeGroupTypeData model.
+type VolumeGroupTypeData struct {
+	// This enumeration is used to indicate the type of the volume group. Values: unknown, traditional, diskPool.
+	Type string `json:"type,omitempty"`
+	// This field is present only if the value of type is equal to VG_TYPE_DISK_POOL.
+	DiskPoolData DiskPoolData `json:"diskPoolData,omitzero"`
+}
+
+// VolumeIOStats is the VolumeIOStats model.
+// A single Volume I/O performance statistics. Note: the DELETE method clears all statistics for all volumes.
+type VolumeIOStats struct {
+	// End time for this collection as measured by the number of seconds since baseTime.
+	ObservedTime      string `json:"observedTime"`
+	ObservedTimeInMS  string `json:"observedTimeInMS"`
+	LastResetTime     string `json:"lastResetTime"`
+	LastResetTimeInMS string `json:"lastResetTimeInMS"`
+	VolumeGroupID     string `json:"volumeGroupId"`
+	ControllerID      string `json:"controllerId"`
+	VolumeID          string `json:"volumeId"`
+	ArrayID           string `json:"arrayId"`
+	ArrayWWN          string `json:"arrayWwn"`
+	VolumeGroupWWN    string `json:"volumeGroupWwn"`
+	VolumeName        string `json:"volumeName"`
+	VolumeWWN         string `json:"volumeWwn"`
+	// The associated workload id
+	WorkloadID string  `json:"workloadId"`
+	ReadOps    float64 `json:"readOps"`
+	// Number of read operations that hit cache
+	ReadHitOps float64 `json:"readHitOps"`
+	// Number of bytes read from cache
+	ReadHitBytes     float64 `json:"readHitBytes"`
+	ReadTimeTotal    float64 `json:"readTimeTotal"`
+	ReadHitTimeTotal float64 `json:"readHitTimeTotal"`
+	WriteOps         float64 `json:"writeOps"`
+	// A redundant field to write Hit Ops
+	WriteCacheHitOps                   float64 `json:"writeCacheHitOps"`
+	WriteTimeTotal                     float64 `json:"writeTimeTotal"`
+	WriteHitTimeTotal                  float64 `json:"writeHitTimeTotal"`
+	ErrRedundancyChkIndeterminateReads float64 `json:"errRedundancyChkIndeterminateReads"`
+	ErrRedundancyChkRecoveredReads     float64 `json:"errRedundancyChkRecoveredReads"`
+	ErrRedundancyChkUnrecoveredReads   float64 `json:"errRedundancyChkUnrecoveredReads"`
+	IdleTime                           float64 `json:"idleTime"`
+	OtherOps                           float64 `json:"otherOps"`
+	OtherTimeMax                       float64 `json:"otherTimeMax"`
+	OtherTimeTotal                     float64 `json:"otherTimeTotal"`
+	OtherTimeTotalSq                   float64 `json:"otherTimeTotalSq"`
+	ReadBytes                          float64 `json:"readBytes"`
+	ReadHitTimeMax                     float64 `json:"readHitTimeMax"`
+	ReadHitTimeTotalSq                 float64 `json:"readHitTimeTotalSq"`
+	ReadTimeMax                        float64 `json:"readTimeMax"`
+	ReadTimeTotalSq                    float64 `json:"readTimeTotalSq"`
+	WriteBytes                         float64 `json:"writeBytes"`
+	// Number of bytes written to cache
+	WriteHitBytes float64 `json:"writeHitBytes"`
+	// Number of write operations that hit cache
+	WriteHitOps         float64 `json:"writeHitOps"`
+	WriteHitTimeMax     float64 `json:"writeHitTimeMax"`
+	WriteHitTimeTotalSq float64 `json:"writeHitTimeTotalSq"`
+	WriteTimeMax        float64 `json:"writeTimeMax"`
+	WriteTimeTotalSq    float64 `json:"writeTimeTotalSq"`
+	// Total channel queue depth.
+	QueueDepthTotal float64 `json:"queueDepthTotal"`
+	// Maximum channel queue depth.
+	QueueDepthMax          float64 `json:"queueDepthMax"`
+	RandomIosTotal         float64 `json:"randomIosTotal"`
+	RandomBytesTotal       float64 `json:"randomBytesTotal"`
+	CacheWriteWaitHitIops  float64 `json:"cacheWriteWaitHitIops"`
+	CacheWriteWaitHitBytes float64 `json:"cacheWriteWaitHitBytes"`
+	// Storage-systems running a firmware version prior to 08.40.+ / 11.40.+ will have this field represent the
+	// number of stripes that use a full stripe write algorithm. storage-systems running a firmware version greater
+	// than or equal to 08.40.+ / 11.40.+ will have this field represent the number bytes that are written in a full
+	// stripe write algorithm.
+	FullStripeWriteBytes float64 `json:"fullStripeWriteBytes"`
+	TotalIosShipped      float64 `json:"totalIosShipped"`
+	TotalBlksEvicted     float64 `json:"totalBlksEvicted"`
+	CacheBlksInUse       float64 `json:"cacheBlksInUse"`
+	// The number of pre-fetched bytes that are ultimately read by the host
+	PrefetchHitBytes float64 `json:"prefetchHitBytes"`
+	// The number of pre-fetched bytes that are evicted from cache without being read by the host.
+	PrefetchMissBytes string `json:"prefetchMissBytes"`
+	// The number of flash cache read hit operations.
+	FlashCacheReadHitOps float64 `json:"flashCacheReadHitOps"`
+	// The number of flash cache read hit bytes.
+	FlashCacheReadHitBytes float64 `json:"flashCacheReadHitBytes"`
+	// The sum of all response times for all reads that have cache hits, in microseconds
+	FlashCacheReadHitTimeTotal float64 `json:"flashCacheReadHitTimeTotal"`
+	// Maximum I/O time in microseconds for any one read I/O that has a cache hit
+	FlashCacheReadHitTimeMax float64 `json:"flashCacheReadHitTimeMax"`
+	// The sum of the squares of the response times for all reads that have cache hits, in microseconds (used for
+	// standard deviation).
+	FlashCacheReadHitTimeTotalSq float64 `json:"flashCacheReadHitTimeTotalSq"`
+}
+
+// VolumeMappingCreateRequest is the VolumeMappingCreateRequest model.
+// Creates a Volume Mapping - maps a Volume to a Host or Host Group.
+type VolumeMappingCreateRequest struct {
+	// The mappable object such as a volume or snapshot volume.
+	MappableObjectID string `json:"mappableObjectId"`
+	// The host group or a host for the volume mapping. If left null, the default host group will be used
+	TargetID string `json:"targetId,omitempty"`
+	// The LUN for the volume mapping.
+	Lun *int `json:"lun,omitempty"`
+}
+
+// VolumeMappingMoveRequest is the VolumeMappingMoveRequest model.
+// Move a volume mapping from a Host/Group to another Host/Group
+type VolumeMappingMoveRequest struct {
+	// The host group or a host for the volume mapping. If left null, the default host group will be used
+	TargetID string `json:"targetId,omitempty"`
+	// The LUN for the volume mapping.
+	Lun *int `json:"lun,omitempty"`
+}
+
+// VolumeMediaScanParams is the VolumeMediaScanParams model.
+type VolumeMediaScanParams struct {
+	// A true value means that the controller should perform a media scan for this volume as part of its background
+	// processing. A false value means that the volume should not participate in media scans.
+	Enable bool `json:"enable,omitempty"`
+	// A true value means that, in addition to ensuring that the volume's media blocks are fully accessible, the
+	// controller should also compute and validate all parity blocks for the volume during the media scan. A false
+	// value means that parity validation should not occur in conjunction with media checking. Note that this field
+	// has no significance unless the enable field is set to true.
+	ParityValidationEnable bool `json:"parityValidationEnable,omitempty"`
+}
+
+// VolumeMediaScanSettings is the VolumeMediaScanSettings model.
+// Background media scan settings for a volume
+type VolumeMediaScanSettings struct {
+	// Indicates whether the controller should perform a media scan for this volume as part of its background
+	// processing
+	Enabled bool `json:"enabled"`
+	// Indicates whether the background media scan should include computation and validation of all parity blocks
+	// for the volume during the media scan
+	ParityValidationEnabled bool `json:"parityValidationEnabled"`
+}
+
+// VolumeMetadataItem is the VolumeMetadataItem model.
+// Key/Value pair for volume meta data
+type VolumeMetadataItem struct {
+	// They key for the property. Some keys are reserved include:workload
+	Key string `json:"key,omitempty"`
+	// The value associated with the key
+	Value string `json:"value,omitempty"`
+}
+
+// VolumePerms is the VolumePerms model.
+type VolumePerms struct {
+	// Can this volume be mapped to a LUN
+	MapToLUN bool `json:"mapToLUN,omitempty"`
+	// This field has been deprecated and should not be used.
+	SnapShot bool `json:"snapShot,omitempty"`
+	// This is true if the volume can be formatted.
+	Format bool `json:"format,omitempty"`
+	// This is true if the volume can be reconfigured (DVE, DCE, DRM, etc.).
+	Reconfigure bool `json:"reconfigure,omitempty"`
+	// This is true if the volume can be a primary mirror.
+	MirrorPrimary bool `json:"mirrorPrimary,omitempty"`
+	// This is true if the volume can be a secondary mirror.
+	MirrorSecondary bool `json:"mirrorSecondary,omitempty"`
+	// This is true if the volume can be the source for a copy.
+	CopySource bool `json:"copySource,omitempty"`
+	// This is true if the volume can be the target for a copy.
+	CopyTarget bool `json:"copyTarget,omitempty"`
+	// This is true if the volume can be read by a host.
+	Readable bool `json:"readable,omitempty"`
+	// Can this volume be written by a host
+	Writable bool `json:"writable,omitempty"`
+	// Set to true if a snapshot rollback can be applied to this volume.
+	Rollback bool `json:"rollback,omitempty"`
+	// Set to true if a remote volume mirror can actively synchronize this volume's data
+	MirrorSync bool `json:"mirrorSync,omitempty"`
+	// Set to true when a new point-in-time image can be taken of this volume, either by creating a new snapshot or
+	// re-creating an existing snapshot.
+	NewImage bool `json:"newImage,omitempty"`
+	// If true, this volume can be expanded via DVE. If false, this will still allow general reconfigures (providing
+	// the 'reconfigure' field is true), but disallow DVE specifically.
+	AllowDVE bool `json:"allowDVE,omitempty"`
+	// If true, this volume can undergo a segment size change (DSS). If false, this will still allow general
+	// reconfigures (providing the 'reconfigure' field is true), but disallow DSS specifically.
+	AllowDSS bool `json:"allowDSS,omitempty"`
+	// If true, this volume can be a member of a ConcatVolume.
+	ConcatVolumeMember bool `json:"concatVolumeMember,omitempty"`
+	// If true, this volume can have flash read cache enabled.
+	FlashReadCache bool `json:"flashReadCache,omitempty"`
+	// If true, this volume can be an Async RVM primary.
+	AsyncMirrorPrimary bool `json:"asyncMirrorPrimary,omitempty"`
+	// If true, this volume can be an Async RVM primary.
+	AsyncMirrorSecondary bool `json:"asyncMirrorSecondary,omitempty"`
+	// If true, a PiT group can be created on this volume.
+	PitGroup bool `json:"pitGroup,omitempty"`
+	// If true, cache parameters may be changed on this volume.
+	CacheParametersChangeable bool `json:"cacheParametersChangeable,omitempty"`
+	// If true, changes to manual mode while the thin volume is in an Asynchronous Mirror Group (AMG) are allowed.
+	AllowThinManualExpansion bool `json:"allowThinManualExpansion,omitempty"`
+	// If true, changes to the growth parameters (quota or warning threshold values) while the thin volume is in an
+	// Asynchronous Mirror Group (AMG) are allowed.
+	AllowThinGrowthParametersChange bool `json:"allowThinGrowthParametersChange,omitempty"`
+	// This is true if the volume can be an import target
+	AllowImportTarget bool `json:"allowImportTarget,omitempty"`
+}
+
+// VolumeTypeParameters is the VolumeTypeParameters model.
+type VolumeTypeParameters struct {
+	// This enumeration is used to identify the capacity provisioning of a volume. Values: unknown, thinProvisioned,
+	// thickProvisioned.
+	VolumeType string `json:"volumeType,omitempty"`
+	// This field is returned if the value of returnCode is equal to RETCODE_OK.
+	CapacityProvisioningDetails ThinVolumeCapacityProvisioningDetails `json:"capacityProvisioningDetails,omitzero"`
+}
+
+// VolumeUpdateRequest is the VolumeUpdateRequest model.
+// Updates a given volume.
+type VolumeUpdateRequest struct {
+	// The user label to assign to the new volume.
+	Name string `json:"name,omitempty"`
+	// Sets the preferred owning controller.
+	OwningControllerID string              `json:"owningControllerId,omitempty"`
+	CacheSettings      VolumeCacheSettings `json:"cacheSettings,omitzero"`
+	// If true, add the volume to the flashCache if one is defined. If false, remove from the flashCache if one
+	// exists.
+	FlashCache   *bool                 `json:"flashCache,omitempty"`
+	ScanSettings VolumeMediaScanParams `json:"scanSettings,omitzero"`
+	// Optional array of Meta Data tags for the volume.
+	MetaTags []VolumeMetadataItem `json:"metaTags,omitempty"`
+	// Sets cache pool id.
+	CachePoolID *int `json:"cachePoolID,omitempty"`
+}
+
+// VolumeUsageHint is the VolumeUsageHint model.
+type VolumeUsageHint struct {
+	// How the volume will be used (e.g. multimedia, transactional, file system). Values: multimedia, transactional,
+	// filesystem.
+	UsageHint string `json:"usageHint,omitempty"`
+	// The volume segment size.
+	SegmentSize int `json:"segmentSize,omitempty"`
+	// A true (non-zero) / false (zero) indicator of whether or not automatic cache read-ahead is enabled.
+	ReadAhead int `json:"readAhead,omitempty"`
+}
+
+// Workload is the Workload model.
+type Workload struct {
+	// The unique handle for the object contained within this structure (a 1st class object).
+	WorkloadRef string `json:"workloadRef,omitempty"`
+	// The workload description describes the workload (not the workload type).
+	Description string `json:"description,omitempty"`
+}
+
+// WorkloadAttribute is the WorkloadAttribute model.
+// An attribute assigned to a work load. This is completely arbitrary and can be used for any purpose by the user..
+type WorkloadAttribute struct {
+	// The key for this attribute.
+	Key string `json:"key"`
+	// The value for the attribute.
+	Value string `json:"value"`
+}
+
+// WorkloadCopyRequest is the WorkloadCopyRequest model.
+// Copies a workload and names it with the given name
+type WorkloadCopyRequest struct {
+	// Name of the new workload.
+	NewWorkloadName string `json:"newWorkloadName"`
+	// If true, volumes tagged to the old workload will be moved to be tagged to the new workload
+	ChangeVolumeTags *bool `json:"changeVolumeTags,omitempty"`
+}
+
+// WorkloadCreateRequest is the WorkloadCreateRequest model.
+// A workload is a tag that can be assigned to a volume. It is completely arbitrary and can be used for any purpose
+// by the user..
+type WorkloadCreateRequest struct {
+	// The id for this workload.
+	Name string `json:"name,omitempty"`
+	// Key/Value pairs to assigned to this workload.
+	WorkloadAttributes []WorkloadAttribute `json:"workloadAttributes,omitempty"`
+}
+
+// WorkloadEvent is the WorkloadEvent model.
+type WorkloadEvent struct {
+	Timestamp   string `json:"timestamp"`
+	EventNumber string `json:"eventNumber"`
+	// Values: objectGraphChangeEvent, passwordStatusEvent, storageSystemStatusEvent, storageSystemMDChangeEvent,
+	// folderEvent, metadataTagEvent, softwareDownloadEvent, softwareReloadEvent, asupConfigChangeEvent,
+	// iomConfigChangeEvent, alertConfigChangeEvent, firmwareDownloadEvent, criticalMelChangeEvent,
+	// statisticsCollectionEvent, melClearEvent, workload, ldapConfigChangeEvent, symbolPortChangeEvent,
+	// keyStoreChangeEvent, trustStoreChangeEvent, untrustedCertificateChangeEvent, trustedCertificateChangeEvent,
+	// externalCertChangeEvent, sslReloadCompleteEvent, sessionTimeoutChangeEvent, samlConfigChangeEvent,
+	// batchSystemChangeEvent, sysReplicationChangeEvent, cfwBatchUpgradeStartEvent, cfwBatchUpgradeCompleteEvent,
+	// cfwVersionChangeEvent.
+	EventType       string `json:"eventType"`
+	StorageSystemID string `json:"storageSystemId,omitempty"`
+}
+
+// WorkloadModel is the WorkloadModel model.
+// A workload is a tag that can be assigned to a volume. It is completely arbitrary and can be used for any purpose
+// by the user..
+type WorkloadModel struct {
+	// The id for this workload.
+	ID string `json:"id,omitempty"`
+	// The name for this workload.
+	Name string `json:"name"`
+	// Key/Value pairs to assigned to this workload.
+	WorkloadAttributes []WorkloadAttribute `json:"workloadAttributes,omitempty"`
+}
+
+// WorkloadStatistics is the WorkloadStatistics model.
+// Aggregation of volume statistics for a workload.
+type WorkloadStatistics struct {
+	// End time for this collection as measured by the number of seconds since baseTime.
+	ObservedTime      string `json:"observedTime"`
+	ObservedTimeInMS  string `json:"observedTimeInMS"`
+	LastResetTime     string `json:"lastResetTime"`
+	LastResetTimeInMS string `json:"lastResetTimeInMS"`
+	ArrayID           string `json:"arrayId"`
+	ArrayWWN          string `json:"arrayWwn"`
+	// Hash of member volume ids.
+	MemberIdsHash string `json:"memberIdsHash"`
+	// Number of read operations
+	ReadOps float64 `json:"readOps"`
+	// Number of read operations that hit cache
+	ReadHitOps float64 `json:"readHitOps"`
+	// Number of bytes read from cache
+	ReadHitBytes     float64 `json:"readHitBytes"`
+	ReadTimeTotal    float64 `json:"readTimeTotal"`
+	ReadHitTimeTotal float64 `json:"readHitTimeTotal"`
+	// Number of write operations
+	WriteOps                           float64 `json:"writeOps"`
+	WriteTimeTotal                     float64 `json:"writeTimeTotal"`
+	WriteHitTimeTotal                  float64 `json:"writeHitTimeTotal"`
+	ErrRedundancyChkIndeterminateReads float64 `json:"errRedundancyChkIndeterminateReads"`
+	ErrRedundancyChkRecoveredReads     float64 `json:"errRedundancyChkRecoveredReads"`
+	ErrRedundancyChkUnrecoveredReads   float64 `json:"errRedundancyChkUnrecoveredReads"`
+	IdleTime                           float64 `json:"idleTime"`
+	OtherOps                           float64 `json:"otherOps"`
+	OtherTimeMax                       float64 `json:"otherTimeMax"`
+	OtherTimeTotal                     float64 `json:"otherTimeTotal"`
+	ReadBytes                          float64 `json:"readBytes"`
+	ReadHitTimeMax                     float64 `json:"readHitTimeMax"`
+	ReadTimeMax                        float64 `json:"readTimeMax"`
+	WriteBytes                         float64 `json:"writeBytes"`
+	// Number of bytes written to cache
+	WriteHitBytes float64 `json:"writeHitBytes"`
+	// Number of write operations that hit cache
+	WriteHitOps     float64 `json:"writeHitOps"`
+	WriteHitTimeMax float64 `json:"writeHitTimeMax"`
+	WriteTimeMax    float64 `json:"writeTimeMax"`
+	// Total channel queue depth.
+	QueueDepthTotal float64 `json:"queueDepthTotal"`
+	// Maximum channel queue depth.
+	QueueDepthMax float64 `json:"queueDepthMax"`
+	// The number of flash cache read hit operations.
+	FlashCacheReadHitOps float64 `json:"flashCacheReadHitOps"`
+	// The number of flash cache read hit bytes.
+	FlashCacheReadHitBytes float64 `json:"flashCacheReadHitBytes"`
+	// The sum of all response times for all reads that have cache hits, in microseconds
+	FlashCacheReadHitTimeTotal float64 `json:"flashCacheReadHitTimeTotal"`
+	// Maximum I/O time in microseconds for any one read I/O that has a cache hit
+	FlashCacheReadHitTimeMax float64 `json:"flashCacheReadHitTimeMax"`
+	// he workload id of the collection
+	WorkloadID string `json:"workloadId"`
+}
+
+// WorkloadUpdateRequest is the WorkloadUpdateRequest model.
+// A workload is a tag that can be assigned to a volume. It is completely arbitrary and can be used for any purpose
+// by the user..
+type WorkloadUpdateRequest struct {
+	// Key/Value pairs to assigned to this workload.
+	WorkloadAttributes []WorkloadAttribute `json:"workloadAttributes,omitempty"`
+}
+
+// WorkloadVolumeMapping is the WorkloadVolumeMapping model.
+type WorkloadVolumeMapping struct {
+	// This field is the unique identifier for the mapping between the workload and the volume.
+	MappingRef string `json:"mappingRef,omitempty"`
+	// This field is the unique identifier for the Workload.
+	WorkloadRef string `json:"workloadRef,omitempty"`
+	// This field is the unique identifier for the volume.
+	VolumeRef string `json:"volumeRef,omitempty"`
+}
+
+// X509CertInfo is the X509CertInfo model.
+// Basic information for X509 Certs stored in the system.
+type X509CertInfo struct {
+	// The alias for the certificate. This value will only be present if the certificate is in the proxy server
+	// truststore.
+	Alias string `json:"alias,omitempty"`
+	// The SHA fingerprint for the certificate
+	ShaFingerprint string `json:"shaFingerprint,omitempty"`
+	// The SHA256 fingerprint for the certificate
+	Sha256Fingerprint string `json:"sha256Fingerprint,omitempty"`
+	// The subject distinguished name for the certificate
+	SubjectDN string `json:"subjectDN,omitempty"`
+	// The list of values that make up the subjectDN for the certificate
+	SubjectRdns []RelativeDistinguishedName `json:"subjectRdns,omitempty"`
+	// The issuer distinguished name for the certificate
+	IssuerDN string `json:"issuerDN,omitempty"`
+	// The list of values that make up the issuerDN for the certificate
+	IssuerRdns []RelativeDistinguishedName `json:"issuerRdns,omitempty"`
+	// The date that the certificate was issued
+	Start string `json:"start,omitempty"`
+	// The date that the certificate expires
+	Expire string `json:"expire,omitempty"`
+	// The certificate status Values: trusted, selfSigned, expired, incompleteTrustChain, commonNameMismatch,
+	// unknown.
+	Status string `json:"status,omitempty"`
+	// Indicates if the certificate is in the truststore
+	Truststore bool `json:"truststore,omitempty"`
+	// Indicates if the certificate was installed by the user
+	IsUserInstalled bool `json:"isUserInstalled,omitempty"`
+	// Returns true if the entry identified by the given alias was created by a call to setKeyEntry, or created by a
+	// call to setEntry with a PrivateKeyEntry or a SecretKeyEntry.
+	IsKeyEntry bool `json:"isKeyEntry,omitempty"`
+	// Indicates if the certificate is self-signed, CA-signed or a root or intermediate certificate. Root or
+	// intermediate certificates that were created without the basic constraints extension may show a value of
+	// 'selfSigned' instead of 'certificateAuthority' Values: selfSigned, caSigned, certificateAuthority.
+	Type string `json:"type,omitempty"`
+}
+
+// X509ExternalCertInfo is the X509ExternalCertInfo model.
+// Basic information for X509 External certificates stored in the system.
+type X509ExternalCertInfo struct {
+	KmsAddress NetworkAddress `json:"kmsAddress,omitzero"`
+	KmsPort    int            `json:"kmsPort,omitempty"`
+	// Values: certificateFileTypeUnknown, clientCertificate, serverCertificate.
+	ClientKmsType   string `json:"clientKmsType,omitempty"`
+	ClientSubjectDN string `json:"clientSubjectDN,omitempty"`
+	ClientIssuerDN  string `json:"clientIssuerDN,omitempty"`
+	ClientStart     string `json:"clientStart,omitempty"`
+	ClientExpire    string `json:"clientExpire,omitempty"`
+	// Values: certificateFileTypeUnknown, clientCertificate, serverCertificate.
+	ServerKmsType   string      `json:"serverKmsType,omitempty"`
+	ServerSubjectDN string      `json:"serverSubjectDN,omitempty"`
+	ServerIssuerDN  string      `json:"serverIssuerDN,omitempty"`
+	ServerStart     string      `json:"serverStart,omitempty"`
+	ServerExpire    string      `json:"serverExpire,omitempty"`
+	KeyServers      []KeyServer `json:"keyServers,omitempty"`
+}
@@ -0,0 +1,28 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestUnmarshalModel(t *testing.T) {
+	var volume VolumeEx
+	if err := json.Unmarshal([]byte(`{"volumeRef": "02000000", "label": "vol1", "thinProvisioned": true}`),
+		&volume); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if volume.VolumeRef != "02000000" || volume.Label != "vol1" || !volume.ThinProvisioned {
+		t.Errorf("decoded volume %q labeled %q (thin %v), expected 02000000 labeled vol1 (thin)",
+			volume.VolumeRef, volume.Label, volume.ThinProvisioned)
+	}
+
+	// A property of another type than the specification says is an error, not an empty field
+	var typeErr *json.UnmarshalTypeError
+	err := json.Unmarshal([]byte(`{"volumeRef": "02000000", "label": 42}`), &volume)
+	if !errors.As(err, &typeErr) {
+		t.Errorf("Unmarshal of a numeric label returned %v, expected an UnmarshalTypeError", err)
+	}
+}
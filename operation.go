@@ -0,0 +1,430 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/scaleoutsean/santricity-go/models/v11"
+	log "github.com/sirupsen/logrus"
+)
+
+// Many changes on the array, like volume expansion, pool reconfiguration or a parity scan, continue in the
+// background after the request that started them returned. An Operation polls the progress of one of them, and
+// WaitFor polls an Operation until it completes.
+
+// OperationState is the state of a long-running operation.
+type OperationState string
+
+const (
+	OperationRunning  OperationState = "running"
+	OperationComplete OperationState = "complete"
+	OperationFailed   OperationState = "failed"
+)
+
+// Progress is the state of a long-running operation when it was last polled.
+type Progress struct {
+	Operation       string         `json:"operation"`       // Description of the operation
+	State           OperationState `json:"state"`           // Running, complete or failed
+	Action          string         `json:"action"`          // Action reported by the array, e.g. "remappingDve"
+	PercentComplete int            `json:"percentComplete"` // 0-100, or -1 if the array does not report it
+	Message         string         `json:"message,omitempty"`
+
+	// Time left, as estimated by the array or, if it has no estimate, from the rate of progress so far. Zero if
+	// unknown.
+	TimeRemaining time.Duration `json:"timeRemaining"`
+	Elapsed       time.Duration `json:"elapsed"` // Time since WaitFor started
+}
+
+// ETA returns the expected completion time of the operation, or the zero time if it is unknown.
+func (p Progress) ETA() time.Time {
+	if p.TimeRemaining <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(p.TimeRemaining)
+}
+
+// Operation is a long-running operation on the array whose progress can be polled.
+type Operation interface {
+	// Name describes the operation, for logs and errors.
+	Name() string
+	// Poll returns the current progress. The State of the result tells whether the operation is still running.
+	Poll(ctx context.Context) (Progress, error)
+}
+
+// OperationFunc adapts a polling function to the Operation interface.
+type OperationFunc struct {
+	Description string
+	PollFunc    func(ctx context.Context) (Progress, error)
+}
+
+func (o OperationFunc) Name() string {
+	return o.Description
+}
+
+func (o OperationFunc) Poll(ctx context.Context) (Progress, error) {
+	return o.PollFunc(ctx)
+}
+
+// WaitConfig controls how WaitFor polls an operation. Zero values select the defaults.
+type WaitConfig struct {
+	PollInterval    time.Duration // Delay before the second poll; default DefaultPollInterval
+	MaxPollInterval time.Duration // Upper bound for the delay between polls; default DefaultMaxPollInterval
+	Timeout         time.Duration // Limit for the whole wait in addition to the context; 0 means none
+
+	// Consecutive polls that may fail with a transport error or ErrConflict before WaitFor gives up (default 3).
+	// Other errors end the wait at once.
+	MaxPollErrors int
+
+	// Called with the progress after every successful poll
+	OnProgress func(Progress)
+	// Receives the progress after every successful poll. Sends do not block; updates are dropped while the
+	// channel is full. WaitFor does not close the channel.
+	Progress chan<- Progress
+}
+
+// OperationError is returned by WaitFor when an operation did not complete: the array reported a failure,
+// polling failed, or the context (or WaitConfig.Timeout) ended the wait. Err is ErrOperationFailed wrapped with
+// the array's message, the polling error or the context's error, so errors.Is(err, context.DeadlineExceeded)
+// identifies timeouts.
+type OperationError struct {
+	Operation string   // Name of the operation
+	Last      Progress // Last progress seen, if any
+	Err       error
+}
+
+func (e *OperationError) Error() string {
+	return fmt.Sprintf("%s did not complete: %v", e.Operation, e.Err)
+}
+
+func (e *OperationError) Unwrap() error {
+	return e.Err
+}
+
+// WaitFor polls an operation with exponential backoff until it completes, fails or the context is done. It
+// returns the final progress, or an *OperationError.
+func WaitFor(ctx context.Context, op Operation, config WaitConfig) (Progress, error) {
+
+	if config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, config.Timeout)
+		defer cancel()
+	}
+
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = durationOrDefault(config.PollInterval, DefaultPollInterval)
+	b.MaxInterval = durationOrDefault(config.MaxPollInterval, DefaultMaxPollInterval)
+	b.Multiplier = 1.5
+	b.RandomizationFactor = 0.1
+	b.MaxElapsedTime = 0 // Bounded by ctx instead
+	b.Reset()
+
+	var estimator progressEstimator
+	var last Progress
+	pollErrors := 0
+	started := time.Now()
+
+	for {
+		progress, err := op.Poll(ctx)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return last, &OperationError{Operation: op.Name(), Last: last, Err: ctx.Err()}
+		case err != nil:
+			pollErrors++
+			if !isTransientPollError(err) || pollErrors >= intOrDefault(config.MaxPollErrors, 3) {
+				return last, &OperationError{Operation: op.Name(), Last: last, Err: err}
+			}
+			Logc(ctx).WithError(err).WithField("Operation", op.Name()).Debug("Could not poll operation, retrying.")
+		default:
+			pollErrors = 0
+			progress.Operation = op.Name()
+			progress.Elapsed = time.Since(started)
+			if progress.TimeRemaining <= 0 {
+				progress.TimeRemaining = estimator.estimate(progress.PercentComplete)
+			}
+			last = progress
+
+			Logc(ctx).WithFields(log.Fields{
+				"Operation":       progress.Operation,
+				"State":           progress.State,
+				"Action":          progress.Action,
+				"PercentComplete": progress.PercentComplete,
+				"TimeRemaining":   progress.TimeRemaining,
+			}).Debug("Operation progress.")
+
+			if config.OnProgress != nil {
+				config.OnProgress(progress)
+			}
+			if config.Progress != nil {
+				select {
+				case config.Progress <- progress:
+				default:
+				}
+			}
+
+			switch progress.State {
+			case OperationComplete:
+				return progress, nil
+			case OperationFailed:
+				return progress, &OperationError{
+					Operation: op.Name(),
+					Last:      progress,
+					Err:       fmt.Errorf("%w: %s", ErrOperationFailed, progress.Message),
+				}
+			}
+		}
+
+		select {
+		case <-time.After(b.NextBackOff()):
+		case <-ctx.Done():
+			return last, &OperationError{Operation: op.Name(), Last: last, Err: ctx.Err()}
+		}
+	}
+}
+
+// isTransientPollError reports whether a failed poll is worth repeating.
+func isTransientPollError(err error) bool {
+	return errors.Is(err, ErrTransport) || errors.Is(err, ErrConflict)
+}
+
+// progressEstimator estimates the time left from the rate of progress since the first observation.
+type progressEstimator struct {
+	startTime    time.Time
+	startPercent int
+}
+
+func (e *progressEstimator) estimate(percent int) time.Duration {
+
+	if percent < 0 {
+		return 0
+	}
+	now := time.Now()
+	if e.startTime.IsZero() {
+		e.startTime, e.startPercent = now, percent
+		return 0
+	}
+	if percent <= e.startPercent || percent >= 100 {
+		return 0
+	}
+	rate := float64(percent-e.startPercent) / float64(now.Sub(e.startTime))
+	return time.Duration(float64(100-percent) / rate)
+}
+
+// VolumeAction returns an Operation that polls the long-running action of a volume (/volumes/{id}/expand), such
+// as an expansion, initialization or reconfiguration. It completes when the volume has no action in progress.
+func (d Client) VolumeAction(volumeRef string) Operation {
+	return OperationFunc{
+		Description: "volume action on " + volumeRef,
+		PollFunc: func(ctx context.Context) (Progress, error) {
+
+			response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/volumes/"+volumeRef+"/expand")
+			if err != nil {
+				return Progress{}, fmt.Errorf("API invocation failed. %w", err)
+			}
+			if response.StatusCode != http.StatusOK {
+				return Progress{}, d.newAPIError(response, responseBody, "could not get progress of volume %s",
+					volumeRef)
+			}
+
+			var status VolumeResizeStatusResponse
+			if err := json.Unmarshal(responseBody, &status); err != nil {
+				return Progress{}, fmt.Errorf("could not parse volume action progress: %s; %v",
+					string(responseBody), err)
+			}
+			return actionProgress(status.Action, status.PercentComplete, status.TimeToCompletion), nil
+		},
+	}
+}
+
+// PoolAction returns an Operation that polls the long-running actions of a storage pool
+// (/storage-pools/{id}/action-progress), such as an expansion, defragmentation or reconstruction. It completes
+// when no volume of the pool has an action in progress; the progress is that of the least advanced action.
+func (d Client) PoolAction(volumeGroupRef string) Operation {
+	return OperationFunc{
+		Description: "storage pool action on " + volumeGroupRef,
+		PollFunc: func(ctx context.Context) (Progress, error) {
+
+			resourcePath := "/storage-pools/" + volumeGroupRef + "/action-progress"
+			response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", resourcePath)
+			if err != nil {
+				return Progress{}, fmt.Errorf("API invocation failed. %w", err)
+			}
+			if response.StatusCode != http.StatusOK {
+				return Progress{}, d.newAPIError(response, responseBody, "could not get progress of storage pool %s",
+					volumeGroupRef)
+			}
+
+			var actions []v11.OperationProgress
+			if err := json.Unmarshal(responseBody, &actions); err != nil {
+				return Progress{}, fmt.Errorf("could not parse storage pool action progress: %s; %v",
+					string(responseBody), err)
+			}
+
+			progress := actionProgress("none", 100, 0)
+			var remaining time.Duration
+			for _, action := range actions {
+				current := actionProgress(action.CurrentAction, action.ProgressPercentage,
+					action.EstimatedTimeToCompletion)
+				if current.State != OperationRunning {
+					continue
+				}
+				remaining = max(remaining, current.TimeRemaining)
+				if progress.State != OperationRunning || current.PercentComplete < progress.PercentComplete {
+					progress = current
+				}
+			}
+			progress.TimeRemaining = remaining
+			return progress, nil
+		},
+	}
+}
+
+// StartVolumeParityCheck starts a parity scan of a volume and returns the ID of the job. Follow it with
+// ParityCheck, or use CheckVolumeParityAndWait.
+func (d Client) StartVolumeParityCheck(
+	ctx context.Context, volumeRef string, request v11.CheckVolumeParityRequest,
+) (string, error) {
+	ctx, span := d.startSpan(ctx, "StartVolumeParityCheck", AttributeVolumeRef.String(volumeRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":    "StartVolumeParityCheck",
+			"Type":      "Client",
+			"volumeRef": volumeRef,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> StartVolumeParityCheck")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< StartVolumeParityCheck")
+	}
+
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
+	}
+
+	resourcePath := "/volumes/" + volumeRef + "/check-volume-parity"
+	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", resourcePath)
+	if err != nil {
+		return "", fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusAccepted {
+		return "", d.newAPIError(response, responseBody, "could not start parity check of volume %s", volumeRef)
+	}
+
+	var result v11.CheckVolumeParityAsyncResponse
+	if err := json.Unmarshal(responseBody, &result); err != nil {
+		return "", fmt.Errorf("could not parse API response: %s; %v", string(responseBody), err)
+	}
+
+	Logc(ctx).WithFields(log.Fields{
+		"volumeRef": volumeRef,
+		"jobID":     result.JobID,
+	}).Debug("Started volume parity check.")
+
+	return result.JobID, nil
+}
+
+// GetVolumeParityCheckJob returns the state of a parity scan job.
+func (d Client) GetVolumeParityCheckJob(ctx context.Context, jobID string) (*ParityCheckJob, error) {
+	ctx, span := d.startSpan(ctx, "GetVolumeParityCheckJob", AttributeJobID.String(jobID))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "GetVolumeParityCheckJob",
+			"Type":   "Client",
+			"jobID":  jobID,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetVolumeParityCheckJob")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetVolumeParityCheckJob")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/volumes/check-volume-parity/jobs/"+jobID)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not get parity check job %s", jobID)
+	}
+
+	var job ParityCheckJob
+	if err := json.Unmarshal(responseBody, &job); err != nil {
+		return nil, fmt.Errorf("could not parse parity check job: %s; %v", string(responseBody), err)
+	}
+	return &job, nil
+}
+
+// ParityCheck returns an Operation that polls a parity scan job. A cancelled job counts as failed.
+func (d Client) ParityCheck(jobID string) Operation {
+	return OperationFunc{
+		Description: "parity check job " + jobID,
+		PollFunc: func(ctx context.Context) (Progress, error) {
+
+			job, err := d.GetVolumeParityCheckJob(ctx, jobID)
+			if err != nil {
+				return Progress{}, err
+			}
+
+			progress := Progress{
+				State:           OperationRunning,
+				Action:          job.JobStatus,
+				PercentComplete: job.PercentComplete,
+			}
+			if seconds, err := strconv.ParseInt(job.EstimatedTimeRemainingInSec, 10, 64); err == nil && seconds > 0 {
+				progress.TimeRemaining = time.Duration(seconds) * time.Second
+			}
+			switch job.JobStatus {
+			case "completed":
+				progress.State = OperationComplete
+				progress.Message = fmt.Sprintf("%d parity errors detected", job.TotalParityErrorsDetected)
+			case "failed", "cancelled":
+				progress.State = OperationFailed
+				progress.Message = fmt.Sprintf("parity check of volume %s %s", job.VolumeName, job.JobStatus)
+			}
+			return progress, nil
+		},
+	}
+}
+
+// CheckVolumeParityAndWait starts a parity scan of a volume and waits for it to finish. The returned job reports
+// the number of parity errors detected.
+func (d Client) CheckVolumeParityAndWait(
+	ctx context.Context, volumeRef string, request v11.CheckVolumeParityRequest, config WaitConfig,
+) (*ParityCheckJob, error) {
+	ctx, span := d.startSpan(ctx, "CheckVolumeParityAndWait", AttributeVolumeRef.String(volumeRef))
+	defer span.End()
+
+	jobID, err := d.StartVolumeParityCheck(ctx, volumeRef, request)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := WaitFor(ctx, d.ParityCheck(jobID), config); err != nil {
+		return nil, err
+	}
+	return d.GetVolumeParityCheckJob(ctx, jobID)
+}
+
+// actionProgress converts the action progress of a volume, as reported by the array, into a Progress. The time
+// to completion is in minutes; the array reports -1 if it has no estimate.
+func actionProgress(action string, percentComplete, minutesToCompletion int) Progress {
+
+	if action == "none" || action == "" {
+		return Progress{State: OperationComplete, Action: "none", PercentComplete: 100}
+	}
+	progress := Progress{
+		State:           OperationRunning,
+		Action:          action,
+		PercentComplete: percentComplete,
+	}
+	if minutesToCompletion > 0 {
+		progress.TimeRemaining = time.Duration(minutesToCompletion) * time.Minute
+	}
+	return progress
+}
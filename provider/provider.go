@@ -57,6 +57,7 @@ func Provider() *schema.Provider {
 			"santricity_consistency_group_member":   resourceConsistencyGroupMember(),
 			"santricity_consistency_group_snapshot": resourceConsistencyGroupSnapshot(),
 			"santricity_consistency_group_view":     resourceConsistencyGroupView(),
+			"santricity_snapshot_schedule":          resourceSnapshotSchedule(),
 		},
 		DataSourcesMap:       map[string]*schema.Resource{},
 		ConfigureContextFunc: providerConfigure,
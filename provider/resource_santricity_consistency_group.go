@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -58,7 +59,7 @@ func resourceConsistencyGroup() *schema.Resource {
 }
 
 func resourceConsistencyGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.ConsistencyGroupAPI)
 
 	req := santricity.ConsistencyGroupCreateRequest{
 		Name:                     d.Get("name").(string),
@@ -80,17 +81,17 @@ func resourceConsistencyGroupCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceConsistencyGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.ConsistencyGroupAPI)
 	id := d.Id()
 
 	group, err := client.GetConsistencyGroup(ctx, id)
 	if err != nil {
+		if errors.Is(err, santricity.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
-	if group == nil {
-		d.SetId("")
-		return nil
-	}
 
 	d.Set("name", group.Label)
 	d.Set("consistency_group_id", group.ConsistencyGroupRef)
@@ -103,7 +104,7 @@ func resourceConsistencyGroupRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceConsistencyGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.ConsistencyGroupAPI)
 	id := d.Id()
 
 	err := client.DeleteConsistencyGroup(ctx, id)
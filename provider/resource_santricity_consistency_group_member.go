@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -60,7 +61,7 @@ func resourceConsistencyGroupMember() *schema.Resource {
 }
 
 func resourceConsistencyGroupMemberCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.ConsistencyGroupAPI)
 
 	cgID := d.Get("consistency_group_id").(string)
 	volID := d.Get("volume_id").(string)
@@ -87,7 +88,7 @@ func resourceConsistencyGroupMemberCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceConsistencyGroupMemberRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.ConsistencyGroupAPI)
 
 	id := d.Id()
 	parts := strings.Split(id, ":")
@@ -99,12 +100,12 @@ func resourceConsistencyGroupMemberRead(ctx context.Context, d *schema.ResourceD
 
 	member, err := client.GetConsistencyGroupMember(ctx, cgID, volID)
 	if err != nil {
+		if errors.Is(err, santricity.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
-	if member == nil {
-		d.SetId("")
-		return nil
-	}
 
 	d.Set("consistency_group_id", member.ConsistencyGroupId)
 	d.Set("volume_id", member.VolumeId)
@@ -113,7 +114,7 @@ func resourceConsistencyGroupMemberRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceConsistencyGroupMemberDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.ConsistencyGroupAPI)
 
 	id := d.Id()
 	parts := strings.Split(id, ":")
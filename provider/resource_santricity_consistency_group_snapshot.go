@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -32,7 +33,7 @@ func resourceConsistencyGroupSnapshot() *schema.Resource {
 }
 
 func resourceConsistencyGroupSnapshotCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.ConsistencyGroupAPI)
 
 	cgID := d.Get("consistency_group_id").(string)
 
@@ -54,7 +55,7 @@ func resourceConsistencyGroupSnapshotCreate(ctx context.Context, d *schema.Resou
 }
 
 func resourceConsistencyGroupSnapshotRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.ConsistencyGroupAPI)
 
 	id := d.Id()
 	parts := strings.Split(id, ":")
@@ -66,9 +67,13 @@ func resourceConsistencyGroupSnapshotRead(ctx context.Context, d *schema.Resourc
 
 	images, err := client.GetConsistencyGroupSnapshot(ctx, cgID, seqNumber)
 	if err != nil {
+		if errors.Is(err, santricity.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
-	if images == nil || len(images) == 0 {
+	if len(images) == 0 {
 		d.SetId("")
 		return nil
 	}
@@ -80,7 +85,7 @@ func resourceConsistencyGroupSnapshotRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceConsistencyGroupSnapshotDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.ConsistencyGroupAPI)
 	id := d.Id()
 
 	parts := strings.Split(id, ":")
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -71,7 +72,7 @@ func resourceConsistencyGroupView() *schema.Resource {
 }
 
 func resourceConsistencyGroupViewCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.ConsistencyGroupAPI)
 
 	cgID := d.Get("consistency_group_id").(string)
 
@@ -109,7 +110,7 @@ func resourceConsistencyGroupViewCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceConsistencyGroupViewRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.ConsistencyGroupAPI)
 
 	id := d.Id()
 	parts := strings.Split(id, ":")
@@ -121,12 +122,12 @@ func resourceConsistencyGroupViewRead(ctx context.Context, d *schema.ResourceDat
 
 	view, err := client.GetConsistencyGroupView(ctx, cgID, viewID)
 	if err != nil {
+		if errors.Is(err, santricity.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
-	if view == nil {
-		d.SetId("")
-		return nil
-	}
 
 	d.Set("consistency_group_id", view.GroupRef) // Adjust field if needed
 	d.Set("name", view.Name)
@@ -141,7 +142,7 @@ func resourceConsistencyGroupViewRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceConsistencyGroupViewDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.ConsistencyGroupAPI)
 	id := d.Id()
 
 	parts := strings.Split(id, ":")
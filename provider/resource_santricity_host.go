@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -73,7 +74,7 @@ func resourceHost() *schema.Resource {
 }
 
 func resourceHostCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.HostAPI)
 
 	name := d.Get("name").(string)
 	hostType := d.Get("type").(string)
@@ -123,13 +124,13 @@ func resourceHostCreate(ctx context.Context, d *schema.ResourceData, m interface
 }
 
 func resourceHostRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.HostAPI)
 	hostID := d.Id()
 
 	host, err := client.GetHostByRef(ctx, hostID)
 	if err != nil {
 		// Verify if 404/not found. The library returns an error if not 200/OK.
-		if apiErr, ok := err.(santricity.Error); ok && apiErr.Code == 404 {
+		if errors.Is(err, santricity.ErrNotFound) {
 			d.SetId("")
 			return nil
 		}
@@ -145,7 +146,7 @@ func resourceHostRead(ctx context.Context, d *schema.ResourceData, m interface{}
 }
 
 func resourceHostUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.HostAPI)
 	hostID := d.Id()
 
 	updateReq := santricity.HostUpdateRequest{}
@@ -179,12 +180,12 @@ func resourceHostUpdate(ctx context.Context, d *schema.ResourceData, m interface
 }
 
 func resourceHostDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.HostAPI)
 	hostID := d.Id()
 
 	err := client.DeleteHost(ctx, hostID)
 	if err != nil {
-		if apiErr, ok := err.(santricity.Error); ok && apiErr.Code == 404 {
+		if errors.Is(err, santricity.ErrNotFound) {
 			d.SetId("")
 			return nil
 		}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,7 +26,7 @@ func resourceHostGroup() *schema.Resource {
 }
 
 func resourceHostGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.HostAPI)
 	name := d.Get("name").(string)
 
 	hg, err := client.CreateHostGroup(ctx, name)
@@ -38,12 +39,12 @@ func resourceHostGroupCreate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceHostGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.HostAPI)
 	id := d.Id()
 
 	hg, err := client.GetHostGroupByRef(ctx, id)
 	if err != nil {
-		if apiErr, ok := err.(santricity.Error); ok && apiErr.Code == 404 {
+		if errors.Is(err, santricity.ErrNotFound) {
 			d.SetId("")
 			return nil
 		}
@@ -55,12 +56,12 @@ func resourceHostGroupRead(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceHostGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.HostAPI)
 	id := d.Id()
 
 	err := client.DeleteHostGroup(ctx, id)
 	if err != nil {
-		if apiErr, ok := err.(santricity.Error); ok && apiErr.Code == 404 {
+		if errors.Is(err, santricity.ErrNotFound) {
 			d.SetId("")
 			return nil
 		}
@@ -51,7 +51,7 @@ func resourceMapping() *schema.Resource {
 }
 
 func resourceMappingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.API)
 
 	volID := d.Get("volume_id").(string)
 	lun := d.Get("lun").(int)
@@ -102,7 +102,7 @@ func resourceMappingRead(ctx context.Context, d *schema.ResourceData, m interfac
 }
 
 func resourceMappingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.API)
 	volID := d.Get("volume_id").(string)
 
 	volObj := santricity.VolumeEx{
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -70,7 +71,7 @@ func resourceSnapshotGroup() *schema.Resource {
 }
 
 func resourceSnapshotGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.SnapshotAPI)
 
 	req := santricity.SnapshotGroupCreateRequest{
 		BaseMappableObjectId: d.Get("base_volume_id").(string),
@@ -97,17 +98,17 @@ func resourceSnapshotGroupCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceSnapshotGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.SnapshotAPI)
 
 	id := d.Id()
 	group, err := client.GetSnapshotGroup(ctx, id)
 	if err != nil {
+		if errors.Is(err, santricity.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
-	if group == nil {
-		d.SetId("")
-		return nil
-	}
 
 	d.Set("base_volume_id", group.BaseVolume)
 	d.Set("name", group.Label)
@@ -120,7 +121,7 @@ func resourceSnapshotGroupRead(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceSnapshotGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.SnapshotAPI)
 	id := d.Id()
 
 	err := client.DeleteSnapshotGroup(ctx, id)
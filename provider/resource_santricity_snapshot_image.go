@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -45,7 +46,7 @@ func resourceSnapshotImage() *schema.Resource {
 }
 
 func resourceSnapshotImageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.SnapshotAPI)
 
 	groupID := d.Get("group_id").(string)
 
@@ -64,18 +65,17 @@ func resourceSnapshotImageCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceSnapshotImageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.SnapshotAPI)
 
 	id := d.Id()
 
 	image, err := client.GetSnapshotImage(ctx, id)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error reading snapshot image %s: %s", id, err))
-	}
-
-	if image == nil {
-		d.SetId("")
-		return nil
+		if errors.Is(err, santricity.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading snapshot image %s: %w", id, err))
 	}
 
 	d.Set("group_id", image.PitGroupRef)
@@ -88,7 +88,7 @@ func resourceSnapshotImageRead(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceSnapshotImageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.SnapshotAPI)
 	id := d.Id()
 
 	err := client.DeleteSnapshotImage(ctx, id)
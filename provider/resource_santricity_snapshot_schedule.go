@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	santricity "github.com/scaleoutsean/santricity-go"
+)
+
+// resourceSnapshotSchedule creates a snapshot group together with its schedule: the array only creates schedules
+// with a new snapshot group, and has no way to change or delete one on its own, so every change replaces both.
+func resourceSnapshotSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSnapshotScheduleCreate,
+		ReadContext:   resourceSnapshotScheduleRead,
+		DeleteContext: resourceSnapshotScheduleDelete,
+		Schema: map[string]*schema.Schema{
+			"base_volume_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID (Ref) of the volume to take snapshot images of.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the snapshot group created with the schedule.",
+			},
+			"repository_percentage": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Default:     20.0,
+				ForceNew:    true,
+				Description: "The size of the repository volume as a percentage of the base volume size.",
+			},
+			"recurrence": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     santricity.SnapshotScheduleDaily,
+				ForceNew:    true,
+				Description: "The recurrence of the schedule (daily or weekly).",
+			},
+			"days_of_week": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ForceNew:    true,
+				Description: "The days a weekly schedule runs on, in lowercase (e.g. monday).",
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "00:00",
+				ForceNew:    true,
+				Description: "The time of day of the first snapshot image (HH:MM).",
+			},
+			"time_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "UTC",
+				ForceNew:    true,
+				Description: "The IANA time zone of the start time (e.g. Europe/Berlin).",
+			},
+			"times_per_day": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				ForceNew:    true,
+				Description: "The number of snapshot images per day.",
+			},
+			"interval_minutes": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				ForceNew:    true,
+				Description: "The minutes between the snapshot images of a day, if more than one.",
+			},
+			"retention": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30,
+				ForceNew:    true,
+				Description: "The number of snapshot images to keep (1-32): the auto-delete limit of the snapshot group.",
+			},
+			"target_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID (Ref) of the snapshot group created with the schedule.",
+			},
+			"schedule_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the created snapshot schedule.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the schedule (active, suspended or completed).",
+			},
+			"next_run": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of the next snapshot image (RFC 3339), empty if there is none.",
+			},
+		},
+	}
+}
+
+// snapshotScheduleConfig returns the schedule config of the resource data.
+func snapshotScheduleConfig(d *schema.ResourceData) (santricity.SnapshotScheduleConfig, error) {
+	config := santricity.SnapshotScheduleConfig{
+		Recurrence:  d.Get("recurrence").(string),
+		TimesPerDay: d.Get("times_per_day").(int),
+		Interval:    time.Duration(d.Get("interval_minutes").(int)) * time.Minute,
+		Retention:   d.Get("retention").(int),
+	}
+
+	clock, err := time.Parse("15:04", d.Get("start_time").(string))
+	if err != nil {
+		return config, fmt.Errorf("invalid start_time %q, expected HH:MM", d.Get("start_time"))
+	}
+	config.StartTime = time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute
+
+	if config.Location, err = time.LoadLocation(d.Get("time_zone").(string)); err != nil {
+		return config, fmt.Errorf("invalid time_zone: %v", err)
+	}
+
+	for _, v := range d.Get("days_of_week").([]interface{}) {
+		name := v.(string)
+		found := false
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if strings.EqualFold(name, day.String()) {
+				config.DaysOfWeek = append(config.DaysOfWeek, day)
+				found = true
+			}
+		}
+		if !found {
+			return config, fmt.Errorf("invalid day of the week %q", name)
+		}
+	}
+	return config, nil
+}
+
+func resourceSnapshotScheduleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(santricity.SnapshotScheduleAPI)
+
+	config, err := snapshotScheduleConfig(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group := santricity.SnapshotGroupCreateRequest{
+		BaseMappableObjectId: d.Get("base_volume_id").(string),
+		Name:                 d.Get("name").(string),
+		RepositoryPercentage: d.Get("repository_percentage").(float64),
+		WarningThreshold:     80,
+		FullPolicy:           "purgepit",
+	}
+
+	schedule, err := client.CreateSnapshotSchedule(ctx, group, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(schedule.ID)
+	d.Set("schedule_id", schedule.ID)
+
+	return resourceSnapshotScheduleRead(ctx, d, m)
+}
+
+func resourceSnapshotScheduleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(santricity.SnapshotScheduleAPI)
+
+	schedule, err := client.GetSnapshotSchedule(ctx, d.Id())
+	if err != nil {
+		if errors.Is(err, santricity.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	config := schedule.Config()
+	days := make([]string, 0, len(config.DaysOfWeek))
+	for _, day := range config.DaysOfWeek {
+		days = append(days, strings.ToLower(day.String()))
+	}
+
+	d.Set("target_id", schedule.TargetObject)
+	d.Set("recurrence", config.Recurrence)
+	d.Set("days_of_week", days)
+	d.Set("start_time", fmt.Sprintf("%02d:%02d", int(config.StartTime.Hours()), int(config.StartTime.Minutes())%60))
+	d.Set("time_zone", config.Location.String())
+	d.Set("times_per_day", max(config.TimesPerDay, 1))
+	d.Set("interval_minutes", int(config.Interval.Minutes()))
+	d.Set("schedule_id", schedule.ID)
+	d.Set("status", schedule.ScheduleStatus)
+
+	nextRun := ""
+	if !schedule.NextRun().IsZero() {
+		nextRun = schedule.NextRun().Format(time.RFC3339)
+	}
+	d.Set("next_run", nextRun)
+
+	group, err := m.(santricity.SnapshotAPI).GetSnapshotGroup(ctx, schedule.TargetObject)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("base_volume_id", group.BaseVolume)
+	d.Set("name", group.Label)
+	d.Set("retention", group.AutoDeleteLimit)
+
+	return nil
+}
+
+// resourceSnapshotScheduleDelete deletes the snapshot group of the schedule, which deletes the schedule with it.
+func resourceSnapshotScheduleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(santricity.SnapshotAPI)
+
+	err := client.DeleteSnapshotGroup(ctx, d.Get("target_id").(string))
+	if err != nil && !errors.Is(err, santricity.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
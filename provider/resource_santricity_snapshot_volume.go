@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -73,7 +74,7 @@ func resourceSnapshotVolume() *schema.Resource {
 }
 
 func resourceSnapshotVolumeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.SnapshotAPI)
 
 	req := santricity.SnapshotVolumeCreateRequest{
 		SnapshotImageId:      d.Get("snapshot_image_id").(string),
@@ -98,18 +99,17 @@ func resourceSnapshotVolumeCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceSnapshotVolumeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.SnapshotAPI)
 
 	id := d.Id()
 
 	volume, err := client.GetSnapshotVolume(ctx, id)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error reading snapshot volume %s: %s", id, err))
-	}
-
-	if volume == nil {
-		d.SetId("")
-		return nil
+		if errors.Is(err, santricity.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading snapshot volume %s: %w", id, err))
 	}
 
 	d.Set("snapshot_image_id", volume.BasePIT)
@@ -124,7 +124,7 @@ func resourceSnapshotVolumeRead(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceSnapshotVolumeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.SnapshotAPI)
 	id := d.Id()
 
 	err := client.DeleteSnapshotVolume(ctx, id)
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -46,6 +47,19 @@ func resourceVolume() *schema.Resource {
 				ForceNew:    true,
 				Description: "The block size of the volume (e.g. 512, 4096). Defaults to pool recommended size if not specified.",
 			},
+			"thin_provisioning": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Create a thin volume. Only supported on disk pools (DDP); raid_level and block_size are ignored.",
+			},
+			"growth_alert_threshold": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Repository utilization in percent at which the array raises an alert. Thin volumes only.",
+			},
 			"volume_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -61,7 +75,7 @@ func resourceVolume() *schema.Resource {
 }
 
 func resourceVolumeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.VolumeAPI)
 
 	name := d.Get("name").(string)
 	poolID := d.Get("pool_id").(string)
@@ -91,7 +105,16 @@ func resourceVolumeCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	// Library CreateVolume signature:
 	// func (d Client) CreateVolume(ctx context.Context, name string, volumeGroupRef string, size uint64, mediaType, fstype string, raidLevel string, blockSize int, segmentSize int, extraTags map[string]string) (VolumeEx, error)
 
-	vol, err := client.CreateVolume(ctx, name, poolID, sizeBytes, "hdd", "xfs", raidLevel, blockSize, 0, nil)
+	var vol santricity.VolumeEx
+	var err error
+	if d.Get("thin_provisioning").(bool) {
+		options := santricity.ThinVolumeOptions{GrowthAlertThreshold: d.Get("growth_alert_threshold").(int)}
+		var thinVol santricity.ThinVolume
+		thinVol, err = client.CreateThinVolume(ctx, name, poolID, sizeBytes, "xfs", options, nil)
+		vol = thinVol.VolumeEx
+	} else {
+		vol, err = client.CreateVolume(ctx, name, poolID, sizeBytes, "hdd", "xfs", raidLevel, blockSize, 0, nil)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -105,13 +128,13 @@ func resourceVolumeCreate(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 func resourceVolumeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.VolumeAPI)
 	volID := d.Id()
 
 	vol, err := client.GetVolumeByRef(ctx, volID)
 	if err != nil {
 		// Verify if 404/not found to update state
-		if apiErr, ok := err.(santricity.Error); ok && apiErr.Code == 404 {
+		if errors.Is(err, santricity.ErrNotFound) {
 			d.SetId("")
 			return nil
 		}
@@ -127,15 +150,35 @@ func resourceVolumeRead(ctx context.Context, d *schema.ResourceData, m interface
 	capInt, _ := strconv.ParseUint(vol.VolumeSize, 10, 64)
 	d.Set("size_gb", int(capInt/(1024*1024*1024)))
 	d.Set("wwn", vol.WorldWideName)
+	d.Set("thin_provisioning", vol.ThinProvisioned)
+
+	if vol.ThinProvisioned {
+		thinVol, err := client.GetThinVolumeByRef(ctx, volID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("growth_alert_threshold", thinVol.GrowthAlertThreshold)
+	}
 
 	return nil
 }
 
 func resourceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.VolumeAPI)
 	volID := d.Id()
 
-	if d.HasChange("name") {
+	if d.Get("thin_provisioning").(bool) {
+		// Thin volumes are renamed through their own endpoint, which also carries the alert threshold
+		if d.HasChanges("name", "growth_alert_threshold") {
+			request := santricity.ThinVolumeUpdateRequest{Name: d.Get("name").(string)}
+			if d.HasChange("growth_alert_threshold") {
+				request.GrowthAlertThreshold = d.Get("growth_alert_threshold").(int)
+			}
+			if _, err := client.UpdateThinVolume(ctx, volID, request); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	} else if d.HasChange("name") {
 		newName := d.Get("name").(string)
 		_, err := client.UpdateVolume(ctx, volID, santricity.VolumeUpdateRequest{Name: newName})
 		if err != nil {
@@ -165,13 +208,14 @@ func resourceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 func resourceVolumeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*santricity.Client)
+	client := m.(santricity.VolumeAPI)
 	volID := d.Id()
 
 	volObj := santricity.VolumeEx{
 		VolumeRef: volID,
 		Label:     d.Get("name").(string),
 	}
+	volObj.ThinProvisioned = d.Get("thin_provisioning").(bool)
 
 	err := client.DeleteVolume(ctx, volObj)
 	if err != nil {
@@ -0,0 +1,284 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// A Web Services Proxy manages many storage systems behind one endpoint, each with its own ID in the resource
+// paths (/devmgr/v2/storage-systems/{id}/...). The embedded web services of a controller know only their own
+// system, usually with ID "1". The methods below work with both.
+
+// StorageSystemRegisterRequest is the body of a request to register a storage system with a Web Services Proxy.
+type StorageSystemRegisterRequest struct {
+	ID                  string      `json:"id,omitempty"`        // Assigned by the proxy if empty
+	ControllerAddresses []string    `json:"controllerAddresses"` // Management addresses of both controllers
+	AcceptCertificate   bool        `json:"acceptCertificate"`   // Accept self-signed controller certificates
+	Validate            bool        `json:"validate"`            // Check that the system can be reached
+	Password            string      `json:"password,omitempty"`  // Storage system admin password
+	WWN                 string      `json:"wwn,omitempty"`       // Only needed for in-band management
+	MetaTags            []KeyValues `json:"metaTags,omitempty"`
+}
+
+type storageSystemRegisterResponse struct {
+	ID            string `json:"id"`
+	AlreadyExists bool   `json:"alreadyExists"`
+}
+
+// ListStorageSystems returns the storage systems known to the web services.
+func (d Client) ListStorageSystems(ctx context.Context) ([]StorageSystem, error) {
+	ctx, span := d.startSpan(ctx, "ListStorageSystems")
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "ListStorageSystems",
+			"Type":   "Client",
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> ListStorageSystems")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< ListStorageSystems")
+	}
+
+	response, responseBody, err := d.invokeAPI(ctx, nil, "GET", "", "")
+	if err != nil {
+		return nil, fmt.Errorf("could not list storage systems: %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "API error listing storage systems")
+	}
+
+	var systems []StorageSystem
+	if err := json.Unmarshal(responseBody, &systems); err != nil {
+		return nil, fmt.Errorf("could not parse storage systems list: %v", err)
+	}
+
+	return systems, nil
+}
+
+// FindStorageSystem returns the storage system matching a selector: its WWN, name, chassis serial number or ID.
+// An empty selector matches the only storage system, if there is just one.
+func (d Client) FindStorageSystem(ctx context.Context, selector string) (*StorageSystem, error) {
+	ctx, span := d.startSpan(ctx, "FindStorageSystem", AttributeArraySelector.String(selector))
+	defer span.End()
+
+	systems, err := d.ListStorageSystems(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return selectStorageSystem(systems, selector)
+}
+
+// RegisterStorageSystem adds a storage system to a Web Services Proxy and returns its ID. Registering a system
+// that is already known returns the existing ID.
+func (d Client) RegisterStorageSystem(ctx context.Context, request StorageSystemRegisterRequest) (string, error) {
+	ctx, span := d.startSpan(ctx, "RegisterStorageSystem")
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":    "RegisterStorageSystem",
+			"Type":      "Client",
+			"ID":        request.ID,
+			"Addresses": request.ControllerAddresses,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> RegisterStorageSystem")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< RegisterStorageSystem")
+	}
+
+	if len(request.ControllerAddresses) == 0 {
+		return "", fmt.Errorf("no controller addresses given: %w", ErrInvalidArgument)
+	}
+
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("could not marshal JSON request: %v", err)
+	}
+
+	response, responseBody, err := d.invokeAPI(ctx, jsonRequest, "POST", "", "")
+	if err != nil {
+		return "", fmt.Errorf("API invocation failed. %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return "", d.newAPIError(response, responseBody, "could not register storage system %v",
+			request.ControllerAddresses)
+	}
+
+	var result storageSystemRegisterResponse
+	if err := json.Unmarshal(responseBody, &result); err != nil {
+		return "", fmt.Errorf("could not parse API response: %s; %v", string(responseBody), err)
+	}
+
+	Logc(ctx).WithFields(log.Fields{
+		"ID":            result.ID,
+		"AlreadyExists": result.AlreadyExists,
+	}).Debug("Registered storage system.")
+
+	return result.ID, nil
+}
+
+// UnregisterStorageSystem removes a storage system from a Web Services Proxy. The array itself is not changed.
+func (d Client) UnregisterStorageSystem(ctx context.Context, id string) error {
+	ctx, span := d.startSpan(ctx, "UnregisterStorageSystem", AttributeObjectRef.String(id))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "UnregisterStorageSystem",
+			"Type":   "Client",
+			"ID":     id,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> UnregisterStorageSystem")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< UnregisterStorageSystem")
+	}
+
+	if id == "" {
+		return fmt.Errorf("no storage system ID given: %w", ErrInvalidArgument)
+	}
+
+	response, responseBody, err := d.invokeAPI(ctx, nil, "DELETE", id, "")
+	if err != nil {
+		return fmt.Errorf("API invocation failed. %w", err)
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+	default:
+		return d.newAPIError(response, responseBody, "could not unregister storage system %s", id)
+	}
+
+	Logc(ctx).WithField("ID", id).Debug("Unregistered storage system.")
+
+	return nil
+}
+
+// ForStorageSystem returns a client for another storage system managed by the same web services, selected like
+// ClientConfig.ArraySelector. The new client shares the connections, sessions and controller health of this one,
+// so only the original client should be closed; Close does nothing for derived clients.
+func (d Client) ForStorageSystem(ctx context.Context, selector string) (*Client, error) {
+	ctx, span := d.startSpan(ctx, "ForStorageSystem", AttributeArraySelector.String(selector))
+	defer span.End()
+
+	system, err := d.FindStorageSystem(ctx, selector)
+	if err != nil {
+		return nil, err
+	}
+	return d.derive(ctx, selector, system.ID), nil
+}
+
+// derive returns a client for the storage system with the given ID that shares the connections of this one.
+func (d Client) derive(ctx context.Context, selector, arrayID string) *Client {
+
+	config := *d.config
+	config.ArraySelector = selector
+	config.ArrayID = arrayID
+
+	client := &Client{
+		config:       &config,
+		m:            d.m,
+		httpClient:   d.httpClient,
+		session:      d.session,
+		health:       d.health,
+		initErr:      d.initErr,
+		array:        &arraySelection{id: arrayID},
+		capabilities: &atomic.Pointer[Capabilities]{},
+		derived:      true,
+		inventory:    newInventoryCache(config.Cache),
+		dryRun:       d.dryRun,
+	}
+	if _, err := client.GetCapabilities(ctx); err != nil {
+		Logc(ctx).WithError(err).WithField("ArrayID", arrayID).Warn("Could not detect storage system capabilities.")
+	}
+
+	return client
+}
+
+// arraySelection holds the ID of the storage system a client manages. It is shared by the copies of the client,
+// as methods with value receivers cannot change the client itself.
+type arraySelection struct {
+	mu sync.Mutex
+	id string
+}
+
+func (a *arraySelection) get() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.id
+}
+
+func (a *arraySelection) set(id string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.id = id
+}
+
+// arrayID returns the ID of the storage system the client manages, empty before ClientConfig.ArraySelector has
+// been resolved.
+func (d Client) arrayID() string {
+	return d.array.get()
+}
+
+// resolveArrayID returns the ID of the storage system the client manages, resolving ClientConfig.ArraySelector
+// on first use. Concurrent first calls may each look the system up; they store the same ID.
+func (d Client) resolveArrayID(ctx context.Context) (string, error) {
+
+	if id := d.array.get(); id != "" || d.config.ArraySelector == "" {
+		return id, nil
+	}
+	system, err := d.FindStorageSystem(ctx, d.config.ArraySelector)
+	if err != nil {
+		return "", fmt.Errorf("could not select storage system %s: %w", d.config.ArraySelector, err)
+	}
+	d.array.set(system.ID)
+	return system.ID, nil
+}
+
+// selectStorageSystem picks the storage system matching a selector from a list. IDs, WWNs and chassis serial
+// numbers identify a system; a name must be unique among the systems.
+func selectStorageSystem(systems []StorageSystem, selector string) (*StorageSystem, error) {
+
+	if len(systems) == 0 {
+		return nil, fmt.Errorf("no storage systems found: %w", ErrNotFound)
+	}
+
+	if selector == "" {
+		if len(systems) > 1 {
+			return nil, fmt.Errorf("%d storage systems found, select one by WWN, name or serial number: %w",
+				len(systems), ErrInvalidArgument)
+		}
+		return &systems[0], nil
+	}
+
+	for i := range systems {
+		system := &systems[i]
+		if system.ID == selector || strings.EqualFold(system.Wwn, selector) ||
+			(system.ChassisSerialNumber != "" && system.ChassisSerialNumber == selector) {
+			return system, nil
+		}
+	}
+
+	var named *StorageSystem
+	for i := range systems {
+		if systems[i].Name == selector {
+			if named != nil {
+				return nil, fmt.Errorf("more than one storage system is named %s, select it by WWN or serial "+
+					"number: %w", selector, ErrInvalidArgument)
+			}
+			named = &systems[i]
+		}
+	}
+	if named == nil {
+		return nil, fmt.Errorf("storage system %s not found: %w", selector, ErrNotFound)
+	}
+	return named, nil
+}
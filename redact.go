@@ -0,0 +1,66 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// Redacted replaces secrets in recorded request and response bodies.
+const Redacted = "<redacted>"
+
+// secretFields are parts of JSON field names whose values are never recorded, compared case-insensitively. They
+// cover passwords, CHAP secrets (iscsiChapSecret, chapSecret), tokens and keys.
+var secretFields = []string{"password", "passphrase", "secret", "token", "privatekey"}
+
+// RedactJSON returns a JSON document with the values of secret fields replaced by Redacted, or nil if the document
+// is empty or not valid JSON. Only strings are replaced, so the document keeps its shape: a secret field holding
+// an object or a list has all strings in it replaced.
+func RedactJSON(body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	var document any
+	if err := json.Unmarshal(body, &document); err != nil {
+		return nil
+	}
+	var redacted bytes.Buffer
+	encoder := json.NewEncoder(&redacted)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactValue(document, false)); err != nil {
+		return nil
+	}
+	return bytes.TrimSuffix(redacted.Bytes(), []byte("\n"))
+}
+
+// redactValue replaces the strings of secret fields in a decoded JSON value. Secret is set below a secret field.
+func redactValue(value any, secret bool) any {
+	switch v := value.(type) {
+	case string:
+		if secret {
+			return Redacted
+		}
+	case map[string]any:
+		for key, field := range v {
+			v[key] = redactValue(field, secret || isSecretField(key))
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactValue(v[i], secret)
+		}
+	}
+	return value
+}
+
+// isSecretField reports whether a JSON field holds a secret.
+func isSecretField(name string) bool {
+	name = strings.ToLower(name)
+	for _, secret := range secretFields {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
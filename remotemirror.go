@@ -0,0 +1,494 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// Remote volume mirroring (RVM, /remote-mirror-pairs) mirrors a volume to a volume of at least the same size on
+// another array, typically at metro distance. In synchronous mode a write completes on the primary only once the
+// secondary has it as well; the asynchronous modes of the pair only suit longer distances, for which async mirror
+// groups are usually the better choice. Pairs are created on the primary array and can be suspended, resumed and
+// resynchronized; their roles can be reversed for failover and failback.
+
+// Write modes of a remote mirror pair
+const (
+	RemoteMirrorWriteModeSynchronous     = 0 // A write completes once both arrays have it
+	RemoteMirrorWriteModeAsynchronous    = 1 // Writes are copied to the secondary after they complete
+	RemoteMirrorWriteModeConsistentAsync = 2 // Asynchronous, in the order of the writes to the primary
+)
+
+// Remote mirror roles, as numbered by the API
+const (
+	remoteMirrorRolePrimary   = 0
+	remoteMirrorRoleSecondary = 1
+)
+
+// maxRemoteMirrorPriority is the highest synchronization priority; 0 is the lowest.
+const maxRemoteMirrorPriority = 4
+
+// GetRemoteMirrorPairs returns the remote mirror pairs of the array, whichever its role in them.
+func (d Client) GetRemoteMirrorPairs(ctx context.Context) ([]RemoteMirrorPair, error) {
+	ctx, span := d.startSpan(ctx, "GetRemoteMirrorPairs")
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "GetRemoteMirrorPairs",
+			"Type":   "Client",
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetRemoteMirrorPairs")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetRemoteMirrorPairs")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/remote-mirror-pairs")
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "failed to read remote mirror pairs")
+	}
+
+	pairs := make([]RemoteMirrorPair, 0)
+	if err := json.Unmarshal(responseBody, &pairs); err != nil {
+		return nil, fmt.Errorf("could not parse remote mirror pairs: %s; %v", string(responseBody), err)
+	}
+	return pairs, nil
+}
+
+// GetRemoteMirrorPair returns a remote mirror pair. The returned error matches ErrNotFound if the pair does not
+// exist.
+func (d Client) GetRemoteMirrorPair(ctx context.Context, pairID string) (*RemoteMirrorPair, error) {
+	ctx, span := d.startSpan(ctx, "GetRemoteMirrorPair", AttributeMirrorRef.String(pairID))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "GetRemoteMirrorPair",
+			"Type":   "Client",
+			"pairID": pairID,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetRemoteMirrorPair")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetRemoteMirrorPair")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/remote-mirror-pairs/"+pairID)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not get remote mirror pair %s", pairID)
+	}
+
+	return parseRemoteMirrorPair(responseBody)
+}
+
+// GetRemoteMirrorCandidates returns, for each array this array can mirror to, the volumes there that can be the
+// secondary of a volume of this array.
+func (d Client) GetRemoteMirrorCandidates(ctx context.Context, volumeRef string) ([]v11.RemoteMirrorCandidate, error) {
+	ctx, span := d.startSpan(ctx, "GetRemoteMirrorCandidates", AttributeVolumeRef.String(volumeRef))
+	defer span.End()
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET",
+		"/remote-mirror-pairs/remote-mirror-target-candidates/"+volumeRef)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not get remote mirror candidates of volume %s",
+			volumeRef)
+	}
+
+	candidates := make([]v11.RemoteMirrorCandidate, 0)
+	if err := json.Unmarshal(responseBody, &candidates); err != nil {
+		return nil, fmt.Errorf("could not parse remote mirror candidates: %s; %v", string(responseBody), err)
+	}
+	return candidates, nil
+}
+
+// CreateRemoteMirrorPair mirrors a volume of this array, which becomes the primary, to a volume of another
+// array. The secondary is given by its WWN and the ID of its array as known to this array, from
+// GetRemoteMirrorCandidates; MirrorPeers.CreateRemoteMirrorPair looks both up. CopyType is the write mode and
+// Priority the synchronization priority, from 0 (lowest) to 4. The initial synchronization starts right away.
+func (d Client) CreateRemoteMirrorPair(
+	ctx context.Context, request v11.RemoteVolumeMirrorCreateRequest,
+) (*RemoteMirrorPair, error) {
+	ctx, span := d.startSpan(ctx, "CreateRemoteMirrorPair", AttributeVolumeRef.String(request.SrcVolID))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":  "CreateRemoteMirrorPair",
+			"Type":    "Client",
+			"request": request,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> CreateRemoteMirrorPair")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< CreateRemoteMirrorPair")
+	}
+
+	if request.SrcVolID == "" || request.TgtVolWWN == "" || request.RemoteArrayID == "" {
+		return nil, fmt.Errorf("a source volume, a target volume WWN and a remote array are required: %w",
+			ErrInvalidArgument)
+	}
+	if err := validateRemoteMirrorParams(&request.CopyType, &request.Priority); err != nil {
+		return nil, err
+	}
+	if err := d.checkFeature("synchronous mirroring", "remoteMirroring"); err != nil {
+		return nil, err
+	}
+
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/remote-mirror-pairs")
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return nil, d.newAPIError(response, responseBody, "could not mirror volume %s to %s", request.SrcVolID,
+			request.TgtVolWWN)
+	}
+
+	pair, err := parseRemoteMirrorPair(responseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	Logc(ctx).WithFields(log.Fields{
+		"PairID":      pair.ID,
+		"Volume":      pair.Base.Label,
+		"TargetArray": pair.TargetArray,
+	}).Info("Created remote mirror pair.")
+
+	return pair, nil
+}
+
+// DeleteRemoteMirrorPair removes a remote mirror pair. Both volumes remain, and can be used independently.
+func (d Client) DeleteRemoteMirrorPair(ctx context.Context, pairID string) error {
+	ctx, span := d.startSpan(ctx, "DeleteRemoteMirrorPair", AttributeMirrorRef.String(pairID))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "DeleteRemoteMirrorPair",
+			"Type":   "Client",
+			"pairID": pairID,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> DeleteRemoteMirrorPair")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< DeleteRemoteMirrorPair")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "DELETE", "/remote-mirror-pairs/"+pairID)
+	if err != nil {
+		return fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return d.newAPIError(response, responseBody, "could not delete remote mirror pair %s", pairID)
+	}
+
+	Logc(ctx).WithField("PairID", pairID).Info("Deleted remote mirror pair.")
+
+	return nil
+}
+
+// UpdateRemoteMirrorPair applies an update to a remote mirror pair. The volume IDs of the request are filled in
+// from the pair if empty. SuspendRemoteMirrorPair, ResumeRemoteMirrorPair, ResyncRemoteMirrorPair,
+// SetRemoteMirrorRole and SetRemoteMirrorWriteMode cover the update types.
+func (d Client) UpdateRemoteMirrorPair(
+	ctx context.Context, pairID string, request v11.RemoteVolumeMirrorUpdateRequest,
+) (*RemoteMirrorPair, error) {
+	ctx, span := d.startSpan(ctx, "UpdateRemoteMirrorPair", AttributeMirrorRef.String(pairID))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":  "UpdateRemoteMirrorPair",
+			"Type":    "Client",
+			"pairID":  pairID,
+			"request": request,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> UpdateRemoteMirrorPair")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< UpdateRemoteMirrorPair")
+	}
+
+	if err := validateRemoteMirrorParams(request.WriteMode, request.Priority); err != nil {
+		return nil, err
+	}
+	if request.BaseVolumeID == "" || request.RemoteVolumeID == "" {
+		pair, err := d.GetRemoteMirrorPair(ctx, pairID)
+		if err != nil {
+			return nil, err
+		}
+		if request.BaseVolumeID == "" {
+			request.BaseVolumeID = pair.Base.VolumeRef
+		}
+		if request.RemoteVolumeID == "" {
+			request.RemoteVolumeID = pair.Target.VolumeRef
+		}
+	}
+
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/remote-mirror-pairs/"+pairID)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not %s remote mirror pair %s", request.UpdateType,
+			pairID)
+	}
+
+	pair, err := parseRemoteMirrorPair(responseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	Logc(ctx).WithFields(log.Fields{
+		"PairID": pairID,
+		"Update": request.UpdateType,
+		"Status": pair.Status,
+	}).Debug("Updated remote mirror pair.")
+
+	return pair, nil
+}
+
+// SuspendRemoteMirrorPair stops mirroring the writes to the primary volume of a pair. The changed regions are
+// tracked, and copied to the secondary once the pair is resumed.
+func (d Client) SuspendRemoteMirrorPair(ctx context.Context, pairID string) (*RemoteMirrorPair, error) {
+	return d.UpdateRemoteMirrorPair(ctx, pairID, v11.RemoteVolumeMirrorUpdateRequest{UpdateType: "suspend"})
+}
+
+// ResumeRemoteMirrorPair resumes mirroring for a suspended pair, starting with the regions changed while it was
+// suspended.
+func (d Client) ResumeRemoteMirrorPair(ctx context.Context, pairID string) (*RemoteMirrorPair, error) {
+	return d.UpdateRemoteMirrorPair(ctx, pairID, v11.RemoteVolumeMirrorUpdateRequest{UpdateType: "resume"})
+}
+
+// ResyncRemoteMirrorPair synchronizes a pair that is unsynchronized, e.g. after the arrays lost their connection
+// and the pair does not resynchronize on its own.
+func (d Client) ResyncRemoteMirrorPair(ctx context.Context, pairID string) (*RemoteMirrorPair, error) {
+	return d.UpdateRemoteMirrorPair(ctx, pairID, v11.RemoteVolumeMirrorUpdateRequest{UpdateType: "resync"})
+}
+
+// SetRemoteMirrorRole makes the volume of this array the primary or the secondary of a pair, which reverses the
+// roles of both volumes.
+func (d Client) SetRemoteMirrorRole(ctx context.Context, pairID string, role MirrorRole) (*RemoteMirrorPair, error) {
+	var value int
+	switch role {
+	case MirrorRolePrimary:
+		value = remoteMirrorRolePrimary
+	case MirrorRoleSecondary:
+		value = remoteMirrorRoleSecondary
+	default:
+		return nil, fmt.Errorf("the role %q is neither primary nor secondary: %w", role, ErrInvalidArgument)
+	}
+	return d.UpdateRemoteMirrorPair(ctx, pairID, v11.RemoteVolumeMirrorUpdateRequest{
+		UpdateType: "roleChange",
+		Role:       &value,
+	})
+}
+
+// SetRemoteMirrorWriteMode changes the write mode of a pair, one of the RemoteMirrorWriteMode constants.
+func (d Client) SetRemoteMirrorWriteMode(ctx context.Context, pairID string, writeMode int) (*RemoteMirrorPair, error) {
+	return d.UpdateRemoteMirrorPair(ctx, pairID, v11.RemoteVolumeMirrorUpdateRequest{
+		UpdateType: "updateParams",
+		WriteMode:  &writeMode,
+	})
+}
+
+// TestRemoteMirrorCommunication measures the round trip time between the arrays of a pair.
+func (d Client) TestRemoteMirrorCommunication(
+	ctx context.Context, pairID string,
+) (*v11.RemoteCommunicationData, error) {
+	ctx, span := d.startSpan(ctx, "TestRemoteMirrorCommunication", AttributeMirrorRef.String(pairID))
+	defer span.End()
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET",
+		"/remote-mirror-pairs/test-remote-mirror-communication/"+pairID)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not test communication of remote mirror pair %s",
+			pairID)
+	}
+
+	var result v11.RemoteCommunicationData
+	if err := json.Unmarshal(responseBody, &result); err != nil {
+		return nil, fmt.Errorf("could not parse remote mirror test result: %s; %v", string(responseBody), err)
+	}
+	return &result, nil
+}
+
+// GetRemoteMirrorSyncProgress returns the progress of the running synchronization of a pair.
+func (d Client) GetRemoteMirrorSyncProgress(ctx context.Context, pairID string) (*v11.JobProgress, error) {
+	ctx, span := d.startSpan(ctx, "GetRemoteMirrorSyncProgress", AttributeMirrorRef.String(pairID))
+	defer span.End()
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/remote-mirror-pairs/mirror-sync-progress/"+pairID)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not get progress of remote mirror pair %s",
+			pairID)
+	}
+
+	var progress v11.JobProgress
+	if err := json.Unmarshal(responseBody, &progress); err != nil {
+		return nil, fmt.Errorf("could not parse remote mirror progress: %s; %v", string(responseBody), err)
+	}
+	return &progress, nil
+}
+
+// GetRemoteMirrorStatus summarizes a remote mirror pair as seen from this array: the roles, the state and the
+// progress of a running synchronization.
+func (d Client) GetRemoteMirrorStatus(ctx context.Context, pairID string) (*MirrorStatus, error) {
+	ctx, span := d.startSpan(ctx, "GetRemoteMirrorStatus", AttributeMirrorRef.String(pairID))
+	defer span.End()
+
+	pair, err := d.GetRemoteMirrorPair(ctx, pairID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := remoteMirrorStatus(*pair)
+	if status.State == MirrorStateSynchronizing {
+		progress, err := d.GetRemoteMirrorSyncProgress(ctx, pairID)
+		if err != nil {
+			return nil, err
+		}
+		status.PercentComplete = progress.PercentComplete
+	}
+	return status, nil
+}
+
+// RemoteMirrorSync returns an Operation that polls the synchronization of a remote mirror pair, the initial one
+// or one after a resume or resync. It completes when the pair is optimal; a pair that is suspended, failed or
+// unsynchronized counts as failed.
+func (d Client) RemoteMirrorSync(pairID string) Operation {
+	return OperationFunc{
+		Description: "remote mirror pair " + pairID,
+		PollFunc: func(ctx context.Context) (Progress, error) {
+			pair, err := d.GetRemoteMirrorPair(ctx, pairID)
+			if err != nil {
+				return Progress{}, err
+			}
+
+			progress := Progress{State: OperationComplete, Action: pair.Status, PercentComplete: 100}
+			switch remoteMirrorStatus(*pair).State {
+			case MirrorStateSynchronizing:
+				current, err := d.GetRemoteMirrorSyncProgress(ctx, pairID)
+				if err != nil {
+					return Progress{}, err
+				}
+				progress.State = OperationRunning
+				progress.PercentComplete = current.PercentComplete
+			case MirrorStateUnknown:
+				progress.State = OperationRunning
+				progress.PercentComplete = 0
+			case MirrorStateSuspended, MirrorStateDegraded, MirrorStateFailed:
+				progress.State = OperationFailed
+				progress.Message = fmt.Sprintf("remote mirror pair of volume %s is %s", pair.Base.Label, pair.Status)
+			}
+			return progress, nil
+		},
+	}
+}
+
+// CreateRemoteMirrorPair mirrors a volume of Local to a volume of Remote, given by their refs. The request sets
+// the write mode, priority and automatic resynchronization; the target WWN and remote array are looked up among
+// the mirror candidates of the volume.
+func (p *MirrorPeers) CreateRemoteMirrorPair(
+	ctx context.Context, remoteVolumeRef string, request v11.RemoteVolumeMirrorCreateRequest,
+) (*RemoteMirrorPair, error) {
+
+	target, err := p.Remote.GetVolumeByRef(ctx, remoteVolumeRef)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := p.Local.GetRemoteMirrorCandidates(ctx, request.SrcVolID)
+	if err != nil {
+		return nil, err
+	}
+	for _, candidate := range candidates {
+		for _, volume := range candidate.TargetVolumes {
+			if strings.EqualFold(volume.WorldWideName, target.WorldWideName) {
+				request.TgtVolWWN = target.WorldWideName
+				request.RemoteArrayID = candidate.TargetArrayID
+				return p.Local.CreateRemoteMirrorPair(ctx, request)
+			}
+		}
+	}
+	return nil, fmt.Errorf("volume %s of storage system %s is not a mirror candidate for volume %s: %w",
+		target.Label, p.Remote.arrayID(), request.SrcVolID, ErrInvalidArgument)
+}
+
+// remoteMirrorStatus describes a remote mirror pair in the terms of MirrorStatus.
+func remoteMirrorStatus(pair RemoteMirrorPair) *MirrorStatus {
+
+	status := &MirrorStatus{
+		Type:       MirrorTypeSync,
+		Ref:        pair.ID,
+		Name:       pair.Base.Label,
+		LocalRole:  MirrorRoleUnknown,
+		RemoteRole: MirrorRoleUnknown,
+		ArrayState: pair.Status,
+	}
+	switch {
+	case pair.Base.RemoteMirrorSource:
+		status.LocalRole, status.RemoteRole = MirrorRolePrimary, MirrorRoleSecondary
+	case pair.Base.RemoteMirrorTarget:
+		status.LocalRole, status.RemoteRole = MirrorRoleSecondary, MirrorRolePrimary
+	}
+	switch pair.Status {
+	case "optimal":
+		status.State = MirrorStateOptimal
+	case "synchronizing", "degradedSynchronizing":
+		status.State = MirrorStateSynchronizing
+	case "suspended":
+		status.State = MirrorStateSuspended
+	case "unsynchronized", "degradedUnsynchronized":
+		status.State = MirrorStateDegraded
+	case "failed", "failedsuspended":
+		status.State = MirrorStateFailed
+	default:
+		status.State = MirrorStateUnknown
+	}
+	return status
+}
+
+// validateRemoteMirrorParams checks the write mode and priority of a pair, where set.
+func validateRemoteMirrorParams(writeMode, priority *int) error {
+	if writeMode != nil && (*writeMode < RemoteMirrorWriteModeSynchronous ||
+		*writeMode > RemoteMirrorWriteModeConsistentAsync) {
+		return fmt.Errorf("invalid remote mirror write mode %d: %w", *writeMode, ErrInvalidArgument)
+	}
+	if priority != nil && (*priority < 0 || *priority > maxRemoteMirrorPriority) {
+		return fmt.Errorf("the remote mirror priority %d is not between 0 and %d: %w", *priority,
+			maxRemoteMirrorPriority, ErrInvalidArgument)
+	}
+	return nil
+}
+
+func parseRemoteMirrorPair(responseBody []byte) (*RemoteMirrorPair, error) {
+	var pair RemoteMirrorPair
+	if err := json.Unmarshal(responseBody, &pair); err != nil {
+		return nil, fmt.Errorf("could not parse remote mirror pair: %s; %v", string(responseBody), err)
+	}
+	return &pair, nil
+}
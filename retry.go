@@ -0,0 +1,164 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	log "github.com/sirupsen/logrus"
+)
+
+// RetryPolicy controls how InvokeAPI repeats requests that failed for a transient reason: every controller was
+// unreachable, the array answered 429/502/503/504, or it reported a "busy"-type return code. Zero values select
+// the defaults. GET, HEAD, PUT and DELETE requests are always considered safe to repeat. POST requests are only
+// repeated if AllowPOST is set, the call was marked with WithRetryableWrite, or VerifyWrite confirms that the
+// failed attempt did not take effect. A POST the array rejected with the 422 "volume busy, try again" race (a 422
+// without a return code) is repeated only after such a check, as the write may have taken effect anyway.
+type RetryPolicy struct {
+	MaxAttempts         int           // Attempts per call, including the first one; default 4
+	InitialInterval     time.Duration // Delay before the first retry; default 500ms
+	MaxInterval         time.Duration // Upper bound for the delay between attempts; default 10s
+	Multiplier          float64       // Growth factor of the delay; default 2
+	RandomizationFactor float64       // Jitter, as a fraction of the delay; default 0.5
+
+	// AllowPOST makes POST requests as retryable as idempotent ones.
+	AllowPOST bool
+
+	// VerifyWrite is called before a failed POST is repeated. It should check the array and return true only if
+	// the write did not take effect, so that sending it again is safe.
+	VerifyWrite func(ctx context.Context, method, resourcePath string, requestBody []byte) bool
+}
+
+// DefaultRetryPolicy returns a retry policy with the default settings.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{}
+}
+
+// transientReturnCodes are SANtricity return codes that indicate a condition expected to clear by itself shortly.
+var transientReturnCodes = map[string]bool{
+	"busy":                     true,
+	"tryAlternate":             true,
+	"learnActiveTryLater":      true,
+	"databaseResyncInProgress": true,
+	"reservationConflict":      true,
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	return intOrDefault(p.MaxAttempts, 4)
+}
+
+// newBackOff returns the exponential backoff with jitter used between the attempts of one call.
+func (p *RetryPolicy) newBackOff(ctx context.Context) backoff.BackOff {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = durationOrDefault(p.InitialInterval, 500*time.Millisecond)
+	b.MaxInterval = durationOrDefault(p.MaxInterval, 10*time.Second)
+	b.Multiplier = 2
+	if p.Multiplier > 1 {
+		b.Multiplier = p.Multiplier
+	}
+	b.RandomizationFactor = 0.5
+	if p.RandomizationFactor > 0 {
+		b.RandomizationFactor = p.RandomizationFactor
+	}
+	b.MaxElapsedTime = 0 // Bounded by MaxAttempts and ctx instead
+	b.Reset()
+	return backoff.WithContext(b, ctx)
+}
+
+// contextKeyWriteVerifier holds the check of a single call that its failed write did not take effect.
+const contextKeyWriteVerifier contextKey = "writeVerifier"
+
+// withWriteVerifier makes the POST requests made with the returned context retryable whenever verify confirms
+// that the failed attempt did not take effect, like RetryPolicy.VerifyWrite does for every call.
+func withWriteVerifier(ctx context.Context, verify func(ctx context.Context) bool) context.Context {
+	return context.WithValue(ctx, contextKeyWriteVerifier, verify)
+}
+
+// WithRetryableWrite marks the POST requests made with the returned context as safe to repeat, for writes the
+// caller knows to be idempotent.
+func WithRetryableWrite(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ContextKeyRetryableWrite, true)
+}
+
+// isTransient reports whether the outcome of an attempt is worth retrying, regardless of the request method.
+func isTransient(ctx context.Context, response *http.Response, responseBody []byte, err error) bool {
+
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return errors.Is(err, ErrTransport)
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	if response.StatusCode < http.StatusBadRequest {
+		return false
+	}
+
+	responseData := CallResponseError{}
+	if json.Unmarshal(responseBody, &responseData) != nil {
+		responseData = CallResponseError{}
+	}
+	return transientReturnCodes[responseData.ReturnCode]
+}
+
+// isBusyRace reports whether a response is the bare 422 (a CallResponse without a return code) with which the
+// array rejects a write while a volume is busy. The condition clears once the volume settles.
+func isBusyRace(response *http.Response, responseBody []byte) bool {
+
+	if response == nil || response.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	responseData := CallResponseError{}
+	return json.Unmarshal(responseBody, &responseData) == nil && responseData.ReturnCode == ""
+}
+
+// shouldRetry decides whether a failed attempt of a call is repeated.
+func (d Client) shouldRetry(
+	ctx context.Context, method, resourcePath string, requestBody []byte,
+	response *http.Response, responseBody []byte, err error,
+) bool {
+
+	policy := d.config.RetryPolicy
+	busyRace := err == nil && ctx.Err() == nil && method == http.MethodPost && isBusyRace(response, responseBody)
+	if !busyRace && !isTransient(ctx, response, responseBody, err) {
+		return false
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	// A busy race is only repeated once verified, other transient failures of a retryable write right away
+	if !busyRace {
+		if policy.AllowPOST {
+			return true
+		}
+		if retryable, ok := ctx.Value(ContextKeyRetryableWrite).(bool); ok && retryable {
+			return true
+		}
+	}
+	verify, _ := ctx.Value(contextKeyWriteVerifier).(func(ctx context.Context) bool)
+	if verify == nil && policy.VerifyWrite != nil {
+		verify = func(ctx context.Context) bool { return policy.VerifyWrite(ctx, method, resourcePath, requestBody) }
+	}
+	if verify != nil {
+		safe := verify(ctx)
+		Logc(ctx).WithFields(log.Fields{
+			"method": method,
+			"path":   resourcePath,
+			"safe":   safe,
+		}).Debug("Verified failed write before retrying.")
+		return safe
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import "strings"
+
+// routeSegments are the fixed segments of the resource paths used by the client. Any other path segment is taken
+// for an object ID.
+var routeSegments = map[string]bool{
+	"action-progress":                  true,
+	"arvm":                             true,
+	"arvm-arrays":                      true,
+	"async-mirrors":                    true,
+	"audit-log":                        true,
+	"capabilities":                     true,
+	"check-volume-parity":              true,
+	"clear-recovery-failure":           true,
+	"concat":                           true,
+	"connections":                      true,
+	"consistency-groups":               true,
+	"defaults":                         true,
+	"expand":                           true,
+	"failures":                         true,
+	"features":                         true,
+	"graph":                            true,
+	"host-groups":                      true,
+	"host-types":                       true,
+	"hosts":                            true,
+	"incomplete-pairs":                 true,
+	"initiator-settings":               true,
+	"iscsi":                            true,
+	"jobs":                             true,
+	"member-volumes":                   true,
+	"members":                          true,
+	"mirror-sync-progress":             true,
+	"nvmeof":                           true,
+	"pairs":                            true,
+	"progress":                         true,
+	"remote-mirror-pairs":              true,
+	"remote-mirror-target-candidates":  true,
+	"repositories":                     true,
+	"repository-utilization":           true,
+	"resume":                           true,
+	"role":                             true,
+	"snapshot-groups":                  true,
+	"snapshot-images":                  true,
+	"snapshot-schedules":               true,
+	"snapshot-volumes":                 true,
+	"snapshots":                        true,
+	"startPITRollback":                 true,
+	"storage-pools":                    true,
+	"suspend":                          true,
+	"symbol":                           true,
+	"sync":                             true,
+	"target-settings":                  true,
+	"test":                             true,
+	"test-remote-mirror-communication": true,
+	"thin-volume":                      true,
+	"thin-volumes":                     true,
+	"views":                            true,
+	"volume-copy-jobs":                 true,
+	"volume-copy-jobs-control":         true,
+	"volume-mappings":                  true,
+	"volumes":                          true,
+}
+
+// routeTemplate returns a resource path with its object IDs replaced by "{id}" and without the query string, for
+// example /volumes/{id}/expand. Unlike the paths themselves, there are few enough templates to use them as metric
+// labels.
+func routeTemplate(resourcePath string) string {
+	resourcePath, _, _ = strings.Cut(resourcePath, "?")
+	segments := strings.Split(resourcePath, "/")
+	for i, segment := range segments {
+		if segment != "" && !routeSegments[segment] {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// collectionRefFields names the ref of the objects in a collection, by the first element of the resource path.
+var collectionRefFields = map[string]string{
+	"volumes":             "volumeRef",
+	"storage-pools":       "volumeGroupRef",
+	"hosts":               "hostRef",
+	"host-groups":         "clusterRef",
+	"volume-mappings":     "lunMappingRef",
+	"snapshot-groups":     "pitGroupRef",
+	"snapshot-images":     "pitRef",
+	"snapshot-schedules":  "id",
+	"snapshot-volumes":    "viewRef",
+	"consistency-groups":  "cgRef",
+	"thin-volumes":        "volumeRef",
+	"volume-copy-jobs":    "volcopyRef",
+	"async-mirrors":       "groupRef",
+	"remote-mirror-pairs": "id",
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// nonResourcePaths are string literals of the client that start with a slash but are not resource paths, such as
+// parts of the URLs of the web services themselves.
+var nonResourcePaths = map[string]bool{
+	"/login":            true,
+	"/storage-systems":  true,
+	"/storage-systems/": true,
+}
+
+// TestRouteTemplateLiteralPaths runs every resource path literal of the client through routeTemplate. The
+// segments the client spells out are fixed ones, so none may be taken for an object ID.
+func TestRouteTemplateLiteralPaths(t *testing.T) {
+
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fset := token.NewFileSet()
+	checked := 0
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ast.Inspect(file, func(node ast.Node) bool {
+			literal, ok := node.(*ast.BasicLit)
+			if !ok || literal.Kind != token.STRING {
+				return true
+			}
+			value, err := strconv.Unquote(literal.Value)
+			if err != nil || !strings.HasPrefix(value, "/") || strings.ContainsAny(value, "%{ ") ||
+				strings.HasPrefix(value, "/devmgr/") || nonResourcePaths[value] {
+				return true
+			}
+			checked++
+			if route := routeTemplate(value); strings.Contains(route, "{id}") {
+				t.Errorf("%s: path %q has the route %s", fset.Position(literal.Pos()), value, route)
+			}
+			return true
+		})
+	}
+	if checked == 0 {
+		t.Error("found no resource paths")
+	}
+}
+
+func TestAuditRefsSkipRouteSegments(t *testing.T) {
+	refs := auditRefs("/symbol/startPITRollback", []byte(`{"pitRef": ["3400000060080E50"]}`), nil)
+	if slices.Contains(refs, "symbol") || slices.Contains(refs, "startPITRollback") {
+		t.Errorf("auditRefs returned %v, expected no path segments", refs)
+	}
+}
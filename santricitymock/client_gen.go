@@ -0,0 +1,1769 @@
+// Code generated by gen.go from santricity.API; DO NOT EDIT.
+
+package santricitymock
+
+import (
+	"context"
+	"github.com/scaleoutsean/santricity-go/models/v11"
+	"net/http"
+	"time"
+
+	santricity "github.com/scaleoutsean/santricity-go"
+)
+
+// Client is a mock implementation of santricity.API.
+type Client struct {
+	callLog
+
+	AboutInfoFunc                           func(ctx context.Context) (*santricity.AboutResponse, error)
+	ActiveControllerFunc                    func() string
+	AddAsyncMirrorPairFunc                  func(ctx context.Context, groupRef string, request v11.AsyncMirrorGroupMemberCreateRequest) (*santricity.AsyncMirrorPair, error)
+	AddConsistencyGroupMemberFunc           func(ctx context.Context, cgID string, request santricity.ConsistencyGroupMemberAddRequest) (*santricity.ConsistencyGroupMember, error)
+	AsyncMirrorSyncFunc                     func(groupRef string) santricity.Operation
+	CapabilitiesFunc                        func() *santricity.Capabilities
+	CheckVolumeDependenciesFunc             func(ctx context.Context, volumeRef string) error
+	CheckVolumeParityAndWaitFunc            func(ctx context.Context, volumeRef string, request v11.CheckVolumeParityRequest, config santricity.WaitConfig) (*santricity.ParityCheckJob, error)
+	CloneVolumeFunc                         func(ctx context.Context, sourceRef string, name string, poolRef string, options santricity.CloneVolumeOptions) (*santricity.VolumeCopyJob, error)
+	CloneVolumeAndWaitFunc                  func(ctx context.Context, sourceRef string, name string, poolRef string, options santricity.CloneVolumeOptions, config santricity.WaitConfig) (santricity.VolumeEx, error)
+	CloseFunc                               func() error
+	CompleteAsyncMirrorPairFunc             func(ctx context.Context, memberRef string, request v11.AsyncMirrorGroupMemberCompletionRequest) (*santricity.AsyncMirrorPair, error)
+	ConnectFunc                             func(ctx context.Context) (string, error)
+	ControllerStatusFunc                    func() []santricity.ControllerHealth
+	CreateAsyncMirrorGroupFunc              func(ctx context.Context, request v11.AsyncMirrorGroupCreateRequest) (*santricity.AsyncMirrorGroup, error)
+	CreateConsistencyGroupFunc              func(ctx context.Context, request santricity.ConsistencyGroupCreateRequest) (*santricity.ConsistencyGroup, error)
+	CreateConsistencyGroupSnapshotFunc      func(ctx context.Context, cgID string) ([]santricity.SnapshotImage, error)
+	CreateConsistencyGroupViewFunc          func(ctx context.Context, cgID string, request santricity.ConsistencyGroupViewCreateRequest) (*santricity.ConsistencyGroupView, error)
+	CreateHostFunc                          func(ctx context.Context, name string, portID string, portType string, hostType string, authSecret string, hostGroup santricity.HostGroup) (santricity.HostEx, error)
+	CreateHostGroupFunc                     func(ctx context.Context, name string) (santricity.HostGroup, error)
+	CreateRemoteMirrorPairFunc              func(ctx context.Context, request v11.RemoteVolumeMirrorCreateRequest) (*santricity.RemoteMirrorPair, error)
+	CreateSnapshotGroupFunc                 func(ctx context.Context, request santricity.SnapshotGroupCreateRequest) (*santricity.SnapshotGroup, error)
+	CreateSnapshotImageFunc                 func(ctx context.Context, request santricity.SnapshotImageCreateRequest) (*santricity.SnapshotImage, error)
+	CreateSnapshotScheduleFunc              func(ctx context.Context, group santricity.SnapshotGroupCreateRequest, config santricity.SnapshotScheduleConfig) (*santricity.SnapshotSchedule, error)
+	CreateSnapshotVolumeFunc                func(ctx context.Context, request santricity.SnapshotVolumeCreateRequest) (*santricity.SnapshotVolume, error)
+	CreateThinVolumeFunc                    func(ctx context.Context, name string, poolRef string, size uint64, fstype string, options santricity.ThinVolumeOptions, extraTags map[string]string) (santricity.ThinVolume, error)
+	CreateVolumeFunc                        func(ctx context.Context, name string, volumeGroupRef string, size uint64, mediaType string, fstype string, raidLevel string, blockSize int, segmentSize int, extraTags map[string]string) (santricity.VolumeEx, error)
+	CreateVolumeCopyJobFunc                 func(ctx context.Context, request v11.VolumeCopyCreateRequest) (*santricity.VolumeCopyJob, error)
+	CreateVolumeMappingFunc                 func(ctx context.Context, request santricity.VolumeMappingCreateRequest) (*santricity.LUNMapping, error)
+	DeleteAsyncMirrorGroupFunc              func(ctx context.Context, groupRef string) error
+	DeleteConsistencyGroupFunc              func(ctx context.Context, cgID string) error
+	DeleteConsistencyGroupSnapshotFunc      func(ctx context.Context, cgID string, sequenceNumber string) error
+	DeleteConsistencyGroupViewFunc          func(ctx context.Context, cgID string, viewID string) error
+	DeleteHostFunc                          func(ctx context.Context, hostRef string) error
+	DeleteHostGroupFunc                     func(ctx context.Context, hostGroupRef string) error
+	DeleteRemoteMirrorPairFunc              func(ctx context.Context, pairID string) error
+	DeleteSnapshotGroupFunc                 func(ctx context.Context, id string) error
+	DeleteSnapshotImageFunc                 func(ctx context.Context, id string) error
+	DeleteSnapshotScheduleFunc              func(ctx context.Context, id string) error
+	DeleteSnapshotVolumeFunc                func(ctx context.Context, id string) error
+	DeleteVolumeFunc                        func(ctx context.Context, volume santricity.VolumeEx) error
+	DeleteVolumeCopyJobFunc                 func(ctx context.Context, jobRef string, retainRepositories bool) error
+	EnsureHostForIQNFunc                    func(ctx context.Context, iqn string) (santricity.HostEx, error)
+	EnsureHostForNQNFunc                    func(ctx context.Context, nqn string) (santricity.HostEx, error)
+	EnsureHostForPortFunc                   func(ctx context.Context, portID string, portType string) (santricity.HostEx, error)
+	EnsureHostGroupFunc                     func(ctx context.Context) (santricity.HostGroup, error)
+	ExpandThinVolumeFunc                    func(ctx context.Context, volumeRef string, newVirtualSize uint64, newRepositorySize uint64) error
+	ExpandVolumeFunc                        func(ctx context.Context, volumeRef string, expansionSize int64) error
+	ExpandVolumeAndWaitFunc                 func(ctx context.Context, volumeRef string, expansionSize int64, config santricity.WaitConfig) error
+	FindStorageSystemFunc                   func(ctx context.Context, selector string) (*santricity.StorageSystem, error)
+	GetAsyncMirrorConnectionsFunc           func(ctx context.Context, groupRef string) (*v11.AsyncMirrorGroupConnectionsResponse, error)
+	GetAsyncMirrorDefaultsFunc              func(ctx context.Context) (*v11.ArvmDefaultsResponse, error)
+	GetAsyncMirrorGroupFunc                 func(ctx context.Context, groupRef string) (*santricity.AsyncMirrorGroup, error)
+	GetAsyncMirrorGroupsFunc                func(ctx context.Context) ([]santricity.AsyncMirrorGroup, error)
+	GetAsyncMirrorPairFunc                  func(ctx context.Context, memberRef string) (*santricity.AsyncMirrorPair, error)
+	GetAsyncMirrorPairsFunc                 func(ctx context.Context, groupRef string) ([]santricity.AsyncMirrorPair, error)
+	GetAsyncMirrorProgressFunc              func(ctx context.Context, groupRef string) (*v11.AsyncMirrorGroupSyncProgress, error)
+	GetAsyncMirrorRepositoryUtilizationFunc func(ctx context.Context, memberRef string) (*v11.AsyncMirrorRepositoryUtilization, error)
+	GetAsyncMirrorStatusFunc                func(ctx context.Context, groupRef string) (*santricity.MirrorStatus, error)
+	GetAsyncMirrorTargetsFunc               func(ctx context.Context, compatibleOnly bool) ([]v11.RemoteCandidate, error)
+	GetAuditLogFunc                         func(ctx context.Context, begin time.Time, end time.Time) ([]v11.AuditLogRecord, error)
+	GetBestIndexForHostTypeFunc             func(ctx context.Context, hostType string) int
+	GetCapabilitiesFunc                     func(ctx context.Context) (*santricity.Capabilities, error)
+	GetChassisSerialNumberFunc              func(ctx context.Context) (string, error)
+	GetConcatRepositoryVolumeFunc           func(ctx context.Context, id string) (*santricity.ConcatRepositoryVolume, error)
+	GetConcatRepositoryVolumesFunc          func(ctx context.Context) ([]santricity.ConcatRepositoryVolume, error)
+	GetConfigGraphFunc                      func(ctx context.Context) (*santricity.ConfigGraph, error)
+	GetConsistencyGroupFunc                 func(ctx context.Context, id string) (*santricity.ConsistencyGroup, error)
+	GetConsistencyGroupMemberFunc           func(ctx context.Context, cgID string, volumeID string) (*santricity.ConsistencyGroupMember, error)
+	GetConsistencyGroupSnapshotFunc         func(ctx context.Context, cgID string, sequenceNumber string) ([]santricity.SnapshotImage, error)
+	GetConsistencyGroupViewFunc             func(ctx context.Context, cgID string, viewID string) (*santricity.ConsistencyGroupView, error)
+	GetFailuresFunc                         func(ctx context.Context) ([]santricity.Failure, error)
+	GetHostByRefFunc                        func(ctx context.Context, hostRef string) (santricity.HostEx, error)
+	GetHostForPortFunc                      func(ctx context.Context, portID string) (santricity.HostEx, error)
+	GetHostGroupFunc                        func(ctx context.Context, name string) (santricity.HostGroup, error)
+	GetHostGroupByRefFunc                   func(ctx context.Context, hostGroupRef string) (santricity.HostGroup, error)
+	GetHostGroupsFunc                       func(ctx context.Context) ([]santricity.HostGroup, error)
+	GetHostsFunc                            func(ctx context.Context) ([]santricity.Host, error)
+	GetIncompleteAsyncMirrorPairsFunc       func(ctx context.Context, groupRef string) ([]v11.AmgIncompleteMember, error)
+	GetInventoryFunc                        func(ctx context.Context) (*santricity.InventoryIndex, error)
+	GetNVMeoFSettingsFunc                   func(ctx context.Context) (*santricity.NvmeofTargetSettings, error)
+	GetRemoteMirrorCandidatesFunc           func(ctx context.Context, volumeRef string) ([]v11.RemoteMirrorCandidate, error)
+	GetRemoteMirrorPairFunc                 func(ctx context.Context, pairID string) (*santricity.RemoteMirrorPair, error)
+	GetRemoteMirrorPairsFunc                func(ctx context.Context) ([]santricity.RemoteMirrorPair, error)
+	GetRemoteMirrorStatusFunc               func(ctx context.Context, pairID string) (*santricity.MirrorStatus, error)
+	GetRemoteMirrorSyncProgressFunc         func(ctx context.Context, pairID string) (*v11.JobProgress, error)
+	GetSnapshotGroupFunc                    func(ctx context.Context, id string) (*santricity.SnapshotGroup, error)
+	GetSnapshotGroupsFunc                   func(ctx context.Context) ([]santricity.SnapshotGroup, error)
+	GetSnapshotImageFunc                    func(ctx context.Context, id string) (*santricity.SnapshotImage, error)
+	GetSnapshotImagesFunc                   func(ctx context.Context) ([]santricity.SnapshotImage, error)
+	GetSnapshotScheduleFunc                 func(ctx context.Context, id string) (*santricity.SnapshotSchedule, error)
+	GetSnapshotScheduleRetentionFunc        func(ctx context.Context, schedule *santricity.SnapshotSchedule) (int, error)
+	GetSnapshotSchedulesFunc                func(ctx context.Context) ([]santricity.SnapshotSchedule, error)
+	GetSnapshotVolumeFunc                   func(ctx context.Context, id string) (*santricity.SnapshotVolume, error)
+	GetSnapshotVolumesFunc                  func(ctx context.Context) ([]santricity.SnapshotVolume, error)
+	GetStorageSystemFunc                    func(ctx context.Context) (*santricity.StorageSystem, error)
+	GetTargetIQNFunc                        func(ctx context.Context) (string, error)
+	GetTargetSettingsFunc                   func(ctx context.Context) (*santricity.IscsiTargetSettings, error)
+	GetThinVolumeByRefFunc                  func(ctx context.Context, volumeRef string) (santricity.ThinVolume, error)
+	GetThinVolumeDefaultsFunc               func(ctx context.Context) (*v11.ThinVolumeDefaultsResponse, error)
+	GetThinVolumesFunc                      func(ctx context.Context) ([]santricity.ThinVolume, error)
+	GetVolumeFunc                           func(ctx context.Context, name string) (santricity.VolumeEx, error)
+	GetVolumeByRefFunc                      func(ctx context.Context, volumeRef string) (santricity.VolumeEx, error)
+	GetVolumeCopyJobFunc                    func(ctx context.Context, jobRef string) (*santricity.VolumeCopyJob, error)
+	GetVolumeCopyJobsFunc                   func(ctx context.Context) ([]santricity.VolumeCopyJob, error)
+	GetVolumeCopyProgressFunc               func(ctx context.Context, jobRef string) (*v11.VolumeCopyProgress, error)
+	GetVolumeMappingsFunc                   func(ctx context.Context) ([]santricity.LUNMapping, error)
+	GetVolumeParityCheckJobFunc             func(ctx context.Context, jobID string) (*santricity.ParityCheckJob, error)
+	GetVolumePoolByRefFunc                  func(ctx context.Context, volumeGroupRef string) (santricity.VolumeGroupEx, error)
+	GetVolumePoolsFunc                      func(ctx context.Context, mediaType string, minFreeSpaceBytes uint64, poolName string) ([]santricity.VolumeGroupEx, error)
+	GetVolumesFunc                          func(ctx context.Context) ([]santricity.VolumeEx, error)
+	InvalidateInventoryFunc                 func(objectTypes ...santricity.InventoryType)
+	InvokeAPIFunc                           func(ctx context.Context, requestBody []byte, method string, resourcePath string) (*http.Response, []byte, error)
+	IsRefValidFunc                          func(ref string) bool
+	ListStorageSystemsFunc                  func(ctx context.Context) ([]santricity.StorageSystem, error)
+	ListVolumesFunc                         func(ctx context.Context) ([]string, error)
+	MapVolumeFunc                           func(ctx context.Context, volume santricity.VolumeEx, host santricity.HostEx, lun int) (santricity.LUNMapping, error)
+	ParityCheckFunc                         func(jobID string) santricity.Operation
+	PlanFunc                                func() []santricity.PlannedCall
+	PoolActionFunc                          func(volumeGroupRef string) santricity.Operation
+	RefreshInventoryFunc                    func(ctx context.Context) (*santricity.InventoryIndex, error)
+	RegisterStorageSystemFunc               func(ctx context.Context, request santricity.StorageSystemRegisterRequest) (string, error)
+	RemoteMirrorSyncFunc                    func(pairID string) santricity.Operation
+	RemoveAsyncMirrorPairFunc               func(ctx context.Context, groupRef string, memberRef string) error
+	RemoveConsistencyGroupMemberFunc        func(ctx context.Context, cgID string, memberVolumeID string) error
+	RemoveIncompleteAsyncMirrorPairFunc     func(ctx context.Context, memberRef string) error
+	ResetPlanFunc                           func()
+	ResizeVolumeFunc                        func(ctx context.Context, volume santricity.VolumeEx, size uint64) error
+	ResizingVolumeFunc                      func(ctx context.Context, volume santricity.VolumeEx) (bool, error)
+	ResumeAsyncMirrorGroupFunc              func(ctx context.Context, groupRef string, deleteRecoveryPointIfNecessary bool) error
+	ResumeRemoteMirrorPairFunc              func(ctx context.Context, pairID string) (*santricity.RemoteMirrorPair, error)
+	ResyncRemoteMirrorPairFunc              func(ctx context.Context, pairID string) (*santricity.RemoteMirrorPair, error)
+	RollbackSnapshotImageFunc               func(ctx context.Context, imageRef string) error
+	SetAsyncMirrorGroupRoleFunc             func(ctx context.Context, groupRef string, request v11.AsyncMirrorGroupRoleUpdateRequest) (*santricity.AsyncMirrorGroup, error)
+	SetIncludeRepositoryVolumesFunc         func(include bool)
+	SetRemoteMirrorRoleFunc                 func(ctx context.Context, pairID string, role santricity.MirrorRole) (*santricity.RemoteMirrorPair, error)
+	SetRemoteMirrorWriteModeFunc            func(ctx context.Context, pairID string, writeMode int) (*santricity.RemoteMirrorPair, error)
+	SetThinVolumeAlertThresholdFunc         func(ctx context.Context, volumeRef string, percent int) (santricity.ThinVolume, error)
+	SetVolumeCopyPriorityFunc               func(ctx context.Context, jobRef string, priority string) (*santricity.VolumeCopyJob, error)
+	StartVolumeCopyJobFunc                  func(ctx context.Context, jobRef string) error
+	StartVolumeParityCheckFunc              func(ctx context.Context, volumeRef string, request v11.CheckVolumeParityRequest) (string, error)
+	StopVolumeCopyJobFunc                   func(ctx context.Context, jobRef string) error
+	SuspendAsyncMirrorGroupFunc             func(ctx context.Context, groupRef string) error
+	SuspendRemoteMirrorPairFunc             func(ctx context.Context, pairID string) (*santricity.RemoteMirrorPair, error)
+	SyncAsyncMirrorGroupFunc                func(ctx context.Context, groupRef string, deleteRecoveryPointIfNecessary bool) error
+	SyncAsyncMirrorGroupAndWaitFunc         func(ctx context.Context, groupRef string, config santricity.WaitConfig) error
+	TestAsyncMirrorGroupFunc                func(ctx context.Context, groupRef string, request v11.AsyncMirrorGroupConnectivityTestRequest) ([]v11.AsyncMirrorGroupCommunicationData, error)
+	TestRemoteMirrorCommunicationFunc       func(ctx context.Context, pairID string) (*v11.RemoteCommunicationData, error)
+	UnmapVolumeFunc                         func(ctx context.Context, volume santricity.VolumeEx) error
+	UnregisterStorageSystemFunc             func(ctx context.Context, id string) error
+	UpdateAsyncMirrorGroupFunc              func(ctx context.Context, groupRef string, request v11.AsyncMirrorGroupUpdateRequest) (*santricity.AsyncMirrorGroup, error)
+	UpdateConsistencyGroupFunc              func(ctx context.Context, id string, request v11.ConsistencyGroupUpdateRequest) (*santricity.ConsistencyGroup, error)
+	UpdateHostFunc                          func(ctx context.Context, hostRef string, request santricity.HostUpdateRequest) (santricity.HostEx, error)
+	UpdateRemoteMirrorPairFunc              func(ctx context.Context, pairID string, request v11.RemoteVolumeMirrorUpdateRequest) (*santricity.RemoteMirrorPair, error)
+	UpdateSnapshotGroupFunc                 func(ctx context.Context, id string, request v11.SnapshotGroupUpdateRequest) (*santricity.SnapshotGroup, error)
+	UpdateSnapshotScheduleFunc              func(ctx context.Context, id string, config santricity.SnapshotScheduleConfig) (*santricity.SnapshotSchedule, error)
+	UpdateThinVolumeFunc                    func(ctx context.Context, volumeRef string, request santricity.ThinVolumeUpdateRequest) (santricity.ThinVolume, error)
+	UpdateVolumeFunc                        func(ctx context.Context, volumeRef string, request santricity.VolumeUpdateRequest) (santricity.VolumeEx, error)
+	UpdateVolumeCopyJobFunc                 func(ctx context.Context, jobRef string, request v11.VolumeCopyUpdateRequest) (*santricity.VolumeCopyJob, error)
+	UpdateVolumeTagsFunc                    func(ctx context.Context, volumeRef string, tags []santricity.VolumeTag) (santricity.VolumeEx, error)
+	VolumeActionFunc                        func(volumeRef string) santricity.Operation
+	VolumeCopyFunc                          func(jobRef string) santricity.Operation
+}
+
+var _ santricity.API = (*Client)(nil)
+
+// AboutInfo calls AboutInfoFunc.
+func (m *Client) AboutInfo(ctx context.Context) (*santricity.AboutResponse, error) {
+	m.record("AboutInfo", ctx)
+	if m.AboutInfoFunc == nil {
+		var r0 *santricity.AboutResponse
+		return r0, notMocked("AboutInfo")
+	}
+	return m.AboutInfoFunc(ctx)
+}
+
+// ActiveController calls ActiveControllerFunc.
+func (m *Client) ActiveController() string {
+	m.record("ActiveController")
+	if m.ActiveControllerFunc == nil {
+		var r0 string
+		return r0
+	}
+	return m.ActiveControllerFunc()
+}
+
+// AddAsyncMirrorPair calls AddAsyncMirrorPairFunc.
+func (m *Client) AddAsyncMirrorPair(ctx context.Context, groupRef string, request v11.AsyncMirrorGroupMemberCreateRequest) (*santricity.AsyncMirrorPair, error) {
+	m.record("AddAsyncMirrorPair", ctx, groupRef, request)
+	if m.AddAsyncMirrorPairFunc == nil {
+		var r0 *santricity.AsyncMirrorPair
+		return r0, notMocked("AddAsyncMirrorPair")
+	}
+	return m.AddAsyncMirrorPairFunc(ctx, groupRef, request)
+}
+
+// AddConsistencyGroupMember calls AddConsistencyGroupMemberFunc.
+func (m *Client) AddConsistencyGroupMember(ctx context.Context, cgID string, request santricity.ConsistencyGroupMemberAddRequest) (*santricity.ConsistencyGroupMember, error) {
+	m.record("AddConsistencyGroupMember", ctx, cgID, request)
+	if m.AddConsistencyGroupMemberFunc == nil {
+		var r0 *santricity.ConsistencyGroupMember
+		return r0, notMocked("AddConsistencyGroupMember")
+	}
+	return m.AddConsistencyGroupMemberFunc(ctx, cgID, request)
+}
+
+// AsyncMirrorSync calls AsyncMirrorSyncFunc.
+func (m *Client) AsyncMirrorSync(groupRef string) santricity.Operation {
+	m.record("AsyncMirrorSync", groupRef)
+	if m.AsyncMirrorSyncFunc == nil {
+		var r0 santricity.Operation
+		return r0
+	}
+	return m.AsyncMirrorSyncFunc(groupRef)
+}
+
+// Capabilities calls CapabilitiesFunc.
+func (m *Client) Capabilities() *santricity.Capabilities {
+	m.record("Capabilities")
+	if m.CapabilitiesFunc == nil {
+		var r0 *santricity.Capabilities
+		return r0
+	}
+	return m.CapabilitiesFunc()
+}
+
+// CheckVolumeDependencies calls CheckVolumeDependenciesFunc.
+func (m *Client) CheckVolumeDependencies(ctx context.Context, volumeRef string) error {
+	m.record("CheckVolumeDependencies", ctx, volumeRef)
+	if m.CheckVolumeDependenciesFunc == nil {
+		return notMocked("CheckVolumeDependencies")
+	}
+	return m.CheckVolumeDependenciesFunc(ctx, volumeRef)
+}
+
+// CheckVolumeParityAndWait calls CheckVolumeParityAndWaitFunc.
+func (m *Client) CheckVolumeParityAndWait(ctx context.Context, volumeRef string, request v11.CheckVolumeParityRequest, config santricity.WaitConfig) (*santricity.ParityCheckJob, error) {
+	m.record("CheckVolumeParityAndWait", ctx, volumeRef, request, config)
+	if m.CheckVolumeParityAndWaitFunc == nil {
+		var r0 *santricity.ParityCheckJob
+		return r0, notMocked("CheckVolumeParityAndWait")
+	}
+	return m.CheckVolumeParityAndWaitFunc(ctx, volumeRef, request, config)
+}
+
+// CloneVolume calls CloneVolumeFunc.
+func (m *Client) CloneVolume(ctx context.Context, sourceRef string, name string, poolRef string, options santricity.CloneVolumeOptions) (*santricity.VolumeCopyJob, error) {
+	m.record("CloneVolume", ctx, sourceRef, name, poolRef, options)
+	if m.CloneVolumeFunc == nil {
+		var r0 *santricity.VolumeCopyJob
+		return r0, notMocked("CloneVolume")
+	}
+	return m.CloneVolumeFunc(ctx, sourceRef, name, poolRef, options)
+}
+
+// CloneVolumeAndWait calls CloneVolumeAndWaitFunc.
+func (m *Client) CloneVolumeAndWait(ctx context.Context, sourceRef string, name string, poolRef string, options santricity.CloneVolumeOptions, config santricity.WaitConfig) (santricity.VolumeEx, error) {
+	m.record("CloneVolumeAndWait", ctx, sourceRef, name, poolRef, options, config)
+	if m.CloneVolumeAndWaitFunc == nil {
+		var r0 santricity.VolumeEx
+		return r0, notMocked("CloneVolumeAndWait")
+	}
+	return m.CloneVolumeAndWaitFunc(ctx, sourceRef, name, poolRef, options, config)
+}
+
+// Close calls CloseFunc.
+func (m *Client) Close() error {
+	m.record("Close")
+	if m.CloseFunc == nil {
+		return notMocked("Close")
+	}
+	return m.CloseFunc()
+}
+
+// CompleteAsyncMirrorPair calls CompleteAsyncMirrorPairFunc.
+func (m *Client) CompleteAsyncMirrorPair(ctx context.Context, memberRef string, request v11.AsyncMirrorGroupMemberCompletionRequest) (*santricity.AsyncMirrorPair, error) {
+	m.record("CompleteAsyncMirrorPair", ctx, memberRef, request)
+	if m.CompleteAsyncMirrorPairFunc == nil {
+		var r0 *santricity.AsyncMirrorPair
+		return r0, notMocked("CompleteAsyncMirrorPair")
+	}
+	return m.CompleteAsyncMirrorPairFunc(ctx, memberRef, request)
+}
+
+// Connect calls ConnectFunc.
+func (m *Client) Connect(ctx context.Context) (string, error) {
+	m.record("Connect", ctx)
+	if m.ConnectFunc == nil {
+		var r0 string
+		return r0, notMocked("Connect")
+	}
+	return m.ConnectFunc(ctx)
+}
+
+// ControllerStatus calls ControllerStatusFunc.
+func (m *Client) ControllerStatus() []santricity.ControllerHealth {
+	m.record("ControllerStatus")
+	if m.ControllerStatusFunc == nil {
+		var r0 []santricity.ControllerHealth
+		return r0
+	}
+	return m.ControllerStatusFunc()
+}
+
+// CreateAsyncMirrorGroup calls CreateAsyncMirrorGroupFunc.
+func (m *Client) CreateAsyncMirrorGroup(ctx context.Context, request v11.AsyncMirrorGroupCreateRequest) (*santricity.AsyncMirrorGroup, error) {
+	m.record("CreateAsyncMirrorGroup", ctx, request)
+	if m.CreateAsyncMirrorGroupFunc == nil {
+		var r0 *santricity.AsyncMirrorGroup
+		return r0, notMocked("CreateAsyncMirrorGroup")
+	}
+	return m.CreateAsyncMirrorGroupFunc(ctx, request)
+}
+
+// CreateConsistencyGroup calls CreateConsistencyGroupFunc.
+func (m *Client) CreateConsistencyGroup(ctx context.Context, request santricity.ConsistencyGroupCreateRequest) (*santricity.ConsistencyGroup, error) {
+	m.record("CreateConsistencyGroup", ctx, request)
+	if m.CreateConsistencyGroupFunc == nil {
+		var r0 *santricity.ConsistencyGroup
+		return r0, notMocked("CreateConsistencyGroup")
+	}
+	return m.CreateConsistencyGroupFunc(ctx, request)
+}
+
+// CreateConsistencyGroupSnapshot calls CreateConsistencyGroupSnapshotFunc.
+func (m *Client) CreateConsistencyGroupSnapshot(ctx context.Context, cgID string) ([]santricity.SnapshotImage, error) {
+	m.record("CreateConsistencyGroupSnapshot", ctx, cgID)
+	if m.CreateConsistencyGroupSnapshotFunc == nil {
+		var r0 []santricity.SnapshotImage
+		return r0, notMocked("CreateConsistencyGroupSnapshot")
+	}
+	return m.CreateConsistencyGroupSnapshotFunc(ctx, cgID)
+}
+
+// CreateConsistencyGroupView calls CreateConsistencyGroupViewFunc.
+func (m *Client) CreateConsistencyGroupView(ctx context.Context, cgID string, request santricity.ConsistencyGroupViewCreateRequest) (*santricity.ConsistencyGroupView, error) {
+	m.record("CreateConsistencyGroupView", ctx, cgID, request)
+	if m.CreateConsistencyGroupViewFunc == nil {
+		var r0 *santricity.ConsistencyGroupView
+		return r0, notMocked("CreateConsistencyGroupView")
+	}
+	return m.CreateConsistencyGroupViewFunc(ctx, cgID, request)
+}
+
+// CreateHost calls CreateHostFunc.
+func (m *Client) CreateHost(ctx context.Context, name string, portID string, portType string, hostType string, authSecret string, hostGroup santricity.HostGroup) (santricity.HostEx, error) {
+	m.record("CreateHost", ctx, name, portID, portType, hostType, authSecret, hostGroup)
+	if m.CreateHostFunc == nil {
+		var r0 santricity.HostEx
+		return r0, notMocked("CreateHost")
+	}
+	return m.CreateHostFunc(ctx, name, portID, portType, hostType, authSecret, hostGroup)
+}
+
+// CreateHostGroup calls CreateHostGroupFunc.
+func (m *Client) CreateHostGroup(ctx context.Context, name string) (santricity.HostGroup, error) {
+	m.record("CreateHostGroup", ctx, name)
+	if m.CreateHostGroupFunc == nil {
+		var r0 santricity.HostGroup
+		return r0, notMocked("CreateHostGroup")
+	}
+	return m.CreateHostGroupFunc(ctx, name)
+}
+
+// CreateRemoteMirrorPair calls CreateRemoteMirrorPairFunc.
+func (m *Client) CreateRemoteMirrorPair(ctx context.Context, request v11.RemoteVolumeMirrorCreateRequest) (*santricity.RemoteMirrorPair, error) {
+	m.record("CreateRemoteMirrorPair", ctx, request)
+	if m.CreateRemoteMirrorPairFunc == nil {
+		var r0 *santricity.RemoteMirrorPair
+		return r0, notMocked("CreateRemoteMirrorPair")
+	}
+	return m.CreateRemoteMirrorPairFunc(ctx, request)
+}
+
+// CreateSnapshotGroup calls CreateSnapshotGroupFunc.
+func (m *Client) CreateSnapshotGroup(ctx context.Context, request santricity.SnapshotGroupCreateRequest) (*santricity.SnapshotGroup, error) {
+	m.record("CreateSnapshotGroup", ctx, request)
+	if m.CreateSnapshotGroupFunc == nil {
+		var r0 *santricity.SnapshotGroup
+		return r0, notMocked("CreateSnapshotGroup")
+	}
+	return m.CreateSnapshotGroupFunc(ctx, request)
+}
+
+// CreateSnapshotImage calls CreateSnapshotImageFunc.
+func (m *Client) CreateSnapshotImage(ctx context.Context, request santricity.SnapshotImageCreateRequest) (*santricity.SnapshotImage, error) {
+	m.record("CreateSnapshotImage", ctx, request)
+	if m.CreateSnapshotImageFunc == nil {
+		var r0 *santricity.SnapshotImage
+		return r0, notMocked("CreateSnapshotImage")
+	}
+	return m.CreateSnapshotImageFunc(ctx, request)
+}
+
+// CreateSnapshotSchedule calls CreateSnapshotScheduleFunc.
+func (m *Client) CreateSnapshotSchedule(ctx context.Context, group santricity.SnapshotGroupCreateRequest, config santricity.SnapshotScheduleConfig) (*santricity.SnapshotSchedule, error) {
+	m.record("CreateSnapshotSchedule", ctx, group, config)
+	if m.CreateSnapshotScheduleFunc == nil {
+		var r0 *santricity.SnapshotSchedule
+		return r0, notMocked("CreateSnapshotSchedule")
+	}
+	return m.CreateSnapshotScheduleFunc(ctx, group, config)
+}
+
+// CreateSnapshotVolume calls CreateSnapshotVolumeFunc.
+func (m *Client) CreateSnapshotVolume(ctx context.Context, request santricity.SnapshotVolumeCreateRequest) (*santricity.SnapshotVolume, error) {
+	m.record("CreateSnapshotVolume", ctx, request)
+	if m.CreateSnapshotVolumeFunc == nil {
+		var r0 *santricity.SnapshotVolume
+		return r0, notMocked("CreateSnapshotVolume")
+	}
+	return m.CreateSnapshotVolumeFunc(ctx, request)
+}
+
+// CreateThinVolume calls CreateThinVolumeFunc.
+func (m *Client) CreateThinVolume(ctx context.Context, name string, poolRef string, size uint64, fstype string, options santricity.ThinVolumeOptions, extraTags map[string]string) (santricity.ThinVolume, error) {
+	m.record("CreateThinVolume", ctx, name, poolRef, size, fstype, options, extraTags)
+	if m.CreateThinVolumeFunc == nil {
+		var r0 santricity.ThinVolume
+		return r0, notMocked("CreateThinVolume")
+	}
+	return m.CreateThinVolumeFunc(ctx, name, poolRef, size, fstype, options, extraTags)
+}
+
+// CreateVolume calls CreateVolumeFunc.
+func (m *Client) CreateVolume(ctx context.Context, name string, volumeGroupRef string, size uint64, mediaType string, fstype string, raidLevel string, blockSize int, segmentSize int, extraTags map[string]string) (santricity.VolumeEx, error) {
+	m.record("CreateVolume", ctx, name, volumeGroupRef, size, mediaType, fstype, raidLevel, blockSize, segmentSize, extraTags)
+	if m.CreateVolumeFunc == nil {
+		var r0 santricity.VolumeEx
+		return r0, notMocked("CreateVolume")
+	}
+	return m.CreateVolumeFunc(ctx, name, volumeGroupRef, size, mediaType, fstype, raidLevel, blockSize, segmentSize, extraTags)
+}
+
+// CreateVolumeCopyJob calls CreateVolumeCopyJobFunc.
+func (m *Client) CreateVolumeCopyJob(ctx context.Context, request v11.VolumeCopyCreateRequest) (*santricity.VolumeCopyJob, error) {
+	m.record("CreateVolumeCopyJob", ctx, request)
+	if m.CreateVolumeCopyJobFunc == nil {
+		var r0 *santricity.VolumeCopyJob
+		return r0, notMocked("CreateVolumeCopyJob")
+	}
+	return m.CreateVolumeCopyJobFunc(ctx, request)
+}
+
+// CreateVolumeMapping calls CreateVolumeMappingFunc.
+func (m *Client) CreateVolumeMapping(ctx context.Context, request santricity.VolumeMappingCreateRequest) (*santricity.LUNMapping, error) {
+	m.record("CreateVolumeMapping", ctx, request)
+	if m.CreateVolumeMappingFunc == nil {
+		var r0 *santricity.LUNMapping
+		return r0, notMocked("CreateVolumeMapping")
+	}
+	return m.CreateVolumeMappingFunc(ctx, request)
+}
+
+// DeleteAsyncMirrorGroup calls DeleteAsyncMirrorGroupFunc.
+func (m *Client) DeleteAsyncMirrorGroup(ctx context.Context, groupRef string) error {
+	m.record("DeleteAsyncMirrorGroup", ctx, groupRef)
+	if m.DeleteAsyncMirrorGroupFunc == nil {
+		return notMocked("DeleteAsyncMirrorGroup")
+	}
+	return m.DeleteAsyncMirrorGroupFunc(ctx, groupRef)
+}
+
+// DeleteConsistencyGroup calls DeleteConsistencyGroupFunc.
+func (m *Client) DeleteConsistencyGroup(ctx context.Context, cgID string) error {
+	m.record("DeleteConsistencyGroup", ctx, cgID)
+	if m.DeleteConsistencyGroupFunc == nil {
+		return notMocked("DeleteConsistencyGroup")
+	}
+	return m.DeleteConsistencyGroupFunc(ctx, cgID)
+}
+
+// DeleteConsistencyGroupSnapshot calls DeleteConsistencyGroupSnapshotFunc.
+func (m *Client) DeleteConsistencyGroupSnapshot(ctx context.Context, cgID string, sequenceNumber string) error {
+	m.record("DeleteConsistencyGroupSnapshot", ctx, cgID, sequenceNumber)
+	if m.DeleteConsistencyGroupSnapshotFunc == nil {
+		return notMocked("DeleteConsistencyGroupSnapshot")
+	}
+	return m.DeleteConsistencyGroupSnapshotFunc(ctx, cgID, sequenceNumber)
+}
+
+// DeleteConsistencyGroupView calls DeleteConsistencyGroupViewFunc.
+func (m *Client) DeleteConsistencyGroupView(ctx context.Context, cgID string, viewID string) error {
+	m.record("DeleteConsistencyGroupView", ctx, cgID, viewID)
+	if m.DeleteConsistencyGroupViewFunc == nil {
+		return notMocked("DeleteConsistencyGroupView")
+	}
+	return m.DeleteConsistencyGroupViewFunc(ctx, cgID, viewID)
+}
+
+// DeleteHost calls DeleteHostFunc.
+func (m *Client) DeleteHost(ctx context.Context, hostRef string) error {
+	m.record("DeleteHost", ctx, hostRef)
+	if m.DeleteHostFunc == nil {
+		return notMocked("DeleteHost")
+	}
+	return m.DeleteHostFunc(ctx, hostRef)
+}
+
+// DeleteHostGroup calls DeleteHostGroupFunc.
+func (m *Client) DeleteHostGroup(ctx context.Context, hostGroupRef string) error {
+	m.record("DeleteHostGroup", ctx, hostGroupRef)
+	if m.DeleteHostGroupFunc == nil {
+		return notMocked("DeleteHostGroup")
+	}
+	return m.DeleteHostGroupFunc(ctx, hostGroupRef)
+}
+
+// DeleteRemoteMirrorPair calls DeleteRemoteMirrorPairFunc.
+func (m *Client) DeleteRemoteMirrorPair(ctx context.Context, pairID string) error {
+	m.record("DeleteRemoteMirrorPair", ctx, pairID)
+	if m.DeleteRemoteMirrorPairFunc == nil {
+		return notMocked("DeleteRemoteMirrorPair")
+	}
+	return m.DeleteRemoteMirrorPairFunc(ctx, pairID)
+}
+
+// DeleteSnapshotGroup calls DeleteSnapshotGroupFunc.
+func (m *Client) DeleteSnapshotGroup(ctx context.Context, id string) error {
+	m.record("DeleteSnapshotGroup", ctx, id)
+	if m.DeleteSnapshotGroupFunc == nil {
+		return notMocked("DeleteSnapshotGroup")
+	}
+	return m.DeleteSnapshotGroupFunc(ctx, id)
+}
+
+// DeleteSnapshotImage calls DeleteSnapshotImageFunc.
+func (m *Client) DeleteSnapshotImage(ctx context.Context, id string) error {
+	m.record("DeleteSnapshotImage", ctx, id)
+	if m.DeleteSnapshotImageFunc == nil {
+		return notMocked("DeleteSnapshotImage")
+	}
+	return m.DeleteSnapshotImageFunc(ctx, id)
+}
+
+// DeleteSnapshotSchedule calls DeleteSnapshotScheduleFunc.
+func (m *Client) DeleteSnapshotSchedule(ctx context.Context, id string) error {
+	m.record("DeleteSnapshotSchedule", ctx, id)
+	if m.DeleteSnapshotScheduleFunc == nil {
+		return notMocked("DeleteSnapshotSchedule")
+	}
+	return m.DeleteSnapshotScheduleFunc(ctx, id)
+}
+
+// DeleteSnapshotVolume calls DeleteSnapshotVolumeFunc.
+func (m *Client) DeleteSnapshotVolume(ctx context.Context, id string) error {
+	m.record("DeleteSnapshotVolume", ctx, id)
+	if m.DeleteSnapshotVolumeFunc == nil {
+		return notMocked("DeleteSnapshotVolume")
+	}
+	return m.DeleteSnapshotVolumeFunc(ctx, id)
+}
+
+// DeleteVolume calls DeleteVolumeFunc.
+func (m *Client) DeleteVolume(ctx context.Context, volume santricity.VolumeEx) error {
+	m.record("DeleteVolume", ctx, volume)
+	if m.DeleteVolumeFunc == nil {
+		return notMocked("DeleteVolume")
+	}
+	return m.DeleteVolumeFunc(ctx, volume)
+}
+
+// DeleteVolumeCopyJob calls DeleteVolumeCopyJobFunc.
+func (m *Client) DeleteVolumeCopyJob(ctx context.Context, jobRef string, retainRepositories bool) error {
+	m.record("DeleteVolumeCopyJob", ctx, jobRef, retainRepositories)
+	if m.DeleteVolumeCopyJobFunc == nil {
+		return notMocked("DeleteVolumeCopyJob")
+	}
+	return m.DeleteVolumeCopyJobFunc(ctx, jobRef, retainRepositories)
+}
+
+// EnsureHostForIQN calls EnsureHostForIQNFunc.
+func (m *Client) EnsureHostForIQN(ctx context.Context, iqn string) (santricity.HostEx, error) {
+	m.record("EnsureHostForIQN", ctx, iqn)
+	if m.EnsureHostForIQNFunc == nil {
+		var r0 santricity.HostEx
+		return r0, notMocked("EnsureHostForIQN")
+	}
+	return m.EnsureHostForIQNFunc(ctx, iqn)
+}
+
+// EnsureHostForNQN calls EnsureHostForNQNFunc.
+func (m *Client) EnsureHostForNQN(ctx context.Context, nqn string) (santricity.HostEx, error) {
+	m.record("EnsureHostForNQN", ctx, nqn)
+	if m.EnsureHostForNQNFunc == nil {
+		var r0 santricity.HostEx
+		return r0, notMocked("EnsureHostForNQN")
+	}
+	return m.EnsureHostForNQNFunc(ctx, nqn)
+}
+
+// EnsureHostForPort calls EnsureHostForPortFunc.
+func (m *Client) EnsureHostForPort(ctx context.Context, portID string, portType string) (santricity.HostEx, error) {
+	m.record("EnsureHostForPort", ctx, portID, portType)
+	if m.EnsureHostForPortFunc == nil {
+		var r0 santricity.HostEx
+		return r0, notMocked("EnsureHostForPort")
+	}
+	return m.EnsureHostForPortFunc(ctx, portID, portType)
+}
+
+// EnsureHostGroup calls EnsureHostGroupFunc.
+func (m *Client) EnsureHostGroup(ctx context.Context) (santricity.HostGroup, error) {
+	m.record("EnsureHostGroup", ctx)
+	if m.EnsureHostGroupFunc == nil {
+		var r0 santricity.HostGroup
+		return r0, notMocked("EnsureHostGroup")
+	}
+	return m.EnsureHostGroupFunc(ctx)
+}
+
+// ExpandThinVolume calls ExpandThinVolumeFunc.
+func (m *Client) ExpandThinVolume(ctx context.Context, volumeRef string, newVirtualSize uint64, newRepositorySize uint64) error {
+	m.record("ExpandThinVolume", ctx, volumeRef, newVirtualSize, newRepositorySize)
+	if m.ExpandThinVolumeFunc == nil {
+		return notMocked("ExpandThinVolume")
+	}
+	return m.ExpandThinVolumeFunc(ctx, volumeRef, newVirtualSize, newRepositorySize)
+}
+
+// ExpandVolume calls ExpandVolumeFunc.
+func (m *Client) ExpandVolume(ctx context.Context, volumeRef string, expansionSize int64) error {
+	m.record("ExpandVolume", ctx, volumeRef, expansionSize)
+	if m.ExpandVolumeFunc == nil {
+		return notMocked("ExpandVolume")
+	}
+	return m.ExpandVolumeFunc(ctx, volumeRef, expansionSize)
+}
+
+// ExpandVolumeAndWait calls ExpandVolumeAndWaitFunc.
+func (m *Client) ExpandVolumeAndWait(ctx context.Context, volumeRef string, expansionSize int64, config santricity.WaitConfig) error {
+	m.record("ExpandVolumeAndWait", ctx, volumeRef, expansionSize, config)
+	if m.ExpandVolumeAndWaitFunc == nil {
+		return notMocked("ExpandVolumeAndWait")
+	}
+	return m.ExpandVolumeAndWaitFunc(ctx, volumeRef, expansionSize, config)
+}
+
+// FindStorageSystem calls FindStorageSystemFunc.
+func (m *Client) FindStorageSystem(ctx context.Context, selector string) (*santricity.StorageSystem, error) {
+	m.record("FindStorageSystem", ctx, selector)
+	if m.FindStorageSystemFunc == nil {
+		var r0 *santricity.StorageSystem
+		return r0, notMocked("FindStorageSystem")
+	}
+	return m.FindStorageSystemFunc(ctx, selector)
+}
+
+// GetAsyncMirrorConnections calls GetAsyncMirrorConnectionsFunc.
+func (m *Client) GetAsyncMirrorConnections(ctx context.Context, groupRef string) (*v11.AsyncMirrorGroupConnectionsResponse, error) {
+	m.record("GetAsyncMirrorConnections", ctx, groupRef)
+	if m.GetAsyncMirrorConnectionsFunc == nil {
+		var r0 *v11.AsyncMirrorGroupConnectionsResponse
+		return r0, notMocked("GetAsyncMirrorConnections")
+	}
+	return m.GetAsyncMirrorConnectionsFunc(ctx, groupRef)
+}
+
+// GetAsyncMirrorDefaults calls GetAsyncMirrorDefaultsFunc.
+func (m *Client) GetAsyncMirrorDefaults(ctx context.Context) (*v11.ArvmDefaultsResponse, error) {
+	m.record("GetAsyncMirrorDefaults", ctx)
+	if m.GetAsyncMirrorDefaultsFunc == nil {
+		var r0 *v11.ArvmDefaultsResponse
+		return r0, notMocked("GetAsyncMirrorDefaults")
+	}
+	return m.GetAsyncMirrorDefaultsFunc(ctx)
+}
+
+// GetAsyncMirrorGroup calls GetAsyncMirrorGroupFunc.
+func (m *Client) GetAsyncMirrorGroup(ctx context.Context, groupRef string) (*santricity.AsyncMirrorGroup, error) {
+	m.record("GetAsyncMirrorGroup", ctx, groupRef)
+	if m.GetAsyncMirrorGroupFunc == nil {
+		var r0 *santricity.AsyncMirrorGroup
+		return r0, notMocked("GetAsyncMirrorGroup")
+	}
+	return m.GetAsyncMirrorGroupFunc(ctx, groupRef)
+}
+
+// GetAsyncMirrorGroups calls GetAsyncMirrorGroupsFunc.
+func (m *Client) GetAsyncMirrorGroups(ctx context.Context) ([]santricity.AsyncMirrorGroup, error) {
+	m.record("GetAsyncMirrorGroups", ctx)
+	if m.GetAsyncMirrorGroupsFunc == nil {
+		var r0 []santricity.AsyncMirrorGroup
+		return r0, notMocked("GetAsyncMirrorGroups")
+	}
+	return m.GetAsyncMirrorGroupsFunc(ctx)
+}
+
+// GetAsyncMirrorPair calls GetAsyncMirrorPairFunc.
+func (m *Client) GetAsyncMirrorPair(ctx context.Context, memberRef string) (*santricity.AsyncMirrorPair, error) {
+	m.record("GetAsyncMirrorPair", ctx, memberRef)
+	if m.GetAsyncMirrorPairFunc == nil {
+		var r0 *santricity.AsyncMirrorPair
+		return r0, notMocked("GetAsyncMirrorPair")
+	}
+	return m.GetAsyncMirrorPairFunc(ctx, memberRef)
+}
+
+// GetAsyncMirrorPairs calls GetAsyncMirrorPairsFunc.
+func (m *Client) GetAsyncMirrorPairs(ctx context.Context, groupRef string) ([]santricity.AsyncMirrorPair, error) {
+	m.record("GetAsyncMirrorPairs", ctx, groupRef)
+	if m.GetAsyncMirrorPairsFunc == nil {
+		var r0 []santricity.AsyncMirrorPair
+		return r0, notMocked("GetAsyncMirrorPairs")
+	}
+	return m.GetAsyncMirrorPairsFunc(ctx, groupRef)
+}
+
+// GetAsyncMirrorProgress calls GetAsyncMirrorProgressFunc.
+func (m *Client) GetAsyncMirrorProgress(ctx context.Context, groupRef string) (*v11.AsyncMirrorGroupSyncProgress, error) {
+	m.record("GetAsyncMirrorProgress", ctx, groupRef)
+	if m.GetAsyncMirrorProgressFunc == nil {
+		var r0 *v11.AsyncMirrorGroupSyncProgress
+		return r0, notMocked("GetAsyncMirrorProgress")
+	}
+	return m.GetAsyncMirrorProgressFunc(ctx, groupRef)
+}
+
+// GetAsyncMirrorRepositoryUtilization calls GetAsyncMirrorRepositoryUtilizationFunc.
+func (m *Client) GetAsyncMirrorRepositoryUtilization(ctx context.Context, memberRef string) (*v11.AsyncMirrorRepositoryUtilization, error) {
+	m.record("GetAsyncMirrorRepositoryUtilization", ctx, memberRef)
+	if m.GetAsyncMirrorRepositoryUtilizationFunc == nil {
+		var r0 *v11.AsyncMirrorRepositoryUtilization
+		return r0, notMocked("GetAsyncMirrorRepositoryUtilization")
+	}
+	return m.GetAsyncMirrorRepositoryUtilizationFunc(ctx, memberRef)
+}
+
+// GetAsyncMirrorStatus calls GetAsyncMirrorStatusFunc.
+func (m *Client) GetAsyncMirrorStatus(ctx context.Context, groupRef string) (*santricity.MirrorStatus, error) {
+	m.record("GetAsyncMirrorStatus", ctx, groupRef)
+	if m.GetAsyncMirrorStatusFunc == nil {
+		var r0 *santricity.MirrorStatus
+		return r0, notMocked("GetAsyncMirrorStatus")
+	}
+	return m.GetAsyncMirrorStatusFunc(ctx, groupRef)
+}
+
+// GetAsyncMirrorTargets calls GetAsyncMirrorTargetsFunc.
+func (m *Client) GetAsyncMirrorTargets(ctx context.Context, compatibleOnly bool) ([]v11.RemoteCandidate, error) {
+	m.record("GetAsyncMirrorTargets", ctx, compatibleOnly)
+	if m.GetAsyncMirrorTargetsFunc == nil {
+		var r0 []v11.RemoteCandidate
+		return r0, notMocked("GetAsyncMirrorTargets")
+	}
+	return m.GetAsyncMirrorTargetsFunc(ctx, compatibleOnly)
+}
+
+// GetAuditLog calls GetAuditLogFunc.
+func (m *Client) GetAuditLog(ctx context.Context, begin time.Time, end time.Time) ([]v11.AuditLogRecord, error) {
+	m.record("GetAuditLog", ctx, begin, end)
+	if m.GetAuditLogFunc == nil {
+		var r0 []v11.AuditLogRecord
+		return r0, notMocked("GetAuditLog")
+	}
+	return m.GetAuditLogFunc(ctx, begin, end)
+}
+
+// GetBestIndexForHostType calls GetBestIndexForHostTypeFunc.
+func (m *Client) GetBestIndexForHostType(ctx context.Context, hostType string) int {
+	m.record("GetBestIndexForHostType", ctx, hostType)
+	if m.GetBestIndexForHostTypeFunc == nil {
+		var r0 int
+		return r0
+	}
+	return m.GetBestIndexForHostTypeFunc(ctx, hostType)
+}
+
+// GetCapabilities calls GetCapabilitiesFunc.
+func (m *Client) GetCapabilities(ctx context.Context) (*santricity.Capabilities, error) {
+	m.record("GetCapabilities", ctx)
+	if m.GetCapabilitiesFunc == nil {
+		var r0 *santricity.Capabilities
+		return r0, notMocked("GetCapabilities")
+	}
+	return m.GetCapabilitiesFunc(ctx)
+}
+
+// GetChassisSerialNumber calls GetChassisSerialNumberFunc.
+func (m *Client) GetChassisSerialNumber(ctx context.Context) (string, error) {
+	m.record("GetChassisSerialNumber", ctx)
+	if m.GetChassisSerialNumberFunc == nil {
+		var r0 string
+		return r0, notMocked("GetChassisSerialNumber")
+	}
+	return m.GetChassisSerialNumberFunc(ctx)
+}
+
+// GetConcatRepositoryVolume calls GetConcatRepositoryVolumeFunc.
+func (m *Client) GetConcatRepositoryVolume(ctx context.Context, id string) (*santricity.ConcatRepositoryVolume, error) {
+	m.record("GetConcatRepositoryVolume", ctx, id)
+	if m.GetConcatRepositoryVolumeFunc == nil {
+		var r0 *santricity.ConcatRepositoryVolume
+		return r0, notMocked("GetConcatRepositoryVolume")
+	}
+	return m.GetConcatRepositoryVolumeFunc(ctx, id)
+}
+
+// GetConcatRepositoryVolumes calls GetConcatRepositoryVolumesFunc.
+func (m *Client) GetConcatRepositoryVolumes(ctx context.Context) ([]santricity.ConcatRepositoryVolume, error) {
+	m.record("GetConcatRepositoryVolumes", ctx)
+	if m.GetConcatRepositoryVolumesFunc == nil {
+		var r0 []santricity.ConcatRepositoryVolume
+		return r0, notMocked("GetConcatRepositoryVolumes")
+	}
+	return m.GetConcatRepositoryVolumesFunc(ctx)
+}
+
+// GetConfigGraph calls GetConfigGraphFunc.
+func (m *Client) GetConfigGraph(ctx context.Context) (*santricity.ConfigGraph, error) {
+	m.record("GetConfigGraph", ctx)
+	if m.GetConfigGraphFunc == nil {
+		var r0 *santricity.ConfigGraph
+		return r0, notMocked("GetConfigGraph")
+	}
+	return m.GetConfigGraphFunc(ctx)
+}
+
+// GetConsistencyGroup calls GetConsistencyGroupFunc.
+func (m *Client) GetConsistencyGroup(ctx context.Context, id string) (*santricity.ConsistencyGroup, error) {
+	m.record("GetConsistencyGroup", ctx, id)
+	if m.GetConsistencyGroupFunc == nil {
+		var r0 *santricity.ConsistencyGroup
+		return r0, notMocked("GetConsistencyGroup")
+	}
+	return m.GetConsistencyGroupFunc(ctx, id)
+}
+
+// GetConsistencyGroupMember calls GetConsistencyGroupMemberFunc.
+func (m *Client) GetConsistencyGroupMember(ctx context.Context, cgID string, volumeID string) (*santricity.ConsistencyGroupMember, error) {
+	m.record("GetConsistencyGroupMember", ctx, cgID, volumeID)
+	if m.GetConsistencyGroupMemberFunc == nil {
+		var r0 *santricity.ConsistencyGroupMember
+		return r0, notMocked("GetConsistencyGroupMember")
+	}
+	return m.GetConsistencyGroupMemberFunc(ctx, cgID, volumeID)
+}
+
+// GetConsistencyGroupSnapshot calls GetConsistencyGroupSnapshotFunc.
+func (m *Client) GetConsistencyGroupSnapshot(ctx context.Context, cgID string, sequenceNumber string) ([]santricity.SnapshotImage, error) {
+	m.record("GetConsistencyGroupSnapshot", ctx, cgID, sequenceNumber)
+	if m.GetConsistencyGroupSnapshotFunc == nil {
+		var r0 []santricity.SnapshotImage
+		return r0, notMocked("GetConsistencyGroupSnapshot")
+	}
+	return m.GetConsistencyGroupSnapshotFunc(ctx, cgID, sequenceNumber)
+}
+
+// GetConsistencyGroupView calls GetConsistencyGroupViewFunc.
+func (m *Client) GetConsistencyGroupView(ctx context.Context, cgID string, viewID string) (*santricity.ConsistencyGroupView, error) {
+	m.record("GetConsistencyGroupView", ctx, cgID, viewID)
+	if m.GetConsistencyGroupViewFunc == nil {
+		var r0 *santricity.ConsistencyGroupView
+		return r0, notMocked("GetConsistencyGroupView")
+	}
+	return m.GetConsistencyGroupViewFunc(ctx, cgID, viewID)
+}
+
+// GetFailures calls GetFailuresFunc.
+func (m *Client) GetFailures(ctx context.Context) ([]santricity.Failure, error) {
+	m.record("GetFailures", ctx)
+	if m.GetFailuresFunc == nil {
+		var r0 []santricity.Failure
+		return r0, notMocked("GetFailures")
+	}
+	return m.GetFailuresFunc(ctx)
+}
+
+// GetHostByRef calls GetHostByRefFunc.
+func (m *Client) GetHostByRef(ctx context.Context, hostRef string) (santricity.HostEx, error) {
+	m.record("GetHostByRef", ctx, hostRef)
+	if m.GetHostByRefFunc == nil {
+		var r0 santricity.HostEx
+		return r0, notMocked("GetHostByRef")
+	}
+	return m.GetHostByRefFunc(ctx, hostRef)
+}
+
+// GetHostForPort calls GetHostForPortFunc.
+func (m *Client) GetHostForPort(ctx context.Context, portID string) (santricity.HostEx, error) {
+	m.record("GetHostForPort", ctx, portID)
+	if m.GetHostForPortFunc == nil {
+		var r0 santricity.HostEx
+		return r0, notMocked("GetHostForPort")
+	}
+	return m.GetHostForPortFunc(ctx, portID)
+}
+
+// GetHostGroup calls GetHostGroupFunc.
+func (m *Client) GetHostGroup(ctx context.Context, name string) (santricity.HostGroup, error) {
+	m.record("GetHostGroup", ctx, name)
+	if m.GetHostGroupFunc == nil {
+		var r0 santricity.HostGroup
+		return r0, notMocked("GetHostGroup")
+	}
+	return m.GetHostGroupFunc(ctx, name)
+}
+
+// GetHostGroupByRef calls GetHostGroupByRefFunc.
+func (m *Client) GetHostGroupByRef(ctx context.Context, hostGroupRef string) (santricity.HostGroup, error) {
+	m.record("GetHostGroupByRef", ctx, hostGroupRef)
+	if m.GetHostGroupByRefFunc == nil {
+		var r0 santricity.HostGroup
+		return r0, notMocked("GetHostGroupByRef")
+	}
+	return m.GetHostGroupByRefFunc(ctx, hostGroupRef)
+}
+
+// GetHostGroups calls GetHostGroupsFunc.
+func (m *Client) GetHostGroups(ctx context.Context) ([]santricity.HostGroup, error) {
+	m.record("GetHostGroups", ctx)
+	if m.GetHostGroupsFunc == nil {
+		var r0 []santricity.HostGroup
+		return r0, notMocked("GetHostGroups")
+	}
+	return m.GetHostGroupsFunc(ctx)
+}
+
+// GetHosts calls GetHostsFunc.
+func (m *Client) GetHosts(ctx context.Context) ([]santricity.Host, error) {
+	m.record("GetHosts", ctx)
+	if m.GetHostsFunc == nil {
+		var r0 []santricity.Host
+		return r0, notMocked("GetHosts")
+	}
+	return m.GetHostsFunc(ctx)
+}
+
+// GetIncompleteAsyncMirrorPairs calls GetIncompleteAsyncMirrorPairsFunc.
+func (m *Client) GetIncompleteAsyncMirrorPairs(ctx context.Context, groupRef string) ([]v11.AmgIncompleteMember, error) {
+	m.record("GetIncompleteAsyncMirrorPairs", ctx, groupRef)
+	if m.GetIncompleteAsyncMirrorPairsFunc == nil {
+		var r0 []v11.AmgIncompleteMember
+		return r0, notMocked("GetIncompleteAsyncMirrorPairs")
+	}
+	return m.GetIncompleteAsyncMirrorPairsFunc(ctx, groupRef)
+}
+
+// GetInventory calls GetInventoryFunc.
+func (m *Client) GetInventory(ctx context.Context) (*santricity.InventoryIndex, error) {
+	m.record("GetInventory", ctx)
+	if m.GetInventoryFunc == nil {
+		var r0 *santricity.InventoryIndex
+		return r0, notMocked("GetInventory")
+	}
+	return m.GetInventoryFunc(ctx)
+}
+
+// GetNVMeoFSettings calls GetNVMeoFSettingsFunc.
+func (m *Client) GetNVMeoFSettings(ctx context.Context) (*santricity.NvmeofTargetSettings, error) {
+	m.record("GetNVMeoFSettings", ctx)
+	if m.GetNVMeoFSettingsFunc == nil {
+		var r0 *santricity.NvmeofTargetSettings
+		return r0, notMocked("GetNVMeoFSettings")
+	}
+	return m.GetNVMeoFSettingsFunc(ctx)
+}
+
+// GetRemoteMirrorCandidates calls GetRemoteMirrorCandidatesFunc.
+func (m *Client) GetRemoteMirrorCandidates(ctx context.Context, volumeRef string) ([]v11.RemoteMirrorCandidate, error) {
+	m.record("GetRemoteMirrorCandidates", ctx, volumeRef)
+	if m.GetRemoteMirrorCandidatesFunc == nil {
+		var r0 []v11.RemoteMirrorCandidate
+		return r0, notMocked("GetRemoteMirrorCandidates")
+	}
+	return m.GetRemoteMirrorCandidatesFunc(ctx, volumeRef)
+}
+
+// GetRemoteMirrorPair calls GetRemoteMirrorPairFunc.
+func (m *Client) GetRemoteMirrorPair(ctx context.Context, pairID string) (*santricity.RemoteMirrorPair, error) {
+	m.record("GetRemoteMirrorPair", ctx, pairID)
+	if m.GetRemoteMirrorPairFunc == nil {
+		var r0 *santricity.RemoteMirrorPair
+		return r0, notMocked("GetRemoteMirrorPair")
+	}
+	return m.GetRemoteMirrorPairFunc(ctx, pairID)
+}
+
+// GetRemoteMirrorPairs calls GetRemoteMirrorPairsFunc.
+func (m *Client) GetRemoteMirrorPairs(ctx context.Context) ([]santricity.RemoteMirrorPair, error) {
+	m.record("GetRemoteMirrorPairs", ctx)
+	if m.GetRemoteMirrorPairsFunc == nil {
+		var r0 []santricity.RemoteMirrorPair
+		return r0, notMocked("GetRemoteMirrorPairs")
+	}
+	return m.GetRemoteMirrorPairsFunc(ctx)
+}
+
+// GetRemoteMirrorStatus calls GetRemoteMirrorStatusFunc.
+func (m *Client) GetRemoteMirrorStatus(ctx context.Context, pairID string) (*santricity.MirrorStatus, error) {
+	m.record("GetRemoteMirrorStatus", ctx, pairID)
+	if m.GetRemoteMirrorStatusFunc == nil {
+		var r0 *santricity.MirrorStatus
+		return r0, notMocked("GetRemoteMirrorStatus")
+	}
+	return m.GetRemoteMirrorStatusFunc(ctx, pairID)
+}
+
+// GetRemoteMirrorSyncProgress calls GetRemoteMirrorSyncProgressFunc.
+func (m *Client) GetRemoteMirrorSyncProgress(ctx context.Context, pairID string) (*v11.JobProgress, error) {
+	m.record("GetRemoteMirrorSyncProgress", ctx, pairID)
+	if m.GetRemoteMirrorSyncProgressFunc == nil {
+		var r0 *v11.JobProgress
+		return r0, notMocked("GetRemoteMirrorSyncProgress")
+	}
+	return m.GetRemoteMirrorSyncProgressFunc(ctx, pairID)
+}
+
+// GetSnapshotGroup calls GetSnapshotGroupFunc.
+func (m *Client) GetSnapshotGroup(ctx context.Context, id string) (*santricity.SnapshotGroup, error) {
+	m.record("GetSnapshotGroup", ctx, id)
+	if m.GetSnapshotGroupFunc == nil {
+		var r0 *santricity.SnapshotGroup
+		return r0, notMocked("GetSnapshotGroup")
+	}
+	return m.GetSnapshotGroupFunc(ctx, id)
+}
+
+// GetSnapshotGroups calls GetSnapshotGroupsFunc.
+func (m *Client) GetSnapshotGroups(ctx context.Context) ([]santricity.SnapshotGroup, error) {
+	m.record("GetSnapshotGroups", ctx)
+	if m.GetSnapshotGroupsFunc == nil {
+		var r0 []santricity.SnapshotGroup
+		return r0, notMocked("GetSnapshotGroups")
+	}
+	return m.GetSnapshotGroupsFunc(ctx)
+}
+
+// GetSnapshotImage calls GetSnapshotImageFunc.
+func (m *Client) GetSnapshotImage(ctx context.Context, id string) (*santricity.SnapshotImage, error) {
+	m.record("GetSnapshotImage", ctx, id)
+	if m.GetSnapshotImageFunc == nil {
+		var r0 *santricity.SnapshotImage
+		return r0, notMocked("GetSnapshotImage")
+	}
+	return m.GetSnapshotImageFunc(ctx, id)
+}
+
+// GetSnapshotImages calls GetSnapshotImagesFunc.
+func (m *Client) GetSnapshotImages(ctx context.Context) ([]santricity.SnapshotImage, error) {
+	m.record("GetSnapshotImages", ctx)
+	if m.GetSnapshotImagesFunc == nil {
+		var r0 []santricity.SnapshotImage
+		return r0, notMocked("GetSnapshotImages")
+	}
+	return m.GetSnapshotImagesFunc(ctx)
+}
+
+// GetSnapshotSchedule calls GetSnapshotScheduleFunc.
+func (m *Client) GetSnapshotSchedule(ctx context.Context, id string) (*santricity.SnapshotSchedule, error) {
+	m.record("GetSnapshotSchedule", ctx, id)
+	if m.GetSnapshotScheduleFunc == nil {
+		var r0 *santricity.SnapshotSchedule
+		return r0, notMocked("GetSnapshotSchedule")
+	}
+	return m.GetSnapshotScheduleFunc(ctx, id)
+}
+
+// GetSnapshotScheduleRetention calls GetSnapshotScheduleRetentionFunc.
+func (m *Client) GetSnapshotScheduleRetention(ctx context.Context, schedule *santricity.SnapshotSchedule) (int, error) {
+	m.record("GetSnapshotScheduleRetention", ctx, schedule)
+	if m.GetSnapshotScheduleRetentionFunc == nil {
+		var r0 int
+		return r0, notMocked("GetSnapshotScheduleRetention")
+	}
+	return m.GetSnapshotScheduleRetentionFunc(ctx, schedule)
+}
+
+// GetSnapshotSchedules calls GetSnapshotSchedulesFunc.
+func (m *Client) GetSnapshotSchedules(ctx context.Context) ([]santricity.SnapshotSchedule, error) {
+	m.record("GetSnapshotSchedules", ctx)
+	if m.GetSnapshotSchedulesFunc == nil {
+		var r0 []santricity.SnapshotSchedule
+		return r0, notMocked("GetSnapshotSchedules")
+	}
+	return m.GetSnapshotSchedulesFunc(ctx)
+}
+
+// GetSnapshotVolume calls GetSnapshotVolumeFunc.
+func (m *Client) GetSnapshotVolume(ctx context.Context, id string) (*santricity.SnapshotVolume, error) {
+	m.record("GetSnapshotVolume", ctx, id)
+	if m.GetSnapshotVolumeFunc == nil {
+		var r0 *santricity.SnapshotVolume
+		return r0, notMocked("GetSnapshotVolume")
+	}
+	return m.GetSnapshotVolumeFunc(ctx, id)
+}
+
+// GetSnapshotVolumes calls GetSnapshotVolumesFunc.
+func (m *Client) GetSnapshotVolumes(ctx context.Context) ([]santricity.SnapshotVolume, error) {
+	m.record("GetSnapshotVolumes", ctx)
+	if m.GetSnapshotVolumesFunc == nil {
+		var r0 []santricity.SnapshotVolume
+		return r0, notMocked("GetSnapshotVolumes")
+	}
+	return m.GetSnapshotVolumesFunc(ctx)
+}
+
+// GetStorageSystem calls GetStorageSystemFunc.
+func (m *Client) GetStorageSystem(ctx context.Context) (*santricity.StorageSystem, error) {
+	m.record("GetStorageSystem", ctx)
+	if m.GetStorageSystemFunc == nil {
+		var r0 *santricity.StorageSystem
+		return r0, notMocked("GetStorageSystem")
+	}
+	return m.GetStorageSystemFunc(ctx)
+}
+
+// GetTargetIQN calls GetTargetIQNFunc.
+func (m *Client) GetTargetIQN(ctx context.Context) (string, error) {
+	m.record("GetTargetIQN", ctx)
+	if m.GetTargetIQNFunc == nil {
+		var r0 string
+		return r0, notMocked("GetTargetIQN")
+	}
+	return m.GetTargetIQNFunc(ctx)
+}
+
+// GetTargetSettings calls GetTargetSettingsFunc.
+func (m *Client) GetTargetSettings(ctx context.Context) (*santricity.IscsiTargetSettings, error) {
+	m.record("GetTargetSettings", ctx)
+	if m.GetTargetSettingsFunc == nil {
+		var r0 *santricity.IscsiTargetSettings
+		return r0, notMocked("GetTargetSettings")
+	}
+	return m.GetTargetSettingsFunc(ctx)
+}
+
+// GetThinVolumeByRef calls GetThinVolumeByRefFunc.
+func (m *Client) GetThinVolumeByRef(ctx context.Context, volumeRef string) (santricity.ThinVolume, error) {
+	m.record("GetThinVolumeByRef", ctx, volumeRef)
+	if m.GetThinVolumeByRefFunc == nil {
+		var r0 santricity.ThinVolume
+		return r0, notMocked("GetThinVolumeByRef")
+	}
+	return m.GetThinVolumeByRefFunc(ctx, volumeRef)
+}
+
+// GetThinVolumeDefaults calls GetThinVolumeDefaultsFunc.
+func (m *Client) GetThinVolumeDefaults(ctx context.Context) (*v11.ThinVolumeDefaultsResponse, error) {
+	m.record("GetThinVolumeDefaults", ctx)
+	if m.GetThinVolumeDefaultsFunc == nil {
+		var r0 *v11.ThinVolumeDefaultsResponse
+		return r0, notMocked("GetThinVolumeDefaults")
+	}
+	return m.GetThinVolumeDefaultsFunc(ctx)
+}
+
+// GetThinVolumes calls GetThinVolumesFunc.
+func (m *Client) GetThinVolumes(ctx context.Context) ([]santricity.ThinVolume, error) {
+	m.record("GetThinVolumes", ctx)
+	if m.GetThinVolumesFunc == nil {
+		var r0 []santricity.ThinVolume
+		return r0, notMocked("GetThinVolumes")
+	}
+	return m.GetThinVolumesFunc(ctx)
+}
+
+// GetVolume calls GetVolumeFunc.
+func (m *Client) GetVolume(ctx context.Context, name string) (santricity.VolumeEx, error) {
+	m.record("GetVolume", ctx, name)
+	if m.GetVolumeFunc == nil {
+		var r0 santricity.VolumeEx
+		return r0, notMocked("GetVolume")
+	}
+	return m.GetVolumeFunc(ctx, name)
+}
+
+// GetVolumeByRef calls GetVolumeByRefFunc.
+func (m *Client) GetVolumeByRef(ctx context.Context, volumeRef string) (santricity.VolumeEx, error) {
+	m.record("GetVolumeByRef", ctx, volumeRef)
+	if m.GetVolumeByRefFunc == nil {
+		var r0 santricity.VolumeEx
+		return r0, notMocked("GetVolumeByRef")
+	}
+	return m.GetVolumeByRefFunc(ctx, volumeRef)
+}
+
+// GetVolumeCopyJob calls GetVolumeCopyJobFunc.
+func (m *Client) GetVolumeCopyJob(ctx context.Context, jobRef string) (*santricity.VolumeCopyJob, error) {
+	m.record("GetVolumeCopyJob", ctx, jobRef)
+	if m.GetVolumeCopyJobFunc == nil {
+		var r0 *santricity.VolumeCopyJob
+		return r0, notMocked("GetVolumeCopyJob")
+	}
+	return m.GetVolumeCopyJobFunc(ctx, jobRef)
+}
+
+// GetVolumeCopyJobs calls GetVolumeCopyJobsFunc.
+func (m *Client) GetVolumeCopyJobs(ctx context.Context) ([]santricity.VolumeCopyJob, error) {
+	m.record("GetVolumeCopyJobs", ctx)
+	if m.GetVolumeCopyJobsFunc == nil {
+		var r0 []santricity.VolumeCopyJob
+		return r0, notMocked("GetVolumeCopyJobs")
+	}
+	return m.GetVolumeCopyJobsFunc(ctx)
+}
+
+// GetVolumeCopyProgress calls GetVolumeCopyProgressFunc.
+func (m *Client) GetVolumeCopyProgress(ctx context.Context, jobRef string) (*v11.VolumeCopyProgress, error) {
+	m.record("GetVolumeCopyProgress", ctx, jobRef)
+	if m.GetVolumeCopyProgressFunc == nil {
+		var r0 *v11.VolumeCopyProgress
+		return r0, notMocked("GetVolumeCopyProgress")
+	}
+	return m.GetVolumeCopyProgressFunc(ctx, jobRef)
+}
+
+// GetVolumeMappings calls GetVolumeMappingsFunc.
+func (m *Client) GetVolumeMappings(ctx context.Context) ([]santricity.LUNMapping, error) {
+	m.record("GetVolumeMappings", ctx)
+	if m.GetVolumeMappingsFunc == nil {
+		var r0 []santricity.LUNMapping
+		return r0, notMocked("GetVolumeMappings")
+	}
+	return m.GetVolumeMappingsFunc(ctx)
+}
+
+// GetVolumeParityCheckJob calls GetVolumeParityCheckJobFunc.
+func (m *Client) GetVolumeParityCheckJob(ctx context.Context, jobID string) (*santricity.ParityCheckJob, error) {
+	m.record("GetVolumeParityCheckJob", ctx, jobID)
+	if m.GetVolumeParityCheckJobFunc == nil {
+		var r0 *santricity.ParityCheckJob
+		return r0, notMocked("GetVolumeParityCheckJob")
+	}
+	return m.GetVolumeParityCheckJobFunc(ctx, jobID)
+}
+
+// GetVolumePoolByRef calls GetVolumePoolByRefFunc.
+func (m *Client) GetVolumePoolByRef(ctx context.Context, volumeGroupRef string) (santricity.VolumeGroupEx, error) {
+	m.record("GetVolumePoolByRef", ctx, volumeGroupRef)
+	if m.GetVolumePoolByRefFunc == nil {
+		var r0 santricity.VolumeGroupEx
+		return r0, notMocked("GetVolumePoolByRef")
+	}
+	return m.GetVolumePoolByRefFunc(ctx, volumeGroupRef)
+}
+
+// GetVolumePools calls GetVolumePoolsFunc.
+func (m *Client) GetVolumePools(ctx context.Context, mediaType string, minFreeSpaceBytes uint64, poolName string) ([]santricity.VolumeGroupEx, error) {
+	m.record("GetVolumePools", ctx, mediaType, minFreeSpaceBytes, poolName)
+	if m.GetVolumePoolsFunc == nil {
+		var r0 []santricity.VolumeGroupEx
+		return r0, notMocked("GetVolumePools")
+	}
+	return m.GetVolumePoolsFunc(ctx, mediaType, minFreeSpaceBytes, poolName)
+}
+
+// GetVolumes calls GetVolumesFunc.
+func (m *Client) GetVolumes(ctx context.Context) ([]santricity.VolumeEx, error) {
+	m.record("GetVolumes", ctx)
+	if m.GetVolumesFunc == nil {
+		var r0 []santricity.VolumeEx
+		return r0, notMocked("GetVolumes")
+	}
+	return m.GetVolumesFunc(ctx)
+}
+
+// InvalidateInventory calls InvalidateInventoryFunc.
+func (m *Client) InvalidateInventory(objectTypes ...santricity.InventoryType) {
+	m.record("InvalidateInventory", objectTypes)
+	if m.InvalidateInventoryFunc == nil {
+		return
+	}
+	m.InvalidateInventoryFunc(objectTypes...)
+}
+
+// InvokeAPI calls InvokeAPIFunc.
+func (m *Client) InvokeAPI(ctx context.Context, requestBody []byte, method string, resourcePath string) (*http.Response, []byte, error) {
+	m.record("InvokeAPI", ctx, requestBody, method, resourcePath)
+	if m.InvokeAPIFunc == nil {
+		var r0 *http.Response
+		var r1 []byte
+		return r0, r1, notMocked("InvokeAPI")
+	}
+	return m.InvokeAPIFunc(ctx, requestBody, method, resourcePath)
+}
+
+// IsRefValid calls IsRefValidFunc.
+func (m *Client) IsRefValid(ref string) bool {
+	m.record("IsRefValid", ref)
+	if m.IsRefValidFunc == nil {
+		var r0 bool
+		return r0
+	}
+	return m.IsRefValidFunc(ref)
+}
+
+// ListStorageSystems calls ListStorageSystemsFunc.
+func (m *Client) ListStorageSystems(ctx context.Context) ([]santricity.StorageSystem, error) {
+	m.record("ListStorageSystems", ctx)
+	if m.ListStorageSystemsFunc == nil {
+		var r0 []santricity.StorageSystem
+		return r0, notMocked("ListStorageSystems")
+	}
+	return m.ListStorageSystemsFunc(ctx)
+}
+
+// ListVolumes calls ListVolumesFunc.
+func (m *Client) ListVolumes(ctx context.Context) ([]string, error) {
+	m.record("ListVolumes", ctx)
+	if m.ListVolumesFunc == nil {
+		var r0 []string
+		return r0, notMocked("ListVolumes")
+	}
+	return m.ListVolumesFunc(ctx)
+}
+
+// MapVolume calls MapVolumeFunc.
+func (m *Client) MapVolume(ctx context.Context, volume santricity.VolumeEx, host santricity.HostEx, lun int) (santricity.LUNMapping, error) {
+	m.record("MapVolume", ctx, volume, host, lun)
+	if m.MapVolumeFunc == nil {
+		var r0 santricity.LUNMapping
+		return r0, notMocked("MapVolume")
+	}
+	return m.MapVolumeFunc(ctx, volume, host, lun)
+}
+
+// ParityCheck calls ParityCheckFunc.
+func (m *Client) ParityCheck(jobID string) santricity.Operation {
+	m.record("ParityCheck", jobID)
+	if m.ParityCheckFunc == nil {
+		var r0 santricity.Operation
+		return r0
+	}
+	return m.ParityCheckFunc(jobID)
+}
+
+// Plan calls PlanFunc.
+func (m *Client) Plan() []santricity.PlannedCall {
+	m.record("Plan")
+	if m.PlanFunc == nil {
+		var r0 []santricity.PlannedCall
+		return r0
+	}
+	return m.PlanFunc()
+}
+
+// PoolAction calls PoolActionFunc.
+func (m *Client) PoolAction(volumeGroupRef string) santricity.Operation {
+	m.record("PoolAction", volumeGroupRef)
+	if m.PoolActionFunc == nil {
+		var r0 santricity.Operation
+		return r0
+	}
+	return m.PoolActionFunc(volumeGroupRef)
+}
+
+// RefreshInventory calls RefreshInventoryFunc.
+func (m *Client) RefreshInventory(ctx context.Context) (*santricity.InventoryIndex, error) {
+	m.record("RefreshInventory", ctx)
+	if m.RefreshInventoryFunc == nil {
+		var r0 *santricity.InventoryIndex
+		return r0, notMocked("RefreshInventory")
+	}
+	return m.RefreshInventoryFunc(ctx)
+}
+
+// RegisterStorageSystem calls RegisterStorageSystemFunc.
+func (m *Client) RegisterStorageSystem(ctx context.Context, request santricity.StorageSystemRegisterRequest) (string, error) {
+	m.record("RegisterStorageSystem", ctx, request)
+	if m.RegisterStorageSystemFunc == nil {
+		var r0 string
+		return r0, notMocked("RegisterStorageSystem")
+	}
+	return m.RegisterStorageSystemFunc(ctx, request)
+}
+
+// RemoteMirrorSync calls RemoteMirrorSyncFunc.
+func (m *Client) RemoteMirrorSync(pairID string) santricity.Operation {
+	m.record("RemoteMirrorSync", pairID)
+	if m.RemoteMirrorSyncFunc == nil {
+		var r0 santricity.Operation
+		return r0
+	}
+	return m.RemoteMirrorSyncFunc(pairID)
+}
+
+// RemoveAsyncMirrorPair calls RemoveAsyncMirrorPairFunc.
+func (m *Client) RemoveAsyncMirrorPair(ctx context.Context, groupRef string, memberRef string) error {
+	m.record("RemoveAsyncMirrorPair", ctx, groupRef, memberRef)
+	if m.RemoveAsyncMirrorPairFunc == nil {
+		return notMocked("RemoveAsyncMirrorPair")
+	}
+	return m.RemoveAsyncMirrorPairFunc(ctx, groupRef, memberRef)
+}
+
+// RemoveConsistencyGroupMember calls RemoveConsistencyGroupMemberFunc.
+func (m *Client) RemoveConsistencyGroupMember(ctx context.Context, cgID string, memberVolumeID string) error {
+	m.record("RemoveConsistencyGroupMember", ctx, cgID, memberVolumeID)
+	if m.RemoveConsistencyGroupMemberFunc == nil {
+		return notMocked("RemoveConsistencyGroupMember")
+	}
+	return m.RemoveConsistencyGroupMemberFunc(ctx, cgID, memberVolumeID)
+}
+
+// RemoveIncompleteAsyncMirrorPair calls RemoveIncompleteAsyncMirrorPairFunc.
+func (m *Client) RemoveIncompleteAsyncMirrorPair(ctx context.Context, memberRef string) error {
+	m.record("RemoveIncompleteAsyncMirrorPair", ctx, memberRef)
+	if m.RemoveIncompleteAsyncMirrorPairFunc == nil {
+		return notMocked("RemoveIncompleteAsyncMirrorPair")
+	}
+	return m.RemoveIncompleteAsyncMirrorPairFunc(ctx, memberRef)
+}
+
+// ResetPlan calls ResetPlanFunc.
+func (m *Client) ResetPlan() {
+	m.record("ResetPlan")
+	if m.ResetPlanFunc == nil {
+		return
+	}
+	m.ResetPlanFunc()
+}
+
+// ResizeVolume calls ResizeVolumeFunc.
+func (m *Client) ResizeVolume(ctx context.Context, volume santricity.VolumeEx, size uint64) error {
+	m.record("ResizeVolume", ctx, volume, size)
+	if m.ResizeVolumeFunc == nil {
+		return notMocked("ResizeVolume")
+	}
+	return m.ResizeVolumeFunc(ctx, volume, size)
+}
+
+// ResizingVolume calls ResizingVolumeFunc.
+func (m *Client) ResizingVolume(ctx context.Context, volume santricity.VolumeEx) (bool, error) {
+	m.record("ResizingVolume", ctx, volume)
+	if m.ResizingVolumeFunc == nil {
+		var r0 bool
+		return r0, notMocked("ResizingVolume")
+	}
+	return m.ResizingVolumeFunc(ctx, volume)
+}
+
+// ResumeAsyncMirrorGroup calls ResumeAsyncMirrorGroupFunc.
+func (m *Client) ResumeAsyncMirrorGroup(ctx context.Context, groupRef string, deleteRecoveryPointIfNecessary bool) error {
+	m.record("ResumeAsyncMirrorGroup", ctx, groupRef, deleteRecoveryPointIfNecessary)
+	if m.ResumeAsyncMirrorGroupFunc == nil {
+		return notMocked("ResumeAsyncMirrorGroup")
+	}
+	return m.ResumeAsyncMirrorGroupFunc(ctx, groupRef, deleteRecoveryPointIfNecessary)
+}
+
+// ResumeRemoteMirrorPair calls ResumeRemoteMirrorPairFunc.
+func (m *Client) ResumeRemoteMirrorPair(ctx context.Context, pairID string) (*santricity.RemoteMirrorPair, error) {
+	m.record("ResumeRemoteMirrorPair", ctx, pairID)
+	if m.ResumeRemoteMirrorPairFunc == nil {
+		var r0 *santricity.RemoteMirrorPair
+		return r0, notMocked("ResumeRemoteMirrorPair")
+	}
+	return m.ResumeRemoteMirrorPairFunc(ctx, pairID)
+}
+
+// ResyncRemoteMirrorPair calls ResyncRemoteMirrorPairFunc.
+func (m *Client) ResyncRemoteMirrorPair(ctx context.Context, pairID string) (*santricity.RemoteMirrorPair, error) {
+	m.record("ResyncRemoteMirrorPair", ctx, pairID)
+	if m.ResyncRemoteMirrorPairFunc == nil {
+		var r0 *santricity.RemoteMirrorPair
+		return r0, notMocked("ResyncRemoteMirrorPair")
+	}
+	return m.ResyncRemoteMirrorPairFunc(ctx, pairID)
+}
+
+// RollbackSnapshotImage calls RollbackSnapshotImageFunc.
+func (m *Client) RollbackSnapshotImage(ctx context.Context, imageRef string) error {
+	m.record("RollbackSnapshotImage", ctx, imageRef)
+	if m.RollbackSnapshotImageFunc == nil {
+		return notMocked("RollbackSnapshotImage")
+	}
+	return m.RollbackSnapshotImageFunc(ctx, imageRef)
+}
+
+// SetAsyncMirrorGroupRole calls SetAsyncMirrorGroupRoleFunc.
+func (m *Client) SetAsyncMirrorGroupRole(ctx context.Context, groupRef string, request v11.AsyncMirrorGroupRoleUpdateRequest) (*santricity.AsyncMirrorGroup, error) {
+	m.record("SetAsyncMirrorGroupRole", ctx, groupRef, request)
+	if m.SetAsyncMirrorGroupRoleFunc == nil {
+		var r0 *santricity.AsyncMirrorGroup
+		return r0, notMocked("SetAsyncMirrorGroupRole")
+	}
+	return m.SetAsyncMirrorGroupRoleFunc(ctx, groupRef, request)
+}
+
+// SetIncludeRepositoryVolumes calls SetIncludeRepositoryVolumesFunc.
+func (m *Client) SetIncludeRepositoryVolumes(include bool) {
+	m.record("SetIncludeRepositoryVolumes", include)
+	if m.SetIncludeRepositoryVolumesFunc == nil {
+		return
+	}
+	m.SetIncludeRepositoryVolumesFunc(include)
+}
+
+// SetRemoteMirrorRole calls SetRemoteMirrorRoleFunc.
+func (m *Client) SetRemoteMirrorRole(ctx context.Context, pairID string, role santricity.MirrorRole) (*santricity.RemoteMirrorPair, error) {
+	m.record("SetRemoteMirrorRole", ctx, pairID, role)
+	if m.SetRemoteMirrorRoleFunc == nil {
+		var r0 *santricity.RemoteMirrorPair
+		return r0, notMocked("SetRemoteMirrorRole")
+	}
+	return m.SetRemoteMirrorRoleFunc(ctx, pairID, role)
+}
+
+// SetRemoteMirrorWriteMode calls SetRemoteMirrorWriteModeFunc.
+func (m *Client) SetRemoteMirrorWriteMode(ctx context.Context, pairID string, writeMode int) (*santricity.RemoteMirrorPair, error) {
+	m.record("SetRemoteMirrorWriteMode", ctx, pairID, writeMode)
+	if m.SetRemoteMirrorWriteModeFunc == nil {
+		var r0 *santricity.RemoteMirrorPair
+		return r0, notMocked("SetRemoteMirrorWriteMode")
+	}
+	return m.SetRemoteMirrorWriteModeFunc(ctx, pairID, writeMode)
+}
+
+// SetThinVolumeAlertThreshold calls SetThinVolumeAlertThresholdFunc.
+func (m *Client) SetThinVolumeAlertThreshold(ctx context.Context, volumeRef string, percent int) (santricity.ThinVolume, error) {
+	m.record("SetThinVolumeAlertThreshold", ctx, volumeRef, percent)
+	if m.SetThinVolumeAlertThresholdFunc == nil {
+		var r0 santricity.ThinVolume
+		return r0, notMocked("SetThinVolumeAlertThreshold")
+	}
+	return m.SetThinVolumeAlertThresholdFunc(ctx, volumeRef, percent)
+}
+
+// SetVolumeCopyPriority calls SetVolumeCopyPriorityFunc.
+func (m *Client) SetVolumeCopyPriority(ctx context.Context, jobRef string, priority string) (*santricity.VolumeCopyJob, error) {
+	m.record("SetVolumeCopyPriority", ctx, jobRef, priority)
+	if m.SetVolumeCopyPriorityFunc == nil {
+		var r0 *santricity.VolumeCopyJob
+		return r0, notMocked("SetVolumeCopyPriority")
+	}
+	return m.SetVolumeCopyPriorityFunc(ctx, jobRef, priority)
+}
+
+// StartVolumeCopyJob calls StartVolumeCopyJobFunc.
+func (m *Client) StartVolumeCopyJob(ctx context.Context, jobRef string) error {
+	m.record("StartVolumeCopyJob", ctx, jobRef)
+	if m.StartVolumeCopyJobFunc == nil {
+		return notMocked("StartVolumeCopyJob")
+	}
+	return m.StartVolumeCopyJobFunc(ctx, jobRef)
+}
+
+// StartVolumeParityCheck calls StartVolumeParityCheckFunc.
+func (m *Client) StartVolumeParityCheck(ctx context.Context, volumeRef string, request v11.CheckVolumeParityRequest) (string, error) {
+	m.record("StartVolumeParityCheck", ctx, volumeRef, request)
+	if m.StartVolumeParityCheckFunc == nil {
+		var r0 string
+		return r0, notMocked("StartVolumeParityCheck")
+	}
+	return m.StartVolumeParityCheckFunc(ctx, volumeRef, request)
+}
+
+// StopVolumeCopyJob calls StopVolumeCopyJobFunc.
+func (m *Client) StopVolumeCopyJob(ctx context.Context, jobRef string) error {
+	m.record("StopVolumeCopyJob", ctx, jobRef)
+	if m.StopVolumeCopyJobFunc == nil {
+		return notMocked("StopVolumeCopyJob")
+	}
+	return m.StopVolumeCopyJobFunc(ctx, jobRef)
+}
+
+// SuspendAsyncMirrorGroup calls SuspendAsyncMirrorGroupFunc.
+func (m *Client) SuspendAsyncMirrorGroup(ctx context.Context, groupRef string) error {
+	m.record("SuspendAsyncMirrorGroup", ctx, groupRef)
+	if m.SuspendAsyncMirrorGroupFunc == nil {
+		return notMocked("SuspendAsyncMirrorGroup")
+	}
+	return m.SuspendAsyncMirrorGroupFunc(ctx, groupRef)
+}
+
+// SuspendRemoteMirrorPair calls SuspendRemoteMirrorPairFunc.
+func (m *Client) SuspendRemoteMirrorPair(ctx context.Context, pairID string) (*santricity.RemoteMirrorPair, error) {
+	m.record("SuspendRemoteMirrorPair", ctx, pairID)
+	if m.SuspendRemoteMirrorPairFunc == nil {
+		var r0 *santricity.RemoteMirrorPair
+		return r0, notMocked("SuspendRemoteMirrorPair")
+	}
+	return m.SuspendRemoteMirrorPairFunc(ctx, pairID)
+}
+
+// SyncAsyncMirrorGroup calls SyncAsyncMirrorGroupFunc.
+func (m *Client) SyncAsyncMirrorGroup(ctx context.Context, groupRef string, deleteRecoveryPointIfNecessary bool) error {
+	m.record("SyncAsyncMirrorGroup", ctx, groupRef, deleteRecoveryPointIfNecessary)
+	if m.SyncAsyncMirrorGroupFunc == nil {
+		return notMocked("SyncAsyncMirrorGroup")
+	}
+	return m.SyncAsyncMirrorGroupFunc(ctx, groupRef, deleteRecoveryPointIfNecessary)
+}
+
+// SyncAsyncMirrorGroupAndWait calls SyncAsyncMirrorGroupAndWaitFunc.
+func (m *Client) SyncAsyncMirrorGroupAndWait(ctx context.Context, groupRef string, config santricity.WaitConfig) error {
+	m.record("SyncAsyncMirrorGroupAndWait", ctx, groupRef, config)
+	if m.SyncAsyncMirrorGroupAndWaitFunc == nil {
+		return notMocked("SyncAsyncMirrorGroupAndWait")
+	}
+	return m.SyncAsyncMirrorGroupAndWaitFunc(ctx, groupRef, config)
+}
+
+// TestAsyncMirrorGroup calls TestAsyncMirrorGroupFunc.
+func (m *Client) TestAsyncMirrorGroup(ctx context.Context, groupRef string, request v11.AsyncMirrorGroupConnectivityTestRequest) ([]v11.AsyncMirrorGroupCommunicationData, error) {
+	m.record("TestAsyncMirrorGroup", ctx, groupRef, request)
+	if m.TestAsyncMirrorGroupFunc == nil {
+		var r0 []v11.AsyncMirrorGroupCommunicationData
+		return r0, notMocked("TestAsyncMirrorGroup")
+	}
+	return m.TestAsyncMirrorGroupFunc(ctx, groupRef, request)
+}
+
+// TestRemoteMirrorCommunication calls TestRemoteMirrorCommunicationFunc.
+func (m *Client) TestRemoteMirrorCommunication(ctx context.Context, pairID string) (*v11.RemoteCommunicationData, error) {
+	m.record("TestRemoteMirrorCommunication", ctx, pairID)
+	if m.TestRemoteMirrorCommunicationFunc == nil {
+		var r0 *v11.RemoteCommunicationData
+		return r0, notMocked("TestRemoteMirrorCommunication")
+	}
+	return m.TestRemoteMirrorCommunicationFunc(ctx, pairID)
+}
+
+// UnmapVolume calls UnmapVolumeFunc.
+func (m *Client) UnmapVolume(ctx context.Context, volume santricity.VolumeEx) error {
+	m.record("UnmapVolume", ctx, volume)
+	if m.UnmapVolumeFunc == nil {
+		return notMocked("UnmapVolume")
+	}
+	return m.UnmapVolumeFunc(ctx, volume)
+}
+
+// UnregisterStorageSystem calls UnregisterStorageSystemFunc.
+func (m *Client) UnregisterStorageSystem(ctx context.Context, id string) error {
+	m.record("UnregisterStorageSystem", ctx, id)
+	if m.UnregisterStorageSystemFunc == nil {
+		return notMocked("UnregisterStorageSystem")
+	}
+	return m.UnregisterStorageSystemFunc(ctx, id)
+}
+
+// UpdateAsyncMirrorGroup calls UpdateAsyncMirrorGroupFunc.
+func (m *Client) UpdateAsyncMirrorGroup(ctx context.Context, groupRef string, request v11.AsyncMirrorGroupUpdateRequest) (*santricity.AsyncMirrorGroup, error) {
+	m.record("UpdateAsyncMirrorGroup", ctx, groupRef, request)
+	if m.UpdateAsyncMirrorGroupFunc == nil {
+		var r0 *santricity.AsyncMirrorGroup
+		return r0, notMocked("UpdateAsyncMirrorGroup")
+	}
+	return m.UpdateAsyncMirrorGroupFunc(ctx, groupRef, request)
+}
+
+// UpdateConsistencyGroup calls UpdateConsistencyGroupFunc.
+func (m *Client) UpdateConsistencyGroup(ctx context.Context, id string, request v11.ConsistencyGroupUpdateRequest) (*santricity.ConsistencyGroup, error) {
+	m.record("UpdateConsistencyGroup", ctx, id, request)
+	if m.UpdateConsistencyGroupFunc == nil {
+		var r0 *santricity.ConsistencyGroup
+		return r0, notMocked("UpdateConsistencyGroup")
+	}
+	return m.UpdateConsistencyGroupFunc(ctx, id, request)
+}
+
+// UpdateHost calls UpdateHostFunc.
+func (m *Client) UpdateHost(ctx context.Context, hostRef string, request santricity.HostUpdateRequest) (santricity.HostEx, error) {
+	m.record("UpdateHost", ctx, hostRef, request)
+	if m.UpdateHostFunc == nil {
+		var r0 santricity.HostEx
+		return r0, notMocked("UpdateHost")
+	}
+	return m.UpdateHostFunc(ctx, hostRef, request)
+}
+
+// UpdateRemoteMirrorPair calls UpdateRemoteMirrorPairFunc.
+func (m *Client) UpdateRemoteMirrorPair(ctx context.Context, pairID string, request v11.RemoteVolumeMirrorUpdateRequest) (*santricity.RemoteMirrorPair, error) {
+	m.record("UpdateRemoteMirrorPair", ctx, pairID, request)
+	if m.UpdateRemoteMirrorPairFunc == nil {
+		var r0 *santricity.RemoteMirrorPair
+		return r0, notMocked("UpdateRemoteMirrorPair")
+	}
+	return m.UpdateRemoteMirrorPairFunc(ctx, pairID, request)
+}
+
+// UpdateSnapshotGroup calls UpdateSnapshotGroupFunc.
+func (m *Client) UpdateSnapshotGroup(ctx context.Context, id string, request v11.SnapshotGroupUpdateRequest) (*santricity.SnapshotGroup, error) {
+	m.record("UpdateSnapshotGroup", ctx, id, request)
+	if m.UpdateSnapshotGroupFunc == nil {
+		var r0 *santricity.SnapshotGroup
+		return r0, notMocked("UpdateSnapshotGroup")
+	}
+	return m.UpdateSnapshotGroupFunc(ctx, id, request)
+}
+
+// UpdateSnapshotSchedule calls UpdateSnapshotScheduleFunc.
+func (m *Client) UpdateSnapshotSchedule(ctx context.Context, id string, config santricity.SnapshotScheduleConfig) (*santricity.SnapshotSchedule, error) {
+	m.record("UpdateSnapshotSchedule", ctx, id, config)
+	if m.UpdateSnapshotScheduleFunc == nil {
+		var r0 *santricity.SnapshotSchedule
+		return r0, notMocked("UpdateSnapshotSchedule")
+	}
+	return m.UpdateSnapshotScheduleFunc(ctx, id, config)
+}
+
+// UpdateThinVolume calls UpdateThinVolumeFunc.
+func (m *Client) UpdateThinVolume(ctx context.Context, volumeRef string, request santricity.ThinVolumeUpdateRequest) (santricity.ThinVolume, error) {
+	m.record("UpdateThinVolume", ctx, volumeRef, request)
+	if m.UpdateThinVolumeFunc == nil {
+		var r0 santricity.ThinVolume
+		return r0, notMocked("UpdateThinVolume")
+	}
+	return m.UpdateThinVolumeFunc(ctx, volumeRef, request)
+}
+
+// UpdateVolume calls UpdateVolumeFunc.
+func (m *Client) UpdateVolume(ctx context.Context, volumeRef string, request santricity.VolumeUpdateRequest) (santricity.VolumeEx, error) {
+	m.record("UpdateVolume", ctx, volumeRef, request)
+	if m.UpdateVolumeFunc == nil {
+		var r0 santricity.VolumeEx
+		return r0, notMocked("UpdateVolume")
+	}
+	return m.UpdateVolumeFunc(ctx, volumeRef, request)
+}
+
+// UpdateVolumeCopyJob calls UpdateVolumeCopyJobFunc.
+func (m *Client) UpdateVolumeCopyJob(ctx context.Context, jobRef string, request v11.VolumeCopyUpdateRequest) (*santricity.VolumeCopyJob, error) {
+	m.record("UpdateVolumeCopyJob", ctx, jobRef, request)
+	if m.UpdateVolumeCopyJobFunc == nil {
+		var r0 *santricity.VolumeCopyJob
+		return r0, notMocked("UpdateVolumeCopyJob")
+	}
+	return m.UpdateVolumeCopyJobFunc(ctx, jobRef, request)
+}
+
+// UpdateVolumeTags calls UpdateVolumeTagsFunc.
+func (m *Client) UpdateVolumeTags(ctx context.Context, volumeRef string, tags []santricity.VolumeTag) (santricity.VolumeEx, error) {
+	m.record("UpdateVolumeTags", ctx, volumeRef, tags)
+	if m.UpdateVolumeTagsFunc == nil {
+		var r0 santricity.VolumeEx
+		return r0, notMocked("UpdateVolumeTags")
+	}
+	return m.UpdateVolumeTagsFunc(ctx, volumeRef, tags)
+}
+
+// VolumeAction calls VolumeActionFunc.
+func (m *Client) VolumeAction(volumeRef string) santricity.Operation {
+	m.record("VolumeAction", volumeRef)
+	if m.VolumeActionFunc == nil {
+		var r0 santricity.Operation
+		return r0
+	}
+	return m.VolumeActionFunc(volumeRef)
+}
+
+// VolumeCopy calls VolumeCopyFunc.
+func (m *Client) VolumeCopy(jobRef string) santricity.Operation {
+	m.record("VolumeCopy", jobRef)
+	if m.VolumeCopyFunc == nil {
+		var r0 santricity.Operation
+		return r0
+	}
+	return m.VolumeCopyFunc(jobRef)
+}
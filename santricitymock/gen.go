@@ -0,0 +1,200 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+//go:build ignore
+
+// gen.go generates client_gen.go, the methods of the mock Client, from the santricity.API interface.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	santricity "github.com/scaleoutsean/santricity-go"
+)
+
+const (
+	outputFile     = "client_gen.go"
+	interfacesFile = "../interfaces.go"
+	santricityPath = "github.com/scaleoutsean/santricity-go"
+)
+
+func main() {
+
+	api := reflect.TypeOf((*santricity.API)(nil)).Elem()
+	imports := make(map[string]bool)
+	names := parameterNames()
+
+	var fields, methods bytes.Buffer
+	for i := 0; i < api.NumMethod(); i++ {
+		method := api.Method(i)
+		signature := method.Type
+
+		var params, args, results []string
+		for j := 0; j < signature.NumIn(); j++ {
+			name := fmt.Sprintf("a%d", j)
+			if len(names[method.Name]) == signature.NumIn() {
+				name = names[method.Name][j]
+			}
+			typeName := typeString(signature.In(j), imports)
+			if signature.IsVariadic() && j == signature.NumIn()-1 {
+				typeName = "..." + typeString(signature.In(j).Elem(), imports)
+				args = append(args, name+"...")
+			} else {
+				args = append(args, name)
+			}
+			params = append(params, name+" "+typeName)
+		}
+		for j := 0; j < signature.NumOut(); j++ {
+			results = append(results, typeString(signature.Out(j), imports))
+		}
+
+		resultList := strings.Join(results, ", ")
+		if len(results) > 1 {
+			resultList = "(" + resultList + ")"
+		}
+		funcType := fmt.Sprintf("func(%s) %s", strings.Join(params, ", "), resultList)
+		fmt.Fprintf(&fields, "\t%sFunc %s\n", method.Name, funcType)
+
+		fmt.Fprintf(&methods, "\n// %s calls %sFunc.\n", method.Name, method.Name)
+		fmt.Fprintf(&methods, "func (m *Client) %s(%s) %s {\n", method.Name, strings.Join(params, ", "), resultList)
+		recordArgs := append([]string{fmt.Sprintf("%q", method.Name)}, trimVariadic(args)...)
+		fmt.Fprintf(&methods, "\tm.record(%s)\n", strings.Join(recordArgs, ", "))
+		fmt.Fprintf(&methods, "\tif m.%sFunc == nil {\n", method.Name)
+		var zeros []string
+		for j := 0; j < signature.NumOut(); j++ {
+			zero := fmt.Sprintf("r%d", j)
+			if isError(signature.Out(j)) {
+				zero = fmt.Sprintf("notMocked(%q)", method.Name)
+			} else {
+				fmt.Fprintf(&methods, "\t\tvar %s %s\n", zero, results[j])
+			}
+			zeros = append(zeros, zero)
+		}
+		if len(zeros) > 0 {
+			fmt.Fprintf(&methods, "\t\treturn %s\n", strings.Join(zeros, ", "))
+		} else {
+			fmt.Fprintf(&methods, "\t\treturn\n")
+		}
+		fmt.Fprintf(&methods, "\t}\n")
+		call := fmt.Sprintf("m.%sFunc(%s)", method.Name, strings.Join(args, ", "))
+		if len(results) > 0 {
+			fmt.Fprintf(&methods, "\treturn %s\n", call)
+		} else {
+			fmt.Fprintf(&methods, "\t%s\n", call)
+		}
+		fmt.Fprintf(&methods, "}\n")
+	}
+
+	paths := make([]string, 0, len(imports))
+	for path := range imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by gen.go from santricity.API; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package santricitymock\n\nimport (\n")
+	for _, path := range paths {
+		if path != santricityPath {
+			fmt.Fprintf(&out, "\t%q\n", path)
+		}
+	}
+	fmt.Fprintf(&out, "\n\tsantricity %q\n)\n\n", santricityPath)
+	fmt.Fprintf(&out, "// Client is a mock implementation of santricity.API.\n")
+	fmt.Fprintf(&out, "type Client struct {\n\tcallLog\n\n%s}\n\n", fields.String())
+	fmt.Fprintf(&out, "var _ santricity.API = (*Client)(nil)\n")
+	out.Write(methods.Bytes())
+
+	source, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatalf("could not format generated code: %v\n%s", err, out.String())
+	}
+	if err := os.WriteFile(outputFile, source, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// typeString returns the Go syntax for a type, qualified by package name, and records the packages it uses.
+func typeString(t reflect.Type, imports map[string]bool) string {
+
+	if t.Name() != "" {
+		if t.PkgPath() == "" {
+			if t.Kind() == reflect.Uint8 {
+				return "byte"
+			}
+			return t.Name() // Predeclared type
+		}
+		imports[t.PkgPath()] = true
+		return t.String()
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + typeString(t.Elem(), imports)
+	case reflect.Slice:
+		return "[]" + typeString(t.Elem(), imports)
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), typeString(t.Elem(), imports))
+	case reflect.Map:
+		return fmt.Sprintf("map[%s]%s", typeString(t.Key(), imports), typeString(t.Elem(), imports))
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return "interface{}"
+		}
+	}
+	log.Fatalf("unsupported type %s", t)
+	return ""
+}
+
+// parameterNames reads the parameter names of the interface methods from their declarations, as reflection only
+// provides the types.
+func parameterNames() map[string][]string {
+
+	file, err := parser.ParseFile(token.NewFileSet(), interfacesFile, nil, 0)
+	if err != nil {
+		log.Fatalf("could not parse %s: %v", interfacesFile, err)
+	}
+
+	names := make(map[string][]string)
+	ast.Inspect(file, func(node ast.Node) bool {
+		field, ok := node.(*ast.Field)
+		if !ok || len(field.Names) != 1 {
+			return true
+		}
+		funcType, ok := field.Type.(*ast.FuncType)
+		if !ok {
+			return true
+		}
+		var params []string
+		for _, param := range funcType.Params.List {
+			for _, name := range param.Names {
+				params = append(params, name.Name)
+			}
+		}
+		names[field.Names[0].Name] = params
+		return false
+	})
+	return names
+}
+
+func isError(t reflect.Type) bool {
+	return t == reflect.TypeOf((*error)(nil)).Elem()
+}
+
+// trimVariadic strips the spread operator from arguments, for recording.
+func trimVariadic(args []string) []string {
+	trimmed := make([]string, len(args))
+	for i, arg := range args {
+		trimmed[i] = strings.TrimSuffix(arg, "...")
+	}
+	return trimmed
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+// Package santricitymock provides a mock implementation of the santricity.API interface (and so of each of the
+// narrower interfaces it embeds) for unit tests of code built on the santricity client.
+//
+// Each method of Client calls the function in the field of the same name with a "Func" suffix. Methods whose
+// function is not set return zero values and an error matching ErrNotMocked. All calls are recorded.
+//
+//	mock := &santricitymock.Client{
+//		GetVolumeByRefFunc: func(ctx context.Context, ref string) (santricity.VolumeEx, error) {
+//			return santricity.VolumeEx{}, santricity.ErrNotFound
+//		},
+//	}
+//	controller := NewController(mock) // accepts santricity.VolumeAPI
+//
+// The methods are generated from santricity.API; run "go generate" in this directory after changing it.
+package santricitymock
+
+//go:generate go run gen.go
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotMocked is returned by methods whose function field is not set.
+var ErrNotMocked = errors.New("method not mocked")
+
+// Call is a recorded method call.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// callLog records the calls made to a mock. Its zero value is ready to use.
+type callLog struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (l *callLog) record(method string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.calls = append(l.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the calls made so far, in order.
+func (l *callLog) Calls() []Call {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]Call(nil), l.calls...)
+}
+
+// CallCount returns how many times a method was called.
+func (l *callLog) CallCount(method string) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	count := 0
+	for _, call := range l.calls {
+		if call.Method == method {
+			count++
+		}
+	}
+	return count
+}
+
+// ResetCalls clears the recorded calls.
+func (l *callLog) ResetCalls() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.calls = nil
+}
+
+func notMocked(method string) error {
+	return fmt.Errorf("santricitymock: %s: %w", method, ErrNotMocked)
+}
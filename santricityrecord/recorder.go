@@ -0,0 +1,118 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricityrecord
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// aboutPath is the web services endpoint that reports the version recorded in cassettes.
+const aboutPath = "/devmgr/utils/about"
+
+// Recorder records the exchanges of a client. Its Wrap method is meant for santricity.ClientConfig.WrapTransport.
+type Recorder struct {
+	// Optional extra sanitization, applied to each interaction after the standard one and before it is stored
+	Sanitize func(*Interaction)
+
+	m        sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder returns a recorder with an empty cassette.
+func NewRecorder() *Recorder {
+	return &Recorder{cassette: Cassette{Recorded: time.Now().UTC()}}
+}
+
+// Wrap returns a transport that sends requests with next and records them.
+func (r *Recorder) Wrap(next http.RoundTripper) http.RoundTripper {
+	return &recordingTransport{recorder: r, next: next}
+}
+
+// Cassette returns a copy of the exchanges recorded so far.
+func (r *Recorder) Cassette() *Cassette {
+	r.m.Lock()
+	defer r.m.Unlock()
+	cassette := r.cassette
+	cassette.Interactions = append([]Interaction(nil), r.cassette.Interactions...)
+	return &cassette
+}
+
+// Save writes the exchanges recorded so far to a cassette file.
+func (r *Recorder) Save(path string) error {
+	return r.Cassette().Save(path)
+}
+
+// record stores an interaction.
+func (r *Recorder) record(interaction Interaction) {
+	if r.Sanitize != nil {
+		r.Sanitize(&interaction)
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.cassette.Version == "" && interaction.Request.URI == aboutPath && interaction.Response.StatusCode == http.StatusOK {
+		var about struct {
+			Version string `json:"version"`
+		}
+		if json.Unmarshal(interaction.Response.Body, &about) == nil {
+			r.cassette.Version = about.Version
+		}
+	}
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+}
+
+// recordingTransport sends requests and records them with a Recorder.
+type recordingTransport struct {
+	recorder *Recorder
+	next     http.RoundTripper
+}
+
+func (t *recordingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+
+	var requestBody []byte
+	if request.Body != nil && request.Body != http.NoBody {
+		var err error
+		if requestBody, err = io.ReadAll(request.Body); err != nil {
+			return nil, err
+		}
+		request.Body.Close()
+		request = request.Clone(request.Context())
+		request.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+
+	interaction := Interaction{
+		Request: Request{
+			Method: request.Method,
+			URI:    request.URL.RequestURI(),
+			Header: sanitizeHeader(request.Header),
+		},
+	}
+	interaction.Request.Body, interaction.Request.Text = sanitizeBody(requestBody)
+
+	response, err := t.next.RoundTrip(request)
+	if err != nil {
+		interaction.Response.Error = err.Error()
+		t.recorder.record(interaction)
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(responseBody))
+	if err != nil {
+		interaction.Response.Error = err.Error()
+		t.recorder.record(interaction)
+		return nil, err
+	}
+
+	interaction.Response.StatusCode = response.StatusCode
+	interaction.Response.Header = sanitizeHeader(response.Header)
+	interaction.Response.Body, interaction.Response.Text = sanitizeBody(responseBody)
+	t.recorder.record(interaction)
+	return response, nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricityrecord
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	santricity "github.com/scaleoutsean/santricity-go"
+)
+
+// ErrNoInteraction is returned for requests that the cassette has no answer for.
+var ErrNoInteraction = errors.New("no recorded interaction matches the request")
+
+// storageSystemsPrefix starts the paths of the storage system resources.
+const storageSystemsPrefix = "/devmgr/v2/storage-systems/"
+
+// Replayer answers requests from a cassette instead of sending them. A request gets the first unused interaction
+// with the same method and URI (path and query), so repeated calls replay the recorded responses in order. Once
+// they are used up, a GET is answered with the last of them again, so that polling ends as it did when recording;
+// other requests fail with ErrNoInteraction. Bodies and headers of requests are not compared.
+type Replayer struct {
+	m         sync.Mutex
+	cassette  *Cassette
+	used      []bool
+	unmatched []string
+}
+
+// NewReplayer returns a replayer for a cassette.
+func NewReplayer(cassette *Cassette) *Replayer {
+	return &Replayer{cassette: cassette, used: make([]bool, len(cassette.Interactions))}
+}
+
+// Wrap returns the replayer itself, for santricity.ClientConfig.WrapTransport. Requests are never sent with next.
+func (p *Replayer) Wrap(next http.RoundTripper) http.RoundTripper {
+	return p
+}
+
+// ClientConfig returns a client configuration that replays the cassette. The controller addresses are
+// placeholders that are never contacted; the array ID is the one used in the recorded paths.
+func (p *Replayer) ClientConfig() santricity.ClientConfig {
+	config := santricity.ClientConfig{
+		ApiControllers: []string{"controller-a.invalid", "controller-b.invalid"},
+		ApiPort:        8443,
+		Username:       "admin",
+		Password:       santricity.Redacted,
+		WrapTransport:  p.Wrap,
+	}
+	for _, interaction := range p.cassette.Interactions {
+		if rest, ok := strings.CutPrefix(interaction.Request.URI, storageSystemsPrefix); ok {
+			config.ArrayID, _, _ = strings.Cut(rest, "/")
+			config.ArrayID, _, _ = strings.Cut(config.ArrayID, "?")
+			break
+		}
+	}
+	return config
+}
+
+// RoundTrip answers a request with the next matching interaction of the cassette.
+func (p *Replayer) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Body != nil {
+		_, _ = io.Copy(io.Discard, request.Body)
+		request.Body.Close()
+	}
+
+	interaction, ok := p.match(request.Method, request.URL.RequestURI())
+	if !ok {
+		return nil, fmt.Errorf("%s %s: %w", request.Method, request.URL.RequestURI(), ErrNoInteraction)
+	}
+	if interaction.Response.Error != "" {
+		return nil, errors.New(interaction.Response.Error)
+	}
+
+	header := interaction.Response.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Del("Content-Length") // Bodies are re-encoded when recorded
+	responseBody := body(interaction.Response.Body, interaction.Response.Text)
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(responseBody)),
+		ContentLength: int64(len(responseBody)),
+		Request:       request,
+	}, nil
+}
+
+// match returns the interaction that answers a request.
+func (p *Replayer) match(method, uri string) (Interaction, bool) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	last := -1
+	for i, interaction := range p.cassette.Interactions {
+		if interaction.Request.Method != method || interaction.Request.URI != uri {
+			continue
+		}
+		if !p.used[i] {
+			p.used[i] = true
+			return interaction, true
+		}
+		last = i
+	}
+	if last >= 0 && method == http.MethodGet {
+		return p.cassette.Interactions[last], true
+	}
+	p.unmatched = append(p.unmatched, method+" "+uri)
+	return Interaction{}, false
+}
+
+// Unmatched returns the requests that the cassette had no answer for, as "METHOD URI".
+func (p *Replayer) Unmatched() []string {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return append([]string(nil), p.unmatched...)
+}
+
+// Unused returns the recorded interactions that no request has used, in the order they were recorded.
+func (p *Replayer) Unused() []Interaction {
+	p.m.Lock()
+	defer p.m.Unlock()
+	var unused []Interaction
+	for i, interaction := range p.cassette.Interactions {
+		if !p.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+	return unused
+}
@@ -0,0 +1,954 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricitytest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	santricity "github.com/scaleoutsean/santricity-go"
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// Defaults and limits the array applies to async mirror groups
+const (
+	defaultSyncIntervalMinutes     = 10
+	minSyncIntervalMinutes         = 10
+	defaultRecoveryWarnMinutes     = 20
+	defaultSyncWarnMinutes         = 10
+	defaultRepositoryWarnThreshold = 75
+	defaultMirrorRepositoryPercent = 20
+)
+
+// mirrorGroup is an async mirror group between two storage systems of the server. It is kept by the server rather
+// than by the state of a system, as both systems see it. A synchronization completes after
+// ServerConfig.OperationDuration and leaves a recovery point; the fake does not synchronize at intervals.
+type mirrorGroup struct {
+	wwn            string
+	label          string
+	sides          [2]*mirrorSide // The system the group was created on first
+	members        []*mirrorMember
+	syncInterval   int // Minutes; 0 for manual synchronization
+	recoveryWarn   int
+	syncWarn       int
+	repositoryWarn int
+	suspended      bool
+	syncStarted    time.Time
+	syncUntil      time.Time // End of the running synchronization; zero if none is running
+	recoveryPoint  time.Time // Zero if there is none
+}
+
+// mirrorSide is an async mirror group as seen by one of its storage systems.
+type mirrorSide struct {
+	sys  *system
+	ref  string
+	role string
+}
+
+// mirrorMember is a pair of an async mirror group. Its fields are indexed like the sides of the group.
+type mirrorMember struct {
+	refs         [2]string
+	volumes      [2]string // The secondary volume is empty while the pair is incomplete
+	repositories [2]string
+	capacity     string
+	completed    time.Time // When the pair became complete; it has a recovery point from the next one on
+}
+
+// SetMirrorLinkDown simulates a loss of the connection between the storage systems of the server's async mirror
+// groups and remote mirror pairs: synchronization, changes to the groups and orderly role changes fail, and a
+// secondary can only be made primary with force. Remote mirror pairs become unsynchronized until the link is up.
+func (s *Server) SetMirrorLinkDown(down bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mirrorLinkDown = down
+	for _, mirror := range s.remoteMirrors {
+		mirror.settle()
+		mirror.setLinkDown(down, s.config.OperationDuration)
+	}
+}
+
+// AsyncMirrorGroups returns the async mirror groups of the first storage system.
+func (s *Server) AsyncMirrorGroups() []santricity.AsyncMirrorGroup {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	groups := make([]santricity.AsyncMirrorGroup, 0)
+	for _, group := range s.mirrorGroups {
+		if i := group.side(s.systems[0]); i >= 0 {
+			groups = append(groups, s.mirrorGroupView(group, i))
+		}
+	}
+	return groups
+}
+
+// side returns the index of a storage system among the sides of a group, or -1.
+func (g *mirrorGroup) side(sys *system) int {
+	for i, side := range g.sides {
+		if side.sys == sys {
+			return i
+		}
+	}
+	return -1
+}
+
+// settle records the recovery point of a synchronization that has completed.
+func (g *mirrorGroup) settle() {
+	if !g.syncUntil.IsZero() && !time.Now().Before(g.syncUntil) {
+		g.recoveryPoint = g.syncUntil
+		g.syncUntil = time.Time{}
+	}
+}
+
+func (g *mirrorGroup) startSync(duration time.Duration) {
+	g.syncStarted = time.Now()
+	g.syncUntil = g.syncStarted.Add(duration)
+}
+
+// conflict reports whether both systems are primary, as after a forced role change.
+func (g *mirrorGroup) conflict() bool {
+	return g.sides[0].role == "primary" && g.sides[1].role == "primary"
+}
+
+func (g *mirrorGroup) percentComplete() int {
+	if g.syncUntil.IsZero() || !g.syncUntil.After(g.syncStarted) {
+		return 100
+	}
+	return int(100 * time.Since(g.syncStarted) / g.syncUntil.Sub(g.syncStarted))
+}
+
+// memberState returns the state of a pair, which is the same on both systems.
+func (g *mirrorGroup) memberState(member *mirrorMember) string {
+	switch {
+	case member.volumes[0] == "" || member.volumes[1] == "":
+		return "incomplete"
+	case g.recoveryPoint.Before(member.completed):
+		return "initialSync"
+	}
+	return "optimal"
+}
+
+// mirrorGroupView returns a group as seen by one of its systems. Must be called with the lock held.
+func (s *Server) mirrorGroupView(group *mirrorGroup, i int) santricity.AsyncMirrorGroup {
+
+	local, remote := group.sides[i], group.sides[1-i]
+	view := santricity.AsyncMirrorGroup{
+		GroupRef:           local.ref,
+		WorldWideName:      group.wwn,
+		Label:              group.label,
+		GroupState:         "optimal",
+		LocalRole:          local.role,
+		RemoteRole:         remote.role,
+		RoleChangeProgress: "none",
+		SyncActivity:       "idle",
+		RemoteTargetID:     remote.sys.id,
+	}
+	for _, member := range group.members {
+		if group.memberState(member) == "initialSync" {
+			view.GroupState = "initialSync"
+		}
+	}
+	if s.mirrorLinkDown || group.conflict() {
+		view.GroupState = "degraded"
+	}
+	switch {
+	case group.suspended:
+		view.SyncActivity = "userSuspended"
+	case !group.syncUntil.IsZero():
+		view.SyncActivity = "active"
+	}
+	view.ID = local.ref
+	view.SyncIntervalMinutes = group.syncInterval
+	view.RecoveryPointAgeAlertThresholdMinutes = group.recoveryWarn
+	view.SyncCompletionTimeAlertThresholdMinutes = group.syncWarn
+	view.RepositoryUtilizationWarnThreshold = group.repositoryWarn
+	view.ConnectionType = "iscsi"
+	view.RemoteTargetWWN = remote.sys.wwn
+	view.RemoteTargetName = remote.sys.name
+	return view
+}
+
+// mirrorPairView returns a pair as seen by one of the systems of its group. Must be called with the lock held.
+func (s *Server) mirrorPairView(group *mirrorGroup, member *mirrorMember, i int) santricity.AsyncMirrorPair {
+
+	view := santricity.AsyncMirrorPair{
+		MemberRef:             member.refs[i],
+		MirrorGroup:           group.sides[i].ref,
+		LocalVolume:           member.volumes[i],
+		RemoteVolume:          member.volumes[1-i],
+		MemberState:           group.memberState(member),
+		LastRecoveryPointTime: "0",
+		RepositoryVolume:      member.repositories[i],
+	}
+	if view.MemberState == "optimal" {
+		view.LastRecoveryPointTime = strconv.FormatInt(group.recoveryPoint.Unix(), 10)
+	}
+	if volume := group.sides[i].sys.state.findVolume(member.volumes[i]); volume != nil {
+		view.LocalVolumeName = volume.Label
+		view.WorldWideName = volume.WorldWideName
+	}
+	if volume := group.sides[1-i].sys.state.findVolume(member.volumes[1-i]); volume != nil {
+		view.RemoteVolumeName = volume.Label
+	}
+	view.ID = member.refs[i]
+	view.TotalSizeInBytes = member.capacity
+	view.MirroredLocalCapacity = member.capacity
+	view.RemoteTargetWWN = group.sides[1-i].sys.wwn
+	view.RemoteTargetName = group.sides[1-i].sys.name
+	view.RemoteTargetID = group.sides[1-i].sys.id
+	return view
+}
+
+// findMirrorGroup returns the group with a ref on a system and the index of that system.
+func (s *Server) findMirrorGroup(sys *system, ref string) (*mirrorGroup, int) {
+	for _, group := range s.mirrorGroups {
+		if i := group.side(sys); i >= 0 && group.sides[i].ref == ref {
+			return group, i
+		}
+	}
+	return nil, -1
+}
+
+// findMirrorMember returns the pair with a ref on a system, its group and the index of that system.
+func (s *Server) findMirrorMember(sys *system, ref string) (*mirrorGroup, *mirrorMember, int) {
+	for _, group := range s.mirrorGroups {
+		i := group.side(sys)
+		if i < 0 {
+			continue
+		}
+		for _, member := range group.members {
+			if member.refs[i] == ref {
+				return group, member, i
+			}
+		}
+	}
+	return nil, nil, -1
+}
+
+// inMirror writes a 422 response if a volume cannot be deleted because it is part of an async or remote mirror
+// pair.
+func (s *state) inMirror(w http.ResponseWriter, volume *santricity.VolumeEx) bool {
+	if volume.AsyncMirrorSource || volume.AsyncMirrorTarget {
+		writeError(w, http.StatusUnprocessableEntity, "volumeHasAsyncMirror", "the volume is part of an async mirror")
+		return true
+	}
+	if volume.RemoteMirrorSource || volume.RemoteMirrorTarget {
+		writeError(w, http.StatusUnprocessableEntity, "volumeHasMirrorRelationship",
+			"the volume is part of a remote mirror")
+		return true
+	}
+	return false
+}
+
+// linkDown writes a 424 response if the systems of the async mirror groups cannot reach each other.
+func (s *Server) linkDown(w http.ResponseWriter) bool {
+	if s.mirrorLinkDown {
+		writeError(w, http.StatusFailedDependency, "", "the remote storage system cannot be reached")
+	}
+	return s.mirrorLinkDown
+}
+
+// routeAsyncMirrors serves /async-mirrors. Must be called with the lock held.
+func (s *Server) routeAsyncMirrors(w http.ResponseWriter, r *http.Request, sys *system, parts []string, body []byte) {
+
+	for _, group := range s.mirrorGroups {
+		group.settle()
+	}
+
+	if len(parts) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			groups := make([]santricity.AsyncMirrorGroup, 0)
+			for _, group := range s.mirrorGroups {
+				if i := group.side(sys); i >= 0 {
+					groups = append(groups, s.mirrorGroupView(group, i))
+				}
+			}
+			writeJSON(w, http.StatusOK, groups)
+		case http.MethodPost:
+			s.createMirrorGroup(w, sys, body)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	switch parts[0] {
+	case "arvm-arrays":
+		if r.Method != http.MethodGet || len(parts) > 1 {
+			methodNotAllowed(w)
+			return
+		}
+		targets := make([]v11.RemoteCandidate, 0)
+		for _, other := range s.systems {
+			if other != sys {
+				targets = append(targets, v11.RemoteCandidate{WWN: other.wwn, ID: other.id, Name: other.name,
+					Type: "iscsi"})
+			}
+		}
+		writeJSON(w, http.StatusOK, targets)
+		return
+	case "pairs":
+		s.routeMirrorPairs(w, r.Method, sys, parts[1:])
+		return
+	case "incomplete-pairs":
+		s.routeIncompleteMirrorPairs(w, r.Method, sys, parts[1:], body)
+		return
+	case "progress", "connections":
+		if r.Method != http.MethodGet || len(parts) > 1 {
+			methodNotAllowed(w)
+			return
+		}
+		results := make([]interface{}, 0)
+		for _, group := range s.mirrorGroups {
+			if i := group.side(sys); i >= 0 {
+				if parts[0] == "progress" {
+					results = append(results, s.mirrorProgress(group, i))
+				} else {
+					results = append(results, s.mirrorConnections(group, i))
+				}
+			}
+		}
+		writeJSON(w, http.StatusOK, results)
+		return
+	}
+
+	group, i := s.findMirrorGroup(sys, parts[0])
+	if group == nil {
+		notFound(w, "arvmGroupDoesNotExist", "async mirror group", parts[0])
+		return
+	}
+	if len(parts) == 1 {
+		s.routeMirrorGroup(w, r.Method, group, i, body)
+		return
+	}
+
+	switch {
+	case parts[1] == "pairs":
+		s.routeGroupMirrorPairs(w, r.Method, group, i, parts[2:], body)
+	case len(parts) > 2:
+		notFound(w, "", "resource", strings.Join(parts, "/"))
+	case r.Method == http.MethodGet && parts[1] == "progress":
+		writeJSON(w, http.StatusOK, s.mirrorProgress(group, i))
+	case r.Method == http.MethodGet && parts[1] == "connections":
+		writeJSON(w, http.StatusOK, s.mirrorConnections(group, i))
+	case r.Method != http.MethodPost:
+		methodNotAllowed(w)
+	case parts[1] == "sync" || parts[1] == "suspend" || parts[1] == "resume":
+		s.controlMirrorGroup(w, group, i, parts[1])
+	case parts[1] == "role":
+		s.changeMirrorRole(w, group, i, body)
+	case parts[1] == "test":
+		var request v11.AsyncMirrorGroupConnectivityTestRequest
+		if !decode(w, body, &request) {
+			return
+		}
+		result := v11.AsyncMirrorGroupCommunicationData{
+			AmgRef:       group.sides[i].ref,
+			TestType:     request.RequestedTestType,
+			Successful:   !s.mirrorLinkDown,
+			ReturnStatus: "ok",
+		}
+		if result.TestType == "" {
+			result.TestType = "basicConnectivityTest"
+		}
+		if s.mirrorLinkDown {
+			result.ReturnStatus = "arvmConnectivityTestNetworkError"
+		}
+		writeJSON(w, http.StatusOK, []v11.AsyncMirrorGroupCommunicationData{result})
+	default:
+		notFound(w, "", "resource", strings.Join(parts, "/"))
+	}
+}
+
+func (s *Server) createMirrorGroup(w http.ResponseWriter, sys *system, body []byte) {
+
+	var request v11.AsyncMirrorGroupCreateRequest
+	if !decode(w, body, &request) {
+		return
+	}
+	if request.Name == "" {
+		writeError(w, http.StatusBadRequest, "invalidLabel", "a group name is required")
+		return
+	}
+	remote := s.findSystem(request.SecondaryArrayID)
+	if remote == nil || remote == sys {
+		writeError(w, http.StatusUnprocessableEntity, "remoteTargetNotFound", "the secondary array is not known")
+		return
+	}
+	if s.linkDown(w) {
+		return
+	}
+	for _, group := range s.mirrorGroups {
+		if group.label != request.Name {
+			continue
+		}
+		if group.side(sys) >= 0 {
+			writeError(w, http.StatusUnprocessableEntity, "arvmGroupUserLabelExists",
+				"an async mirror group with this name already exists")
+			return
+		}
+		if group.side(remote) >= 0 {
+			writeError(w, http.StatusUnprocessableEntity, "arvmRemoteGroupUserLabelExists",
+				"an async mirror group with this name already exists on the secondary array")
+			return
+		}
+	}
+
+	group := &mirrorGroup{
+		wwn:   sys.state.newWWN(),
+		label: request.Name,
+		sides: [2]*mirrorSide{
+			{sys: sys, ref: sys.state.newRef(), role: "primary"},
+			{sys: remote, ref: remote.state.newRef(), role: "secondary"},
+		},
+		syncInterval:   defaultSyncIntervalMinutes,
+		recoveryWarn:   defaultRecoveryWarnMinutes,
+		syncWarn:       defaultSyncWarnMinutes,
+		repositoryWarn: defaultRepositoryWarnThreshold,
+	}
+	if !s.updateMirrorGroup(w, group, request.SyncIntervalMinutes, request.RecoveryWarnThresholdMinutes,
+		request.SyncWarnThresholdMinutes, request.RepoUtilizationWarnThreshold) {
+		return
+	}
+	if request.ManualSync != nil && *request.ManualSync {
+		group.syncInterval = 0
+	}
+	s.mirrorGroups = append(s.mirrorGroups, group)
+
+	writeJSON(w, http.StatusOK, s.mirrorGroupView(group, 0))
+}
+
+// updateMirrorGroup validates and applies the settings of a group that are set.
+func (s *Server) updateMirrorGroup(w http.ResponseWriter, group *mirrorGroup, interval, recoveryWarn, syncWarn,
+	repositoryWarn *int) bool {
+
+	if interval != nil && *interval < minSyncIntervalMinutes {
+		writeError(w, http.StatusUnprocessableEntity, "arvmInvalidSyncInterval", "invalid synchronization interval")
+		return false
+	}
+	if repositoryWarn != nil && (*repositoryWarn < 1 || *repositoryWarn > 100) {
+		writeError(w, http.StatusUnprocessableEntity, "invalidWarnThreshold", "invalid warning threshold")
+		return false
+	}
+	for _, setting := range []struct {
+		value  *int
+		target *int
+	}{
+		{interval, &group.syncInterval},
+		{recoveryWarn, &group.recoveryWarn},
+		{syncWarn, &group.syncWarn},
+		{repositoryWarn, &group.repositoryWarn},
+	} {
+		if setting.value != nil {
+			*setting.target = *setting.value
+		}
+	}
+	return true
+}
+
+func (s *Server) routeMirrorGroup(w http.ResponseWriter, method string, group *mirrorGroup, i int, body []byte) {
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.mirrorGroupView(group, i))
+
+	case http.MethodPost:
+		var request v11.AsyncMirrorGroupUpdateRequest
+		if !decode(w, body, &request) || s.linkDown(w) {
+			return
+		}
+		if request.Name != "" && request.Name != group.label {
+			for _, other := range s.mirrorGroups {
+				if other.label == request.Name && (other.side(group.sides[0].sys) >= 0 ||
+					other.side(group.sides[1].sys) >= 0) {
+					writeError(w, http.StatusUnprocessableEntity, "arvmGroupUserLabelExists",
+						"an async mirror group with this name already exists")
+					return
+				}
+			}
+		}
+		if !s.updateMirrorGroup(w, group, request.SyncIntervalMinutes, request.RecoveryWarnThresholdMinutes,
+			request.SyncWarnThresholdMinutes, request.RepoUtilizationWarnThreshold) {
+			return
+		}
+		if request.Name != "" {
+			group.label = request.Name
+		}
+		writeJSON(w, http.StatusOK, s.mirrorGroupView(group, i))
+
+	case http.MethodDelete:
+		if len(group.members) > 0 {
+			writeError(w, http.StatusUnprocessableEntity, "arvmGroupNotEmpty", "the async mirror group has pairs")
+			return
+		}
+		if s.linkDown(w) {
+			return
+		}
+		s.mirrorGroups = removeWhere(s.mirrorGroups, func(g *mirrorGroup) bool { return g == group })
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// controlMirrorGroup starts a synchronization of a group, or suspends or resumes it.
+func (s *Server) controlMirrorGroup(w http.ResponseWriter, group *mirrorGroup, i int, action string) {
+
+	if group.sides[i].role != "primary" {
+		writeError(w, http.StatusUnprocessableEntity, "arvmGroupNotPrimary", "the async mirror group is not primary")
+		return
+	}
+
+	switch action {
+	case "sync":
+		if group.suspended {
+			writeError(w, http.StatusUnprocessableEntity, "arvmInvalidAmgRequestWhileSuspended",
+				"the async mirror group is suspended")
+			return
+		}
+		if !group.syncUntil.IsZero() {
+			writeError(w, http.StatusUnprocessableEntity, "arvmManualSyncAlreadyInProgress",
+				"a synchronization is in progress")
+			return
+		}
+		if s.linkDown(w) {
+			return
+		}
+		group.startSync(s.config.OperationDuration)
+	case "suspend":
+		group.suspended = true
+		group.syncUntil = time.Time{}
+	case "resume":
+		if !group.suspended {
+			writeError(w, http.StatusUnprocessableEntity, "arvmGroupNotSuspended",
+				"the async mirror group is not suspended")
+			return
+		}
+		if s.linkDown(w) {
+			return
+		}
+		group.suspended = false
+		group.startSync(s.config.OperationDuration)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// changeMirrorRole makes a system primary or secondary for a group. A secondary whose primary cannot be reached
+// can only be made primary with force; both systems are primary then.
+func (s *Server) changeMirrorRole(w http.ResponseWriter, group *mirrorGroup, i int, body []byte) {
+
+	var request v11.AsyncMirrorGroupRoleUpdateRequest
+	if !decode(w, body, &request) {
+		return
+	}
+	if request.Role != "primary" && request.Role != "secondary" {
+		writeError(w, http.StatusBadRequest, "illegalParam", "the role must be primary or secondary")
+		return
+	}
+	local, remote := group.sides[i], group.sides[1-i]
+	force := request.Force != nil && *request.Force
+
+	switch {
+	case local.role == request.Role:
+	case request.Role == "primary" && s.mirrorLinkDown && force:
+		local.role = "primary"
+		group.syncUntil = time.Time{}
+	case s.linkDown(w):
+		return
+	case group.conflict():
+		// The system made secondary discards its changes and is synchronized from the other one again
+		local.role = "secondary"
+		group.recoveryPoint = time.Time{}
+		for _, member := range group.members {
+			member.completed = time.Now()
+		}
+		group.startSync(s.config.OperationDuration)
+	default:
+		local.role, remote.role = remote.role, local.role
+	}
+
+	for _, member := range group.members {
+		for k, side := range group.sides {
+			if volume := side.sys.state.findVolume(member.volumes[k]); volume != nil {
+				volume.AsyncMirrorSource = side.role == "primary"
+				volume.AsyncMirrorTarget = side.role == "secondary"
+			}
+		}
+	}
+	writeJSON(w, http.StatusOK, s.mirrorGroupView(group, i))
+}
+
+func (s *Server) mirrorProgress(group *mirrorGroup, i int) v11.AsyncMirrorGroupSyncProgress {
+
+	progress := v11.AsyncMirrorGroupSyncProgress{
+		GroupRef:             group.sides[i].ref,
+		GroupPercentComplete: group.percentComplete(),
+		MemberProgress:       []v11.AsyncMirrorGroupMemberSyncProgress{},
+	}
+	if remaining := time.Until(group.syncUntil); !group.syncUntil.IsZero() && remaining > 0 {
+		progress.GroupTimeToCompletion = int((remaining + time.Minute - 1) / time.Minute)
+	}
+	for _, member := range group.members {
+		if group.memberState(member) == "incomplete" {
+			continue
+		}
+		progress.MemberProgress = append(progress.MemberProgress, v11.AsyncMirrorGroupMemberSyncProgress{
+			MirrorRef:        member.refs[i],
+			PercentComplete:  progress.GroupPercentComplete,
+			TimeToCompletion: progress.GroupTimeToCompletion,
+		})
+	}
+	return progress
+}
+
+func (s *Server) mirrorConnections(group *mirrorGroup, i int) v11.AsyncMirrorGroupConnectionsResponse {
+	connections := v11.AsyncMirrorGroupConnectionsResponse{
+		AmgRef:      group.sides[i].ref,
+		Connections: []v11.AsyncMirrorGroupRemoteConnection{},
+	}
+	if !s.mirrorLinkDown {
+		connections.Connections = append(connections.Connections, v11.AsyncMirrorGroupRemoteConnection{
+			LocalPortName:   "iSCSI 0a",
+			RemotePortName:  "iSCSI 0a",
+			IoInterfaceType: "iscsi",
+		})
+	}
+	return connections
+}
+
+// routeMirrorPairs serves /async-mirrors/pairs, the pairs of all groups of a system.
+func (s *Server) routeMirrorPairs(w http.ResponseWriter, method string, sys *system, parts []string) {
+
+	if method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+
+	if len(parts) == 0 || parts[0] == "repository-utilization" {
+		pairs := make([]santricity.AsyncMirrorPair, 0)
+		utilization := make([]v11.AsyncMirrorRepositoryUtilization, 0)
+		for _, group := range s.mirrorGroups {
+			i := group.side(sys)
+			if i < 0 {
+				continue
+			}
+			for _, member := range group.members {
+				pairs = append(pairs, s.mirrorPairView(group, member, i))
+				if member.repositories[i] != "" {
+					utilization = append(utilization, s.repositoryUtilization(sys, member, i))
+				}
+			}
+		}
+		if len(parts) == 0 {
+			writeJSON(w, http.StatusOK, pairs)
+		} else {
+			writeJSON(w, http.StatusOK, utilization)
+		}
+		return
+	}
+
+	group, member, i := s.findMirrorMember(sys, parts[0])
+	if member == nil {
+		notFound(w, "arvmMirrorMemberDoesNotExist", "async mirror pair", parts[0])
+		return
+	}
+	switch {
+	case len(parts) == 1:
+		writeJSON(w, http.StatusOK, s.mirrorPairView(group, member, i))
+	case len(parts) == 2 && parts[1] == "repository-utilization" && member.repositories[i] != "":
+		writeJSON(w, http.StatusOK, s.repositoryUtilization(sys, member, i))
+	default:
+		notFound(w, "", "resource", strings.Join(parts, "/"))
+	}
+}
+
+// repositoryUtilization reports the repository of a pair as unused.
+func (s *Server) repositoryUtilization(sys *system, member *mirrorMember, i int) v11.AsyncMirrorRepositoryUtilization {
+	utilization := v11.AsyncMirrorRepositoryUtilization{
+		MirrorRef:      member.refs[i],
+		ID:             member.refs[i],
+		PitDataBytes:   "0",
+		DeltaLogBytes:  "0",
+		BytesAvailable: "0",
+	}
+	for _, repository := range sys.state.repositories {
+		if repository.ConcatVolRef == member.repositories[i] {
+			utilization.BytesAvailable = repository.AggregateCapacity
+		}
+	}
+	return utilization
+}
+
+// routeGroupMirrorPairs serves /async-mirrors/{id}/pairs.
+func (s *Server) routeGroupMirrorPairs(
+	w http.ResponseWriter, method string, group *mirrorGroup, i int, parts []string, body []byte,
+) {
+	if len(parts) == 0 {
+		switch method {
+		case http.MethodGet:
+			pairs := make([]santricity.AsyncMirrorPair, 0, len(group.members))
+			for _, member := range group.members {
+				pairs = append(pairs, s.mirrorPairView(group, member, i))
+			}
+			writeJSON(w, http.StatusOK, pairs)
+		case http.MethodPost:
+			s.addMirrorPair(w, group, i, body)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	var member *mirrorMember
+	for _, m := range group.members {
+		if m.refs[i] == parts[0] {
+			member = m
+		}
+	}
+	if member == nil || len(parts) != 1 {
+		notFound(w, "arvmMirrorMemberDoesNotExist", "async mirror pair", parts[0])
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.mirrorPairView(group, member, i))
+	case http.MethodDelete:
+		if s.linkDown(w) {
+			return
+		}
+		s.removeMirrorPair(group, member)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) addMirrorPair(w http.ResponseWriter, group *mirrorGroup, i int, body []byte) {
+
+	var request v11.AsyncMirrorGroupMemberCreateRequest
+	if !decode(w, body, &request) {
+		return
+	}
+	if group.sides[i].role != "primary" {
+		writeError(w, http.StatusUnprocessableEntity, "arvmGroupNotPrimary", "the async mirror group is not primary")
+		return
+	}
+	if s.linkDown(w) {
+		return
+	}
+
+	local := group.sides[i].sys.state
+	volume := local.findVolume(request.PrimaryVolumeRef)
+	if volume == nil {
+		writeError(w, http.StatusUnprocessableEntity, "volumeNotExist", "the primary volume does not exist")
+		return
+	}
+	if mirrored(volume) {
+		writeError(w, http.StatusUnprocessableEntity, "arvmVolumeAlreadyInMirrorRelationship",
+			"the volume is already mirrored")
+		return
+	}
+	pool, size := s.mirrorRepositoryPool(w, local, volume, request.PrimaryPoolID, request.PercentCapacity)
+	if pool == nil {
+		return
+	}
+
+	var secondary *santricity.VolumeEx
+	if request.SecondaryVolumeRef != "" {
+		secondary = s.checkSecondaryVolume(w, group.sides[1-i].sys.state, volume, request.SecondaryVolumeRef)
+		if secondary == nil {
+			return
+		}
+	}
+
+	member := &mirrorMember{capacity: volume.VolumeSize}
+	member.refs[i] = local.newRef()
+	member.refs[1-i] = group.sides[1-i].sys.state.newRef()
+	member.volumes[i] = volume.VolumeRef
+	member.repositories[i] = local.addRepository(pool, size, "asyncMirrorGroupMember", member.refs[i]).ConcatVolRef
+	volume.AsyncMirrorSource = true
+	group.members = append(group.members, member)
+
+	if secondary != nil && !s.completeMirrorPair(w, group, member, 1-i, secondary, request.SecondaryPoolID,
+		request.SecondaryPercentCapacity) {
+		s.removeMirrorPair(group, member)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.mirrorPairView(group, member, i))
+}
+
+// mirrorRepositoryPool returns the pool for the repository of a pair, by default that of the volume, and the
+// size of the repository.
+func (s *Server) mirrorRepositoryPool(
+	w http.ResponseWriter, st *state, volume *santricity.VolumeEx, poolRef string, percent *float64,
+) (*santricity.VolumeGroupEx, uint64) {
+
+	if poolRef == "" {
+		poolRef = volume.VolumeGroupRef
+	}
+	pool := st.findPool(poolRef)
+	if pool == nil {
+		writeError(w, http.StatusUnprocessableEntity, "volumeGroupNotExist", "the storage pool does not exist")
+		return nil, 0
+	}
+	capacity, _ := strconv.ParseUint(volume.VolumeSize, 10, 64)
+	size := capacity * defaultMirrorRepositoryPercent / 100
+	if percent != nil {
+		size = uint64(float64(capacity) * *percent / 100)
+	}
+	if free, _ := strconv.ParseUint(pool.FreeSpace, 10, 64); size > free {
+		writeError(w, http.StatusUnprocessableEntity, "illegalParam", "insufficient free capacity in the storage pool")
+		return nil, 0
+	}
+	return pool, size
+}
+
+// checkSecondaryVolume returns the secondary volume of a pair, or nil after writing an error response.
+func (s *Server) checkSecondaryVolume(
+	w http.ResponseWriter, st *state, primary *santricity.VolumeEx, ref string,
+) *santricity.VolumeEx {
+
+	volume := st.findVolume(ref)
+	if volume == nil {
+		writeError(w, http.StatusUnprocessableEntity, "volumeNotExist", "the secondary volume does not exist")
+		return nil
+	}
+	if mirrored(volume) {
+		writeError(w, http.StatusUnprocessableEntity, "arvmVolumeAlreadyInMirrorRelationship",
+			"the secondary volume is already mirrored")
+		return nil
+	}
+	primarySize, _ := strconv.ParseUint(primary.VolumeSize, 10, 64)
+	secondarySize, _ := strconv.ParseUint(volume.VolumeSize, 10, 64)
+	if secondarySize < primarySize {
+		writeError(w, http.StatusUnprocessableEntity, "arvmInvalidSecondaryCapacity",
+			"the secondary volume is smaller than the primary volume")
+		return nil
+	}
+	return volume
+}
+
+// completeMirrorPair gives an incomplete pair its secondary volume on side j and starts the initial
+// synchronization.
+func (s *Server) completeMirrorPair(
+	w http.ResponseWriter, group *mirrorGroup, member *mirrorMember, j int, volume *santricity.VolumeEx,
+	poolRef string, percent *float64,
+) bool {
+	st := group.sides[j].sys.state
+	pool, size := s.mirrorRepositoryPool(w, st, volume, poolRef, percent)
+	if pool == nil {
+		return false
+	}
+	member.volumes[j] = volume.VolumeRef
+	member.repositories[j] = st.addRepository(pool, size, "asyncMirrorGroupMember", member.refs[j]).ConcatVolRef
+	member.completed = time.Now()
+	volume.AsyncMirrorTarget = true
+	if !group.suspended {
+		group.startSync(s.config.OperationDuration)
+	}
+	return true
+}
+
+// removeMirrorPair removes a pair and its repositories from both systems.
+func (s *Server) removeMirrorPair(group *mirrorGroup, member *mirrorMember) {
+	for k, side := range group.sides {
+		if volume := side.sys.state.findVolume(member.volumes[k]); volume != nil {
+			volume.AsyncMirrorSource = false
+			volume.AsyncMirrorTarget = false
+		}
+		side.sys.state.deleteRepositories(member.refs[k])
+	}
+	group.members = removeWhere(group.members, func(m *mirrorMember) bool { return m == member })
+}
+
+// routeIncompleteMirrorPairs serves /async-mirrors/incomplete-pairs, the pairs that lack their secondary volume
+// on a system. GET takes a group ref, POST and DELETE a pair ref.
+func (s *Server) routeIncompleteMirrorPairs(
+	w http.ResponseWriter, method string, sys *system, parts []string, body []byte,
+) {
+	if len(parts) > 1 {
+		notFound(w, "", "resource", strings.Join(parts, "/"))
+		return
+	}
+
+	if method == http.MethodGet {
+		var groupRef string
+		if len(parts) == 1 {
+			if group, _ := s.findMirrorGroup(sys, parts[0]); group == nil {
+				notFound(w, "arvmGroupDoesNotExist", "async mirror group", parts[0])
+				return
+			}
+			groupRef = parts[0]
+		}
+		incomplete := make([]v11.AmgIncompleteMember, 0)
+		for _, group := range s.mirrorGroups {
+			i := group.side(sys)
+			if i < 0 || (groupRef != "" && group.sides[i].ref != groupRef) {
+				continue
+			}
+			for _, member := range group.members {
+				if member.volumes[i] == "" {
+					incomplete = append(incomplete, s.incompleteMirrorPair(group, member, i))
+				}
+			}
+		}
+		writeJSON(w, http.StatusOK, incomplete)
+		return
+	}
+
+	if len(parts) == 0 {
+		methodNotAllowed(w)
+		return
+	}
+	group, member, i := s.findMirrorMember(sys, parts[0])
+	if member == nil || member.volumes[i] != "" {
+		notFound(w, "arvmMirrorMemberDoesNotExist", "incomplete async mirror pair", parts[0])
+		return
+	}
+
+	switch method {
+	case http.MethodPost:
+		var request v11.AsyncMirrorGroupMemberCompletionRequest
+		if !decode(w, body, &request) || s.linkDown(w) {
+			return
+		}
+		primary := group.sides[1-i].sys.state.findVolume(member.volumes[1-i])
+		volume := s.checkSecondaryVolume(w, sys.state, primary, request.SecondaryVolumeRef)
+		if volume == nil {
+			return
+		}
+		if !s.completeMirrorPair(w, group, member, i, volume, request.SecondaryPoolID,
+			request.SecondaryPercentCapacity) {
+			return
+		}
+		writeJSON(w, http.StatusOK, s.mirrorPairView(group, member, i))
+	case http.MethodDelete:
+		s.removeMirrorPair(group, member)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) incompleteMirrorPair(group *mirrorGroup, member *mirrorMember, i int) v11.AmgIncompleteMember {
+	remote := group.sides[1-i].sys
+	incomplete := v11.AmgIncompleteMember{
+		MemberRef:          member.refs[i],
+		GroupRef:           group.sides[i].ref,
+		PrimaryVolCapacity: member.capacity,
+		RemoteTargetWWN:    remote.wwn,
+		RemoteTargetName:   remote.name,
+		RemoteTargetID:     remote.id,
+		ID:                 member.refs[i],
+	}
+	if volume := remote.state.findVolume(member.volumes[1-i]); volume != nil {
+		incomplete.PrimaryVolWWN = volume.WorldWideName
+		incomplete.PrimaryVolUserLabel = volume.Label
+		incomplete.PrimaryVolRAIDLevel = volume.RaidLevel
+		incomplete.BlkSize = volume.BlockSize
+	}
+	return incomplete
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricitytest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// recordAudit adds a request that may have changed a storage system to its audit log, like an array with the
+// audit log level set to writeOnly. Must be called with the lock held.
+func (s *Server) recordAudit(sys *system, r *http.Request, statusCode int) {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+		return
+	}
+
+	now := time.Now().UTC()
+	record := v11.AuditLogRecord{
+		Method:           r.Method,
+		AccessedURL:      r.URL.RequestURI(),
+		AuthType:         "localAccount",
+		ClientIP:         r.RemoteAddr,
+		UserID:           s.config.Username,
+		HTTPStatus:       statusCode,
+		HTTPReasonPhrase: http.StatusText(statusCode),
+		LoggingAgent:     "webServices",
+		UserAgent:        r.UserAgent(),
+		Timestamp:        strconv.FormatInt(now.Unix(), 10),
+		DateTime:         now.Format(time.RFC3339),
+	}
+	if user, _, ok := r.BasicAuth(); ok {
+		record.UserID = user
+	}
+	if s.config.Token != "" && r.Header.Get("Authorization") == "Bearer "+s.config.Token {
+		record.AuthType = "jwt"
+	}
+	sys.state.auditLog = append(sys.state.auditLog, record)
+}
+
+// handleAuditLog returns the audit log records of a storage system made between the beginTime and endTime query
+// parameters. Must be called with the lock held.
+func (st *state) handleAuditLog(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "", "method not allowed")
+		return
+	}
+
+	var begin, end time.Time
+	for name, t := range map[string]*time.Time{"beginTime": &begin, "endTime": &end} {
+		value := r.URL.Query().Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "", "invalid "+name)
+			return
+		}
+		*t = parsed
+	}
+
+	records := []v11.AuditLogRecord{}
+	for _, record := range st.auditLog {
+		seconds, _ := strconv.ParseInt(record.Timestamp, 10, 64)
+		recordTime := time.Unix(seconds, 0)
+		if (!begin.IsZero() && recordTime.Before(begin)) || (!end.IsZero() && recordTime.After(end)) {
+			continue
+		}
+		records = append(records, record)
+	}
+	writeJSON(w, http.StatusOK, v11.AuditLogGetResponse{
+		TotalLogRecords: len(st.auditLog),
+		LogRecords:      records,
+	})
+}
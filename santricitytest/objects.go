@@ -0,0 +1,916 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricitytest
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	santricity "github.com/scaleoutsean/santricity-go"
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// addPool adds a storage pool, filling in defaults, and returns its reference.
+func (s *state) addPool(pool santricity.VolumeGroupEx) string {
+	if pool.VolumeGroupRef == "" {
+		pool.VolumeGroupRef = s.newRef()
+	}
+	if pool.WorldWideName == "" {
+		pool.WorldWideName = s.newWWN()
+	}
+	if pool.Label == "" {
+		pool.Label = "pool_" + strconv.Itoa(len(s.pools)+1)
+	}
+	if pool.FreeSpace == "" {
+		pool.FreeSpace = strconv.FormatUint(defaultPoolCapacity, 10)
+	}
+	if pool.RaidLevel == "" {
+		pool.RaidLevel = "raidDiskPool"
+	}
+	if len(pool.BlkSizeSupported) == 0 {
+		pool.BlkSizeSupported = []int{512, 4096}
+	}
+	if pool.BlkSizeRecommended == 0 {
+		pool.BlkSizeRecommended = pool.BlkSizeSupported[0]
+	}
+	s.pools = append(s.pools, &pool)
+	return pool.VolumeGroupRef
+}
+
+func (s *state) findPool(ref string) *santricity.VolumeGroupEx {
+	for _, pool := range s.pools {
+		if pool.VolumeGroupRef == ref {
+			return pool
+		}
+	}
+	return nil
+}
+
+// allocate takes capacity from a pool. It returns false if the pool does not have enough free space.
+func (s *state) allocate(pool *santricity.VolumeGroupEx, size uint64) bool {
+	free, _ := strconv.ParseUint(pool.FreeSpace, 10, 64)
+	if size > free {
+		return false
+	}
+	pool.FreeSpace = strconv.FormatUint(free-size, 10)
+	return true
+}
+
+// release returns capacity to a pool.
+func (s *state) release(poolRef string, size uint64) {
+	if pool := s.findPool(poolRef); pool != nil {
+		free, _ := strconv.ParseUint(pool.FreeSpace, 10, 64)
+		pool.FreeSpace = strconv.FormatUint(free+size, 10)
+	}
+}
+
+func (s *state) routePools(w http.ResponseWriter, method string, parts []string) {
+
+	if method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+
+	switch len(parts) {
+	case 0:
+		writeJSON(w, http.StatusOK, values(s.pools))
+	case 1, 2:
+		pool := s.findPool(parts[0])
+		if pool == nil {
+			notFound(w, "volumeGroupNotExist", "storage pool", parts[0])
+			return
+		}
+		if len(parts) == 1 {
+			writeJSON(w, http.StatusOK, pool)
+		} else if parts[1] == "action-progress" {
+			s.poolActionProgress(w, pool)
+		} else {
+			notFound(w, "", "resource", strings.Join(parts, "/"))
+		}
+	default:
+		notFound(w, "", "resource", strings.Join(parts, "/"))
+	}
+}
+
+// findVolume returns a thick or thin volume, as either can be mapped or have snapshots.
+func (s *state) findVolume(ref string) *santricity.VolumeEx {
+	for _, volume := range s.volumes {
+		if volume.VolumeRef == ref {
+			return volume
+		}
+	}
+	if volume := s.findThinVolume(ref); volume != nil {
+		return &volume.VolumeEx
+	}
+	return nil
+}
+
+func (s *state) findVolumeByLabel(label string) *santricity.VolumeEx {
+	for _, volume := range s.volumes {
+		if volume.Label == label {
+			return volume
+		}
+	}
+	for _, volume := range s.thinVolumes {
+		if volume.Label == label {
+			return &volume.VolumeEx
+		}
+	}
+	return nil
+}
+
+// addVolume creates a volume in a pool, taking the capacity from its free space.
+func (s *state) addVolume(pool *santricity.VolumeGroupEx, label string, size uint64, use string) *santricity.VolumeEx {
+	s.allocate(pool, size)
+	volume := &santricity.VolumeEx{
+		Label:          label,
+		VolumeSize:     strconv.FormatUint(size, 10),
+		SegmentSize:    128 << 10,
+		VolumeRef:      s.newRef(),
+		WorldWideName:  s.newWWN(),
+		VolumeGroupRef: pool.VolumeGroupRef,
+		RaidLevel:      pool.RaidLevel,
+		BlockSize:      pool.BlkSizeRecommended,
+		Mappings:       []santricity.LUNMapping{},
+		VolumeTags:     []santricity.VolumeTag{},
+		VolumeUse:      use,
+	}
+	s.volumes = append(s.volumes, volume)
+	return volume
+}
+
+// syncVolumeMappings refreshes the mapping list embedded in a volume.
+func (s *state) syncVolumeMappings(volume *santricity.VolumeEx) {
+	volume.Mappings = []santricity.LUNMapping{}
+	for _, mapping := range s.mappings {
+		if mapping.VolumeRef == volume.VolumeRef {
+			volume.Mappings = append(volume.Mappings, *mapping)
+		}
+	}
+	volume.IsMapped = len(volume.Mappings) > 0
+}
+
+func (s *state) routeVolumes(w http.ResponseWriter, method string, parts []string, body []byte) {
+
+	if len(parts) == 0 {
+		switch method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, values(s.volumes))
+		case http.MethodPost:
+			s.createVolume(w, body)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	if parts[0] == "check-volume-parity" {
+		s.routeParityJobs(w, method, parts[1:])
+		return
+	}
+
+	volume := s.findVolume(parts[0])
+	if volume == nil || volume.ThinProvisioned {
+		notFound(w, "volumeNotExist", "volume", parts[0])
+		return
+	}
+
+	if len(parts) == 2 && parts[1] == "expand" {
+		switch method {
+		case http.MethodGet:
+			s.expansionStatus(w, volume)
+		case http.MethodPost:
+			s.expandVolume(w, volume, body)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+	if len(parts) == 2 && parts[1] == "check-volume-parity" {
+		if method != http.MethodPost {
+			methodNotAllowed(w)
+			return
+		}
+		s.startParityCheck(w, volume, body)
+		return
+	}
+	if len(parts) != 1 {
+		notFound(w, "", "resource", strings.Join(parts, "/"))
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, volume)
+
+	case http.MethodPost:
+		var request santricity.VolumeUpdateRequest
+		if !decode(w, body, &request) {
+			return
+		}
+		if request.Name != "" && request.Name != volume.Label {
+			if s.findVolumeByLabel(request.Name) != nil {
+				writeError(w, http.StatusUnprocessableEntity, "invalidLabel", "a volume with this name already exists")
+				return
+			}
+			volume.Label = request.Name
+		}
+		if request.VolumeTags != nil {
+			volume.VolumeTags = append([]santricity.VolumeTag{}, request.VolumeTags...)
+		}
+		writeJSON(w, http.StatusOK, volume)
+
+	case http.MethodDelete:
+		if s.hasSnapshotRelationship(w, volume) || s.inCopyJob(w, volume) || s.inMirror(w, volume) {
+			return
+		}
+		s.deleteVolume(volume)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *state) createVolume(w http.ResponseWriter, body []byte) {
+
+	var request santricity.VolumeCreateRequest
+	if !decode(w, body, &request) {
+		return
+	}
+	if request.Name == "" {
+		writeError(w, http.StatusBadRequest, "invalidLabel", "a volume name is required")
+		return
+	}
+	if s.findVolumeByLabel(request.Name) != nil {
+		writeError(w, http.StatusUnprocessableEntity, "invalidLabel", "a volume with this name already exists")
+		return
+	}
+	pool := s.findPool(request.VolumeGroupRef)
+	if pool == nil {
+		writeError(w, http.StatusUnprocessableEntity, "volumeGroupNotExist", "the storage pool does not exist")
+		return
+	}
+
+	size, err := strconv.ParseUint(request.Size, 10, 64)
+	if err == nil {
+		size, err = sizeInBytes(size, request.SizeUnit)
+	}
+	if err != nil || size == 0 {
+		writeError(w, http.StatusBadRequest, "illegalParam", "invalid volume size")
+		return
+	}
+
+	blockSize := pool.BlkSizeRecommended
+	if request.BlockSize != 0 {
+		supported := false
+		for _, candidate := range pool.BlkSizeSupported {
+			supported = supported || candidate == request.BlockSize
+		}
+		if !supported {
+			writeError(w, http.StatusUnprocessableEntity, "invalidBlockSize",
+				"the block size is not supported by the storage pool")
+			return
+		}
+		blockSize = request.BlockSize
+	}
+
+	free, _ := strconv.ParseUint(pool.FreeSpace, 10, 64)
+	if size > free {
+		writeError(w, http.StatusUnprocessableEntity, "illegalParam", "insufficient free capacity in the storage pool")
+		return
+	}
+
+	volume := s.addVolume(pool, request.Name, size, "standardVolume")
+	volume.BlockSize = blockSize
+	if request.SegmentSize != 0 {
+		volume.SegmentSize = request.SegmentSize << 10
+	}
+	if request.VolumeTags != nil {
+		volume.VolumeTags = append([]santricity.VolumeTag{}, request.VolumeTags...)
+	}
+	writeJSON(w, http.StatusOK, volume)
+}
+
+// hasSnapshotRelationship writes a 422 response if a volume cannot be deleted because it has snapshot groups or
+// is a consistency group member.
+func (s *state) hasSnapshotRelationship(w http.ResponseWriter, volume *santricity.VolumeEx) bool {
+	for _, group := range s.snapshotGroups {
+		if group.BaseVolume == volume.VolumeRef {
+			writeError(w, http.StatusUnprocessableEntity, "volumeHasSnapshotRelationship",
+				"the volume has snapshot groups")
+			return true
+		}
+	}
+	for _, member := range s.cgMembers {
+		if member.VolumeId == volume.VolumeRef {
+			writeError(w, http.StatusUnprocessableEntity, "volumeHasSnapshotRelationship",
+				"the volume is a consistency group member")
+			return true
+		}
+	}
+	return false
+}
+
+// deleteVolume removes a volume with its mappings and returns its capacity to the pool.
+func (s *state) deleteVolume(volume *santricity.VolumeEx) {
+	size, _ := strconv.ParseUint(volume.VolumeSize, 10, 64)
+	s.release(volume.VolumeGroupRef, size)
+	s.mappings = removeWhere(s.mappings, func(m *santricity.LUNMapping) bool { return m.VolumeRef == volume.VolumeRef })
+	s.volumes = removeWhere(s.volumes, func(v *santricity.VolumeEx) bool { return v.VolumeRef == volume.VolumeRef })
+	delete(s.expansions, volume.VolumeRef)
+}
+
+func (s *state) expandVolume(w http.ResponseWriter, volume *santricity.VolumeEx, body []byte) {
+
+	var request struct {
+		ExpansionSize json.RawMessage `json:"expansionSize"`
+		SizeUnit      string          `json:"sizeUnit"`
+	}
+	if !decode(w, body, &request) {
+		return
+	}
+
+	size, err := parseSize(request.ExpansionSize)
+	if err == nil {
+		size, err = sizeInBytes(size, request.SizeUnit)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidExpansionSize", "invalid expansion size")
+		return
+	}
+
+	if until, ok := s.expansions[volume.VolumeRef]; ok && time.Now().Before(until) {
+		writeError(w, http.StatusUnprocessableEntity, "volumeReconfiguring", "an expansion is already in progress")
+		return
+	}
+
+	current, _ := strconv.ParseUint(volume.VolumeSize, 10, 64)
+	if size <= current {
+		writeError(w, http.StatusUnprocessableEntity, "invalidExpansionSize",
+			"the new size must be larger than the current size")
+		return
+	}
+	pool := s.findPool(volume.VolumeGroupRef)
+	if pool == nil || !s.allocate(pool, size-current) {
+		writeError(w, http.StatusUnprocessableEntity, "invalidExpansionSize",
+			"insufficient free capacity in the storage pool")
+		return
+	}
+
+	volume.VolumeSize = strconv.FormatUint(size, 10)
+	if s.config.OperationDuration > 0 {
+		s.expansions[volume.VolumeRef] = time.Now().Add(s.config.OperationDuration)
+	}
+	writeJSON(w, http.StatusOK, volume)
+}
+
+func (s *state) expansionStatus(w http.ResponseWriter, volume *santricity.VolumeEx) {
+
+	status := santricity.VolumeResizeStatusResponse{Action: "none"}
+
+	if until, ok := s.expansions[volume.VolumeRef]; ok {
+		remaining := time.Until(until)
+		if remaining > 0 {
+			status.Action = "remappingDve"
+			status.PercentComplete = int(100 - 100*remaining/s.config.OperationDuration)
+			status.TimeToCompletion = int((remaining + time.Minute - 1) / time.Minute)
+		} else {
+			delete(s.expansions, volume.VolumeRef)
+		}
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+// poolActionProgress reports the expansions in progress of the volumes in a pool.
+func (s *state) poolActionProgress(w http.ResponseWriter, pool *santricity.VolumeGroupEx) {
+
+	actions := []v11.OperationProgress{}
+	for _, volume := range s.volumes {
+		if volume.VolumeGroupRef != pool.VolumeGroupRef {
+			continue
+		}
+		until, ok := s.expansions[volume.VolumeRef]
+		if remaining := time.Until(until); ok && remaining > 0 {
+			actions = append(actions, v11.OperationProgress{
+				VolumeRef:                 volume.VolumeRef,
+				CurrentAction:             "remappingDve",
+				ProgressPercentage:        int(100 - 100*remaining/s.config.OperationDuration),
+				EstimatedTimeToCompletion: int((remaining + time.Minute - 1) / time.Minute),
+			})
+		}
+	}
+	writeJSON(w, http.StatusOK, actions)
+}
+
+func (s *state) startParityCheck(w http.ResponseWriter, volume *santricity.VolumeEx, body []byte) {
+
+	var request v11.CheckVolumeParityRequest
+	if len(body) > 0 && !decode(w, body, &request) {
+		return
+	}
+
+	job := &parityJob{started: time.Now(), until: time.Now().Add(s.config.OperationDuration)}
+	job.JobID = s.newRef()
+	job.VolumeID = volume.VolumeRef
+	job.VolumeName = volume.Label
+	job.ScanPriority = request.ScanPriority
+	job.JobStartedTimestamp = job.started.UTC().Format(time.RFC3339)
+	s.parityJobs = append(s.parityJobs, job)
+
+	writeJSON(w, http.StatusOK, v11.CheckVolumeParityAsyncResponse{
+		JobID:      job.JobID,
+		VolumeID:   volume.VolumeRef,
+		VolumeName: volume.Label,
+	})
+}
+
+// routeParityJobs serves /volumes/check-volume-parity/jobs[/{id}].
+func (s *state) routeParityJobs(w http.ResponseWriter, method string, parts []string) {
+
+	if len(parts) == 0 || parts[0] != "jobs" || len(parts) > 2 {
+		notFound(w, "", "resource", strings.Join(parts, "/"))
+		return
+	}
+	if method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+
+	if len(parts) == 1 {
+		jobs := make([]santricity.ParityCheckJob, 0, len(s.parityJobs))
+		for _, job := range s.parityJobs {
+			jobs = append(jobs, job.status())
+		}
+		writeJSON(w, http.StatusOK, jobs)
+		return
+	}
+	for _, job := range s.parityJobs {
+		if job.JobID == parts[1] {
+			writeJSON(w, http.StatusOK, job.status())
+			return
+		}
+	}
+	notFound(w, "", "parity check job", parts[1])
+}
+
+// parityJob is a parity scan, which completes after ServerConfig.OperationDuration without finding errors.
+type parityJob struct {
+	santricity.ParityCheckJob
+	started time.Time
+	until   time.Time
+}
+
+func (j *parityJob) status() santricity.ParityCheckJob {
+
+	status := j.ParityCheckJob
+	remaining := time.Until(j.until)
+	status.RuntimeInSec = strconv.Itoa(int(time.Since(j.started).Seconds()))
+	if remaining <= 0 {
+		status.JobStatus = "completed"
+		status.PercentComplete = 100
+		status.EstimatedTimeRemainingInSec = "0"
+		return status
+	}
+	status.JobStatus = "inProgress"
+	status.PercentComplete = int(100 - 100*remaining/j.until.Sub(j.started))
+	status.EstimatedTimeRemainingInSec = strconv.Itoa(int(remaining.Seconds()) + 1)
+	return status
+}
+
+func (s *state) findHost(ref string) *santricity.HostEx {
+	for _, host := range s.hosts {
+		if host.HostRef == ref {
+			return host
+		}
+	}
+	return nil
+}
+
+func (s *state) findHostGroup(ref string) *santricity.HostGroup {
+	for _, group := range s.hostGroups {
+		if group.ClusterRef == ref {
+			return group
+		}
+	}
+	return nil
+}
+
+// hostTypes returns the host types known to the fake array, indexed in alphabetical order of their codes.
+func hostTypes() []santricity.HostType {
+	names := make([]string, 0, len(santricity.HostTypes))
+	for name := range santricity.HostTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	types := make([]santricity.HostType, 0, len(names))
+	for i, name := range names {
+		types = append(types, santricity.HostType{Name: name, Index: i, Code: santricity.HostTypes[name]})
+	}
+	return types
+}
+
+func (s *state) routeHostTypes(w http.ResponseWriter, method string, parts []string) {
+	if method != http.MethodGet || len(parts) != 0 {
+		methodNotAllowed(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, hostTypes())
+}
+
+// newInitiator builds the initiator of a host port.
+func (s *state) newInitiator(port santricity.HostPort) santricity.HostExInitiator {
+	nodeName := santricity.HostExScsiNodeName{IoInterfaceType: port.Type}
+	switch port.Type {
+	case "iscsi":
+		nodeName.IscsiNodeName = port.Port
+	case "nvmeof":
+		nodeName.NvmeNodeName = port.Port
+	default:
+		nodeName.RemoteNodeWWN = port.Port
+	}
+	return santricity.HostExInitiator{
+		InitiatorRef: s.newRef(),
+		NodeName:     nodeName,
+		Label:        port.Label,
+		InitiatorNodeName: santricity.HostExInitiatorNodeName{
+			NodeName:      nodeName,
+			InterfaceType: port.Type,
+		},
+	}
+}
+
+// portInUse reports whether a host other than exclude already has an initiator with the given port name.
+func (s *state) portInUse(port, exclude string) bool {
+	for _, host := range s.hosts {
+		if host.HostRef == exclude {
+			continue
+		}
+		for _, initiator := range host.Initiators {
+			name := initiator.NodeName
+			if name.IscsiNodeName == port || name.NvmeNodeName == port || name.RemoteNodeWWN == port {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *state) routeHosts(w http.ResponseWriter, method string, parts []string, body []byte) {
+
+	if len(parts) == 0 {
+		switch method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, values(s.hosts))
+		case http.MethodPost:
+			s.createHost(w, body)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	host := s.findHost(parts[0])
+	if host == nil || len(parts) != 1 {
+		notFound(w, "partNodeNonexistent", "host", parts[0])
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, host)
+	case http.MethodPost:
+		s.updateHost(w, host, body)
+	case http.MethodDelete:
+		s.unmapTarget(host.HostRef)
+		s.hosts = removeWhere(s.hosts, func(h *santricity.HostEx) bool { return h.HostRef == host.HostRef })
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *state) createHost(w http.ResponseWriter, body []byte) {
+
+	var request santricity.HostCreateRequest
+	if !decode(w, body, &request) {
+		return
+	}
+	if request.Name == "" {
+		writeError(w, http.StatusBadRequest, "invalidLabel", "a host name is required")
+		return
+	}
+	for _, host := range s.hosts {
+		if host.Label == request.Name {
+			writeError(w, http.StatusUnprocessableEntity, "invalidLabel", "a host with this name already exists")
+			return
+		}
+	}
+
+	index := request.HostType.Index
+	if request.HostType.Code != "" {
+		index = -1
+		for _, hostType := range hostTypes() {
+			if hostType.Code == request.HostType.Code {
+				index = hostType.Index
+			}
+		}
+	}
+	if index < 0 || index >= len(santricity.HostTypes) {
+		writeError(w, http.StatusUnprocessableEntity, "invalidHostTypeIndex", "invalid host type")
+		return
+	}
+
+	clusterRef := santricity.NullRef
+	if request.GroupID != "" {
+		if s.findHostGroup(request.GroupID) == nil {
+			writeError(w, http.StatusUnprocessableEntity, "partNodeNonexistent", "the host group does not exist")
+			return
+		}
+		clusterRef = request.GroupID
+	}
+
+	host := &santricity.HostEx{
+		HostRef:       s.newRef(),
+		ClusterRef:    clusterRef,
+		Label:         request.Name,
+		HostTypeIndex: index,
+		Initiators:    []santricity.HostExInitiator{},
+	}
+	for _, port := range request.Ports {
+		if s.portInUse(port.Port, "") {
+			writeError(w, http.StatusUnprocessableEntity, "partDupId", "the port is already defined for another host")
+			return
+		}
+		host.Initiators = append(host.Initiators, s.newInitiator(port))
+	}
+
+	s.hosts = append(s.hosts, host)
+	writeJSON(w, http.StatusOK, host)
+}
+
+func (s *state) updateHost(w http.ResponseWriter, host *santricity.HostEx, body []byte) {
+
+	var request santricity.HostUpdateRequest
+	if !decode(w, body, &request) {
+		return
+	}
+
+	if request.GroupID != "" && request.GroupID != santricity.NullRef && s.findHostGroup(request.GroupID) == nil {
+		writeError(w, http.StatusUnprocessableEntity, "partNodeNonexistent", "the host group does not exist")
+		return
+	}
+	for _, port := range request.Ports {
+		if s.portInUse(port.Port, host.HostRef) {
+			writeError(w, http.StatusUnprocessableEntity, "partDupId", "the port is already defined for another host")
+			return
+		}
+	}
+
+	if request.Name != "" {
+		host.Label = request.Name
+	}
+	if request.GroupID != "" {
+		host.ClusterRef = request.GroupID
+	}
+	if request.HostType != nil {
+		host.HostTypeIndex = request.HostType.Index
+	}
+	for _, port := range request.Ports {
+		host.Initiators = append(host.Initiators, s.newInitiator(port))
+	}
+	for _, ref := range request.PortsToRemove {
+		initiators := host.Initiators[:0]
+		for _, initiator := range host.Initiators {
+			if initiator.InitiatorRef != ref {
+				initiators = append(initiators, initiator)
+			}
+		}
+		host.Initiators = initiators
+	}
+	writeJSON(w, http.StatusOK, host)
+}
+
+func (s *state) routeHostGroups(w http.ResponseWriter, method string, parts []string, body []byte) {
+
+	if len(parts) == 0 {
+		switch method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, values(s.hostGroups))
+		case http.MethodPost:
+			var request santricity.HostGroupCreateRequest
+			if !decode(w, body, &request) {
+				return
+			}
+			if request.Name == "" {
+				writeError(w, http.StatusBadRequest, "invalidLabel", "a host group name is required")
+				return
+			}
+			for _, group := range s.hostGroups {
+				if group.Label == request.Name {
+					writeError(w, http.StatusUnprocessableEntity, "invalidLabel",
+						"a host group with this name already exists")
+					return
+				}
+			}
+			group := &santricity.HostGroup{ClusterRef: s.newRef(), Label: request.Name}
+			s.hostGroups = append(s.hostGroups, group)
+			for _, ref := range request.Hosts {
+				if host := s.findHost(ref); host != nil {
+					host.ClusterRef = group.ClusterRef
+				}
+			}
+			writeJSON(w, http.StatusOK, group)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	group := s.findHostGroup(parts[0])
+	if group == nil || len(parts) != 1 {
+		notFound(w, "partNodeNonexistent", "host group", parts[0])
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, group)
+	case http.MethodDelete:
+		s.unmapTarget(group.ClusterRef)
+		for _, host := range s.hosts {
+			if host.ClusterRef == group.ClusterRef {
+				host.ClusterRef = santricity.NullRef
+			}
+		}
+		s.hostGroups = removeWhere(s.hostGroups, func(g *santricity.HostGroup) bool {
+			return g.ClusterRef == group.ClusterRef
+		})
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// unmapTarget removes all mappings to a host or host group.
+func (s *state) unmapTarget(ref string) {
+	var affected []string
+	s.mappings = removeWhere(s.mappings, func(m *santricity.LUNMapping) bool {
+		if m.MapRef == ref {
+			affected = append(affected, m.VolumeRef)
+			return true
+		}
+		return false
+	})
+	for _, volumeRef := range affected {
+		if volume := s.findVolume(volumeRef); volume != nil {
+			s.syncVolumeMappings(volume)
+		}
+	}
+}
+
+func (s *state) routeMappings(w http.ResponseWriter, method string, parts []string, body []byte) {
+
+	if len(parts) == 0 {
+		switch method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, values(s.mappings))
+		case http.MethodPost:
+			s.createMapping(w, body)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	var mapping *santricity.LUNMapping
+	for _, candidate := range s.mappings {
+		if candidate.LunMappingRef == parts[0] {
+			mapping = candidate
+		}
+	}
+	if mapping == nil || len(parts) != 1 {
+		notFound(w, "partMappingNonexistent", "volume mapping", parts[0])
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, mapping)
+	case http.MethodDelete:
+		s.mappings = removeWhere(s.mappings, func(m *santricity.LUNMapping) bool {
+			return m.LunMappingRef == mapping.LunMappingRef
+		})
+		if volume := s.findVolume(mapping.VolumeRef); volume != nil {
+			s.syncVolumeMappings(volume)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *state) createMapping(w http.ResponseWriter, body []byte) {
+
+	var request santricity.VolumeMappingCreateRequest
+	if !decode(w, body, &request) {
+		return
+	}
+
+	volume := s.findVolume(request.MappableObjectID)
+	if volume == nil {
+		writeError(w, http.StatusUnprocessableEntity, "volumeNotExist", "the volume does not exist")
+		return
+	}
+
+	mappingType := "host"
+	if s.findHost(request.TargetID) == nil {
+		if s.findHostGroup(request.TargetID) == nil {
+			writeError(w, http.StatusUnprocessableEntity, "partNodeNonexistent", "the host or host group does not exist")
+			return
+		}
+		mappingType = "cluster"
+	}
+
+	for _, mapping := range s.mappings {
+		if mapping.VolumeRef == volume.VolumeRef {
+			writeError(w, http.StatusUnprocessableEntity, "duplicateVolMapping", "the volume is already mapped")
+			return
+		}
+	}
+
+	// LUNs are unique per host or host group; a host also sees the LUNs of its group
+	used := make(map[int]bool)
+	for _, mapping := range s.mappings {
+		if s.sharesLUNSpace(mapping.MapRef, request.TargetID) {
+			used[mapping.LunNumber] = true
+		}
+	}
+
+	lun := request.LunNumber
+	if lun == 0 {
+		for lun = 1; used[lun]; lun++ {
+		}
+	} else if used[lun] {
+		writeError(w, http.StatusUnprocessableEntity, "partLunCollision", "the LUN is already in use")
+		return
+	}
+
+	mapping := &santricity.LUNMapping{
+		LunMappingRef: s.newRef(),
+		LunNumber:     lun,
+		VolumeRef:     volume.VolumeRef,
+		MapRef:        request.TargetID,
+		Type:          mappingType,
+	}
+	s.mappings = append(s.mappings, mapping)
+	s.syncVolumeMappings(volume)
+	writeJSON(w, http.StatusOK, mapping)
+}
+
+// sharesLUNSpace reports whether two mapping targets (hosts or host groups) see each other's LUNs.
+func (s *state) sharesLUNSpace(a, b string) bool {
+	if a == b {
+		return true
+	}
+	groupOf := func(ref string) string {
+		if host := s.findHost(ref); host != nil {
+			return host.ClusterRef
+		}
+		return ref
+	}
+	groupA, groupB := groupOf(a), groupOf(b)
+	return groupA != santricity.NullRef && groupA == groupB
+}
+
+func (s *state) routeTargetSettings(w http.ResponseWriter, method string, parts []string) {
+
+	if method != http.MethodGet || len(parts) != 2 {
+		notFound(w, "", "resource", strings.Join(parts, "/"))
+		return
+	}
+
+	switch parts[0] + "/" + parts[1] {
+	case "iscsi/target-settings":
+		var settings santricity.IscsiTargetSettings
+		settings.TargetRef = "9000000060080E50" + strings.Repeat("0", 24)
+		settings.NodeName.IoInterfaceType = "iscsi"
+		settings.NodeName.IscsiNodeName = "iqn.1992-08.com.netapp:5700.600a098000fake0000000000000001"
+		settings.Alias.IoInterfaceType = "iscsi"
+		settings.Alias.IscsiAlias = s.config.Name
+		writeJSON(w, http.StatusOK, settings)
+	case "nvmeof/initiator-settings":
+		var settings santricity.NvmeofTargetSettings
+		settings.TargetRef = "9100000060080E50" + strings.Repeat("0", 24)
+		settings.NodeName.IoInterfaceType = "nvmeof"
+		settings.NodeName.NvmeNodeName = "nqn.1992-08.com.netapp:5700.600a098000fake0000000000000001"
+		writeJSON(w, http.StatusOK, settings)
+	default:
+		notFound(w, "", "resource", strings.Join(parts, "/"))
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricitytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	santricity "github.com/scaleoutsean/santricity-go"
+)
+
+// system is a storage system served by the fake server. The first one is described by ServerConfig; a server
+// simulating a Web Services Proxy can hold more, each with its own objects.
+type system struct {
+	id        string
+	name      string
+	wwn       string
+	serial    string
+	addresses []string // Controller addresses given at registration; empty for the first system
+	state     *state
+}
+
+// AddStorageSystem adds an empty storage system with a default pool, as if it had been registered with a Web
+// Services Proxy, and returns its ID. An empty name selects "fake-array-<id>".
+func (s *Server) AddStorageSystem(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addSystem("", name, nil).id
+}
+
+// StorageSystems returns the storage systems known to the server, in the order they were added.
+func (s *Server) StorageSystems() []santricity.StorageSystem {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	systems := make([]santricity.StorageSystem, 0, len(s.systems))
+	for _, sys := range s.systems {
+		systems = append(systems, s.storageSystem(sys))
+	}
+	return systems
+}
+
+// addSystem creates a storage system. Must be called with the lock held.
+func (s *Server) addSystem(id, name string, addresses []string) *system {
+
+	s.nextSystem++
+	if id == "" {
+		id = strconv.Itoa(s.nextSystem)
+	}
+	if name == "" {
+		name = "fake-array-" + id
+	}
+
+	config := s.config
+	config.ArrayID = id
+	config.Name = name
+	sys := &system{
+		id:        id,
+		name:      name,
+		wwn:       fmt.Sprintf("600A098000F63714%016X", s.nextSystem),
+		serial:    fmt.Sprintf("FAKE%010d", s.nextSystem),
+		addresses: addresses,
+		state:     newState(config),
+	}
+	s.systems = append(s.systems, sys)
+	return sys
+}
+
+// findSystem returns the storage system with the given ID, or nil. Must be called with the lock held.
+func (s *Server) findSystem(id string) *system {
+	for _, sys := range s.systems {
+		if sys.id == id {
+			return sys
+		}
+	}
+	return nil
+}
+
+// handleStorageSystems implements the storage system list: GET lists the systems and, on a proxy, POST
+// registers one.
+func (s *Server) handleStorageSystems(w http.ResponseWriter, r *http.Request, body []byte) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		systems := make([]santricity.StorageSystem, 0, len(s.systems))
+		for _, sys := range s.systems {
+			systems = append(systems, s.storageSystem(sys))
+		}
+		writeJSON(w, http.StatusOK, systems)
+
+	case http.MethodPost:
+		if !s.config.Proxy {
+			methodNotAllowed(w)
+			return
+		}
+		var request santricity.StorageSystemRegisterRequest
+		if err := json.Unmarshal(body, &request); err != nil || len(request.ControllerAddresses) == 0 {
+			writeError(w, http.StatusBadRequest, "invalidRequest", "controller addresses are required")
+			return
+		}
+		for _, sys := range s.systems {
+			if sys.id == request.ID || slices.Equal(sys.addresses, request.ControllerAddresses) {
+				writeJSON(w, http.StatusOK, map[string]interface{}{"id": sys.id, "alreadyExists": true})
+				return
+			}
+		}
+		sys := s.addSystem(request.ID, "", request.ControllerAddresses)
+		writeJSON(w, http.StatusCreated, map[string]interface{}{"id": sys.id, "alreadyExists": false})
+
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// removeSystem implements DELETE /storage-systems/{id} on a proxy.
+func (s *Server) removeSystem(w http.ResponseWriter, id string) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.config.Proxy {
+		methodNotAllowed(w)
+		return
+	}
+	s.systems = slices.DeleteFunc(s.systems, func(sys *system) bool { return sys.id == id })
+	s.mirrorGroups = slices.DeleteFunc(s.mirrorGroups, func(g *mirrorGroup) bool {
+		return g.sides[0].sys.id == id || g.sides[1].sys.id == id
+	})
+	s.remoteMirrors = slices.DeleteFunc(s.remoteMirrors, func(m *remoteMirror) bool {
+		return m.sides[0].id == id || m.sides[1].id == id
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
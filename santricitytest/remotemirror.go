@@ -0,0 +1,401 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricitytest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	santricity "github.com/scaleoutsean/santricity-go"
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// remoteMirror is a remote mirror pair between two storage systems of the server. Like async mirror groups it is
+// kept by the server, and its fields are indexed by side, the system it was created on first. A synchronization
+// completes after ServerConfig.OperationDuration. While the link between the systems is down the pair is
+// unsynchronized; once it is up again, the pair resynchronizes on its own if it was created with AutoResync.
+type remoteMirror struct {
+	sides          [2]*system
+	refs           [2]string
+	volumes        [2]string
+	primary        int // Side of the primary volume
+	writeMode      int
+	priority       int
+	autoResync     bool
+	suspended      bool
+	unsynchronized bool
+	syncStarted    time.Time
+	syncUntil      time.Time // End of the running synchronization; zero if none is running
+	lastComplete   time.Time
+}
+
+// remoteMirrorCandidate is v11.RemoteMirrorCandidate with the volumes of the client, whose fields shadow those of
+// the model, so that they are encoded with the values the server keeps.
+type remoteMirrorCandidate struct {
+	SourceVolume    santricity.VolumeEx   `json:"sourceVolume"`
+	TargetVolumes   []santricity.VolumeEx `json:"targetVolumes"`
+	TargetArrayName string                `json:"targetArrayName"`
+	TargetArrayID   string                `json:"targetArrayId"`
+}
+
+// RemoteMirrorPairs returns the remote mirror pairs of the first storage system.
+func (s *Server) RemoteMirrorPairs() []santricity.RemoteMirrorPair {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pairs := make([]santricity.RemoteMirrorPair, 0)
+	for _, mirror := range s.remoteMirrors {
+		if i := mirror.side(s.systems[0]); i >= 0 {
+			pairs = append(pairs, s.remoteMirrorView(mirror, i))
+		}
+	}
+	return pairs
+}
+
+// side returns the index of a storage system among the sides of a pair, or -1.
+func (m *remoteMirror) side(sys *system) int {
+	for i, side := range m.sides {
+		if side == sys {
+			return i
+		}
+	}
+	return -1
+}
+
+// settle records the completion of a synchronization that has ended.
+func (m *remoteMirror) settle() {
+	if !m.syncUntil.IsZero() && !time.Now().Before(m.syncUntil) {
+		m.lastComplete = m.syncUntil
+		m.syncUntil = time.Time{}
+	}
+}
+
+func (m *remoteMirror) startSync(duration time.Duration) {
+	m.unsynchronized = false
+	m.syncStarted = time.Now()
+	m.syncUntil = m.syncStarted.Add(duration)
+}
+
+// setLinkDown marks the pair unsynchronized when the link goes down, and resynchronizes it when the link is up
+// again if it does so on its own.
+func (m *remoteMirror) setLinkDown(down bool, duration time.Duration) {
+	if down {
+		m.unsynchronized = true
+		m.syncUntil = time.Time{}
+	} else if m.unsynchronized && m.autoResync && !m.suspended {
+		m.startSync(duration)
+	}
+}
+
+func (s *Server) remoteMirrorStatus(mirror *remoteMirror) string {
+	switch {
+	case mirror.suspended:
+		return "suspended"
+	case s.mirrorLinkDown || mirror.unsynchronized:
+		return "unsynchronized"
+	case !mirror.syncUntil.IsZero():
+		return "synchronizing"
+	}
+	return "optimal"
+}
+
+// remoteMirrorView returns a pair as seen by one of its systems. Must be called with the lock held.
+func (s *Server) remoteMirrorView(mirror *remoteMirror, i int) santricity.RemoteMirrorPair {
+
+	view := santricity.RemoteMirrorPair{
+		TargetArray: mirror.sides[1-i].id,
+		ID:          mirror.refs[i],
+		Status:      s.remoteMirrorStatus(mirror),
+		WriteMode:   mirror.writeMode,
+	}
+	if volume := mirror.sides[i].state.findVolume(mirror.volumes[i]); volume != nil {
+		view.Base = *volume
+	}
+	if volume := mirror.sides[1-i].state.findVolume(mirror.volumes[1-i]); volume != nil {
+		view.Target = *volume
+	}
+	view.BaseArray = mirror.sides[i].id
+	view.Priority = mirror.priority
+	view.AutoResync = mirror.autoResync
+	view.FeatureActive = true
+	view.BaseStatus = view.Status
+	view.TargetStatus = view.Status
+	view.LastStartTime = mirror.syncStarted.UTC().Format(time.RFC3339)
+	if !mirror.lastComplete.IsZero() {
+		view.LastCompleteTime = mirror.lastComplete.UTC().Format(time.RFC3339)
+	}
+	return view
+}
+
+// findRemoteMirror returns the pair with an ID on a system and the index of that system.
+func (s *Server) findRemoteMirror(sys *system, id string) (*remoteMirror, int) {
+	for _, mirror := range s.remoteMirrors {
+		if i := mirror.side(sys); i >= 0 && mirror.refs[i] == id {
+			return mirror, i
+		}
+	}
+	return nil, -1
+}
+
+// mirrored reports whether a volume is part of a remote mirror pair or an async mirror group.
+func mirrored(volume *santricity.VolumeEx) bool {
+	return volume.RemoteMirrorSource || volume.RemoteMirrorTarget || volume.AsyncMirrorSource ||
+		volume.AsyncMirrorTarget
+}
+
+// routeRemoteMirrors serves /remote-mirror-pairs. Must be called with the lock held.
+func (s *Server) routeRemoteMirrors(w http.ResponseWriter, method string, sys *system, parts []string, body []byte) {
+
+	for _, mirror := range s.remoteMirrors {
+		mirror.settle()
+	}
+
+	if len(parts) == 0 {
+		switch method {
+		case http.MethodGet:
+			pairs := make([]santricity.RemoteMirrorPair, 0)
+			for _, mirror := range s.remoteMirrors {
+				if i := mirror.side(sys); i >= 0 {
+					pairs = append(pairs, s.remoteMirrorView(mirror, i))
+				}
+			}
+			writeJSON(w, http.StatusOK, pairs)
+		case http.MethodPost:
+			s.createRemoteMirror(w, sys, body)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	if len(parts) == 2 {
+		if method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		switch parts[0] {
+		case "remote-mirror-target-candidates":
+			s.remoteMirrorCandidates(w, sys, parts[1])
+			return
+		case "test-remote-mirror-communication", "mirror-sync-progress":
+			mirror, _ := s.findRemoteMirror(sys, parts[1])
+			if mirror == nil {
+				notFound(w, "invalidMirrorvol", "remote mirror pair", parts[1])
+				return
+			}
+			if parts[0] == "mirror-sync-progress" {
+				writeJSON(w, http.StatusOK, v11.JobProgress{ObjectID: parts[1], PercentComplete: mirror.percentComplete()})
+				return
+			}
+			if s.mirrorLinkDown {
+				writeError(w, http.StatusUnprocessableEntity, "rvmCommunicationError",
+					"the remote storage system cannot be reached")
+				return
+			}
+			writeJSON(w, http.StatusOK, v11.RemoteCommunicationData{Samples: []int{250, 240, 260}, Timeout: 30})
+			return
+		}
+	}
+
+	mirror, i := s.findRemoteMirror(sys, parts[0])
+	if mirror == nil || len(parts) != 1 {
+		notFound(w, "invalidMirrorvol", "remote mirror pair", parts[0])
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.remoteMirrorView(mirror, i))
+	case http.MethodPost:
+		s.updateRemoteMirror(w, mirror, i, body)
+	case http.MethodDelete:
+		for k, side := range mirror.sides {
+			if volume := side.state.findVolume(mirror.volumes[k]); volume != nil {
+				volume.RemoteMirrorSource = false
+				volume.RemoteMirrorTarget = false
+			}
+		}
+		s.remoteMirrors = removeWhere(s.remoteMirrors, func(m *remoteMirror) bool { return m == mirror })
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (m *remoteMirror) percentComplete() int {
+	if m.syncUntil.IsZero() || !m.syncUntil.After(m.syncStarted) {
+		return 100
+	}
+	return int(100 * time.Since(m.syncStarted) / m.syncUntil.Sub(m.syncStarted))
+}
+
+// remoteMirrorCandidates lists the volumes of the other storage systems that can mirror a volume: standard volumes
+// of at least its size that are not mirrored yet.
+func (s *Server) remoteMirrorCandidates(w http.ResponseWriter, sys *system, volumeRef string) {
+
+	volume := sys.state.findVolume(volumeRef)
+	if volume == nil {
+		notFound(w, "volumeNotExist", "volume", volumeRef)
+		return
+	}
+	size, _ := strconv.ParseUint(volume.VolumeSize, 10, 64)
+
+	candidates := make([]remoteMirrorCandidate, 0)
+	for _, other := range s.systems {
+		if other == sys {
+			continue
+		}
+		candidate := remoteMirrorCandidate{
+			SourceVolume:    *volume,
+			TargetVolumes:   []santricity.VolumeEx{},
+			TargetArrayName: other.name,
+			TargetArrayID:   other.id,
+		}
+		for _, target := range other.state.volumes {
+			targetSize, _ := strconv.ParseUint(target.VolumeSize, 10, 64)
+			if target.VolumeUse == "standardVolume" && targetSize >= size && !mirrored(target) {
+				candidate.TargetVolumes = append(candidate.TargetVolumes, *target)
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+	writeJSON(w, http.StatusOK, candidates)
+}
+
+func (s *Server) createRemoteMirror(w http.ResponseWriter, sys *system, body []byte) {
+
+	var request v11.RemoteVolumeMirrorCreateRequest
+	if !decode(w, body, &request) {
+		return
+	}
+	if request.CopyType < 0 || request.CopyType > 2 {
+		writeError(w, http.StatusBadRequest, "illegalParam", "invalid write mode")
+		return
+	}
+	if request.Priority < 0 || request.Priority > 4 {
+		writeError(w, http.StatusUnprocessableEntity, "invalidSyncPriority", "invalid synchronization priority")
+		return
+	}
+	volume := sys.state.findVolume(request.SrcVolID)
+	if volume == nil {
+		writeError(w, http.StatusUnprocessableEntity, "volumeNotExist", "the source volume does not exist")
+		return
+	}
+	if mirrored(volume) {
+		writeError(w, http.StatusUnprocessableEntity, "volumeHasMirrorRelationship", "the volume is already mirrored")
+		return
+	}
+	remote := s.findSystem(request.RemoteArrayID)
+	if remote == nil || remote == sys {
+		writeError(w, http.StatusUnprocessableEntity, "remoteNoArray", "the remote array is not known")
+		return
+	}
+	if s.linkDown(w) {
+		return
+	}
+
+	var target *santricity.VolumeEx
+	for _, candidate := range remote.state.volumes {
+		if strings.EqualFold(candidate.WorldWideName, request.TgtVolWWN) {
+			target = candidate
+		}
+	}
+	size, _ := strconv.ParseUint(volume.VolumeSize, 10, 64)
+	if target != nil {
+		targetSize, _ := strconv.ParseUint(target.VolumeSize, 10, 64)
+		if targetSize < size || mirrored(target) || target.VolumeUse != "standardVolume" {
+			target = nil
+		}
+	}
+	if target == nil {
+		writeError(w, http.StatusUnprocessableEntity, "invalidMirrorCandidateVol",
+			"the target volume cannot be the secondary of the source volume")
+		return
+	}
+
+	mirror := &remoteMirror{
+		sides:      [2]*system{sys, remote},
+		refs:       [2]string{sys.state.newRef(), remote.state.newRef()},
+		volumes:    [2]string{volume.VolumeRef, target.VolumeRef},
+		writeMode:  request.CopyType,
+		priority:   request.Priority,
+		autoResync: request.AutoResync,
+	}
+	volume.RemoteMirrorSource = true
+	target.RemoteMirrorTarget = true
+	mirror.startSync(s.config.OperationDuration)
+	s.remoteMirrors = append(s.remoteMirrors, mirror)
+
+	writeJSON(w, http.StatusOK, s.remoteMirrorView(mirror, 0))
+}
+
+func (s *Server) updateRemoteMirror(w http.ResponseWriter, mirror *remoteMirror, i int, body []byte) {
+
+	var request v11.RemoteVolumeMirrorUpdateRequest
+	if !decode(w, body, &request) {
+		return
+	}
+	if request.UpdateType != "roleChange" && mirror.primary != i {
+		writeError(w, http.StatusUnprocessableEntity, "rvmOperNotAllowedOnSec",
+			"the operation is not allowed on the secondary volume")
+		return
+	}
+
+	switch request.UpdateType {
+	case "suspend":
+		mirror.suspended = true
+		mirror.syncUntil = time.Time{}
+	case "resume", "resync":
+		if s.linkDown(w) {
+			return
+		}
+		if request.UpdateType == "resume" && !mirror.suspended {
+			writeError(w, http.StatusUnprocessableEntity, "invalidMirrorvol", "the remote mirror is not suspended")
+			return
+		}
+		mirror.suspended = false
+		mirror.startSync(s.config.OperationDuration)
+	case "roleChange":
+		if request.Role == nil || *request.Role < 0 || *request.Role > 1 {
+			writeError(w, http.StatusBadRequest, "illegalParam", "the role must be 0 (primary) or 1 (secondary)")
+			return
+		}
+		if (*request.Role == 0) == (mirror.primary == i) {
+			break
+		}
+		if s.linkDown(w) {
+			return
+		}
+		mirror.primary = 1 - mirror.primary
+		for k, side := range mirror.sides {
+			if volume := side.state.findVolume(mirror.volumes[k]); volume != nil {
+				volume.RemoteMirrorSource = k == mirror.primary
+				volume.RemoteMirrorTarget = k != mirror.primary
+			}
+		}
+	case "updateParams":
+		if request.WriteMode != nil {
+			if *request.WriteMode < 0 || *request.WriteMode > 2 {
+				writeError(w, http.StatusBadRequest, "illegalParam", "invalid write mode")
+				return
+			}
+			mirror.writeMode = *request.WriteMode
+		}
+		if request.Priority != nil {
+			if *request.Priority < 0 || *request.Priority > 4 {
+				writeError(w, http.StatusUnprocessableEntity, "invalidSyncPriority",
+					"invalid synchronization priority")
+				return
+			}
+			mirror.priority = *request.Priority
+		}
+		if request.AutoResync != nil {
+			mirror.autoResync = *request.AutoResync
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "illegalParam", "invalid update type")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.remoteMirrorView(mirror, i))
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricitytest
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	santricity "github.com/scaleoutsean/santricity-go"
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// SnapshotSchedules returns the snapshot schedules of the fake array.
+func (s *Server) SnapshotSchedules() []santricity.SnapshotSchedule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return values(s.state.snapshotSchedules)
+}
+
+// RunSnapshotSchedules takes a snapshot image for every active snapshot schedule of the fake array, as the array
+// does when a schedule is due, and deletes the oldest images beyond the auto-delete limit of the group. The fake
+// does not run schedules on its own.
+func (s *Server) RunSnapshotSchedules() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.state
+	for _, schedule := range st.snapshotSchedules {
+		if schedule.ScheduleStatus != "active" {
+			continue
+		}
+		if group := st.findSnapshotGroup(schedule.TargetObject); group != nil {
+			st.newImage(group.PitGroupRef, group.BaseVolume, st.nextSequenceNumber(group.PitGroupRef))
+			st.purgeImages(group.PitGroupRef, group.AutoDeleteLimit)
+		}
+		location := scheduleLocation(schedule.Schedule.Timezone)
+		schedule.LastRunTime = localEpoch(time.Now().In(location))
+		schedule.NextRunTime = nextScheduleRun(schedule, time.Now())
+	}
+}
+
+func (s *state) findSnapshotSchedule(id string) *santricity.SnapshotSchedule {
+	for _, schedule := range s.snapshotSchedules {
+		if schedule.ID == id {
+			return schedule
+		}
+	}
+	return nil
+}
+
+// deleteSchedules removes the schedules of a snapshot group or consistency group, which the array deletes with the
+// group.
+func (s *state) deleteSchedules(targetRef string) {
+	s.snapshotSchedules = removeWhere(s.snapshotSchedules, func(schedule *santricity.SnapshotSchedule) bool {
+		return schedule.TargetObject == targetRef
+	})
+}
+
+// routeSnapshotSchedules serves /snapshot-schedules, which the API only offers for reading. Schedules are created
+// with their snapshot group (see createSnapshotGroup) and deleted with it.
+func (s *state) routeSnapshotSchedules(w http.ResponseWriter, method string, parts []string) {
+
+	if method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+	if len(parts) == 0 {
+		writeJSON(w, http.StatusOK, values(s.snapshotSchedules))
+		return
+	}
+
+	schedule := s.findSnapshotSchedule(parts[0])
+	if schedule == nil || len(parts) != 1 {
+		notFound(w, "invalidScheduleRef", "snapshot schedule", parts[0])
+		return
+	}
+	writeJSON(w, http.StatusOK, schedule)
+}
+
+// addSchedule creates the schedule of a new snapshot group.
+func (s *state) addSchedule(request v11.ScheduleCreateRequest, groupRef string) {
+	ref := s.newRef()
+	schedule := &santricity.SnapshotSchedule{ID: ref, ScheduleStatus: "active"}
+	schedule.SchedRef = ref
+	schedule.CreationTime = localEpoch(time.Now().In(scheduleLocation(request.Timezone)))
+	schedule.LastRunTime = "0"
+	request.TargetObject = groupRef
+	applySchedule(schedule, request)
+	s.snapshotSchedules = append(s.snapshotSchedules, schedule)
+}
+
+// validSchedule writes an error response for the schedule of a snapshot group create request that the fake array
+// does not accept. Of the schedule methods of the array, the fake supports daily and weekly.
+func validSchedule(w http.ResponseWriter, request v11.ScheduleCreateRequest) bool {
+
+	daily := request.DailySchedule
+	switch {
+	case request.Action != "newpit":
+		writeError(w, http.StatusBadRequest, "illegalParam", "invalid schedule action")
+	case request.ScheduleMethod != "daily" && request.ScheduleMethod != "weekly":
+		writeError(w, http.StatusBadRequest, "illegalParam", "invalid or unsupported schedule method")
+	case request.ScheduleMethod == "weekly" && len(request.DaysOfWeek) == 0:
+		writeError(w, http.StatusBadRequest, "illegalParam", "a weekly schedule needs days of the week")
+	case daily.TimeOfDay < 0 || daily.TimeOfDay >= 24*60*60 || daily.TimesPerDay < 1:
+		writeError(w, http.StatusBadRequest, "illegalParam", "invalid time of day")
+	case daily.TimesPerDay > 1 &&
+		(daily.EveryNMinutes < 1 || daily.TimeOfDay+(daily.TimesPerDay-1)*daily.EveryNMinutes*60 >= 24*60*60):
+		writeError(w, http.StatusBadRequest, "illegalParam", "the occurrences of the schedule do not fit in a day")
+	default:
+		return true
+	}
+	return false
+}
+
+// applySchedule sets the timing of a schedule from a request and computes its next run.
+func applySchedule(schedule *santricity.SnapshotSchedule, request v11.ScheduleCreateRequest) {
+
+	schedule.Action = request.Action
+	schedule.TargetObject = request.TargetObject
+	schedule.ScheduleStatus = "active"
+
+	calendar := v11.ScheduleCalendar{ScheduleMethod: request.ScheduleMethod}
+	if request.ScheduleMethod == "weekly" {
+		calendar.Weekly = v11.ScheduleWeekly{DaysOfWeek: request.DaysOfWeek, DailySchedule: request.DailySchedule}
+	} else {
+		calendar.Daily = v11.ScheduleDaily{DailySchedule: request.DailySchedule}
+	}
+	schedule.Schedule = v11.Schedule{
+		Calendar:   calendar,
+		StartDate:  request.StartDate,
+		Recurrence: v11.Recurrence{RecurrenceType: "unlimited"},
+		Timezone:   request.Timezone,
+	}
+	schedule.StopTime = "0"
+	if request.EndDate != "" && request.EndDate != "0" {
+		schedule.Schedule.Recurrence = v11.Recurrence{RecurrenceType: "endDate", RecurrenceEndDate: request.EndDate}
+		schedule.StopTime = request.EndDate
+	}
+	schedule.NextRunTime = nextScheduleRun(schedule, time.Now())
+	if schedule.NextRunTime == "0" {
+		schedule.ScheduleStatus = "completed"
+	}
+}
+
+// nextScheduleRun returns the next run of a schedule after a time, in local epoch seconds, or "0" if there is
+// none.
+func nextScheduleRun(schedule *santricity.SnapshotSchedule, after time.Time) string {
+
+	calendar := schedule.Schedule.Calendar
+	daily := calendar.Daily.DailySchedule
+	if calendar.ScheduleMethod == "weekly" {
+		daily = calendar.Weekly.DailySchedule
+	}
+
+	// Work on the wall clock of the schedule's time zone, as the array does
+	now, _ := strconv.ParseInt(localEpoch(after.In(scheduleLocation(schedule.Schedule.Timezone))), 10, 64)
+	start, _ := strconv.ParseInt(schedule.Schedule.StartDate, 10, 64)
+	end, _ := strconv.ParseInt(schedule.StopTime, 10, 64)
+	day := max(now, start) / 86400 * 86400
+
+	// A week and a day cover every weekday, including today's after its last run
+	for ; day <= max(now, start)+8*86400; day += 86400 {
+		weekday := strings.ToLower(time.Unix(day, 0).UTC().Weekday().String())
+		if calendar.ScheduleMethod == "weekly" && !slices.Contains(calendar.Weekly.DaysOfWeek, weekday) {
+			continue
+		}
+		for i := 0; i < max(daily.TimesPerDay, 1); i++ {
+			run := day + int64(daily.TimeOfDay) + int64(i*daily.EveryNMinutes*60)
+			if end > 0 && run > end {
+				return "0"
+			}
+			if run > now && run >= start {
+				return strconv.FormatInt(run, 10)
+			}
+		}
+	}
+	return "0"
+}
+
+// localEpoch returns a time as seconds since 1970 on the wall clock of its time zone, as the array reports the
+// times of schedules.
+func localEpoch(t time.Time) string {
+	_, offset := t.Zone()
+	return strconv.FormatInt(t.Unix()+int64(offset), 10)
+}
+
+// scheduleLocation returns the location of a schedule time zone, falling back to its standard offset.
+func scheduleLocation(zone v11.TimeZoneDescription) *time.Location {
+	if location, err := time.LoadLocation(zone.TzLabel); zone.TzLabel != "" && err == nil {
+		return location
+	}
+	return time.FixedZone(zone.TzLabel, zone.TzOffset)
+}
@@ -0,0 +1,586 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+// Package santricitytest provides an in-memory fake of the SANtricity Web Services REST API for tests that
+// exercise the santricity client, or anything built on it, without an array.
+//
+// A Server holds stateful volumes, thin volumes, storage pools, hosts, host groups, LUN mappings, snapshot
+// groups, snapshot images, snapshot volumes, consistency groups, snapshot schedules, repository volumes, volume
+// copy jobs and an audit log of the changes, and answers the endpoints under /devmgr/v2/storage-systems/{id} that
+// the client uses. With ServerConfig.Proxy it acts as a Web Services Proxy that manages several storage systems,
+// each with its own objects, and async mirror groups and remote mirror pairs between them. Faults such as error
+// responses, latency and controller outages can be injected to exercise error handling, retries and failover.
+//
+//	srv := santricitytest.NewServer(santricitytest.ServerConfig{})
+//	defer srv.Close()
+//	client := santricity.NewAPIClient(ctx, srv.ClientConfig())
+package santricitytest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	santricity "github.com/scaleoutsean/santricity-go"
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+const (
+	apiPrefix   = "/devmgr/v2/storage-systems"
+	aboutPath   = "/devmgr/utils/about"
+	loginPath   = "/devmgr/utils/login"
+	sessionName = "JSESSIONID"
+)
+
+// ServerConfig configures a fake server. Zero values select the defaults.
+type ServerConfig struct {
+	// Number of management controllers to simulate (default 1). Each controller listens on its own loopback
+	// address (127.0.0.1, 127.0.0.2, ...) on the same port, as the client expects one port for all controllers.
+	// More than one controller requires an OS that routes all of 127.0.0.0/8 to the loopback interface (Linux).
+	Controllers int
+
+	ArrayID  string // Storage system ID (default "1")
+	Name     string // Storage system name (default "fake-array")
+	Version  string // Web services version reported by /devmgr/utils/about (default "11.90.0000.0000")
+	Firmware string // Controller firmware version (default "08.90.00.00")
+	Username string // If set together with Password, requests must authenticate
+	Password string
+	Token    string // If set, requests may authenticate with this bearer token
+	Proxy    bool   // Act as a Web Services Proxy, which can register and remove storage systems
+
+	// Premium features reported as supported and enabled (default snapshots, volumeCopy, remoteMirroring and
+	// remoteMirroringType2)
+	Features []string
+
+	// How long volume expansions, parity checks and volume copies report an operation in progress (default 0,
+	// i.e. they complete at once)
+	OperationDuration time.Duration
+
+	// Storage pools to create at startup. If empty, a single 10 TiB disk pool named "pool_1" is created.
+	Pools []santricity.VolumeGroupEx
+}
+
+// Fault describes an injected failure. A request matches if its method, storage system and resource path
+// (relative to the storage system, e.g. "/volumes/0200000060080E50...") match.
+type Fault struct {
+	Method     string        // HTTP method to match; empty matches any
+	ArrayID    string        // Storage system to match; empty matches any
+	Path       string        // Resource path pattern as understood by path.Match, e.g. "/volumes/*"; empty matches any
+	StatusCode int           // Status to return; 0 returns the normal response, after Latency
+	ReturnCode string        // "retcode" of the CallResponse body returned with StatusCode
+	Latency    time.Duration // Delay before the response
+	Times      int           // Number of requests to affect; 0 means until the fault is cleared
+
+	// ApplyFirst processes the request normally before returning StatusCode, to simulate a write that took
+	// effect although the array reported an error (the SANtricity "422 race").
+	ApplyFirst bool
+}
+
+// Request is a request received by the fake server, as recorded for assertions.
+type Request struct {
+	Controller int    // Index of the controller that received the request
+	ArrayID    string // Storage system the request was addressed to, if any
+	Method     string
+	Path       string // Resource path relative to the storage system; absolute for utils endpoints
+	StatusCode int
+	Header     http.Header // Request headers, such as X-Request-ID and traceparent
+}
+
+// Server is a fake SANtricity Web Services endpoint.
+type Server struct {
+	config ServerConfig
+
+	mu          sync.Mutex
+	controllers []*httptest.Server
+	down        []bool
+	faults      []*Fault
+	latency     time.Duration
+	sessions    map[string]bool
+	requests    []Request
+	systems     []*system
+	nextSystem  int
+	state       *state // Objects of the first storage system, which the accessors return
+
+	mirrorGroups   []*mirrorGroup  // Async mirror groups, which span two storage systems
+	remoteMirrors  []*remoteMirror // Remote mirror pairs, which span two storage systems as well
+	mirrorLinkDown bool
+}
+
+// NewServer starts a fake server. It panics if the listeners cannot be created, like httptest.NewServer.
+// Call Close when done.
+func NewServer(config ServerConfig) *Server {
+
+	if config.Controllers <= 0 {
+		config.Controllers = 1
+	}
+	if config.ArrayID == "" {
+		config.ArrayID = "1"
+	}
+	if config.Name == "" {
+		config.Name = "fake-array"
+	}
+	if config.Version == "" {
+		config.Version = "11.90.0000.0000"
+	}
+	if config.Firmware == "" {
+		config.Firmware = "08.90.00.00"
+	}
+	if config.Features == nil {
+		config.Features = []string{"snapshots", "volumeCopy", "remoteMirroring", "remoteMirroringType2"}
+	}
+
+	s := &Server{
+		config:   config,
+		down:     make([]bool, config.Controllers),
+		sessions: make(map[string]bool),
+	}
+	s.state = s.addSystem(config.ArrayID, config.Name, nil).state
+
+	var port string
+	for i := 0; i < config.Controllers; i++ {
+		addr := "127.0.0.1:0"
+		if i > 0 {
+			addr = net.JoinHostPort(fmt.Sprintf("127.0.0.%d", i+1), port)
+		}
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			s.Close()
+			panic(fmt.Sprintf("santricitytest: failed to listen on %s: %v", addr, err))
+		}
+		if i == 0 {
+			_, port, _ = net.SplitHostPort(listener.Addr().String())
+		}
+
+		controller := httptest.NewUnstartedServer(s.handler(i))
+		controller.Listener.Close()
+		controller.Listener = listener
+		controller.StartTLS()
+		s.controllers = append(s.controllers, controller)
+	}
+
+	return s
+}
+
+// Close shuts down all controllers.
+func (s *Server) Close() {
+	for _, controller := range s.controllers {
+		controller.Close()
+	}
+}
+
+// Controllers returns the management addresses of the controllers, for ClientConfig.ApiControllers.
+func (s *Server) Controllers() []string {
+	addresses := make([]string, 0, len(s.controllers))
+	for _, controller := range s.controllers {
+		host, _, _ := net.SplitHostPort(controller.Listener.Addr().String())
+		addresses = append(addresses, host)
+	}
+	return addresses
+}
+
+// Port returns the port all controllers listen on, for ClientConfig.ApiPort.
+func (s *Server) Port() int {
+	_, port, _ := net.SplitHostPort(s.controllers[0].Listener.Addr().String())
+	portNum, _ := strconv.Atoi(port)
+	return portNum
+}
+
+// ClientConfig returns a client configuration that talks to this server. TLS verification is disabled, as the
+// test certificate only covers the first controller address.
+func (s *Server) ClientConfig() santricity.ClientConfig {
+	return santricity.ClientConfig{
+		ApiControllers: s.Controllers(),
+		ApiPort:        s.Port(),
+		ArrayID:        s.config.ArrayID,
+		Username:       s.config.Username,
+		Password:       s.config.Password,
+		VerifyTLS:      false,
+	}
+}
+
+// InjectFault adds a fault. Faults are checked in the order they were added; the first match applies.
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes all injected faults and the global latency.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+	s.latency = 0
+}
+
+// SetLatency delays every response by the given duration.
+func (s *Server) SetLatency(latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = latency
+}
+
+// SetControllerDown simulates an outage of a controller: while down, it drops every connection without a
+// response, so the client sees a transport error.
+func (s *Server) SetControllerDown(index int, down bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.down[index] = down
+}
+
+// ExpireSessions invalidates all web services sessions, so that session-based clients get a 401.
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions = make(map[string]bool)
+}
+
+// Requests returns the requests received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// ResetRequests clears the recorded requests.
+func (s *Server) ResetRequests() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+}
+
+// handler returns the HTTP handler of one controller.
+func (s *Server) handler(index int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		s.mu.Lock()
+		down := s.down[index]
+		s.mu.Unlock()
+
+		if down {
+			dropConnection(w)
+			return
+		}
+
+		resourcePath, sys := s.resourcePath(r.URL.Path)
+		arrayID := ""
+		if sys != nil {
+			arrayID = sys.id
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		s.serve(recorder, r, arrayID, resourcePath, body)
+
+		s.mu.Lock()
+		s.requests = append(s.requests, Request{
+			Controller: index,
+			ArrayID:    arrayID,
+			Method:     r.Method,
+			Path:       resourcePath,
+			StatusCode: recorder.status,
+			Header:     r.Header.Clone(),
+		})
+		if sys != nil {
+			s.recordAudit(sys, r, recorder.status)
+		}
+		s.mu.Unlock()
+	})
+}
+
+// serve applies faults and authentication, then dispatches a request.
+func (s *Server) serve(w http.ResponseWriter, r *http.Request, arrayID, resourcePath string, body []byte) {
+
+	s.mu.Lock()
+	latency := s.latency
+	fault := s.matchFault(r.Method, arrayID, resourcePath)
+	s.mu.Unlock()
+
+	if fault != nil {
+		latency += fault.Latency
+	}
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if fault != nil && fault.StatusCode != 0 {
+		if fault.ApplyFirst {
+			s.dispatch(httptest.NewRecorder(), r, resourcePath, body)
+		}
+		writeError(w, fault.StatusCode, fault.ReturnCode, "injected fault")
+		return
+	}
+
+	s.dispatch(w, r, resourcePath, body)
+}
+
+// dispatch routes a request to the utils endpoints or to the storage system resources.
+func (s *Server) dispatch(w http.ResponseWriter, r *http.Request, resourcePath string, body []byte) {
+
+	switch r.URL.Path {
+	case aboutPath:
+		writeJSON(w, http.StatusOK, santricity.AboutResponse{
+			Version:        s.config.Version,
+			SystemID:       s.config.ArrayID,
+			RunningAsProxy: s.config.Proxy,
+		})
+		return
+	case loginPath:
+		s.handleLogin(w, r, body)
+		return
+	}
+
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "", "authentication required")
+		return
+	}
+
+	// Storage system list
+	if r.URL.Path == apiPrefix || r.URL.Path == apiPrefix+"/" {
+		s.handleStorageSystems(w, r, body)
+		return
+	}
+
+	_, sys := s.resourcePath(r.URL.Path)
+	if sys == nil {
+		writeError(w, http.StatusNotFound, "", "the requested resource was not found")
+		return
+	}
+	if resourcePath == "" || resourcePath == "/" {
+		if r.Method == http.MethodDelete {
+			s.removeSystem(w, sys.id)
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		writeJSON(w, http.StatusOK, s.storageSystem(sys))
+		return
+	}
+	if resourcePath == "/capabilities" && r.Method == http.MethodGet {
+		writeJSON(w, http.StatusOK, s.capabilities())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if resourcePath == "/audit-log" {
+		sys.state.handleAuditLog(w, r)
+		return
+	}
+	switch parts := strings.Split(strings.Trim(resourcePath, "/"), "/"); parts[0] {
+	case "volume-copy-jobs-control":
+		sys.state.routeCopyControl(w, r, parts[1:])
+		return
+	case "async-mirrors":
+		s.routeAsyncMirrors(w, r, sys, parts[1:], body)
+		return
+	case "remote-mirror-pairs":
+		s.routeRemoteMirrors(w, r.Method, sys, parts[1:], body)
+		return
+	}
+	sys.state.route(w, r.Method, resourcePath, body)
+}
+
+// resourcePath returns the part of a URL path below /devmgr/v2/storage-systems/{id} and the storage system it
+// refers to, or nil if there is no such system. Other paths are returned unchanged.
+func (s *Server) resourcePath(urlPath string) (string, *system) {
+	rest, ok := strings.CutPrefix(urlPath, apiPrefix+"/")
+	if !ok {
+		return urlPath, nil
+	}
+	id, resourcePath, _ := strings.Cut(rest, "/")
+
+	s.mu.Lock()
+	sys := s.findSystem(id)
+	s.mu.Unlock()
+	if sys == nil {
+		return urlPath, nil
+	}
+	if resourcePath == "" {
+		return "", sys
+	}
+	return "/" + resourcePath, sys
+}
+
+// matchFault returns the first fault matching a request and consumes one of its uses. Must be called with the
+// lock held.
+func (s *Server) matchFault(method, arrayID, resourcePath string) *Fault {
+	for i, fault := range s.faults {
+		if fault.Method != "" && !strings.EqualFold(fault.Method, method) {
+			continue
+		}
+		if fault.ArrayID != "" && fault.ArrayID != arrayID {
+			continue
+		}
+		if fault.Path != "" {
+			if ok, _ := path.Match(fault.Path, resourcePath); !ok {
+				continue
+			}
+		}
+		matched := *fault
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return &matched
+	}
+	return nil
+}
+
+// authorized checks the credentials of a request, if the server requires any.
+func (s *Server) authorized(r *http.Request) bool {
+
+	if s.config.Username == "" && s.config.Token == "" {
+		return true
+	}
+
+	if s.config.Token != "" && r.Header.Get("Authorization") == "Bearer "+s.config.Token {
+		return true
+	}
+	if user, password, ok := r.BasicAuth(); ok {
+		return user == s.config.Username && password == s.config.Password
+	}
+	if cookie, err := r.Cookie(sessionName); err == nil {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.sessions[cookie.Value]
+	}
+	return false
+}
+
+// handleLogin implements session login (POST) and logout (DELETE).
+func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request, body []byte) {
+
+	switch r.Method {
+	case http.MethodPost:
+		var request santricity.LoginRequest
+		if err := json.Unmarshal(body, &request); err != nil {
+			writeError(w, http.StatusBadRequest, "", "invalid login request")
+			return
+		}
+		if s.config.Username != "" && (request.UserID != s.config.Username || request.Password != s.config.Password) {
+			writeError(w, http.StatusUnauthorized, "authFailPassword", "invalid credentials")
+			return
+		}
+		id := make([]byte, 16)
+		_, _ = rand.Read(id)
+		session := hex.EncodeToString(id)
+
+		s.mu.Lock()
+		s.sessions[session] = true
+		s.mu.Unlock()
+
+		http.SetCookie(w, &http.Cookie{Name: sessionName, Value: session, Path: "/", Secure: true, HttpOnly: true})
+		w.WriteHeader(http.StatusNoContent)
+
+	case http.MethodDelete:
+		if cookie, err := r.Cookie(sessionName); err == nil {
+			s.mu.Lock()
+			delete(s.sessions, cookie.Value)
+			s.mu.Unlock()
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "", "method not allowed")
+	}
+}
+
+// storageSystem describes a fake array. Must be called with the lock held.
+func (s *Server) storageSystem(sys *system) santricity.StorageSystem {
+	managementPaths := sys.addresses
+	if len(managementPaths) == 0 {
+		managementPaths = s.Controllers()
+	}
+	return santricity.StorageSystem{
+		ID:                  sys.id,
+		Name:                sys.name,
+		Wwn:                 sys.wwn,
+		Status:              "optimal",
+		Model:               "fake",
+		FwVersion:           s.config.Firmware,
+		AppVersion:          s.config.Firmware,
+		ChassisSerialNumber: sys.serial,
+		ManagementPaths:     managementPaths,
+	}
+}
+
+// capabilities describes the features of the fake array: the configured premium features and SSD support,
+// without a feature pack.
+func (s *Server) capabilities() v11.StorageSystemCapabilitiesResponse {
+	response := v11.StorageSystemCapabilitiesResponse{
+		Capabilities:        append(slices.Clone(s.config.Features), "ssdSupport"),
+		ProductCapabilities: []string{"capabilityDiskPools", "capabilityIscsiTarget", "capabilitySsdSupport"},
+		FeatureParameters:   v11.FeatureParams{SupportedSegSizes: []int{32, 64, 128, 256, 512}},
+	}
+	for _, feature := range s.config.Features {
+		response.FeatureStates = append(response.FeatureStates,
+			v11.FeatureState{Capability: feature, IsEnabled: true, IsCompliant: true})
+	}
+	return response
+}
+
+// statusRecorder remembers the status code written to a response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// dropConnection closes the client connection without writing a response.
+func dropConnection(w http.ResponseWriter) {
+	if hijacker, ok := w.(http.Hijacker); ok {
+		if conn, _, err := hijacker.Hijack(); err == nil {
+			conn.Close()
+			return
+		}
+	}
+	panic(http.ErrAbortHandler)
+}
+
+// writeJSON writes a JSON response.
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(value); err != nil {
+		writeError(w, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// writeError writes a SANtricity CallResponse error.
+func writeError(w http.ResponseWriter, status int, retcode, message string) {
+	codeType := "webservice"
+	if retcode != "" {
+		codeType = "symbol"
+	}
+	writeJSON(w, status, santricity.CallResponseError{
+		ErrorMsg:     message,
+		LocalizedMsg: message,
+		ReturnCode:   retcode,
+		CodeType:     codeType,
+	})
+}
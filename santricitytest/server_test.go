@@ -0,0 +1,310 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricitytest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	santricity "github.com/scaleoutsean/santricity-go"
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// countRequests returns the number of recorded requests with the given method and path.
+func countRequests(s *Server, method, path string) int {
+	count := 0
+	for _, r := range s.Requests() {
+		if r.Method == method && r.Path == path {
+			count++
+		}
+	}
+	return count
+}
+
+func TestVolumeLifecycle(t *testing.T) {
+	srv := NewServer(ServerConfig{})
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := santricity.NewAPIClient(ctx, srv.ClientConfig())
+	pool := srv.Pools()[0]
+
+	volume, err := client.CreateVolume(ctx, "vol1", pool.VolumeGroupRef, 1<<30, "", "ext4", "", 0, 0,
+		map[string]string{"pvc": "data"})
+	if err != nil {
+		t.Fatalf("CreateVolume: %v", err)
+	}
+	if volume.Label != "vol1" || volume.VolumeRef == "" || volume.VolumeGroupRef != pool.VolumeGroupRef {
+		t.Fatalf("CreateVolume returned %+v", volume)
+	}
+	if volume.VolumeSize != "1073741824" || volume.Capacity != volume.VolumeSize {
+		t.Errorf("volume capacity is %q (model %q), expected 1073741824", volume.VolumeSize, volume.Capacity)
+	}
+	if !client.IsRefValid(volume.VolumeRef) {
+		t.Errorf("volume ref %q is not valid", volume.VolumeRef)
+	}
+
+	byName, err := client.GetVolume(ctx, "vol1")
+	if err != nil || byName.VolumeRef != volume.VolumeRef {
+		t.Fatalf("GetVolume returned %q, %v; expected %q", byName.VolumeRef, err, volume.VolumeRef)
+	}
+
+	updated, err := client.UpdateVolume(ctx, volume.VolumeRef, santricity.VolumeUpdateRequest{Name: "vol2"})
+	if err != nil {
+		t.Fatalf("UpdateVolume: %v", err)
+	}
+	if updated.Label != "vol2" {
+		t.Errorf("renamed volume is labeled %q, expected vol2", updated.Label)
+	}
+
+	volumes, err := client.GetVolumes(ctx)
+	if err != nil {
+		t.Fatalf("GetVolumes: %v", err)
+	}
+	if len(volumes) != 1 || volumes[0].Label != "vol2" {
+		t.Errorf("GetVolumes returned %d volumes, expected vol2 only", len(volumes))
+	}
+
+	if err := client.DeleteVolume(ctx, updated); err != nil {
+		t.Fatalf("DeleteVolume: %v", err)
+	}
+	if len(srv.Volumes()) != 0 {
+		t.Errorf("server still has %d volumes after the delete", len(srv.Volumes()))
+	}
+
+	if _, err := client.GetVolumeByRef(ctx, volume.VolumeRef); !errors.Is(err, santricity.ErrNotFound) {
+		t.Errorf("GetVolumeByRef of a deleted volume returned %v, expected ErrNotFound", err)
+	}
+	if byName, err := client.GetVolume(ctx, "vol2"); err != nil || byName.VolumeRef != "" {
+		t.Errorf("GetVolume of a deleted volume returned %q, %v; expected no volume", byName.VolumeRef, err)
+	}
+}
+
+func TestSessionExpiry(t *testing.T) {
+	srv := NewServer(ServerConfig{Username: "admin", Password: "secret"})
+	defer srv.Close()
+
+	ctx := context.Background()
+	config := srv.ClientConfig()
+	config.UseSession = true
+	config.Credentials = &santricity.StaticCredentialProvider{
+		Value: santricity.Credentials{Username: "admin", Password: "secret"},
+	}
+	client := santricity.NewAPIClient(ctx, config)
+
+	if _, err := client.GetVolumes(ctx); err != nil {
+		t.Fatalf("GetVolumes: %v", err)
+	}
+	if logins := countRequests(srv, http.MethodPost, loginPath); logins != 1 {
+		t.Fatalf("client logged in %d times, expected once", logins)
+	}
+
+	// The session is reused until it expires, and then replaced by a new one
+	if _, err := client.GetVolumes(ctx); err != nil {
+		t.Fatalf("GetVolumes: %v", err)
+	}
+	srv.ExpireSessions()
+	if _, err := client.GetVolumes(ctx); err != nil {
+		t.Fatalf("GetVolumes after the session expired: %v", err)
+	}
+	if logins := countRequests(srv, http.MethodPost, loginPath); logins != 2 {
+		t.Errorf("client logged in %d times, expected twice", logins)
+	}
+}
+
+func TestSessionExpiryWithoutProvider(t *testing.T) {
+	srv := NewServer(ServerConfig{Username: "admin", Password: "secret"})
+	defer srv.Close()
+
+	ctx := context.Background()
+	config := srv.ClientConfig()
+	config.UseSession = true
+	client := santricity.NewAPIClient(ctx, config)
+
+	if _, err := client.GetVolumes(ctx); err != nil {
+		t.Fatalf("GetVolumes: %v", err)
+	}
+
+	// The client logs in again with the password of its config
+	srv.ExpireSessions()
+	if _, err := client.GetVolumes(ctx); err != nil {
+		t.Fatalf("GetVolumes after the session expired: %v", err)
+	}
+	if logins := countRequests(srv, http.MethodPost, loginPath); logins != 2 {
+		t.Errorf("client logged in %d times, expected twice", logins)
+	}
+}
+
+func TestRetryOnServiceUnavailable(t *testing.T) {
+	srv := NewServer(ServerConfig{})
+	defer srv.Close()
+
+	ctx := context.Background()
+	config := srv.ClientConfig()
+	config.RetryPolicy = &santricity.RetryPolicy{InitialInterval: time.Millisecond}
+	client := santricity.NewAPIClient(ctx, config)
+
+	srv.InjectFault(Fault{Method: http.MethodGet, Path: "/volumes", StatusCode: http.StatusServiceUnavailable, Times: 2})
+	if _, err := client.GetVolumes(ctx); err != nil {
+		t.Fatalf("GetVolumes: %v", err)
+	}
+	if attempts := countRequests(srv, http.MethodGet, "/volumes"); attempts != 3 {
+		t.Errorf("client sent %d requests, expected 3", attempts)
+	}
+
+	// Without a retry policy the first 503 is returned
+	srv.ResetRequests()
+	srv.InjectFault(Fault{Method: http.MethodGet, Path: "/volumes", StatusCode: http.StatusServiceUnavailable, Times: 1})
+	client = santricity.NewAPIClient(ctx, srv.ClientConfig())
+	if _, err := client.GetVolumes(ctx); err == nil {
+		t.Error("GetVolumes succeeded despite a 503 without a retry policy")
+	}
+	if attempts := countRequests(srv, http.MethodGet, "/volumes"); attempts != 1 {
+		t.Errorf("client sent %d requests, expected 1", attempts)
+	}
+}
+
+func TestRetryVolumeBusyRace(t *testing.T) {
+	srv := NewServer(ServerConfig{})
+	defer srv.Close()
+
+	ctx := context.Background()
+	config := srv.ClientConfig()
+	config.RetryPolicy = &santricity.RetryPolicy{InitialInterval: time.Millisecond}
+	client := santricity.NewAPIClient(ctx, config)
+	pool := srv.Pools()[0]
+
+	// A create that failed without taking effect is repeated
+	srv.InjectFault(Fault{Method: http.MethodPost, Path: "/volumes", StatusCode: http.StatusUnprocessableEntity, Times: 1})
+	if _, err := client.CreateVolume(ctx, "vol1", pool.VolumeGroupRef, 1<<30, "", "ext4", "", 0, 0, nil); err != nil {
+		t.Fatalf("CreateVolume: %v", err)
+	}
+	if creates := countRequests(srv, http.MethodPost, "/volumes"); creates != 2 {
+		t.Errorf("client sent %d create requests, expected 2", creates)
+	}
+
+	// A create that took effect is not, and the client returns the volume it created
+	srv.ResetRequests()
+	srv.InjectFault(Fault{
+		Method: http.MethodPost, Path: "/volumes", StatusCode: http.StatusUnprocessableEntity, Times: 1, ApplyFirst: true,
+	})
+	volume, err := client.CreateVolume(ctx, "vol2", pool.VolumeGroupRef, 1<<30, "", "ext4", "", 0, 0, nil)
+	if err != nil {
+		t.Fatalf("CreateVolume: %v", err)
+	}
+	if volume.Label != "vol2" {
+		t.Errorf("CreateVolume returned volume %q, expected vol2", volume.Label)
+	}
+	if creates := countRequests(srv, http.MethodPost, "/volumes"); creates != 1 {
+		t.Errorf("client sent %d create requests, expected 1", creates)
+	}
+	if len(srv.Volumes()) != 2 {
+		t.Errorf("server has %d volumes, expected 2", len(srv.Volumes()))
+	}
+
+	// The race is only verified for creates; other requests rejected with a bare 422 are not repeated
+	srv.ResetRequests()
+	srv.InjectFault(Fault{Method: http.MethodDelete, Path: "/volumes/*", StatusCode: http.StatusUnprocessableEntity})
+	_ = client.DeleteVolume(ctx, volume)
+	if deletes := countRequests(srv, http.MethodDelete, "/volumes/"+volume.VolumeRef); deletes != 1 {
+		t.Errorf("client sent %d delete requests, expected 1", deletes)
+	}
+}
+
+func TestSnapshotSchedule(t *testing.T) {
+	srv := NewServer(ServerConfig{})
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := santricity.NewAPIClient(ctx, srv.ClientConfig())
+	pool := srv.Pools()[0]
+
+	volume, err := client.CreateVolume(ctx, "vol1", pool.VolumeGroupRef, 1<<30, "", "ext4", "", 0, 0, nil)
+	if err != nil {
+		t.Fatalf("CreateVolume: %v", err)
+	}
+
+	// The schedule is created together with its snapshot group
+	group := santricity.SnapshotGroupCreateRequest{
+		BaseMappableObjectId: volume.VolumeRef, Name: "vol1_sg", RepositoryPercentage: 20, FullPolicy: "purgepit",
+	}
+	schedule, err := client.CreateSnapshotSchedule(ctx, group, santricity.SnapshotScheduleConfig{
+		StartTime: 2 * time.Hour, Location: time.UTC, Retention: 2,
+	})
+	if err != nil {
+		t.Fatalf("CreateSnapshotSchedule: %v", err)
+	}
+	if retention, err := client.GetSnapshotScheduleRetention(ctx, schedule); err != nil || retention != 2 {
+		t.Errorf("GetSnapshotScheduleRetention returned %d, %v; expected 2", retention, err)
+	}
+	if config := schedule.Config(); config.StartTime != 2*time.Hour || config.TargetRef != schedule.TargetObject {
+		t.Errorf("schedule config is %+v, expected a start time of 2h", config)
+	}
+
+	for i := 0; i < 3; i++ {
+		srv.RunSnapshotSchedules()
+	}
+	images, err := client.GetSnapshotImages(ctx)
+	if err != nil || len(images) != 2 {
+		t.Errorf("GetSnapshotImages returned %d images, %v; expected the 2 retained", len(images), err)
+	}
+
+	// The API has no endpoint that adds, changes or deletes a schedule on its own
+	config := santricity.SnapshotScheduleConfig{TargetRef: schedule.TargetObject}
+	if _, err := client.CreateSnapshotSchedule(ctx, group, config); !errors.Is(err, santricity.ErrUnsupported) {
+		t.Errorf("CreateSnapshotSchedule for an existing group returned %v, expected ErrUnsupported", err)
+	}
+	if _, err := client.UpdateSnapshotSchedule(ctx, schedule.ID, config); !errors.Is(err, santricity.ErrUnsupported) {
+		t.Errorf("UpdateSnapshotSchedule returned %v, expected ErrUnsupported", err)
+	}
+	if err := client.DeleteSnapshotSchedule(ctx, schedule.ID); !errors.Is(err, santricity.ErrUnsupported) {
+		t.Errorf("DeleteSnapshotSchedule returned %v, expected ErrUnsupported", err)
+	}
+	response, _, err := client.InvokeAPI(ctx, []byte(`{}`), http.MethodPost, "/snapshot-schedules")
+	if err != nil || response.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("POST /snapshot-schedules returned %v, %v; expected 405", response, err)
+	}
+
+	if err := client.DeleteSnapshotGroup(ctx, schedule.TargetObject); err != nil {
+		t.Fatalf("DeleteSnapshotGroup: %v", err)
+	}
+	if _, err := client.GetSnapshotSchedule(ctx, schedule.ID); !errors.Is(err, santricity.ErrNotFound) {
+		t.Errorf("GetSnapshotSchedule after the group was deleted returned %v, expected ErrNotFound", err)
+	}
+}
+
+func TestGetVolumesThinVolumeError(t *testing.T) {
+	srv := NewServer(ServerConfig{})
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := santricity.NewAPIClient(ctx, srv.ClientConfig())
+
+	// The volumes are not returned without the thin volumes among them
+	srv.InjectFault(Fault{Method: http.MethodGet, Path: "/thin-volumes", StatusCode: http.StatusInternalServerError})
+	if volumes, err := client.GetVolumes(ctx); err == nil {
+		t.Errorf("GetVolumes returned %d volumes despite a failed thin volume listing", len(volumes))
+	}
+}
+
+func TestMissingFeature(t *testing.T) {
+	srv := NewServer(ServerConfig{Features: []string{"snapshots"}})
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := santricity.NewAPIClient(ctx, srv.ClientConfig())
+	if _, err := client.Connect(ctx); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+
+	_, err := client.CreateVolumeCopyJob(ctx, v11.VolumeCopyCreateRequest{SourceID: "02000001", TargetID: "02000002"})
+	if !errors.Is(err, santricity.ErrUnsupported) {
+		t.Errorf("CreateVolumeCopyJob without the volumeCopy feature returned %v, expected ErrUnsupported", err)
+	}
+	if copies := countRequests(srv, http.MethodPost, "/volume-copy-jobs"); copies != 0 {
+		t.Errorf("client sent %d copy requests, expected none", copies)
+	}
+}
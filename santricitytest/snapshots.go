@@ -0,0 +1,956 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricitytest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	santricity "github.com/scaleoutsean/santricity-go"
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// Defaults the array applies to snapshot groups and consistency groups
+const (
+	defaultRepositoryPercent = 20
+	defaultWarnThreshold     = 75
+	defaultAutoDeleteLimit   = 32
+	defaultFullPolicy        = "purgepit"
+	defaultRollbackPriority  = "medium"
+)
+
+// createRepository creates a repository volume for a snapshot object and the concat repository that holds it.
+// The repository is sized as a percentage of the base volume, in the given pool or else the base volume's pool.
+func (s *state) createRepository(
+	base *santricity.VolumeEx, poolRef string, percent float64, objectType, objectID string,
+) (*santricity.ConcatRepositoryVolume, error) {
+
+	if percent == 0 {
+		percent = defaultRepositoryPercent
+	}
+	if poolRef == "" {
+		poolRef = base.VolumeGroupRef
+	}
+	pool := s.findPool(poolRef)
+	if pool == nil {
+		return nil, fmt.Errorf("the repository storage pool does not exist")
+	}
+
+	baseSize, _ := strconv.ParseUint(base.VolumeSize, 10, 64)
+	size := uint64(float64(baseSize) * percent / 100)
+	free, _ := strconv.ParseUint(pool.FreeSpace, 10, 64)
+	if size > free {
+		return nil, fmt.Errorf("insufficient free capacity for the repository")
+	}
+
+	return s.addRepository(pool, size, objectType, objectID), nil
+}
+
+// addRepository creates a repository volume of the given size for an object and the concat repository that
+// holds it, taking the capacity from a pool.
+func (s *state) addRepository(
+	pool *santricity.VolumeGroupEx, size uint64, objectType, objectID string,
+) *santricity.ConcatRepositoryVolume {
+
+	volume := s.addVolume(pool, fmt.Sprintf("repos_%04d", len(s.repositories)+1), size, "repositoryVolume")
+	repository := &santricity.ConcatRepositoryVolume{
+		ConcatVolRef:      s.newRef(),
+		Status:            "optimal",
+		MemberCount:       1,
+		AggregateCapacity: volume.VolumeSize,
+		MemberRefs:        []string{volume.VolumeRef},
+		BaseObjectType:    objectType,
+		BaseObjectId:      objectID,
+		Name:              volume.Label,
+		MemberNames:       volume.Label,
+	}
+	s.repositories = append(s.repositories, repository)
+	return repository
+}
+
+// deleteRepositories removes the repositories of an object together with their member volumes.
+func (s *state) deleteRepositories(objectID string) {
+	s.repositories = removeWhere(s.repositories, func(r *santricity.ConcatRepositoryVolume) bool {
+		if r.BaseObjectId != objectID {
+			return false
+		}
+		for _, ref := range r.MemberRefs {
+			if volume := s.findVolume(ref); volume != nil {
+				s.deleteVolume(volume)
+			}
+		}
+		return true
+	})
+}
+
+func (s *state) findSnapshotGroup(ref string) *santricity.SnapshotGroup {
+	for _, group := range s.snapshotGroups {
+		if group.PitGroupRef == ref {
+			return group
+		}
+	}
+	return nil
+}
+
+func (s *state) findSnapshotImage(ref string) *santricity.SnapshotImage {
+	for _, image := range s.snapshotImages {
+		if image.PitRef == ref {
+			return image
+		}
+	}
+	return nil
+}
+
+// imageInUse reports whether a snapshot volume or consistency group view is based on a snapshot image.
+func (s *state) imageInUse(image *santricity.SnapshotImage) bool {
+	for _, view := range s.snapshotVolumes {
+		if view.BasePIT == image.PitRef {
+			return true
+		}
+	}
+	for _, view := range s.cgViews {
+		if view.GroupRef == image.PitGroupRef && view.ViewSequenceNumber == image.PitSequenceNumber {
+			return true
+		}
+	}
+	return false
+}
+
+// nextSequenceNumber returns the sequence number for a new snapshot image in a snapshot or consistency group.
+func (s *state) nextSequenceNumber(groupRef string) string {
+	var last uint64
+	for _, image := range s.snapshotImages {
+		if image.PitGroupRef == groupRef {
+			if sequence, _ := strconv.ParseUint(image.PitSequenceNumber, 10, 64); sequence > last {
+				last = sequence
+			}
+		}
+	}
+	return strconv.FormatUint(last+1, 10)
+}
+
+// purgeImages deletes the oldest unused images of a group beyond its auto-delete limit.
+func (s *state) purgeImages(groupRef string, limit int) {
+	if limit <= 0 {
+		return
+	}
+	var images []*santricity.SnapshotImage
+	for _, image := range s.snapshotImages {
+		if image.PitGroupRef == groupRef {
+			images = append(images, image)
+		}
+	}
+	for excess := len(images) - limit; excess > 0; excess-- {
+		for _, image := range images {
+			if s.findSnapshotImage(image.PitRef) != nil && !s.imageInUse(image) {
+				s.snapshotImages = removeWhere(s.snapshotImages, func(i *santricity.SnapshotImage) bool {
+					return i.PitRef == image.PitRef
+				})
+				break
+			}
+		}
+	}
+	s.countImages(groupRef)
+}
+
+// countImages updates the snapshot count of a snapshot group.
+func (s *state) countImages(groupRef string) {
+	group := s.findSnapshotGroup(groupRef)
+	if group == nil {
+		return
+	}
+	group.SnapshotCount = 0
+	for _, image := range s.snapshotImages {
+		if image.PitGroupRef == groupRef {
+			group.SnapshotCount++
+		}
+	}
+}
+
+func (s *state) routeSnapshotGroups(w http.ResponseWriter, method string, parts []string, body []byte) {
+
+	if len(parts) == 0 {
+		switch method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, values(s.snapshotGroups))
+		case http.MethodPost:
+			s.createSnapshotGroup(w, body)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	group := s.findSnapshotGroup(parts[0])
+	if group == nil || len(parts) != 1 {
+		notFound(w, "invalidPitGroupRef", "snapshot group", parts[0])
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, group)
+	case http.MethodPost:
+		var request v11.SnapshotGroupUpdateRequest
+		if !decode(w, body, &request) {
+			return
+		}
+		if request.AutoDeleteLimit != nil && (*request.AutoDeleteLimit < 0 || *request.AutoDeleteLimit > 32) {
+			writeError(w, http.StatusUnprocessableEntity, "invalidAutoDeleteLimit",
+				"the auto-delete limit must be between 0 and 32")
+			return
+		}
+		if request.Name != "" {
+			group.Label = request.Name
+		}
+		if request.WarningThreshold != nil {
+			group.FullWarnThreshold = *request.WarningThreshold
+		}
+		if request.AutoDeleteLimit != nil {
+			group.AutoDeleteLimit = *request.AutoDeleteLimit
+		}
+		if request.FullPolicy != "" {
+			group.RepFullPolicy = request.FullPolicy
+		}
+		if request.RollbackPriority != "" {
+			group.RollbackPriority = request.RollbackPriority
+		}
+		writeJSON(w, http.StatusOK, group)
+	case http.MethodDelete:
+		for _, image := range s.snapshotImages {
+			if image.PitGroupRef == group.PitGroupRef && s.imageInUse(image) {
+				writeError(w, http.StatusUnprocessableEntity, "volumeHasSnapshotRelationship",
+					"a snapshot volume depends on an image of the snapshot group")
+				return
+			}
+		}
+		s.snapshotImages = removeWhere(s.snapshotImages, func(i *santricity.SnapshotImage) bool {
+			return i.PitGroupRef == group.PitGroupRef
+		})
+		s.snapshotGroups = removeWhere(s.snapshotGroups, func(g *santricity.SnapshotGroup) bool {
+			return g.PitGroupRef == group.PitGroupRef
+		})
+		s.deleteRepositories(group.PitGroupRef)
+		s.deleteSchedules(group.PitGroupRef)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *state) createSnapshotGroup(w http.ResponseWriter, body []byte) {
+
+	var request santricity.SnapshotGroupCreateRequest
+	if !decode(w, body, &request) {
+		return
+	}
+	if request.Name == "" {
+		writeError(w, http.StatusBadRequest, "invalidPitGroupLabel", "a snapshot group name is required")
+		return
+	}
+	base := s.findVolume(request.BaseMappableObjectId)
+	if base == nil {
+		writeError(w, http.StatusUnprocessableEntity, "volumeNotExist", "the base volume does not exist")
+		return
+	}
+	if request.Schedule != nil && !validSchedule(w, *request.Schedule) {
+		return
+	}
+
+	group := &santricity.SnapshotGroup{
+		PitGroupRef:       s.newRef(),
+		BaseVolume:        base.VolumeRef,
+		Label:             request.Name,
+		Status:            "optimal",
+		FullWarnThreshold: request.WarningThreshold,
+		AutoDeleteLimit:   request.AutoDeleteLimit,
+		RepFullPolicy:     request.FullPolicy,
+		RollbackPriority:  defaultRollbackPriority,
+	}
+	if group.FullWarnThreshold == 0 {
+		group.FullWarnThreshold = defaultWarnThreshold
+	}
+	if group.AutoDeleteLimit == 0 {
+		group.AutoDeleteLimit = defaultAutoDeleteLimit
+	}
+	if group.RepFullPolicy == "" {
+		group.RepFullPolicy = defaultFullPolicy
+	}
+
+	if _, err := s.createRepository(base, request.StoragePoolId, request.RepositoryPercentage, "pitGroup",
+		group.PitGroupRef); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "invalidRepositoryCapacity", err.Error())
+		return
+	}
+
+	s.snapshotGroups = append(s.snapshotGroups, group)
+	if request.Schedule != nil {
+		s.addSchedule(*request.Schedule, group.PitGroupRef)
+	}
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *state) routeSnapshotImages(w http.ResponseWriter, method string, parts []string, body []byte) {
+
+	if len(parts) == 0 {
+		switch method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, values(s.snapshotImages))
+		case http.MethodPost:
+			var request santricity.SnapshotImageCreateRequest
+			if !decode(w, body, &request) {
+				return
+			}
+			group := s.findSnapshotGroup(request.GroupId)
+			if group == nil {
+				writeError(w, http.StatusUnprocessableEntity, "invalidPitGroupRef", "the snapshot group does not exist")
+				return
+			}
+			image := s.newImage(group.PitGroupRef, group.BaseVolume, s.nextSequenceNumber(group.PitGroupRef))
+			s.purgeImages(group.PitGroupRef, group.AutoDeleteLimit)
+			writeJSON(w, http.StatusOK, image)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	image := s.findSnapshotImage(parts[0])
+	if image == nil || len(parts) != 1 {
+		notFound(w, "invalidPitRef", "snapshot image", parts[0])
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, image)
+	case http.MethodDelete:
+		if s.imageInUse(image) {
+			writeError(w, http.StatusUnprocessableEntity, "volumeHasSnapshotRelationship",
+				"a snapshot volume depends on the snapshot image")
+			return
+		}
+		s.snapshotImages = removeWhere(s.snapshotImages, func(i *santricity.SnapshotImage) bool {
+			return i.PitRef == image.PitRef
+		})
+		s.countImages(image.PitGroupRef)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// newImage creates a snapshot image in a snapshot group or consistency group.
+func (s *state) newImage(groupRef, baseVolume, sequence string) *santricity.SnapshotImage {
+	image := &santricity.SnapshotImage{
+		PitRef:            s.newRef(),
+		PitGroupRef:       groupRef,
+		Status:            "optimal",
+		PitTimestamp:      strconv.FormatInt(time.Now().Unix(), 10),
+		PitSequenceNumber: sequence,
+		BaseVol:           baseVolume,
+	}
+	s.snapshotImages = append(s.snapshotImages, image)
+	s.countImages(groupRef)
+	return image
+}
+
+func (s *state) routeSnapshotVolumes(w http.ResponseWriter, method string, parts []string, body []byte) {
+
+	if len(parts) == 0 {
+		switch method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, values(s.snapshotVolumes))
+		case http.MethodPost:
+			s.createSnapshotVolume(w, body)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	var view *santricity.SnapshotVolume
+	for _, candidate := range s.snapshotVolumes {
+		if candidate.SnapshotRef == parts[0] {
+			view = candidate
+		}
+	}
+	if view == nil || len(parts) != 1 {
+		notFound(w, "invalidPitViewRef", "snapshot volume", parts[0])
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, view)
+	case http.MethodDelete:
+		s.snapshotVolumes = removeWhere(s.snapshotVolumes, func(v *santricity.SnapshotVolume) bool {
+			return v.SnapshotRef == view.SnapshotRef
+		})
+		s.deleteRepositories(view.SnapshotRef)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *state) createSnapshotVolume(w http.ResponseWriter, body []byte) {
+
+	var request santricity.SnapshotVolumeCreateRequest
+	if !decode(w, body, &request) {
+		return
+	}
+	if request.Name == "" {
+		writeError(w, http.StatusBadRequest, "invalidPitViewLabel", "a snapshot volume name is required")
+		return
+	}
+	image := s.findSnapshotImage(request.SnapshotImageId)
+	if image == nil {
+		writeError(w, http.StatusUnprocessableEntity, "invalidPitRef", "the snapshot image does not exist")
+		return
+	}
+
+	view := &santricity.SnapshotVolume{
+		SnapshotRef: s.newRef(),
+		BaseVolume:  image.BaseVol,
+		BasePIT:     image.PitRef,
+		Label:       request.Name,
+		Status:      "optimal",
+	}
+
+	// Read-only views don't need a repository
+	if request.ViewMode == "readWrite" {
+		base := s.findVolume(image.BaseVol)
+		if base == nil {
+			writeError(w, http.StatusUnprocessableEntity, "volumeNotExist", "the base volume does not exist")
+			return
+		}
+		if _, err := s.createRepository(base, request.RepositoryPoolId, request.RepositoryPercentage, "pitView",
+			view.SnapshotRef); err != nil {
+			writeError(w, http.StatusUnprocessableEntity, "invalidRepositoryCapacity", err.Error())
+			return
+		}
+	}
+
+	s.snapshotVolumes = append(s.snapshotVolumes, view)
+	writeJSON(w, http.StatusOK, view)
+}
+
+func (s *state) routeSymbol(w http.ResponseWriter, method string, parts []string, body []byte) {
+
+	if method != http.MethodPost || len(parts) != 1 || parts[0] != "startPITRollback" {
+		notFound(w, "", "resource", strings.Join(parts, "/"))
+		return
+	}
+
+	var request santricity.SnapshotRollbackRequest
+	if !decode(w, body, &request) {
+		return
+	}
+	for _, ref := range request.PitRef {
+		if s.findSnapshotImage(ref) == nil {
+			writeError(w, http.StatusUnprocessableEntity, "invalidPitRef", "the snapshot image does not exist")
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, "ok")
+}
+
+func (s *state) routeRepositories(w http.ResponseWriter, method string, parts []string) {
+
+	if method != http.MethodGet || len(parts) == 0 || parts[0] != "concat" {
+		notFound(w, "", "resource", strings.Join(parts, "/"))
+		return
+	}
+
+	switch len(parts) {
+	case 1:
+		writeJSON(w, http.StatusOK, values(s.repositories))
+	case 2:
+		for _, repository := range s.repositories {
+			if repository.ConcatVolRef == parts[1] {
+				writeJSON(w, http.StatusOK, repository)
+				return
+			}
+		}
+		notFound(w, "invalidConcatVolRef", "repository", parts[1])
+	default:
+		notFound(w, "", "resource", strings.Join(parts, "/"))
+	}
+}
+
+func (s *state) findConsistencyGroup(ref string) *santricity.ConsistencyGroup {
+	for _, group := range s.consistencyGroups {
+		if group.ConsistencyGroupRef == ref {
+			return group
+		}
+	}
+	return nil
+}
+
+func (s *state) routeConsistencyGroups(w http.ResponseWriter, method string, parts []string, body []byte) {
+
+	if len(parts) == 0 {
+		switch method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, values(s.consistencyGroups))
+		case http.MethodPost:
+			s.createConsistencyGroup(w, body)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	group := s.findConsistencyGroup(parts[0])
+	if group == nil {
+		notFound(w, "invalidPitConsistencyGroupRef", "consistency group", parts[0])
+		return
+	}
+
+	if len(parts) == 1 {
+		switch method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, group)
+		case http.MethodPost:
+			s.updateConsistencyGroup(w, group, body)
+		case http.MethodDelete:
+			s.deleteConsistencyGroup(group)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	switch parts[1] {
+	case "member-volumes", "members":
+		s.routeMembers(w, method, group, parts[2:], body)
+	case "snapshots":
+		s.routeGroupSnapshots(w, method, group, parts[2:])
+	case "views":
+		s.routeViews(w, method, group, parts[2:], body)
+	default:
+		notFound(w, "", "resource", strings.Join(parts, "/"))
+	}
+}
+
+func (s *state) createConsistencyGroup(w http.ResponseWriter, body []byte) {
+
+	var request santricity.ConsistencyGroupCreateRequest
+	if !decode(w, body, &request) {
+		return
+	}
+	if request.Name == "" {
+		writeError(w, http.StatusBadRequest, "invalidLabel", "a consistency group name is required")
+		return
+	}
+	for _, group := range s.consistencyGroups {
+		if group.Label == request.Name {
+			writeError(w, http.StatusUnprocessableEntity, "invalidLabel",
+				"a consistency group with this name already exists")
+			return
+		}
+	}
+
+	group := &santricity.ConsistencyGroup{
+		ConsistencyGroupRef: s.newRef(),
+		Label:               request.Name,
+		RepFullPolicy:       request.RepositoryFullPolicy,
+		FullWarnThreshold:   request.FullWarnThresholdPercent,
+		AutoDeleteLimit:     request.AutoDeleteThreshold,
+		RollbackPriority:    request.RollbackPriority,
+	}
+	if group.RepFullPolicy == "" {
+		group.RepFullPolicy = defaultFullPolicy
+	}
+	if group.FullWarnThreshold == 0 {
+		group.FullWarnThreshold = defaultWarnThreshold
+	}
+	if group.AutoDeleteLimit == 0 {
+		group.AutoDeleteLimit = defaultAutoDeleteLimit
+	}
+	if group.RollbackPriority == "" {
+		group.RollbackPriority = defaultRollbackPriority
+	}
+
+	s.consistencyGroups = append(s.consistencyGroups, group)
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *state) updateConsistencyGroup(w http.ResponseWriter, group *santricity.ConsistencyGroup, body []byte) {
+
+	var request v11.ConsistencyGroupUpdateRequest
+	if !decode(w, body, &request) {
+		return
+	}
+	if request.AutoDeleteThreshold != nil && (*request.AutoDeleteThreshold < 0 || *request.AutoDeleteThreshold > 32) {
+		writeError(w, http.StatusUnprocessableEntity, "invalidAutoDeleteLimit",
+			"the auto-delete limit must be between 0 and 32")
+		return
+	}
+	if request.Name != "" {
+		for _, other := range s.consistencyGroups {
+			if other != group && other.Label == request.Name {
+				writeError(w, http.StatusUnprocessableEntity, "invalidLabel",
+					"a consistency group with this name already exists")
+				return
+			}
+		}
+		group.Label = request.Name
+	}
+	if request.FullWarnThresholdPercent != nil {
+		group.FullWarnThreshold = *request.FullWarnThresholdPercent
+	}
+	if request.AutoDeleteThreshold != nil {
+		group.AutoDeleteLimit = *request.AutoDeleteThreshold
+	}
+	if request.RepositoryFullPolicy != "" {
+		group.RepFullPolicy = request.RepositoryFullPolicy
+	}
+	if request.RollbackPriority != "" {
+		group.RollbackPriority = request.RollbackPriority
+	}
+	writeJSON(w, http.StatusOK, group)
+}
+
+// deleteConsistencyGroup removes a consistency group with its members, snapshots, views, repositories and
+// schedules.
+func (s *state) deleteConsistencyGroup(group *santricity.ConsistencyGroup) {
+	ref := group.ConsistencyGroupRef
+	s.deleteSchedules(ref)
+	s.cgViews = removeWhere(s.cgViews, func(v *santricity.ConsistencyGroupView) bool {
+		if v.GroupRef != ref {
+			return false
+		}
+		s.deleteRepositories(v.ConsistencyGroupViewRef)
+		return true
+	})
+	s.snapshotImages = removeWhere(s.snapshotImages, func(i *santricity.SnapshotImage) bool {
+		return i.PitGroupRef == ref
+	})
+	s.cgMembers = removeWhere(s.cgMembers, func(m *santricity.ConsistencyGroupMember) bool {
+		if m.ConsistencyGroupId != ref {
+			return false
+		}
+		s.deleteRepositories(ref + "/" + m.VolumeId)
+		return true
+	})
+	s.consistencyGroups = removeWhere(s.consistencyGroups, func(g *santricity.ConsistencyGroup) bool {
+		return g.ConsistencyGroupRef == ref
+	})
+}
+
+func (s *state) routeMembers(
+	w http.ResponseWriter, method string, group *santricity.ConsistencyGroup, parts []string, body []byte,
+) {
+
+	ref := group.ConsistencyGroupRef
+
+	if len(parts) == 0 {
+		switch method {
+		case http.MethodGet:
+			var members []santricity.ConsistencyGroupMember
+			for _, member := range s.cgMembers {
+				if member.ConsistencyGroupId == ref {
+					members = append(members, *member)
+				}
+			}
+			if members == nil {
+				members = []santricity.ConsistencyGroupMember{}
+			}
+			writeJSON(w, http.StatusOK, members)
+		case http.MethodPost:
+			s.addMember(w, group, body)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	var member *santricity.ConsistencyGroupMember
+	for _, candidate := range s.cgMembers {
+		if candidate.ConsistencyGroupId == ref && candidate.VolumeId == parts[0] {
+			member = candidate
+		}
+	}
+	if member == nil || len(parts) != 1 {
+		notFound(w, "volumeNotExist", "consistency group member", parts[0])
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, member)
+	case http.MethodDelete:
+		s.snapshotImages = removeWhere(s.snapshotImages, func(i *santricity.SnapshotImage) bool {
+			return i.PitGroupRef == ref && i.BaseVol == member.VolumeId
+		})
+		s.cgMembers = removeWhere(s.cgMembers, func(m *santricity.ConsistencyGroupMember) bool { return m == member })
+		s.deleteRepositories(ref + "/" + member.VolumeId)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *state) addMember(w http.ResponseWriter, group *santricity.ConsistencyGroup, body []byte) {
+
+	var request santricity.ConsistencyGroupMemberAddRequest
+	if !decode(w, body, &request) {
+		return
+	}
+	volume := s.findVolume(request.VolumeId)
+	if volume == nil {
+		writeError(w, http.StatusUnprocessableEntity, "volumeNotExist", "the volume does not exist")
+		return
+	}
+	for _, member := range s.cgMembers {
+		if member.ConsistencyGroupId == group.ConsistencyGroupRef && member.VolumeId == volume.VolumeRef {
+			writeError(w, http.StatusUnprocessableEntity, "partDupId",
+				"the volume is already a member of the consistency group")
+			return
+		}
+	}
+
+	// Member repositories are keyed by group and volume, as a volume can be a member of several groups
+	repository, err := s.createRepository(volume, request.RepositoryPoolId, request.RepositoryPercent, "pitGroup",
+		group.ConsistencyGroupRef+"/"+volume.VolumeRef)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "invalidRepositoryCapacity", err.Error())
+		return
+	}
+
+	member := &santricity.ConsistencyGroupMember{
+		ConsistencyGroupId:      group.ConsistencyGroupRef,
+		VolumeId:                volume.VolumeRef,
+		VolumeWwn:               volume.WorldWideName,
+		BaseVolumeName:          volume.Label,
+		RepositoryVolume:        repository.ConcatVolRef,
+		TotalRepositoryCapacity: repository.AggregateCapacity,
+		UsedRepositoryCapacity:  "0",
+		AutoDeleteLimit:         group.AutoDeleteLimit,
+		FullWarnThreshold:       group.FullWarnThreshold,
+	}
+	s.cgMembers = append(s.cgMembers, member)
+	s.memberGroupRefs[group.ConsistencyGroupRef+"/"+volume.VolumeRef] = s.newRef()
+	writeJSON(w, http.StatusOK, member)
+}
+
+func (s *state) routeGroupSnapshots(
+	w http.ResponseWriter, method string, group *santricity.ConsistencyGroup, parts []string,
+) {
+
+	ref := group.ConsistencyGroupRef
+
+	if len(parts) == 0 {
+		switch method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, s.groupImages(ref, ""))
+		case http.MethodPost:
+			images := s.takeGroupSnapshot(group)
+			if len(images) == 0 {
+				writeError(w, http.StatusUnprocessableEntity, "illegalParam", "the consistency group has no members")
+				return
+			}
+			writeJSON(w, http.StatusOK, images)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	images := s.groupImages(ref, parts[0])
+	if len(images) == 0 || len(parts) != 1 {
+		notFound(w, "invalidPitRef", "consistency group snapshot", parts[0])
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, images)
+	case http.MethodDelete:
+		for _, view := range s.cgViews {
+			if view.GroupRef == ref && view.ViewSequenceNumber == parts[0] {
+				writeError(w, http.StatusUnprocessableEntity, "volumeHasSnapshotRelationship",
+					"a consistency group view depends on the snapshot")
+				return
+			}
+		}
+		s.snapshotImages = removeWhere(s.snapshotImages, func(i *santricity.SnapshotImage) bool {
+			return i.PitGroupRef == ref && i.PitSequenceNumber == parts[0]
+		})
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// takeGroupSnapshot takes an image of every member of a consistency group, with the same sequence number, and
+// deletes the oldest snapshots beyond the group's auto-delete limit.
+func (s *state) takeGroupSnapshot(group *santricity.ConsistencyGroup) []santricity.SnapshotImage {
+
+	ref := group.ConsistencyGroupRef
+	sequence := s.nextSequenceNumber(ref)
+	images := []santricity.SnapshotImage{}
+	for _, member := range s.cgMembers {
+		if member.ConsistencyGroupId == ref {
+			images = append(images, *s.newImage(ref, member.VolumeId, sequence))
+		}
+	}
+	if group.AutoDeleteLimit <= 0 {
+		return images
+	}
+
+	var sequences []string
+	for _, image := range s.snapshotImages {
+		if image.PitGroupRef == ref && !slices.Contains(sequences, image.PitSequenceNumber) {
+			sequences = append(sequences, image.PitSequenceNumber)
+		}
+	}
+	excess := len(sequences) - group.AutoDeleteLimit
+	for _, oldest := range sequences {
+		if excess <= 0 {
+			break
+		}
+		if slices.ContainsFunc(s.cgViews, func(v *santricity.ConsistencyGroupView) bool {
+			return v.GroupRef == ref && v.ViewSequenceNumber == oldest
+		}) {
+			continue
+		}
+		s.snapshotImages = removeWhere(s.snapshotImages, func(i *santricity.SnapshotImage) bool {
+			return i.PitGroupRef == ref && i.PitSequenceNumber == oldest
+		})
+		excess--
+	}
+	return images
+}
+
+// groupImages returns the images of a consistency group, optionally only those with a sequence number.
+func (s *state) groupImages(groupRef, sequence string) []santricity.SnapshotImage {
+	images := []santricity.SnapshotImage{}
+	for _, image := range s.snapshotImages {
+		if image.PitGroupRef == groupRef && (sequence == "" || image.PitSequenceNumber == sequence) {
+			images = append(images, *image)
+		}
+	}
+	return images
+}
+
+func (s *state) routeViews(
+	w http.ResponseWriter, method string, group *santricity.ConsistencyGroup, parts []string, body []byte,
+) {
+
+	ref := group.ConsistencyGroupRef
+
+	if len(parts) == 0 {
+		switch method {
+		case http.MethodGet:
+			var views []santricity.ConsistencyGroupView
+			for _, view := range s.cgViews {
+				if view.GroupRef == ref {
+					views = append(views, *view)
+				}
+			}
+			if views == nil {
+				views = []santricity.ConsistencyGroupView{}
+			}
+			writeJSON(w, http.StatusOK, views)
+		case http.MethodPost:
+			s.createView(w, group, body)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	var view *santricity.ConsistencyGroupView
+	for _, candidate := range s.cgViews {
+		if candidate.GroupRef == ref && candidate.ConsistencyGroupViewRef == parts[0] {
+			view = candidate
+		}
+	}
+	if view == nil || len(parts) != 1 {
+		notFound(w, "invalidPitConsistencyGroupViewRef", "consistency group view", parts[0])
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, view)
+	case http.MethodDelete:
+		s.cgViews = removeWhere(s.cgViews, func(v *santricity.ConsistencyGroupView) bool { return v == view })
+		s.deleteRepositories(view.ConsistencyGroupViewRef)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *state) createView(w http.ResponseWriter, group *santricity.ConsistencyGroup, body []byte) {
+
+	var request santricity.ConsistencyGroupViewCreateRequest
+	if !decode(w, body, &request) {
+		return
+	}
+	if request.Name == "" {
+		writeError(w, http.StatusBadRequest, "invalidPitViewLabel", "a view name is required")
+		return
+	}
+
+	ref := group.ConsistencyGroupRef
+	sequence := ""
+	if request.PitSequenceNumber != 0 {
+		sequence = strconv.FormatInt(request.PitSequenceNumber, 10)
+	}
+	var images []*santricity.SnapshotImage
+	for _, image := range s.snapshotImages {
+		if image.PitGroupRef != ref {
+			continue
+		}
+		if (request.PitId != "" && image.PitRef == request.PitId) || (sequence != "" && image.PitSequenceNumber == sequence) {
+			sequence = image.PitSequenceNumber
+		}
+	}
+	for _, image := range s.snapshotImages {
+		if image.PitGroupRef == ref && sequence != "" && image.PitSequenceNumber == sequence {
+			images = append(images, image)
+		}
+	}
+	if len(images) == 0 {
+		writeError(w, http.StatusUnprocessableEntity, "invalidPitRef", "the consistency group snapshot does not exist")
+		return
+	}
+
+	view := &santricity.ConsistencyGroupView{
+		ConsistencyGroupViewRef: s.newRef(),
+		GroupRef:                ref,
+		Label:                   request.Name,
+		ViewTime:                strconv.FormatInt(time.Now().Unix(), 10),
+		ViewSequenceNumber:      sequence,
+		Name:                    request.Name,
+	}
+	view.Id = view.ConsistencyGroupViewRef
+
+	if request.AccessMode == "readWrite" {
+		for _, image := range images {
+			base := s.findVolume(image.BaseVol)
+			if base == nil {
+				continue
+			}
+			if _, err := s.createRepository(base, request.RepositoryPoolId, request.RepositoryPercent, "cgView",
+				view.ConsistencyGroupViewRef); err != nil {
+				s.deleteRepositories(view.ConsistencyGroupViewRef)
+				writeError(w, http.StatusUnprocessableEntity, "invalidRepositoryCapacity", err.Error())
+				return
+			}
+		}
+	}
+
+	s.cgViews = append(s.cgViews, view)
+	writeJSON(w, http.StatusOK, view)
+}
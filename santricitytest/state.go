@@ -0,0 +1,370 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricitytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	santricity "github.com/scaleoutsean/santricity-go"
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// Default capacity of the storage pool created when ServerConfig.Pools is empty
+const defaultPoolCapacity = 10 << 40
+
+// state holds the objects of the fake storage system. Its methods must be called with the Server lock held.
+type state struct {
+	config ServerConfig
+	nextID uint64
+
+	pools             []*santricity.VolumeGroupEx
+	volumes           []*santricity.VolumeEx
+	thinVolumes       []*santricity.ThinVolume
+	expansions        map[string]time.Time // Volume ref -> time the expansion completes
+	hosts             []*santricity.HostEx
+	hostGroups        []*santricity.HostGroup
+	mappings          []*santricity.LUNMapping
+	snapshotGroups    []*santricity.SnapshotGroup
+	snapshotImages    []*santricity.SnapshotImage
+	snapshotVolumes   []*santricity.SnapshotVolume
+	consistencyGroups []*santricity.ConsistencyGroup
+	cgMembers         []*santricity.ConsistencyGroupMember
+	memberGroupRefs   map[string]string // Consistency group ref + "/" + volume ref -> ref of the member's PiT group
+	cgViews           []*santricity.ConsistencyGroupView
+	snapshotSchedules []*santricity.SnapshotSchedule
+	repositories      []*santricity.ConcatRepositoryVolume
+	failures          []*santricity.Failure
+	parityJobs        []*parityJob
+	copyJobs          []*copyJob
+	auditLog          []v11.AuditLogRecord
+}
+
+func newState(config ServerConfig) *state {
+	s := &state{
+		config:          config,
+		expansions:      make(map[string]time.Time),
+		memberGroupRefs: make(map[string]string),
+	}
+
+	pools := config.Pools
+	if len(pools) == 0 {
+		pools = []santricity.VolumeGroupEx{{
+			Label:              "pool_1",
+			FreeSpace:          strconv.FormatUint(defaultPoolCapacity, 10),
+			DriveMediaType:     "ssd",
+			DrivePhysicalType:  "sas",
+			RaidLevel:          "raidDiskPool",
+			BlkSizeSupported:   []int{512, 4096},
+			BlkSizeRecommended: 512,
+		}}
+	}
+	for _, pool := range pools {
+		s.addPool(pool)
+	}
+	return s
+}
+
+// newRef returns a new unique object reference in the 40 hex digit format used by the array.
+func (s *state) newRef() string {
+	s.nextID++
+	return fmt.Sprintf("0200000060080E50%024X", s.nextID)
+}
+
+// newWWN returns a new unique world wide name.
+func (s *state) newWWN() string {
+	s.nextID++
+	return fmt.Sprintf("60080E50%024X", s.nextID)
+}
+
+// route dispatches a request for a storage system resource.
+func (s *state) route(w http.ResponseWriter, method, resourcePath string, body []byte) {
+
+	parts := strings.Split(strings.Trim(resourcePath, "/"), "/")
+
+	switch parts[0] {
+	case "storage-pools":
+		s.routePools(w, method, parts[1:])
+	case "volumes":
+		s.routeVolumes(w, method, parts[1:], body)
+	case "thin-volumes":
+		s.routeThinVolumes(w, method, parts[1:], body)
+	case "features":
+		s.routeFeatures(w, method, parts[1:])
+	case "volume-copy-jobs":
+		s.routeCopyJobs(w, method, parts[1:], body)
+	case "hosts":
+		s.routeHosts(w, method, parts[1:], body)
+	case "host-types":
+		s.routeHostTypes(w, method, parts[1:])
+	case "host-groups":
+		s.routeHostGroups(w, method, parts[1:], body)
+	case "volume-mappings":
+		s.routeMappings(w, method, parts[1:], body)
+	case "iscsi", "nvmeof":
+		s.routeTargetSettings(w, method, parts)
+	case "snapshot-groups":
+		s.routeSnapshotGroups(w, method, parts[1:], body)
+	case "snapshot-images":
+		s.routeSnapshotImages(w, method, parts[1:], body)
+	case "snapshot-volumes":
+		s.routeSnapshotVolumes(w, method, parts[1:], body)
+	case "consistency-groups":
+		s.routeConsistencyGroups(w, method, parts[1:], body)
+	case "snapshot-schedules":
+		s.routeSnapshotSchedules(w, method, parts[1:])
+	case "repositories":
+		s.routeRepositories(w, method, parts[1:])
+	case "symbol":
+		s.routeSymbol(w, method, parts[1:], body)
+	case "failures":
+		if method != http.MethodGet || len(parts) > 1 {
+			methodNotAllowed(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, values(s.failures))
+	case "graph":
+		if method != http.MethodGet || len(parts) > 1 {
+			methodNotAllowed(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, s.graph())
+	default:
+		writeError(w, http.StatusNotFound, "", "the requested resource was not found")
+	}
+}
+
+// graph returns the object graph of the array, with the objects in the lists and bundles the array uses. As on
+// the array, each consistency group member has a PiT group that holds the member's images.
+func (s *state) graph() map[string]interface{} {
+
+	pitGroups := values(s.snapshotGroups)
+	for _, member := range s.cgMembers {
+		group := santricity.SnapshotGroup{
+			PitGroupRef: s.memberGroupRefs[member.ConsistencyGroupId+"/"+member.VolumeId],
+			BaseVolume:  member.VolumeId,
+			Label:       member.BaseVolumeName,
+			Status:      "optimal",
+		}
+		group.RepositoryVolume = member.RepositoryVolume
+		group.ConsistencyGroup = true
+		group.ConsistencyGroupRef = member.ConsistencyGroupId
+		pitGroups = append(pitGroups, group)
+	}
+
+	pits := values(s.snapshotImages)
+	for i := range pits {
+		if ref, ok := s.memberGroupRefs[pits[i].PitGroupRef+"/"+pits[i].BaseVol]; ok {
+			pits[i].PitGroupRef = ref
+		}
+	}
+
+	concatVolMembers := []map[string]string{}
+	for _, repository := range s.repositories {
+		for _, ref := range repository.MemberRefs {
+			concatVolMembers = append(concatVolMembers, map[string]string{
+				"concatVolRef":     repository.ConcatVolRef,
+				"storageVolumeRef": ref,
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"sa": map[string]interface{}{
+			"saData": map[string]interface{}{"storageArrayLabel": s.config.Name},
+		},
+		"volumeGroup": values(s.pools),
+		"volume":      values(s.volumes),
+		"storagePoolBundle": map[string]interface{}{
+			"host":       values(s.hosts),
+			"cluster":    values(s.hostGroups),
+			"lunMapping": values(s.mappings),
+		},
+		"highLevelVolBundle": map[string]interface{}{
+			"pitGroup":                pitGroups,
+			"pit":                     pits,
+			"pitView":                 values(s.snapshotVolumes),
+			"pitConsistencyGroup":     values(s.consistencyGroups),
+			"pitConsistencyGroupView": values(s.cgViews),
+			"thinVolume":              values(s.thinVolumes),
+			"concatVolume":            values(s.repositories),
+			"concatVolMember":         concatVolMembers,
+		},
+	}
+}
+
+// decode parses a JSON request body, and writes a 400 response if it is invalid.
+func decode(w http.ResponseWriter, body []byte, value interface{}) bool {
+	if err := json.Unmarshal(body, value); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidRequest", fmt.Sprintf("invalid request body: %v", err))
+		return false
+	}
+	return true
+}
+
+// methodNotAllowed writes a 405 response.
+func methodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "", "method not allowed")
+}
+
+// notFound writes a 404 response for a missing object.
+func notFound(w http.ResponseWriter, retcode, kind, id string) {
+	writeError(w, http.StatusNotFound, retcode, fmt.Sprintf("%s %s not found", kind, id))
+}
+
+// sizeInBytes converts a size in the given unit to bytes.
+func sizeInBytes(size uint64, unit string) (uint64, error) {
+	multipliers := map[string]uint64{
+		"bytes": 1,
+		"b":     1,
+		"kb":    1 << 10,
+		"mb":    1 << 20,
+		"gb":    1 << 30,
+		"tb":    1 << 40,
+		"pb":    1 << 50,
+	}
+	multiplier, ok := multipliers[strings.ToLower(unit)]
+	if !ok {
+		return 0, fmt.Errorf("unsupported size unit %q", unit)
+	}
+	return size * multiplier, nil
+}
+
+// parseSize parses a JSON number or numeric string, as the array accepts both for sizes.
+func parseSize(raw json.RawMessage) (uint64, error) {
+	var text string
+	if err := json.Unmarshal(raw, &text); err != nil {
+		text = string(raw)
+	}
+	return strconv.ParseUint(strings.TrimSpace(text), 10, 64)
+}
+
+// removeWhere removes the elements matching a predicate from a slice, in place.
+func removeWhere[T any](items []*T, match func(*T) bool) []*T {
+	kept := items[:0]
+	for _, item := range items {
+		if !match(item) {
+			kept = append(kept, item)
+		}
+	}
+	for i := len(kept); i < len(items); i++ {
+		items[i] = nil
+	}
+	return kept
+}
+
+// values returns copies of the elements of a slice of pointers.
+func values[T any](items []*T) []T {
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		result = append(result, *item)
+	}
+	return result
+}
+
+// Pools returns the storage pools of the fake array.
+func (s *Server) Pools() []santricity.VolumeGroupEx {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return values(s.state.pools)
+}
+
+// AddPool adds a storage pool and returns its reference. Fields left empty get the same defaults as the
+// initial pool.
+func (s *Server) AddPool(pool santricity.VolumeGroupEx) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state.addPool(pool)
+}
+
+// Volumes returns the volumes of the fake array, including snapshot repository volumes.
+func (s *Server) Volumes() []santricity.VolumeEx {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return values(s.state.volumes)
+}
+
+// ThinVolumes returns the thin volumes of the fake array.
+func (s *Server) ThinVolumes() []santricity.ThinVolume {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return values(s.state.thinVolumes)
+}
+
+// VolumeCopyJobs returns the volume copy jobs of the fake array.
+func (s *Server) VolumeCopyJobs() []santricity.VolumeCopyJob {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	jobs := make([]santricity.VolumeCopyJob, 0, len(s.state.copyJobs))
+	for _, job := range s.state.copyJobs {
+		jobs = append(jobs, job.status())
+	}
+	return jobs
+}
+
+// Hosts returns the hosts defined on the fake array.
+func (s *Server) Hosts() []santricity.HostEx {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return values(s.state.hosts)
+}
+
+// HostGroups returns the host groups defined on the fake array.
+func (s *Server) HostGroups() []santricity.HostGroup {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return values(s.state.hostGroups)
+}
+
+// Mappings returns the LUN mappings of the fake array.
+func (s *Server) Mappings() []santricity.LUNMapping {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return values(s.state.mappings)
+}
+
+// SnapshotGroups returns the snapshot groups of the fake array.
+func (s *Server) SnapshotGroups() []santricity.SnapshotGroup {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return values(s.state.snapshotGroups)
+}
+
+// SnapshotImages returns the snapshot images of the fake array.
+func (s *Server) SnapshotImages() []santricity.SnapshotImage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return values(s.state.snapshotImages)
+}
+
+// SnapshotVolumes returns the snapshot volumes (linked clones) of the fake array.
+func (s *Server) SnapshotVolumes() []santricity.SnapshotVolume {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return values(s.state.snapshotVolumes)
+}
+
+// ConsistencyGroups returns the consistency groups of the fake array.
+func (s *Server) ConsistencyGroups() []santricity.ConsistencyGroup {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return values(s.state.consistencyGroups)
+}
+
+// AddFailure adds a failure to the list reported by the fake array, as if the Recovery Guru had found a problem.
+func (s *Server) AddFailure(failure santricity.Failure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state.failures = append(s.state.failures, &failure)
+}
+
+// ClearFailures empties the list of failures reported by the fake array.
+func (s *Server) ClearFailures() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state.failures = nil
+}
@@ -0,0 +1,313 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricitytest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	santricity "github.com/scaleoutsean/santricity-go"
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// Defaults and limits the array applies to thin volumes
+const (
+	defaultGrowthAlertThreshold = 95
+	defaultExpansionPolicy      = "automatic"
+	minThinRepositorySize       = 4 << 30
+	maxThinVirtualCapacity      = 256 << 40
+)
+
+func (s *state) findThinVolume(ref string) *santricity.ThinVolume {
+	for _, volume := range s.thinVolumes {
+		if volume.VolumeRef == ref {
+			return volume
+		}
+	}
+	return nil
+}
+
+func (s *state) routeThinVolumes(w http.ResponseWriter, method string, parts []string, body []byte) {
+
+	if len(parts) == 0 {
+		switch method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, values(s.thinVolumes))
+		case http.MethodPost:
+			s.createThinVolume(w, body)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	volume := s.findThinVolume(parts[0])
+	if volume == nil {
+		notFound(w, "volumeNotExist", "thin volume", parts[0])
+		return
+	}
+
+	if len(parts) == 2 && parts[1] == "expand" {
+		if method != http.MethodPost {
+			methodNotAllowed(w)
+			return
+		}
+		s.expandThinVolume(w, volume, body)
+		return
+	}
+	if len(parts) != 1 {
+		notFound(w, "", "resource", strings.Join(parts, "/"))
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, volume)
+
+	case http.MethodPost:
+		var request santricity.ThinVolumeUpdateRequest
+		if !decode(w, body, &request) {
+			return
+		}
+		if request.Name != "" && request.Name != volume.Label {
+			if s.findVolumeByLabel(request.Name) != nil {
+				writeError(w, http.StatusUnprocessableEntity, "invalidLabel", "a volume with this name already exists")
+				return
+			}
+			volume.Label = request.Name
+		}
+		if request.GrowthAlertThreshold != 0 {
+			if request.GrowthAlertThreshold < 10 || request.GrowthAlertThreshold > 100 {
+				writeError(w, http.StatusUnprocessableEntity, "illegalParam", "invalid growth alert threshold")
+				return
+			}
+			volume.GrowthAlertThreshold = request.GrowthAlertThreshold
+		}
+		if request.ExpansionPolicy != "" {
+			volume.ExpansionPolicy = request.ExpansionPolicy
+		}
+		if request.VolumeTags != nil {
+			volume.VolumeTags = append([]santricity.VolumeTag{}, request.VolumeTags...)
+		}
+		writeJSON(w, http.StatusOK, volume)
+
+	case http.MethodDelete:
+		if s.hasSnapshotRelationship(w, &volume.VolumeEx) || s.inCopyJob(w, &volume.VolumeEx) ||
+			s.inMirror(w, &volume.VolumeEx) {
+			return
+		}
+		s.mappings = removeWhere(s.mappings, func(m *santricity.LUNMapping) bool { return m.VolumeRef == volume.VolumeRef })
+		s.thinVolumes = removeWhere(s.thinVolumes, func(v *santricity.ThinVolume) bool {
+			return v.VolumeRef == volume.VolumeRef
+		})
+		s.deleteRepositories(volume.VolumeRef)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *state) createThinVolume(w http.ResponseWriter, body []byte) {
+
+	var request struct {
+		santricity.ThinVolumeCreateRequest
+		VirtualSize           json.RawMessage `json:"virtualSize"`
+		RepositorySize        json.RawMessage `json:"repositorySize"`
+		MaximumRepositorySize json.RawMessage `json:"maximumRepositorySize"`
+	}
+	if !decode(w, body, &request) {
+		return
+	}
+	if request.Name == "" {
+		writeError(w, http.StatusBadRequest, "invalidLabel", "a volume name is required")
+		return
+	}
+	if s.findVolumeByLabel(request.Name) != nil {
+		writeError(w, http.StatusUnprocessableEntity, "invalidLabel", "a volume with this name already exists")
+		return
+	}
+	pool := s.findPool(request.PoolID)
+	if pool == nil {
+		writeError(w, http.StatusUnprocessableEntity, "volumeGroupNotExist", "the storage pool does not exist")
+		return
+	}
+	if pool.RaidLevel != "raidDiskPool" {
+		writeError(w, http.StatusUnprocessableEntity, "invalidVolumeGroupType",
+			"thin volumes can only be created on disk pools")
+		return
+	}
+
+	sizes := make([]uint64, 3)
+	for i, raw := range []json.RawMessage{request.VirtualSize, request.RepositorySize, request.MaximumRepositorySize} {
+		size, err := parseSize(raw)
+		if err == nil {
+			size, err = sizeInBytes(size, request.SizeUnit)
+		}
+		if err != nil || size == 0 {
+			writeError(w, http.StatusBadRequest, "illegalParam", "invalid volume size")
+			return
+		}
+		sizes[i] = size
+	}
+	virtualSize, repositorySize, maximumRepositorySize := sizes[0], sizes[1], sizes[2]
+	if virtualSize > maxThinVirtualCapacity || repositorySize < minThinRepositorySize ||
+		repositorySize > maximumRepositorySize {
+		writeError(w, http.StatusUnprocessableEntity, "illegalParam", "invalid thin volume capacity")
+		return
+	}
+	if request.GrowthAlertThreshold == 0 {
+		request.GrowthAlertThreshold = defaultGrowthAlertThreshold
+	}
+	if request.ExpansionPolicy == "" {
+		request.ExpansionPolicy = defaultExpansionPolicy
+	}
+
+	free, _ := strconv.ParseUint(pool.FreeSpace, 10, 64)
+	if repositorySize > free {
+		writeError(w, http.StatusUnprocessableEntity, "illegalParam", "insufficient free capacity in the storage pool")
+		return
+	}
+
+	volume := &santricity.ThinVolume{
+		VolumeEx: santricity.VolumeEx{
+			Label:          request.Name,
+			VolumeSize:     strconv.FormatUint(virtualSize, 10),
+			VolumeRef:      s.newRef(),
+			WorldWideName:  s.newWWN(),
+			VolumeGroupRef: pool.VolumeGroupRef,
+			RaidLevel:      pool.RaidLevel,
+			BlockSize:      pool.BlkSizeRecommended,
+			Mappings:       []santricity.LUNMapping{},
+			VolumeTags:     []santricity.VolumeTag{},
+			VolumeUse:      "standardVolume",
+		},
+		ThinVolumeDetails: santricity.ThinVolumeDetails{
+			InitialProvisionedCapacity: strconv.FormatUint(repositorySize, 10),
+			CurrentProvisionedCapacity: strconv.FormatUint(repositorySize, 10),
+			ProvisionedCapacityQuota:   strconv.FormatUint(maximumRepositorySize, 10),
+			MaxVirtualCapacity:         strconv.FormatUint(maxThinVirtualCapacity, 10),
+			GrowthAlertThreshold:       request.GrowthAlertThreshold,
+			ExpansionPolicy:            request.ExpansionPolicy,
+			ReportingPolicy:            "sparse",
+		},
+	}
+	volume.ThinProvisioned = true
+	if request.VolumeTags != nil {
+		volume.VolumeTags = append([]santricity.VolumeTag{}, request.VolumeTags...)
+	}
+	volume.RepositoryRef = s.addRepository(pool, repositorySize, "thinVolume", volume.VolumeRef).ConcatVolRef
+	s.thinVolumes = append(s.thinVolumes, volume)
+	writeJSON(w, http.StatusOK, volume)
+}
+
+func (s *state) expandThinVolume(w http.ResponseWriter, volume *santricity.ThinVolume, body []byte) {
+
+	var request struct {
+		SizeUnit          string          `json:"sizeUnit"`
+		NewVirtualSize    json.RawMessage `json:"newVirtualSize"`
+		NewRepositorySize json.RawMessage `json:"newRepositorySize"`
+	}
+	if !decode(w, body, &request) {
+		return
+	}
+
+	virtualSize, _ := strconv.ParseUint(volume.VolumeSize, 10, 64)
+	repositorySize, _ := strconv.ParseUint(volume.CurrentProvisionedCapacity, 10, 64)
+	newSizes := []uint64{virtualSize, repositorySize}
+	for i, raw := range []json.RawMessage{request.NewVirtualSize, request.NewRepositorySize} {
+		if len(raw) == 0 {
+			continue
+		}
+		size, err := parseSize(raw)
+		if err == nil {
+			size, err = sizeInBytes(size, request.SizeUnit)
+		}
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalidExpansionSize", "invalid expansion size")
+			return
+		}
+		newSizes[i] = size
+	}
+	newVirtualSize, newRepositorySize := newSizes[0], newSizes[1]
+	if newVirtualSize < virtualSize || newRepositorySize < repositorySize ||
+		(newVirtualSize == virtualSize && newRepositorySize == repositorySize) {
+		writeError(w, http.StatusUnprocessableEntity, "invalidExpansionSize",
+			"the new size must be larger than the current size")
+		return
+	}
+	if newVirtualSize > maxThinVirtualCapacity {
+		writeError(w, http.StatusUnprocessableEntity, "invalidExpansionSize", "the new virtual size is too large")
+		return
+	}
+
+	if newRepositorySize > repositorySize {
+		pool := s.findPool(volume.VolumeGroupRef)
+		if pool == nil || !s.allocate(pool, newRepositorySize-repositorySize) {
+			writeError(w, http.StatusUnprocessableEntity, "invalidExpansionSize",
+				"insufficient free capacity in the storage pool")
+			return
+		}
+		s.growRepository(volume.RepositoryRef, newRepositorySize)
+		volume.CurrentProvisionedCapacity = strconv.FormatUint(newRepositorySize, 10)
+		if quota, _ := strconv.ParseUint(volume.ProvisionedCapacityQuota, 10, 64); newRepositorySize > quota {
+			volume.ProvisionedCapacityQuota = volume.CurrentProvisionedCapacity
+		}
+	}
+	volume.VolumeSize = strconv.FormatUint(newVirtualSize, 10)
+	writeJSON(w, http.StatusOK, volume)
+}
+
+// growRepository sets the capacity of a single-member repository and its member volume. The capacity must already
+// have been taken from the pool.
+func (s *state) growRepository(ref string, size uint64) {
+	for _, repository := range s.repositories {
+		if repository.ConcatVolRef != ref {
+			continue
+		}
+		repository.AggregateCapacity = strconv.FormatUint(size, 10)
+		for _, memberRef := range repository.MemberRefs {
+			if member := s.findVolume(memberRef); member != nil {
+				member.VolumeSize = repository.AggregateCapacity
+			}
+		}
+	}
+}
+
+// routeFeatures answers the feature defaults, of which only those of thin volumes and async mirroring are known.
+func (s *state) routeFeatures(w http.ResponseWriter, method string, parts []string) {
+
+	feature := strings.Join(parts, "/")
+	if feature != "defaults/thin-volume" && feature != "defaults/arvm" {
+		notFound(w, "", "resource", feature)
+		return
+	}
+	if method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+	if feature == "defaults/arvm" {
+		writeJSON(w, http.StatusOK, v11.ArvmDefaultsResponse{
+			MinRepositoryCapacityInBytes:                         strconv.FormatUint(minThinRepositorySize, 10),
+			MaxRepositoryCapacityAsPercentOfMirroredCapacity:     100,
+			DefaultRepositoryCapacityAsPercentOfMirroredCapacity: defaultMirrorRepositoryPercent,
+			DefaultRepositoryUtilizationWarnThreshold:            defaultRepositoryWarnThreshold,
+			MinSyncIntervalInMinutes:                             minSyncIntervalMinutes,
+			DefaultSyncIntervalInMinutes:                         defaultSyncIntervalMinutes,
+			DefaultSyncCompletionTimeAlertThresholdInMinutes:     defaultSyncWarnMinutes,
+			DefaultRecoveryPointAgeAlertThresholdInMinutes:       defaultRecoveryWarnMinutes,
+			MaxConnectivityTestTimeoutInSeconds:                  60,
+			MaxLinkLatencyTestIterations:                         10,
+		})
+		return
+	}
+	writeJSON(w, http.StatusOK, v11.ThinVolumeDefaultsResponse{
+		MaxProvisionedCapacityInBytes: strconv.FormatUint(maxThinVirtualCapacity, 10),
+		MinVirtualCapacityInBytes:     strconv.FormatUint(1<<30, 10),
+		MaxVirtualCapacityInBytes:     strconv.FormatUint(maxThinVirtualCapacity, 10),
+		MinAlertThreshold:             10,
+		DefaultAlertThreshold:         defaultGrowthAlertThreshold,
+	})
+}
@@ -0,0 +1,263 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricitytest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	santricity "github.com/scaleoutsean/santricity-go"
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// copyJob is a volume copy, which completes after ServerConfig.OperationDuration. The target has the content of
+// the source from the start.
+type copyJob struct {
+	santricity.VolumeCopyJob
+	started time.Time
+	until   time.Time
+	halted  bool
+}
+
+func (j *copyJob) status() santricity.VolumeCopyJob {
+
+	status := j.VolumeCopyJob
+	status.CopyStartTime = strconv.FormatInt(j.started.Unix(), 10)
+	switch {
+	case j.halted:
+		status.Status = "halted"
+	case time.Now().Before(j.until):
+		status.Status = "inProgress"
+	default:
+		status.Status = "complete"
+		status.CopyCompleteTime = strconv.FormatInt(j.until.Unix(), 10)
+	}
+	return status
+}
+
+func (j *copyJob) progress() v11.VolumeCopyProgress {
+
+	progress := v11.VolumeCopyProgress{VolumeCopyID: j.VolcopyRef, PercentComplete: 100}
+	if remaining := time.Until(j.until); !j.halted && remaining > 0 {
+		progress.PercentComplete = int(100 - 100*remaining/j.until.Sub(j.started))
+		progress.TimeToCompletion = int((remaining + time.Minute - 1) / time.Minute)
+	}
+	return progress
+}
+
+func (s *state) findCopyJob(ref string) *copyJob {
+	for _, job := range s.copyJobs {
+		if job.VolcopyRef == ref {
+			return job
+		}
+	}
+	return nil
+}
+
+// inCopyJob writes a 422 response if a volume cannot be deleted because it is the source or target of a volume
+// copy job.
+func (s *state) inCopyJob(w http.ResponseWriter, volume *santricity.VolumeEx) bool {
+	for _, job := range s.copyJobs {
+		if job.SourceVolume == volume.VolumeRef || job.TargetVolume == volume.VolumeRef {
+			writeError(w, http.StatusUnprocessableEntity, "volumeHasVolcopyRelationship",
+				"the volume is part of a volume copy job")
+			return true
+		}
+	}
+	return false
+}
+
+func (s *state) routeCopyJobs(w http.ResponseWriter, method string, parts []string, body []byte) {
+
+	if len(parts) == 0 {
+		switch method {
+		case http.MethodGet:
+			jobs := make([]santricity.VolumeCopyJob, 0, len(s.copyJobs))
+			for _, job := range s.copyJobs {
+				jobs = append(jobs, job.status())
+			}
+			writeJSON(w, http.StatusOK, jobs)
+		case http.MethodPost:
+			s.createCopyJob(w, body)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	job := s.findCopyJob(parts[0])
+	if job == nil || len(parts) != 1 {
+		notFound(w, "invalidVolumecopyref", "volume copy job", parts[0])
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, job.status())
+
+	case http.MethodPost:
+		var request v11.VolumeCopyUpdateRequest
+		if !decode(w, body, &request) {
+			return
+		}
+		if request.CopyPriority != "" {
+			if !validCopyPriority(request.CopyPriority) {
+				writeError(w, http.StatusBadRequest, "illegalParam", "invalid copy priority")
+				return
+			}
+			job.CopyPriority = request.CopyPriority
+		}
+		if request.TargetWriteProtected != nil {
+			job.IdleTargetWriteProt = *request.TargetWriteProtected
+		}
+		writeJSON(w, http.StatusOK, job.status())
+
+	case http.MethodDelete:
+		s.copyJobs = removeWhere(s.copyJobs, func(j *copyJob) bool { return j.VolcopyRef == job.VolcopyRef })
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *state) createCopyJob(w http.ResponseWriter, body []byte) {
+
+	var request v11.VolumeCopyCreateRequest
+	if !decode(w, body, &request) {
+		return
+	}
+	if request.CopyPriority == "" {
+		request.CopyPriority = "priority2"
+	}
+	if !validCopyPriority(request.CopyPriority) {
+		writeError(w, http.StatusBadRequest, "illegalParam", "invalid copy priority")
+		return
+	}
+
+	// Snapshot volumes can be copied as well; their size is that of their base volume
+	sourceRef := request.SourceID
+	for _, view := range s.snapshotVolumes {
+		if view.SnapshotRef == request.SourceID {
+			sourceRef = view.BaseVolume
+		}
+	}
+	source := s.findVolume(sourceRef)
+	target := s.findVolume(request.TargetID)
+	if source == nil || target == nil {
+		writeError(w, http.StatusUnprocessableEntity, "volumeNotExist", "the source or target volume does not exist")
+		return
+	}
+	if source.VolumeRef == target.VolumeRef {
+		writeError(w, http.StatusUnprocessableEntity, "illegalParam", "a volume cannot be copied to itself")
+		return
+	}
+	sourceSize, _ := strconv.ParseUint(source.VolumeSize, 10, 64)
+	targetSize, _ := strconv.ParseUint(target.VolumeSize, 10, 64)
+	if targetSize < sourceSize {
+		writeError(w, http.StatusUnprocessableEntity, "illegalParam",
+			"the target volume is smaller than the source volume")
+		return
+	}
+	for _, job := range s.copyJobs {
+		if job.TargetVolume == target.VolumeRef || job.SourceVolume == target.VolumeRef {
+			writeError(w, http.StatusUnprocessableEntity, "volumeHasVolcopyRelationship",
+				"the target volume is already part of a volume copy job")
+			return
+		}
+	}
+
+	job := &copyJob{started: time.Now(), until: time.Now().Add(s.config.OperationDuration)}
+	job.VolcopyRef = s.newRef()
+	job.SourceVolume = request.SourceID
+	job.TargetVolume = target.VolumeRef
+	job.CopyPriority = request.CopyPriority
+	job.ID = job.VolcopyRef
+	job.WorldWideName = s.newWWN()
+	job.BaseSourceVolumeID = source.VolumeRef
+	job.IdleTargetWriteProt = request.TargetWriteProtected == nil || *request.TargetWriteProtected
+	job.OnlineCopy = request.OnlineCopy != nil && *request.OnlineCopy
+	job.Type = "offline"
+	if job.OnlineCopy {
+		job.Type = "online"
+	}
+	s.copyJobs = append(s.copyJobs, job)
+
+	writeJSON(w, http.StatusOK, job.status())
+}
+
+// routeCopyControl serves /volume-copy-jobs-control[/{ids}], which reports progress and starts or stops jobs.
+func (s *state) routeCopyControl(w http.ResponseWriter, r *http.Request, parts []string) {
+
+	if len(parts) > 1 {
+		notFound(w, "", "resource", strings.Join(parts, "/"))
+		return
+	}
+
+	var jobs []*copyJob
+	if len(parts) == 0 {
+		jobs = s.copyJobs
+	} else {
+		for _, ref := range strings.Split(parts[0], ",") {
+			job := s.findCopyJob(ref)
+			if job == nil {
+				notFound(w, "invalidVolumecopyref", "volume copy job", ref)
+				return
+			}
+			jobs = append(jobs, job)
+		}
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if len(parts) == 1 && len(jobs) == 1 {
+			writeJSON(w, http.StatusOK, jobs[0].progress())
+			return
+		}
+		progress := make([]v11.VolumeCopyProgress, 0, len(jobs))
+		for _, job := range jobs {
+			progress = append(progress, job.progress())
+		}
+		writeJSON(w, http.StatusOK, progress)
+
+	case http.MethodPost:
+		if len(parts) == 0 {
+			methodNotAllowed(w)
+			return
+		}
+		control := r.URL.Query().Get("control")
+		if control != "start" && control != "stop" {
+			writeError(w, http.StatusBadRequest, "illegalParam", "control must be start or stop")
+			return
+		}
+		progress := make([]v11.VolumeCopyProgress, 0, len(jobs))
+		for _, job := range jobs {
+			if control == "start" {
+				if job.status().Status == "inProgress" {
+					writeError(w, http.StatusUnprocessableEntity, "copyActive", "the volume copy is in progress")
+					return
+				}
+				job.halted = false
+				job.started = time.Now()
+				job.until = job.started.Add(s.config.OperationDuration)
+			} else if job.status().Status == "inProgress" {
+				job.halted = true
+			}
+			progress = append(progress, job.progress())
+		}
+		writeJSON(w, http.StatusOK, progress)
+
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func validCopyPriority(priority string) bool {
+	switch priority {
+	case "priority0", "priority1", "priority2", "priority3", "priority4":
+		return true
+	}
+	return false
+}
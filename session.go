@@ -0,0 +1,174 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const loginPath = "/devmgr/utils/login"
+
+// logoutTimeout bounds how long Close waits for the controllers to acknowledge a logout.
+const logoutTimeout = 10 * time.Second
+
+// sessionState tracks the controllers we hold a web services session with. The session cookies themselves
+// live in the cookie jar of the client's HTTP transport.
+type sessionState struct {
+	mu       sync.Mutex
+	loggedIn map[string]bool
+}
+
+// canReauthenticate reports whether repeating a request rejected with 401 could succeed, because the client
+// holds a session that may have expired or gets its credentials from a provider that may have new ones.
+func (d Client) canReauthenticate() bool {
+	return d.config.UseSession || d.config.Credentials != nil
+}
+
+// invalidateCredentials discards the session with a controller and any credentials cached by the provider.
+func (d Client) invalidateCredentials(controller string) {
+	if d.config.Credentials != nil {
+		d.config.Credentials.Invalidate()
+	}
+	d.invalidateSession(controller)
+}
+
+// ensureSession logs in to a controller unless the client already holds a session with it.
+func (d Client) ensureSession(ctx context.Context, controller string, creds Credentials) error {
+
+	d.session.mu.Lock()
+	defer d.session.mu.Unlock()
+
+	if d.session.loggedIn[controller] {
+		return nil
+	}
+	if err := d.login(ctx, controller, creds); err != nil {
+		return err
+	}
+	d.session.loggedIn[controller] = true
+	return nil
+}
+
+// invalidateSession forgets the session with a controller, so that the next request logs in again.
+func (d Client) invalidateSession(controller string) {
+	d.session.mu.Lock()
+	defer d.session.mu.Unlock()
+	delete(d.session.loggedIn, controller)
+}
+
+// login authenticates against a controller's web services and stores the returned session cookie.
+func (d Client) login(ctx context.Context, controller string, creds Credentials) error {
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":     "login",
+			"Type":       "Client",
+			"Controller": controller,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> login")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< login")
+	}
+
+	jsonBody, err := json.Marshal(LoginRequest{
+		UserID:   creds.Username,
+		Password: creds.Password,
+	})
+	if err != nil {
+		return fmt.Errorf("could not marshal JSON request: %v", err)
+	}
+
+	url := fmt.Sprintf("https://%s:%d%s", controller, d.config.ApiPort, loginPath)
+	request, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("X-Request-ID", requestID(ctx))
+	d.injectTraceContext(ctx, request)
+
+	if d.config.DebugTraceFlags["api"] {
+		LogHTTPRequest(request, []byte("<suppressed>"))
+	}
+
+	response, err := d.httpClient.Do(request)
+	if err != nil {
+		return &TransportError{
+			Controller: controller,
+			RequestID:  request.Header.Get("X-Request-ID"),
+			Err:        err,
+		}
+	}
+	responseBody, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return &TransportError{
+			Controller: controller,
+			RequestID:  request.Header.Get("X-Request-ID"),
+			Err:        err,
+		}
+	}
+
+	if d.config.DebugTraceFlags["api"] {
+		LogHTTPResponse(ctx, response, []byte("<suppressed>"))
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return d.newAPIError(response, responseBody, "could not log in to controller %s", controller)
+	}
+
+	Logc(ctx).WithField("controller", controller).Debug("Logged in to web services.")
+	return nil
+}
+
+// logout ends the session with a controller.
+func (d Client) logout(ctx context.Context, controller string) error {
+
+	url := fmt.Sprintf("https://%s:%d%s", controller, d.config.ApiPort, loginPath)
+	request, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+
+	response, err := d.httpClient.Do(request)
+	if err != nil {
+		return &TransportError{Controller: controller, Err: err}
+	}
+	responseBody, _ := io.ReadAll(response.Body)
+	response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusUnauthorized:
+		// Unauthorized means the session had expired already
+		return nil
+	default:
+		return d.newAPIError(response, responseBody, "could not log out of controller %s", controller)
+	}
+}
+
+// logoutAll ends every open session. Errors are collected rather than aborting, so that one unreachable
+// controller does not leave sessions open on the others.
+func (d Client) logoutAll(ctx context.Context) error {
+
+	d.session.mu.Lock()
+	defer d.session.mu.Unlock()
+
+	var errs []error
+	for controller := range d.session.loggedIn {
+		if err := d.logout(ctx, controller); err != nil {
+			errs = append(errs, err)
+		}
+		delete(d.session.loggedIn, controller)
+	}
+	return errors.Join(errs...)
+}
@@ -6,10 +6,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+
+	"github.com/scaleoutsean/santricity-go/models/v11"
 )
 
 // CreateSnapshotGroup creates a new snapshot group for a volume.
 func (c *Client) CreateSnapshotGroup(ctx context.Context, request SnapshotGroupCreateRequest) (*SnapshotGroup, error) {
+	ctx, span := c.startSpan(ctx, "CreateSnapshotGroup")
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/snapshot-groups
 	// Swagger ID: newSnapshotGroup
 
@@ -29,7 +34,7 @@ func (c *Client) CreateSnapshotGroup(ctx context.Context, request SnapshotGroupC
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
-		return nil, fmt.Errorf("failed to create snapshot group: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, c.newAPIError(resp, responseBody, "failed to create snapshot group")
 	}
 
 	var group SnapshotGroup
@@ -42,6 +47,9 @@ func (c *Client) CreateSnapshotGroup(ctx context.Context, request SnapshotGroupC
 
 // CreateSnapshotImage creates a new snapshot (PiT) in an existing Snapshot Group.
 func (c *Client) CreateSnapshotImage(ctx context.Context, request SnapshotImageCreateRequest) (*SnapshotImage, error) {
+	ctx, span := c.startSpan(ctx, "CreateSnapshotImage")
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/snapshot-images
 
 	if _, err := c.Connect(ctx); err != nil {
@@ -60,7 +68,7 @@ func (c *Client) CreateSnapshotImage(ctx context.Context, request SnapshotImageC
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
-		return nil, fmt.Errorf("failed to create snapshot image: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, c.newAPIError(resp, responseBody, "failed to create snapshot image")
 	}
 
 	var image SnapshotImage
@@ -73,6 +81,9 @@ func (c *Client) CreateSnapshotImage(ctx context.Context, request SnapshotImageC
 
 // CreateSnapshotVolume creates a new Snapshot Volume (Linked Clone).
 func (c *Client) CreateSnapshotVolume(ctx context.Context, request SnapshotVolumeCreateRequest) (*SnapshotVolume, error) {
+	ctx, span := c.startSpan(ctx, "CreateSnapshotVolume")
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/snapshot-volumes
 
 	if _, err := c.Connect(ctx); err != nil {
@@ -91,7 +102,7 @@ func (c *Client) CreateSnapshotVolume(ctx context.Context, request SnapshotVolum
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
-		return nil, fmt.Errorf("failed to create snapshot volume: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, c.newAPIError(resp, responseBody, "failed to create snapshot volume")
 	}
 
 	var volume SnapshotVolume
@@ -106,6 +117,9 @@ func (c *Client) CreateSnapshotVolume(ctx context.Context, request SnapshotVolum
 // RollbackSnapshotImage initiates a rollback of a volume to a specific Snapshot Image (PiT).
 // CAUTION: This overwrites the base volume with the snapshot data.
 func (c *Client) RollbackSnapshotImage(ctx context.Context, imageRef string) error {
+	ctx, span := c.startSpan(ctx, "RollbackSnapshotImage", AttributeSnapshotImageRef.String(imageRef))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/symbol/startPITRollback
 	// This uses the legacy Symbol API proxy endpoint.
 
@@ -132,7 +146,7 @@ func (c *Client) RollbackSnapshotImage(ctx context.Context, imageRef string) err
 	// But standard REST semantic is 200/204.
 	// We need to check if response code is success.
 	if resp.StatusCode != 200 && resp.StatusCode != 204 {
-		return fmt.Errorf("failed to start rollback: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return c.newAPIError(resp, responseBody, "failed to start rollback")
 	}
 
 	// We can trust the user to monitor progress via GetVolume -> underlying Action status
@@ -141,6 +155,9 @@ func (c *Client) RollbackSnapshotImage(ctx context.Context, imageRef string) err
 
 // DeleteSnapshotGroup deletes a snapshot group.
 func (c *Client) DeleteSnapshotGroup(ctx context.Context, id string) error {
+	ctx, span := c.startSpan(ctx, "DeleteSnapshotGroup", AttributeObjectRef.String(id))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/snapshot-groups/{id}
 
 	if _, err := c.Connect(ctx); err != nil {
@@ -154,13 +171,16 @@ func (c *Client) DeleteSnapshotGroup(ctx context.Context, id string) error {
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 204 {
-		return fmt.Errorf("failed to delete snapshot group: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return c.newAPIError(resp, responseBody, "failed to delete snapshot group")
 	}
 	return nil
 }
 
 // DeleteSnapshotImage deletes a snapshot image (PiT).
 func (c *Client) DeleteSnapshotImage(ctx context.Context, id string) error {
+	ctx, span := c.startSpan(ctx, "DeleteSnapshotImage", AttributeObjectRef.String(id))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/snapshot-images/{id}
 
 	if _, err := c.Connect(ctx); err != nil {
@@ -174,13 +194,16 @@ func (c *Client) DeleteSnapshotImage(ctx context.Context, id string) error {
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 204 {
-		return fmt.Errorf("failed to delete snapshot image: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return c.newAPIError(resp, responseBody, "failed to delete snapshot image")
 	}
 	return nil
 }
 
 // DeleteSnapshotVolume deletes a snapshot volume (linked clone).
 func (c *Client) DeleteSnapshotVolume(ctx context.Context, id string) error {
+	ctx, span := c.startSpan(ctx, "DeleteSnapshotVolume", AttributeObjectRef.String(id))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/snapshot-volumes/{id}
 
 	if _, err := c.Connect(ctx); err != nil {
@@ -194,13 +217,17 @@ func (c *Client) DeleteSnapshotVolume(ctx context.Context, id string) error {
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 204 {
-		return fmt.Errorf("failed to delete snapshot volume: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return c.newAPIError(resp, responseBody, "failed to delete snapshot volume")
 	}
 	return nil
 }
 
 // GetSnapshotGroup returns a snapshot group by ID.
+// The returned error matches ErrNotFound if the object does not exist.
 func (c *Client) GetSnapshotGroup(ctx context.Context, id string) (*SnapshotGroup, error) {
+	ctx, span := c.startSpan(ctx, "GetSnapshotGroup", AttributeObjectRef.String(id))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/snapshot-groups/{id}
 	if _, err := c.Connect(ctx); err != nil {
 		return nil, err
@@ -212,12 +239,43 @@ func (c *Client) GetSnapshotGroup(ctx context.Context, id string) (*SnapshotGrou
 		return nil, err
 	}
 
-	if resp.StatusCode == 404 {
-		return nil, nil // Not found
+	if resp.StatusCode != 200 {
+		return nil, c.newAPIError(resp, responseBody, "failed to get snapshot group")
+	}
+
+	var group SnapshotGroup
+	err = json.Unmarshal(responseBody, &group)
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// UpdateSnapshotGroup changes the name, thresholds or policies of a snapshot group.
+func (c *Client) UpdateSnapshotGroup(ctx context.Context, id string, request v11.SnapshotGroupUpdateRequest) (*SnapshotGroup, error) {
+	ctx, span := c.startSpan(ctx, "UpdateSnapshotGroup", AttributeObjectRef.String(id))
+	defer span.End()
+
+	// Endpoint: /storage-systems/{system-id}/snapshot-groups/{id}
+	// Swagger ID: update-SnapshotGroup
+
+	if _, err := c.Connect(ctx); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/snapshot-groups/%s", id)
+
+	jsonBody, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, responseBody, err := c.InvokeAPI(ctx, jsonBody, "POST", path)
+	if err != nil {
+		return nil, err
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get snapshot group: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, c.newAPIError(resp, responseBody, "failed to update snapshot group")
 	}
 
 	var group SnapshotGroup
@@ -229,7 +287,11 @@ func (c *Client) GetSnapshotGroup(ctx context.Context, id string) (*SnapshotGrou
 }
 
 // GetSnapshotImage returns a snapshot image (PiT) by ID.
+// The returned error matches ErrNotFound if the object does not exist.
 func (c *Client) GetSnapshotImage(ctx context.Context, id string) (*SnapshotImage, error) {
+	ctx, span := c.startSpan(ctx, "GetSnapshotImage", AttributeObjectRef.String(id))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/snapshot-images/{id}
 	if _, err := c.Connect(ctx); err != nil {
 		return nil, err
@@ -241,12 +303,8 @@ func (c *Client) GetSnapshotImage(ctx context.Context, id string) (*SnapshotImag
 		return nil, err
 	}
 
-	if resp.StatusCode == 404 {
-		return nil, nil // Not found
-	}
-
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get snapshot image: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, c.newAPIError(resp, responseBody, "failed to get snapshot image")
 	}
 
 	var image SnapshotImage
@@ -258,7 +316,11 @@ func (c *Client) GetSnapshotImage(ctx context.Context, id string) (*SnapshotImag
 }
 
 // GetSnapshotVolume returns a snapshot volume (linked clone) by ID.
+// The returned error matches ErrNotFound if the object does not exist.
 func (c *Client) GetSnapshotVolume(ctx context.Context, id string) (*SnapshotVolume, error) {
+	ctx, span := c.startSpan(ctx, "GetSnapshotVolume", AttributeObjectRef.String(id))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/snapshot-volumes/{id}
 	if _, err := c.Connect(ctx); err != nil {
 		return nil, err
@@ -270,12 +332,8 @@ func (c *Client) GetSnapshotVolume(ctx context.Context, id string) (*SnapshotVol
 		return nil, err
 	}
 
-	if resp.StatusCode == 404 {
-		return nil, nil // Not found
-	}
-
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get snapshot volume: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, c.newAPIError(resp, responseBody, "failed to get snapshot volume")
 	}
 
 	var volume SnapshotVolume
@@ -288,6 +346,9 @@ func (c *Client) GetSnapshotVolume(ctx context.Context, id string) (*SnapshotVol
 
 // CreateConsistencyGroup creates a new Consistency Group (Snapshot Group Container).
 func (c *Client) CreateConsistencyGroup(ctx context.Context, request ConsistencyGroupCreateRequest) (*ConsistencyGroup, error) {
+	ctx, span := c.startSpan(ctx, "CreateConsistencyGroup")
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/consistency-groups
 	if _, err := c.Connect(ctx); err != nil {
 		return nil, err
@@ -305,7 +366,7 @@ func (c *Client) CreateConsistencyGroup(ctx context.Context, request Consistency
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
-		return nil, fmt.Errorf("failed to create consistency group: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, c.newAPIError(resp, responseBody, "failed to create consistency group")
 	}
 
 	var group ConsistencyGroup
@@ -318,6 +379,9 @@ func (c *Client) CreateConsistencyGroup(ctx context.Context, request Consistency
 
 // AddConsistencyGroupMember adds a volume member to a Consistency Group.
 func (c *Client) AddConsistencyGroupMember(ctx context.Context, cgID string, request ConsistencyGroupMemberAddRequest) (*ConsistencyGroupMember, error) {
+	ctx, span := c.startSpan(ctx, "AddConsistencyGroupMember", AttributeConsistencyGroupRef.String(cgID))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/consistency-groups/{cg-id}/member-volumes
 	if _, err := c.Connect(ctx); err != nil {
 		return nil, err
@@ -335,7 +399,7 @@ func (c *Client) AddConsistencyGroupMember(ctx context.Context, cgID string, req
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
-		return nil, fmt.Errorf("failed to add consistency group member: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, c.newAPIError(resp, responseBody, "failed to add consistency group member")
 	}
 
 	var member ConsistencyGroupMember
@@ -348,6 +412,9 @@ func (c *Client) AddConsistencyGroupMember(ctx context.Context, cgID string, req
 
 // CreateConsistencyGroupSnapshot creates a new snapshot (PiT) for a Consistency Group.
 func (c *Client) CreateConsistencyGroupSnapshot(ctx context.Context, cgID string) ([]SnapshotImage, error) {
+	ctx, span := c.startSpan(ctx, "CreateConsistencyGroupSnapshot", AttributeConsistencyGroupRef.String(cgID))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/consistency-groups/{cg-id}/snapshots
 
 	if _, err := c.Connect(ctx); err != nil {
@@ -362,7 +429,7 @@ func (c *Client) CreateConsistencyGroupSnapshot(ctx context.Context, cgID string
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
-		return nil, fmt.Errorf("failed to create consistency group snapshot: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, c.newAPIError(resp, responseBody, "failed to create consistency group snapshot")
 	}
 
 	var images []SnapshotImage
@@ -375,6 +442,9 @@ func (c *Client) CreateConsistencyGroupSnapshot(ctx context.Context, cgID string
 
 // CreateConsistencyGroupView creates a new Consistency Group View (Linked Clone).
 func (c *Client) CreateConsistencyGroupView(ctx context.Context, cgID string, request ConsistencyGroupViewCreateRequest) (*ConsistencyGroupView, error) {
+	ctx, span := c.startSpan(ctx, "CreateConsistencyGroupView", AttributeConsistencyGroupRef.String(cgID))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/consistency-groups/{cg-id}/views
 
 	if _, err := c.Connect(ctx); err != nil {
@@ -393,7 +463,7 @@ func (c *Client) CreateConsistencyGroupView(ctx context.Context, cgID string, re
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
-		return nil, fmt.Errorf("failed to create consistency group view: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, c.newAPIError(resp, responseBody, "failed to create consistency group view")
 	}
 
 	var view ConsistencyGroupView
@@ -406,6 +476,9 @@ func (c *Client) CreateConsistencyGroupView(ctx context.Context, cgID string, re
 
 // DeleteConsistencyGroup deletes a Consistency Group.
 func (c *Client) DeleteConsistencyGroup(ctx context.Context, cgID string) error {
+	ctx, span := c.startSpan(ctx, "DeleteConsistencyGroup", AttributeConsistencyGroupRef.String(cgID))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/consistency-groups/{id}
 	if _, err := c.Connect(ctx); err != nil {
 		return err
@@ -418,7 +491,7 @@ func (c *Client) DeleteConsistencyGroup(ctx context.Context, cgID string) error
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 204 {
-		return fmt.Errorf("failed to delete consistency group: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return c.newAPIError(resp, responseBody, "failed to delete consistency group")
 	}
 
 	return nil
@@ -426,6 +499,9 @@ func (c *Client) DeleteConsistencyGroup(ctx context.Context, cgID string) error
 
 // RemoveConsistencyGroupMember removes a volume from a Consistency Group.
 func (c *Client) RemoveConsistencyGroupMember(ctx context.Context, cgID string, memberVolumeID string) error {
+	ctx, span := c.startSpan(ctx, "RemoveConsistencyGroupMember", AttributeConsistencyGroupRef.String(cgID), AttributeVolumeRef.String(memberVolumeID))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/consistency-groups/{id}/members/{memberId}
 	if _, err := c.Connect(ctx); err != nil {
 		return err
@@ -438,7 +514,7 @@ func (c *Client) RemoveConsistencyGroupMember(ctx context.Context, cgID string,
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 204 {
-		return fmt.Errorf("failed to remove consistency group member: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return c.newAPIError(resp, responseBody, "failed to remove consistency group member")
 	}
 
 	return nil
@@ -446,6 +522,9 @@ func (c *Client) RemoveConsistencyGroupMember(ctx context.Context, cgID string,
 
 // GetConcatRepositoryVolumes returns a list of all concatenated repository volumes.
 func (c *Client) GetConcatRepositoryVolumes(ctx context.Context) ([]ConcatRepositoryVolume, error) {
+	ctx, span := c.startSpan(ctx, "GetConcatRepositoryVolumes")
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/repositories/concat
 	if _, err := c.Connect(ctx); err != nil {
 		return nil, err
@@ -458,7 +537,7 @@ func (c *Client) GetConcatRepositoryVolumes(ctx context.Context) ([]ConcatReposi
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get concat repository volumes: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, c.newAPIError(resp, responseBody, "failed to get concat repository volumes")
 	}
 
 	var concatVols []ConcatRepositoryVolume
@@ -472,6 +551,9 @@ func (c *Client) GetConcatRepositoryVolumes(ctx context.Context) ([]ConcatReposi
 
 // GetConcatRepositoryVolume returns a specific concatenated repository volume by ID.
 func (c *Client) GetConcatRepositoryVolume(ctx context.Context, id string) (*ConcatRepositoryVolume, error) {
+	ctx, span := c.startSpan(ctx, "GetConcatRepositoryVolume", AttributeObjectRef.String(id))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/repositories/concat/{id}
 	if _, err := c.Connect(ctx); err != nil {
 		return nil, err
@@ -484,7 +566,7 @@ func (c *Client) GetConcatRepositoryVolume(ctx context.Context, id string) (*Con
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get concat repository volume: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, c.newAPIError(resp, responseBody, "failed to get concat repository volume")
 	}
 
 	var concatVol ConcatRepositoryVolume
@@ -498,6 +580,9 @@ func (c *Client) GetConcatRepositoryVolume(ctx context.Context, id string) (*Con
 
 // DeleteConsistencyGroupView deletes a Consistency Group View.
 func (c *Client) DeleteConsistencyGroupView(ctx context.Context, cgID string, viewID string) error {
+	ctx, span := c.startSpan(ctx, "DeleteConsistencyGroupView", AttributeConsistencyGroupRef.String(cgID), AttributeObjectRef.String(viewID))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/consistency-groups/{cg-id}/views/{viewId}
 	if _, err := c.Connect(ctx); err != nil {
 		return err
@@ -510,14 +595,18 @@ func (c *Client) DeleteConsistencyGroupView(ctx context.Context, cgID string, vi
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 204 {
-		return fmt.Errorf("failed to delete consistency group view: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return c.newAPIError(resp, responseBody, "failed to delete consistency group view")
 	}
 
 	return nil
 }
 
 // GetConsistencyGroup returns a specific Consistency Group by ID.
+// The returned error matches ErrNotFound if the object does not exist.
 func (c *Client) GetConsistencyGroup(ctx context.Context, id string) (*ConsistencyGroup, error) {
+	ctx, span := c.startSpan(ctx, "GetConsistencyGroup", AttributeObjectRef.String(id))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/consistency-groups/{id}
 	if _, err := c.Connect(ctx); err != nil {
 		return nil, err
@@ -529,12 +618,44 @@ func (c *Client) GetConsistencyGroup(ctx context.Context, id string) (*Consisten
 		return nil, err
 	}
 
-	if resp.StatusCode == 404 {
-		return nil, nil // Return nil, nil for Terraform ReadContext missing check
+	if resp.StatusCode != 200 {
+		return nil, c.newAPIError(resp, responseBody, "failed to get consistency group")
+	}
+
+	var group ConsistencyGroup
+	err = json.Unmarshal(responseBody, &group)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// UpdateConsistencyGroup changes the name, thresholds or policies of a Consistency Group.
+func (c *Client) UpdateConsistencyGroup(ctx context.Context, id string, request v11.ConsistencyGroupUpdateRequest) (*ConsistencyGroup, error) {
+	ctx, span := c.startSpan(ctx, "UpdateConsistencyGroup", AttributeConsistencyGroupRef.String(id))
+	defer span.End()
+
+	// Endpoint: /storage-systems/{system-id}/consistency-groups/{id}
+	// Swagger ID: update-ConsistencyGroup
+
+	if _, err := c.Connect(ctx); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/consistency-groups/%s", id)
+
+	jsonBody, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, responseBody, err := c.InvokeAPI(ctx, jsonBody, "POST", path)
+	if err != nil {
+		return nil, err
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get consistency group: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, c.newAPIError(resp, responseBody, "failed to update consistency group")
 	}
 
 	var group ConsistencyGroup
@@ -542,12 +663,15 @@ func (c *Client) GetConsistencyGroup(ctx context.Context, id string) (*Consisten
 	if err != nil {
 		return nil, err
 	}
-
 	return &group, nil
 }
 
 // GetConsistencyGroupMember returns a specific volume member of a Consistency Group.
+// The returned error matches ErrNotFound if the object does not exist.
 func (c *Client) GetConsistencyGroupMember(ctx context.Context, cgID string, volumeID string) (*ConsistencyGroupMember, error) {
+	ctx, span := c.startSpan(ctx, "GetConsistencyGroupMember", AttributeConsistencyGroupRef.String(cgID))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/consistency-groups/{cg-id}/member-volumes/{volumeRef}
 	if _, err := c.Connect(ctx); err != nil {
 		return nil, err
@@ -559,12 +683,8 @@ func (c *Client) GetConsistencyGroupMember(ctx context.Context, cgID string, vol
 		return nil, err
 	}
 
-	if resp.StatusCode == 404 {
-		return nil, nil
-	}
-
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get consistency group member: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, c.newAPIError(resp, responseBody, "failed to get consistency group member")
 	}
 
 	var member ConsistencyGroupMember
@@ -577,7 +697,11 @@ func (c *Client) GetConsistencyGroupMember(ctx context.Context, cgID string, vol
 }
 
 // GetConsistencyGroupSnapshot returns snapshots of a given sequence number for a Consistency Group.
+// The returned error matches ErrNotFound if the object does not exist.
 func (c *Client) GetConsistencyGroupSnapshot(ctx context.Context, cgID string, sequenceNumber string) ([]SnapshotImage, error) {
+	ctx, span := c.startSpan(ctx, "GetConsistencyGroupSnapshot", AttributeConsistencyGroupRef.String(cgID))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/consistency-groups/{cg-id}/snapshots/{sequenceNumber}
 	if _, err := c.Connect(ctx); err != nil {
 		return nil, err
@@ -589,12 +713,8 @@ func (c *Client) GetConsistencyGroupSnapshot(ctx context.Context, cgID string, s
 		return nil, err
 	}
 
-	if resp.StatusCode == 404 {
-		return nil, nil
-	}
-
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get consistency group snapshot: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, c.newAPIError(resp, responseBody, "failed to get consistency group snapshot")
 	}
 
 	var images []SnapshotImage
@@ -608,6 +728,9 @@ func (c *Client) GetConsistencyGroupSnapshot(ctx context.Context, cgID string, s
 
 // DeleteConsistencyGroupSnapshot deletes consistency group snapshots associated with a sequence number.
 func (c *Client) DeleteConsistencyGroupSnapshot(ctx context.Context, cgID string, sequenceNumber string) error {
+	ctx, span := c.startSpan(ctx, "DeleteConsistencyGroupSnapshot", AttributeConsistencyGroupRef.String(cgID))
+	defer span.End()
+
 	if _, err := c.Connect(ctx); err != nil {
 		return err
 	}
@@ -619,14 +742,18 @@ func (c *Client) DeleteConsistencyGroupSnapshot(ctx context.Context, cgID string
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 204 {
-		return fmt.Errorf("failed to delete consistency group snapshot: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return c.newAPIError(resp, responseBody, "failed to delete consistency group snapshot")
 	}
 
 	return nil
 }
 
 // GetConsistencyGroupView returns a specific Consistency Group View by ID.
+// The returned error matches ErrNotFound if the object does not exist.
 func (c *Client) GetConsistencyGroupView(ctx context.Context, cgID string, viewID string) (*ConsistencyGroupView, error) {
+	ctx, span := c.startSpan(ctx, "GetConsistencyGroupView", AttributeConsistencyGroupRef.String(cgID), AttributeObjectRef.String(viewID))
+	defer span.End()
+
 	// Endpoint: /storage-systems/{system-id}/consistency-groups/{cg-id}/views/{viewId}
 	if _, err := c.Connect(ctx); err != nil {
 		return nil, err
@@ -638,12 +765,8 @@ func (c *Client) GetConsistencyGroupView(ctx context.Context, cgID string, viewI
 		return nil, err
 	}
 
-	if resp.StatusCode == 404 {
-		return nil, nil
-	}
-
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get consistency group view: status %d, body: %s", resp.StatusCode, string(responseBody))
+		return nil, c.newAPIError(resp, responseBody, "failed to get consistency group view")
 	}
 
 	var view ConsistencyGroupView
@@ -2,6 +2,8 @@
 
 package santricity
 
+import "github.com/scaleoutsean/santricity-go/models/v11"
+
 // SnapshotGroupCreateRequest is the payload for creating a Snapshot Group
 type SnapshotGroupCreateRequest struct {
 	BaseMappableObjectId string  `json:"baseMappableObjectId"` // The Ref of the volume/resource to snapshot
@@ -11,6 +13,9 @@ type SnapshotGroupCreateRequest struct {
 	AutoDeleteLimit      int     `json:"autoDeleteLimit"`
 	FullPolicy           string  `json:"fullPolicy"`              // "purgepit" (auto-delete oldest) or "failbasewrites"
 	StoragePoolId        string  `json:"storagePoolId,omitempty"` // Optional: Pool to create repository on
+
+	// Optional: schedule on which the array takes snapshot images of the group, as set by CreateSnapshotSchedule
+	Schedule *v11.ScheduleCreateRequest `json:"schedule,omitempty"`
 }
 
 // SnapshotImageCreateRequest is the payload for creating a Snapshot Image (Instant Snapshot)
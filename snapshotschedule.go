@@ -0,0 +1,419 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// Snapshot schedules (/snapshot-schedules) let the array take the snapshot images of a snapshot group or
+// consistency group itself, daily or on some days of the week, at a time of day in a time zone of their own. The
+// array keeps as many images as the auto-delete limit of the group, deleting the oldest when a new one is taken,
+// so the limit is the retention of the schedule. The API only reads schedules; a schedule is created together
+// with its snapshot group, and deleted with it.
+
+// Recurrences of a snapshot schedule
+const (
+	SnapshotScheduleDaily  = "daily"
+	SnapshotScheduleWeekly = "weekly"
+)
+
+// Schedule actions, by the kind of object a schedule takes snapshot images of
+const (
+	scheduleActionSnapshotGroup    = "newpit"
+	scheduleActionConsistencyGroup = "newcgpit"
+)
+
+// maxAutoDeleteLimit is the highest auto-delete limit of a snapshot group or consistency group.
+const maxAutoDeleteLimit = 32
+
+// SnapshotScheduleConfig describes when a snapshot schedule takes snapshot images. Zero values select the
+// defaults.
+type SnapshotScheduleConfig struct {
+	TargetRef  string         // Snapshot group or consistency group, as read back by SnapshotSchedule.Config
+	Recurrence string         // SnapshotScheduleDaily (default) or SnapshotScheduleWeekly
+	DaysOfWeek []time.Weekday // Days a weekly schedule runs on
+	StartTime  time.Duration  // Time of day of the first image, e.g. 2*time.Hour for 02:00
+	Location   *time.Location // Time zone of StartTime, StartDate and EndDate; default time.Local
+	StartDate  time.Time      // The schedule takes no images before this time; default now
+	EndDate    time.Time      // Nor after this one; zero for no end
+
+	// More than one image a day, Interval apart in whole minutes starting at StartTime. All images of a day must
+	// be taken before midnight.
+	TimesPerDay int
+	Interval    time.Duration
+
+	// Number of images to keep, from 1 to 32, set as the auto-delete limit of the new snapshot group. 0 keeps the
+	// limit of the group create request. SnapshotSchedule.Config does not return it; see
+	// GetSnapshotScheduleRetention.
+	Retention int
+}
+
+// GetSnapshotSchedules returns the snapshot schedules of the array.
+func (d Client) GetSnapshotSchedules(ctx context.Context) ([]SnapshotSchedule, error) {
+	ctx, span := d.startSpan(ctx, "GetSnapshotSchedules")
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "GetSnapshotSchedules",
+			"Type":   "Client",
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetSnapshotSchedules")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetSnapshotSchedules")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/snapshot-schedules")
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "failed to read snapshot schedules")
+	}
+
+	schedules := make([]SnapshotSchedule, 0)
+	if err := json.Unmarshal(responseBody, &schedules); err != nil {
+		return nil, fmt.Errorf("could not parse snapshot schedules: %s; %v", string(responseBody), err)
+	}
+	return schedules, nil
+}
+
+// GetSnapshotSchedule returns a snapshot schedule by ID.
+// The returned error matches ErrNotFound if the schedule does not exist.
+func (d Client) GetSnapshotSchedule(ctx context.Context, id string) (*SnapshotSchedule, error) {
+	ctx, span := d.startSpan(ctx, "GetSnapshotSchedule", AttributeObjectRef.String(id))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "GetSnapshotSchedule",
+			"Type":   "Client",
+			"id":     id,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetSnapshotSchedule")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetSnapshotSchedule")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/snapshot-schedules/"+id)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "failed to read snapshot schedule %s", id)
+	}
+
+	return parseSnapshotSchedule(responseBody)
+}
+
+// CreateSnapshotSchedule creates a snapshot group for a volume together with a schedule that takes its snapshot
+// images, and returns the schedule. The array only creates schedules with a new snapshot group (the schedule of
+// SnapshotGroupCreateRequest); the retention of the config, if given, becomes the auto-delete limit of the group.
+// Schedules for existing snapshot groups and for consistency groups are not supported: the config must not have a
+// TargetRef.
+func (d Client) CreateSnapshotSchedule(
+	ctx context.Context, group SnapshotGroupCreateRequest, config SnapshotScheduleConfig,
+) (*SnapshotSchedule, error) {
+	ctx, span := d.startSpan(ctx, "CreateSnapshotSchedule", AttributeVolumeRef.String(group.BaseMappableObjectId))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "CreateSnapshotSchedule",
+			"Type":   "Client",
+			"group":  group.Name,
+			"config": config,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> CreateSnapshotSchedule")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< CreateSnapshotSchedule")
+	}
+
+	if config.TargetRef != "" {
+		return nil, d.unsupportedError(fmt.Sprintf("adding a snapshot schedule to the existing group %s",
+			config.TargetRef))
+	}
+	request, err := config.request(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	request.Action = scheduleActionSnapshotGroup
+	group.Schedule = &request
+	if config.Retention != 0 {
+		group.AutoDeleteLimit = config.Retention
+	}
+
+	created, err := d.CreateSnapshotGroup(ctx, group)
+	if err != nil {
+		return nil, fmt.Errorf("could not create snapshot group %s with a schedule; %w", group.Name, err)
+	}
+
+	schedules, err := d.GetSnapshotSchedules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("created snapshot group %s, but could not read its schedule; %w", created.PitGroupRef,
+			err)
+	}
+	for _, schedule := range schedules {
+		if schedule.TargetObject == created.PitGroupRef {
+			Logc(ctx).WithFields(log.Fields{
+				"ScheduleID": schedule.ID,
+				"Target":     schedule.TargetObject,
+				"Recurrence": request.ScheduleMethod,
+			}).Info("Created snapshot schedule.")
+			return &schedule, nil
+		}
+	}
+	return nil, fmt.Errorf("created snapshot group %s, but the array reports no schedule for it: %w",
+		created.PitGroupRef, ErrNotFound)
+}
+
+// UpdateSnapshotSchedule would replace the timing of a snapshot schedule. The web services API has no endpoint that
+// changes a schedule, so it returns an UnsupportedError without contacting the array; delete the snapshot group
+// and create it again with CreateSnapshotSchedule instead.
+func (d Client) UpdateSnapshotSchedule(ctx context.Context, id string, config SnapshotScheduleConfig) (
+	*SnapshotSchedule, error,
+) {
+	return nil, d.unsupportedError(fmt.Sprintf("changing snapshot schedule %s", id))
+}
+
+// DeleteSnapshotSchedule would delete a snapshot schedule. The web services API has no endpoint that deletes a
+// schedule, so it returns an UnsupportedError without contacting the array; the array deletes the schedule of a
+// snapshot group with the group (DeleteSnapshotGroup).
+func (d Client) DeleteSnapshotSchedule(ctx context.Context, id string) error {
+	return d.unsupportedError(fmt.Sprintf("deleting snapshot schedule %s", id))
+}
+
+// GetSnapshotScheduleRetention returns the retention of a snapshot schedule, which is the auto-delete limit of the
+// snapshot group or consistency group it takes images of.
+func (d Client) GetSnapshotScheduleRetention(ctx context.Context, schedule *SnapshotSchedule) (int, error) {
+	if schedule.Action == scheduleActionConsistencyGroup {
+		group, err := d.GetConsistencyGroup(ctx, schedule.TargetObject)
+		if err != nil {
+			return 0, err
+		}
+		return group.AutoDeleteLimit, nil
+	}
+	group, err := d.GetSnapshotGroup(ctx, schedule.TargetObject)
+	if err != nil {
+		return 0, err
+	}
+	return group.AutoDeleteLimit, nil
+}
+
+// request validates the config and converts it to a schedule request without action.
+func (c SnapshotScheduleConfig) request(now time.Time) (v11.ScheduleCreateRequest, error) {
+
+	var request v11.ScheduleCreateRequest
+
+	switch c.Recurrence {
+	case "", SnapshotScheduleDaily:
+		if len(c.DaysOfWeek) > 0 {
+			return request, fmt.Errorf("days of the week only apply to weekly snapshot schedules: %w",
+				ErrInvalidArgument)
+		}
+		request.ScheduleMethod = SnapshotScheduleDaily
+	case SnapshotScheduleWeekly:
+		if len(c.DaysOfWeek) == 0 {
+			return request, fmt.Errorf("a weekly snapshot schedule needs days of the week: %w", ErrInvalidArgument)
+		}
+		request.ScheduleMethod = SnapshotScheduleWeekly
+		for _, day := range c.DaysOfWeek {
+			if day < time.Sunday || day > time.Saturday {
+				return request, fmt.Errorf("invalid day of the week %d: %w", day, ErrInvalidArgument)
+			}
+			request.DaysOfWeek = append(request.DaysOfWeek, strings.ToLower(day.String()))
+		}
+	default:
+		return request, fmt.Errorf("the recurrence %q is neither daily nor weekly: %w", c.Recurrence,
+			ErrInvalidArgument)
+	}
+
+	if c.StartTime < 0 || c.StartTime >= 24*time.Hour {
+		return request, fmt.Errorf("the start time %v is not a time of day: %w", c.StartTime, ErrInvalidArgument)
+	}
+	times := max(c.TimesPerDay, 1)
+	if times > 1 {
+		if c.Interval < time.Minute || c.Interval%time.Minute != 0 {
+			return request, fmt.Errorf("the interval %v between snapshot images is not a number of minutes: %w",
+				c.Interval, ErrInvalidArgument)
+		}
+		if c.StartTime+time.Duration(times-1)*c.Interval >= 24*time.Hour {
+			return request, fmt.Errorf("%d snapshot images %v apart from %v do not fit in a day: %w", times,
+				c.Interval, c.StartTime, ErrInvalidArgument)
+		}
+		request.DailySchedule.EveryNMinutes = int(c.Interval / time.Minute)
+	}
+	request.DailySchedule.TimeOfDay = int(c.StartTime / time.Second)
+	request.DailySchedule.TimesPerDay = times
+
+	if c.Retention < 0 || c.Retention > maxAutoDeleteLimit {
+		return request, fmt.Errorf("the retention %d is not between 1 and %d snapshot images: %w", c.Retention,
+			maxAutoDeleteLimit, ErrInvalidArgument)
+	}
+
+	location := c.Location
+	if location == nil {
+		location = time.Local
+	}
+	start := c.StartDate
+	if start.IsZero() {
+		start = now
+	}
+	request.StartDate = localEpoch(start.In(location))
+	request.EndDate = "0"
+	if !c.EndDate.IsZero() {
+		if !c.EndDate.After(start) {
+			return request, fmt.Errorf("the snapshot schedule ends before it starts: %w", ErrInvalidArgument)
+		}
+		request.EndDate = localEpoch(c.EndDate.In(location))
+	}
+	request.Timezone = scheduleTimeZone(location, start)
+	request.TargetObject = c.TargetRef
+
+	return request, nil
+}
+
+// Config returns the timing of the schedule as a SnapshotScheduleConfig, without the retention.
+func (s SnapshotSchedule) Config() SnapshotScheduleConfig {
+
+	calendar := s.Schedule.Calendar
+	config := SnapshotScheduleConfig{
+		TargetRef:  s.TargetObject,
+		Recurrence: calendar.ScheduleMethod,
+		Location:   scheduleLocation(s.Schedule.Timezone),
+	}
+
+	daily := calendar.Daily.DailySchedule
+	if calendar.ScheduleMethod == SnapshotScheduleWeekly {
+		daily = calendar.Weekly.DailySchedule
+		for _, name := range calendar.Weekly.DaysOfWeek {
+			for day := time.Sunday; day <= time.Saturday; day++ {
+				if strings.EqualFold(name, day.String()) {
+					config.DaysOfWeek = append(config.DaysOfWeek, day)
+				}
+			}
+		}
+	}
+	config.StartTime = time.Duration(daily.TimeOfDay) * time.Second
+	if daily.TimesPerDay > 1 {
+		config.TimesPerDay = daily.TimesPerDay
+		config.Interval = time.Duration(daily.EveryNMinutes) * time.Minute
+	}
+
+	config.StartDate = fromLocalEpoch(s.Schedule.StartDate, config.Location)
+	if s.Schedule.Recurrence.RecurrenceType == "endDate" {
+		config.EndDate = fromLocalEpoch(s.Schedule.Recurrence.RecurrenceEndDate, config.Location)
+	}
+	return config
+}
+
+// NextRun returns when the schedule takes its next snapshot image, or the zero time if it will not.
+func (s SnapshotSchedule) NextRun() time.Time {
+	return fromLocalEpoch(s.NextRunTime, scheduleLocation(s.Schedule.Timezone))
+}
+
+// LastRun returns when the schedule took its last snapshot image, or the zero time if it has not yet.
+func (s SnapshotSchedule) LastRun() time.Time {
+	return fromLocalEpoch(s.LastRunTime, scheduleLocation(s.Schedule.Timezone))
+}
+
+func parseSnapshotSchedule(responseBody []byte) (*SnapshotSchedule, error) {
+	var schedule SnapshotSchedule
+	if err := json.Unmarshal(responseBody, &schedule); err != nil {
+		return nil, fmt.Errorf("could not parse snapshot schedule: %s; %v", string(responseBody), err)
+	}
+	return &schedule, nil
+}
+
+// localEpoch returns a time as the array counts schedule times: seconds since 1970 in the time zone of the
+// schedule rather than in UTC.
+func localEpoch(t time.Time) string {
+	_, offset := t.Zone()
+	return strconv.FormatInt(t.Unix()+int64(offset), 10)
+}
+
+// fromLocalEpoch is the reverse of localEpoch. It returns the zero time for 0 or an invalid value.
+func fromLocalEpoch(value string, location *time.Location) time.Time {
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds <= 0 {
+		return time.Time{}
+	}
+	wall := time.Unix(seconds, 0).UTC()
+	return time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, location)
+}
+
+// scheduleLocation returns the location of a schedule time zone: the IANA zone of its label if known here, and a
+// fixed zone with its standard offset otherwise.
+func scheduleLocation(zone v11.TimeZoneDescription) *time.Location {
+	if zone.TzLabel != "" {
+		if location, err := time.LoadLocation(zone.TzLabel); err == nil {
+			return location
+		}
+	}
+	return time.FixedZone(zone.TzLabel, zone.TzOffset)
+}
+
+// scheduleTimeZone describes a location to the array. The array has no time zone database, so daylight saving
+// time is described by the transitions in the year of the given time, as rules in the style of
+// java.util.SimpleTimeZone: the nth (or, with -1, the last) weekday of a month, at a wall clock time.
+func scheduleTimeZone(location *time.Location, at time.Time) v11.TimeZoneDescription {
+
+	year := at.In(location).Year()
+	_, januaryOffset := time.Date(year, time.January, 1, 0, 0, 0, 0, location).Zone()
+	_, julyOffset := time.Date(year, time.July, 1, 0, 0, 0, 0, location).Zone()
+
+	zone := v11.TimeZoneDescription{TzLabel: location.String(), TzOffset: min(januaryOffset, julyOffset)}
+	if januaryOffset == julyOffset {
+		return zone
+	}
+	zone.DstAdjust = max(januaryOffset, julyOffset) - zone.TzOffset
+
+	// Find the transitions by the day, then by the minute
+	for day := 1; day <= 366; day++ {
+		start := time.Date(year, time.January, day, 0, 0, 0, 0, location)
+		if start.Year() != year {
+			break
+		}
+		end := start.Add(24 * time.Hour)
+		_, startOffset := start.Zone()
+		if _, endOffset := end.Zone(); startOffset == endOffset {
+			continue
+		}
+		for t := start; t.Before(end); t = t.Add(time.Minute) {
+			if _, offset := t.Add(time.Minute).Zone(); offset != startOffset {
+				rule := dstRule(t.Add(time.Minute), startOffset)
+				if offset > startOffset {
+					zone.DstStart = rule
+				} else {
+					zone.DstEnd = rule
+				}
+				break
+			}
+		}
+	}
+	return zone
+}
+
+// dstRule describes a daylight saving time transition by the wall clock time before it.
+func dstRule(transition time.Time, offsetBefore int) v11.DaylightSavingsTime {
+	wall := transition.UTC().Add(time.Duration(offsetBefore) * time.Second)
+	week := (wall.Day()-1)/7 + 1
+	if wall.AddDate(0, 0, 7).Month() != wall.Month() {
+		week = -1
+	}
+	return v11.DaylightSavingsTime{
+		Time:       wall.Hour()*3600 + wall.Minute()*60,
+		ClockMode:  "wallClockTime",
+		Month:      int(wall.Month()),
+		DayOfMonth: week,
+		DayOfWeek:  int(wall.Weekday()) + 1,
+	}
+}
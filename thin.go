@@ -0,0 +1,370 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// Thin volumes live on disk pools and are managed through their own resource (/thin-volumes). Their capacity is
+// virtual: the pool only provides the capacity of a repository, which the array expands as data is written, up
+// to a quota. GetVolumes, GetVolumeByRef, DeleteVolume, ResizeVolume and ExpandVolume handle thin and thick
+// volumes alike, with VolumeEx.ThinProvisioned telling them apart; the methods below expose the thin provisioning
+// details and settings.
+
+// GetThinVolumes returns the thin volumes of the array.
+func (d Client) GetThinVolumes(ctx context.Context) ([]ThinVolume, error) {
+	ctx, span := d.startSpan(ctx, "GetThinVolumes")
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "GetThinVolumes",
+			"Type":   "Client",
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetThinVolumes")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetThinVolumes")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/thin-volumes")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read thin volumes: %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "failed to read thin volumes")
+	}
+
+	volumes := make([]ThinVolume, 0)
+	if err := json.Unmarshal(responseBody, &volumes); err != nil {
+		return nil, fmt.Errorf("could not parse thin volume data: %s. %v", string(responseBody), err)
+	}
+	for i := range volumes {
+		volumes[i].ThinProvisioned = true
+	}
+
+	Logc(ctx).WithField("Count", len(volumes)).Debug("Read thin volumes.")
+
+	return volumes, nil
+}
+
+// GetThinVolumeByRef gets a single thin volume from the array.
+func (d Client) GetThinVolumeByRef(ctx context.Context, volumeRef string) (ThinVolume, error) {
+	ctx, span := d.startSpan(ctx, "GetThinVolumeByRef", AttributeVolumeRef.String(volumeRef))
+	defer span.End()
+
+	if volumeRef == "" {
+		return ThinVolume{}, fmt.Errorf("volumeRef cannot be empty: %w", ErrInvalidArgument)
+	}
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":    "GetThinVolumeByRef",
+			"Type":      "Client",
+			"volumeRef": volumeRef,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetThinVolumeByRef")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetThinVolumeByRef")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/thin-volumes/"+volumeRef)
+	if err != nil {
+		return ThinVolume{}, fmt.Errorf("API invocation failed. %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return ThinVolume{}, d.newAPIError(response, responseBody, "failed to read thin volume")
+	}
+
+	return parseThinVolume(responseBody)
+}
+
+// CreateThinVolume creates a thin volume of the given virtual size in bytes on a disk pool, tagged like the
+// volumes of CreateVolume, and it returns the resulting ThinVolume structure. Pools that are not disk pools
+// cannot hold thin volumes; an UnsupportedError is returned for them without contacting the array.
+func (d Client) CreateThinVolume(
+	ctx context.Context, name string, poolRef string, size uint64, fstype string, options ThinVolumeOptions,
+	extraTags map[string]string,
+) (ThinVolume, error) {
+	ctx, span := d.startSpan(ctx, "CreateThinVolume", AttributeName.String(name), AttributePoolRef.String(poolRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":  "CreateThinVolume",
+			"Type":    "Client",
+			"name":    name,
+			"poolRef": poolRef,
+			"size":    size,
+			"options": options,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> CreateThinVolume")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< CreateThinVolume")
+	}
+
+	// Ensure that we do not exceed the maximum allowed volume length
+	if len(name) > maxNameLength {
+		return ThinVolume{}, fmt.Errorf("the volume name %v exceeds the maximum length of %d characters: %w", name,
+			maxNameLength, ErrInvalidArgument)
+	}
+
+	pool, err := d.GetVolumePoolByRef(ctx, poolRef)
+	if err != nil {
+		return ThinVolume{}, err
+	}
+	if pool.RaidLevel != "raidDiskPool" {
+		return ThinVolume{}, d.unsupportedError(fmt.Sprintf("thin provisioning on volume group %s", pool.Label))
+	}
+
+	repositorySize := options.RepositorySize
+	if repositorySize == 0 {
+		repositorySize = DefaultThinRepositorySize
+	}
+	maximumRepositorySize := options.MaximumRepositorySize
+	if maximumRepositorySize == 0 {
+		maximumRepositorySize = max(size, repositorySize)
+	}
+	if repositorySize > maximumRepositorySize {
+		return ThinVolume{}, fmt.Errorf("the repository size %d exceeds the maximum repository size %d: %w",
+			repositorySize, maximumRepositorySize, ErrInvalidArgument)
+	}
+
+	tags := d.newVolumeTags(fstype, extraTags)
+
+	request := ThinVolumeCreateRequest{
+		PoolID:                poolRef,
+		Name:                  name,
+		SizeUnit:              "bytes",
+		VirtualSize:           strconv.FormatUint(size, 10),
+		RepositorySize:        strconv.FormatUint(repositorySize, 10),
+		MaximumRepositorySize: strconv.FormatUint(maximumRepositorySize, 10),
+		GrowthAlertThreshold:  options.GrowthAlertThreshold,
+		ExpansionPolicy:       options.ExpansionPolicy,
+		VolumeTags:            tags,
+	}
+
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return ThinVolume{}, fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/thin-volumes")
+	if err != nil {
+		return ThinVolume{}, fmt.Errorf("API invocation failed. %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return ThinVolume{}, d.newAPIError(response, responseBody, "could not create thin volume %s", name)
+	}
+
+	vol, err := parseThinVolume(responseBody)
+	if err != nil {
+		return ThinVolume{}, err
+	}
+
+	Logc(ctx).WithFields(log.Fields{
+		"Name":           vol.Label,
+		"VolumeRef":      vol.VolumeRef,
+		"VolumeGroupRef": vol.VolumeGroupRef,
+		"RepositoryRef":  vol.RepositoryRef,
+	}).Debug("Created thin volume.")
+
+	return vol, nil
+}
+
+// UpdateThinVolume updates a thin volume configuration, such as its name, tags, growth alert threshold or
+// repository expansion policy.
+func (d Client) UpdateThinVolume(
+	ctx context.Context, volumeRef string, request ThinVolumeUpdateRequest,
+) (ThinVolume, error) {
+	ctx, span := d.startSpan(ctx, "UpdateThinVolume", AttributeVolumeRef.String(volumeRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":    "UpdateThinVolume",
+			"Type":      "Client",
+			"volumeRef": volumeRef,
+			"request":   request,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> UpdateThinVolume")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< UpdateThinVolume")
+	}
+
+	if request.GrowthAlertThreshold < 0 || request.GrowthAlertThreshold > 100 {
+		return ThinVolume{}, fmt.Errorf("the growth alert threshold %d is not a percentage: %w",
+			request.GrowthAlertThreshold, ErrInvalidArgument)
+	}
+
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return ThinVolume{}, fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/thin-volumes/"+volumeRef)
+	if err != nil {
+		return ThinVolume{}, fmt.Errorf("API invocation failed. %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return ThinVolume{}, d.newAPIError(response, responseBody, "could not update thin volume %s", volumeRef)
+	}
+
+	vol, err := parseThinVolume(responseBody)
+	if err != nil {
+		return ThinVolume{}, err
+	}
+
+	Logc(ctx).WithFields(log.Fields{
+		"Name":      vol.Label,
+		"VolumeRef": vol.VolumeRef,
+	}).Debug("Updated thin volume.")
+
+	return vol, nil
+}
+
+// SetThinVolumeAlertThreshold sets the repository utilization, in percent, at which the array raises an alert
+// for a thin volume.
+func (d Client) SetThinVolumeAlertThreshold(ctx context.Context, volumeRef string, percent int) (ThinVolume, error) {
+	if percent <= 0 {
+		return ThinVolume{}, fmt.Errorf("the growth alert threshold %d is not a percentage: %w", percent,
+			ErrInvalidArgument)
+	}
+	return d.UpdateThinVolume(ctx, volumeRef, ThinVolumeUpdateRequest{GrowthAlertThreshold: percent})
+}
+
+// ExpandThinVolume expands a thin volume. newVirtualSize is the new capacity reported to hosts and
+// newRepositorySize the new capacity of its repository, both in bytes; zero leaves either unchanged. The
+// repository only needs to be expanded by hand if the volume's expansion policy is "manual".
+func (d Client) ExpandThinVolume(
+	ctx context.Context, volumeRef string, newVirtualSize, newRepositorySize uint64,
+) error {
+	ctx, span := d.startSpan(ctx, "ExpandThinVolume", AttributeVolumeRef.String(volumeRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":            "ExpandThinVolume",
+			"Type":              "Client",
+			"ref":               volumeRef,
+			"newVirtualSize":    newVirtualSize,
+			"newRepositorySize": newRepositorySize,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> ExpandThinVolume")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< ExpandThinVolume")
+	}
+
+	if newVirtualSize == 0 && newRepositorySize == 0 {
+		return fmt.Errorf("a new virtual or repository size is required: %w", ErrInvalidArgument)
+	}
+
+	request := ThinVolumeExpansionRequest{SizeUnit: "bytes"}
+	if newVirtualSize != 0 {
+		request.NewVirtualSize = strconv.FormatUint(newVirtualSize, 10)
+	}
+	if newRepositorySize != 0 {
+		request.NewRepositorySize = strconv.FormatUint(newRepositorySize, 10)
+	}
+
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/thin-volumes/"+volumeRef+"/expand")
+	if err != nil {
+		return fmt.Errorf("API invocation failed. %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusAccepted {
+		return d.newAPIError(response, responseBody, "could not expand thin volume %s", volumeRef)
+	}
+
+	return nil
+}
+
+// GetThinVolumeDefaults returns the array's limits and defaults for thin volumes, such as the smallest and
+// largest virtual capacity and the default growth alert threshold.
+func (d Client) GetThinVolumeDefaults(ctx context.Context) (*v11.ThinVolumeDefaultsResponse, error) {
+	ctx, span := d.startSpan(ctx, "GetThinVolumeDefaults")
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "GetThinVolumeDefaults",
+			"Type":   "Client",
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetThinVolumeDefaults")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetThinVolumeDefaults")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/features/defaults/thin-volume")
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "failed to read thin volume defaults")
+	}
+
+	var defaults v11.ThinVolumeDefaultsResponse
+	if err := json.Unmarshal(responseBody, &defaults); err != nil {
+		return nil, fmt.Errorf("could not parse thin volume defaults: %s. %v", string(responseBody), err)
+	}
+	return &defaults, nil
+}
+
+// parseThinVolume parses a thin volume returned by the array.
+func parseThinVolume(responseBody []byte) (ThinVolume, error) {
+	var vol ThinVolume
+	if err := json.Unmarshal(responseBody, &vol); err != nil {
+		return ThinVolume{}, fmt.Errorf("could not parse thin volume data: %s. %v", string(responseBody), err)
+	}
+	vol.ThinProvisioned = true
+	return vol, nil
+}
+
+// listThinVolumes returns the thin volumes for the volume list of GetVolumes. Arrays and proxies that do not
+// know the thin volume resource have none, and neither do arrays without disk pools, which are not asked.
+func (d Client) listThinVolumes(ctx context.Context) ([]VolumeEx, error) {
+
+	if capabilities := d.capabilities.Load(); capabilities != nil &&
+		!capabilities.HasProductCapability("capabilityDiskPools") {
+		return nil, nil
+	}
+
+	thinVolumes, err := d.GetThinVolumes(ctx)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	volumes := make([]VolumeEx, 0, len(thinVolumes))
+	for _, thinVolume := range thinVolumes {
+		volumes = append(volumes, thinVolume.VolumeEx)
+	}
+	return volumes, nil
+}
+
+// getThinVolumeByRef reads a volume from the thin volume resource, for GetVolumeByRef. It returns false if the
+// array has no thin volume with the ref either.
+func (d Client) getThinVolumeByRef(ctx context.Context, volumeRef string) (VolumeEx, bool, error) {
+
+	thinVolume, err := d.GetThinVolumeByRef(ctx, volumeRef)
+	if errors.Is(err, ErrNotFound) {
+		return VolumeEx{}, false, nil
+	} else if err != nil {
+		return VolumeEx{}, false, err
+	}
+	return thinVolume.VolumeEx, true, nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// tracerName is the instrumentation scope of the spans created by the client.
+const tracerName = "github.com/scaleoutsean/santricity-go"
+
+// Span attribute keys
+const (
+	AttributeArrayID    = attribute.Key("santricity.array_id")
+	AttributeController = attribute.Key("santricity.controller")
+	AttributeAttempt    = attribute.Key("santricity.attempt")
+	AttributeFailover   = attribute.Key("santricity.failover")
+	AttributeRequestID  = attribute.Key("santricity.request_id")
+	AttributePath       = attribute.Key("santricity.path")
+
+	AttributeArraySelector       = attribute.Key("santricity.array_selector")
+	AttributeName                = attribute.Key("santricity.name")
+	AttributeObjectRef           = attribute.Key("santricity.object_ref")
+	AttributeVolumeRef           = attribute.Key("santricity.volume_ref")
+	AttributePoolRef             = attribute.Key("santricity.pool_ref")
+	AttributeHostRef             = attribute.Key("santricity.host_ref")
+	AttributeHostGroupRef        = attribute.Key("santricity.host_group_ref")
+	AttributeSnapshotImageRef    = attribute.Key("santricity.snapshot_image_ref")
+	AttributeConsistencyGroupRef = attribute.Key("santricity.consistency_group_ref")
+	AttributePortID              = attribute.Key("santricity.port_id")
+	AttributeJobID               = attribute.Key("santricity.job_id")
+	AttributeMirrorGroupRef      = attribute.Key("santricity.mirror_group_ref")
+	AttributeMirrorRef           = attribute.Key("santricity.mirror_ref")
+)
+
+// tracer returns the tracer for the client's spans. Without a ClientConfig.TracerProvider the spans are not
+// recorded, but the trace context of the caller is still passed on to the array.
+func (d Client) tracer() trace.Tracer {
+	provider := d.config.TracerProvider
+	if provider == nil {
+		provider = noop.NewTracerProvider()
+	}
+	return provider.Tracer(tracerName)
+}
+
+// propagator returns the propagator used to pass the trace context to the array, W3C trace context by default.
+func (d Client) propagator() propagation.TextMapPropagator {
+	if d.config.Propagator != nil {
+		return d.config.Propagator
+	}
+	return propagation.TraceContext{}
+}
+
+// startSpan starts the span of a high-level client method and names the operation of the API calls it makes,
+// unless an outer method did so. The attributes should identify the objects the method works on.
+func (d Client) startSpan(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs, AttributeArrayID.String(d.arrayID()))
+	ctx = withOperation(ctx, method)
+	return d.tracer().Start(ctx, "santricity."+method,
+		trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(attrs...))
+}
+
+// startAttemptSpan starts the span of one HTTP request sent to a controller.
+func (d Client) startAttemptSpan(
+	ctx context.Context, method, arrayID, resourcePath, controller string, attempt int, failover bool,
+) (context.Context, trace.Span) {
+	return d.tracer().Start(ctx, "HTTP "+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", method),
+			attribute.String("server.address", controller),
+			attribute.Int("server.port", d.config.ApiPort),
+			AttributePath.String(resourcePath),
+			AttributeArrayID.String(arrayID),
+			AttributeController.String(controller),
+			AttributeAttempt.Int(attempt),
+			AttributeFailover.Bool(failover),
+			AttributeRequestID.String(requestID(ctx)),
+		))
+}
+
+// endAttemptSpan records the outcome of an HTTP request and ends its span.
+func endAttemptSpan(span trace.Span, response *http.Response, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else if response != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", response.StatusCode))
+		if response.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, response.Status)
+		}
+	}
+	span.End()
+}
+
+// injectTraceContext adds the trace context headers of the current span to a request.
+func (d Client) injectTraceContext(ctx context.Context, request *http.Request) {
+	d.propagator().Inject(ctx, propagation.HeaderCarrier(request.Header))
+}
+
+// withRequestID returns a context with a request ID, generating a new one if the caller did not set any.
+func withRequestID(ctx context.Context) context.Context {
+	if requestID(ctx) != "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ContextKeyRequestID, newRequestID())
+}
+
+// requestID returns the request ID of a context, or an empty string.
+func requestID(ctx context.Context) string {
+	if id := ctx.Value(ContextKeyRequestID); id != nil {
+		return fmt.Sprint(id)
+	}
+	return ""
+}
+
+// newRequestID generates a random (version 4) UUID.
+func newRequestID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// newHTTPClient builds the HTTP client shared by all requests made through a Client. The transport keeps
+// connections to the controllers alive between calls, so the TLS handshake is only repeated when an idle
+// connection expires or a controller closes it.
+func newHTTPClient(config *ClientConfig) (*http.Client, error) {
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: !config.VerifyTLS, // Allow certificate validation override
+		MinVersion:         MinTLSVersion,
+	}
+	if config.VerifyTLS && config.CACertPEM != "" {
+		caCertPool := x509.NewCertPool()
+		if !caCertPool.AppendCertsFromPEM([]byte(config.CACertPEM)) {
+			return nil, fmt.Errorf("could not parse any certificate from CACertPEM: %w", ErrInvalidArgument)
+		}
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   durationOrDefault(config.DialTimeout, DefaultDialTimeout),
+		KeepAlive: 30 * time.Second,
+	}
+
+	tr := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		TLSClientConfig:       tlsConfig,
+		TLSHandshakeTimeout:   durationOrDefault(config.TLSHandshakeTimeout, DefaultTLSHandshakeTimeout),
+		ResponseHeaderTimeout: config.ResponseHeaderTimeout,
+		ExpectContinueTimeout: DefaultExpectContinueTimeout,
+		IdleConnTimeout:       durationOrDefault(config.IdleConnTimeout, DefaultIdleConnTimeout),
+		MaxIdleConns:          intOrDefault(config.MaxIdleConns, DefaultMaxIdleConns),
+		MaxIdleConnsPerHost:   intOrDefault(config.MaxIdleConnsPerHost, DefaultMaxIdleConnsPerHost),
+		MaxConnsPerHost:       config.MaxConnsPerHost,
+		// A custom TLS config disables the automatic HTTP/2 upgrade, so ask for it explicitly. Controllers
+		// that don't offer h2 via ALPN are still spoken to over HTTP/1.1.
+		ForceAttemptHTTP2: !config.DisableHTTP2,
+	}
+
+	var transport http.RoundTripper = tr
+	if config.WrapTransport != nil {
+		transport = config.WrapTransport(tr)
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   durationOrDefault(config.RequestTimeout, StorageAPITimeoutSeconds*time.Second),
+	}, nil
+}
+
+func durationOrDefault(value, defaultValue time.Duration) time.Duration {
+	if value <= 0 {
+		return defaultValue
+	}
+	return value
+}
+
+func intOrDefault(value, defaultValue int) int {
+	if value <= 0 {
+		return defaultValue
+	}
+	return value
+}
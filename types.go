@@ -2,7 +2,7 @@
 
 package santricity
 
-import "fmt"
+import "github.com/scaleoutsean/santricity-go/models/v11"
 
 var HostTypes = map[string]string{
 	"linux_atto":        "LnxTPGSALUA",
@@ -18,16 +18,6 @@ var HostTypes = map[string]string{
 	"windows_clustered": "W2KNETCL",
 }
 
-// Error wrapper
-type Error struct {
-	Code    int
-	Message string
-}
-
-func (e Error) Error() string {
-	return fmt.Sprintf("device API error: %s, Status code: %d", e.Message, e.Code)
-}
-
 // AboutResponse includes basic information about the system.
 type AboutResponse struct {
 	RunningAsProxy     bool   `json:"runningAsProxy"`     // "runningAsProxy": false,
@@ -39,6 +29,8 @@ type AboutResponse struct {
 }
 
 type StorageSystem struct {
+	v11.StorageSystemResponse
+
 	ID              string   `json:"id"`
 	Name            string   `json:"name"`
 	Wwn             string   `json:"wwn"`
@@ -127,6 +119,8 @@ type KeyValues struct {
 }
 
 type VolumeGroupEx struct {
+	v11.VolumeGroupEx
+
 	IsOffline          bool   `json:"offline"`
 	WorldWideName      string `json:"worldWideName"`
 	VolumeGroupRef     string `json:"volumeGroupRef"`
@@ -181,6 +175,8 @@ func (t VolumeTag) Equals(otherTag VolumeTag) bool {
 }
 
 type VolumeEx struct {
+	v11.VolumeEx
+
 	IsOffline      bool         `json:"offline"`
 	Label          string       `json:"label"`
 	VolumeSize     string       `json:"capacity"`
@@ -196,6 +192,63 @@ type VolumeEx struct {
 	VolumeUse      string       `json:"volumeUse,omitempty"` // "standardVolume", "freeRepositoryVolume", etc.
 }
 
+// ThinVolume is a thin provisioned volume on a disk pool. VolumeSize is the virtual capacity reported to hosts;
+// the capacity taken from the pool is that of the volume's repository, which may grow up to
+// ProvisionedCapacityQuota.
+type ThinVolume struct {
+	VolumeEx
+	ThinVolumeDetails
+}
+
+// ThinVolumeDetails are the properties of a thin volume that thick volumes do not have.
+type ThinVolumeDetails struct {
+	RepositoryRef              string `json:"repositoryRef"`
+	InitialProvisionedCapacity string `json:"initialProvisionedCapacity"`
+	CurrentProvisionedCapacity string `json:"currentProvisionedCapacity"` // Current capacity of the repository
+	ProvisionedCapacityQuota   string `json:"provisionedCapacityQuota"`   // Capacity up to which the repository grows
+	MaxVirtualCapacity         string `json:"maxVirtualCapacity"`
+	GrowthAlertThreshold       int    `json:"growthAlertThreshold"` // Repository utilization (%) that raises an alert
+	ExpansionPolicy            string `json:"expansionPolicy"`      // "automatic" or "manual"
+	ReportingPolicy            string `json:"reportingPolicy"`
+}
+
+// ThinVolumeCreateRequest is used to create a thin volume
+type ThinVolumeCreateRequest struct {
+	PoolID                string      `json:"poolId"`
+	Name                  string      `json:"name"`
+	SizeUnit              string      `json:"sizeUnit"` //bytes, b, kb, mb, gb, tb, pb, eb, zb, yb
+	VirtualSize           string      `json:"virtualSize"`
+	RepositorySize        string      `json:"repositorySize"`
+	MaximumRepositorySize string      `json:"maximumRepositorySize"`
+	GrowthAlertThreshold  int         `json:"growthAlertThreshold,omitempty"`
+	ExpansionPolicy       string      `json:"expansionPolicy,omitempty"` // "automatic" (default) or "manual"
+	VolumeTags            []VolumeTag `json:"metaTags,omitempty"`
+}
+
+// ThinVolumeUpdateRequest is used to update a thin volume
+type ThinVolumeUpdateRequest struct {
+	Name                 string      `json:"name,omitempty"`
+	GrowthAlertThreshold int         `json:"growthAlertThreshold,omitempty"`
+	ExpansionPolicy      string      `json:"expansionPolicy,omitempty"`
+	VolumeTags           []VolumeTag `json:"metaTags,omitempty"`
+}
+
+// ThinVolumeExpansionRequest is used to expand the virtual capacity or the repository of a thin volume
+type ThinVolumeExpansionRequest struct {
+	SizeUnit          string `json:"sizeUnit"`
+	NewVirtualSize    string `json:"newVirtualSize,omitempty"`
+	NewRepositorySize string `json:"newRepositorySize,omitempty"`
+}
+
+// ThinVolumeOptions are the optional settings of a thin volume created with CreateThinVolume. Zero values select
+// the defaults.
+type ThinVolumeOptions struct {
+	RepositorySize        uint64 // Initial repository capacity in bytes; default DefaultThinRepositorySize
+	MaximumRepositorySize uint64 // Repository capacity in bytes up to which it may grow; default the virtual size
+	GrowthAlertThreshold  int    // Repository utilization in percent that raises an alert; default set by the array
+	ExpansionPolicy       string // "automatic" (default) or "manual"
+}
+
 type VolumeResizeRequest struct {
 	ExpansionSize int    `json:"expansionSize"`
 	SizeUnit      string `json:"sizeUnit"` //bytes, b, kb, mb, gb, tb, pb, eb, zb, yb
@@ -231,6 +284,8 @@ type HostPort struct {
 }
 
 type HostEx struct {
+	v11.HostEx
+
 	HostRef       string            `json:"hostRef"`
 	ClusterRef    string            `json:"clusterRef"`
 	Label         string            `json:"label"`
@@ -263,6 +318,8 @@ type HostGroupCreateRequest struct {
 }
 
 type HostGroup struct {
+	v11.HostGroup
+
 	ClusterRef string `json:"clusterRef"`
 	Label      string `json:"label"`
 }
@@ -291,6 +348,8 @@ type VolumeMappingCreateRequest struct {
 }
 
 type LUNMapping struct {
+	v11.LUNMapping
+
 	LunMappingRef string `json:"lunMappingRef"`
 	LunNumber     int    `json:"lun"`
 	VolumeRef     string `json:"volumeRef"`
@@ -298,6 +357,13 @@ type LUNMapping struct {
 	Type          string `json:"type"`
 }
 
+// LoginRequest is the body of a session login against the web services (/devmgr/utils/login).
+type LoginRequest struct {
+	UserID        string `json:"userId"`
+	Password      string `json:"password"`
+	XsrfProtected bool   `json:"xsrfProtected"`
+}
+
 // Used for errors on RESTful calls to return what went wrong
 type CallResponseError struct {
 	ErrorMsg     string `json:"errorMessage"`
@@ -306,50 +372,14 @@ type CallResponseError struct {
 	CodeType     string `json:"codeType"` //'symbol', 'webservice', 'systemerror', 'devicemgrerror'
 }
 
+// IscsiTargetSettings are the iSCSI target settings of the array (iscsi/target-settings).
 type IscsiTargetSettings struct {
-	TargetRef string `json:"targetRef"`
-	NodeName  struct {
-		IoInterfaceType string      `json:"ioInterfaceType"`
-		IscsiNodeName   string      `json:"iscsiNodeName"`
-		RemoteNodeWWN   interface{} `json:"remoteNodeWWN"`
-	} `json:"nodeName"`
-	Alias struct {
-		IoInterfaceType string `json:"ioInterfaceType"`
-		IscsiAlias      string `json:"iscsiAlias"`
-	} `json:"alias"`
-	ConfiguredAuthMethods struct {
-		AuthMethodData []struct {
-			AuthMethod string      `json:"authMethod"`
-			ChapSecret interface{} `json:"chapSecret"`
-		} `json:"authMethodData"`
-	} `json:"configuredAuthMethods"`
-	Portals []struct {
-		GroupTag  int `json:"groupTag"`
-		IPAddress struct {
-			AddressType string      `json:"addressType"`
-			Ipv4Address string      `json:"ipv4Address"`
-			Ipv6Address interface{} `json:"ipv6Address"`
-		} `json:"ipAddress"`
-		TCPListenPort int `json:"tcpListenPort"`
-	} `json:"portals"`
+	v11.Target
 }
 
+// NvmeofTargetSettings are the NVMe-oF target settings of the array (nvmeof/initiator-settings).
 type NvmeofTargetSettings struct {
-	TargetRef string `json:"targetRef"`
-	NodeName  struct {
-		IoInterfaceType string  `json:"ioInterfaceType"`
-		NvmeNodeName    string  `json:"nvmeNodeName"`
-		IscsiNodeName   *string `json:"iscsiNodeName"`
-		RemoteNodeWWN   *string `json:"remoteNodeWWN"`
-	} `json:"nodeName"`
-	Alias struct {
-		IoInterfaceType string  `json:"ioInterfaceType"`
-		IscsiAlias      *string `json:"iscsiAlias"`
-	} `json:"alias"`
-	ConfiguredAuthMethods struct {
-		AuthMethodData []interface{} `json:"authMethodData"`
-	} `json:"configuredAuthMethods"`
-	Portals []interface{} `json:"portals"`
+	v11.Target
 }
 
 type VolumeExpansionRequest struct {
@@ -359,6 +389,8 @@ type VolumeExpansionRequest struct {
 
 // SnapshotGroup represents a PiT Group (Snapshot Group)
 type SnapshotGroup struct {
+	v11.SnapshotGroup
+
 	PitGroupRef       string `json:"pitGroupRef"`
 	BaseVolume        string `json:"baseVolume"`
 	Label             string `json:"label"`
@@ -373,6 +405,8 @@ type SnapshotGroup struct {
 // SnapshotImage represents a PiT (Point-in-Time Image/Snapshot)
 // API definition name: "Snapshot"
 type SnapshotImage struct {
+	v11.Snapshot
+
 	PitRef            string `json:"pitRef"`
 	PitGroupRef       string `json:"pitGroupRef"`
 	Status            string `json:"status"`
@@ -384,6 +418,8 @@ type SnapshotImage struct {
 // SnapshotVolume represents a Linked Clone (Snapshot Volume)
 // API definition name: "PitViewEx"
 type SnapshotVolume struct {
+	v11.PitViewEx
+
 	SnapshotRef string `json:"viewRef"` // The ID of the Snapshot Volume (linked clone)
 	BaseVolume  string `json:"baseVol"` // The Base Volume it was created from
 	BasePIT     string `json:"basePIT"` // The Snapshot Image (PiT) this volume accesses
@@ -394,6 +430,8 @@ type SnapshotVolume struct {
 // ConsistencyGroup represents a Consistency Group for snapshots (Snapshot Group container)
 // API definition name: "PITConsistencyGroup"
 type ConsistencyGroup struct {
+	v11.PITConsistencyGroup
+
 	ConsistencyGroupRef string `json:"cgRef"`
 	Label               string `json:"label"`
 	RepFullPolicy       string `json:"repFullPolicy"`
@@ -405,6 +443,8 @@ type ConsistencyGroup struct {
 // ConsistencyGroupMember represents a volume member of a Consistency Group
 // API definition name: "PITCGMember"
 type ConsistencyGroupMember struct {
+	v11.PITCGMember
+
 	ConsistencyGroupId      string `json:"consistencyGroupId"`
 	VolumeId                string `json:"volumeId"`
 	VolumeWwn               string `json:"volumeWwn"`
@@ -419,6 +459,8 @@ type ConsistencyGroupMember struct {
 // ConsistencyGroupView represents a Linked Clone (View) of a Consistency Group Snapshot
 // API definition name: "PITConsistencyGroupView"
 type ConsistencyGroupView struct {
+	v11.PITConsistencyGroupView
+
 	ConsistencyGroupViewRef string `json:"cgViewRef"`
 	GroupRef                string `json:"groupRef"`
 	Label                   string `json:"label"`
@@ -428,7 +470,20 @@ type ConsistencyGroupView struct {
 	Id                      string `json:"id"`
 }
 
+// SnapshotSchedule is a schedule that takes snapshot images of a snapshot group or consistency group.
+// API definition name: "ScheduleInstance"
+type SnapshotSchedule struct {
+	v11.ScheduleInstance
+
+	ID             string `json:"id"`
+	ScheduleStatus string `json:"scheduleStatus"` // "active", "disabled" or "completed"
+	Action         string `json:"action"`         // "newpit" for snapshot groups, "newcgpit" for consistency groups
+	TargetObject   string `json:"targetObject"`   // Ref of the snapshot group or consistency group
+}
+
 type Host struct {
+	v11.HostEx
+
 	ClusterRef     string `json:"clusterRef"`
 	HostRef        string `json:"hostRef"`
 	Name           string `json:"name"`
@@ -444,6 +499,8 @@ type SnapshotRollbackRequest struct {
 // ConcatRepositoryVolume represents a concatenated repository volume used by Snapshots and R/W Linked Clones
 // API definition name: "ConcatRepositoryVolume"
 type ConcatRepositoryVolume struct {
+	v11.ConcatRepositoryVolume
+
 	ConcatVolRef      string   `json:"concatVolRef"`
 	Status            string   `json:"status"`
 	MemberCount       int      `json:"memberCount"`
@@ -454,3 +511,84 @@ type ConcatRepositoryVolume struct {
 	Name              string   `json:"name,omitempty"`
 	MemberNames       string   `json:"memberNames,omitempty"`
 }
+
+// Failure is a problem reported by the array's Recovery Guru, e.g. a failed drive or a degraded volume
+// API definition name: "FailureData"
+type Failure struct {
+	v11.FailureData
+}
+
+// ParityCheckJob is a parity scan of a volume started with StartVolumeParityCheck
+// API definition name: "CheckVolumeParityJobResponse"
+type ParityCheckJob struct {
+	v11.CheckVolumeParityJobResponse
+}
+
+// VolumeCopyJob is a full copy of a source volume to a target volume, running or finished. The target is
+// read-only for hosts while the job exists if it was created with TargetWriteProtected.
+// API definition name: "VolumeCopyPair"
+type VolumeCopyJob struct {
+	v11.VolumeCopyPair
+
+	VolcopyRef   string `json:"volcopyRef"`
+	Status       string `json:"status"` // "pending", "inProgress", "complete", "halted" (stopped) or "failed"
+	SourceVolume string `json:"sourceVolume"`
+	TargetVolume string `json:"targetVolume"`
+	CopyPriority string `json:"copyPriority"`
+}
+
+// CloneVolumeOptions are the optional settings of a full copy made with CloneVolume. Zero values select the
+// defaults.
+type CloneVolumeOptions struct {
+	SnapshotImageRef string // Copy this snapshot image of the source volume instead of its current content
+	CopyPriority     string // "priority0" (lowest) to "priority4"; default set by the array, "priority2"
+	OnlineCopy       bool   // Copy from a snapshot taken by the array, so hosts can keep writing to the source
+	FSType           string // fstype tag of the target; default that of the source
+	ExtraTags        map[string]string
+}
+
+// AsyncMirrorGroup is a group of volumes mirrored asynchronously, at intervals, to another array, where they have
+// a common recovery point. The group exists on both arrays with the same WorldWideName; its GroupRef differs.
+// API definition name: "AsyncMirrorGroupEx"
+type AsyncMirrorGroup struct {
+	v11.AsyncMirrorGroupEx
+
+	GroupRef           string `json:"groupRef"`
+	WorldWideName      string `json:"worldWideName"`
+	Label              string `json:"label"`
+	GroupState         string `json:"groupState"`         // "initialSync", "optimal", "degraded" or "rpFailed"
+	LocalRole          string `json:"localRole"`          // "primary" or "secondary"
+	RemoteRole         string `json:"remoteRole"`         // "primary" or "secondary"
+	RoleChangeProgress string `json:"roleChangeProgress"` // "none", "pending" or "inProgress"
+	SyncActivity       string `json:"syncActivity"`       // "idle", "active", "paused", "userSuspended", ...
+	RemoteTargetID     string `json:"remoteTargetId"`
+}
+
+// AsyncMirrorPair is a volume of an asynchronous mirror group and its counterpart on the other array. LocalVolume
+// and RemoteVolume are as seen from the array that returned the pair.
+// API definition name: "AsyncMirrorGroupMemberEx"
+type AsyncMirrorPair struct {
+	v11.AsyncMirrorGroupMemberEx
+
+	MemberRef             string `json:"memberRef"`
+	MirrorGroup           string `json:"mirrorGroup"`
+	LocalVolume           string `json:"localVolume"`
+	RemoteVolume          string `json:"remoteVolume"`
+	MemberState           string `json:"memberState"`           // "initialSync", "optimal", "incomplete", ...
+	LastRecoveryPointTime string `json:"lastRecoveryPointTime"` // Seconds since the epoch; "0" if there is none
+	RepositoryVolume      string `json:"repositoryVolume"`
+}
+
+// RemoteMirrorPair is a volume mirrored synchronously to a volume on another array (remote volume mirroring).
+// Base is the volume on the array that returned the pair, Target its counterpart on the other array.
+// API definition name: "RemoteMirrorPair"
+type RemoteMirrorPair struct {
+	v11.RemoteMirrorPair
+
+	Base        VolumeEx `json:"base"`
+	Target      VolumeEx `json:"target"`
+	TargetArray string   `json:"targetArray"`
+	ID          string   `json:"id"`
+	Status      string   `json:"status"`    // "optimal", "synchronizing", "unsynchronized", "suspended", ...
+	WriteMode   int      `json:"writeMode"` // RemoteMirrorWriteModeSynchronous, ...
+}
@@ -0,0 +1,481 @@
+// Copyright 2026 NetApp, Inc. All Rights Reserved.
+
+package santricity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/scaleoutsean/santricity-go/models/v11"
+)
+
+// A volume copy job (/volume-copy-jobs) copies every block of a source volume to a target volume of at least the
+// same size. Unlike a snapshot volume, the target does not depend on the source once the copy is complete and the
+// job is removed. Jobs run in the background; VolumeCopy polls one, and /volume-copy-jobs-control stops and
+// restarts them.
+
+// GetVolumeCopyJobs returns the volume copy jobs of the array.
+func (d Client) GetVolumeCopyJobs(ctx context.Context) ([]VolumeCopyJob, error) {
+	ctx, span := d.startSpan(ctx, "GetVolumeCopyJobs")
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "GetVolumeCopyJobs",
+			"Type":   "Client",
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetVolumeCopyJobs")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetVolumeCopyJobs")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/volume-copy-jobs")
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "failed to read volume copy jobs")
+	}
+
+	jobs := make([]VolumeCopyJob, 0)
+	if err := json.Unmarshal(responseBody, &jobs); err != nil {
+		return nil, fmt.Errorf("could not parse volume copy jobs: %s; %v", string(responseBody), err)
+	}
+	return jobs, nil
+}
+
+// GetVolumeCopyJob returns a volume copy job. The returned error matches ErrNotFound if the job does not exist.
+func (d Client) GetVolumeCopyJob(ctx context.Context, jobRef string) (*VolumeCopyJob, error) {
+	ctx, span := d.startSpan(ctx, "GetVolumeCopyJob", AttributeJobID.String(jobRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": "GetVolumeCopyJob",
+			"Type":   "Client",
+			"jobRef": jobRef,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> GetVolumeCopyJob")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< GetVolumeCopyJob")
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/volume-copy-jobs/"+jobRef)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not get volume copy job %s", jobRef)
+	}
+
+	return parseVolumeCopyJob(responseBody)
+}
+
+// CreateVolumeCopyJob starts copying a source volume, which may be a snapshot volume, to an existing target
+// volume. The target must be at least as large as the source; its content is overwritten.
+func (d Client) CreateVolumeCopyJob(
+	ctx context.Context, request v11.VolumeCopyCreateRequest,
+) (*VolumeCopyJob, error) {
+	ctx, span := d.startSpan(ctx, "CreateVolumeCopyJob", AttributeVolumeRef.String(request.SourceID))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":  "CreateVolumeCopyJob",
+			"Type":    "Client",
+			"request": request,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> CreateVolumeCopyJob")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< CreateVolumeCopyJob")
+	}
+
+	if request.SourceID == "" || request.TargetID == "" {
+		return nil, fmt.Errorf("a source and a target volume are required: %w", ErrInvalidArgument)
+	}
+	if request.SourceID == request.TargetID {
+		return nil, fmt.Errorf("volume %s cannot be copied to itself: %w", request.SourceID, ErrInvalidArgument)
+	}
+	if err := checkCopyPriority(request.CopyPriority); err != nil {
+		return nil, err
+	}
+	if err := d.checkFeature("volume copy", "volumeCopy"); err != nil {
+		return nil, err
+	}
+
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/volume-copy-jobs")
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return nil, d.newAPIError(response, responseBody, "could not copy volume %s to volume %s",
+			request.SourceID, request.TargetID)
+	}
+
+	job, err := parseVolumeCopyJob(responseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	Logc(ctx).WithFields(log.Fields{
+		"JobRef":       job.VolcopyRef,
+		"SourceVolume": job.SourceVolume,
+		"TargetVolume": job.TargetVolume,
+	}).Debug("Created volume copy job.")
+
+	return job, nil
+}
+
+// UpdateVolumeCopyJob changes the priority of a volume copy job or the write protection of its target.
+func (d Client) UpdateVolumeCopyJob(
+	ctx context.Context, jobRef string, request v11.VolumeCopyUpdateRequest,
+) (*VolumeCopyJob, error) {
+	ctx, span := d.startSpan(ctx, "UpdateVolumeCopyJob", AttributeJobID.String(jobRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":  "UpdateVolumeCopyJob",
+			"Type":    "Client",
+			"jobRef":  jobRef,
+			"request": request,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> UpdateVolumeCopyJob")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< UpdateVolumeCopyJob")
+	}
+
+	if err := checkCopyPriority(request.CopyPriority); err != nil {
+		return nil, err
+	}
+
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal JSON request: %v; %v", request, err)
+	}
+
+	response, responseBody, err := d.InvokeAPI(ctx, jsonRequest, "POST", "/volume-copy-jobs/"+jobRef)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not update volume copy job %s", jobRef)
+	}
+
+	return parseVolumeCopyJob(responseBody)
+}
+
+// SetVolumeCopyPriority sets the priority of a volume copy job, from "priority0" (lowest) to "priority4". Higher
+// priorities finish sooner at the expense of host I/O.
+func (d Client) SetVolumeCopyPriority(ctx context.Context, jobRef, priority string) (*VolumeCopyJob, error) {
+	if priority == "" {
+		return nil, fmt.Errorf("a copy priority is required: %w", ErrInvalidArgument)
+	}
+	return d.UpdateVolumeCopyJob(ctx, jobRef, v11.VolumeCopyUpdateRequest{CopyPriority: priority})
+}
+
+// DeleteVolumeCopyJob removes a volume copy job, stopping it if it is still running. The target volume keeps
+// what was copied so far and becomes an independent volume. The snapshot repositories of an online copy are
+// deleted unless retainRepositories is set.
+func (d Client) DeleteVolumeCopyJob(ctx context.Context, jobRef string, retainRepositories bool) error {
+	ctx, span := d.startSpan(ctx, "DeleteVolumeCopyJob", AttributeJobID.String(jobRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":             "DeleteVolumeCopyJob",
+			"Type":               "Client",
+			"jobRef":             jobRef,
+			"retainRepositories": retainRepositories,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> DeleteVolumeCopyJob")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< DeleteVolumeCopyJob")
+	}
+
+	resourcePath := "/volume-copy-jobs/" + jobRef
+	if retainRepositories {
+		resourcePath += "?retainRepositories=true"
+	}
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "DELETE", resourcePath)
+	if err != nil {
+		return fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return d.newAPIError(response, responseBody, "could not delete volume copy job %s", jobRef)
+	}
+	return nil
+}
+
+// GetVolumeCopyProgress returns the progress of a running volume copy job.
+func (d Client) GetVolumeCopyProgress(ctx context.Context, jobRef string) (*v11.VolumeCopyProgress, error) {
+	ctx, span := d.startSpan(ctx, "GetVolumeCopyProgress", AttributeJobID.String(jobRef))
+	defer span.End()
+
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "GET", "/volume-copy-jobs-control/"+jobRef)
+	if err != nil {
+		return nil, fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, d.newAPIError(response, responseBody, "could not get progress of volume copy job %s", jobRef)
+	}
+
+	var progress v11.VolumeCopyProgress
+	if err := json.Unmarshal(responseBody, &progress); err != nil {
+		return nil, fmt.Errorf("could not parse volume copy progress: %s; %v", string(responseBody), err)
+	}
+	return &progress, nil
+}
+
+// StartVolumeCopyJob starts a stopped volume copy job again. The copy restarts from the beginning; a completed
+// job copies the source again.
+func (d Client) StartVolumeCopyJob(ctx context.Context, jobRef string) error {
+	return d.controlVolumeCopyJob(ctx, "StartVolumeCopyJob", jobRef, "start")
+}
+
+// StopVolumeCopyJob stops a running volume copy job. The job is kept, in the "halted" state, and can be started
+// again with StartVolumeCopyJob.
+func (d Client) StopVolumeCopyJob(ctx context.Context, jobRef string) error {
+	return d.controlVolumeCopyJob(ctx, "StopVolumeCopyJob", jobRef, "stop")
+}
+
+func (d Client) controlVolumeCopyJob(ctx context.Context, method, jobRef, control string) error {
+	ctx, span := d.startSpan(ctx, method, AttributeJobID.String(jobRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method": method,
+			"Type":   "Client",
+			"jobRef": jobRef,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> " + method)
+		defer Logc(ctx).WithFields(fields).Debug("<<<< " + method)
+	}
+
+	resourcePath := "/volume-copy-jobs-control/" + jobRef + "?" + url.Values{"control": {control}}.Encode()
+	response, responseBody, err := d.InvokeAPI(ctx, nil, "POST", resourcePath)
+	if err != nil {
+		return fmt.Errorf("API invocation failed. %w", err)
+	}
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return d.newAPIError(response, responseBody, "could not %s volume copy job %s", control, jobRef)
+	}
+	return nil
+}
+
+// VolumeCopy returns an Operation that polls a volume copy job. It completes when the copy is complete; a job
+// that failed or was stopped counts as failed.
+func (d Client) VolumeCopy(jobRef string) Operation {
+	return OperationFunc{
+		Description: "volume copy job " + jobRef,
+		PollFunc: func(ctx context.Context) (Progress, error) {
+
+			job, err := d.GetVolumeCopyJob(ctx, jobRef)
+			if err != nil {
+				return Progress{}, err
+			}
+
+			progress := Progress{State: OperationRunning, Action: job.Status}
+			switch job.Status {
+			case "complete":
+				progress.State = OperationComplete
+				progress.PercentComplete = 100
+			case "failed":
+				progress.State = OperationFailed
+				progress.Message = fmt.Sprintf("copy of volume %s to volume %s failed", job.SourceVolume,
+					job.TargetVolume)
+			case "halted":
+				progress.State = OperationFailed
+				progress.Message = fmt.Sprintf("copy of volume %s to volume %s was stopped", job.SourceVolume,
+					job.TargetVolume)
+			case "inProgress":
+				current, err := d.GetVolumeCopyProgress(ctx, jobRef)
+				if err != nil {
+					return Progress{}, err
+				}
+				progress.PercentComplete = current.PercentComplete
+				if current.TimeToCompletion > 0 {
+					progress.TimeRemaining = time.Duration(current.TimeToCompletion) * time.Minute
+				}
+			}
+			return progress, nil
+		},
+	}
+}
+
+// CloneVolume makes a full, independent copy of a volume: it creates a volume named name in a pool, with the
+// block size and fstype of the source, and starts a volume copy job to it. With options.SnapshotImageRef set, a
+// read-only snapshot volume of that image is created and copied instead of the source; remove it with
+// DeleteSnapshotVolume (the job's SourceVolume) once the copy is complete. CloneVolumeAndWait does both for you.
+// Objects created before a failure are removed again.
+func (d Client) CloneVolume(
+	ctx context.Context, sourceRef, name, poolRef string, options CloneVolumeOptions,
+) (*VolumeCopyJob, error) {
+	ctx, span := d.startSpan(ctx, "CloneVolume", AttributeVolumeRef.String(sourceRef), AttributeName.String(name),
+		AttributePoolRef.String(poolRef))
+	defer span.End()
+
+	if d.config.DebugTraceFlags["method"] {
+		fields := log.Fields{
+			"Method":    "CloneVolume",
+			"Type":      "Client",
+			"sourceRef": sourceRef,
+			"name":      name,
+			"poolRef":   poolRef,
+			"options":   options,
+		}
+		Logc(ctx).WithFields(fields).Debug(">>>> CloneVolume")
+		defer Logc(ctx).WithFields(fields).Debug("<<<< CloneVolume")
+	}
+
+	if err := checkCopyPriority(options.CopyPriority); err != nil {
+		return nil, err
+	}
+
+	source, err := d.GetVolumeByRef(ctx, sourceRef)
+	if err != nil {
+		return nil, err
+	}
+	if options.SnapshotImageRef != "" {
+		image, err := d.GetSnapshotImage(ctx, options.SnapshotImageRef)
+		if err != nil {
+			return nil, err
+		}
+		if image.BaseVol != source.VolumeRef {
+			return nil, fmt.Errorf("snapshot image %s is not an image of volume %s: %w", image.PitRef,
+				source.VolumeRef, ErrInvalidArgument)
+		}
+	}
+
+	fstype := options.FSType
+	if fstype == "" {
+		for _, tag := range source.VolumeTags {
+			if tag.Key == "fstype" {
+				fstype = tag.Value
+			}
+		}
+	}
+	size, err := strconv.ParseUint(source.VolumeSize, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the size %q of volume %s: %v", source.VolumeSize, sourceRef, err)
+	}
+
+	target, err := d.CreateVolume(ctx, name, poolRef, size, "", fstype, "", source.BlockSize, 0, options.ExtraTags)
+	if err != nil {
+		return nil, err
+	}
+
+	copySource := source.VolumeRef
+	if options.SnapshotImageRef != "" {
+		view, err := d.CreateSnapshotVolume(ctx, SnapshotVolumeCreateRequest{
+			SnapshotImageId: options.SnapshotImageRef,
+			Name:            cloneSourceName(name),
+			ViewMode:        "readOnly",
+		})
+		if err != nil {
+			d.removeCloneTarget(ctx, target)
+			return nil, err
+		}
+		copySource = view.SnapshotRef
+	}
+
+	request := v11.VolumeCopyCreateRequest{
+		SourceID:     copySource,
+		TargetID:     target.VolumeRef,
+		CopyPriority: options.CopyPriority,
+	}
+	if options.OnlineCopy {
+		request.OnlineCopy = &options.OnlineCopy
+	}
+	job, err := d.CreateVolumeCopyJob(ctx, request)
+	if err != nil {
+		if copySource != source.VolumeRef {
+			if deleteErr := d.DeleteSnapshotVolume(ctx, copySource); deleteErr != nil {
+				Logc(ctx).WithError(deleteErr).WithField("SnapshotVolumeRef", copySource).Warning(
+					"Could not remove the snapshot volume of a failed clone.")
+			}
+		}
+		d.removeCloneTarget(ctx, target)
+		return nil, err
+	}
+
+	Logc(ctx).WithFields(log.Fields{
+		"SourceVolume": source.Label,
+		"TargetVolume": target.Label,
+		"JobRef":       job.VolcopyRef,
+	}).Info("Started volume clone.")
+
+	return job, nil
+}
+
+// CloneVolumeAndWait clones a volume like CloneVolume and waits for the copy to complete. It then removes the
+// copy job, and the snapshot volume copied from if any, and returns the new volume. If the copy does not
+// complete, the job is kept for inspection and the error of WaitFor returned.
+func (d Client) CloneVolumeAndWait(
+	ctx context.Context, sourceRef, name, poolRef string, options CloneVolumeOptions, config WaitConfig,
+) (VolumeEx, error) {
+	ctx, span := d.startSpan(ctx, "CloneVolumeAndWait", AttributeVolumeRef.String(sourceRef),
+		AttributeName.String(name))
+	defer span.End()
+
+	job, err := d.CloneVolume(ctx, sourceRef, name, poolRef, options)
+	if err != nil {
+		return VolumeEx{}, err
+	}
+	if _, err := WaitFor(ctx, d.VolumeCopy(job.VolcopyRef), config); err != nil {
+		return VolumeEx{}, err
+	}
+
+	if err := d.DeleteVolumeCopyJob(ctx, job.VolcopyRef, false); err != nil {
+		return VolumeEx{}, err
+	}
+	if options.SnapshotImageRef != "" {
+		if err := d.DeleteSnapshotVolume(ctx, job.SourceVolume); err != nil {
+			return VolumeEx{}, err
+		}
+	}
+	return d.GetVolumeByRef(ctx, job.TargetVolume)
+}
+
+// removeCloneTarget deletes the target volume of a clone that could not be started.
+func (d Client) removeCloneTarget(ctx context.Context, target VolumeEx) {
+	if err := d.DeleteVolume(ctx, target); err != nil {
+		Logc(ctx).WithError(err).WithField("VolumeRef", target.VolumeRef).Warning(
+			"Could not remove the target volume of a failed clone.")
+	}
+}
+
+// cloneSourceName returns the name of the snapshot volume that a clone of a snapshot image is copied from.
+func cloneSourceName(name string) string {
+	const suffix = "_src"
+	if len(name) > maxNameLength-len(suffix) {
+		name = name[:maxNameLength-len(suffix)]
+	}
+	return name + suffix
+}
+
+// checkCopyPriority returns an error if a copy priority is set but not one the array accepts.
+func checkCopyPriority(priority string) error {
+	switch priority {
+	case "", "priority0", "priority1", "priority2", "priority3", "priority4":
+		return nil
+	}
+	return fmt.Errorf("the copy priority %q is not one of priority0 to priority4: %w", priority, ErrInvalidArgument)
+}
+
+// parseVolumeCopyJob parses a volume copy job returned by the array.
+func parseVolumeCopyJob(responseBody []byte) (*VolumeCopyJob, error) {
+	var job VolumeCopyJob
+	if err := json.Unmarshal(responseBody, &job); err != nil {
+		return nil, fmt.Errorf("could not parse volume copy job: %s; %v", string(responseBody), err)
+	}
+	return &job, nil
+}